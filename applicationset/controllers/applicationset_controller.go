@@ -16,14 +16,18 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"runtime/debug"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	log "github.com/sirupsen/logrus"
@@ -44,6 +48,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
@@ -67,13 +72,11 @@ const (
 	//   https://github.com/argoproj-labs/argocd-notifications/blob/33d345fa838829bb50fca5c08523aba380d2c12b/pkg/controller/state.go#L17
 	NotifiedAnnotationKey             = "notified.notifications.argoproj.io"
 	ReconcileRequeueOnValidationError = time.Minute * 3
+	// rolloutRollbackRetryInterval is the interval at which the rollbacks of a rollout which could not be started are
+	// retried
+	rolloutRollbackRetryInterval = 10 * time.Second
 )
 
-var defaultPreservedAnnotations = []string{
-	NotifiedAnnotationKey,
-	argov1alpha1.AnnotationKeyRefresh,
-}
-
 // ApplicationSetReconciler reconciles a ApplicationSet object
 type ApplicationSetReconciler struct {
 	client.Client
@@ -92,6 +95,12 @@ type ApplicationSetReconciler struct {
 	GlobalPreservedAnnotations []string
 	GlobalPreservedLabels      []string
 	Metrics                    *metrics.ApplicationsetMetrics
+	// ResourceWatcher requeues the ApplicationSets using KubernetesResource generators when their resources change
+	ResourceWatcher *generators.ResourceWatcher
+	// LastKnownGood records the generators which fell back to their last known good parameters
+	LastKnownGood *generators.LastKnownGood
+	// RolloutAnalysisAllowedURLs are the URLs the analyses of RollingSync steps may call. Analyses are disabled if empty.
+	RolloutAnalysisAllowedURLs []string
 }
 
 // +kubebuilder:rbac:groups=argoproj.io,resources=applicationsets,verbs=get;list;watch;create;update;patch;delete
@@ -119,6 +128,8 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	if err := r.Get(ctx, req.NamespacedName, &applicationSetInfo); err != nil {
 		if client.IgnoreNotFound(err) != nil {
 			logCtx.WithError(err).Infof("unable to get ApplicationSet: '%v' ", err)
+		} else {
+			r.ResourceWatcher.Forget(req.NamespacedName)
 		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
@@ -153,6 +164,10 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	// Log a warning if there are unrecognized generators
 	_ = utils.CheckInvalidGenerators(&applicationSetInfo)
+	// The resources watched for the ApplicationSet which are not used by its generators anymore are removed once the
+	// applications are generated
+	r.ResourceWatcher.MarkStale(req.NamespacedName)
+	r.LastKnownGood.ClearFallbacks(req.NamespacedName)
 	// desiredApplications is the main list of all expected Applications from all generators in this appset.
 	desiredApplications, applicationSetReason, err := template.GenerateApplications(logCtx, applicationSetInfo, r.Generators, r.Renderer, r.Client)
 	if err != nil {
@@ -171,6 +186,14 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	parametersGenerated = true
+	r.ResourceWatcher.RemoveStale(req.NamespacedName)
+
+	generatorFallbacks := r.LastKnownGood.Fallbacks(req.NamespacedName)
+	if len(generatorFallbacks) > 0 {
+		message := generators.FallbacksMessage(generatorFallbacks)
+		logCtx.Warn(message)
+		r.Recorder.Event(&applicationSetInfo, corev1.EventTypeWarning, argov1alpha1.ApplicationSetReasonParametersStale, message)
+	}
 
 	validateErrors, err := r.validateGeneratedApplications(ctx, desiredApplications, applicationSetInfo)
 	if err != nil {
@@ -209,26 +232,49 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	appMap := map[string]argov1alpha1.Application{}
 	// appSyncMap tracks which apps will be synced during this reconciliation.
 	appSyncMap := map[string]bool{}
+	// appUpdateMap tracks which apps will have their spec updated during this reconciliation, with the RollingUpdate strategy.
+	appUpdateMap := map[string]bool{}
+	// rolloutRequeueAfter is the time after which a paused RollingSync step must be checked again
+	var rolloutRequeueAfter time.Duration
 
 	if r.EnableProgressiveSyncs {
 		if !isRollingSyncStrategy(&applicationSetInfo) && len(applicationSetInfo.Status.ApplicationStatus) > 0 {
-			// If an appset was previously syncing with a `RollingSync` strategy but it has switched to the default strategy, clean up the progressive sync application statuses
+			// If an appset was previously syncing with a `RollingSync` strategy but it has switched to another strategy, clean up the progressive sync application statuses
 			logCtx.Infof("Removing %v unnecessary AppStatus entries from ApplicationSet %v", len(applicationSetInfo.Status.ApplicationStatus), applicationSetInfo.Name)
 
 			err := r.setAppSetApplicationStatus(ctx, logCtx, &applicationSetInfo, []argov1alpha1.ApplicationSetApplicationStatus{})
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to clear previous AppSet application statuses for %v: %w", applicationSetInfo.Name, err)
 			}
-		} else if isRollingSyncStrategy(&applicationSetInfo) {
+		}
+		if !isRollingSyncStrategy(&applicationSetInfo) && !isRollingUpdateStrategy(&applicationSetInfo) && len(applicationSetInfo.Status.RolloutSteps) > 0 {
+			// the rollout steps are only reported by the `RollingSync` and `RollingUpdate` strategies
+			err := r.setAppSetRolloutStepStatus(ctx, logCtx, &applicationSetInfo, []argov1alpha1.ApplicationSetRolloutStepStatus{})
+			if err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to clear previous AppSet rollout step statuses for %v: %w", applicationSetInfo.Name, err)
+			}
+		}
+
+		if isRollingSyncStrategy(&applicationSetInfo) {
 			// The appset uses progressive sync with `RollingSync` strategy
 			for _, app := range currentApplications {
 				appMap[app.Name] = app
 			}
 
-			appSyncMap, err = r.performProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, desiredApplications, appMap)
+			appSyncMap, rolloutRequeueAfter, err = r.performProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, desiredApplications, appMap)
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to perform progressive sync reconciliation for application set: %w", err)
 			}
+		} else if isRollingUpdateStrategy(&applicationSetInfo) {
+			// The appset uses progressive sync with `RollingUpdate` strategy
+			for _, app := range currentApplications {
+				appMap[app.Name] = app
+			}
+
+			appUpdateMap, err = r.performRollingUpdate(ctx, logCtx, applicationSetInfo, desiredApplications, appMap)
+			if err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to perform rolling update reconciliation for application set: %w", err)
+			}
 		}
 	}
 
@@ -265,6 +311,10 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		if progressiveSyncsRollingSyncStrategyEnabled(&applicationSetInfo) {
 			validApps = r.syncValidApplications(logCtx, &applicationSetInfo, appSyncMap, appMap, validApps)
 		}
+		// hold the spec changes of the Applications not yet updated if RollingUpdate strategy is enabled
+		if progressiveSyncsRollingUpdateStrategyEnabled(&applicationSetInfo) {
+			validApps = r.holdValidApplications(logCtx, appUpdateMap, appMap, validApps)
+		}
 	}
 
 	if utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowUpdate() {
@@ -297,9 +347,28 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
+	deletionsPending := false
 	if utils.DefaultPolicy(applicationSetInfo.Spec.SyncPolicy, r.Policy, r.EnablePolicyOverride).AllowDelete() {
 		err = r.deleteInCluster(ctx, logCtx, applicationSetInfo, desiredApplications)
-		if err != nil {
+		var maxDeletionsErr *maxDeletionsExceededError
+		if errors.As(err, &maxDeletionsErr) {
+			// The deletions are paused until they are approved, the other changes are still applied
+			deletionsPending = true
+			logCtx.Warn(err.Error())
+			if _, approved := applicationSetInfo.Annotations[common.AnnotationApplicationSetApproveDeletion]; approved {
+				logCtx.Warn("the deletion approval does not match the deletions awaiting approval, it is ignored")
+			}
+			r.Recorder.Event(&applicationSetInfo, corev1.EventTypeWarning, argov1alpha1.ApplicationSetReasonDeletionThresholdExceeded, err.Error())
+			_ = r.setApplicationSetStatusCondition(ctx,
+				&applicationSetInfo,
+				argov1alpha1.ApplicationSetCondition{
+					Type:    argov1alpha1.ApplicationSetConditionResourcesUpToDate,
+					Message: err.Error(),
+					Reason:  argov1alpha1.ApplicationSetReasonDeletionThresholdExceeded,
+					Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
+				}, parametersGenerated,
+			)
+		} else if err != nil {
 			_ = r.setApplicationSetStatusCondition(ctx,
 				&applicationSetInfo,
 				argov1alpha1.ApplicationSetCondition{
@@ -311,6 +380,15 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			)
 			return ctrl.Result{}, err
 		}
+
+		// The approval only applies to the deletions pending when it was given. An approval which does not match the
+		// pending deletions is removed as well, the deletions have to be approved again.
+		if _, approved := applicationSetInfo.Annotations[common.AnnotationApplicationSetApproveDeletion]; approved {
+			if err := r.removeApplicationSetAnnotation(ctx, &applicationSetInfo, common.AnnotationApplicationSetApproveDeletion); err != nil {
+				logCtx.Warnf("error removing the deletion approval annotation: %v", err)
+				return ctrl.Result{}, err
+			}
+		}
 	}
 
 	if applicationSetInfo.RefreshRequired() {
@@ -332,18 +410,27 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	requeueAfter := r.getMinRequeueAfter(&applicationSetInfo)
+	if rolloutRequeueAfter != 0 && (requeueAfter == 0 || rolloutRequeueAfter < requeueAfter) {
+		requeueAfter = rolloutRequeueAfter
+	}
+	// The failed generators are retried like on errors, while their last known good parameters are used
+	if len(generatorFallbacks) > 0 && (requeueAfter == 0 || ReconcileRequeueOnValidationError < requeueAfter) {
+		requeueAfter = ReconcileRequeueOnValidationError
+	}
 
 	if len(validateErrors) == 0 {
-		if err := r.setApplicationSetStatusCondition(ctx,
-			&applicationSetInfo,
-			argov1alpha1.ApplicationSetCondition{
-				Type:    argov1alpha1.ApplicationSetConditionResourcesUpToDate,
-				Message: "All applications have been generated successfully",
-				Reason:  argov1alpha1.ApplicationSetReasonApplicationSetUpToDate,
-				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
-			}, parametersGenerated,
-		); err != nil {
-			return ctrl.Result{}, err
+		if !deletionsPending {
+			if err := r.setApplicationSetStatusCondition(ctx,
+				&applicationSetInfo,
+				argov1alpha1.ApplicationSetCondition{
+					Type:    argov1alpha1.ApplicationSetConditionResourcesUpToDate,
+					Message: "All applications have been generated successfully",
+					Reason:  argov1alpha1.ApplicationSetReasonApplicationSetUpToDate,
+					Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+				}, parametersGenerated,
+			); err != nil {
+				return ctrl.Result{}, err
+			}
 		}
 	} else if requeueAfter == time.Duration(0) {
 		// Ensure that the request is requeued if there are validation errors.
@@ -415,7 +502,18 @@ func (r *ApplicationSetReconciler) setApplicationSetStatusCondition(ctx context.
 	}
 
 	paramtersGeneratedCondition := getParametersGeneratedCondition(paramtersGenerated, condition.Message)
+	if paramtersGenerated {
+		fallbacks := r.LastKnownGood.Fallbacks(types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name})
+		if message := generators.FallbacksMessage(fallbacks); message != "" {
+			paramtersGeneratedCondition.Reason = argov1alpha1.ApplicationSetReasonParametersStale
+			paramtersGeneratedCondition.Message = message
+		}
+	}
 	resourceUpToDateCondition := getResourceUpToDateCondition(errOccurred, condition.Message, condition.Reason)
+	if condition.Type == argov1alpha1.ApplicationSetConditionResourcesUpToDate && condition.Status == argov1alpha1.ApplicationSetConditionStatusFalse {
+		// the resources are not up to date without an error, e.g. when deletions are awaiting approval
+		resourceUpToDateCondition = condition
+	}
 
 	evaluatedTypes := map[argov1alpha1.ApplicationSetConditionType]bool{
 		argov1alpha1.ApplicationSetConditionErrorOccurred:       true,
@@ -556,7 +654,7 @@ func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager, enableProg
 	appOwnsHandler := getApplicationOwnsHandler(enableProgressiveSyncs)
 	appSetOwnsHandler := getApplicationSetOwnsHandler(enableProgressiveSyncs)
 
-	return ctrl.NewControllerManagedBy(mgr).WithOptions(controller.Options{
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).WithOptions(controller.Options{
 		MaxConcurrentReconciles: maxConcurrentReconciliations,
 	}).For(&argov1alpha1.ApplicationSet{}, builder.WithPredicates(appSetOwnsHandler)).
 		Owns(&argov1alpha1.Application{}, builder.WithPredicates(appOwnsHandler)).
@@ -566,8 +664,11 @@ func (r *ApplicationSetReconciler) SetupWithManager(mgr ctrl.Manager, enableProg
 			&clusterSecretEventHandler{
 				Client: mgr.GetClient(),
 				Log:    log.WithField("type", "createSecretEventHandler"),
-			}).
-		Complete(r)
+			})
+	if r.ResourceWatcher != nil {
+		controllerBuilder = controllerBuilder.WatchesRawSource(source.Channel(r.ResourceWatcher.Events(), &handler.EnqueueRequestForObject{}))
+	}
+	return controllerBuilder.Complete(r)
 }
 
 // createOrUpdateInCluster will create / update application resources in the cluster.
@@ -595,14 +696,6 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 		}
 
 		action, err := utils.CreateOrUpdate(ctx, appLog, r.Client, applicationSet.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, found, func() error {
-			// Copy only the Application/ObjectMeta fields that are significant, from the generatedApp
-			found.Spec = generatedApp.Spec
-
-			// allow setting the Operation field to trigger a sync operation on an Application
-			if generatedApp.Operation != nil {
-				found.Operation = generatedApp.Operation
-			}
-
 			preservedAnnotations := make([]string, 0)
 			preservedLabels := make([]string, 0)
 
@@ -622,41 +715,9 @@ func (r *ApplicationSetReconciler) createOrUpdateInCluster(ctx context.Context,
 			// Preserve specially treated argo cd annotations:
 			// * https://github.com/argoproj/applicationset/issues/180
 			// * https://github.com/argoproj/argo-cd/issues/10500
-			preservedAnnotations = append(preservedAnnotations, defaultPreservedAnnotations...)
-
-			for _, key := range preservedAnnotations {
-				if state, exists := found.Annotations[key]; exists {
-					if generatedApp.Annotations == nil {
-						generatedApp.Annotations = map[string]string{}
-					}
-					generatedApp.Annotations[key] = state
-				}
-			}
-
-			for _, key := range preservedLabels {
-				if state, exists := found.Labels[key]; exists {
-					if generatedApp.Labels == nil {
-						generatedApp.Labels = map[string]string{}
-					}
-					generatedApp.Labels[key] = state
-				}
-			}
-
-			// Preserve post-delete finalizers:
-			//   https://github.com/argoproj/argo-cd/issues/17181
-			for _, finalizer := range found.Finalizers {
-				if strings.HasPrefix(finalizer, argov1alpha1.PostDeleteFinalizerName) {
-					if generatedApp.Finalizers == nil {
-						generatedApp.Finalizers = []string{}
-					}
-					generatedApp.Finalizers = append(generatedApp.Finalizers, finalizer)
-				}
-			}
-
-			found.Annotations = generatedApp.Annotations
+			preservedAnnotations = append(preservedAnnotations, utils.DefaultPreservedAnnotations...)
 
-			found.Finalizers = generatedApp.Finalizers
-			found.Labels = generatedApp.Labels
+			utils.MergeGeneratedApplication(found, generatedApp, preservedAnnotations, preservedLabels)
 
 			return controllerutil.SetControllerReference(&applicationSet, found, r.Scheme)
 		})
@@ -738,38 +799,82 @@ func (r *ApplicationSetReconciler) deleteInCluster(ctx context.Context, logCtx *
 		m[app.Name] = true
 	}
 
+	var deleteApps []argov1alpha1.Application
+	var deleteAppNames []string
+	for _, app := range current {
+		if _, exists := m[app.Name]; !exists {
+			deleteApps = append(deleteApps, app)
+			deleteAppNames = append(deleteAppNames, app.Name)
+		}
+	}
+
+	// The approval only holds for the exact set of deletions it was given for: if the set changed since, the
+	// deletions are paused again until they are approved anew.
+	deletionSet := utils.DeletionSetDigest(deleteAppNames)
+	if applicationSet.Annotations[common.AnnotationApplicationSetApproveDeletion] != deletionSet {
+		if err := checkMaxDeletions(applicationSet, len(current), len(deleteApps), deletionSet); err != nil {
+			return err
+		}
+	}
+
 	// Delete apps that are not in m[string]bool
 	var firstError error
-	for _, app := range current {
+	for _, app := range deleteApps {
 		logCtx = logCtx.WithFields(applog.GetAppLogFields(&app))
-		_, exists := m[app.Name]
 
-		if !exists {
-			// Removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
-			err := r.removeFinalizerOnInvalidDestination(ctx, applicationSet, &app, clusterList, logCtx)
-			if err != nil {
-				logCtx.WithError(err).Error("failed to update Application")
-				if firstError != nil {
-					firstError = err
-				}
-				continue
+		// Removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
+		err := r.removeFinalizerOnInvalidDestination(ctx, applicationSet, &app, clusterList, logCtx)
+		if err != nil {
+			logCtx.WithError(err).Error("failed to update Application")
+			if firstError != nil {
+				firstError = err
 			}
+			continue
+		}
 
-			err = r.Delete(ctx, &app)
-			if err != nil {
-				logCtx.WithError(err).Error("failed to delete Application")
-				if firstError != nil {
-					firstError = err
-				}
-				continue
+		err = r.Delete(ctx, &app)
+		if err != nil {
+			logCtx.WithError(err).Error("failed to delete Application")
+			if firstError != nil {
+				firstError = err
 			}
-			r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, "Deleted", "Deleted Application %q", app.Name)
-			logCtx.Log(log.InfoLevel, "Deleted application")
+			continue
 		}
+		r.Recorder.Eventf(&applicationSet, corev1.EventTypeNormal, "Deleted", "Deleted Application %q", app.Name)
+		logCtx.Log(log.InfoLevel, "Deleted application")
 	}
 	return firstError
 }
 
+// maxDeletionsExceededError is returned when a reconcile would delete more Applications than the maxDeletions of the
+// ApplicationSet allows, and the deletions are not approved.
+type maxDeletionsExceededError struct {
+	deletions    int
+	maxDeletions int
+	// deletionSet is the digest of the deletions, which their approval must hold
+	deletionSet string
+}
+
+func (e *maxDeletionsExceededError) Error() string {
+	return fmt.Sprintf("%d Applications would be deleted, which exceeds the maximum of %d deletions: the deletions are awaiting approval %s", e.deletions, e.maxDeletions, utils.FormatDeletionSet(e.deletionSet))
+}
+
+// checkMaxDeletions returns an error if deleting the given number of Applications, out of the current Applications of
+// the ApplicationSet, exceeds the maxDeletions of its sync policy. deletionSet is the digest of the deletions.
+func checkMaxDeletions(applicationSet argov1alpha1.ApplicationSet, currentCount int, deleteCount int, deletionSet string) error {
+	if deleteCount == 0 || applicationSet.Spec.SyncPolicy == nil || applicationSet.Spec.SyncPolicy.MaxDeletions == nil {
+		return nil
+	}
+	maxDeletions, err := intstr.GetScaledValueFromIntOrPercent(applicationSet.Spec.SyncPolicy.MaxDeletions, currentCount, false)
+	if err != nil {
+		return fmt.Errorf("invalid maxDeletions: %w", err)
+	}
+	if deleteCount > maxDeletions {
+		return &maxDeletionsExceededError{deletions: deleteCount, maxDeletions: maxDeletions, deletionSet: deletionSet}
+	}
+	return nil
+}
+
 // removeFinalizerOnInvalidDestination removes the Argo CD resources finalizer if the application contains an invalid target (eg missing cluster)
 func (r *ApplicationSetReconciler) removeFinalizerOnInvalidDestination(ctx context.Context, applicationSet argov1alpha1.ApplicationSet, app *argov1alpha1.Application, clusterList []utils.ClusterSpecifier, appLog *log.Entry) error {
 	// Only check if the finalizers need to be removed IF there are finalizers to remove
@@ -855,12 +960,14 @@ func (r *ApplicationSetReconciler) removeOwnerReferencesOnDeleteAppSet(ctx conte
 	return nil
 }
 
-func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application, appMap map[string]argov1alpha1.Application) (map[string]bool, error) {
+// performProgressiveSyncs returns the Applications allowed to sync, and the time after which the ApplicationSet must
+// be reconciled again to complete a paused step, if any.
+func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application, appMap map[string]argov1alpha1.Application) (map[string]bool, time.Duration, error) {
 	appDependencyList, appStepMap := r.buildAppDependencyList(logCtx, appset, desiredApplications)
 
 	_, err := r.updateApplicationSetApplicationStatus(ctx, logCtx, &appset, applications, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset app status: %w", err)
+		return nil, 0, fmt.Errorf("failed to update applicationset app status: %w", err)
 	}
 
 	logCtx.Infof("ApplicationSet %v step list:", appset.Name)
@@ -868,17 +975,212 @@ func (r *ApplicationSetReconciler) performProgressiveSyncs(ctx context.Context,
 		logCtx.Infof("step %v: %+v", i+1, step)
 	}
 
+	requeueAfter, err := r.updateApplicationSetRolloutStepStatus(ctx, logCtx, &appset, appDependencyList, appMap)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to update applicationset rollout step status: %w", err)
+	}
+
 	appSyncMap := r.buildAppSyncMap(appset, appDependencyList, appMap)
 	logCtx.Infof("Application allowed to sync before maxUpdate?: %+v", appSyncMap)
 
 	_, err = r.updateApplicationSetApplicationStatusProgress(ctx, logCtx, &appset, appSyncMap, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset application status progress: %w", err)
+		return nil, 0, fmt.Errorf("failed to update applicationset application status progress: %w", err)
 	}
 
 	_ = r.updateApplicationSetApplicationStatusConditions(ctx, &appset)
 
-	return appSyncMap, nil
+	return appSyncMap, requeueAfter, nil
+}
+
+// performRollingUpdate returns whether the spec of each generated Application is allowed to be updated. The Applications
+// of a step are updated once the Applications of the previous steps are up to date and healthy, at most maxUpdate at a
+// time. The Applications not selected by any step are updated last.
+func (r *ApplicationSetReconciler) performRollingUpdate(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, desiredApplications []argov1alpha1.Application, appMap map[string]argov1alpha1.Application) (map[string]bool, error) {
+	appDependencyList, appStepMap := r.buildAppDependencyList(logCtx, appset, desiredApplications)
+
+	logCtx.Infof("ApplicationSet %v step list:", appset.Name)
+	for i, step := range appDependencyList {
+		logCtx.Infof("step %v: %+v", i+1, step)
+	}
+
+	// appOutdatedMap tracks which existing Applications have a pending spec change
+	appOutdatedMap := map[string]bool{}
+	for i := range desiredApplications {
+		currentApp, ok := appMap[desiredApplications[i].Name]
+		if !ok {
+			// new Applications are created right away
+			continue
+		}
+		outdated, err := utils.ApplicationSpecChanged(appset.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, &currentApp, &desiredApplications[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare the spec of application %s: %w", currentApp.Name, err)
+		}
+		appOutdatedMap[currentApp.Name] = outdated
+	}
+
+	appUpdateMap, stepStatuses := buildAppUpdateMap(logCtx, &appset, appDependencyList, appOutdatedMap, appMap)
+	for i := range desiredApplications {
+		if _, ok := appStepMap[desiredApplications[i].Name]; !ok {
+			appUpdateMap[desiredApplications[i].Name] = rolloutStepsCompleted(stepStatuses)
+		}
+	}
+	logCtx.Infof("Application allowed to update: %+v", appUpdateMap)
+
+	err := r.setAppSetRolloutStepStatus(ctx, logCtx, &appset, stepStatuses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set AppSet rollout step statuses: %w", err)
+	}
+
+	return appUpdateMap, nil
+}
+
+// buildAppUpdateMap determines which Applications of the RollingUpdate steps are allowed to be updated, and computes
+// the status of each step.
+func buildAppUpdateMap(logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, appDependencyList [][]string, appOutdatedMap map[string]bool, appMap map[string]argov1alpha1.Application) (map[string]bool, []argov1alpha1.ApplicationSetRolloutStepStatus) {
+	now := metav1.Now()
+	appUpdateMap := map[string]bool{}
+	stepStatuses := make([]argov1alpha1.ApplicationSetRolloutStepStatus, 0, len(appDependencyList))
+	previousStepsCompleted := true
+
+	for i, appNames := range appDependencyList {
+		outdatedApps := []string{}
+		updatingApps := []string{}
+		for _, appName := range appNames {
+			if appOutdatedMap[appName] {
+				outdatedApps = append(outdatedApps, appName)
+				continue
+			}
+			if !isApplicationUpToDate(appMap[appName]) {
+				// the Application was updated, or is new, and is not yet synced and healthy
+				updatingApps = append(updatingApps, describeApplicationRolloutState(appMap[appName]))
+			}
+			appUpdateMap[appName] = true
+		}
+		updatingCount := len(updatingApps)
+
+		maxUpdateVal := len(appNames)
+		if maxUpdate := applicationSet.Spec.Strategy.RollingUpdate.Steps[i].MaxUpdate; maxUpdate != nil {
+			val, err := intstr.GetScaledValueFromIntOrPercent(maxUpdate, len(appNames), false)
+			if err != nil {
+				logCtx.Warnf("AppSet '%v' has a invalid maxUpdate value '%+v', ignoring maxUpdate logic for this step: %v", applicationSet.Name, maxUpdate, err)
+			} else {
+				// ensure that percentage values greater than 0% always result in at least 1 Application being selected
+				if maxUpdate.Type == intstr.String && maxUpdate.StrVal != "0%" && val < 1 {
+					val = 1
+				}
+				maxUpdateVal = val
+			}
+		}
+
+		upToDateCount := len(appNames) - len(outdatedApps) - updatingCount
+
+		// outdated Applications are updated in a stable order, as long as maxUpdate is not reached
+		sort.Strings(outdatedApps)
+		for _, appName := range outdatedApps {
+			allowed := previousStepsCompleted && updatingCount < maxUpdateVal
+			if allowed {
+				logCtx.Infof("Application %v is allowed to update in step %v", appName, i+1)
+				updatingCount++
+			}
+			appUpdateMap[appName] = allowed
+		}
+
+		stepStatus := argov1alpha1.ApplicationSetRolloutStepStatus{
+			Step:    strconv.Itoa(i + 1),
+			Status:  argov1alpha1.RolloutStepStatusHealthy,
+			Message: "Applications of the step are up to date and Healthy.",
+		}
+		switch {
+		case len(outdatedApps) == 0 && updatingCount == 0:
+		case previousStepsCompleted:
+			stepStatus.Status = argov1alpha1.RolloutStepStatusProgressing
+			stepStatus.Message = fmt.Sprintf("%d/%d Applications of the step are up to date and Healthy.", upToDateCount, len(appNames))
+			if len(updatingApps) > 0 {
+				// the Applications holding the rollout are listed, so that a stalled rollout can be diagnosed
+				stepStatus.Message += " Waiting for: " + strings.Join(updatingApps, ", ") + "."
+			}
+		default:
+			stepStatus.Status = argov1alpha1.RolloutStepStatusWaiting
+			stepStatus.Message = "Applications of the step are waiting for the previous steps to be updated."
+		}
+		if idx := findRolloutStepStatusIndex(applicationSet.Status.RolloutSteps, stepStatus.Step); idx > -1 && applicationSet.Status.RolloutSteps[idx].Status == stepStatus.Status {
+			stepStatus.LastTransitionTime = applicationSet.Status.RolloutSteps[idx].LastTransitionTime
+		}
+		if stepStatus.LastTransitionTime == nil {
+			stepStatus.LastTransitionTime = &now
+		}
+		stepStatuses = append(stepStatuses, stepStatus)
+
+		previousStepsCompleted = previousStepsCompleted && stepStatus.Status == argov1alpha1.RolloutStepStatusHealthy
+	}
+
+	return appUpdateMap, stepStatuses
+}
+
+// rolloutStepsCompleted returns whether all the steps of the rollout are Healthy
+func rolloutStepsCompleted(stepStatuses []argov1alpha1.ApplicationSetRolloutStepStatus) bool {
+	for _, stepStatus := range stepStatuses {
+		if stepStatus.Status != argov1alpha1.RolloutStepStatusHealthy {
+			return false
+		}
+	}
+	return true
+}
+
+// isApplicationUpToDate returns whether the Application was compared to its current spec and is healthy, and either is
+// synced or was successfully synced to its current revision. The latter lets the Applications which never become
+// Synced, e.g. because some of their resources are always OutOfSync, complete their step.
+func isApplicationUpToDate(app argov1alpha1.Application) bool {
+	if !isApplicationComparedToSpec(app) || app.Status.Health.Status != health.HealthStatusHealthy {
+		return false
+	}
+	operationState := app.Status.OperationState
+	if operationState != nil && operationState.Phase != synccommon.OperationSucceeded {
+		return false
+	}
+	if app.Status.Sync.Status == argov1alpha1.SyncStatusCodeSynced {
+		return true
+	}
+	if operationState == nil || operationState.SyncResult == nil {
+		return false
+	}
+	if app.Spec.HasMultipleSources() {
+		return slices.Equal(operationState.SyncResult.Revisions, app.Status.Sync.Revisions)
+	}
+	return operationState.SyncResult.Revision == app.Status.Sync.Revision
+}
+
+// isApplicationComparedToSpec returns whether the sync status of the Application was computed against its current spec
+func isApplicationComparedToSpec(app argov1alpha1.Application) bool {
+	comparedTo := app.Spec.BuildComparedToStatus()
+	return cmp.Equal(comparedTo, app.Status.Sync.ComparedTo, cmpopts.EquateEmpty(), cmpopts.EquateComparable(argov1alpha1.ApplicationDestination{}))
+}
+
+// describeApplicationRolloutState describes why an Application of a RollingUpdate step is not up to date yet
+func describeApplicationRolloutState(app argov1alpha1.Application) string {
+	if !isApplicationComparedToSpec(app) {
+		return app.Name + " (not yet compared to its updated spec)"
+	}
+	if app.Status.OperationState != nil && app.Status.OperationState.Phase != synccommon.OperationSucceeded {
+		return fmt.Sprintf("%s (operation %s)", app.Name, app.Status.OperationState.Phase)
+	}
+	return fmt.Sprintf("%s (%s, %s)", app.Name, app.Status.Sync.Status, app.Status.Health.Status)
+}
+
+// holdValidApplications keeps the live spec of the Applications which are not yet allowed to be updated by the
+// RollingUpdate strategy
+func (r *ApplicationSetReconciler) holdValidApplications(logCtx *log.Entry, appUpdateMap map[string]bool, appMap map[string]argov1alpha1.Application, validApps []argov1alpha1.Application) []argov1alpha1.Application {
+	rolloutApps := []argov1alpha1.Application{}
+	for i := range validApps {
+		currentApp, ok := appMap[validApps[i].Name]
+		if ok && !appUpdateMap[validApps[i].Name] {
+			logCtx.Debugf("holding the spec update of application: %v", validApps[i].Name)
+			validApps[i].Spec = *currentApp.Spec.DeepCopy()
+		}
+		rolloutApps = append(rolloutApps, validApps[i])
+	}
+	return rolloutApps
 }
 
 // this list tracks which Applications belong to each RollingUpdate step
@@ -890,6 +1192,8 @@ func (r *ApplicationSetReconciler) buildAppDependencyList(logCtx *log.Entry, app
 	steps := []argov1alpha1.ApplicationSetRolloutStep{}
 	if progressiveSyncsRollingSyncStrategyEnabled(&applicationSet) {
 		steps = applicationSet.Spec.Strategy.RollingSync.Steps
+	} else if progressiveSyncsRollingUpdateStrategyEnabled(&applicationSet) {
+		steps = applicationSet.Spec.Strategy.RollingUpdate.Steps
 	}
 
 	appDependencyList := make([][]string, 0)
@@ -959,11 +1263,15 @@ func (r *ApplicationSetReconciler) buildAppSyncMap(applicationSet argov1alpha1.A
 
 	// healthy stages and the first non-healthy stage should have sync enabled
 	// every stage after should have sync disabled
+	// stages requiring approval only have sync enabled once promoted
+	// a rolled back rollout has sync disabled for every stage, so that the rolled back Applications are not updated again
 
+	rolledBack := rolloutRolledBack(&applicationSet)
 	for i := range appDependencyList {
 		// set the syncEnabled boolean for every Application in the current step
+		approved := rolloutStepApproved(&applicationSet, i)
 		for _, appName := range appDependencyList[i] {
-			appSyncMap[appName] = syncEnabled
+			appSyncMap[appName] = syncEnabled && approved && !rolledBack
 		}
 
 		// detect if we need to halt before progressing to the next step
@@ -987,6 +1295,11 @@ func (r *ApplicationSetReconciler) buildAppSyncMap(applicationSet argov1alpha1.A
 				break
 			}
 		}
+
+		// a healthy step still holds the next steps during its pause and analysis
+		if syncEnabled && !rolloutStepCompleted(&applicationSet, i) {
+			syncEnabled = false
+		}
 	}
 
 	return appSyncMap
@@ -1001,6 +1314,40 @@ func appSyncEnabledForNextStep(appset *argov1alpha1.ApplicationSet, app argov1al
 	return true
 }
 
+// rolloutStepApproved returns whether the Applications of the step at the given index are allowed to sync
+func rolloutStepApproved(appset *argov1alpha1.ApplicationSet, i int) bool {
+	if !progressiveSyncsRollingSyncStrategyEnabled(appset) || i >= len(appset.Spec.Strategy.RollingSync.Steps) || !appset.Spec.Strategy.RollingSync.Steps[i].RequireApproval {
+		return true
+	}
+	idx := findRolloutStepStatusIndex(appset.Status.RolloutSteps, strconv.Itoa(i+1))
+	return idx > -1 && appset.Status.RolloutSteps[idx].Approved
+}
+
+// rolloutStepCompleted returns whether the step at the given index completed its pause and analysis, provided its
+// Applications are healthy
+func rolloutStepCompleted(appset *argov1alpha1.ApplicationSet, i int) bool {
+	if !progressiveSyncsRollingSyncStrategyEnabled(appset) || i >= len(appset.Spec.Strategy.RollingSync.Steps) {
+		return true
+	}
+	step := appset.Spec.Strategy.RollingSync.Steps[i]
+	if step.PauseDuration == "" && step.Analysis == nil {
+		return true
+	}
+	idx := findRolloutStepStatusIndex(appset.Status.RolloutSteps, strconv.Itoa(i+1))
+	return idx > -1 && appset.Status.RolloutSteps[idx].Status == argov1alpha1.RolloutStepStatusHealthy
+}
+
+// rolloutRolledBack returns whether a step of the rollout failed and the Applications updated by the rollout were
+// rolled back
+func rolloutRolledBack(appset *argov1alpha1.ApplicationSet) bool {
+	for _, stepStatus := range appset.Status.RolloutSteps {
+		if stepStatus.Rollback != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func isRollingSyncStrategy(appset *argov1alpha1.ApplicationSet) bool {
 	// It's only RollingSync if the type specifically sets it
 	return appset.Spec.Strategy != nil && appset.Spec.Strategy.Type == "RollingSync" && appset.Spec.Strategy.RollingSync != nil
@@ -1011,6 +1358,16 @@ func progressiveSyncsRollingSyncStrategyEnabled(appset *argov1alpha1.Application
 	return isRollingSyncStrategy(appset) && len(appset.Spec.Strategy.RollingSync.Steps) > 0
 }
 
+func isRollingUpdateStrategy(appset *argov1alpha1.ApplicationSet) bool {
+	// It's only RollingUpdate if the type specifically sets it
+	return appset.Spec.Strategy != nil && appset.Spec.Strategy.Type == "RollingUpdate" && appset.Spec.Strategy.RollingUpdate != nil
+}
+
+func progressiveSyncsRollingUpdateStrategyEnabled(appset *argov1alpha1.ApplicationSet) bool {
+	// ProgressiveSync is enabled if the strategy is set to `RollingUpdate` + steps slice is not empty
+	return isRollingUpdateStrategy(appset) && len(appset.Spec.Strategy.RollingUpdate.Steps) > 0
+}
+
 func isApplicationHealthy(app argov1alpha1.Application) bool {
 	healthStatusString, syncStatusString, operationPhaseString := statusStrings(app)
 
@@ -1206,7 +1563,439 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusProgress
 	return appStatuses, nil
 }
 
+// rolloutStepApplicationsHealthy returns whether all the Applications of a RollingSync step are healthy, and whether
+// some of them are being updated
+func rolloutStepApplicationsHealthy(applicationSet *argov1alpha1.ApplicationSet, appNames []string, appMap map[string]argov1alpha1.Application) (bool, bool) {
+	healthy := true
+	updating := false
+	for _, appName := range appNames {
+		idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+		if idx == -1 {
+			healthy = false
+			continue
+		}
+		appStatus := applicationSet.Status.ApplicationStatus[idx]
+		if appStatus.Status == "Pending" || appStatus.Status == "Progressing" {
+			updating = true
+		}
+		app, ok := appMap[appName]
+		if !ok || !appSyncEnabledForNextStep(applicationSet, app, appStatus) {
+			healthy = false
+		}
+	}
+	return healthy, updating
+}
+
+// rolloutStepDegradedApplications returns the Applications of a RollingSync step that became Degraded once updated
+func rolloutStepDegradedApplications(applicationSet *argov1alpha1.ApplicationSet, appNames []string, appMap map[string]argov1alpha1.Application) []string {
+	degradedApps := []string{}
+	for _, appName := range appNames {
+		idx := findApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+		if idx == -1 || applicationSet.Status.ApplicationStatus[idx].Status != "Progressing" {
+			continue
+		}
+		app, ok := appMap[appName]
+		if !ok {
+			continue
+		}
+		if healthStatusString, _, _ := statusStrings(app); healthStatusString == "Degraded" {
+			degradedApps = append(degradedApps, appName)
+		}
+	}
+	return degradedApps
+}
+
+// updateApplicationSetRolloutStepStatus computes the status of each RollingSync step from the status of its
+// Applications, its approval, its pause duration and its analysis. It returns the time after which a paused step must
+// be checked again, or 0 if no step is paused.
+func (r *ApplicationSetReconciler) updateApplicationSetRolloutStepStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, appDependencyList [][]string, appMap map[string]argov1alpha1.Application) (time.Duration, error) {
+	now := metav1.Now()
+	var requeueAfter time.Duration
+	requeueWithin := func(d time.Duration) {
+		if d > 0 && (requeueAfter == 0 || d < requeueAfter) {
+			requeueAfter = d
+		}
+	}
+
+	stepStatuses := make([]argov1alpha1.ApplicationSetRolloutStepStatus, 0, len(appDependencyList))
+	promotedStep, promoted := applicationSet.Annotations[common.AnnotationApplicationSetPromote]
+	previousStepsCompleted := true
+	rollbackOnFailure := applicationSet.Spec.Strategy.RollingSync.RollbackOnFailure
+	// the rollout start must be read before the rollout conditions are updated for this reconciliation
+	rolloutStartTime := getRolloutStartTime(applicationSet)
+
+	for i, appNames := range appDependencyList {
+		step := applicationSet.Spec.Strategy.RollingSync.Steps[i]
+		currentStepStatus := argov1alpha1.ApplicationSetRolloutStepStatus{
+			Step:   strconv.Itoa(i + 1),
+			Status: argov1alpha1.RolloutStepStatusWaiting,
+		}
+		if idx := findRolloutStepStatusIndex(applicationSet.Status.RolloutSteps, currentStepStatus.Step); idx > -1 {
+			currentStepStatus = applicationSet.Status.RolloutSteps[idx]
+		}
+		stepPromoted := promoted && promotedStep == currentStepStatus.Step
+		if currentStepStatus.Rollback != nil && (stepPromoted || currentStepStatus.Rollback.Generation != applicationSet.Generation) {
+			logCtx.Infof("Resuming the rollout of ApplicationSet %v, rolled back at step %v", applicationSet.Name, currentStepStatus.Step)
+			currentStepStatus.Rollback = nil
+			currentStepStatus.Status = argov1alpha1.RolloutStepStatusWaiting
+		}
+		status, message := currentStepStatus.Status, currentStepStatus.Message
+
+		healthy, updating := rolloutStepApplicationsHealthy(applicationSet, appNames, appMap)
+		degradedApps := []string{}
+		if rollbackOnFailure {
+			degradedApps = rolloutStepDegradedApplications(applicationSet, appNames, appMap)
+		}
+		switch {
+		case currentStepStatus.Rollback != nil:
+			// the rollout stays halted until the step is promoted or the ApplicationSet is modified
+			if pendingApps := currentStepStatus.Rollback.PendingApplications; len(pendingApps) > 0 {
+				currentStepStatus.Rollback = r.rollbackRolloutApplications(ctx, logCtx, applicationSet, *currentStepStatus.Rollback, pendingApps, appMap)
+			}
+		case len(degradedApps) > 0:
+			status, message = argov1alpha1.RolloutStepStatusDegraded, fmt.Sprintf("Applications of the step are Degraded: %s.", strings.Join(degradedApps, ", "))
+		case !healthy:
+			if stepPromoted {
+				logCtx.Infof("Step %v of ApplicationSet %v was approved", currentStepStatus.Step, applicationSet.Name)
+				currentStepStatus.Approved = true
+			}
+			switch {
+			case updating:
+				status, message = argov1alpha1.RolloutStepStatusProgressing, "Applications of the step are being updated."
+			case previousStepsCompleted && step.RequireApproval && !currentStepStatus.Approved:
+				status, message = argov1alpha1.RolloutStepStatusAwaitingApproval, "Step requires approval, waiting for it to be promoted."
+			default:
+				status, message = argov1alpha1.RolloutStepStatusWaiting, "Applications of the step are waiting to be updated."
+			}
+		case status == argov1alpha1.RolloutStepStatusHealthy:
+			currentStepStatus.Approved = false
+		case stepPromoted && (status == argov1alpha1.RolloutStepStatusPaused || status == argov1alpha1.RolloutStepStatusDegraded):
+			logCtx.Infof("Step %v of ApplicationSet %v was promoted while %v", currentStepStatus.Step, applicationSet.Name, status)
+			status, message = argov1alpha1.RolloutStepStatusHealthy, fmt.Sprintf("Step was promoted while %s.", status)
+			currentStepStatus.Approved = false
+		case status == argov1alpha1.RolloutStepStatusDegraded:
+			// the rollout stays halted until the step is promoted or its Applications change
+		case step.PauseDuration == "" && step.Analysis == nil:
+			status, message = argov1alpha1.RolloutStepStatusHealthy, "Applications of the step are Healthy."
+			currentStepStatus.Approved = false
+		default:
+			pausedAt := now.Time
+			if status == argov1alpha1.RolloutStepStatusPaused && currentStepStatus.LastTransitionTime != nil {
+				pausedAt = currentStepStatus.LastTransitionTime.Time
+			}
+			status, message = r.getRolloutStepPauseStatus(ctx, logCtx, applicationSet, step, &currentStepStatus, appNames, pausedAt, now.Time, requeueWithin)
+			if status == argov1alpha1.RolloutStepStatusHealthy {
+				currentStepStatus.Approved = false
+			}
+		}
+
+		if rollbackOnFailure && status == argov1alpha1.RolloutStepStatusDegraded && currentStepStatus.Status != argov1alpha1.RolloutStepStatusDegraded {
+			// the Applications of the failed step and of the previous steps are rolled back
+			var updatedApps []string
+			for _, stepAppNames := range appDependencyList[:i+1] {
+				updatedApps = append(updatedApps, stepAppNames...)
+			}
+			rollback := argov1alpha1.ApplicationSetRolloutStepRollback{
+				Applications:     []string{},
+				Generation:       applicationSet.Generation,
+				RolloutStartTime: rolloutStartTime,
+			}
+			currentStepStatus.Rollback = r.rollbackRolloutApplications(ctx, logCtx, applicationSet, rollback, updatedApps, appMap)
+		}
+		if currentStepStatus.Rollback != nil && len(currentStepStatus.Rollback.PendingApplications) > 0 {
+			requeueWithin(rolloutRollbackRetryInterval)
+		}
+
+		if status != currentStepStatus.Status || currentStepStatus.LastTransitionTime == nil {
+			logCtx.Infof("Step %v of ApplicationSet %v moved to %v status: %v", currentStepStatus.Step, applicationSet.Name, status, message)
+			currentStepStatus.LastTransitionTime = &now
+		}
+		if status != argov1alpha1.RolloutStepStatusPaused {
+			// the next pause of the step runs its analysis right away
+			currentStepStatus.LastAnalysisTime = nil
+		}
+		currentStepStatus.Status = status
+		currentStepStatus.Message = message
+		stepStatuses = append(stepStatuses, currentStepStatus)
+
+		previousStepsCompleted = previousStepsCompleted && status == argov1alpha1.RolloutStepStatusHealthy
+	}
+
+	err := r.setAppSetRolloutStepStatus(ctx, logCtx, applicationSet, stepStatuses)
+	if err != nil {
+		return 0, fmt.Errorf("failed to set AppSet rollout step statuses: %w", err)
+	}
+
+	if promoted {
+		err = r.removeApplicationSetAnnotation(ctx, applicationSet, common.AnnotationApplicationSetPromote)
+		if err != nil {
+			return 0, fmt.Errorf("failed to remove promote annotation: %w", err)
+		}
+	}
+
+	return requeueAfter, nil
+}
+
+// getRolloutStepPauseStatus returns the status of a step whose Applications are healthy, and which was paused at the
+// given time. The step completes once its pause duration elapsed and its analysis, if any, passed. The analysis is run
+// at most once per interval, in between the step keeps the status of the last analysis.
+func (r *ApplicationSetReconciler) getRolloutStepPauseStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, step argov1alpha1.ApplicationSetRolloutStep, stepStatus *argov1alpha1.ApplicationSetRolloutStepStatus, appNames []string, pausedAt time.Time, now time.Time, requeueWithin func(time.Duration)) (string, string) {
+	// an invalid step holds the rollout until the ApplicationSet is fixed
+	pauseDuration, err := step.GetPauseDuration()
+	if err != nil {
+		return argov1alpha1.RolloutStepStatusPaused, err.Error()
+	}
+	remaining := pausedAt.Add(pauseDuration).Sub(now)
+	message := fmt.Sprintf("Applications of the step are Healthy, pausing until %s.", pausedAt.Add(pauseDuration).UTC().Format(time.RFC3339))
+
+	if step.Analysis != nil {
+		interval, err := step.Analysis.GetInterval()
+		if err != nil {
+			return argov1alpha1.RolloutStepStatusPaused, err.Error()
+		}
+		if !rolloutAnalysisURLAllowed(step.Analysis.URL, r.RolloutAnalysisAllowedURLs) {
+			logCtx.WithField(common.SecurityField, common.SecurityMedium).Warnf("analysis URL %q of step %v of ApplicationSet %v is not allowed", step.Analysis.URL, stepStatus.Step, applicationSet.Name)
+			return argov1alpha1.RolloutStepStatusPaused, fmt.Sprintf("Analysis URL %q is not allowed by the ApplicationSet controller.", step.Analysis.URL)
+		}
+		if stepStatus.LastAnalysisTime != nil {
+			if sinceLastAnalysis := now.Sub(stepStatus.LastAnalysisTime.Time); sinceLastAnalysis >= 0 && sinceLastAnalysis < interval {
+				requeueWithin(interval - sinceLastAnalysis)
+				return stepStatus.Status, stepStatus.Message
+			}
+		}
+		stepStatus.LastAnalysisTime = &metav1.Time{Time: now}
+		passed, analysisMessage, err := runRolloutAnalysis(ctx, step.Analysis, applicationSet, stepStatus.Step, appNames)
+		if err != nil {
+			// the result is inconclusive, the step stays paused until the analysis can be run
+			logCtx.Warnf("unable to run analysis of step %v of ApplicationSet %v: %v", stepStatus.Step, applicationSet.Name, err)
+			requeueWithin(interval)
+			return argov1alpha1.RolloutStepStatusPaused, fmt.Sprintf("Unable to run the analysis of the step: %v", err)
+		}
+		if !passed {
+			return argov1alpha1.RolloutStepStatusDegraded, "Analysis of the step failed: " + analysisMessage
+		}
+		if remaining > 0 {
+			requeueWithin(interval)
+		}
+	}
+
+	if remaining > 0 {
+		requeueWithin(remaining)
+		return argov1alpha1.RolloutStepStatusPaused, message
+	}
+	return argov1alpha1.RolloutStepStatusHealthy, "Applications of the step are Healthy, pause and analysis completed."
+}
+
+// getRolloutStartTime returns the time the current rollout of the ApplicationSet started, or nil if no rollout is in
+// progress
+func getRolloutStartTime(applicationSet *argov1alpha1.ApplicationSet) *metav1.Time {
+	for _, condition := range applicationSet.Status.Conditions {
+		if condition.Type == argov1alpha1.ApplicationSetConditionRolloutProgressing && condition.Status == argov1alpha1.ApplicationSetConditionStatusTrue {
+			return condition.LastTransitionTime
+		}
+	}
+	return nil
+}
+
+// getRollbackRevisionHistory returns the deployment an Application is rolled back to, which is its last deployment
+// before the rollout started. It returns nil if the Application was not updated by the rollout.
+func getRollbackRevisionHistory(app argov1alpha1.Application, rolloutStartTime time.Time) *argov1alpha1.RevisionHistory {
+	history := app.Status.History
+	if len(history) == 0 || history.LastRevisionHistory().DeployedAt.Time.Before(rolloutStartTime) {
+		return nil
+	}
+	for i := len(history) - 2; i >= 0; i-- {
+		if !history[i].DeployedAt.Time.Before(rolloutStartTime) {
+			continue
+		}
+		if history[i].Source.IsZero() && history[i].Sources.IsZero() {
+			// deployments made with Argo CD v0.11 or below cannot be rolled back to
+			return nil
+		}
+		deploymentInfo := history[i]
+		return &deploymentInfo
+	}
+	return nil
+}
+
+// rollbackRolloutApplications rolls the given Applications updated by the rollout back to their last deployment before
+// the rollout started, the same way as `argocd app rollback`. The Applications whose rollback cannot be started yet are
+// left pending, and are retried by the next reconciliations. It returns the rollback updated with the Applications
+// rolled back and pending.
+func (r *ApplicationSetReconciler) rollbackRolloutApplications(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, rollback argov1alpha1.ApplicationSetRolloutStepRollback, appNames []string, appMap map[string]argov1alpha1.Application) *argov1alpha1.ApplicationSetRolloutStepRollback {
+	rollback.Applications = slices.Clone(rollback.Applications)
+	rollback.PendingApplications = nil
+	if rollback.RolloutStartTime == nil {
+		logCtx.Warnf("unable to roll back the rollout of ApplicationSet %v, its start time is unknown", applicationSet.Name)
+		return &rollback
+	}
+
+	for _, appName := range appNames {
+		app, ok := appMap[appName]
+		if !ok {
+			continue
+		}
+		deploymentInfo := getRollbackRevisionHistory(app, rollback.RolloutStartTime.Time)
+		if deploymentInfo == nil {
+			continue
+		}
+		err := r.setApplicationRollbackOperation(ctx, app, deploymentInfo)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if errors.Is(err, argoutil.ErrAnotherOperationInProgress) {
+			logCtx.Infof("rollback of Application %v is pending, waiting for its operation to terminate", appName)
+			rollback.PendingApplications = append(rollback.PendingApplications, appName)
+			continue
+		}
+		if err != nil {
+			logCtx.WithError(err).Errorf("failed to roll back Application %v, retrying", appName)
+			rollback.PendingApplications = append(rollback.PendingApplications, appName)
+			continue
+		}
+		logCtx.Infof("Application %v rolled back to deployment %d", appName, deploymentInfo.ID)
+		r.Recorder.Eventf(applicationSet, corev1.EventTypeWarning, "RolledBack", "Rolled back Application %q to deployment %d", appName, deploymentInfo.ID)
+		rollback.Applications = append(rollback.Applications, appName)
+	}
+	return &rollback
+}
+
+// setApplicationRollbackOperation sets the operation syncing an Application to the given deployment of its history. If
+// another operation is running, e.g. the sync of the failed update, it is terminated and ErrAnotherOperationInProgress
+// is returned: the rollback has to be retried once the operation completed.
+func (r *ApplicationSetReconciler) setApplicationRollbackOperation(ctx context.Context, app argov1alpha1.Application, deploymentInfo *argov1alpha1.RevisionHistory) error {
+	namespacedName := types.NamespacedName{Namespace: app.Namespace, Name: app.Name}
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		updatedApp := &argov1alpha1.Application{}
+		if err := r.Get(ctx, namespacedName, updatedApp); err != nil {
+			return fmt.Errorf("error fetching application: %w", err)
+		}
+		if updatedApp.Operation != nil {
+			if updatedApp.Status.OperationState != nil && updatedApp.Status.OperationState.Phase == synccommon.OperationRunning {
+				updatedApp.Status.OperationState.Phase = synccommon.OperationTerminating
+				if err := r.Update(ctx, updatedApp); err != nil {
+					return err
+				}
+			}
+			return argoutil.ErrAnotherOperationInProgress
+		}
+
+		var syncOptions argov1alpha1.SyncOptions
+		if updatedApp.Spec.SyncPolicy != nil {
+			syncOptions = updatedApp.Spec.SyncPolicy.SyncOptions
+		}
+		updatedApp.Operation = &argov1alpha1.Operation{
+			Sync: argoutil.NewRollbackSyncOperation(deploymentInfo, syncOptions, false, false),
+			InitiatedBy: argov1alpha1.OperationInitiator{
+				Username:  "applicationset-controller",
+				Automated: true,
+			},
+			Info: []*argov1alpha1.Info{
+				{
+					Name:  "Reason",
+					Value: "ApplicationSet RollingSync rolled back this Application resource after a step failed.",
+				},
+			},
+		}
+		updatedApp.Status.OperationState = nil
+		return r.Update(ctx, updatedApp)
+	})
+}
+
+// setAppSetRolloutStepStatus updates the ApplicationSet's status field
+// with any new/changed rollout step statuses.
+func (r *ApplicationSetReconciler) setAppSetRolloutStepStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, stepStatuses []argov1alpha1.ApplicationSetRolloutStepStatus) error {
+	needToUpdateStatus := len(stepStatuses) != len(applicationSet.Status.RolloutSteps)
+	for i := 0; !needToUpdateStatus && i < len(stepStatuses); i++ {
+		currentStatus := applicationSet.Status.RolloutSteps[i]
+		stepStatus := stepStatuses[i]
+		if currentStatus.Step != stepStatus.Step || currentStatus.Status != stepStatus.Status || currentStatus.Message != stepStatus.Message || currentStatus.Approved != stepStatus.Approved || !reflect.DeepEqual(currentStatus.Rollback, stepStatus.Rollback) || !currentStatus.LastAnalysisTime.Equal(stepStatus.LastAnalysisTime) {
+			needToUpdateStatus = true
+		}
+	}
+	if !needToUpdateStatus {
+		return nil
+	}
+
+	namespacedName := types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}
+	applicationSet.Status.RolloutSteps = stepStatuses
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.RolloutSteps = applicationSet.Status.RolloutSteps
+
+		// Update the newly fetched object with new set of RolloutSteps
+		err := r.Client.Status().Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(applicationSet)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set rollout step status: %v", err)
+		return fmt.Errorf("unable to set application set rollout step status: %w", err)
+	}
+	return nil
+}
+
+// removeApplicationSetAnnotation removes an annotation requesting an action, such as a promotion, once the action is
+// done.
+func (r *ApplicationSetReconciler) removeApplicationSetAnnotation(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet, annotation string) error {
+	namespacedName := types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, namespacedName, updatedAppset); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if _, ok := updatedAppset.Annotations[annotation]; !ok {
+			return nil
+		}
+		delete(updatedAppset.Annotations, annotation)
+		if err := r.Update(ctx, updatedAppset); err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(applicationSet)
+		return nil
+	})
+}
+
 func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditions(ctx context.Context, applicationSet *argov1alpha1.ApplicationSet) []argov1alpha1.ApplicationSetCondition {
+	for _, stepStatus := range applicationSet.Status.RolloutSteps {
+		if stepStatus.Status != argov1alpha1.RolloutStepStatusDegraded {
+			continue
+		}
+		// the rollout is halted until the step is promoted or its Applications change
+		condition := argov1alpha1.ApplicationSetCondition{
+			Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+			Message: fmt.Sprintf("ApplicationSet Rollout halted, step %s is Degraded: %s", stepStatus.Step, stepStatus.Message),
+			Reason:  argov1alpha1.ApplicationSetReasonRolloutDegraded,
+			Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
+		}
+		if stepStatus.Rollback != nil {
+			condition.Reason = argov1alpha1.ApplicationSetReasonRolloutRolledBack
+			if len(stepStatus.Rollback.Applications) > 0 {
+				condition.Message += fmt.Sprintf(" (rolled back Applications: %s)", strings.Join(stepStatus.Rollback.Applications, ", "))
+			}
+			if len(stepStatus.Rollback.PendingApplications) > 0 {
+				condition.Message += fmt.Sprintf(" (rollback pending for Applications: %s)", strings.Join(stepStatus.Rollback.PendingApplications, ", "))
+			}
+			if len(stepStatus.Rollback.Applications) == 0 && len(stepStatus.Rollback.PendingApplications) == 0 {
+				condition.Message += " (no Application was rolled back)"
+			}
+		}
+		_ = r.setApplicationSetStatusCondition(ctx, applicationSet, condition, true)
+		return applicationSet.Status.Conditions
+	}
+
 	appSetProgressing := false
 	for _, appStatus := range applicationSet.Status.ApplicationStatus {
 		if appStatus.Status != "Healthy" {
@@ -1214,13 +2003,24 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditio
 			break
 		}
 	}
+	for _, stepStatus := range applicationSet.Status.RolloutSteps {
+		if stepStatus.Status == argov1alpha1.RolloutStepStatusPaused {
+			appSetProgressing = true
+			break
+		}
+	}
 
 	appSetConditionProgressing := false
+	appSetConditionDegraded := false
 	for _, appSetCondition := range applicationSet.Status.Conditions {
 		if appSetCondition.Type == argov1alpha1.ApplicationSetConditionRolloutProgressing && appSetCondition.Status == argov1alpha1.ApplicationSetConditionStatusTrue {
 			appSetConditionProgressing = true
 			break
 		}
+		if appSetCondition.Type == argov1alpha1.ApplicationSetConditionRolloutProgressing && (appSetCondition.Reason == argov1alpha1.ApplicationSetReasonRolloutDegraded || appSetCondition.Reason == argov1alpha1.ApplicationSetReasonRolloutRolledBack) {
+			appSetConditionDegraded = true
+			break
+		}
 	}
 
 	if appSetProgressing && !appSetConditionProgressing {
@@ -1233,7 +2033,7 @@ func (r *ApplicationSetReconciler) updateApplicationSetApplicationStatusConditio
 				Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
 			}, true,
 		)
-	} else if !appSetProgressing && appSetConditionProgressing {
+	} else if !appSetProgressing && (appSetConditionProgressing || appSetConditionDegraded) {
 		_ = r.setApplicationSetStatusCondition(ctx,
 			applicationSet,
 			argov1alpha1.ApplicationSetCondition{
@@ -1257,6 +2057,15 @@ func findApplicationStatusIndex(appStatuses []argov1alpha1.ApplicationSetApplica
 	return -1
 }
 
+func findRolloutStepStatusIndex(stepStatuses []argov1alpha1.ApplicationSetRolloutStepStatus, step string) int {
+	for i := range stepStatuses {
+		if stepStatuses[i].Step == step {
+			return i
+		}
+	}
+	return -1
+}
+
 // migrateStatus run migrations on the status subresource of ApplicationSet early during the run of ApplicationSetReconciler.Reconcile
 // this handles any defaulting of values - which would otherwise cause the references to r.Client.Status().Update to fail given missing required fields.
 func (r *ApplicationSetReconciler) migrateStatus(ctx context.Context, appset *argov1alpha1.ApplicationSet) error {
@@ -1540,6 +2349,11 @@ func shouldRequeueForApplication(appOld *argov1alpha1.Application, appNew *argov
 			return true
 		}
 
+		// the RollingUpdate strategy waits for the Application to be compared to its updated spec
+		if !cmp.Equal(appOld.Status.Sync.ComparedTo, appNew.Status.Sync.ComparedTo, cmpopts.EquateEmpty(), cmpopts.EquateComparable(argov1alpha1.ApplicationDestination{})) {
+			return true
+		}
+
 		if appOld.Status.OperationState != nil && appNew.Status.OperationState != nil {
 			if appOld.Status.OperationState.Phase != appNew.Status.OperationState.Phase ||
 				appOld.Status.OperationState.StartedAt != appNew.Status.OperationState.StartedAt {
@@ -1630,6 +2444,22 @@ func shouldRequeueForApplicationSet(appSetOld, appSetNew *argov1alpha1.Applicati
 		if oldHasRefreshAnnotation && !newHasRefreshAnnotation {
 			return false
 		}
+
+		// The promote annotation is removed once the promotion is recorded in the status.
+		_, oldHasPromoteAnnotation := appSetOld.Annotations[common.AnnotationApplicationSetPromote]
+		_, newHasPromoteAnnotation := appSetNew.Annotations[common.AnnotationApplicationSetPromote]
+
+		if oldHasPromoteAnnotation && !newHasPromoteAnnotation {
+			return false
+		}
+
+		// The deletion approval annotation is removed once the Applications are deleted.
+		_, oldHasApproveDeletionAnnotation := appSetOld.Annotations[common.AnnotationApplicationSetApproveDeletion]
+		_, newHasApproveDeletionAnnotation := appSetNew.Annotations[common.AnnotationApplicationSetApproveDeletion]
+
+		if oldHasApproveDeletionAnnotation && !newHasApproveDeletionAnnotation {
+			return false
+		}
 		return true
 	}
 
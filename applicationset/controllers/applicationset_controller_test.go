@@ -4,7 +4,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -20,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	crtclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -1819,6 +1824,212 @@ func TestDeleteInCluster(t *testing.T) {
 	}
 }
 
+func TestDeleteInClusterMaxDeletions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	newApp := func(name string) v1alpha1.Application {
+		return v1alpha1.Application{
+			TypeMeta:   metav1.TypeMeta{Kind: application.ApplicationKind, APIVersion: "argoproj.io/v1alpha1"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "namespace"},
+			Spec:       v1alpha1.ApplicationSpec{Project: "project"},
+		}
+	}
+
+	for _, c := range []struct {
+		name          string
+		maxDeletions  *intstr.IntOrString
+		annotations   map[string]string
+		expectedError string
+		deleted       bool
+	}{
+		{
+			name:    "no maximum",
+			deleted: true,
+		},
+		{
+			name:         "deletions within the maximum",
+			maxDeletions: ptr.To(intstr.FromInt(2)),
+			deleted:      true,
+		},
+		{
+			name:          "deletions exceeding the maximum",
+			maxDeletions:  ptr.To(intstr.FromInt(1)),
+			expectedError: "2 Applications would be deleted, which exceeds the maximum of 1 deletions: the deletions are awaiting approval",
+		},
+		{
+			name:          "deletions exceeding the maximum percentage",
+			maxDeletions:  ptr.To(intstr.FromString("50%")),
+			expectedError: "2 Applications would be deleted, which exceeds the maximum of 1 deletions: the deletions are awaiting approval",
+		},
+		{
+			name:         "approved deletions exceeding the maximum",
+			maxDeletions: ptr.To(intstr.FromInt(1)),
+			annotations:  map[string]string{argocommon.AnnotationApplicationSetApproveDeletion: utils.DeletionSetDigest([]string{"delete2", "delete1"})},
+			deleted:      true,
+		},
+		{
+			name:          "approval of other deletions",
+			maxDeletions:  ptr.To(intstr.FromInt(1)),
+			annotations:   map[string]string{argocommon.AnnotationApplicationSetApproveDeletion: utils.DeletionSetDigest([]string{"delete1"})},
+			expectedError: "the deletions are awaiting approval " + utils.FormatDeletionSet(utils.DeletionSetDigest([]string{"delete1", "delete2"})),
+		},
+		{
+			name:          "approval without deletion set",
+			maxDeletions:  ptr.To(intstr.FromInt(1)),
+			annotations:   map[string]string{argocommon.AnnotationApplicationSetApproveDeletion: "true"},
+			expectedError: "2 Applications would be deleted, which exceeds the maximum of 1 deletions",
+		},
+		{
+			name:          "invalid maximum",
+			maxDeletions:  ptr.To(intstr.FromString("half")),
+			expectedError: "invalid maxDeletions",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			appSet := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "name",
+					Namespace:   "namespace",
+					Annotations: c.annotations,
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					SyncPolicy: &v1alpha1.ApplicationSetSyncPolicy{MaxDeletions: c.maxDeletions},
+				},
+			}
+			initObjs := []crtclient.Object{&appSet}
+			for _, name := range []string{"keep", "delete1", "delete2"} {
+				app := newApp(name)
+				err = controllerutil.SetControllerReference(&appSet, &app, scheme)
+				require.NoError(t, err)
+				initObjs = append(initObjs, &app)
+			}
+
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjs...).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+			r := ApplicationSetReconciler{
+				Client:        client,
+				Scheme:        scheme,
+				Recorder:      record.NewFakeRecorder(len(initObjs)),
+				KubeClientset: kubefake.NewSimpleClientset(),
+				Metrics:       appsetmetrics.NewFakeAppsetMetrics(),
+			}
+
+			err := r.deleteInCluster(t.Context(), log.NewEntry(log.StandardLogger()), appSet, []v1alpha1.Application{newApp("keep")})
+			if c.expectedError != "" {
+				require.ErrorContains(t, err, c.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			apps := &v1alpha1.ApplicationList{}
+			require.NoError(t, client.List(t.Context(), apps))
+			if c.deleted {
+				assert.Len(t, apps.Items, 1)
+			} else {
+				assert.Len(t, apps.Items, 3)
+			}
+		})
+	}
+}
+
+func TestReconcileMaxDeletionsExceeded(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	appSet := v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Generators: []v1alpha1.ApplicationSetGenerator{{
+				List: &v1alpha1.ListGenerator{},
+			}},
+			SyncPolicy: &v1alpha1.ApplicationSetSyncPolicy{MaxDeletions: ptr.To(intstr.FromInt(1))},
+			Template: v1alpha1.ApplicationSetTemplate{
+				ApplicationSetTemplateMeta: v1alpha1.ApplicationSetTemplateMeta{Name: "{{name}}", Namespace: "argocd"},
+				Spec:                       v1alpha1.ApplicationSpec{Project: "default"},
+			},
+		},
+	}
+	initObjs := []crtclient.Object{&appSet}
+	for _, name := range []string{"app1", "app2"} {
+		app := &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+		}
+		err = controllerutil.SetControllerReference(&appSet, app, scheme)
+		require.NoError(t, err)
+		initObjs = append(initObjs, app)
+	}
+
+	kubeclientset := getDefaultTestClientSet()
+	client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(initObjs...).WithStatusSubresource(&appSet).WithIndex(&v1alpha1.Application{}, ".metadata.controller", appControllerIndexer).Build()
+	r := ApplicationSetReconciler{
+		Client:   client,
+		Scheme:   scheme,
+		Renderer: &utils.Render{},
+		Recorder: record.NewFakeRecorder(10),
+		Generators: map[string]generators.Generator{
+			"List": generators.NewListGenerator(),
+		},
+		ArgoDB:          db.NewDB("argocd", settings.NewSettingsManager(t.Context(), kubeclientset, "argocd"), kubeclientset),
+		KubeClientset:   kubeclientset,
+		Policy:          v1alpha1.ApplicationsSyncPolicySync,
+		ArgoCDNamespace: "argocd",
+		Metrics:         appsetmetrics.NewFakeAppsetMetrics(),
+	}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "argocd", Name: "name"}}
+
+	_, err = r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+
+	apps := &v1alpha1.ApplicationList{}
+	require.NoError(t, client.List(t.Context(), apps))
+	assert.Len(t, apps.Items, 2)
+
+	updatedAppSet := &v1alpha1.ApplicationSet{}
+	require.NoError(t, client.Get(t.Context(), req.NamespacedName, updatedAppSet))
+	var upToDate *v1alpha1.ApplicationSetCondition
+	for i := range updatedAppSet.Status.Conditions {
+		if updatedAppSet.Status.Conditions[i].Type == v1alpha1.ApplicationSetConditionResourcesUpToDate {
+			upToDate = &updatedAppSet.Status.Conditions[i]
+		}
+	}
+	require.NotNil(t, upToDate)
+	assert.Equal(t, v1alpha1.ApplicationSetConditionStatusFalse, upToDate.Status)
+	assert.Equal(t, v1alpha1.ApplicationSetReasonDeletionThresholdExceeded, upToDate.Reason)
+	deletionSet, ok := utils.ParseDeletionSet(upToDate.Message)
+	require.True(t, ok)
+	assert.Equal(t, utils.DeletionSetDigest([]string{"app1", "app2"}), deletionSet)
+
+	// An approval which does not match the pending deletions is refused and removed
+	updatedAppSet.Annotations = map[string]string{argocommon.AnnotationApplicationSetApproveDeletion: "true"}
+	require.NoError(t, client.Update(t.Context(), updatedAppSet))
+
+	_, err = r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+
+	require.NoError(t, client.List(t.Context(), apps))
+	assert.Len(t, apps.Items, 2)
+	require.NoError(t, client.Get(t.Context(), req.NamespacedName, updatedAppSet))
+	assert.NotContains(t, updatedAppSet.Annotations, argocommon.AnnotationApplicationSetApproveDeletion)
+
+	// Approving the pending deletions deletes the Applications, and consumes the approval
+	updatedAppSet.Annotations = map[string]string{argocommon.AnnotationApplicationSetApproveDeletion: deletionSet}
+	require.NoError(t, client.Update(t.Context(), updatedAppSet))
+
+	_, err = r.Reconcile(t.Context(), req)
+	require.NoError(t, err)
+
+	require.NoError(t, client.List(t.Context(), apps))
+	assert.Empty(t, apps.Items)
+	require.NoError(t, client.Get(t.Context(), req.NamespacedName, updatedAppSet))
+	assert.NotContains(t, updatedAppSet.Annotations, argocommon.AnnotationApplicationSetApproveDeletion)
+}
+
 func TestGetMinRequeueAfter(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -6111,6 +6322,540 @@ func TestUpdateApplicationSetApplicationStatusProgress(t *testing.T) {
 	}
 }
 
+func TestUpdateApplicationSetRolloutStepStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	passingAnalysisServer := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer passingAnalysisServer.Close()
+	failingAnalysisServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("error rate above threshold"))
+	}))
+	defer failingAnalysisServer.Close()
+
+	healthyApp := func(name string) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: v1alpha1.ApplicationStatus{
+				Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusHealthy},
+				Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+			},
+		}
+	}
+	appMap := map[string]v1alpha1.Application{
+		"app1": healthyApp("app1"),
+		"app2": healthyApp("app2"),
+	}
+	pausedAt := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(time.Now().Add(-d))
+		return &t
+	}
+
+	for _, cc := range []struct {
+		name                 string
+		steps                []v1alpha1.ApplicationSetRolloutStep
+		annotations          map[string]string
+		appStatus            []v1alpha1.ApplicationSetApplicationStatus
+		stepStatus           []v1alpha1.ApplicationSetRolloutStepStatus
+		expectedStepStatus   []v1alpha1.ApplicationSetRolloutStepStatus
+		expectedRequeueAfter bool
+	}{
+		{
+			name:  "completes a healthy step without pause",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{}, {RequireApproval: true}},
+			appStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: "Healthy"},
+				{Application: "app2", Status: "Waiting"},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy", Message: "Applications of the step are Healthy."},
+				{Step: "2", Status: "AwaitingApproval", Message: "Step requires approval, waiting for it to be promoted."},
+			},
+		},
+		{
+			name:        "approves a promoted step",
+			steps:       []v1alpha1.ApplicationSetRolloutStep{{}, {RequireApproval: true}},
+			annotations: map[string]string{argocommon.AnnotationApplicationSetPromote: "2"},
+			appStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: "Healthy"},
+				{Application: "app2", Status: "Waiting"},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy", Message: "Applications of the step are Healthy."},
+				{Step: "2", Status: "Waiting", Message: "Applications of the step are waiting to be updated.", Approved: true},
+			},
+		},
+		{
+			name:  "pauses a healthy step",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{PauseDuration: "1h"}, {}},
+			appStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: "Healthy"},
+				{Application: "app2", Status: "Waiting"},
+			},
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Progressing", LastTransitionTime: pausedAt(time.Hour)},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Paused"},
+				{Step: "2", Status: "Waiting", Message: "Applications of the step are waiting to be updated."},
+			},
+			expectedRequeueAfter: true,
+		},
+		{
+			name:  "completes a step once its pause elapsed",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{PauseDuration: "1h", Analysis: &v1alpha1.ApplicationSetRolloutAnalysis{URL: passingAnalysisServer.URL}}, {}},
+			appStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: "Healthy"},
+				{Application: "app2", Status: "Waiting"},
+			},
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Paused", LastTransitionTime: pausedAt(2 * time.Hour)},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy", Message: "Applications of the step are Healthy, pause and analysis completed."},
+				{Step: "2", Status: "Waiting", Message: "Applications of the step are waiting to be updated."},
+			},
+		},
+		{
+			name:  "degrades a step whose analysis fails",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{PauseDuration: "1h", Analysis: &v1alpha1.ApplicationSetRolloutAnalysis{URL: failingAnalysisServer.URL}}, {}},
+			appStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: "Healthy"},
+				{Application: "app2", Status: "Waiting"},
+			},
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Paused", LastTransitionTime: pausedAt(time.Minute)},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Degraded", Message: "Analysis of the step failed: error rate above threshold"},
+				{Step: "2", Status: "Waiting", Message: "Applications of the step are waiting to be updated."},
+			},
+		},
+		{
+			name:  "does not run the analysis of a step again within its interval",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{PauseDuration: "1h", Analysis: &v1alpha1.ApplicationSetRolloutAnalysis{URL: failingAnalysisServer.URL}}, {}},
+			appStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: "Healthy"},
+				{Application: "app2", Status: "Waiting"},
+			},
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Paused", Message: "Unable to run the analysis of the step: timeout", LastTransitionTime: pausedAt(time.Minute), LastAnalysisTime: pausedAt(10 * time.Second)},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Paused", Message: "Unable to run the analysis of the step: timeout"},
+				{Step: "2", Status: "Waiting", Message: "Applications of the step are waiting to be updated."},
+			},
+			expectedRequeueAfter: true,
+		},
+		{
+			name:  "holds a step whose analysis URL is not allowed",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{PauseDuration: "1h", Analysis: &v1alpha1.ApplicationSetRolloutAnalysis{URL: "http://169.254.169.254/latest/meta-data"}}, {}},
+			appStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: "Healthy"},
+				{Application: "app2", Status: "Waiting"},
+			},
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Paused", LastTransitionTime: pausedAt(2 * time.Hour)},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Paused", Message: `Analysis URL "http://169.254.169.254/latest/meta-data" is not allowed by the ApplicationSet controller.`},
+				{Step: "2", Status: "Waiting", Message: "Applications of the step are waiting to be updated."},
+			},
+		},
+		{
+			name:        "completes a promoted degraded step",
+			steps:       []v1alpha1.ApplicationSetRolloutStep{{PauseDuration: "1h", Analysis: &v1alpha1.ApplicationSetRolloutAnalysis{URL: failingAnalysisServer.URL}}, {}},
+			annotations: map[string]string{argocommon.AnnotationApplicationSetPromote: "1"},
+			appStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: "Healthy"},
+				{Application: "app2", Status: "Waiting"},
+			},
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Degraded", Message: "Analysis of the step failed: error rate above threshold"},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy", Message: "Step was promoted while Degraded."},
+				{Step: "2", Status: "Waiting", Message: "Applications of the step are waiting to be updated."},
+			},
+		},
+		{
+			name:  "restarts a step whose applications are updated",
+			steps: []v1alpha1.ApplicationSetRolloutStep{{PauseDuration: "1h"}, {}},
+			appStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: "Pending"},
+				{Application: "app2", Status: "Healthy"},
+			},
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy"},
+				{Step: "2", Status: "Healthy"},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Progressing", Message: "Applications of the step are being updated."},
+				{Step: "2", Status: "Healthy"},
+			},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			appSet := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "name",
+					Namespace:   "argocd",
+					Annotations: cc.annotations,
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Strategy: &v1alpha1.ApplicationSetStrategy{
+						Type: "RollingSync",
+						RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+							Steps: cc.steps,
+						},
+					},
+				},
+				Status: v1alpha1.ApplicationSetStatus{
+					ApplicationStatus: cc.appStatus,
+					RolloutSteps:      cc.stepStatus,
+				},
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&appSet).WithStatusSubresource(&appSet).Build()
+
+			r := ApplicationSetReconciler{
+				Client:                     client,
+				Scheme:                     scheme,
+				Recorder:                   record.NewFakeRecorder(1),
+				Metrics:                    appsetmetrics.NewFakeAppsetMetrics(),
+				RolloutAnalysisAllowedURLs: []string{passingAnalysisServer.URL, failingAnalysisServer.URL},
+			}
+
+			requeueAfter, err := r.updateApplicationSetRolloutStepStatus(t.Context(), log.NewEntry(log.StandardLogger()), &appSet, [][]string{{"app1"}, {"app2"}}, appMap)
+			require.NoError(t, err)
+
+			stepStatuses := appSet.Status.RolloutSteps
+			for i := range stepStatuses {
+				// opt out of testing the LastTransitionTime and LastAnalysisTime, and the message of paused steps which
+				// contains a time
+				stepStatuses[i].LastTransitionTime = nil
+				stepStatuses[i].LastAnalysisTime = nil
+				if strings.HasPrefix(stepStatuses[i].Message, "Applications of the step are Healthy, pausing until") {
+					stepStatuses[i].Message = ""
+				}
+			}
+			assert.Equal(t, cc.expectedStepStatus, stepStatuses)
+			assert.Equal(t, cc.expectedRequeueAfter, requeueAfter > 0)
+			assert.NotContains(t, appSet.Annotations, argocommon.AnnotationApplicationSetPromote)
+		})
+	}
+}
+
+func TestUpdateApplicationSetRolloutStepStatusRollback(t *testing.T) {
+	scheme := runtime.NewScheme()
+	err := v1alpha1.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	rolloutStartTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	deployedAt := func(d time.Duration) metav1.Time {
+		return metav1.NewTime(rolloutStartTime.Add(d))
+	}
+	source := func(revision string) v1alpha1.ApplicationSource {
+		return v1alpha1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook", TargetRevision: revision}
+	}
+	app := func(name string, healthStatus health.HealthStatusCode, history v1alpha1.RevisionHistories) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec:       v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{TargetRevision: "v2"}},
+			Status: v1alpha1.ApplicationStatus{
+				Health:  v1alpha1.AppHealthStatus{Status: healthStatus},
+				Sync:    v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+				History: history,
+			},
+		}
+	}
+	// app1 and app2 were updated by the rollout, app3 was deployed before it started
+	updatedHistory := v1alpha1.RevisionHistories{
+		{ID: 1, Revision: "v1", Source: source("v1"), DeployedAt: deployedAt(-24 * time.Hour)},
+		{ID: 2, Revision: "v2", Source: source("v2"), DeployedAt: deployedAt(10 * time.Minute)},
+	}
+	apps := []*v1alpha1.Application{
+		app("app1", health.HealthStatusHealthy, updatedHistory),
+		app("app2", health.HealthStatusDegraded, updatedHistory),
+		app("app3", health.HealthStatusHealthy, v1alpha1.RevisionHistories{
+			{ID: 1, Revision: "v1", Source: source("v1"), DeployedAt: deployedAt(-24 * time.Hour)},
+		}),
+	}
+	appStatus := []v1alpha1.ApplicationSetApplicationStatus{
+		{Application: "app1", Status: "Healthy"},
+		{Application: "app2", Status: "Progressing"},
+		{Application: "app3", Status: "Healthy"},
+	}
+	// the rolled back applications are OutOfSync, waiting to be updated again
+	rolledBackAppStatus := []v1alpha1.ApplicationSetApplicationStatus{
+		{Application: "app1", Status: "Waiting"},
+		{Application: "app2", Status: "Waiting"},
+		{Application: "app3", Status: "Healthy"},
+	}
+	rollback := &v1alpha1.ApplicationSetRolloutStepRollback{Applications: []string{"app1", "app2"}, Generation: 3, RolloutStartTime: &rolloutStartTime}
+	pendingRollback := &v1alpha1.ApplicationSetRolloutStepRollback{Applications: []string{"app1"}, PendingApplications: []string{"app2"}, Generation: 3, RolloutStartTime: &rolloutStartTime}
+
+	for _, cc := range []struct {
+		name               string
+		rollbackOnFailure  bool
+		generation         int64
+		appStatus          []v1alpha1.ApplicationSetApplicationStatus
+		runningOperations  []string
+		stepStatus         []v1alpha1.ApplicationSetRolloutStepStatus
+		expectedStepStatus []v1alpha1.ApplicationSetRolloutStepStatus
+		expectedRolledBack []string
+		expectedRequeue    bool
+	}{
+		{
+			name:              "rolls back the applications updated by the rollout when a step degrades",
+			rollbackOnFailure: true,
+			generation:        3,
+			appStatus:         appStatus,
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy"},
+				{Step: "2", Status: "Progressing"},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy"},
+				{Step: "2", Status: "Degraded", Message: "Applications of the step are Degraded: app2.", Rollback: rollback},
+				{Step: "3", Status: "Healthy", Message: "Applications of the step are Healthy."},
+			},
+			expectedRolledBack: []string{"app1", "app2"},
+		},
+		{
+			name:              "terminates the running operations of the applications to roll back and retries them",
+			rollbackOnFailure: true,
+			generation:        3,
+			appStatus:         appStatus,
+			runningOperations: []string{"app2"},
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy"},
+				{Step: "2", Status: "Progressing"},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy"},
+				{Step: "2", Status: "Degraded", Message: "Applications of the step are Degraded: app2.", Rollback: pendingRollback},
+				{Step: "3", Status: "Healthy", Message: "Applications of the step are Healthy."},
+			},
+			expectedRolledBack: []string{"app1"},
+			expectedRequeue:    true,
+		},
+		{
+			name:              "rolls back the pending applications once their operation completed",
+			rollbackOnFailure: true,
+			generation:        3,
+			appStatus:         appStatus,
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy"},
+				{Step: "2", Status: "Degraded", Message: "Applications of the step are Degraded: app2.", Rollback: pendingRollback},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy"},
+				{Step: "2", Status: "Degraded", Message: "Applications of the step are Degraded: app2.", Rollback: rollback},
+				{Step: "3", Status: "Healthy", Message: "Applications of the step are Healthy."},
+			},
+			expectedRolledBack: []string{"app2"},
+		},
+		{
+			name:              "keeps a rolled back rollout halted",
+			rollbackOnFailure: true,
+			generation:        3,
+			appStatus:         rolledBackAppStatus,
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy"},
+				{Step: "2", Status: "Degraded", Message: "Applications of the step are Degraded: app2.", Rollback: rollback},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Waiting", Message: "Applications of the step are waiting to be updated."},
+				{Step: "2", Status: "Degraded", Message: "Applications of the step are Degraded: app2.", Rollback: rollback},
+				{Step: "3", Status: "Healthy", Message: "Applications of the step are Healthy."},
+			},
+		},
+		{
+			name:              "resumes a rolled back rollout once the ApplicationSet is modified",
+			rollbackOnFailure: true,
+			generation:        4,
+			appStatus:         rolledBackAppStatus,
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy"},
+				{Step: "2", Status: "Degraded", Message: "Applications of the step are Degraded: app2.", Rollback: rollback},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Waiting", Message: "Applications of the step are waiting to be updated."},
+				{Step: "2", Status: "Waiting", Message: "Applications of the step are waiting to be updated."},
+				{Step: "3", Status: "Healthy", Message: "Applications of the step are Healthy."},
+			},
+		},
+		{
+			name:       "does not roll back without rollbackOnFailure",
+			generation: 3,
+			appStatus:  appStatus,
+			stepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy"},
+				{Step: "2", Status: "Progressing"},
+			},
+			expectedStepStatus: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: "Healthy"},
+				{Step: "2", Status: "Progressing", Message: "Applications of the step are being updated."},
+				{Step: "3", Status: "Healthy", Message: "Applications of the step are Healthy."},
+			},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			appSet := v1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "name",
+					Namespace:  "argocd",
+					Generation: cc.generation,
+				},
+				Spec: v1alpha1.ApplicationSetSpec{
+					Strategy: &v1alpha1.ApplicationSetStrategy{
+						Type: "RollingSync",
+						RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+							Steps:             []v1alpha1.ApplicationSetRolloutStep{{}, {}, {}},
+							RollbackOnFailure: cc.rollbackOnFailure,
+						},
+					},
+				},
+				Status: v1alpha1.ApplicationSetStatus{
+					Conditions: []v1alpha1.ApplicationSetCondition{
+						{
+							Type:               v1alpha1.ApplicationSetConditionRolloutProgressing,
+							Message:            "ApplicationSet Rollout Rollout started",
+							Reason:             v1alpha1.ApplicationSetReasonApplicationSetModified,
+							Status:             v1alpha1.ApplicationSetConditionStatusTrue,
+							LastTransitionTime: &rolloutStartTime,
+						},
+					},
+					ApplicationStatus: cc.appStatus,
+					RolloutSteps:      cc.stepStatus,
+				},
+			}
+			objects := []crtclient.Object{&appSet}
+			appMap := map[string]v1alpha1.Application{}
+			for _, app := range apps {
+				app = app.DeepCopy()
+				if slices.Contains(cc.runningOperations, app.Name) {
+					app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{Revision: "v2"}}
+					app.Status.OperationState = &v1alpha1.OperationState{Operation: *app.Operation, Phase: common.OperationRunning}
+				}
+				objects = append(objects, app)
+				appMap[app.Name] = *app
+			}
+			client := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithStatusSubresource(&appSet).Build()
+
+			r := ApplicationSetReconciler{
+				Client:   client,
+				Scheme:   scheme,
+				Recorder: record.NewFakeRecorder(len(apps)),
+				Metrics:  appsetmetrics.NewFakeAppsetMetrics(),
+			}
+
+			requeueAfter, err := r.updateApplicationSetRolloutStepStatus(t.Context(), log.NewEntry(log.StandardLogger()), &appSet, [][]string{{"app1"}, {"app2"}, {"app3"}}, appMap)
+			require.NoError(t, err)
+			assert.Equal(t, cc.expectedRequeue, requeueAfter > 0)
+
+			stepStatuses := appSet.Status.RolloutSteps
+			for i := range stepStatuses {
+				// opt out of testing the LastTransitionTime
+				stepStatuses[i].LastTransitionTime = nil
+			}
+			assert.Equal(t, cc.expectedStepStatus, stepStatuses)
+
+			for _, app := range apps {
+				var retrievedApp v1alpha1.Application
+				err := client.Get(t.Context(), crtclient.ObjectKey{Namespace: "argocd", Name: app.Name}, &retrievedApp)
+				require.NoError(t, err)
+				if slices.Contains(cc.runningOperations, app.Name) {
+					require.NotNil(t, retrievedApp.Status.OperationState)
+					assert.Equal(t, common.OperationTerminating, retrievedApp.Status.OperationState.Phase, "operation of application %s should be terminated", app.Name)
+					assert.Equal(t, "v2", retrievedApp.Operation.Sync.Revision)
+					continue
+				}
+				if !slices.Contains(cc.expectedRolledBack, app.Name) {
+					assert.Nil(t, retrievedApp.Operation, "application %s should not be rolled back", app.Name)
+					continue
+				}
+				require.NotNil(t, retrievedApp.Operation, "application %s should be rolled back", app.Name)
+				assert.Equal(t, "applicationset-controller", retrievedApp.Operation.InitiatedBy.Username)
+				assert.Equal(t, "v1", retrievedApp.Operation.Sync.Revision)
+				assert.Equal(t, source("v1"), *retrievedApp.Operation.Sync.Source)
+			}
+		})
+	}
+}
+
+func TestBuildAppSyncMapRolloutStepGates(t *testing.T) {
+	healthyApp := func(name string) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: v1alpha1.ApplicationStatus{
+				Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusHealthy},
+				Sync:   v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced},
+			},
+		}
+	}
+	appMap := map[string]v1alpha1.Application{
+		"app1": healthyApp("app1"),
+		"app2": healthyApp("app2"),
+		"app3": healthyApp("app3"),
+	}
+	appSet := func(steps []v1alpha1.ApplicationSetRolloutStep, stepStatus []v1alpha1.ApplicationSetRolloutStepStatus) v1alpha1.ApplicationSet {
+		return v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type:        "RollingSync",
+					RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{Steps: steps},
+				},
+			},
+			Status: v1alpha1.ApplicationSetStatus{
+				ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+					{Application: "app1", Status: "Healthy"},
+					{Application: "app2", Status: "Waiting"},
+					{Application: "app3", Status: "Waiting"},
+				},
+				RolloutSteps: stepStatus,
+			},
+		}
+	}
+	appDependencyList := [][]string{{"app1"}, {"app2"}, {"app3"}}
+	r := ApplicationSetReconciler{}
+
+	t.Run("paused step holds the next steps", func(t *testing.T) {
+		appSyncMap := r.buildAppSyncMap(appSet(
+			[]v1alpha1.ApplicationSetRolloutStep{{PauseDuration: "1h"}, {}, {}},
+			[]v1alpha1.ApplicationSetRolloutStepStatus{{Step: "1", Status: "Paused"}},
+		), appDependencyList, appMap)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": false, "app3": false}, appSyncMap)
+	})
+
+	t.Run("completed step releases the next step", func(t *testing.T) {
+		appSyncMap := r.buildAppSyncMap(appSet(
+			[]v1alpha1.ApplicationSetRolloutStep{{PauseDuration: "1h"}, {}, {}},
+			[]v1alpha1.ApplicationSetRolloutStepStatus{{Step: "1", Status: "Healthy"}},
+		), appDependencyList, appMap)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": true, "app3": false}, appSyncMap)
+	})
+
+	t.Run("step requiring approval is held until approved", func(t *testing.T) {
+		steps := []v1alpha1.ApplicationSetRolloutStep{{}, {RequireApproval: true}, {}}
+		appSyncMap := r.buildAppSyncMap(appSet(steps, []v1alpha1.ApplicationSetRolloutStepStatus{{Step: "2", Status: "AwaitingApproval"}}), appDependencyList, appMap)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": false, "app3": false}, appSyncMap)
+
+		appSyncMap = r.buildAppSyncMap(appSet(steps, []v1alpha1.ApplicationSetRolloutStepStatus{{Step: "2", Status: "AwaitingApproval", Approved: true}}), appDependencyList, appMap)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": true, "app3": false}, appSyncMap)
+	})
+
+	t.Run("rolled back rollout holds every step", func(t *testing.T) {
+		appSyncMap := r.buildAppSyncMap(appSet(
+			[]v1alpha1.ApplicationSetRolloutStep{{}, {}, {}},
+			[]v1alpha1.ApplicationSetRolloutStepStatus{{Step: "1", Status: "Healthy"}, {Step: "2", Status: "Degraded", Rollback: &v1alpha1.ApplicationSetRolloutStepRollback{Applications: []string{"app1"}}}},
+		), appDependencyList, appMap)
+		assert.Equal(t, map[string]bool{"app1": false, "app2": false, "app3": false}, appSyncMap)
+	})
+}
+
 func TestUpdateResourceStatus(t *testing.T) {
 	scheme := runtime.NewScheme()
 	err := v1alpha1.AddToScheme(scheme)
@@ -6406,6 +7151,163 @@ func TestResourceStatusAreOrdered(t *testing.T) {
 	}
 }
 
+func TestBuildAppUpdateMap(t *testing.T) {
+	upToDateApp := func(name string, revision string) v1alpha1.Application {
+		source := &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", TargetRevision: revision}
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       v1alpha1.ApplicationSpec{Source: source},
+			Status: v1alpha1.ApplicationStatus{
+				Health: v1alpha1.AppHealthStatus{Status: health.HealthStatusHealthy},
+				Sync: v1alpha1.SyncStatus{
+					Status:     v1alpha1.SyncStatusCodeSynced,
+					ComparedTo: v1alpha1.ComparedTo{Source: *source},
+				},
+			},
+		}
+	}
+	appSet := func(steps []v1alpha1.ApplicationSetRolloutStep) *v1alpha1.ApplicationSet {
+		return &v1alpha1.ApplicationSet{
+			Spec: v1alpha1.ApplicationSetSpec{
+				Strategy: &v1alpha1.ApplicationSetStrategy{
+					Type:          "RollingUpdate",
+					RollingUpdate: &v1alpha1.ApplicationSetRolloutStrategy{Steps: steps},
+				},
+			},
+		}
+	}
+	appDependencyList := [][]string{{"app1", "app2"}, {"app3"}}
+
+	t.Run("outdated applications of the first step are updated", func(t *testing.T) {
+		appMap := map[string]v1alpha1.Application{
+			"app1": upToDateApp("app1", "v1"),
+			"app2": upToDateApp("app2", "v1"),
+			"app3": upToDateApp("app3", "v1"),
+		}
+		appOutdatedMap := map[string]bool{"app1": true, "app2": true, "app3": true}
+
+		appUpdateMap, stepStatuses := buildAppUpdateMap(log.NewEntry(log.StandardLogger()), appSet([]v1alpha1.ApplicationSetRolloutStep{{}, {}}), appDependencyList, appOutdatedMap, appMap)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": true, "app3": false}, appUpdateMap)
+		require.Len(t, stepStatuses, 2)
+		assert.Equal(t, v1alpha1.RolloutStepStatusProgressing, stepStatuses[0].Status)
+		assert.Equal(t, "0/2 Applications of the step are up to date and Healthy.", stepStatuses[0].Message)
+		assert.Equal(t, v1alpha1.RolloutStepStatusWaiting, stepStatuses[1].Status)
+	})
+
+	t.Run("maxUpdate limits the applications updated at once", func(t *testing.T) {
+		appMap := map[string]v1alpha1.Application{
+			"app1": upToDateApp("app1", "v1"),
+			"app2": upToDateApp("app2", "v1"),
+			"app3": upToDateApp("app3", "v1"),
+		}
+		appOutdatedMap := map[string]bool{"app1": true, "app2": true, "app3": true}
+		maxUpdate := intstr.FromInt(1)
+
+		appUpdateMap, _ := buildAppUpdateMap(log.NewEntry(log.StandardLogger()), appSet([]v1alpha1.ApplicationSetRolloutStep{{MaxUpdate: &maxUpdate}, {}}), appDependencyList, appOutdatedMap, appMap)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": false, "app3": false}, appUpdateMap)
+	})
+
+	t.Run("updating application counts towards maxUpdate", func(t *testing.T) {
+		updatingApp := upToDateApp("app1", "v2")
+		updatingApp.Status.Sync.ComparedTo.Source.TargetRevision = "v1"
+		appMap := map[string]v1alpha1.Application{
+			"app1": updatingApp,
+			"app2": upToDateApp("app2", "v1"),
+			"app3": upToDateApp("app3", "v1"),
+		}
+		appOutdatedMap := map[string]bool{"app1": false, "app2": true, "app3": true}
+		maxUpdate := intstr.FromString("50%")
+
+		appUpdateMap, stepStatuses := buildAppUpdateMap(log.NewEntry(log.StandardLogger()), appSet([]v1alpha1.ApplicationSetRolloutStep{{MaxUpdate: &maxUpdate}, {}}), appDependencyList, appOutdatedMap, appMap)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": false, "app3": false}, appUpdateMap)
+		assert.Equal(t, v1alpha1.RolloutStepStatusProgressing, stepStatuses[0].Status)
+	})
+
+	t.Run("completed step releases the next step", func(t *testing.T) {
+		appMap := map[string]v1alpha1.Application{
+			"app1": upToDateApp("app1", "v2"),
+			"app2": upToDateApp("app2", "v2"),
+			"app3": upToDateApp("app3", "v1"),
+		}
+		appOutdatedMap := map[string]bool{"app1": false, "app2": false, "app3": true}
+
+		appUpdateMap, stepStatuses := buildAppUpdateMap(log.NewEntry(log.StandardLogger()), appSet([]v1alpha1.ApplicationSetRolloutStep{{}, {}}), appDependencyList, appOutdatedMap, appMap)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": true, "app3": true}, appUpdateMap)
+		assert.Equal(t, v1alpha1.RolloutStepStatusHealthy, stepStatuses[0].Status)
+		assert.Equal(t, v1alpha1.RolloutStepStatusProgressing, stepStatuses[1].Status)
+		assert.False(t, rolloutStepsCompleted(stepStatuses))
+	})
+
+	t.Run("application successfully synced to its revision is up to date", func(t *testing.T) {
+		outOfSyncApp := func(name string) v1alpha1.Application {
+			app := upToDateApp(name, "v2")
+			app.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+			app.Status.Sync.Revision = "abc"
+			app.Status.OperationState = &v1alpha1.OperationState{
+				Phase:      common.OperationSucceeded,
+				SyncResult: &v1alpha1.SyncOperationResult{Revision: "abc"},
+			}
+			return app
+		}
+		appMap := map[string]v1alpha1.Application{
+			"app1": outOfSyncApp("app1"),
+			"app2": outOfSyncApp("app2"),
+			"app3": upToDateApp("app3", "v1"),
+		}
+		appOutdatedMap := map[string]bool{"app1": false, "app2": false, "app3": true}
+
+		appUpdateMap, stepStatuses := buildAppUpdateMap(log.NewEntry(log.StandardLogger()), appSet([]v1alpha1.ApplicationSetRolloutStep{{}, {}}), appDependencyList, appOutdatedMap, appMap)
+		assert.Equal(t, map[string]bool{"app1": true, "app2": true, "app3": true}, appUpdateMap)
+		assert.Equal(t, v1alpha1.RolloutStepStatusHealthy, stepStatuses[0].Status)
+	})
+
+	t.Run("applications holding the step are listed", func(t *testing.T) {
+		outOfSyncApp := upToDateApp("app1", "v2")
+		outOfSyncApp.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+		progressingApp := upToDateApp("app2", "v2")
+		progressingApp.Status.Health.Status = health.HealthStatusProgressing
+		syncingApp := upToDateApp("app3", "v2")
+		syncingApp.Status.OperationState = &v1alpha1.OperationState{Phase: common.OperationRunning}
+		appMap := map[string]v1alpha1.Application{
+			"app1": outOfSyncApp,
+			"app2": progressingApp,
+			"app3": syncingApp,
+		}
+		appOutdatedMap := map[string]bool{"app1": false, "app2": false, "app3": false}
+
+		_, stepStatuses := buildAppUpdateMap(log.NewEntry(log.StandardLogger()), appSet([]v1alpha1.ApplicationSetRolloutStep{{}}), [][]string{{"app1", "app2", "app3"}}, appOutdatedMap, appMap)
+		require.Len(t, stepStatuses, 1)
+		assert.Equal(t, v1alpha1.RolloutStepStatusProgressing, stepStatuses[0].Status)
+		assert.Equal(t, "0/3 Applications of the step are up to date and Healthy. Waiting for: app1 (OutOfSync, Healthy), app2 (Synced, Progressing), app3 (operation Running).", stepStatuses[0].Message)
+	})
+}
+
+func TestHoldValidApplications(t *testing.T) {
+	liveApp := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app1"},
+		Spec:       v1alpha1.ApplicationSpec{Project: "default", Source: &v1alpha1.ApplicationSource{TargetRevision: "v1"}},
+	}
+	validApps := []v1alpha1.Application{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "app1", Labels: map[string]string{"env": "dev"}},
+			Spec:       v1alpha1.ApplicationSpec{Project: "default", Source: &v1alpha1.ApplicationSource{TargetRevision: "v2"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "app2"},
+			Spec:       v1alpha1.ApplicationSpec{Project: "default", Source: &v1alpha1.ApplicationSource{TargetRevision: "v2"}},
+		},
+	}
+	r := ApplicationSetReconciler{}
+
+	heldApps := r.holdValidApplications(log.NewEntry(log.StandardLogger()), map[string]bool{"app1": false, "app2": false}, map[string]v1alpha1.Application{"app1": liveApp}, validApps)
+	require.Len(t, heldApps, 2)
+	// the spec of the existing application is held, its metadata is still updated
+	assert.Equal(t, "v1", heldApps[0].Spec.Source.TargetRevision)
+	assert.Equal(t, map[string]string{"env": "dev"}, heldApps[0].Labels)
+	// new applications are created right away
+	assert.Equal(t, "v2", heldApps[1].Spec.Source.TargetRevision)
+}
+
 func TestApplicationOwnsHandler(t *testing.T) {
 	// progressive syncs do not affect create, delete, or generic
 	ownsHandler := getApplicationOwnsHandler(true)
@@ -6499,6 +7401,21 @@ func TestApplicationOwnsHandler(t *testing.T) {
 			},
 			enableProgressiveSyncs: true,
 		}, want: true},
+		{name: "ApplicationComparedToDiff", args: args{
+			e: event.UpdateEvent{
+				ObjectOld: &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{
+					Sync: v1alpha1.SyncStatus{
+						ComparedTo: v1alpha1.ComparedTo{Source: v1alpha1.ApplicationSource{TargetRevision: "foo"}},
+					},
+				}},
+				ObjectNew: &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{
+					Sync: v1alpha1.SyncStatus{
+						ComparedTo: v1alpha1.ComparedTo{Source: v1alpha1.ApplicationSource{TargetRevision: "bar"}},
+					},
+				}},
+			},
+			enableProgressiveSyncs: true,
+		}, want: true},
 		{name: "SameApplicationGeneration", args: args{e: event.UpdateEvent{
 			ObjectOld: &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{
 				Generation: 1,
@@ -6961,6 +7878,56 @@ func TestShouldRequeueForApplicationSet(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "ApplicationSetPromoteAnnotationAdded",
+			args: args{
+				appSetOld: &v1alpha1.ApplicationSet{},
+				appSetNew: &v1alpha1.ApplicationSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{argocommon.AnnotationApplicationSetPromote: "2"},
+					},
+				},
+				enableProgressiveSyncs: true,
+			},
+			want: true,
+		},
+		{
+			name: "ApplicationSetPromoteAnnotationRemoved",
+			args: args{
+				appSetOld: &v1alpha1.ApplicationSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{argocommon.AnnotationApplicationSetPromote: "2"},
+					},
+				},
+				appSetNew:              &v1alpha1.ApplicationSet{},
+				enableProgressiveSyncs: true,
+			},
+			want: false,
+		},
+		{
+			name: "ApplicationSetApproveDeletionAnnotationAdded",
+			args: args{
+				appSetOld: &v1alpha1.ApplicationSet{},
+				appSetNew: &v1alpha1.ApplicationSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{argocommon.AnnotationApplicationSetApproveDeletion: "true"},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "ApplicationSetApproveDeletionAnnotationRemoved",
+			args: args{
+				appSetOld: &v1alpha1.ApplicationSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{argocommon.AnnotationApplicationSetApproveDeletion: "true"},
+					},
+				},
+				appSetNew: &v1alpha1.ApplicationSet{},
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
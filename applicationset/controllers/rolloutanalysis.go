@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	rolloutAnalysisTimeout = 30 * time.Second
+	// maxRolloutAnalysisMessageSize is the maximum size of the response body reported in the status of a failed step
+	maxRolloutAnalysisMessageSize = 1024
+)
+
+// rolloutAnalysisClient does not follow redirects, which could lead to URLs which are not allowed
+var rolloutAnalysisClient = &http.Client{
+	Timeout: rolloutAnalysisTimeout,
+	CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// rolloutAnalysisRequest is the body of the request sent to the analysis URL of a RollingSync step.
+type rolloutAnalysisRequest struct {
+	// ApplicationSet is the name of the ApplicationSet being rolled out
+	ApplicationSet string `json:"applicationSet"`
+	// Namespace is the namespace of the ApplicationSet
+	Namespace string `json:"namespace"`
+	// Step is the number of the step, starting at 1
+	Step string `json:"step"`
+	// Applications are the names of the Applications of the step
+	Applications []string `json:"applications"`
+}
+
+// rolloutAnalysisURLAllowed returns whether the analysis URL of a step matches one of the URLs allowed by the
+// controller. A URL matches an allowed URL if both have the same scheme and host, and the path of the allowed URL is a
+// prefix of its path.
+func rolloutAnalysisURLAllowed(analysisURL string, allowedURLs []string) bool {
+	u, err := url.Parse(analysisURL)
+	if err != nil || u.Host == "" {
+		return false
+	}
+	analysisPath := path.Clean("/" + u.Path)
+	for _, allowedURL := range allowedURLs {
+		allowed, err := url.Parse(allowedURL)
+		if err != nil || !strings.EqualFold(allowed.Scheme, u.Scheme) || !strings.EqualFold(allowed.Host, u.Host) {
+			continue
+		}
+		allowedPath := path.Clean("/" + allowed.Path)
+		if allowedPath == "/" || analysisPath == allowedPath || strings.HasPrefix(analysisPath, allowedPath+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// runRolloutAnalysis runs the analysis of a RollingSync step. It returns whether the analysis passed, along with the
+// response body of a failed analysis. An error is returned if the analysis could not be run, in which case its result
+// is inconclusive.
+func runRolloutAnalysis(ctx context.Context, analysis *argov1alpha1.ApplicationSetRolloutAnalysis, applicationSet *argov1alpha1.ApplicationSet, step string, appNames []string) (bool, string, error) {
+	body, err := json.Marshal(rolloutAnalysisRequest{
+		ApplicationSet: applicationSet.Name,
+		Namespace:      applicationSet.Namespace,
+		Step:           step,
+		Applications:   appNames,
+	})
+	if err != nil {
+		return false, "", fmt.Errorf("error marshaling analysis request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, analysis.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, "", fmt.Errorf("error creating analysis request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := rolloutAnalysisClient.Do(req)
+	if err != nil {
+		return false, "", fmt.Errorf("error calling analysis URL: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return true, "", nil
+	}
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxRolloutAnalysisMessageSize))
+	if err != nil {
+		return false, "", fmt.Errorf("error reading analysis response: %w", err)
+	}
+	message := strings.TrimSpace(string(respBody))
+	if message == "" {
+		message = resp.Status
+	}
+	return false, message, nil
+}
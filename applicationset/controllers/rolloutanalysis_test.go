@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestRunRolloutAnalysis(t *testing.T) {
+	appSet := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "argocd",
+		},
+	}
+
+	t.Run("passed", func(t *testing.T) {
+		var received rolloutAnalysisRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}))
+		defer server.Close()
+
+		passed, message, err := runRolloutAnalysis(t.Context(), &v1alpha1.ApplicationSetRolloutAnalysis{URL: server.URL}, appSet, "1", []string{"app1", "app2"})
+		require.NoError(t, err)
+		assert.True(t, passed)
+		assert.Empty(t, message)
+		assert.Equal(t, rolloutAnalysisRequest{ApplicationSet: "guestbook", Namespace: "argocd", Step: "1", Applications: []string{"app1", "app2"}}, received)
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			_, _ = w.Write([]byte("error rate above threshold\n"))
+		}))
+		defer server.Close()
+
+		passed, message, err := runRolloutAnalysis(t.Context(), &v1alpha1.ApplicationSetRolloutAnalysis{URL: server.URL}, appSet, "1", nil)
+		require.NoError(t, err)
+		assert.False(t, passed)
+		assert.Equal(t, "error rate above threshold", message)
+	})
+
+	t.Run("failed without body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		passed, message, err := runRolloutAnalysis(t.Context(), &v1alpha1.ApplicationSetRolloutAnalysis{URL: server.URL}, appSet, "1", nil)
+		require.NoError(t, err)
+		assert.False(t, passed)
+		assert.Equal(t, "500 Internal Server Error", message)
+	})
+
+	t.Run("redirect not followed", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+		}))
+		defer server.Close()
+
+		passed, message, err := runRolloutAnalysis(t.Context(), &v1alpha1.ApplicationSetRolloutAnalysis{URL: server.URL}, appSet, "1", nil)
+		require.NoError(t, err)
+		assert.False(t, passed)
+		assert.Equal(t, "302 Found", message)
+	})
+
+	t.Run("inconclusive", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+		server.Close()
+
+		_, _, err := runRolloutAnalysis(t.Context(), &v1alpha1.ApplicationSetRolloutAnalysis{URL: server.URL}, appSet, "1", nil)
+		require.Error(t, err)
+	})
+}
+
+func TestRolloutAnalysisURLAllowed(t *testing.T) {
+	allowedURLs := []string{"https://analysis.example.com/argocd/", "http://rollout-analysis.monitoring.svc"}
+	for _, tc := range []struct {
+		url      string
+		expected bool
+	}{
+		{url: "https://analysis.example.com/argocd", expected: true},
+		{url: "https://analysis.example.com/argocd/error-rate?window=5m", expected: true},
+		{url: "https://ANALYSIS.example.com/argocd/error-rate", expected: true},
+		{url: "http://rollout-analysis.monitoring.svc/error-rate", expected: true},
+		{url: "https://analysis.example.com/argocd-admin", expected: false},
+		{url: "https://analysis.example.com/argocd/../admin", expected: false},
+		{url: "http://analysis.example.com/argocd/error-rate", expected: false},
+		{url: "https://analysis.example.com:8443/argocd/error-rate", expected: false},
+		{url: "https://analysis.example.com.evil.com/argocd/error-rate", expected: false},
+		{url: "http://169.254.169.254/latest/meta-data", expected: false},
+		{url: "/argocd/error-rate", expected: false},
+		{url: "::", expected: false},
+	} {
+		t.Run(tc.url, func(t *testing.T) {
+			assert.Equal(t, tc.expected, rolloutAnalysisURLAllowed(tc.url, allowedURLs))
+		})
+	}
+	assert.False(t, rolloutAnalysisURLAllowed("https://analysis.example.com/argocd/error-rate", nil))
+}
@@ -2,7 +2,11 @@ package generators
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -17,6 +21,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/common"
 	argoappsetv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 )
 
 var _ Generator = (*ClusterGenerator)(nil)
@@ -29,11 +34,20 @@ type ClusterGenerator struct {
 	// namespace is the Argo CD namespace
 	namespace       string
 	settingsManager *settings.SettingsManager
+	// clusterInfo returns the information recorded by the application controller about the clusters, it is nil when
+	// that information is not available
+	clusterInfo ClusterInfoGetter
+}
+
+// ClusterInfoGetter returns the information the application controller records about a cluster, such as the app
+// state cache.
+type ClusterInfoGetter interface {
+	GetClusterInfo(server string, res *argoappsetv1alpha1.ClusterInfo) error
 }
 
 var render = &utils.Render{}
 
-func NewClusterGenerator(ctx context.Context, c client.Client, clientset kubernetes.Interface, namespace string) Generator {
+func NewClusterGenerator(ctx context.Context, c client.Client, clientset kubernetes.Interface, namespace string, clusterInfo ClusterInfoGetter) Generator {
 	settingsManager := settings.NewSettingsManager(ctx, clientset, namespace)
 
 	g := &ClusterGenerator{
@@ -42,13 +56,18 @@ func NewClusterGenerator(ctx context.Context, c client.Client, clientset kuberne
 		clientset:       clientset,
 		namespace:       namespace,
 		settingsManager: settingsManager,
+		clusterInfo:     clusterInfo,
 	}
 	return g
 }
 
 // GetRequeueAfter never requeue the cluster generator because the `clusterSecretEventHandler` will requeue the appsets
-// when the cluster secrets change
-func (g *ClusterGenerator) GetRequeueAfter(_ *argoappsetv1alpha1.ApplicationSetGenerator) time.Duration {
+// when the cluster secrets change, unless the information about the clusters is used, as it changes independently of
+// the cluster secrets.
+func (g *ClusterGenerator) GetRequeueAfter(appSetGenerator *argoappsetv1alpha1.ApplicationSetGenerator) time.Duration {
+	if appSetGenerator != nil && appSetGenerator.Clusters != nil && appSetGenerator.Clusters.UsesClusterInfo() {
+		return getDefaultRequeueAfter()
+	}
 	return NoRequeueAfter
 }
 
@@ -106,6 +125,15 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 			params["server"] = cluster.Server
 			params["project"] = ""
 
+			matched, err := g.appendClusterInfo(appSetGenerator.Clusters, cluster.Server, params, appSet.Spec.GoTemplate)
+			if err != nil {
+				return nil, fmt.Errorf("error getting info of local cluster: %w", err)
+			}
+			if !matched {
+				logCtx.WithField("cluster", "local cluster").Debug("local cluster does not match the cluster info requirements")
+				continue
+			}
+
 			err = appendTemplatedValues(appSetGenerator.Clusters.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
 			if err != nil {
 				return nil, fmt.Errorf("error appending templated values for local cluster: %w", err)
@@ -157,6 +185,15 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 			}
 		}
 
+		matched, err := g.appendClusterInfo(appSetGenerator.Clusters, string(cluster.Data["server"]), params, appSet.Spec.GoTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("error getting info of cluster %s: %w", cluster.Name, err)
+		}
+		if !matched {
+			logCtx.WithField("cluster", cluster.Name).Debug("cluster does not match the cluster info requirements")
+			continue
+		}
+
 		err = appendTemplatedValues(appSetGenerator.Clusters.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
 		if err != nil {
 			return nil, fmt.Errorf("error appending templated values for cluster: %w", err)
@@ -179,6 +216,73 @@ func (g *ClusterGenerator) GenerateParams(appSetGenerator *argoappsetv1alpha1.Ap
 	return res, nil
 }
 
+// appendClusterInfo adds the information recorded about the cluster to the parameters when requested by the generator.
+// It returns false if the cluster does not meet the requirements of the generator on that information.
+func (g *ClusterGenerator) appendClusterInfo(generator *argoappsetv1alpha1.ClusterGenerator, server string, params map[string]any, useGoTemplate bool) (bool, error) {
+	if !generator.UsesClusterInfo() {
+		return true, nil
+	}
+
+	info := &argoappsetv1alpha1.ClusterInfo{}
+	if g.clusterInfo != nil {
+		err := g.clusterInfo.GetClusterInfo(server, info)
+		if err != nil && !errors.Is(err, cacheutil.ErrCacheMiss) {
+			return false, err
+		}
+	}
+	// Nothing is recorded about the clusters the application controller does not monitor
+	if info.ConnectionState.Status == "" {
+		info.ConnectionState.Status = argoappsetv1alpha1.ConnectionStatusUnknown
+	}
+
+	if generator.SkipUnreachable && info.ConnectionState.Status == argoappsetv1alpha1.ConnectionStatusFailed {
+		return false, nil
+	}
+	for _, apiVersion := range generator.RequiredAPIVersions {
+		if !slices.Contains(info.APIVersions, apiVersion) {
+			return false, nil
+		}
+	}
+
+	if !generator.Info {
+		return true, nil
+	}
+	lastCacheSyncTime := ""
+	if info.CacheInfo.LastCacheSyncTime != nil {
+		lastCacheSyncTime = info.CacheInfo.LastCacheSyncTime.UTC().Format(time.RFC3339)
+	}
+	if useGoTemplate {
+		apiVersions := []string{}
+		apiVersions = append(apiVersions, info.APIVersions...)
+		params["info"] = map[string]any{
+			"kubernetesVersion": info.ServerVersion,
+			"apiVersions":       apiVersions,
+			"applicationsCount": info.ApplicationsCount,
+			"connectionState": map[string]any{
+				"status":  info.ConnectionState.Status,
+				"message": info.ConnectionState.Message,
+			},
+			"cacheInfo": map[string]any{
+				"resourcesCount":    info.CacheInfo.ResourcesCount,
+				"apisCount":         info.CacheInfo.APIsCount,
+				"nodesCount":        info.CacheInfo.NodesCount,
+				"lastCacheSyncTime": lastCacheSyncTime,
+			},
+		}
+	} else {
+		params["info.kubernetesVersion"] = info.ServerVersion
+		params["info.apiVersions"] = strings.Join(info.APIVersions, ",")
+		params["info.applicationsCount"] = strconv.FormatInt(info.ApplicationsCount, 10)
+		params["info.connectionState.status"] = info.ConnectionState.Status
+		params["info.connectionState.message"] = info.ConnectionState.Message
+		params["info.cacheInfo.resourcesCount"] = strconv.FormatInt(info.CacheInfo.ResourcesCount, 10)
+		params["info.cacheInfo.apisCount"] = strconv.FormatInt(info.CacheInfo.APIsCount, 10)
+		params["info.cacheInfo.nodesCount"] = strconv.FormatInt(info.CacheInfo.NodesCount, 10)
+		params["info.cacheInfo.lastCacheSyncTime"] = lastCacheSyncTime
+	}
+	return true, nil
+}
+
 func (g *ClusterGenerator) getSecretsByClusterName(log *log.Entry, appSetGenerator *argoappsetv1alpha1.ApplicationSetGenerator) (map[string]corev1.Secret, error) {
 	clusterSecretList := &corev1.SecretList{}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/applicationset/utils"
 	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -315,7 +317,7 @@ func TestGenerateParams(t *testing.T) {
 				testCase.clientError,
 			}
 
-			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace")
+			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace", nil)
 
 			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
@@ -853,7 +855,7 @@ func TestGenerateParamsGoTemplate(t *testing.T) {
 				testCase.clientError,
 			}
 
-			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace")
+			clusterGenerator := NewClusterGenerator(t.Context(), cl, appClientset, "namespace", nil)
 
 			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
 				ObjectMeta: metav1.ObjectMeta{
@@ -882,6 +884,163 @@ func TestGenerateParamsGoTemplate(t *testing.T) {
 	}
 }
 
+type fakeClusterInfoGetter struct {
+	infos map[string]argoprojiov1alpha1.ClusterInfo
+	err   error
+}
+
+func (f *fakeClusterInfoGetter) GetClusterInfo(server string, res *argoprojiov1alpha1.ClusterInfo) error {
+	if f.err != nil {
+		return f.err
+	}
+	info, ok := f.infos[server]
+	if !ok {
+		return cacheutil.ErrCacheMiss
+	}
+	*res = info
+	return nil
+}
+
+func TestGenerateParamsClusterInfo(t *testing.T) {
+	cluster := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "production-01",
+			Namespace: "namespace",
+			Labels: map[string]string{
+				"argocd.argoproj.io/secret-type": "cluster",
+			},
+		},
+		Data: map[string][]byte{
+			"config": []byte("{}"),
+			"name":   []byte("production-01"),
+			"server": []byte("https://production-01.example.com"),
+		},
+	}
+	syncTime := metav1.NewTime(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	productionInfo := argoprojiov1alpha1.ClusterInfo{
+		ConnectionState:   argoprojiov1alpha1.ConnectionState{Status: argoprojiov1alpha1.ConnectionStatusSuccessful},
+		ServerVersion:     "1.31",
+		ApplicationsCount: 3,
+		APIVersions:       []string{"v1", "apps/v1", "gateway.networking.k8s.io/v1"},
+		CacheInfo:         argoprojiov1alpha1.ClusterCacheInfo{ResourcesCount: 120, APIsCount: 40, NodesCount: 5, LastCacheSyncTime: &syncTime},
+	}
+
+	testCases := []struct {
+		name          string
+		generator     argoprojiov1alpha1.ClusterGenerator
+		goTemplate    bool
+		infos         map[string]argoprojiov1alpha1.ClusterInfo
+		infoError     error
+		expected      []map[string]any
+		expectedError string
+	}{
+		{
+			name:       "info with go template",
+			generator:  argoprojiov1alpha1.ClusterGenerator{Info: true},
+			goTemplate: true,
+			infos:      map[string]argoprojiov1alpha1.ClusterInfo{"https://production-01.example.com": productionInfo},
+			expected: []map[string]any{
+				{
+					"name": "in-cluster", "nameNormalized": "in-cluster", "server": "https://kubernetes.default.svc", "project": "",
+					"info": map[string]any{
+						"kubernetesVersion": "",
+						"apiVersions":       []string{},
+						"applicationsCount": int64(0),
+						"connectionState":   map[string]any{"status": "Unknown", "message": ""},
+						"cacheInfo":         map[string]any{"resourcesCount": int64(0), "apisCount": int64(0), "nodesCount": int64(0), "lastCacheSyncTime": ""},
+					},
+				},
+				{
+					"name": "production-01", "nameNormalized": "production-01", "server": "https://production-01.example.com", "project": "",
+					"metadata": map[string]any{"labels": map[string]string{"argocd.argoproj.io/secret-type": "cluster"}},
+					"info": map[string]any{
+						"kubernetesVersion": "1.31",
+						"apiVersions":       []string{"v1", "apps/v1", "gateway.networking.k8s.io/v1"},
+						"applicationsCount": int64(3),
+						"connectionState":   map[string]any{"status": "Successful", "message": ""},
+						"cacheInfo":         map[string]any{"resourcesCount": int64(120), "apisCount": int64(40), "nodesCount": int64(5), "lastCacheSyncTime": "2025-01-02T03:04:05Z"},
+					},
+				},
+			},
+		},
+		{
+			name:      "info without go template",
+			generator: argoprojiov1alpha1.ClusterGenerator{Info: true, Selector: metav1.LabelSelector{MatchLabels: map[string]string{"argocd.argoproj.io/secret-type": "cluster"}}},
+			infos:     map[string]argoprojiov1alpha1.ClusterInfo{"https://production-01.example.com": productionInfo},
+			expected: []map[string]any{
+				{
+					"name": "production-01", "nameNormalized": "production-01", "server": "https://production-01.example.com", "project": "",
+					"metadata.labels.argocd.argoproj.io/secret-type": "cluster",
+					"info.kubernetesVersion":                         "1.31",
+					"info.apiVersions":                               "v1,apps/v1,gateway.networking.k8s.io/v1",
+					"info.applicationsCount":                         "3",
+					"info.connectionState.status":                    "Successful",
+					"info.connectionState.message":                   "",
+					"info.cacheInfo.resourcesCount":                  "120",
+					"info.cacheInfo.apisCount":                       "40",
+					"info.cacheInfo.nodesCount":                      "5",
+					"info.cacheInfo.lastCacheSyncTime":               "2025-01-02T03:04:05Z",
+				},
+			},
+		},
+		{
+			name:      "required API versions",
+			generator: argoprojiov1alpha1.ClusterGenerator{RequiredAPIVersions: []string{"gateway.networking.k8s.io/v1"}},
+			infos:     map[string]argoprojiov1alpha1.ClusterInfo{"https://production-01.example.com": productionInfo},
+			expected: []map[string]any{
+				{
+					"name": "production-01", "nameNormalized": "production-01", "server": "https://production-01.example.com", "project": "",
+					"metadata.labels.argocd.argoproj.io/secret-type": "cluster",
+				},
+			},
+		},
+		{
+			name:      "unreachable clusters are skipped",
+			generator: argoprojiov1alpha1.ClusterGenerator{SkipUnreachable: true},
+			infos: map[string]argoprojiov1alpha1.ClusterInfo{"https://production-01.example.com": {
+				ConnectionState: argoprojiov1alpha1.ConnectionState{Status: argoprojiov1alpha1.ConnectionStatusFailed, Message: "connection refused"},
+			}},
+			expected: []map[string]any{
+				{"name": "in-cluster", "nameNormalized": "in-cluster", "server": "https://kubernetes.default.svc", "project": ""},
+			},
+		},
+		{
+			name:          "cache error",
+			generator:     argoprojiov1alpha1.ClusterGenerator{Info: true},
+			infoError:     errors.New("redis unavailable"),
+			expectedError: "error getting info of local cluster: redis unavailable",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			appClientset := kubefake.NewSimpleClientset(cluster)
+			fakeClient := fake.NewClientBuilder().WithObjects(cluster).Build()
+
+			clusterGenerator := NewClusterGenerator(t.Context(), fakeClient, appClientset, "namespace", &fakeClusterInfoGetter{infos: testCase.infos, err: testCase.infoError})
+
+			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "set",
+				},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					GoTemplate: testCase.goTemplate,
+				},
+			}
+
+			appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{Clusters: &testCase.generator}
+			got, err := clusterGenerator.GenerateParams(appSetGenerator, &applicationSetInfo, nil)
+			if testCase.expectedError != "" {
+				require.EqualError(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.ElementsMatch(t, testCase.expected, got)
+			assert.Equal(t, getDefaultRequeueAfter(), clusterGenerator.GetRequeueAfter(appSetGenerator))
+		})
+	}
+}
+
 func TestSanitizeClusterName(t *testing.T) {
 	t.Run("valid DNS-1123 subdomain name", func(t *testing.T) {
 		assert.Equal(t, "cluster-name", utils.SanitizeName("cluster-name"))
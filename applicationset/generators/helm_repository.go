@@ -0,0 +1,154 @@
+package generators
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+)
+
+var _ Generator = (*HelmRepositoryGenerator)(nil)
+
+// HelmRepositoryGenerator generates a parameter set per chart version of a Helm repository index.
+type HelmRepositoryGenerator struct {
+	repos services.Repos
+}
+
+// NewHelmRepositoryGenerator creates a new instance of Helm repository Generator
+func NewHelmRepositoryGenerator(repos services.Repos) Generator {
+	return &HelmRepositoryGenerator{
+		repos: repos,
+	}
+}
+
+func (g *HelmRepositoryGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.HelmRepository.Template
+}
+
+func (g *HelmRepositoryGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	if appSetGenerator.HelmRepository.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.HelmRepository.RequeueAfterSeconds) * time.Second
+	}
+
+	return getDefaultRequeueAfter()
+}
+
+func (g *HelmRepositoryGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.HelmRepository == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	generatorConfig := appSetGenerator.HelmRepository
+
+	var constraint *semver.Constraints
+	if generatorConfig.VersionConstraint != "" {
+		var err error
+		constraint, err = semver.NewConstraint(generatorConfig.VersionConstraint)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing version constraint %q: %w", generatorConfig.VersionConstraint, err)
+		}
+	}
+
+	// The repository credentials of the project are used when the project of the template can be resolved
+	project := resolveProjectName(appSet.Spec.Template.Spec.Project)
+	index, err := g.repos.GetHelmIndex(context.TODO(), generatorConfig.RepoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Helm repository index: %w", err)
+	}
+
+	charts := generatorConfig.Charts
+	if len(charts) == 0 {
+		for chart := range index.Entries {
+			charts = append(charts, chart)
+		}
+	}
+	sort.Strings(charts)
+
+	paramsArray := []map[string]any{}
+	for _, chart := range charts {
+		entries, err := index.GetEntries(chart)
+		if err != nil {
+			return nil, err
+		}
+		entries = filterHelmEntries(entries, constraint)
+		if !generatorConfig.AllVersions && len(entries) > 0 {
+			entries = entries[len(entries)-1:]
+		}
+
+		for _, entry := range entries {
+			params := map[string]any{
+				"repoURL":           generatorConfig.RepoURL,
+				"chart":             chart,
+				"chartNormalized":   utils.SanitizeName(chart),
+				"version":           entry.Version,
+				"versionNormalized": utils.SanitizeName(entry.Version),
+				"appVersion":        entry.AppVersion,
+				"description":       entry.Description,
+			}
+			if appSet.Spec.GoTemplate {
+				annotations := map[string]string{}
+				for key, value := range entry.Annotations {
+					annotations[key] = value
+				}
+				params["annotations"] = annotations
+			} else {
+				for key, value := range entry.Annotations {
+					params["annotations."+key] = value
+				}
+			}
+
+			err = appendTemplatedValues(generatorConfig.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to append templated values: %w", err)
+			}
+
+			paramsArray = append(paramsArray, params)
+		}
+	}
+	return paramsArray, nil
+}
+
+// filterHelmEntries returns the entries matching the version constraint, sorted by ascending semantic version. Entries
+// which are not semantic versions are ignored, as well as pre-releases when no constraint is given.
+func filterHelmEntries(entries helm.Entries, constraint *semver.Constraints) helm.Entries {
+	type versionedEntry struct {
+		version *semver.Version
+		entry   helm.Entry
+	}
+	matching := []versionedEntry{}
+	for _, entry := range entries {
+		version, err := semver.NewVersion(entry.Version)
+		if err != nil {
+			continue
+		}
+		if constraint == nil {
+			if version.Prerelease() != "" {
+				continue
+			}
+		} else if !constraint.Check(version) {
+			continue
+		}
+		matching = append(matching, versionedEntry{version: version, entry: entry})
+	}
+	sort.SliceStable(matching, func(i, j int) bool {
+		return matching[i].version.LessThan(matching[j].version)
+	})
+
+	filtered := make(helm.Entries, len(matching))
+	for i := range matching {
+		filtered[i] = matching[i].entry
+	}
+	return filtered
+}
@@ -0,0 +1,181 @@
+package generators
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/mocks"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+)
+
+func TestHelmRepositoryGenerateParams(t *testing.T) {
+	index := &helm.Index{
+		Entries: map[string]helm.Entries{
+			"guestbook": {
+				{Version: "2.0.0-rc.1", AppVersion: "v2"},
+				{Version: "1.1.0", AppVersion: "v1.1", Description: "A guestbook", Annotations: map[string]string{"category": "demo"}},
+				{Version: "1.0.0", AppVersion: "v1"},
+				{Version: "latest"},
+			},
+			"redis": {
+				{Version: "0.1.0", AppVersion: "7.0", Description: "Redis"},
+			},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		generator     argoprojiov1alpha1.HelmRepositoryGenerator
+		goTemplate    bool
+		indexError    error
+		expected      []map[string]any
+		expectedError string
+	}{
+		{
+			name: "latest version of every chart",
+			generator: argoprojiov1alpha1.HelmRepositoryGenerator{
+				RepoURL: "https://charts.example.com",
+				Values:  map[string]string{"release": "{{chart}}-{{version}}"},
+			},
+			expected: []map[string]any{
+				{
+					"repoURL":              "https://charts.example.com",
+					"chart":                "guestbook",
+					"chartNormalized":      "guestbook",
+					"version":              "1.1.0",
+					"versionNormalized":    "1.1.0",
+					"appVersion":           "v1.1",
+					"description":          "A guestbook",
+					"annotations.category": "demo",
+					"values.release":       "guestbook-1.1.0",
+				},
+				{
+					"repoURL":           "https://charts.example.com",
+					"chart":             "redis",
+					"chartNormalized":   "redis",
+					"version":           "0.1.0",
+					"versionNormalized": "0.1.0",
+					"appVersion":        "7.0",
+					"description":       "Redis",
+					"values.release":    "redis-0.1.0",
+				},
+			},
+		},
+		{
+			name: "all versions of a chart matching a constraint",
+			generator: argoprojiov1alpha1.HelmRepositoryGenerator{
+				RepoURL:           "https://charts.example.com",
+				Charts:            []string{"guestbook"},
+				VersionConstraint: ">=1.0.0-0",
+				AllVersions:       true,
+			},
+			goTemplate: true,
+			expected: []map[string]any{
+				{
+					"repoURL":           "https://charts.example.com",
+					"chart":             "guestbook",
+					"chartNormalized":   "guestbook",
+					"version":           "1.0.0",
+					"versionNormalized": "1.0.0",
+					"appVersion":        "v1",
+					"description":       "",
+					"annotations":       map[string]string{},
+				},
+				{
+					"repoURL":           "https://charts.example.com",
+					"chart":             "guestbook",
+					"chartNormalized":   "guestbook",
+					"version":           "1.1.0",
+					"versionNormalized": "1.1.0",
+					"appVersion":        "v1.1",
+					"description":       "A guestbook",
+					"annotations":       map[string]string{"category": "demo"},
+				},
+				{
+					"repoURL":           "https://charts.example.com",
+					"chart":             "guestbook",
+					"chartNormalized":   "guestbook",
+					"version":           "2.0.0-rc.1",
+					"versionNormalized": "2.0.0-rc.1",
+					"appVersion":        "v2",
+					"description":       "",
+					"annotations":       map[string]string{},
+				},
+			},
+		},
+		{
+			name: "no version matching the constraint",
+			generator: argoprojiov1alpha1.HelmRepositoryGenerator{
+				RepoURL:           "https://charts.example.com",
+				Charts:            []string{"redis"},
+				VersionConstraint: "^1.0.0",
+			},
+			expected: []map[string]any{},
+		},
+		{
+			name: "unknown chart",
+			generator: argoprojiov1alpha1.HelmRepositoryGenerator{
+				RepoURL: "https://charts.example.com",
+				Charts:  []string{"unknown"},
+			},
+			expectedError: "chart 'unknown' not found in index",
+		},
+		{
+			name: "invalid constraint",
+			generator: argoprojiov1alpha1.HelmRepositoryGenerator{
+				RepoURL:           "https://charts.example.com",
+				VersionConstraint: "not a constraint",
+			},
+			expectedError: "error parsing version constraint",
+		},
+		{
+			name: "index error",
+			generator: argoprojiov1alpha1.HelmRepositoryGenerator{
+				RepoURL: "https://charts.example.com",
+			},
+			indexError:    errors.New("unauthorized"),
+			expectedError: "error getting Helm repository index: unauthorized",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			argoCDServiceMock := mocks.Repos{}
+			argoCDServiceMock.On("GetHelmIndex", mock.Anything, "https://charts.example.com", "my-project").Return(index, testCase.indexError).Maybe()
+
+			helmRepositoryGenerator := NewHelmRepositoryGenerator(&argoCDServiceMock)
+			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "set",
+				},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					GoTemplate: testCase.goTemplate,
+					Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{
+						HelmRepository: &testCase.generator,
+					}},
+					Template: argoprojiov1alpha1.ApplicationSetTemplate{
+						Spec: argoprojiov1alpha1.ApplicationSpec{
+							Project: "my-project",
+						},
+					},
+				},
+			}
+
+			got, err := helmRepositoryGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
+			if testCase.expectedError != "" {
+				require.ErrorContains(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, got)
+		})
+	}
+}
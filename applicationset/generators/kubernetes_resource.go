@@ -0,0 +1,355 @@
+package generators
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+)
+
+var _ Generator = (*KubernetesResourceGenerator)(nil)
+
+var ErrKubernetesResourceGeneratorDisabled = errors.New("the KubernetesResource generator is disabled")
+
+// KubernetesResourceConfig holds the settings of the KubernetesResource generators which are set on the ApplicationSet
+// controller
+type KubernetesResourceConfig struct {
+	enabled bool
+	// allowedResources are the glob patterns of the kinds of resources which may be read, formatted as <kind>.<group>
+	// or as <kind> for the core group
+	allowedResources []string
+	// allowedNamespaces are the glob patterns of the namespaces whose resources may be read
+	allowedNamespaces []string
+	clients           *clusterClientsCache
+}
+
+func NewKubernetesResourceConfig(enabled bool, allowedResources []string, allowedNamespaces []string) KubernetesResourceConfig {
+	return KubernetesResourceConfig{
+		enabled:           enabled,
+		allowedResources:  allowedResources,
+		allowedNamespaces: allowedNamespaces,
+		clients:           &clusterClientsCache{clusters: map[string]*clusterClients{}},
+	}
+}
+
+// clusterClientsCache holds the clients of the clusters the resources are read from, so that the API resources of a
+// cluster are not discovered again on every generation
+type clusterClientsCache struct {
+	lock sync.Mutex
+	// inClusterRESTMapper is the REST mapper of the cluster the ApplicationSet controller runs in
+	inClusterRESTMapper meta.RESTMapper
+	// clusters holds the clients of the managed clusters by server URL
+	clusters map[string]*clusterClients
+}
+
+type clusterClients struct {
+	// config is the serialized configuration the clients were created with, the clients are created again when the
+	// configuration of the cluster changes
+	config     string
+	dynClient  dynamic.Interface
+	restMapper meta.RESTMapper
+}
+
+// KubernetesResourceGenerator generates a parameter set per Kubernetes resource of any kind, in the control plane or
+// in a managed cluster.
+type KubernetesResourceGenerator struct {
+	ctx        context.Context
+	clientset  kubernetes.Interface
+	namespace  string // namespace is the Argo CD namespace
+	dynClient  dynamic.Interface
+	restMapper meta.RESTMapper
+	KubernetesResourceConfig
+	// watcher requeues the ApplicationSets when their resources change, it is nil when the generator is not used by
+	// the controller
+	watcher *ResourceWatcher
+}
+
+func NewKubernetesResourceGenerator(ctx context.Context, dynClient dynamic.Interface, clientset kubernetes.Interface, namespace string, kubernetesResourceConfig KubernetesResourceConfig, watcher *ResourceWatcher) Generator {
+	var restMapper meta.RESTMapper
+	if kubernetesResourceConfig.clients != nil {
+		restMapper = kubernetesResourceConfig.clients.getInClusterRESTMapper(clientset)
+	} else {
+		restMapper = newRESTMapper(clientset.Discovery())
+	}
+	return &KubernetesResourceGenerator{
+		ctx:                      ctx,
+		clientset:                clientset,
+		namespace:                namespace,
+		dynClient:                dynClient,
+		restMapper:               restMapper,
+		KubernetesResourceConfig: kubernetesResourceConfig,
+		watcher:                  watcher,
+	}
+}
+
+func (g *KubernetesResourceGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	if appSetGenerator.KubernetesResource.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.KubernetesResource.RequeueAfterSeconds) * time.Second
+	}
+
+	// The changes of the resources are watched, there is no need to poll them
+	if g.watcher != nil {
+		return NoRequeueAfter
+	}
+	return getDefaultRequeueAfter()
+}
+
+func (g *KubernetesResourceGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.KubernetesResource.Template
+}
+
+func (g *KubernetesResourceGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.KubernetesResource == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if !g.enabled {
+		return nil, ErrKubernetesResourceGeneratorDisabled
+	}
+
+	generatorConfig := appSetGenerator.KubernetesResource
+
+	groupVersion, err := schema.ParseGroupVersion(generatorConfig.APIVersion)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing apiVersion %q: %w", generatorConfig.APIVersion, err)
+	}
+	if generatorConfig.Kind == "" {
+		return nil, errors.New("kind is required")
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&generatorConfig.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing label selector: %w", err)
+	}
+	var condition *vm.Program
+	if generatorConfig.Condition != "" {
+		condition, err = expr.Compile(generatorConfig.Condition, expr.AsBool())
+		if err != nil {
+			return nil, fmt.Errorf("error compiling condition %q: %w", generatorConfig.Condition, err)
+		}
+	}
+	paramPaths := map[string]*jsonpath.JSONPath{}
+	for name, path := range generatorConfig.Params {
+		paramPath := jsonpath.New(name).AllowMissingKeys(true)
+		if err := paramPath.Parse(path); err != nil {
+			return nil, fmt.Errorf("error parsing JSONPath %q of param %q: %w", path, name, err)
+		}
+		paramPaths[name] = paramPath
+	}
+
+	server, dynClient, restMapper, err := g.getClusterClients(generatorConfig.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := restMapper.RESTMapping(groupVersion.WithKind(generatorConfig.Kind).GroupKind(), groupVersion.Version)
+	if resettableRESTMapper, ok := restMapper.(meta.ResettableRESTMapper); ok && meta.IsNoMatchError(err) {
+		// The kind may have been added to the cluster since its API resources were discovered
+		resettableRESTMapper.Reset()
+		mapping, err = restMapper.RESTMapping(groupVersion.WithKind(generatorConfig.Kind).GroupKind(), groupVersion.Version)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting the resource of %s: %w", groupVersion.WithKind(generatorConfig.Kind), err)
+	}
+	if mapping.Resource.Group == "" && mapping.Resource.Resource == "secrets" {
+		return nil, errors.New("secrets cannot be used by the KubernetesResource generator")
+	}
+	groupKind := mapping.GroupVersionKind.GroupKind().String()
+	if !glob.MatchStringInList(g.allowedResources, groupKind, glob.GLOB) {
+		return nil, fmt.Errorf("%s is not one of the resources allowed by the ApplicationSet controller", groupKind)
+	}
+
+	namespace := ""
+	resourceClient := dynClient.Resource(mapping.Resource)
+	var resources dynamic.ResourceInterface = resourceClient
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		namespace = generatorConfig.Namespace
+		// Listing the resources of all namespaces requires all the namespaces to be allowed
+		if !glob.MatchStringInList(g.allowedNamespaces, namespace, glob.GLOB) {
+			if namespace == "" {
+				return nil, errors.New("the resources of all namespaces may not be read, a namespace allowed by the ApplicationSet controller is required")
+			}
+			return nil, fmt.Errorf("namespace %q is not one of the namespaces allowed by the ApplicationSet controller", namespace)
+		}
+		resources = resourceClient.Namespace(namespace)
+	}
+	resourceList, err := resources.List(g.ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s: %w", mapping.Resource, err)
+	}
+
+	// Watch the resources so that any change reconciles the ApplicationSet
+	g.watcher.Watch(types.NamespacedName{Namespace: appSet.Namespace, Name: appSet.Name}, resourceWatchKey{
+		server:        server,
+		gvr:           mapping.Resource,
+		namespace:     namespace,
+		labelSelector: selector.String(),
+	}, dynClient)
+
+	items := resourceList.Items
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].GetNamespace() != items[j].GetNamespace() {
+			return items[i].GetNamespace() < items[j].GetNamespace()
+		}
+		return items[i].GetName() < items[j].GetName()
+	})
+
+	paramsArray := []map[string]any{}
+	for _, item := range items {
+		if condition != nil {
+			result, err := expr.Run(condition, item.Object)
+			if err != nil {
+				return nil, fmt.Errorf("error evaluating condition on %s/%s: %w", item.GetNamespace(), item.GetName(), err)
+			}
+			if matched, ok := result.(bool); !ok || !matched {
+				continue
+			}
+		}
+
+		params := map[string]any{
+			"name":      item.GetName(),
+			"namespace": item.GetNamespace(),
+		}
+		if appSet.Spec.GoTemplate {
+			params["object"] = item.Object
+		}
+		for name, paramPath := range paramPaths {
+			value, err := evaluateJSONPath(paramPath, item.Object, appSet.Spec.GoTemplate)
+			if err != nil {
+				return nil, fmt.Errorf("error evaluating param %q on %s/%s: %w", name, item.GetNamespace(), item.GetName(), err)
+			}
+			params[name] = value
+		}
+
+		err = appendTemplatedValues(generatorConfig.Values, params, appSet.Spec.GoTemplate, appSet.Spec.GoTemplateOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to append templated values: %w", err)
+		}
+
+		paramsArray = append(paramsArray, params)
+	}
+	return paramsArray, nil
+}
+
+// getClusterClients returns the server URL and the clients of the cluster the resources are read from. The server URL
+// is empty for the cluster the ApplicationSet controller runs in.
+func (g *KubernetesResourceGenerator) getClusterClients(clusterName string) (string, dynamic.Interface, meta.RESTMapper, error) {
+	if clusterName == "" {
+		return "", g.dynClient, g.restMapper, nil
+	}
+	cluster, err := utils.GetCluster(g.ctx, g.clientset, g.namespace, clusterName)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("error getting cluster: %w", err)
+	}
+	if cluster.Server == argoprojiov1alpha1.KubernetesInternalAPIServerAddr {
+		return "", g.dynClient, g.restMapper, nil
+	}
+
+	if g.clients == nil {
+		clients, err := newClusterClients(cluster)
+		if err != nil {
+			return "", nil, nil, err
+		}
+		return cluster.Server, clients.dynClient, clients.restMapper, nil
+	}
+	clients, err := g.clients.get(cluster)
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return cluster.Server, clients.dynClient, clients.restMapper, nil
+}
+
+func (c *clusterClientsCache) getInClusterRESTMapper(clientset kubernetes.Interface) meta.RESTMapper {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.inClusterRESTMapper == nil {
+		c.inClusterRESTMapper = newRESTMapper(clientset.Discovery())
+	}
+	return c.inClusterRESTMapper
+}
+
+// get returns the cached clients of a cluster, or creates them if the cluster was not used yet or its configuration
+// changed since.
+func (c *clusterClientsCache) get(cluster *argoprojiov1alpha1.Cluster) (*clusterClients, error) {
+	config, err := json.Marshal(cluster.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing configuration of cluster %q: %w", cluster.Name, err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if clients, ok := c.clusters[cluster.Server]; ok && clients.config == string(config) {
+		return clients, nil
+	}
+	clients, err := newClusterClients(cluster)
+	if err != nil {
+		return nil, err
+	}
+	clients.config = string(config)
+	c.clusters[cluster.Server] = clients
+	return clients, nil
+}
+
+func newClusterClients(cluster *argoprojiov1alpha1.Cluster) (*clusterClients, error) {
+	config, err := cluster.RESTConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error getting REST config of cluster %q: %w", cluster.Name, err)
+	}
+	dynClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dynamic client for cluster %q: %w", cluster.Name, err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating discovery client for cluster %q: %w", cluster.Name, err)
+	}
+	return &clusterClients{dynClient: dynClient, restMapper: newRESTMapper(discoveryClient)}, nil
+}
+
+// newRESTMapper returns a REST mapper caching the API resources of a cluster
+func newRESTMapper(discoveryClient discovery.DiscoveryInterface) meta.RESTMapper {
+	return restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+}
+
+// evaluateJSONPath returns the value selected by a JSONPath expression. A single value is returned as is when Go
+// templates are used, values are otherwise formatted as a string.
+func evaluateJSONPath(path *jsonpath.JSONPath, object map[string]any, useGoTemplate bool) (any, error) {
+	if useGoTemplate {
+		results, err := path.FindResults(object)
+		if err != nil {
+			return nil, err
+		}
+		if len(results) == 1 && len(results[0]) == 1 {
+			return results[0][0].Interface(), nil
+		}
+	}
+	buf := &bytes.Buffer{}
+	if err := path.Execute(buf, object); err != nil {
+		return nil, err
+	}
+	return buf.String(), nil
+}
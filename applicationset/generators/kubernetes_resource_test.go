@@ -0,0 +1,367 @@
+package generators
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+var (
+	tenantGVR = schema.GroupVersionResource{Group: "platform.example.com", Version: "v1", Resource: "tenants"}
+	secretGVR = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+)
+
+func newTenant(namespace, name string, labels map[string]string, spec map[string]any) *unstructured.Unstructured {
+	tenant := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "platform.example.com/v1",
+		"kind":       "Tenant",
+		"metadata": map[string]any{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"spec": spec,
+	}}
+	tenant.SetLabels(labels)
+	return tenant
+}
+
+func newTestKubernetesResourceGenerator(t *testing.T, watcher *ResourceWatcher, objects ...runtime.Object) *KubernetesResourceGenerator {
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(tenantGVR.GroupVersion().WithKind("Tenant"), meta.RESTScopeNamespace)
+	restMapper.Add(secretGVR.GroupVersion().WithKind("Secret"), meta.RESTScopeNamespace)
+	dynClient := dynfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		tenantGVR: "TenantList",
+		secretGVR: "SecretList",
+	}, objects...)
+
+	return &KubernetesResourceGenerator{
+		ctx:        t.Context(),
+		clientset:  kubefake.NewClientset(),
+		namespace:  "argocd",
+		dynClient:  dynClient,
+		restMapper: restMapper,
+		KubernetesResourceConfig: KubernetesResourceConfig{
+			enabled:           true,
+			allowedResources:  []string{"*"},
+			allowedNamespaces: []string{"*"},
+		},
+		watcher: watcher,
+	}
+}
+
+func TestKubernetesResourceGenerateParams(t *testing.T) {
+	tenants := []runtime.Object{
+		newTenant("tenants", "blue", map[string]string{"tier": "gold"}, map[string]any{"owner": "team-blue", "enabled": true, "regions": []any{"eu", "us"}}),
+		newTenant("tenants", "red", map[string]string{"tier": "silver"}, map[string]any{"owner": "team-red", "enabled": false}),
+		newTenant("other", "green", map[string]string{"tier": "gold"}, map[string]any{"owner": "team-green", "enabled": true}),
+	}
+
+	cases := []struct {
+		name          string
+		generator     argoprojiov1alpha1.KubernetesResourceGenerator
+		goTemplate    bool
+		expected      []map[string]any
+		expectedError string
+	}{
+		{
+			name: "label selector and JSONPath params",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion:    "platform.example.com/v1",
+				Kind:          "Tenant",
+				LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}},
+				Params:        map[string]string{"owner": "{.spec.owner}", "regions": "{.spec.regions[*]}", "missing": "{.spec.missing}"},
+				Values:        map[string]string{"release": "{{name}}-{{owner}}"},
+			},
+			expected: []map[string]any{
+				{"name": "green", "namespace": "other", "owner": "team-green", "regions": "", "missing": "", "values.release": "green-team-green"},
+				{"name": "blue", "namespace": "tenants", "owner": "team-blue", "regions": "eu us", "missing": "", "values.release": "blue-team-blue"},
+			},
+		},
+		{
+			name: "condition in a namespace",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "platform.example.com/v1",
+				Kind:       "Tenant",
+				Namespace:  "tenants",
+				Condition:  `spec.enabled && metadata.labels?.tier in ["gold", "silver"]`,
+				Params:     map[string]string{"regions": "{.spec.regions}"},
+			},
+			goTemplate: true,
+			expected: []map[string]any{
+				{
+					"name":      "blue",
+					"namespace": "tenants",
+					"object":    tenants[0].(*unstructured.Unstructured).Object,
+					"regions":   []any{"eu", "us"},
+				},
+			},
+		},
+		{
+			name: "invalid condition",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "platform.example.com/v1",
+				Kind:       "Tenant",
+				Condition:  "spec.enabled &&",
+			},
+			expectedError: "error compiling condition",
+		},
+		{
+			name: "invalid JSONPath",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "platform.example.com/v1",
+				Kind:       "Tenant",
+				Params:     map[string]string{"owner": "{.spec.owner"},
+			},
+			expectedError: `error parsing JSONPath "{.spec.owner" of param "owner"`,
+		},
+		{
+			name: "unknown kind",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "platform.example.com/v1",
+				Kind:       "Team",
+			},
+			expectedError: "error getting the resource of platform.example.com/v1, Kind=Team",
+		},
+		{
+			name: "secrets are refused",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "v1",
+				Kind:       "Secret",
+			},
+			expectedError: "secrets cannot be used by the KubernetesResource generator",
+		},
+		{
+			name: "unknown cluster",
+			generator: argoprojiov1alpha1.KubernetesResourceGenerator{
+				APIVersion: "platform.example.com/v1",
+				Kind:       "Tenant",
+				Cluster:    "production",
+			},
+			expectedError: `error getting cluster: cluster "production" not found`,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			generator := newTestKubernetesResourceGenerator(t, nil, tenants...)
+			appSet := argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					GoTemplate: testCase.goTemplate,
+					Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{
+						KubernetesResource: &testCase.generator,
+					}},
+				},
+			}
+
+			got, err := generator.GenerateParams(&appSet.Spec.Generators[0], &appSet, nil)
+			if testCase.expectedError != "" {
+				require.ErrorContains(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, got)
+		})
+	}
+}
+
+func TestKubernetesResourceAllowlists(t *testing.T) {
+	tenants := []runtime.Object{
+		newTenant("tenants", "blue", nil, map[string]any{}),
+		newTenant("other", "green", nil, map[string]any{}),
+	}
+
+	cases := []struct {
+		name              string
+		config            KubernetesResourceConfig
+		namespace         string
+		expectedNames     []string
+		expectedError     string
+		expectedErrorType error
+	}{
+		{
+			name:              "disabled generator",
+			config:            NewKubernetesResourceConfig(false, []string{"*"}, []string{"*"}),
+			namespace:         "tenants",
+			expectedErrorType: ErrKubernetesResourceGeneratorDisabled,
+		},
+		{
+			name:          "allowed resource in an allowed namespace",
+			config:        NewKubernetesResourceConfig(true, []string{"Tenant.platform.example.com"}, []string{"tenant*"}),
+			namespace:     "tenants",
+			expectedNames: []string{"blue"},
+		},
+		{
+			name:          "resource matching a pattern",
+			config:        NewKubernetesResourceConfig(true, []string{"*.platform.example.com"}, []string{"tenants"}),
+			namespace:     "tenants",
+			expectedNames: []string{"blue"},
+		},
+		{
+			name:          "resource which is not allowed",
+			config:        NewKubernetesResourceConfig(true, []string{"ConfigMap", "Deployment.apps"}, []string{"*"}),
+			namespace:     "tenants",
+			expectedError: "Tenant.platform.example.com is not one of the resources allowed by the ApplicationSet controller",
+		},
+		{
+			name:          "namespace which is not allowed",
+			config:        NewKubernetesResourceConfig(true, []string{"*"}, []string{"tenants"}),
+			namespace:     "other",
+			expectedError: `namespace "other" is not one of the namespaces allowed by the ApplicationSet controller`,
+		},
+		{
+			name:          "all namespaces without every namespace allowed",
+			config:        NewKubernetesResourceConfig(true, []string{"*"}, []string{"tenants", "other"}),
+			expectedError: "the resources of all namespaces may not be read",
+		},
+		{
+			name:          "all namespaces with every namespace allowed",
+			config:        NewKubernetesResourceConfig(true, []string{"*"}, []string{"*"}),
+			expectedNames: []string{"green", "blue"},
+		},
+		{
+			name:          "empty allowlists",
+			config:        NewKubernetesResourceConfig(true, nil, nil),
+			namespace:     "tenants",
+			expectedError: "is not one of the resources allowed by the ApplicationSet controller",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			generator := newTestKubernetesResourceGenerator(t, nil, tenants...)
+			generator.KubernetesResourceConfig = testCase.config
+			appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+				KubernetesResource: &argoprojiov1alpha1.KubernetesResourceGenerator{
+					APIVersion: "platform.example.com/v1",
+					Kind:       "Tenant",
+					Namespace:  testCase.namespace,
+				},
+			}
+
+			got, err := generator.GenerateParams(appSetGenerator, &argoprojiov1alpha1.ApplicationSet{}, nil)
+			switch {
+			case testCase.expectedErrorType != nil:
+				require.ErrorIs(t, err, testCase.expectedErrorType)
+				return
+			case testCase.expectedError != "":
+				require.ErrorContains(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			names := []string{}
+			for _, params := range got {
+				names = append(names, params["name"].(string))
+			}
+			assert.Equal(t, testCase.expectedNames, names)
+		})
+	}
+}
+
+func TestClusterClientsCache(t *testing.T) {
+	cache := NewKubernetesResourceConfig(true, nil, nil).clients
+	cluster := &argoprojiov1alpha1.Cluster{
+		Name:   "production",
+		Server: "https://production.example.com",
+		Config: argoprojiov1alpha1.ClusterConfig{BearerToken: "token"},
+	}
+
+	clients, err := cache.get(cluster)
+	require.NoError(t, err)
+	cached, err := cache.get(cluster)
+	require.NoError(t, err)
+	assert.Same(t, clients, cached)
+
+	// The clients are created again when the credentials of the cluster change
+	cluster.Config.BearerToken = "rotated"
+	rotated, err := cache.get(cluster)
+	require.NoError(t, err)
+	assert.NotSame(t, clients, rotated)
+	assert.Len(t, cache.clusters, 1)
+
+	clientset := kubefake.NewClientset()
+	assert.Same(t, cache.getInClusterRESTMapper(clientset), cache.getInClusterRESTMapper(clientset))
+}
+
+func TestKubernetesResourceGetRequeueAfter(t *testing.T) {
+	appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{
+		KubernetesResource: &argoprojiov1alpha1.KubernetesResourceGenerator{},
+	}
+
+	generator := newTestKubernetesResourceGenerator(t, nil)
+	assert.Equal(t, getDefaultRequeueAfter(), generator.GetRequeueAfter(appSetGenerator))
+
+	generator = newTestKubernetesResourceGenerator(t, NewResourceWatcher(t.Context()))
+	assert.Equal(t, NoRequeueAfter, generator.GetRequeueAfter(appSetGenerator))
+
+	appSetGenerator.KubernetesResource.RequeueAfterSeconds = ptr.To[int64](60)
+	assert.Equal(t, time.Minute, generator.GetRequeueAfter(appSetGenerator))
+}
+
+func TestKubernetesResourceWatch(t *testing.T) {
+	watcher := NewResourceWatcher(t.Context())
+	generator := newTestKubernetesResourceGenerator(t, watcher, newTenant("tenants", "blue", nil, map[string]any{}))
+	appSet := argoprojiov1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "set", Namespace: "argocd"},
+		Spec: argoprojiov1alpha1.ApplicationSetSpec{
+			Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{
+				KubernetesResource: &argoprojiov1alpha1.KubernetesResourceGenerator{
+					APIVersion: "platform.example.com/v1",
+					Kind:       "Tenant",
+					Namespace:  "tenants",
+				},
+			}},
+		},
+	}
+	appSetKey := types.NamespacedName{Namespace: "argocd", Name: "set"}
+
+	_, err := generator.GenerateParams(&appSet.Spec.Generators[0], &appSet, nil)
+	require.NoError(t, err)
+	require.Len(t, watcher.watches, 1)
+
+	// Creating a resource reconciles the ApplicationSet, once the initial resources are listed by the watch
+	received := false
+	for i := 0; i < 100 && !received; i++ {
+		_, err := generator.dynClient.Resource(tenantGVR).Namespace("tenants").Create(t.Context(), newTenant("tenants", fmt.Sprintf("red-%d", i), nil, map[string]any{}), metav1.CreateOptions{})
+		require.NoError(t, err)
+		select {
+		case e := <-watcher.Events():
+			assert.Equal(t, "set", e.Object.GetName())
+			assert.Equal(t, "argocd", e.Object.GetNamespace())
+			received = true
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+	require.True(t, received, "no event received")
+
+	// A watch used again by the ApplicationSet is kept
+	watcher.MarkStale(appSetKey)
+	_, err = generator.GenerateParams(&appSet.Spec.Generators[0], &appSet, nil)
+	require.NoError(t, err)
+	watcher.RemoveStale(appSetKey)
+	assert.Len(t, watcher.watches, 1)
+
+	// A watch the ApplicationSet no longer uses is stopped
+	watcher.MarkStale(appSetKey)
+	watcher.RemoveStale(appSetKey)
+	assert.Empty(t, watcher.watches)
+
+	_, err = generator.GenerateParams(&appSet.Spec.Generators[0], &appSet, nil)
+	require.NoError(t, err)
+	watcher.Forget(appSetKey)
+	assert.Empty(t, watcher.watches)
+}
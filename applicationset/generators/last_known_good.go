@@ -0,0 +1,268 @@
+package generators
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/google/go-github/v69/github"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	log "github.com/sirupsen/logrus"
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+)
+
+// lastKnownGoodParams are the last parameters successfully generated by a generator of an ApplicationSet
+type lastKnownGoodParams struct {
+	// Params are the parameters, as JSON
+	Params      string
+	GeneratedAt time.Time
+}
+
+// GeneratorFallback describes a generator which failed, and whose last known good parameters were used instead.
+type GeneratorFallback struct {
+	// Generator is the name of the generator, e.g. SCMProvider
+	Generator string
+	// GeneratedAt is the time the parameters were generated
+	GeneratedAt time.Time
+	// Err is the error of the generator
+	Err error
+}
+
+// LastKnownGood stores the last parameters successfully generated by the generators of each ApplicationSet, and
+// returns them when a generator fails transiently, e.g. because an SCM provider API is rate limited or down, as long
+// as they are more recent than the configured duration. The generators which fell back to their last known good
+// parameters in the last generation of an ApplicationSet are recorded, to report them in its status.
+type LastKnownGood struct {
+	cache    *cacheutil.Cache
+	duration time.Duration
+
+	lock      sync.Mutex
+	fallbacks map[types.NamespacedName][]GeneratorFallback
+}
+
+// NewLastKnownGood returns a LastKnownGood storing the parameters in the given cache, for the given duration. It
+// returns nil if the duration is not positive, disabling the fallback to the last known good parameters.
+func NewLastKnownGood(cache *cacheutil.Cache, duration time.Duration) *LastKnownGood {
+	if cache == nil || duration <= 0 {
+		return nil
+	}
+	return &LastKnownGood{
+		cache:     cache,
+		duration:  duration,
+		fallbacks: map[types.NamespacedName][]GeneratorFallback{},
+	}
+}
+
+// Wrap returns the generators, falling back to their last known good parameters when they fail.
+func (l *LastKnownGood) Wrap(generators map[string]Generator) map[string]Generator {
+	if l == nil {
+		return generators
+	}
+	res := make(map[string]Generator, len(generators))
+	for name, g := range generators {
+		res[name] = &lastKnownGoodGenerator{Generator: g, name: name, lastKnownGood: l}
+	}
+	return res
+}
+
+// ClearFallbacks forgets the generators of the ApplicationSet which fell back to their last known good parameters. It
+// is called before generating the parameters of the ApplicationSet.
+func (l *LastKnownGood) ClearFallbacks(appSet types.NamespacedName) {
+	if l == nil {
+		return
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	delete(l.fallbacks, appSet)
+}
+
+// Fallbacks returns the generators of the ApplicationSet which fell back to their last known good parameters since
+// the fallbacks were last cleared.
+func (l *LastKnownGood) Fallbacks(appSet types.NamespacedName) []GeneratorFallback {
+	if l == nil {
+		return nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return append([]GeneratorFallback(nil), l.fallbacks[appSet]...)
+}
+
+// FallbacksMessage describes the generators which fell back to their last known good parameters, or returns an
+// empty string if there are none.
+func FallbacksMessage(fallbacks []GeneratorFallback) string {
+	if len(fallbacks) == 0 {
+		return ""
+	}
+	messages := make([]string, 0, len(fallbacks))
+	for _, fallback := range fallbacks {
+		messages = append(messages, fmt.Sprintf("the %s generator failed, using its parameters generated at %s: %v", fallback.Generator, fallback.GeneratedAt.UTC().Format(time.RFC3339), fallback.Err))
+	}
+	sort.Strings(messages)
+	return "Parameters are stale: " + strings.Join(messages, "; ")
+}
+
+func (l *LastKnownGood) addFallback(appSet types.NamespacedName, fallback GeneratorFallback) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.fallbacks[appSet] = append(l.fallbacks[appSet], fallback)
+}
+
+// lastKnownGoodKey returns the cache key of the parameters of a generator of an ApplicationSet. It includes a hash of
+// the generator, so that the parameters are not used anymore once the generator is modified.
+func lastKnownGoodKey(appSet *argoprojiov1alpha1.ApplicationSet, name string, appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) (string, error) {
+	data, err := json.Marshal(appSetGenerator)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling generator: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return fmt.Sprintf("appset|generator|%s|%s|%s|%s", appSet.Namespace, appSet.Name, name, hex.EncodeToString(hash[:])), nil
+}
+
+// lastKnownGoodGenerator falls back to the last known good parameters of the generator it wraps when it fails
+type lastKnownGoodGenerator struct {
+	Generator
+	name          string
+	lastKnownGood *LastKnownGood
+}
+
+func (g *lastKnownGoodGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, appSet *argoprojiov1alpha1.ApplicationSet, client client.Client) ([]map[string]any, error) {
+	params, genErr := g.Generator.GenerateParams(appSetGenerator, appSet, client)
+	// Only transient failures fall back, the other ones are not going to go away until the generator, its credentials
+	// or the resources it reads are fixed
+	if genErr != nil && !isTransientError(genErr) {
+		return params, genErr
+	}
+
+	logCtx := log.WithField("applicationset", appSet.Name).WithField("generator", g.name)
+	key, err := lastKnownGoodKey(appSet, g.name, appSetGenerator)
+	if err != nil {
+		logCtx.WithError(err).Warn("unable to compute the last known good parameters key")
+		return params, genErr
+	}
+
+	if genErr == nil {
+		data, err := json.Marshal(params)
+		if err != nil {
+			logCtx.WithError(err).Warn("unable to marshal the last known good parameters")
+			return params, nil
+		}
+		item := &lastKnownGoodParams{Params: string(data), GeneratedAt: time.Now()}
+		if err := g.lastKnownGood.cache.SetItem(key, item, &cacheutil.CacheActionOpts{Expiration: g.lastKnownGood.duration}); err != nil {
+			logCtx.WithError(err).Warn("unable to store the last known good parameters")
+		}
+		return params, nil
+	}
+
+	item := &lastKnownGoodParams{}
+	if err := g.lastKnownGood.cache.GetItem(key, item); err != nil {
+		if !errors.Is(err, cacheutil.ErrCacheMiss) {
+			logCtx.WithError(err).Warn("unable to get the last known good parameters")
+		}
+		return nil, genErr
+	}
+	if time.Since(item.GeneratedAt) > g.lastKnownGood.duration {
+		return nil, genErr
+	}
+	var lastKnownGoodParams []map[string]any
+	if err := json.Unmarshal([]byte(item.Params), &lastKnownGoodParams); err != nil {
+		logCtx.WithError(err).Warn("unable to unmarshal the last known good parameters")
+		return nil, genErr
+	}
+
+	logCtx.WithError(genErr).Warnf("generator failed, using its last known good parameters generated at %s", item.GeneratedAt.UTC().Format(time.RFC3339))
+	g.lastKnownGood.addFallback(types.NamespacedName{Namespace: appSet.Namespace, Name: appSet.Name}, GeneratorFallback{
+		Generator:   g.name,
+		GeneratedAt: item.GeneratedAt,
+		Err:         genErr,
+	})
+	return lastKnownGoodParams, nil
+}
+
+// transientErrorRegexp matches the messages of the errors of the clients which do not return the status code of the
+// response in a typed error
+var transientErrorRegexp = regexp.MustCompile(`(?i)too many requests|rate limit|internal server error|bad gateway|service unavailable|gateway timeout|(status code|api error):? (429|5\d\d)\b|i/o timeout|tls handshake timeout|connection reset by peer|connection refused`)
+
+// isTransientError returns whether the error of a generator may go away when it is retried: network errors, timeouts,
+// and the responses of APIs which are rate limited or unavailable.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, os.ErrDeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary || dnsErr.IsTimeout
+	}
+
+	var githubRateLimitErr *github.RateLimitError
+	var githubAbuseRateLimitErr *github.AbuseRateLimitError
+	var awsErr awserr.Error
+	if errors.As(err, &githubRateLimitErr) || errors.As(err, &githubAbuseRateLimitErr) || (errors.As(err, &awsErr) && request.IsErrorThrottle(awsErr)) {
+		return true
+	}
+	if statusCode := httpStatusCode(err); statusCode != 0 {
+		return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+	}
+
+	// The errors of the repo server
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+			return true
+		}
+	}
+	return transientErrorRegexp.MatchString(err.Error())
+}
+
+// httpStatusCode returns the status code of the response of the SCM provider API which failed with the error, or 0 if
+// it is not known.
+func httpStatusCode(err error) int {
+	var githubErr *github.ErrorResponse
+	if errors.As(err, &githubErr) && githubErr.Response != nil {
+		return githubErr.Response.StatusCode
+	}
+	var gitlabErr *gitlab.ErrorResponse
+	if errors.As(err, &gitlabErr) && gitlabErr.Response != nil {
+		return gitlabErr.Response.StatusCode
+	}
+	var azureDevOpsErr azuredevops.WrappedError
+	if errors.As(err, &azureDevOpsErr) && azureDevOpsErr.StatusCode != nil {
+		return *azureDevOpsErr.StatusCode
+	}
+	// e.g. awserr.RequestFailure
+	var statusCodeErr interface{ StatusCode() int }
+	if errors.As(err, &statusCodeErr) {
+		return statusCodeErr.StatusCode()
+	}
+	return 0
+}
@@ -0,0 +1,178 @@
+package generators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v69/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+)
+
+func TestNewLastKnownGoodDisabled(t *testing.T) {
+	cache := cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour))
+	assert.Nil(t, NewLastKnownGood(cache, 0))
+	assert.Nil(t, NewLastKnownGood(nil, time.Hour))
+
+	var lastKnownGood *LastKnownGood
+	generators := map[string]Generator{"List": &generatorMock{}}
+	assert.Equal(t, generators, lastKnownGood.Wrap(generators))
+	assert.Empty(t, lastKnownGood.Fallbacks(types.NamespacedName{Name: "set"}))
+	lastKnownGood.ClearFallbacks(types.NamespacedName{Name: "set"})
+}
+
+func TestLastKnownGoodGenerateParams(t *testing.T) {
+	appSet := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "set",
+			Namespace: "argocd",
+		},
+	}
+	appSetKey := types.NamespacedName{Namespace: "argocd", Name: "set"}
+	appSetGenerator := &v1alpha1.ApplicationSetGenerator{
+		SCMProvider: &v1alpha1.SCMProviderGenerator{Github: &v1alpha1.SCMProviderGeneratorGithub{Organization: "argoproj"}},
+	}
+	params := []map[string]any{{"repository": "argo-cd", "labels": []any{"gitops"}}}
+	genErr := errors.New("rate limit exceeded")
+
+	newGenerator := func(t *testing.T) (*generatorMock, *LastKnownGood, Generator) {
+		t.Helper()
+		genMock := &generatorMock{}
+		lastKnownGood := NewLastKnownGood(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour)
+		require.NotNil(t, lastKnownGood)
+		return genMock, lastKnownGood, lastKnownGood.Wrap(map[string]Generator{"SCMProvider": genMock})["SCMProvider"]
+	}
+
+	t.Run("falls back to the last known good parameters", func(t *testing.T) {
+		genMock, lastKnownGood, generator := newGenerator(t)
+		genMock.On("GenerateParams", appSetGenerator, appSet).Return(params, nil).Once()
+		genMock.On("GenerateParams", appSetGenerator, appSet).Return([]map[string]any(nil), genErr).Once()
+
+		got, err := generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.NoError(t, err)
+		assert.Equal(t, params, got)
+		assert.Empty(t, lastKnownGood.Fallbacks(appSetKey))
+
+		got, err = generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.NoError(t, err)
+		assert.Equal(t, params, got)
+
+		fallbacks := lastKnownGood.Fallbacks(appSetKey)
+		require.Len(t, fallbacks, 1)
+		assert.Equal(t, "SCMProvider", fallbacks[0].Generator)
+		assert.Equal(t, genErr, fallbacks[0].Err)
+		assert.Contains(t, FallbacksMessage(fallbacks), "Parameters are stale: the SCMProvider generator failed, using its parameters generated at ")
+		assert.Contains(t, FallbacksMessage(fallbacks), ": rate limit exceeded")
+
+		lastKnownGood.ClearFallbacks(appSetKey)
+		assert.Empty(t, lastKnownGood.Fallbacks(appSetKey))
+		genMock.AssertExpectations(t)
+	})
+
+	t.Run("returns the error without last known good parameters", func(t *testing.T) {
+		genMock, lastKnownGood, generator := newGenerator(t)
+		genMock.On("GenerateParams", appSetGenerator, appSet).Return([]map[string]any(nil), genErr).Once()
+
+		_, err := generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.ErrorIs(t, err, genErr)
+		assert.Empty(t, lastKnownGood.Fallbacks(appSetKey))
+	})
+
+	t.Run("ignores the parameters of a modified generator", func(t *testing.T) {
+		genMock, lastKnownGood, generator := newGenerator(t)
+		modifiedGenerator := appSetGenerator.DeepCopy()
+		modifiedGenerator.SCMProvider.Github.Organization = "argoproj-labs"
+		genMock.On("GenerateParams", appSetGenerator, appSet).Return(params, nil).Once()
+		genMock.On("GenerateParams", modifiedGenerator, appSet).Return([]map[string]any(nil), genErr).Once()
+
+		_, err := generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.NoError(t, err)
+		_, err = generator.GenerateParams(modifiedGenerator, appSet, nil)
+		require.ErrorIs(t, err, genErr)
+		assert.Empty(t, lastKnownGood.Fallbacks(appSetKey))
+	})
+
+	t.Run("does not fall back for an empty generator", func(t *testing.T) {
+		genMock, lastKnownGood, generator := newGenerator(t)
+		genMock.On("GenerateParams", appSetGenerator, appSet).Return(params, nil).Once()
+		genMock.On("GenerateParams", appSetGenerator, appSet).Return([]map[string]any(nil), ErrEmptyAppSetGenerator).Once()
+
+		_, err := generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.NoError(t, err)
+		_, err = generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.ErrorIs(t, err, ErrEmptyAppSetGenerator)
+		assert.Empty(t, lastKnownGood.Fallbacks(appSetKey))
+	})
+
+	t.Run("does not fall back for a permanent error", func(t *testing.T) {
+		genMock, lastKnownGood, generator := newGenerator(t)
+		unauthorizedErr := fmt.Errorf("error listing repos: %w", &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusUnauthorized}, Message: "Bad credentials"})
+		genMock.On("GenerateParams", appSetGenerator, appSet).Return(params, nil).Once()
+		genMock.On("GenerateParams", appSetGenerator, appSet).Return([]map[string]any(nil), unauthorizedErr).Once()
+
+		_, err := generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.NoError(t, err)
+		_, err = generator.GenerateParams(appSetGenerator, appSet, nil)
+		require.ErrorIs(t, err, unauthorizedErr)
+		assert.Empty(t, lastKnownGood.Fallbacks(appSetKey))
+	})
+}
+
+func TestIsTransientError(t *testing.T) {
+	githubResponse := func(statusCode int) *http.Response {
+		return &http.Response{
+			StatusCode: statusCode,
+			Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{Scheme: "https", Host: "api.github.com", Path: "/orgs/argoproj/repos"}},
+		}
+	}
+	githubErr := func(statusCode int) error {
+		return &github.ErrorResponse{Response: githubResponse(statusCode), Message: http.StatusText(statusCode)}
+	}
+	for _, err := range []error{
+		fmt.Errorf("error listing repos: %w", &github.RateLimitError{Response: githubResponse(http.StatusForbidden), Message: "API rate limit exceeded"}),
+		fmt.Errorf("error listing repos: %w", githubErr(http.StatusTooManyRequests)),
+		fmt.Errorf("error listing repos: %w", githubErr(http.StatusBadGateway)),
+		fmt.Errorf("error listing repos: %w", context.DeadlineExceeded),
+		fmt.Errorf("error listing repos: %w", &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}),
+		fmt.Errorf("error listing repos: %w", &net.DNSError{Err: "server misbehaving", Name: "github.com", IsTemporary: true}),
+		fmt.Errorf("error listing repos: %w", &bitbucketStatusError{"503 Service Unavailable"}),
+		errors.New("error listing pull requests: API error with status code 500: "),
+		status.Error(codes.Unavailable, "connection error"),
+	} {
+		assert.True(t, isTransientError(err), err.Error())
+	}
+
+	for _, err := range []error{
+		ErrEmptyAppSetGenerator,
+		fmt.Errorf("error listing repos: %w", githubErr(http.StatusUnauthorized)),
+		fmt.Errorf("error listing repos: %w", githubErr(http.StatusNotFound)),
+		fmt.Errorf("error listing repos: %w", &net.DNSError{Err: "no such host", Name: "github.example.com", IsNotFound: true}),
+		errors.New("error parsing regexp: missing closing ): `(`"),
+		status.Error(codes.PermissionDenied, "permission denied"),
+	} {
+		assert.False(t, isTransientError(err), err.Error())
+	}
+}
+
+// bitbucketStatusError is an error which only reports the status of the response in its message
+type bitbucketStatusError struct {
+	status string
+}
+
+func (e *bitbucketStatusError) Error() string {
+	return e.status
+}
@@ -0,0 +1,314 @@
+package generators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/oci_registry"
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+)
+
+var _ Generator = (*OCIGenerator)(nil)
+
+const (
+	DefaultOCIRequeueAfter = 30 * time.Minute
+)
+
+// OCIConfig holds the settings of the OCI generators which are set on the ApplicationSet controller
+type OCIConfig struct {
+	// insecureRegistries are the registry hosts which may be accessed with insecure TLS or plain HTTP
+	insecureRegistries []string
+	cache              *oci_registry.Cache
+}
+
+func NewOCIConfig(insecureRegistries []string) OCIConfig {
+	return OCIConfig{
+		insecureRegistries: insecureRegistries,
+		cache:              oci_registry.NewCache(oci_registry.DefaultCacheTTL),
+	}
+}
+
+type OCIGenerator struct {
+	client             client.Client
+	tokenRefStrictMode bool
+	OCIConfig
+	// Testing hooks.
+	overrideService oci_registry.RegistryService
+}
+
+func NewOCIGenerator(client client.Client, tokenRefStrictMode bool, ociConfig OCIConfig) Generator {
+	return &OCIGenerator{
+		client:             client,
+		tokenRefStrictMode: tokenRefStrictMode,
+		OCIConfig:          ociConfig,
+	}
+}
+
+// Testing generator
+func NewTestOCIGenerator(overrideService oci_registry.RegistryService, ociConfig OCIConfig) Generator {
+	return &OCIGenerator{overrideService: overrideService, OCIConfig: ociConfig}
+}
+
+func (g *OCIGenerator) GetRequeueAfter(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) time.Duration {
+	// Return a requeue default of 30 minutes, if no default is specified.
+
+	if appSetGenerator.OCI.RequeueAfterSeconds != nil {
+		return time.Duration(*appSetGenerator.OCI.RequeueAfterSeconds) * time.Second
+	}
+
+	return DefaultOCIRequeueAfter
+}
+
+func (g *OCIGenerator) GetTemplate(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator) *argoprojiov1alpha1.ApplicationSetTemplate {
+	return &appSetGenerator.OCI.Template
+}
+
+func (g *OCIGenerator) GenerateParams(appSetGenerator *argoprojiov1alpha1.ApplicationSetGenerator, applicationSetInfo *argoprojiov1alpha1.ApplicationSet, _ client.Client) ([]map[string]any, error) {
+	if appSetGenerator == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	if appSetGenerator.OCI == nil {
+		return nil, ErrEmptyAppSetGenerator
+	}
+
+	ctx := context.Background()
+	providerConfig := appSetGenerator.OCI
+
+	host, path, err := parseOCIRegistryURL(providerConfig.RepoURL)
+	if err != nil {
+		return nil, err
+	}
+	filters, err := compileOCIFilters(providerConfig.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling filters: %w", err)
+	}
+	if (providerConfig.Insecure || providerConfig.PlainHTTP) && !g.insecureRegistryAllowed(host) {
+		return nil, fmt.Errorf("registry %s may not be accessed with insecure TLS or plain HTTP, it is not one of the insecure registries allowed by the ApplicationSet controller", host)
+	}
+
+	service := g.overrideService
+	if service == nil {
+		password := ""
+		username := ""
+		if providerConfig.BasicAuth != nil {
+			username = providerConfig.BasicAuth.Username
+			password, err = utils.GetSecretRef(ctx, g.client, providerConfig.BasicAuth.PasswordRef, applicationSetInfo.Namespace, g.tokenRefStrictMode)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching Secret password: %w", err)
+			}
+		}
+		service, err = oci_registry.NewOrasRegistryService(host, username, password, providerConfig.Insecure, providerConfig.PlainHTTP)
+		if err != nil {
+			return nil, fmt.Errorf("error initializing OCI registry service: %w", err)
+		}
+		if g.cache != nil {
+			service = g.cache.Wrap(service, oci_registry.CacheKey(host, username, password, providerConfig.Insecure, providerConfig.PlainHTTP))
+		}
+	}
+
+	var repositories []string
+	if len(providerConfig.Repositories) > 0 {
+		for _, name := range providerConfig.Repositories {
+			repositories = append(repositories, joinOCIPath(path, strings.Trim(name, "/")))
+		}
+	} else {
+		repositories, err = service.ListRepositories(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("error listing repositories: %w", err)
+		}
+	}
+	sort.Strings(repositories)
+
+	paramsArray := []map[string]any{}
+	for _, repository := range repositories {
+		name := strings.TrimPrefix(strings.TrimPrefix(repository, path), "/")
+		if !filters.matchRepository(name) {
+			continue
+		}
+
+		tags, err := service.ListTags(ctx, repository)
+		if err != nil {
+			return nil, fmt.Errorf("error listing tags: %w", err)
+		}
+		tags = filters.filterTags(name, tags)
+		if providerConfig.LatestOnly {
+			tags = latestOCITag(tags)
+		}
+
+		for _, tag := range tags {
+			manifest, err := service.GetManifest(ctx, repository, tag)
+			if err != nil {
+				return nil, fmt.Errorf("error getting manifest: %w", err)
+			}
+
+			params := map[string]any{
+				"registry":       host,
+				"repository":     repository,
+				"name":           name,
+				"nameNormalized": utils.SanitizeName(name),
+				"repoURL":        oci.Prefix + host + "/" + repository,
+				"tag":            tag,
+				"tagNormalized":  utils.SanitizeName(tag),
+				"digest":         manifest.Digest,
+			}
+			if applicationSetInfo.Spec.GoTemplate {
+				annotations := map[string]string{}
+				for key, value := range manifest.Annotations {
+					annotations[key] = value
+				}
+				params["annotations"] = annotations
+			} else {
+				for key, value := range manifest.Annotations {
+					params["annotations."+key] = value
+				}
+			}
+
+			err = appendTemplatedValues(providerConfig.Values, params, applicationSetInfo.Spec.GoTemplate, applicationSetInfo.Spec.GoTemplateOptions)
+			if err != nil {
+				return nil, fmt.Errorf("failed to append templated values: %w", err)
+			}
+
+			paramsArray = append(paramsArray, params)
+		}
+	}
+	return paramsArray, nil
+}
+
+// insecureRegistryAllowed returns whether a registry host may be accessed with insecure TLS or plain HTTP
+func (g *OCIGenerator) insecureRegistryAllowed(host string) bool {
+	for _, registry := range g.insecureRegistries {
+		if strings.EqualFold(registry, host) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseOCIRegistryURL splits a registry path, with or without the oci:// prefix, into the registry host and the path
+// of the repositories within the registry.
+func parseOCIRegistryURL(repoURL string) (string, string, error) {
+	host, path, _ := strings.Cut(strings.TrimPrefix(repoURL, oci.Prefix), "/")
+	if host == "" {
+		return "", "", fmt.Errorf("invalid OCI registry URL %q", repoURL)
+	}
+	return host, strings.Trim(path, "/"), nil
+}
+
+func joinOCIPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "/" + name
+}
+
+// ociFilter is a compiled version of OCIGeneratorFilter.
+type ociFilter struct {
+	repositoryMatch *regexp.Regexp
+	tagMatch        *regexp.Regexp
+	tagSemver       *semver.Constraints
+}
+
+type ociFilters []ociFilter
+
+func compileOCIFilters(filters []argoprojiov1alpha1.OCIGeneratorFilter) (ociFilters, error) {
+	compiled := make(ociFilters, 0, len(filters))
+	for _, filter := range filters {
+		outFilter := ociFilter{}
+		var err error
+		if filter.RepositoryMatch != nil {
+			outFilter.repositoryMatch, err = regexp.Compile(*filter.RepositoryMatch)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling RepositoryMatch regexp %q: %w", *filter.RepositoryMatch, err)
+			}
+		}
+		if filter.TagMatch != nil {
+			outFilter.tagMatch, err = regexp.Compile(*filter.TagMatch)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling TagMatch regexp %q: %w", *filter.TagMatch, err)
+			}
+		}
+		if filter.TagSemver != nil {
+			outFilter.tagSemver, err = semver.NewConstraint(*filter.TagSemver)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing TagSemver constraint %q: %w", *filter.TagSemver, err)
+			}
+		}
+		compiled = append(compiled, outFilter)
+	}
+	return compiled, nil
+}
+
+func (f ociFilter) matchTag(tag string) bool {
+	if f.tagMatch != nil && !f.tagMatch.MatchString(tag) {
+		return false
+	}
+	if f.tagSemver != nil {
+		version, err := semver.NewVersion(tag)
+		if err != nil || !f.tagSemver.Check(version) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchRepository returns whether the tags of a repository may pass any of the filters
+func (filters ociFilters) matchRepository(name string) bool {
+	if len(filters) == 0 {
+		return true
+	}
+	for _, filter := range filters {
+		if filter.repositoryMatch == nil || filter.repositoryMatch.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterTags returns the sorted tags of a repository which pass any of the filters
+func (filters ociFilters) filterTags(name string, tags []string) []string {
+	filtered := []string{}
+	for _, tag := range tags {
+		if len(filters) == 0 {
+			filtered = append(filtered, tag)
+			continue
+		}
+		for _, filter := range filters {
+			if (filter.repositoryMatch == nil || filter.repositoryMatch.MatchString(name)) && filter.matchTag(tag) {
+				filtered = append(filtered, tag)
+				break
+			}
+		}
+	}
+	sort.Strings(filtered)
+	return filtered
+}
+
+// latestOCITag returns the tag with the highest semantic version, ignoring the tags which are not semantic versions
+func latestOCITag(tags []string) []string {
+	var latestTag string
+	var latestVersion *semver.Version
+	for _, tag := range tags {
+		version, err := semver.NewVersion(tag)
+		if err != nil {
+			continue
+		}
+		if latestVersion == nil || version.GreaterThan(latestVersion) {
+			latestTag = tag
+			latestVersion = version
+		}
+	}
+	if latestVersion == nil {
+		return []string{}
+	}
+	return []string{latestTag}
+}
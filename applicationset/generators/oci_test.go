@@ -0,0 +1,225 @@
+package generators
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/oci_registry"
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestOCIGenerateParams(t *testing.T) {
+	mockService := &oci_registry.MockRegistryService{
+		Tags: map[string]map[string]*oci_registry.Manifest{
+			"myorg/charts/guestbook": {
+				"1.0.0":  {Digest: "sha256:a1", Annotations: map[string]string{"org.opencontainers.image.version": "1.0.0"}},
+				"1.1.0":  {Digest: "sha256:a2"},
+				"2.0.0":  {Digest: "sha256:a3"},
+				"latest": {Digest: "sha256:a3"},
+			},
+			"myorg/charts/helm-guestbook": {
+				"0.1.0": {Digest: "sha256:b1"},
+			},
+			"other/app": {
+				"1.0.0": {Digest: "sha256:c1"},
+			},
+		},
+	}
+
+	cases := []struct {
+		name          string
+		generator     argoprojiov1alpha1.OCIGenerator
+		goTemplate    bool
+		expected      []map[string]any
+		expectedError string
+	}{
+		{
+			name: "semver filter on a listed repository",
+			generator: argoprojiov1alpha1.OCIGenerator{
+				RepoURL:      "oci://ghcr.io/myorg/charts",
+				Repositories: []string{"guestbook"},
+				Filters:      []argoprojiov1alpha1.OCIGeneratorFilter{{TagSemver: ptr.To("^1.0.0")}},
+			},
+			expected: []map[string]any{
+				{
+					"registry":       "ghcr.io",
+					"repository":     "myorg/charts/guestbook",
+					"name":           "guestbook",
+					"nameNormalized": "guestbook",
+					"repoURL":        "oci://ghcr.io/myorg/charts/guestbook",
+					"tag":            "1.0.0",
+					"tagNormalized":  "1.0.0",
+					"digest":         "sha256:a1",
+					"annotations.org.opencontainers.image.version": "1.0.0",
+				},
+				{
+					"registry":       "ghcr.io",
+					"repository":     "myorg/charts/guestbook",
+					"name":           "guestbook",
+					"nameNormalized": "guestbook",
+					"repoURL":        "oci://ghcr.io/myorg/charts/guestbook",
+					"tag":            "1.1.0",
+					"tagNormalized":  "1.1.0",
+					"digest":         "sha256:a2",
+				},
+			},
+		},
+		{
+			name: "latest tag of each repository under the path",
+			generator: argoprojiov1alpha1.OCIGenerator{
+				RepoURL:    "ghcr.io/myorg/charts",
+				LatestOnly: true,
+				Values:     map[string]string{"release": "{{name}}-{{tag}}"},
+			},
+			expected: []map[string]any{
+				{
+					"registry":       "ghcr.io",
+					"repository":     "myorg/charts/guestbook",
+					"name":           "guestbook",
+					"nameNormalized": "guestbook",
+					"repoURL":        "oci://ghcr.io/myorg/charts/guestbook",
+					"tag":            "2.0.0",
+					"tagNormalized":  "2.0.0",
+					"digest":         "sha256:a3",
+					"values.release": "guestbook-2.0.0",
+				},
+				{
+					"registry":       "ghcr.io",
+					"repository":     "myorg/charts/helm-guestbook",
+					"name":           "helm-guestbook",
+					"nameNormalized": "helm-guestbook",
+					"repoURL":        "oci://ghcr.io/myorg/charts/helm-guestbook",
+					"tag":            "0.1.0",
+					"tagNormalized":  "0.1.0",
+					"digest":         "sha256:b1",
+					"values.release": "helm-guestbook-0.1.0",
+				},
+			},
+		},
+		{
+			name: "filters are OR'd",
+			generator: argoprojiov1alpha1.OCIGenerator{
+				RepoURL: "oci://ghcr.io",
+				Filters: []argoprojiov1alpha1.OCIGeneratorFilter{
+					{RepositoryMatch: ptr.To("^other/"), TagMatch: ptr.To(`^1\.`)},
+					{RepositoryMatch: ptr.To("guestbook$"), TagMatch: ptr.To("^latest$")},
+				},
+			},
+			goTemplate: true,
+			expected: []map[string]any{
+				{
+					"registry":       "ghcr.io",
+					"repository":     "myorg/charts/guestbook",
+					"name":           "myorg/charts/guestbook",
+					"nameNormalized": "myorg-charts-guestbook",
+					"repoURL":        "oci://ghcr.io/myorg/charts/guestbook",
+					"tag":            "latest",
+					"tagNormalized":  "latest",
+					"digest":         "sha256:a3",
+					"annotations":    map[string]string{},
+				},
+				{
+					"registry":       "ghcr.io",
+					"repository":     "other/app",
+					"name":           "other/app",
+					"nameNormalized": "other-app",
+					"repoURL":        "oci://ghcr.io/other/app",
+					"tag":            "1.0.0",
+					"tagNormalized":  "1.0.0",
+					"digest":         "sha256:c1",
+					"annotations":    map[string]string{},
+				},
+			},
+		},
+		{
+			name: "invalid filter",
+			generator: argoprojiov1alpha1.OCIGenerator{
+				RepoURL: "oci://ghcr.io",
+				Filters: []argoprojiov1alpha1.OCIGeneratorFilter{{TagSemver: ptr.To("not a constraint")}},
+			},
+			expectedError: "error compiling filters",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ociGenerator := NewTestOCIGenerator(mockService, OCIConfig{})
+			applicationSetInfo := argoprojiov1alpha1.ApplicationSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "set",
+				},
+				Spec: argoprojiov1alpha1.ApplicationSetSpec{
+					GoTemplate: testCase.goTemplate,
+					Generators: []argoprojiov1alpha1.ApplicationSetGenerator{{
+						OCI: &testCase.generator,
+					}},
+				},
+			}
+
+			got, err := ociGenerator.GenerateParams(&applicationSetInfo.Spec.Generators[0], &applicationSetInfo, nil)
+			if testCase.expectedError != "" {
+				require.ErrorContains(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, got)
+		})
+	}
+}
+
+func TestOCIGenerateParamsInsecureRegistries(t *testing.T) {
+	mockService := &oci_registry.MockRegistryService{
+		Tags: map[string]map[string]*oci_registry.Manifest{
+			"guestbook": {"1.0.0": {Digest: "sha256:a1"}},
+		},
+	}
+	ociConfig := NewOCIConfig([]string{"registry.example.com:5000"})
+
+	for _, testCase := range []struct {
+		name          string
+		generator     argoprojiov1alpha1.OCIGenerator
+		expectedError string
+	}{
+		{
+			name:      "insecure access to an allowed registry",
+			generator: argoprojiov1alpha1.OCIGenerator{RepoURL: "oci://registry.example.com:5000", Repositories: []string{"guestbook"}, Insecure: true},
+		},
+		{
+			name:      "plain HTTP access to an allowed registry",
+			generator: argoprojiov1alpha1.OCIGenerator{RepoURL: "oci://REGISTRY.example.com:5000", Repositories: []string{"guestbook"}, PlainHTTP: true},
+		},
+		{
+			name:      "secure access to any registry",
+			generator: argoprojiov1alpha1.OCIGenerator{RepoURL: "oci://ghcr.io", Repositories: []string{"guestbook"}},
+		},
+		{
+			name:          "insecure access to another registry",
+			generator:     argoprojiov1alpha1.OCIGenerator{RepoURL: "oci://ghcr.io", Repositories: []string{"guestbook"}, Insecure: true},
+			expectedError: "registry ghcr.io may not be accessed with insecure TLS or plain HTTP",
+		},
+		{
+			name:          "plain HTTP access to another port of the registry",
+			generator:     argoprojiov1alpha1.OCIGenerator{RepoURL: "oci://registry.example.com", Repositories: []string{"guestbook"}, PlainHTTP: true},
+			expectedError: "registry registry.example.com may not be accessed with insecure TLS or plain HTTP",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			ociGenerator := NewTestOCIGenerator(mockService, ociConfig)
+			appSetGenerator := &argoprojiov1alpha1.ApplicationSetGenerator{OCI: &testCase.generator}
+
+			got, err := ociGenerator.GenerateParams(appSetGenerator, &argoprojiov1alpha1.ApplicationSet{}, nil)
+			if testCase.expectedError != "" {
+				require.ErrorContains(t, err, testCase.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, got, 1)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package generators
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	argoprojiov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// resourceWatchKey identifies the resources watched on behalf of ApplicationSets
+type resourceWatchKey struct {
+	server        string
+	gvr           schema.GroupVersionResource
+	namespace     string
+	labelSelector string
+}
+
+type resourceWatch struct {
+	cancel context.CancelFunc
+	// appSets maps the ApplicationSets using the watch to whether they still used it in their last generation
+	appSets map[types.NamespacedName]bool
+}
+
+// ResourceWatcher watches the resources selected by the KubernetesResource generators, and emits an event for each
+// ApplicationSet using them whenever one of the resources changes.
+type ResourceWatcher struct {
+	ctx     context.Context
+	events  chan event.GenericEvent
+	lock    sync.Mutex
+	watches map[resourceWatchKey]*resourceWatch
+}
+
+func NewResourceWatcher(ctx context.Context) *ResourceWatcher {
+	return &ResourceWatcher{
+		ctx:     ctx,
+		events:  make(chan event.GenericEvent, 1024),
+		watches: map[resourceWatchKey]*resourceWatch{},
+	}
+}
+
+// Events returns the channel of the ApplicationSets to reconcile
+func (w *ResourceWatcher) Events() <-chan event.GenericEvent {
+	return w.events
+}
+
+// Watch starts watching the resources for the ApplicationSet, unless they are already watched.
+func (w *ResourceWatcher) Watch(appSet types.NamespacedName, key resourceWatchKey, dynClient dynamic.Interface) {
+	if w == nil {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if watch, ok := w.watches[key]; ok {
+		watch.appSets[appSet] = true
+		return
+	}
+
+	ctx, cancel := context.WithCancel(w.ctx)
+	w.watches[key] = &resourceWatch{cancel: cancel, appSets: map[types.NamespacedName]bool{appSet: true}}
+
+	informer := dynamicinformer.NewFilteredDynamicInformer(dynClient, key.gvr, key.namespace, 0, cache.Indexers{}, func(options *metav1.ListOptions) {
+		options.LabelSelector = key.labelSelector
+	}).Informer()
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(_ any, isInInitialList bool) {
+			// The resources listed when the watch starts were already used to generate the parameters
+			if !isInInitialList {
+				w.notify(key)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			oldResource, oldOk := oldObj.(*unstructured.Unstructured)
+			newResource, newOk := newObj.(*unstructured.Unstructured)
+			if oldOk && newOk && oldResource.GetResourceVersion() == newResource.GetResourceVersion() {
+				return
+			}
+			w.notify(key)
+		},
+		DeleteFunc: func(_ any) {
+			w.notify(key)
+		},
+	})
+	if err != nil {
+		log.WithError(err).WithField("resource", key.gvr.String()).Error("unable to watch resources")
+		cancel()
+		delete(w.watches, key)
+		return
+	}
+	log.WithFields(log.Fields{"resource": key.gvr.String(), "namespace": key.namespace, "server": key.server}).Info("watching resources")
+	go informer.Run(ctx.Done())
+}
+
+// MarkStale marks the watches of the ApplicationSet as stale, until they are used again by the ApplicationSet.
+func (w *ResourceWatcher) MarkStale(appSet types.NamespacedName) {
+	if w == nil {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for _, watch := range w.watches {
+		if _, ok := watch.appSets[appSet]; ok {
+			watch.appSets[appSet] = false
+		}
+	}
+}
+
+// RemoveStale stops watching the resources the ApplicationSet no longer uses. The watches no ApplicationSet uses
+// are stopped.
+func (w *ResourceWatcher) RemoveStale(appSet types.NamespacedName) {
+	if w == nil {
+		return
+	}
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for key, watch := range w.watches {
+		if used, ok := watch.appSets[appSet]; ok && !used {
+			delete(watch.appSets, appSet)
+		}
+		if len(watch.appSets) == 0 {
+			watch.cancel()
+			delete(w.watches, key)
+		}
+	}
+}
+
+// Forget stops watching the resources used by a deleted ApplicationSet
+func (w *ResourceWatcher) Forget(appSet types.NamespacedName) {
+	w.MarkStale(appSet)
+	w.RemoveStale(appSet)
+}
+
+func (w *ResourceWatcher) notify(key resourceWatchKey) {
+	w.lock.Lock()
+	appSets := []types.NamespacedName{}
+	if watch, ok := w.watches[key]; ok {
+		for appSet := range watch.appSets {
+			appSets = append(appSets, appSet)
+		}
+	}
+	w.lock.Unlock()
+
+	for _, appSet := range appSets {
+		select {
+		case w.events <- event.GenericEvent{Object: &argoprojiov1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Namespace: appSet.Namespace, Name: appSet.Name}}}:
+		case <-w.ctx.Done():
+			return
+		}
+	}
+}
@@ -10,15 +10,18 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 )
 
-func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, namespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig) map[string]Generator {
+func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.Interface, namespace string, argoCDService services.Repos, dynamicClient dynamic.Interface, scmConfig SCMConfig, ociConfig OCIConfig, kubernetesResourceConfig KubernetesResourceConfig, resourceWatcher *ResourceWatcher, clusterInfo ClusterInfoGetter) map[string]Generator {
 	terminalGenerators := map[string]Generator{
 		"List":                    NewListGenerator(),
-		"Clusters":                NewClusterGenerator(ctx, c, k8sClient, namespace),
+		"Clusters":                NewClusterGenerator(ctx, c, k8sClient, namespace, clusterInfo),
 		"Git":                     NewGitGenerator(argoCDService, namespace),
 		"SCMProvider":             NewSCMProviderGenerator(c, scmConfig),
 		"ClusterDecisionResource": NewDuckTypeGenerator(ctx, dynamicClient, k8sClient, namespace),
 		"PullRequest":             NewPullRequestGenerator(c, scmConfig),
 		"Plugin":                  NewPluginGenerator(ctx, c, k8sClient, namespace),
+		"OCI":                     NewOCIGenerator(c, scmConfig.tokenRefStrictMode, ociConfig),
+		"HelmRepository":          NewHelmRepositoryGenerator(argoCDService),
+		"KubernetesResource":      NewKubernetesResourceGenerator(ctx, dynamicClient, k8sClient, namespace, kubernetesResourceConfig, resourceWatcher),
 	}
 
 	nestedGenerators := map[string]Generator{
@@ -29,6 +32,9 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
+		"OCI":                     terminalGenerators["OCI"],
+		"HelmRepository":          terminalGenerators["HelmRepository"],
+		"KubernetesResource":      terminalGenerators["KubernetesResource"],
 		"Matrix":                  NewMatrixGenerator(terminalGenerators),
 		"Merge":                   NewMergeGenerator(terminalGenerators),
 	}
@@ -41,6 +47,9 @@ func GetGenerators(ctx context.Context, c client.Client, k8sClient kubernetes.In
 		"ClusterDecisionResource": terminalGenerators["ClusterDecisionResource"],
 		"PullRequest":             terminalGenerators["PullRequest"],
 		"Plugin":                  terminalGenerators["Plugin"],
+		"OCI":                     terminalGenerators["OCI"],
+		"HelmRepository":          terminalGenerators["HelmRepository"],
+		"KubernetesResource":      terminalGenerators["KubernetesResource"],
 		"Matrix":                  NewMatrixGenerator(nestedGenerators),
 		"Merge":                   NewMergeGenerator(nestedGenerators),
 	}
@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/argoproj/argo-cd/v3/util/helm"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -159,3 +160,61 @@ func (_c *Repos_GetFiles_Call) RunAndReturn(run func(ctx context.Context, repoUR
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetHelmIndex provides a mock function for the type Repos
+func (_mock *Repos) GetHelmIndex(ctx context.Context, repoURL string, project string) (*helm.Index, error) {
+	ret := _mock.Called(ctx, repoURL, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHelmIndex")
+	}
+
+	var r0 *helm.Index
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*helm.Index, error)); ok {
+		return returnFunc(ctx, repoURL, project)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *helm.Index); ok {
+		r0 = returnFunc(ctx, repoURL, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*helm.Index)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, repoURL, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Repos_GetHelmIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHelmIndex'
+type Repos_GetHelmIndex_Call struct {
+	*mock.Call
+}
+
+// GetHelmIndex is a helper method to define mock.On call
+//   - ctx
+//   - repoURL
+//   - project
+func (_e *Repos_Expecter) GetHelmIndex(ctx interface{}, repoURL interface{}, project interface{}) *Repos_GetHelmIndex_Call {
+	return &Repos_GetHelmIndex_Call{Call: _e.mock.On("GetHelmIndex", ctx, repoURL, project)}
+}
+
+func (_c *Repos_GetHelmIndex_Call) Run(run func(ctx context.Context, repoURL string, project string)) *Repos_GetHelmIndex_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Repos_GetHelmIndex_Call) Return(index *helm.Index, err error) *Repos_GetHelmIndex_Call {
+	_c.Call.Return(index, err)
+	return _c
+}
+
+func (_c *Repos_GetHelmIndex_Call) RunAndReturn(run func(ctx context.Context, repoURL string, project string) (*helm.Index, error)) *Repos_GetHelmIndex_Call {
+	_c.Call.Return(run)
+	return _c
+}
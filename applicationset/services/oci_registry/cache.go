@@ -0,0 +1,116 @@
+package oci_registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+)
+
+const (
+	// DefaultCacheTTL is the duration during which the repository and tag lists of a registry, and the digests
+	// referenced by its tags, are cached
+	DefaultCacheTTL = 3 * time.Minute
+	// manifestCacheTTL is the duration during which an unused manifest stays cached. The manifest referenced by a
+	// digest never changes.
+	manifestCacheTTL = 24 * time.Hour
+	// maxCachedManifests is the maximum number of manifests cached by digest
+	maxCachedManifests = 10000
+)
+
+// Cache holds the responses of OCI registries, shared by the OCI generators of all the ApplicationSets. The repository
+// and tag lists, and the digests referenced by the tags, are cached for a TTL, since they change as artifacts are
+// pushed. The manifests are cached by digest, so that only the tags whose digest changed are fetched again.
+type Cache struct {
+	lists     *gocache.Cache
+	manifests *gocache.Cache
+}
+
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{
+		lists:     gocache.New(ttl, ttl),
+		manifests: gocache.New(manifestCacheTTL, time.Hour),
+	}
+}
+
+// CacheKey returns the key identifying a registry accessed with the given settings in the cache. The responses of a
+// registry are not shared between credentials, which may grant access to different repositories.
+func CacheKey(host, username, password string, insecure, plainHTTP bool) string {
+	passwordHash := sha256.Sum256([]byte(password))
+	return fmt.Sprintf("%s|%s|%s|%t|%t", host, username, hex.EncodeToString(passwordHash[:]), insecure, plainHTTP)
+}
+
+// Wrap returns a registry service answering from the cache, and calling service when the cached responses expired.
+func (c *Cache) Wrap(service RegistryService, key string) RegistryService {
+	return &cachedRegistryService{service: service, cache: c, key: key}
+}
+
+type cachedRegistryService struct {
+	service RegistryService
+	cache   *Cache
+	key     string
+}
+
+var _ RegistryService = &cachedRegistryService{}
+
+func (s *cachedRegistryService) ListRepositories(ctx context.Context, path string) ([]string, error) {
+	key := s.key + "|repositories|" + path
+	if repos, ok := s.cache.lists.Get(key); ok {
+		return repos.([]string), nil
+	}
+	repos, err := s.service.ListRepositories(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.lists.SetDefault(key, repos)
+	return repos, nil
+}
+
+func (s *cachedRegistryService) ListTags(ctx context.Context, repository string) ([]string, error) {
+	key := s.key + "|tags|" + repository
+	if tags, ok := s.cache.lists.Get(key); ok {
+		return tags.([]string), nil
+	}
+	tags, err := s.service.ListTags(ctx, repository)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.lists.SetDefault(key, tags)
+	return tags, nil
+}
+
+func (s *cachedRegistryService) ResolveTag(ctx context.Context, repository string, tag string) (string, error) {
+	key := s.key + "|digest|" + repository + ":" + tag
+	if digest, ok := s.cache.lists.Get(key); ok {
+		return digest.(string), nil
+	}
+	digest, err := s.service.ResolveTag(ctx, repository, tag)
+	if err != nil {
+		return "", err
+	}
+	s.cache.lists.SetDefault(key, digest)
+	return digest, nil
+}
+
+// GetManifest resolves a tag to its digest, and only fetches the manifest if it is not cached under that digest.
+func (s *cachedRegistryService) GetManifest(ctx context.Context, repository string, reference string) (*Manifest, error) {
+	digest, err := s.ResolveTag(ctx, repository, reference)
+	if err != nil {
+		return nil, err
+	}
+	key := s.key + "|manifest|" + repository + "@" + digest
+	if manifest, ok := s.cache.manifests.Get(key); ok {
+		return manifest.(*Manifest), nil
+	}
+	manifest, err := s.service.GetManifest(ctx, repository, digest)
+	if err != nil {
+		return nil, err
+	}
+	if s.cache.manifests.ItemCount() < maxCachedManifests {
+		s.cache.manifests.SetDefault(key, manifest)
+	}
+	return manifest, nil
+}
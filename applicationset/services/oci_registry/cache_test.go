@@ -0,0 +1,96 @@
+package oci_registry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingRegistryService counts the calls made to a registry service
+type countingRegistryService struct {
+	RegistryService
+	calls map[string]int
+}
+
+func (s *countingRegistryService) ListRepositories(ctx context.Context, path string) ([]string, error) {
+	s.calls["ListRepositories"]++
+	return s.RegistryService.ListRepositories(ctx, path)
+}
+
+func (s *countingRegistryService) ListTags(ctx context.Context, repository string) ([]string, error) {
+	s.calls["ListTags"]++
+	return s.RegistryService.ListTags(ctx, repository)
+}
+
+func (s *countingRegistryService) ResolveTag(ctx context.Context, repository string, tag string) (string, error) {
+	s.calls["ResolveTag"]++
+	return s.RegistryService.ResolveTag(ctx, repository, tag)
+}
+
+func (s *countingRegistryService) GetManifest(ctx context.Context, repository string, reference string) (*Manifest, error) {
+	s.calls["GetManifest"]++
+	return s.RegistryService.GetManifest(ctx, repository, reference)
+}
+
+func TestCache(t *testing.T) {
+	mock := &MockRegistryService{
+		Tags: map[string]map[string]*Manifest{
+			"guestbook": {
+				"1.0.0":  {Digest: "sha256:a1", Annotations: map[string]string{"version": "1.0.0"}},
+				"latest": {Digest: "sha256:a1", Annotations: map[string]string{"version": "1.0.0"}},
+			},
+		},
+	}
+	service := &countingRegistryService{RegistryService: mock, calls: map[string]int{}}
+	cache := NewCache(time.Hour)
+	cached := cache.Wrap(service, CacheKey("ghcr.io", "user", "password", false, false))
+
+	for range 2 {
+		repos, err := cached.ListRepositories(t.Context(), "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"guestbook"}, repos)
+		tags, err := cached.ListTags(t.Context(), "guestbook")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"1.0.0", "latest"}, tags)
+		for _, tag := range tags {
+			manifest, err := cached.GetManifest(t.Context(), "guestbook", tag)
+			require.NoError(t, err)
+			assert.Equal(t, "sha256:a1", manifest.Digest)
+		}
+	}
+	// the tags are resolved once, and the manifest they both reference is fetched once
+	assert.Equal(t, map[string]int{"ListRepositories": 1, "ListTags": 1, "ResolveTag": 2, "GetManifest": 1}, service.calls)
+
+	t.Run("expired tag is resolved again", func(t *testing.T) {
+		cache.lists.Flush()
+		mock.Tags["guestbook"]["latest"] = &Manifest{Digest: "sha256:a2", Annotations: map[string]string{"version": "2.0.0"}}
+
+		manifest, err := cached.GetManifest(t.Context(), "guestbook", "latest")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"version": "2.0.0"}, manifest.Annotations)
+		manifest, err = cached.GetManifest(t.Context(), "guestbook", "1.0.0")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"version": "1.0.0"}, manifest.Annotations)
+		// only the manifest whose digest changed is fetched
+		assert.Equal(t, 4, service.calls["ResolveTag"])
+		assert.Equal(t, 2, service.calls["GetManifest"])
+	})
+
+	t.Run("responses are not shared between credentials", func(t *testing.T) {
+		other := cache.Wrap(service, CacheKey("ghcr.io", "user", "other-password", false, false))
+		_, err := other.ListTags(t.Context(), "guestbook")
+		require.NoError(t, err)
+		assert.Equal(t, 2, service.calls["ListTags"])
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		_, err := cached.GetManifest(t.Context(), "guestbook", "3.0.0")
+		require.Error(t, err)
+		_, err = cached.GetManifest(t.Context(), "guestbook", "3.0.0")
+		require.Error(t, err)
+		assert.Equal(t, 6, service.calls["ResolveTag"])
+	})
+}
@@ -0,0 +1,56 @@
+package oci_registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type MockRegistryService struct {
+	// Tags maps the repositories to their tags, and the tags to their manifest
+	Tags map[string]map[string]*Manifest
+}
+
+var _ RegistryService = &MockRegistryService{}
+
+func (m *MockRegistryService) ListRepositories(_ context.Context, path string) ([]string, error) {
+	prefix := strings.Trim(path, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	repos := []string{}
+	for repo := range m.Tags {
+		if strings.HasPrefix(repo, prefix) {
+			repos = append(repos, repo)
+		}
+	}
+	return repos, nil
+}
+
+func (m *MockRegistryService) ListTags(_ context.Context, repository string) ([]string, error) {
+	tags := []string{}
+	for tag := range m.Tags[repository] {
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+func (m *MockRegistryService) ResolveTag(_ context.Context, repository string, tag string) (string, error) {
+	manifest, ok := m.Tags[repository][tag]
+	if !ok {
+		return "", fmt.Errorf("tag %s of repository %s not found", tag, repository)
+	}
+	return manifest.Digest, nil
+}
+
+func (m *MockRegistryService) GetManifest(_ context.Context, repository string, reference string) (*Manifest, error) {
+	if manifest, ok := m.Tags[repository][reference]; ok {
+		return manifest, nil
+	}
+	for _, manifest := range m.Tags[repository] {
+		if manifest.Digest == reference {
+			return manifest, nil
+		}
+	}
+	return nil, fmt.Errorf("manifest %s of repository %s not found", reference, repository)
+}
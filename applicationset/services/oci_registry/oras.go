@@ -0,0 +1,123 @@
+package oci_registry
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+)
+
+// maxManifestSize is the maximum size of a manifest read to get its annotations
+const maxManifestSize = 4 * 1024 * 1024
+
+type OrasRegistryService struct {
+	registry *remote.Registry
+}
+
+var _ RegistryService = &OrasRegistryService{}
+
+func NewOrasRegistryService(host, username, password string, insecure, plainHTTP bool) (*OrasRegistryService, error) {
+	reg, err := remote.NewRegistry(host)
+	if err != nil {
+		return nil, fmt.Errorf("error creating a new registry client for %s: %w", host, err)
+	}
+
+	httpClient := &http.Client{}
+	if insecure {
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		tr.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		httpClient.Transport = tr
+	}
+	reg.PlainHTTP = plainHTTP
+	reg.Client = &auth.Client{
+		Client: httpClient,
+		Cache:  auth.NewCache(),
+		Credential: auth.StaticCredential(reg.Reference.Registry, auth.Credential{
+			Username: username,
+			Password: password,
+		}),
+	}
+	return &OrasRegistryService{registry: reg}, nil
+}
+
+func (o *OrasRegistryService) ListRepositories(ctx context.Context, path string) ([]string, error) {
+	prefix := strings.Trim(path, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	repos := []string{}
+	err := o.registry.Repositories(ctx, "", func(page []string) error {
+		for _, repo := range page {
+			if strings.HasPrefix(repo, prefix) {
+				repos = append(repos, repo)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing repositories of registry %s: %w", o.registry.Reference.Registry, err)
+	}
+	return repos, nil
+}
+
+func (o *OrasRegistryService) ListTags(ctx context.Context, repository string) ([]string, error) {
+	repo, err := o.registry.Repository(ctx, repository)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository %s: %w", repository, err)
+	}
+	tags := []string{}
+	err = repo.Tags(ctx, "", func(page []string) error {
+		tags = append(tags, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing tags of repository %s: %w", repository, err)
+	}
+	return tags, nil
+}
+
+func (o *OrasRegistryService) ResolveTag(ctx context.Context, repository string, tag string) (string, error) {
+	repo, err := o.registry.Repository(ctx, repository)
+	if err != nil {
+		return "", fmt.Errorf("error getting repository %s: %w", repository, err)
+	}
+	desc, err := repo.Resolve(ctx, tag)
+	if err != nil {
+		return "", fmt.Errorf("error resolving %s:%s: %w", repository, tag, err)
+	}
+	return desc.Digest.String(), nil
+}
+
+func (o *OrasRegistryService) GetManifest(ctx context.Context, repository string, reference string) (*Manifest, error) {
+	repo, err := o.registry.Repository(ctx, repository)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository %s: %w", repository, err)
+	}
+	desc, rc, err := repo.FetchReference(ctx, reference)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching manifest of %s:%s: %w", repository, reference, err)
+	}
+	defer rc.Close()
+
+	// image manifests and image indexes both hold their annotations in the same field
+	var manifest struct {
+		Annotations map[string]string `json:"annotations,omitempty"`
+	}
+	data, err := io.ReadAll(io.LimitReader(rc, maxManifestSize))
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest of %s:%s: %w", repository, reference, err)
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("error parsing manifest of %s:%s: %w", repository, reference, err)
+	}
+	return &Manifest{
+		Digest:      desc.Digest.String(),
+		Annotations: manifest.Annotations,
+	}, nil
+}
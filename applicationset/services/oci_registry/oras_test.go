@@ -0,0 +1,73 @@
+package oci_registry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrasRegistryService(t *testing.T) {
+	manifest := []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.manifest.v1+json","annotations":{"org.opencontainers.image.version":"1.0.0"}}`)
+	manifestDigest := digest.FromBytes(manifest)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v2/_catalog":
+			_ = json.NewEncoder(w).Encode(map[string]any{"repositories": []string{"myorg/charts/guestbook", "myorg/charts/helm-guestbook", "other/app"}})
+		case "/v2/myorg/charts/guestbook/tags/list":
+			_ = json.NewEncoder(w).Encode(map[string]any{"name": "myorg/charts/guestbook", "tags": []string{"1.0.0", "latest"}})
+		case "/v2/myorg/charts/guestbook/manifests/1.0.0", "/v2/myorg/charts/guestbook/manifests/" + manifestDigest.String():
+			w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+			w.Header().Set("Docker-Content-Digest", manifestDigest.String())
+			w.Header().Set("Content-Length", strconv.Itoa(len(manifest)))
+			if r.Method == http.MethodGet {
+				_, _ = w.Write(manifest)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	service, err := NewOrasRegistryService(strings.TrimPrefix(server.URL, "http://"), "", "", false, true)
+	require.NoError(t, err)
+
+	repos, err := service.ListRepositories(t.Context(), "myorg/charts")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"myorg/charts/guestbook", "myorg/charts/helm-guestbook"}, repos)
+
+	repos, err = service.ListRepositories(t.Context(), "")
+	require.NoError(t, err)
+	assert.Len(t, repos, 3)
+
+	tags, err := service.ListTags(t.Context(), "myorg/charts/guestbook")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.0.0", "latest"}, tags)
+
+	m, err := service.GetManifest(t.Context(), "myorg/charts/guestbook", "1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, manifestDigest.String(), m.Digest)
+	assert.Equal(t, map[string]string{"org.opencontainers.image.version": "1.0.0"}, m.Annotations)
+
+	resolved, err := service.ResolveTag(t.Context(), "myorg/charts/guestbook", "1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, manifestDigest.String(), resolved)
+
+	m, err = service.GetManifest(t.Context(), "myorg/charts/guestbook", manifestDigest.String())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"org.opencontainers.image.version": "1.0.0"}, m.Annotations)
+
+	_, err = service.ResolveTag(t.Context(), "myorg/charts/guestbook", "2.0.0")
+	require.Error(t, err)
+
+	_, err = service.GetManifest(t.Context(), "myorg/charts/guestbook", "2.0.0")
+	require.Error(t, err)
+}
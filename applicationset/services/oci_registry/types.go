@@ -0,0 +1,22 @@
+package oci_registry
+
+import "context"
+
+// An abstract tagged artifact of an OCI registry.
+type Manifest struct {
+	// Digest is the digest of the manifest referenced by the tag
+	Digest string
+	// Annotations are the annotations of the manifest
+	Annotations map[string]string
+}
+
+type RegistryService interface {
+	// ListRepositories returns the repositories of the registry under the given path
+	ListRepositories(ctx context.Context, path string) ([]string, error)
+	// ListTags returns the tags of a repository
+	ListTags(ctx context.Context, repository string) ([]string, error)
+	// ResolveTag returns the digest of the manifest referenced by a tag of a repository, without fetching the manifest
+	ResolveTag(ctx context.Context, repository string, tag string) (string, error)
+	// GetManifest returns the manifest referenced by a tag or a digest of a repository
+	GetManifest(ctx context.Context, repository string, reference string) (*Manifest, error)
+}
@@ -38,6 +38,7 @@ type AzureDevOpsService struct {
 
 var (
 	_ PullRequestService       = (*AzureDevOpsService)(nil)
+	_ PullRequestOpener        = (*AzureDevOpsService)(nil)
 	_ AzureDevOpsClientFactory = &devopsFactoryImpl{}
 )
 
@@ -109,6 +110,79 @@ func (a *AzureDevOpsService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+func (a *AzureDevOpsService) Open(ctx context.Context, branch, targetBranch, title, description string) (*PullRequest, error) {
+	client, err := a.clientFactory.GetClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Azure DevOps client: %w", err)
+	}
+
+	sourceRefName := "refs/heads/" + branch
+	targetRefName := "refs/heads/" + targetBranch
+	args := git.GetPullRequestsArgs{
+		RepositoryId: &a.repo,
+		Project:      &a.project,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{
+			SourceRefName: &sourceRefName,
+			TargetRefName: &targetRefName,
+			Status:        &git.PullRequestStatusValues.Active,
+		},
+	}
+	azurePullRequests, err := client.GetPullRequests(ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull requests: %w", err)
+	}
+	if azurePullRequests != nil && len(*azurePullRequests) > 0 {
+		return toAzureDevOpsPullRequest(&(*azurePullRequests)[0]), nil
+	}
+
+	createArgs := git.CreatePullRequestArgs{
+		GitPullRequestToCreate: &git.GitPullRequest{
+			SourceRefName: &sourceRefName,
+			TargetRefName: &targetRefName,
+			Title:         &title,
+			Description:   &description,
+		},
+		RepositoryId: &a.repo,
+		Project:      &a.project,
+	}
+	pr, err := client.CreatePullRequest(ctx, createArgs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+	return toAzureDevOpsPullRequest(pr), nil
+}
+
+func toAzureDevOpsPullRequest(pr *git.GitPullRequest) *PullRequest {
+	pullRequest := &PullRequest{
+		Labels: convertLabels(pr.Labels),
+	}
+	if pr.PullRequestId != nil {
+		pullRequest.Number = *pr.PullRequestId
+	}
+	if pr.Title != nil {
+		pullRequest.Title = *pr.Title
+	}
+	if pr.SourceRefName != nil {
+		pullRequest.Branch = strings.Replace(*pr.SourceRefName, "refs/heads/", "", 1)
+	}
+	if pr.TargetRefName != nil {
+		pullRequest.TargetBranch = strings.Replace(*pr.TargetRefName, "refs/heads/", "", 1)
+	}
+	if pr.LastMergeSourceCommit != nil && pr.LastMergeSourceCommit.CommitId != nil {
+		pullRequest.HeadSHA = *pr.LastMergeSourceCommit.CommitId
+	}
+	if pr.CreatedBy != nil && pr.CreatedBy.UniqueName != nil {
+		pullRequest.Author = strings.Split(*pr.CreatedBy.UniqueName, "@")[0]
+	}
+	// The URL of the API object is not browsable, build the web URL from the repository instead.
+	if pr.Repository != nil && pr.Repository.WebUrl != nil {
+		pullRequest.URL = fmt.Sprintf("%s/pullrequest/%d", *pr.Repository.WebUrl, pullRequest.Number)
+	} else if pr.Url != nil {
+		pullRequest.URL = *pr.Url
+	}
+	return pullRequest
+}
+
 // convertLabels converts WebApiTagDefinitions to strings
 func convertLabels(tags *[]core.WebApiTagDefinition) []string {
 	if tags == nil {
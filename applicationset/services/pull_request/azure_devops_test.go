@@ -2,6 +2,7 @@ package pull_request
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
@@ -113,6 +114,100 @@ func TestListPullRequest(t *testing.T) {
 	assert.Equal(t, uniqueName, list[0].Author)
 }
 
+func TestOpenPullRequest_AzureDevOps(t *testing.T) {
+	teamProject := "myorg_project"
+	repoName := "myorg_project_repo"
+	sourceRefName := "refs/heads/environment/dev-next"
+	targetRefName := "refs/heads/environment/dev"
+	webURL := "https://dev.azure.com/myorg/myorg_project/_git/myorg_project_repo"
+	ctx := t.Context()
+
+	existingPullRequest := git.GitPullRequest{
+		PullRequestId: createIntPtr(123),
+		Title:         createStringPtr("existing"),
+		SourceRefName: createStringPtr(sourceRefName),
+		TargetRefName: createStringPtr(targetRefName),
+		Repository: &git.GitRepository{
+			Name:   createStringPtr(repoName),
+			WebUrl: createStringPtr(webURL),
+		},
+	}
+	createdPullRequest := git.GitPullRequest{
+		PullRequestId: createIntPtr(124),
+		Title:         createStringPtr("title"),
+		SourceRefName: createStringPtr(sourceRefName),
+		TargetRefName: createStringPtr(targetRefName),
+		Repository: &git.GitRepository{
+			Name:   createStringPtr(repoName),
+			WebUrl: createStringPtr(webURL),
+		},
+	}
+
+	listArgs := git.GetPullRequestsArgs{
+		RepositoryId: &repoName,
+		Project:      &teamProject,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{
+			SourceRefName: &sourceRefName,
+			TargetRefName: &targetRefName,
+			Status:        &git.PullRequestStatusValues.Active,
+		},
+	}
+	title := "title"
+	description := "description"
+	createArgs := git.CreatePullRequestArgs{
+		GitPullRequestToCreate: &git.GitPullRequest{
+			SourceRefName: &sourceRefName,
+			TargetRefName: &targetRefName,
+			Title:         &title,
+			Description:   &description,
+		},
+		RepositoryId: &repoName,
+		Project:      &teamProject,
+	}
+
+	testCases := []struct {
+		name           string
+		existing       []git.GitPullRequest
+		expectedNumber int
+	}{
+		{
+			name:           "existing pull request",
+			existing:       []git.GitPullRequest{existingPullRequest},
+			expectedNumber: 123,
+		},
+		{
+			name:           "new pull request",
+			existing:       []git.GitPullRequest{},
+			expectedNumber: 124,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gitClientMock := azureMock.Client{}
+			clientFactoryMock := &AzureClientFactoryMock{mock: &mock.Mock{}}
+			clientFactoryMock.mock.On("GetClient", mock.Anything).Return(&gitClientMock, nil)
+			gitClientMock.On("GetPullRequests", ctx, listArgs).Return(&tc.existing, nil)
+			if len(tc.existing) == 0 {
+				gitClientMock.On("CreatePullRequest", ctx, createArgs).Return(&createdPullRequest, nil)
+			}
+
+			provider := AzureDevOpsService{
+				clientFactory: clientFactoryMock,
+				project:       teamProject,
+				repo:          repoName,
+			}
+
+			pr, err := provider.Open(ctx, "environment/dev-next", "environment/dev", title, description)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedNumber, pr.Number)
+			assert.Equal(t, "environment/dev-next", pr.Branch)
+			assert.Equal(t, "environment/dev", pr.TargetBranch)
+			assert.Equal(t, fmt.Sprintf("%s/pullrequest/%d", webURL, tc.expectedNumber), pr.URL)
+			gitClientMock.AssertExpectations(t)
+		})
+	}
+}
+
 func TestConvertLabes(t *testing.T) {
 	testCases := []struct {
 		name           string
@@ -22,6 +22,15 @@ type BitbucketCloudPullRequest struct {
 	Source      BitbucketCloudPullRequestSource      `json:"source"`
 	Author      BitbucketCloudPullRequestAuthor      `json:"author"`
 	Destination BitbucketCloudPullRequestDestination `json:"destination"`
+	Links       BitbucketCloudPullRequestLinks       `json:"links"`
+}
+
+type BitbucketCloudPullRequestLinks struct {
+	HTML BitbucketCloudPullRequestLink `json:"html"`
+}
+
+type BitbucketCloudPullRequestLink struct {
+	Href string `json:"href"`
 }
 
 type BitbucketCloudPullRequestDestination struct {
@@ -59,7 +68,10 @@ type PullRequestResponse struct {
 	Items    []PullRequest `json:"values"`
 }
 
-var _ PullRequestService = (*BitbucketCloudService)(nil)
+var (
+	_ PullRequestService = (*BitbucketCloudService)(nil)
+	_ PullRequestOpener  = (*BitbucketCloudService)(nil)
+)
 
 func parseURL(uri string) (*url.URL, error) {
 	if uri == "" {
@@ -156,3 +168,68 @@ func (b *BitbucketCloudService) List(_ context.Context) ([]*PullRequest, error)
 
 	return pullRequests, nil
 }
+
+func (b *BitbucketCloudService) Open(_ context.Context, branch, targetBranch, title, description string) (*PullRequest, error) {
+	opts := &bitbucket.PullRequestsOptions{
+		Owner:    b.owner,
+		RepoSlug: b.repositorySlug,
+	}
+
+	// Only open pull requests are returned by default. As in List, the client follows the next pages, so that the
+	// existing pull request is found among all the open ones.
+	response, err := b.client.Repositories.PullRequests.Gets(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests for %s/%s: %w", b.owner, b.repositorySlug, err)
+	}
+	resp, ok := response.(map[string]any)
+	if !ok {
+		return nil, errors.New("unknown type returned from bitbucket pull requests")
+	}
+	var pulls []BitbucketCloudPullRequest
+	if err := unmarshalBitbucketCloudResponse(resp["values"], &pulls); err != nil {
+		return nil, err
+	}
+	for _, pull := range pulls {
+		if pull.Source.Branch.Name == branch && pull.Destination.Branch.Name == targetBranch {
+			return toBitbucketCloudPullRequest(pull), nil
+		}
+	}
+
+	opts.Title = title
+	opts.Description = description
+	opts.SourceBranch = branch
+	opts.DestinationBranch = targetBranch
+	response, err = b.client.Repositories.PullRequests.Create(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error creating pull request for %s/%s: %w", b.owner, b.repositorySlug, err)
+	}
+	var pull BitbucketCloudPullRequest
+	if err := unmarshalBitbucketCloudResponse(response, &pull); err != nil {
+		return nil, err
+	}
+	return toBitbucketCloudPullRequest(pull), nil
+}
+
+// unmarshalBitbucketCloudResponse converts the untyped response of the Bitbucket client to out.
+func unmarshalBitbucketCloudResponse(response any, out any) error {
+	jsonStr, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("error marshalling response body to json: %w", err)
+	}
+	if err := json.Unmarshal(jsonStr, out); err != nil {
+		return fmt.Errorf("error unmarshalling json to type '%T': %w", out, err)
+	}
+	return nil
+}
+
+func toBitbucketCloudPullRequest(pull BitbucketCloudPullRequest) *PullRequest {
+	return &PullRequest{
+		Number:       pull.ID,
+		Title:        pull.Title,
+		Branch:       pull.Source.Branch.Name,
+		TargetBranch: pull.Destination.Branch.Name,
+		HeadSHA:      pull.Source.Commit.Hash,
+		Author:       pull.Author.Nickname,
+		URL:          pull.Links.HTML.Href,
+	}
+}
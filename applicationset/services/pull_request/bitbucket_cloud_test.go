@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -492,3 +493,120 @@ func TestListPullRequestBranchMatchCloud(t *testing.T) {
 		TargetBranch: "branch-200",
 	}, *pullRequests[0])
 }
+
+func TestOpenPullRequestCloud(t *testing.T) {
+	testCases := []struct {
+		name             string
+		listResponse     string
+		nextPageResponse string
+		expectedNumber   int
+	}{
+		{
+			name: "existing pull request",
+			listResponse: `{
+				"size": 1,
+				"pagelen": 10,
+				"page": 1,
+				"values": [
+					{
+						"id": 101,
+						"title": "existing",
+						"source": {"branch": {"name": "environment/dev-next"}},
+						"destination": {"branch": {"name": "environment/dev"}},
+						"links": {"html": {"href": "https://bitbucket.org/OWNER/REPO/pull-requests/101"}}
+					}
+				]
+			}`,
+			expectedNumber: 101,
+		},
+		{
+			name: "existing pull request on a later page",
+			listResponse: `{
+				"size": 2,
+				"pagelen": 1,
+				"page": 1,
+				"next": "http://HOST/repositories/OWNER/REPO/pullrequests/?pagelen=1&page=2",
+				"values": [
+					{
+						"id": 100,
+						"title": "other",
+						"source": {"branch": {"name": "feature"}},
+						"destination": {"branch": {"name": "main"}},
+						"links": {"html": {"href": "https://bitbucket.org/OWNER/REPO/pull-requests/100"}}
+					}
+				]
+			}`,
+			nextPageResponse: `{
+				"size": 2,
+				"pagelen": 1,
+				"page": 2,
+				"values": [
+					{
+						"id": 101,
+						"title": "existing",
+						"source": {"branch": {"name": "environment/dev-next"}},
+						"destination": {"branch": {"name": "environment/dev"}},
+						"links": {"html": {"href": "https://bitbucket.org/OWNER/REPO/pull-requests/101"}}
+					}
+				]
+			}`,
+			expectedNumber: 101,
+		},
+		{
+			name: "new pull request",
+			listResponse: `{
+				"size": 0,
+				"pagelen": 10,
+				"page": 1,
+				"values": []
+			}`,
+			expectedNumber: 102,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer TOKEN", r.Header.Get("Authorization"))
+				assert.Equal(t, "/repositories/OWNER/REPO/pullrequests/", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodGet:
+					response := tc.listResponse
+					if r.URL.Query().Get("page") == "2" {
+						response = tc.nextPageResponse
+					}
+					_, err := io.WriteString(w, strings.ReplaceAll(response, "HOST", r.Host))
+					require.NoError(t, err)
+				case http.MethodPost:
+					body, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					assert.Contains(t, string(body), `"environment/dev-next"`)
+					assert.Contains(t, string(body), `"environment/dev"`)
+					w.WriteHeader(http.StatusCreated)
+					_, err = io.WriteString(w, `{
+						"id": 102,
+						"title": "title",
+						"source": {"branch": {"name": "environment/dev-next"}},
+						"destination": {"branch": {"name": "environment/dev"}},
+						"links": {"html": {"href": "https://bitbucket.org/OWNER/REPO/pull-requests/102"}}
+					}`)
+					require.NoError(t, err)
+				default:
+					t.Errorf("unexpected method %s", r.Method)
+				}
+			}))
+			defer ts.Close()
+			svc, err := NewBitbucketCloudServiceBearerToken(ts.URL, "TOKEN", "OWNER", "REPO")
+			require.NoError(t, err)
+			opener, ok := svc.(PullRequestOpener)
+			require.True(t, ok)
+
+			pr, err := opener.Open(t.Context(), "environment/dev-next", "environment/dev", "title", "description")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedNumber, pr.Number)
+			assert.Equal(t, "environment/dev-next", pr.Branch)
+			assert.Equal(t, "environment/dev", pr.TargetBranch)
+			assert.Equal(t, fmt.Sprintf("https://bitbucket.org/OWNER/REPO/pull-requests/%d", tc.expectedNumber), pr.URL)
+		})
+	}
+}
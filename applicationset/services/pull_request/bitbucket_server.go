@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	bitbucketv1 "github.com/gfleury/go-bitbucket-v1"
 	log "github.com/sirupsen/logrus"
@@ -13,13 +14,17 @@ import (
 
 type BitbucketService struct {
 	client         *bitbucketv1.APIClient
+	baseURL        string
 	projectKey     string
 	repositorySlug string
 	// Not supported for PRs by Bitbucket Server
 	// labels         []string
 }
 
-var _ PullRequestService = (*BitbucketService)(nil)
+var (
+	_ PullRequestService = (*BitbucketService)(nil)
+	_ PullRequestOpener  = (*BitbucketService)(nil)
+)
 
 func NewBitbucketServiceBasicAuth(ctx context.Context, username, password, url, projectKey, repositorySlug string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	bitbucketConfig := bitbucketv1.NewConfiguration(url)
@@ -58,6 +63,7 @@ func newBitbucketService(ctx context.Context, bitbucketConfig *bitbucketv1.Confi
 
 	return &BitbucketService{
 		client:         bitbucketClient,
+		baseURL:        strings.TrimSuffix(bitbucketConfig.BasePath, "/rest"),
 		projectKey:     projectKey,
 		repositorySlug: repositorySlug,
 	}, nil
@@ -100,3 +106,69 @@ func (b *BitbucketService) List(_ context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+func (b *BitbucketService) Open(_ context.Context, branch, targetBranch, title, description string) (*PullRequest, error) {
+	paged := map[string]any{
+		"limit":     100,
+		"at":        "refs/heads/" + branch,
+		"direction": "OUTGOING",
+		"state":     "OPEN",
+	}
+	for {
+		response, err := b.client.DefaultApi.GetPullRequestsPage(b.projectKey, b.repositorySlug, paged)
+		if err != nil {
+			return nil, fmt.Errorf("error listing pull requests for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+		}
+		pulls, err := bitbucketv1.GetPullRequestsResponse(response)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+		}
+		for _, pull := range pulls {
+			if pull.FromRef.DisplayID == branch && pull.ToRef.DisplayID == targetBranch {
+				return b.toPullRequest(pull), nil
+			}
+		}
+		hasNextPage, nextPageStart := bitbucketv1.HasNextPage(response)
+		if !hasNextPage {
+			break
+		}
+		paged["start"] = nextPageStart
+	}
+
+	repository := bitbucketv1.Repository{
+		Slug:    b.repositorySlug,
+		Project: &bitbucketv1.Project{Key: b.projectKey},
+	}
+	response, err := b.client.DefaultApi.CreatePullRequest(b.projectKey, b.repositorySlug, bitbucketv1.PullRequest{
+		Title:       title,
+		Description: description,
+		State:       "OPEN",
+		Open:        true,
+		FromRef:     bitbucketv1.PullRequestRef{ID: "refs/heads/" + branch, Repository: repository},
+		ToRef:       bitbucketv1.PullRequestRef{ID: "refs/heads/" + targetBranch, Repository: repository},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating pull request for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	pull, err := bitbucketv1.GetPullRequestResponse(response)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing pull request response for %s/%s: %w", b.projectKey, b.repositorySlug, err)
+	}
+	return b.toPullRequest(pull), nil
+}
+
+func (b *BitbucketService) toPullRequest(pull bitbucketv1.PullRequest) *PullRequest {
+	pullRequest := &PullRequest{
+		Number:       pull.ID,
+		Title:        pull.Title,
+		Branch:       pull.FromRef.DisplayID,
+		TargetBranch: pull.ToRef.DisplayID,
+		HeadSHA:      pull.FromRef.LatestCommit,
+		Labels:       []string{},
+		URL:          fmt.Sprintf("%s/projects/%s/repos/%s/pull-requests/%d", b.baseURL, b.projectKey, b.repositorySlug, pull.ID),
+	}
+	if pull.Author != nil {
+		pullRequest.Author = pull.Author.User.Name
+	}
+	return pullRequest
+}
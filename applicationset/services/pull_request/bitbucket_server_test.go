@@ -3,6 +3,7 @@ package pull_request
 import (
 	"crypto/x509"
 	"encoding/pem"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -510,3 +511,92 @@ func TestListPullRequestBranchMatch(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+func TestOpenPullRequest(t *testing.T) {
+	testCases := []struct {
+		name           string
+		listResponse   string
+		expectedNumber int
+	}{
+		{
+			name: "existing pull request",
+			listResponse: `{
+				"size": 1,
+				"limit": 100,
+				"isLastPage": true,
+				"values": [
+					{
+						"id": 101,
+						"title": "existing",
+						"toRef": {"displayId": "environment/dev", "id": "refs/heads/environment/dev"},
+						"fromRef": {"displayId": "environment/dev-next", "id": "refs/heads/environment/dev-next"}
+					}
+				],
+				"start": 0
+			}`,
+			expectedNumber: 101,
+		},
+		{
+			name: "new pull request",
+			listResponse: `{
+				"size": 1,
+				"limit": 100,
+				"isLastPage": true,
+				"values": [
+					{
+						"id": 101,
+						"title": "other target branch",
+						"toRef": {"displayId": "master", "id": "refs/heads/master"},
+						"fromRef": {"displayId": "environment/dev-next", "id": "refs/heads/environment/dev-next"}
+					}
+				],
+				"start": 0
+			}`,
+			expectedNumber: 102,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer tolkien", r.Header.Get("Authorization"))
+				assert.Equal(t, "/rest/api/1.0/projects/PROJECT/repos/REPO/pull-requests", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodGet:
+					assert.Equal(t, "refs/heads/environment/dev-next", r.URL.Query().Get("at"))
+					assert.Equal(t, "OUTGOING", r.URL.Query().Get("direction"))
+					assert.Equal(t, "OPEN", r.URL.Query().Get("state"))
+					_, err := io.WriteString(w, tc.listResponse)
+					require.NoError(t, err)
+				case http.MethodPost:
+					body, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					assert.Contains(t, string(body), `"refs/heads/environment/dev-next"`)
+					assert.Contains(t, string(body), `"refs/heads/environment/dev"`)
+					w.WriteHeader(http.StatusCreated)
+					_, err = io.WriteString(w, `{
+						"id": 102,
+						"title": "title",
+						"toRef": {"displayId": "environment/dev", "id": "refs/heads/environment/dev"},
+						"fromRef": {"displayId": "environment/dev-next", "id": "refs/heads/environment/dev-next"}
+					}`)
+					require.NoError(t, err)
+				default:
+					t.Errorf("unexpected method %s", r.Method)
+				}
+			}))
+			defer ts.Close()
+			svc, err := NewBitbucketServiceBearerToken(t.Context(), "tolkien", ts.URL, "PROJECT", "REPO", "", false, nil)
+			require.NoError(t, err)
+			opener, ok := svc.(PullRequestOpener)
+			require.True(t, ok)
+
+			pr, err := opener.Open(t.Context(), "environment/dev-next", "environment/dev", "title", "description")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedNumber, pr.Number)
+			assert.Equal(t, "environment/dev-next", pr.Branch)
+			assert.Equal(t, "environment/dev", pr.TargetBranch)
+			assert.Equal(t, fmt.Sprintf("%s/projects/PROJECT/repos/REPO/pull-requests/%d", ts.URL, tc.expectedNumber), pr.URL)
+		})
+	}
+}
@@ -17,7 +17,10 @@ type GiteaService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GiteaService)(nil)
+var (
+	_ PullRequestService = (*GiteaService)(nil)
+	_ PullRequestOpener  = (*GiteaService)(nil)
+)
 
 func NewGiteaService(token, url, owner, repo string, labels []string, insecure bool) (PullRequestService, error) {
 	if token == "" {
@@ -74,6 +77,58 @@ func (g *GiteaService) List(ctx context.Context) ([]*PullRequest, error) {
 	return list, nil
 }
 
+func (g *GiteaService) Open(ctx context.Context, branch, targetBranch, title, description string) (*PullRequest, error) {
+	opts := gitea.ListPullRequestsOptions{
+		State: gitea.StateOpen,
+	}
+	g.client.SetContext(ctx)
+	for {
+		prs, resp, err := g.client.ListRepoPullRequests(g.owner, g.repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, pr := range prs {
+			if pr.Head != nil && pr.Head.Ref == branch && pr.Base != nil && pr.Base.Ref == targetBranch {
+				return toGiteaPullRequest(pr), nil
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	pr, _, err := g.client.CreatePullRequest(g.owner, g.repo, gitea.CreatePullRequestOption{
+		Head:  branch,
+		Base:  targetBranch,
+		Title: title,
+		Body:  description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toGiteaPullRequest(pr), nil
+}
+
+func toGiteaPullRequest(pr *gitea.PullRequest) *PullRequest {
+	pullRequest := &PullRequest{
+		Number: int(pr.Index),
+		Title:  pr.Title,
+		Labels: getGiteaPRLabelNames(pr.Labels),
+		URL:    pr.HTMLURL,
+	}
+	if pr.Head != nil {
+		pullRequest.Branch = pr.Head.Ref
+		pullRequest.HeadSHA = pr.Head.Sha
+	}
+	if pr.Base != nil {
+		pullRequest.TargetBranch = pr.Base.Ref
+	}
+	if pr.Poster != nil {
+		pullRequest.Author = pr.Poster.UserName
+	}
+	return pullRequest
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func giteaContainLabels(expectedLabels []string, gotLabels []*gitea.Label) bool {
 	gotLabelNamesMap := make(map[string]bool)
@@ -1,6 +1,7 @@
 package pull_request
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -311,6 +312,85 @@ func TestGiteaList(t *testing.T) {
 	assert.Equal(t, "graytshirt", prs[0].Author)
 }
 
+func TestGiteaOpen(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.RequestURI == "/api/v1/repos/test-argocd/pr-test/pulls" {
+			var opts gitea.CreatePullRequestOption
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&opts))
+			assert.Equal(t, "environment/dev-next", opts.Head)
+			assert.Equal(t, "environment/dev", opts.Base)
+			assert.Equal(t, "title", opts.Title)
+			assert.Equal(t, "description", opts.Body)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, err := io.WriteString(w, `{
+				"number": 2,
+				"title": "title",
+				"html_url": "https://gitea.com/test-argocd/pr-test/pulls/2",
+				"head": {"ref": "environment/dev-next", "sha": "abc123"},
+				"base": {"ref": "environment/dev"}
+			}`)
+			require.NoError(t, err)
+			return
+		}
+		giteaMockHandler(t)(w, r)
+	}))
+	defer ts.Close()
+	host, err := NewGiteaService("", ts.URL, "test-argocd", "pr-test", nil, false)
+	require.NoError(t, err)
+	opener, ok := host.(PullRequestOpener)
+	require.True(t, ok)
+
+	t.Run("existing pull request", func(t *testing.T) {
+		pr, err := opener.Open(t.Context(), "test", "main", "title", "description")
+		require.NoError(t, err)
+		assert.Equal(t, 1, pr.Number)
+		assert.Equal(t, "https://gitea.com/test-argocd/pr-test/pulls/1", pr.URL)
+	})
+
+	t.Run("new pull request", func(t *testing.T) {
+		pr, err := opener.Open(t.Context(), "environment/dev-next", "environment/dev", "title", "description")
+		require.NoError(t, err)
+		assert.Equal(t, 2, pr.Number)
+		assert.Equal(t, "environment/dev-next", pr.Branch)
+		assert.Equal(t, "environment/dev", pr.TargetBranch)
+		assert.Equal(t, "https://gitea.com/test-argocd/pr-test/pulls/2", pr.URL)
+	})
+
+	t.Run("existing pull request on a later page", func(t *testing.T) {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodPost:
+				t.Errorf("unexpected creation of a pull request")
+			case r.RequestURI == "/api/v1/repos/test-argocd/pr-test/pulls?limit=0&page=1&state=open":
+				w.Header().Set("Link", `<https://gitea.com/api/v1/repos/test-argocd/pr-test/pulls?page=2&state=open>; rel="next"`)
+			case r.RequestURI == "/api/v1/repos/test-argocd/pr-test/pulls?limit=0&page=2&state=open":
+				w.Header().Set("Content-Type", "application/json")
+				_, err := io.WriteString(w, `[{
+					"number": 3,
+					"title": "title",
+					"html_url": "https://gitea.com/test-argocd/pr-test/pulls/3",
+					"head": {"ref": "environment/dev-next", "sha": "abc123"},
+					"base": {"ref": "environment/dev"}
+				}]`)
+				require.NoError(t, err)
+				return
+			}
+			giteaMockHandler(t)(w, r)
+		}))
+		defer ts.Close()
+		host, err := NewGiteaService("", ts.URL, "test-argocd", "pr-test", nil, false)
+		require.NoError(t, err)
+		opener, ok := host.(PullRequestOpener)
+		require.True(t, ok)
+
+		pr, err := opener.Open(t.Context(), "environment/dev-next", "environment/dev", "title", "description")
+		require.NoError(t, err)
+		assert.Equal(t, 3, pr.Number)
+		assert.Equal(t, "https://gitea.com/test-argocd/pr-test/pulls/3", pr.URL)
+	})
+}
+
 func TestGetGiteaPRLabelNames(t *testing.T) {
 	Tests := []struct {
 		Name           string
@@ -16,7 +16,10 @@ type GithubService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GithubService)(nil)
+var (
+	_ PullRequestService = (*GithubService)(nil)
+	_ PullRequestOpener  = (*GithubService)(nil)
+)
 
 func NewGithubService(token, url, owner, repo string, labels []string) (PullRequestService, error) {
 	// Undocumented environment variable to set a default token, to be used in testing to dodge anonymous rate limits.
@@ -37,6 +40,9 @@ func NewGithubService(token, url, owner, repo string, labels []string) (PullRequ
 		if err != nil {
 			return nil, err
 		}
+		if token != "" {
+			client = client.WithAuthToken(token)
+		}
 	}
 	return &GithubService{
 		client: client,
@@ -80,6 +86,44 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+func (g *GithubService) Open(ctx context.Context, branch, targetBranch, title, description string) (*PullRequest, error) {
+	opts := &github.PullRequestListOptions{
+		State: "open",
+		Head:  g.owner + ":" + branch,
+		Base:  targetBranch,
+	}
+	pulls, _, err := g.client.PullRequests.List(ctx, g.owner, g.repo, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests for %s/%s: %w", g.owner, g.repo, err)
+	}
+	if len(pulls) > 0 {
+		return toGithubPullRequest(pulls[0]), nil
+	}
+	pull, _, err := g.client.PullRequests.Create(ctx, g.owner, g.repo, &github.NewPullRequest{
+		Title: &title,
+		Head:  &branch,
+		Base:  &targetBranch,
+		Body:  &description,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+	}
+	return toGithubPullRequest(pull), nil
+}
+
+func toGithubPullRequest(pull *github.PullRequest) *PullRequest {
+	return &PullRequest{
+		Number:       pull.GetNumber(),
+		Title:        pull.GetTitle(),
+		Branch:       pull.GetHead().GetRef(),
+		TargetBranch: pull.GetBase().GetRef(),
+		HeadSHA:      pull.GetHead().GetSHA(),
+		Labels:       getGithubPRLabelNames(pull.Labels),
+		Author:       pull.GetUser().GetLogin(),
+		URL:          pull.GetHTMLURL(),
+	}
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
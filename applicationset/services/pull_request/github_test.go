@@ -1,9 +1,14 @@
 package pull_request
 
 import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-github/v69/github"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -86,3 +91,81 @@ func TestGetGitHubPRLabelNames(t *testing.T) {
 		})
 	}
 }
+
+func TestGithubOpen(t *testing.T) {
+	existing := `[{
+		"number": 1,
+		"title": "existing",
+		"html_url": "https://github.example.com/argoproj/argo-cd/pull/1",
+		"head": {"ref": "environment/dev-next", "sha": "abc123"},
+		"base": {"ref": "environment/dev"}
+	}]`
+
+	testCases := []struct {
+		name           string
+		listResponse   string
+		expectedNumber int
+		expectedURL    string
+	}{
+		{
+			name:           "existing pull request",
+			listResponse:   existing,
+			expectedNumber: 1,
+			expectedURL:    "https://github.example.com/argoproj/argo-cd/pull/1",
+		},
+		{
+			name:           "new pull request",
+			listResponse:   `[]`,
+			expectedNumber: 2,
+			expectedURL:    "https://github.example.com/argoproj/argo-cd/pull/2",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v3/repos/argoproj/argo-cd/pulls", r.URL.Path)
+				assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodGet:
+					assert.Equal(t, "argoproj:environment/dev-next", r.URL.Query().Get("head"))
+					assert.Equal(t, "environment/dev", r.URL.Query().Get("base"))
+					assert.Equal(t, "open", r.URL.Query().Get("state"))
+					_, err := io.WriteString(w, tc.listResponse)
+					require.NoError(t, err)
+				case http.MethodPost:
+					var pull github.NewPullRequest
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&pull))
+					assert.Equal(t, "environment/dev-next", pull.GetHead())
+					assert.Equal(t, "environment/dev", pull.GetBase())
+					assert.Equal(t, "title", pull.GetTitle())
+					assert.Equal(t, "description", pull.GetBody())
+					w.WriteHeader(http.StatusCreated)
+					_, err := io.WriteString(w, `{
+						"number": 2,
+						"title": "title",
+						"html_url": "https://github.example.com/argoproj/argo-cd/pull/2",
+						"head": {"ref": "environment/dev-next", "sha": "abc123"},
+						"base": {"ref": "environment/dev"}
+					}`)
+					require.NoError(t, err)
+				default:
+					t.Errorf("unexpected method %s", r.Method)
+				}
+			}))
+			defer ts.Close()
+
+			svc, err := NewGithubService("token", ts.URL, "argoproj", "argo-cd", nil)
+			require.NoError(t, err)
+			opener, ok := svc.(PullRequestOpener)
+			require.True(t, ok)
+
+			pr, err := opener.Open(t.Context(), "environment/dev-next", "environment/dev", "title", "description")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedNumber, pr.Number)
+			assert.Equal(t, "environment/dev-next", pr.Branch)
+			assert.Equal(t, "environment/dev", pr.TargetBranch)
+			assert.Equal(t, tc.expectedURL, pr.URL)
+		})
+	}
+}
@@ -19,7 +19,10 @@ type GitLabService struct {
 	pullRequestState string
 }
 
-var _ PullRequestService = (*GitLabService)(nil)
+var (
+	_ PullRequestService = (*GitLabService)(nil)
+	_ PullRequestOpener  = (*GitLabService)(nil)
+)
 
 func NewGitLabService(token, url, project string, labels []string, pullRequestState string, scmRootCAPath string, insecure bool, caCerts []byte) (PullRequestService, error) {
 	var clientOptionFns []gitlab.ClientOptionFunc
@@ -96,3 +99,46 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+func (g *GitLabService) Open(ctx context.Context, branch, targetBranch, title, description string) (*PullRequest, error) {
+	state := "opened"
+	opts := &gitlab.ListProjectMergeRequestsOptions{
+		State:        &state,
+		SourceBranch: &branch,
+		TargetBranch: &targetBranch,
+	}
+	mrs, _, err := g.client.MergeRequests.ListProjectMergeRequests(g.project, opts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error listing merge requests for project '%s': %w", g.project, err)
+	}
+	if len(mrs) > 0 {
+		return toGitLabPullRequest(mrs[0]), nil
+	}
+	createOpts := &gitlab.CreateMergeRequestOptions{
+		Title:        &title,
+		Description:  &description,
+		SourceBranch: &branch,
+		TargetBranch: &targetBranch,
+	}
+	mr, _, err := g.client.MergeRequests.CreateMergeRequest(g.project, createOpts, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error creating merge request for project '%s': %w", g.project, err)
+	}
+	return toGitLabPullRequest(&mr.BasicMergeRequest), nil
+}
+
+func toGitLabPullRequest(mr *gitlab.BasicMergeRequest) *PullRequest {
+	pullRequest := &PullRequest{
+		Number:       mr.IID,
+		Title:        mr.Title,
+		Branch:       mr.SourceBranch,
+		TargetBranch: mr.TargetBranch,
+		HeadSHA:      mr.SHA,
+		Labels:       mr.Labels,
+		URL:          mr.WebURL,
+	}
+	if mr.Author != nil {
+		pullRequest.Author = mr.Author.Username
+	}
+	return pullRequest
+}
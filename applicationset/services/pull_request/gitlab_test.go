@@ -2,6 +2,7 @@ package pull_request
 
 import (
 	"crypto/x509"
+	"encoding/json"
 	"encoding/pem"
 	"io"
 	"net/http"
@@ -191,3 +192,80 @@ func TestListWithStateTLS(t *testing.T) {
 		})
 	}
 }
+
+func TestGitLabOpen(t *testing.T) {
+	testCases := []struct {
+		name           string
+		listResponse   string
+		expectedNumber int
+		expectedURL    string
+	}{
+		{
+			name: "existing merge request",
+			listResponse: `[{
+				"iid": 1,
+				"title": "existing",
+				"source_branch": "environment/dev-next",
+				"target_branch": "environment/dev",
+				"web_url": "https://gitlab.example.com/group/project/-/merge_requests/1"
+			}]`,
+			expectedNumber: 1,
+			expectedURL:    "https://gitlab.example.com/group/project/-/merge_requests/1",
+		},
+		{
+			name:           "new merge request",
+			listResponse:   `[]`,
+			expectedNumber: 2,
+			expectedURL:    "https://gitlab.example.com/group/project/-/merge_requests/2",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			mux.HandleFunc("/api/v4/projects/278964/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodGet:
+					assert.Equal(t, "environment/dev-next", r.URL.Query().Get("source_branch"))
+					assert.Equal(t, "environment/dev", r.URL.Query().Get("target_branch"))
+					assert.Equal(t, "opened", r.URL.Query().Get("state"))
+					_, err := io.WriteString(w, tc.listResponse)
+					require.NoError(t, err)
+				case http.MethodPost:
+					var body map[string]string
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					assert.Equal(t, "environment/dev-next", body["source_branch"])
+					assert.Equal(t, "environment/dev", body["target_branch"])
+					assert.Equal(t, "title", body["title"])
+					assert.Equal(t, "description", body["description"])
+					w.WriteHeader(http.StatusCreated)
+					_, err := io.WriteString(w, `{
+						"iid": 2,
+						"title": "title",
+						"source_branch": "environment/dev-next",
+						"target_branch": "environment/dev",
+						"web_url": "https://gitlab.example.com/group/project/-/merge_requests/2"
+					}`)
+					require.NoError(t, err)
+				default:
+					t.Errorf("unexpected method %s", r.Method)
+				}
+			})
+
+			svc, err := NewGitLabService("token-123", server.URL, "278964", nil, "", "", false, nil)
+			require.NoError(t, err)
+			opener, ok := svc.(PullRequestOpener)
+			require.True(t, ok)
+
+			pr, err := opener.Open(t.Context(), "environment/dev-next", "environment/dev", "title", "description")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedNumber, pr.Number)
+			assert.Equal(t, "environment/dev-next", pr.Branch)
+			assert.Equal(t, "environment/dev", pr.TargetBranch)
+			assert.Equal(t, tc.expectedURL, pr.URL)
+		})
+	}
+}
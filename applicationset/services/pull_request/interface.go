@@ -20,6 +20,8 @@ type PullRequest struct {
 	Labels []string
 	// Author is the author of the pull request.
 	Author string
+	// URL is the web URL of the pull request. It is only set for pull requests returned by PullRequestOpener.
+	URL string
 }
 
 type PullRequestService interface {
@@ -27,6 +29,13 @@ type PullRequestService interface {
 	List(ctx context.Context) ([]*PullRequest, error)
 }
 
+// PullRequestOpener is implemented by the services which can open pull requests.
+type PullRequestOpener interface {
+	// Open opens a pull request from branch into targetBranch. If a pull request between these branches is already
+	// open, it is returned as is, since pushing to branch already updates it.
+	Open(ctx context.Context, branch, targetBranch, title, description string) (*PullRequest, error)
+}
+
 type Filter struct {
 	BranchMatch       *regexp.Regexp
 	TargetBranchMatch *regexp.Regexp
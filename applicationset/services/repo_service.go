@@ -2,20 +2,42 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/helm"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
 
+const (
+	// maxHelmIndexSize is the size limit of the Helm repository indexes downloaded by the ApplicationSet controller,
+	// which keeps them in memory
+	maxHelmIndexSize int64 = 100 * 1024 * 1024
+	// helmIndexCacheTTL is the duration during which the index of a Helm repository is cached
+	helmIndexCacheTTL = 3 * time.Minute
+	// maxCachedHelmIndexes is the maximum number of cached Helm repository indexes
+	maxCachedHelmIndexes = 100
+)
+
+// helmIndexes caches the parsed Helm repository indexes, so that the generators reading the same repository do not
+// download and parse its index on every reconciliation
+var helmIndexes = gocache.New(helmIndexCacheTTL, helmIndexCacheTTL)
+
 type argoCDService struct {
 	getRepository                   func(ctx context.Context, url, project string) (*v1alpha1.Repository, error)
 	submoduleEnabled                bool
 	newFileGlobbingEnabled          bool
 	getGitFilesFromRepoServer       func(ctx context.Context, req *apiclient.GitFilesRequest) (*apiclient.GitFilesResponse, error)
 	getGitDirectoriesFromRepoServer func(ctx context.Context, req *apiclient.GitDirectoriesRequest) (*apiclient.GitDirectoriesResponse, error)
+	getHelmIndex                    func(repo *v1alpha1.Repository) (*helm.Index, error)
+	helmIndexCache                  *gocache.Cache
 }
 
 type Repos interface {
@@ -24,6 +46,9 @@ type Repos interface {
 
 	// GetDirectories returns a list of directories (not files) within the target repo
 	GetDirectories(ctx context.Context, repoURL, revision, project string, noRevisionCache, verifyCommit bool) ([]string, error)
+
+	// GetHelmIndex returns the index of the target Helm repository
+	GetHelmIndex(ctx context.Context, repoURL, project string) (*helm.Index, error)
 }
 
 func NewArgoCDService(db db.ArgoDB, submoduleEnabled bool, repoClientset apiclient.Clientset, newFileGlobbingEnabled bool) Repos {
@@ -47,6 +72,10 @@ func NewArgoCDService(db db.ArgoDB, submoduleEnabled bool, repoClientset apiclie
 			defer utilio.Close(closer)
 			return client.GetGitDirectories(ctx, dirRequest)
 		},
+		getHelmIndex: func(repo *v1alpha1.Repository) (*helm.Index, error) {
+			return helm.NewClient(repo.Repo, repo.GetHelmCreds(), false, repo.Proxy, repo.NoProxy).GetIndex(true, maxHelmIndexSize)
+		},
+		helmIndexCache: helmIndexes,
 	}
 }
 
@@ -92,3 +121,37 @@ func (a *argoCDService) GetDirectories(ctx context.Context, repoURL, revision, p
 	}
 	return dirResponse.GetPaths(), nil
 }
+
+func (a *argoCDService) GetHelmIndex(ctx context.Context, repoURL, project string) (*helm.Index, error) {
+	repo, err := a.getRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error in GetRepository: %w", err)
+	}
+
+	cacheKey := helmIndexCacheKey(repo)
+	if a.helmIndexCache != nil {
+		if index, ok := a.helmIndexCache.Get(cacheKey); ok {
+			return index.(*helm.Index), nil
+		}
+	}
+
+	index, err := a.getHelmIndex(repo)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Helm index: %w", err)
+	}
+	if a.helmIndexCache != nil && a.helmIndexCache.ItemCount() < maxCachedHelmIndexes {
+		a.helmIndexCache.SetDefault(cacheKey, index)
+	}
+	return index, nil
+}
+
+// helmIndexCacheKey returns the key of the index of a Helm repository in the cache. The indexes are not shared between
+// credentials, which may grant access to different charts.
+func helmIndexCacheKey(repo *v1alpha1.Repository) string {
+	hash := sha256.New()
+	for _, value := range []string{repo.Username, repo.Password, repo.TLSClientCertData, repo.TLSClientCertKey, repo.Proxy, repo.NoProxy} {
+		_, _ = fmt.Fprintf(hash, "%d:%s", len(value), value)
+	}
+	_, _ = fmt.Fprintf(hash, "%t:%t", repo.Insecure, repo.UseAzureWorkloadIdentity)
+	return repo.Repo + "|" + hex.EncodeToString(hash.Sum(nil))
+}
@@ -5,13 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	gocache "github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	repo_mocks "github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/helm"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -168,6 +172,84 @@ func TestGetFiles(t *testing.T) {
 	}
 }
 
+func TestGetHelmIndex(t *testing.T) {
+	index := &helm.Index{Entries: map[string]helm.Entries{"guestbook": {{Version: "1.0.0"}}}}
+	tests := []struct {
+		name          string
+		getRepository func(ctx context.Context, url, project string) (*v1alpha1.Repository, error)
+		getHelmIndex  func(repo *v1alpha1.Repository) (*helm.Index, error)
+		want          *helm.Index
+		wantErr       assert.ErrorAssertionFunc
+	}{
+		{name: "ErrorGettingRepos", getRepository: func(_ context.Context, _, _ string) (*v1alpha1.Repository, error) {
+			return nil, errors.New("unable to get repos")
+		}, want: nil, wantErr: assert.Error},
+		{name: "ErrorGettingIndex", getRepository: func(_ context.Context, _, _ string) (*v1alpha1.Repository, error) {
+			return &v1alpha1.Repository{}, nil
+		}, getHelmIndex: func(_ *v1alpha1.Repository) (*helm.Index, error) {
+			return nil, errors.New("unable to get index")
+		}, want: nil, wantErr: assert.Error},
+		{name: "HappyCase", getRepository: func(_ context.Context, url, project string) (*v1alpha1.Repository, error) {
+			return &v1alpha1.Repository{Repo: url, Project: project, Username: "user"}, nil
+		}, getHelmIndex: func(repo *v1alpha1.Repository) (*helm.Index, error) {
+			if repo.Repo != "https://charts.example.com" || repo.Project != "my-project" || repo.Username != "user" {
+				return nil, fmt.Errorf("unexpected repository %v", repo)
+			}
+			return index, nil
+		}, want: index, wantErr: assert.NoError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &argoCDService{
+				getRepository: tt.getRepository,
+				getHelmIndex:  tt.getHelmIndex,
+			}
+			got, err := a.GetHelmIndex(t.Context(), "https://charts.example.com", "my-project")
+			if !tt.wantErr(t, err) {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetHelmIndexCache(t *testing.T) {
+	calls := 0
+	a := &argoCDService{
+		getRepository: func(_ context.Context, url, project string) (*v1alpha1.Repository, error) {
+			return &v1alpha1.Repository{Repo: url, Username: project}, nil
+		},
+		getHelmIndex: func(repo *v1alpha1.Repository) (*helm.Index, error) {
+			calls++
+			if repo.Repo == "https://broken.example.com" {
+				return nil, errors.New("unable to get index")
+			}
+			return &helm.Index{Entries: map[string]helm.Entries{repo.Username: {{Version: "1.0.0"}}}}, nil
+		},
+		helmIndexCache: gocache.New(time.Hour, time.Hour),
+	}
+
+	first, err := a.GetHelmIndex(t.Context(), "https://charts.example.com", "team-a")
+	require.NoError(t, err)
+	second, err := a.GetHelmIndex(t.Context(), "https://charts.example.com", "team-a")
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, calls)
+
+	// The index is not shared with the repositories configured with other credentials
+	other, err := a.GetHelmIndex(t.Context(), "https://charts.example.com", "team-b")
+	require.NoError(t, err)
+	assert.Contains(t, other.Entries, "team-b")
+	assert.Equal(t, 2, calls)
+
+	// Errors are not cached
+	for range 2 {
+		_, err = a.GetHelmIndex(t.Context(), "https://broken.example.com", "team-a")
+		require.Error(t, err)
+	}
+	assert.Equal(t, 4, calls)
+}
+
 func TestNewArgoCDService(t *testing.T) {
 	testNamespace := "test"
 	clientset := fake.NewClientset()
@@ -58,3 +58,28 @@ func ListClusters(ctx context.Context, clientset kubernetes.Interface, namespace
 	}
 	return clusterList, nil
 }
+
+// GetCluster returns the cluster registered in Argo CD with the given name or server URL. The in-cluster cluster is
+// returned even if it has no cluster secret, with no credentials.
+func GetCluster(ctx context.Context, clientset kubernetes.Interface, namespace string, nameOrServer string) (*appv1.Cluster, error) {
+	clusterSecretsList, err := clientset.CoreV1().Secrets(namespace).List(ctx,
+		metav1.ListOptions{LabelSelector: common.LabelKeySecretType + "=" + common.LabelValueSecretTypeCluster})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range clusterSecretsList.Items {
+		cluster, err := db.SecretToCluster(&clusterSecretsList.Items[i])
+		if err != nil || cluster == nil {
+			return nil, fmt.Errorf("unable to convert cluster secret to cluster object '%s': %w", clusterSecretsList.Items[i].Name, err)
+		}
+		if cluster.Name == nameOrServer || cluster.Server == nameOrServer {
+			return cluster, nil
+		}
+	}
+
+	if nameOrServer == "in-cluster" || nameOrServer == appv1.KubernetesInternalAPIServerAddr {
+		return &appv1.Cluster{Name: "in-cluster", Server: appv1.KubernetesInternalAPIServerAddr}, nil
+	}
+	return nil, fmt.Errorf("cluster %q not found", nameOrServer)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	stderrors "errors"
 	"fmt"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -24,6 +25,32 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
 )
 
+// applicationEquality compares Applications, ignoring unimportant differences
+var applicationEquality = conversion.EqualitiesOrDie(
+	func(a, b resource.Quantity) bool {
+		// Ignore formatting, only care that numeric value stayed the same.
+		// TODO: if we decide it's important, it should be safe to start comparing the format.
+		//
+		// Uninitialized quantities are equivalent to 0 quantities.
+		return a.Cmp(b) == 0
+	},
+	func(a, b metav1.MicroTime) bool {
+		return a.UTC().Equal(b.UTC())
+	},
+	func(a, b metav1.Time) bool {
+		return a.UTC().Equal(b.UTC())
+	},
+	func(a, b labels.Selector) bool {
+		return a.String() == b.String()
+	},
+	func(a, b fields.Selector) bool {
+		return a.String() == b.String()
+	},
+	func(a, b argov1alpha1.ApplicationDestination) bool {
+		return a.Namespace == b.Namespace && a.Name == b.Name && a.Server == b.Server
+	},
+)
+
 // CreateOrUpdate overrides "sigs.k8s.io/controller-runtime" function
 // in sigs.k8s.io/controller-runtime/pkg/controller/controllerutil/controllerutil.go
 // to add equality for argov1alpha1.ApplicationDestination
@@ -59,43 +86,11 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 		return controllerutil.OperationResultNone, err
 	}
 
-	// Apply ignoreApplicationDifferences rules to remove ignored fields from both the live and the desired state. This
-	// prevents those differences from appearing in the diff and therefore in the patch.
-	err := applyIgnoreDifferences(ignoreAppDifferences, normalizedLive, obj, ignoreNormalizerOpts)
+	changed, err := NormalizeAndCompare(ignoreAppDifferences, ignoreNormalizerOpts, normalizedLive, obj)
 	if err != nil {
-		return controllerutil.OperationResultNone, fmt.Errorf("failed to apply ignore differences: %w", err)
+		return controllerutil.OperationResultNone, err
 	}
-
-	// Normalize to avoid diffing on unimportant differences.
-	normalizedLive.Spec = *argo.NormalizeApplicationSpec(&normalizedLive.Spec)
-	obj.Spec = *argo.NormalizeApplicationSpec(&obj.Spec)
-
-	equality := conversion.EqualitiesOrDie(
-		func(a, b resource.Quantity) bool {
-			// Ignore formatting, only care that numeric value stayed the same.
-			// TODO: if we decide it's important, it should be safe to start comparing the format.
-			//
-			// Uninitialized quantities are equivalent to 0 quantities.
-			return a.Cmp(b) == 0
-		},
-		func(a, b metav1.MicroTime) bool {
-			return a.UTC().Equal(b.UTC())
-		},
-		func(a, b metav1.Time) bool {
-			return a.UTC().Equal(b.UTC())
-		},
-		func(a, b labels.Selector) bool {
-			return a.String() == b.String()
-		},
-		func(a, b fields.Selector) bool {
-			return a.String() == b.String()
-		},
-		func(a, b argov1alpha1.ApplicationDestination) bool {
-			return a.Namespace == b.Namespace && a.Name == b.Name && a.Server == b.Server
-		},
-	)
-
-	if equality.DeepEqual(normalizedLive, obj) {
+	if !changed {
 		return controllerutil.OperationResultNone, nil
 	}
 
@@ -109,6 +104,96 @@ func CreateOrUpdate(ctx context.Context, logCtx *log.Entry, c client.Client, ign
 	return controllerutil.OperationResultUpdated, nil
 }
 
+// NormalizeAndCompare prepares the live and desired Applications the way CreateOrUpdate compares them, and returns
+// whether they differ. The ignoreApplicationDifferences rules are applied to both Applications and their specs are
+// normalized, in place.
+func NormalizeAndCompare(ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts, live *argov1alpha1.Application, desired *argov1alpha1.Application) (bool, error) {
+	// Apply ignoreApplicationDifferences rules to remove ignored fields from both the live and the desired state. This
+	// prevents those differences from appearing in the diff and therefore in the patch.
+	err := applyIgnoreDifferences(ignoreAppDifferences, live, desired, ignoreNormalizerOpts)
+	if err != nil {
+		return false, fmt.Errorf("failed to apply ignore differences: %w", err)
+	}
+
+	// Normalize to avoid diffing on unimportant differences.
+	live.Spec = *argo.NormalizeApplicationSpec(&live.Spec)
+	desired.Spec = *argo.NormalizeApplicationSpec(&desired.Spec)
+
+	return !applicationEquality.DeepEqual(live, desired), nil
+}
+
+// DefaultPreservedAnnotations are the annotations of the live Applications which are always preserved: the state of
+// the notifications controller and the refresh requests.
+var DefaultPreservedAnnotations = []string{
+	"notified.notifications.argoproj.io",
+	argov1alpha1.AnnotationKeyRefresh,
+}
+
+// MergeGeneratedApplication copies the fields the ApplicationSet controller manages from the generated Application to
+// the live one. The given annotations and labels of the live Application are preserved, as well as its post-delete
+// finalizers.
+func MergeGeneratedApplication(found *argov1alpha1.Application, generatedApp argov1alpha1.Application, preservedAnnotations []string, preservedLabels []string) {
+	// Copy only the Application/ObjectMeta fields that are significant, from the generatedApp
+	found.Spec = generatedApp.Spec
+
+	// allow setting the Operation field to trigger a sync operation on an Application
+	if generatedApp.Operation != nil {
+		found.Operation = generatedApp.Operation
+	}
+
+	for _, key := range preservedAnnotations {
+		if state, exists := found.Annotations[key]; exists {
+			if generatedApp.Annotations == nil {
+				generatedApp.Annotations = map[string]string{}
+			}
+			generatedApp.Annotations[key] = state
+		}
+	}
+
+	for _, key := range preservedLabels {
+		if state, exists := found.Labels[key]; exists {
+			if generatedApp.Labels == nil {
+				generatedApp.Labels = map[string]string{}
+			}
+			generatedApp.Labels[key] = state
+		}
+	}
+
+	// Preserve post-delete finalizers:
+	//   https://github.com/argoproj/argo-cd/issues/17181
+	for _, finalizer := range found.Finalizers {
+		if strings.HasPrefix(finalizer, argov1alpha1.PostDeleteFinalizerName) {
+			if generatedApp.Finalizers == nil {
+				generatedApp.Finalizers = []string{}
+			}
+			generatedApp.Finalizers = append(generatedApp.Finalizers, finalizer)
+		}
+	}
+
+	found.Annotations = generatedApp.Annotations
+
+	found.Finalizers = generatedApp.Finalizers
+	found.Labels = generatedApp.Labels
+}
+
+// ApplicationSpecChanged returns whether the spec of the generated Application differs from the spec of the live
+// Application, once the ignoreApplicationDifferences rules are applied to both. It is the spec change CreateOrUpdate
+// would apply.
+func ApplicationSpecChanged(ignoreAppDifferences argov1alpha1.ApplicationSetIgnoreDifferences, ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts, live *argov1alpha1.Application, generatedApp *argov1alpha1.Application) (bool, error) {
+	normalizedLive := live.DeepCopy()
+	desired := live.DeepCopy()
+	desired.Spec = generatedApp.Spec
+
+	err := applyIgnoreDifferences(ignoreAppDifferences, normalizedLive, desired, ignoreNormalizerOpts)
+	if err != nil {
+		return false, fmt.Errorf("failed to apply ignore differences: %w", err)
+	}
+
+	normalizedLive.Spec = *argo.NormalizeApplicationSpec(&normalizedLive.Spec)
+	desired.Spec = *argo.NormalizeApplicationSpec(&desired.Spec)
+	return !applicationEquality.DeepEqual(normalizedLive.Spec, desired.Spec), nil
+}
+
 func LogPatch(logCtx *log.Entry, patch client.Patch, obj *argov1alpha1.Application) {
 	patchBytes, err := patch.Data(obj)
 	if err != nil {
@@ -233,3 +233,62 @@ spec:
 		})
 	}
 }
+
+func TestApplicationSpecChanged(t *testing.T) {
+	app := func(targetRevision string, labels map[string]string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: v1alpha1.ApplicationSchemaGroupVersionKind.GroupVersion().String(),
+				Kind:       v1alpha1.ApplicationSchemaGroupVersionKind.Kind,
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Labels: labels},
+			Spec: v1alpha1.ApplicationSpec{
+				Source: &v1alpha1.ApplicationSource{RepoURL: "https://git.example.com/test-org/test-repo", TargetRevision: targetRevision},
+			},
+		}
+	}
+	testCases := []struct {
+		name              string
+		ignoreDifferences v1alpha1.ApplicationSetIgnoreDifferences
+		liveApp           *v1alpha1.Application
+		generatedApp      *v1alpha1.Application
+		expectedChanged   bool
+	}{
+		{
+			name:            "same spec",
+			liveApp:         app("foo", nil),
+			generatedApp:    app("foo", nil),
+			expectedChanged: false,
+		},
+		{
+			name:            "changed spec",
+			liveApp:         app("foo", nil),
+			generatedApp:    app("bar", nil),
+			expectedChanged: true,
+		},
+		{
+			name:            "changed metadata only",
+			liveApp:         app("foo", map[string]string{"env": "dev"}),
+			generatedApp:    app("foo", map[string]string{"env": "prod"}),
+			expectedChanged: false,
+		},
+		{
+			name: "ignored spec change",
+			ignoreDifferences: v1alpha1.ApplicationSetIgnoreDifferences{
+				{JQPathExpressions: []string{".spec.source.targetRevision"}},
+			},
+			liveApp:         app("foo", nil),
+			generatedApp:    app("bar", nil),
+			expectedChanged: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			changed, err := ApplicationSpecChanged(tc.ignoreDifferences, normalizers.IgnoreNormalizerOpts{}, tc.liveApp, tc.generatedApp)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedChanged, changed)
+		})
+	}
+}
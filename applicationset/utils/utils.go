@@ -2,8 +2,10 @@ package utils
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +13,7 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"text/template"
@@ -526,3 +529,30 @@ func GetTlsConfig(scmRootCAPath string, insecure bool, caCerts []byte) *tls.Conf
 	}
 	return tlsConfig
 }
+
+// deletionSetRegexp matches the digest of the deletions awaiting approval in the message of the condition recording them
+var deletionSetRegexp = regexp.MustCompile(`\(deletion set ([0-9a-f]{16})\)`)
+
+// DeletionSetDigest returns the digest identifying the deletions of the given Applications. The approval of deletions
+// exceeding the maxDeletions of an ApplicationSet holds this digest, so that it only applies to the Applications which
+// were pending deletion when it was given.
+func DeletionSetDigest(appNames []string) string {
+	names := slices.Clone(appNames)
+	slices.Sort(names)
+	hash := sha256.Sum256([]byte(strings.Join(names, "\n")))
+	return hex.EncodeToString(hash[:8])
+}
+
+// FormatDeletionSet returns the suffix recording the digest of the deletions awaiting approval in a condition message.
+func FormatDeletionSet(digest string) string {
+	return fmt.Sprintf("(deletion set %s)", digest)
+}
+
+// ParseDeletionSet returns the digest of the deletions awaiting approval recorded in a condition message, if any.
+func ParseDeletionSet(message string) (string, bool) {
+	match := deletionSetRegexp.FindStringSubmatch(message)
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
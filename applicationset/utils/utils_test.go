@@ -1390,3 +1390,17 @@ WkBKOclmOV2xlTVuPw==
 		})
 	}
 }
+
+func TestDeletionSet(t *testing.T) {
+	digest := DeletionSetDigest([]string{"app2", "app1"})
+	assert.Equal(t, DeletionSetDigest([]string{"app1", "app2"}), digest)
+	assert.NotEqual(t, DeletionSetDigest([]string{"app1"}), digest)
+	assert.NotEqual(t, DeletionSetDigest([]string{"app1", "app2", "app3"}), digest)
+
+	parsed, ok := ParseDeletionSet("2 Applications would be deleted: the deletions are awaiting approval " + FormatDeletionSet(digest))
+	require.True(t, ok)
+	assert.Equal(t, digest, parsed)
+
+	_, ok = ParseDeletionSet("2 Applications would be deleted: the deletions are awaiting approval")
+	assert.False(t, ok)
+}
@@ -473,6 +473,9 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g0.ClusterDecisionResource,
 		PullRequest:             g0.PullRequest,
 		Plugin:                  g0.Plugin,
+		OCI:                     g0.OCI,
+		HelmRepository:          g0.HelmRepository,
+		KubernetesResource:      g0.KubernetesResource,
 		Matrix:                  matrixGenerator0,
 		Merge:                   mergeGenerator0,
 	}
@@ -528,6 +531,9 @@ func (h *WebhookHandler) shouldRefreshMatrixGenerator(gen *v1alpha1.MatrixGenera
 		ClusterDecisionResource: g1.ClusterDecisionResource,
 		PullRequest:             g1.PullRequest,
 		Plugin:                  g1.Plugin,
+		OCI:                     g1.OCI,
+		HelmRepository:          g1.HelmRepository,
+		KubernetesResource:      g1.KubernetesResource,
 		Matrix:                  matrixGenerator1,
 		Merge:                   mergeGenerator1,
 	}
@@ -97,6 +97,12 @@ func NewCommand() *cobra.Command {
 		// argocd k8s event logging flag
 		enableK8sEvent  []string
 		hydratorEnabled bool
+
+		hydrationCoalescingWindow time.Duration
+
+		clusterSyncConcurrency int
+		projectSyncConcurrency int
+		queuePrioritiesEnabled bool
 	)
 	command := cobra.Command{
 		Use:               cliName,
@@ -170,6 +176,8 @@ func NewCommand() *cobra.Command {
 
 			cache, err := cacheSource()
 			errors.CheckError(err)
+			// cluster loads are updated by all controller replicas, so they are not cached in memory
+			clusterLoadCache := appstatecache.NewCache(cacheutil.NewCache(cache.Cache.GetClient()), 0)
 			cache.Cache.SetClient(cacheutil.NewTwoLevelClient(cache.Cache.GetClient(), 10*time.Minute))
 
 			var appController *controller.ApplicationController
@@ -178,7 +186,7 @@ func NewCommand() *cobra.Command {
 				appController.InvalidateProjectsCache()
 			}))
 			kubectl := kubeutil.NewKubectl()
-			clusterSharding, err := sharding.GetClusterSharding(kubeClient, settingsMgr, shardingAlgorithm, enableDynamicClusterDistribution)
+			clusterSharding, err := sharding.GetClusterSharding(kubeClient, settingsMgr, shardingAlgorithm, enableDynamicClusterDistribution, clusterLoadCache)
 			errors.CheckError(err)
 			var selfHealBackoff *wait.Backoff
 			if selfHealBackoffTimeoutSeconds != 0 {
@@ -220,6 +228,10 @@ func NewCommand() *cobra.Command {
 				ignoreNormalizerOpts,
 				enableK8sEvent,
 				hydratorEnabled,
+				hydrationCoalescingWindow,
+				clusterSyncConcurrency,
+				projectSyncConcurrency,
+				queuePrioritiesEnabled,
 			)
 			errors.CheckError(err)
 			cacheutil.CollectMetrics(redisClient, appController.GetMetricsServer(), nil)
@@ -288,7 +300,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&otlpAttrs, "otlp-attrs", env.StringsFromEnv("ARGOCD_APPLICATION_CONTROLLER_OTLP_ATTRS", []string{}, ","), "List of OpenTelemetry collector extra attrs when send traces, each attribute is separated by a colon(e.g. key:value)")
 	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", env.StringsFromEnv("ARGOCD_APPLICATION_NAMESPACES", []string{}, ","), "List of additional namespaces that applications are allowed to be reconciled from")
 	command.Flags().BoolVar(&persistResourceHealth, "persist-resource-health", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_PERSIST_RESOURCE_HEALTH", false), "Enables storing the managed resources health in the Application CRD")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", env.StringFromEnv(common.EnvControllerShardingAlgorithm, common.DefaultShardingAlgorithm), "Enables choice of sharding method. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", env.StringFromEnv(common.EnvControllerShardingAlgorithm, common.DefaultShardingAlgorithm), "Enables choice of sharding method. Supported sharding methods are : [legacy, round-robin, consistent-hashing, load-based] ")
 	// global queue rate limit config
 	command.Flags().Int64Var(&workqueueRateLimit.BucketSize, "wq-bucket-size", env.ParseInt64FromEnv("WORKQUEUE_BUCKET_SIZE", 500, 1, math.MaxInt64), "Set Workqueue Rate Limiter Bucket Size, default 500")
 	command.Flags().Float64Var(&workqueueRateLimit.BucketQPS, "wq-bucket-qps", env.ParseFloat64FromEnv("WORKQUEUE_BUCKET_QPS", math.MaxFloat64, 1, math.MaxFloat64), "Set Workqueue Rate Limiter Bucket QPS, default set to MaxFloat64 which disables the bucket limiter")
@@ -304,6 +316,10 @@ func NewCommand() *cobra.Command {
 	// argocd k8s event logging flag
 	command.Flags().StringSliceVar(&enableK8sEvent, "enable-k8s-event", env.StringsFromEnv("ARGOCD_ENABLE_K8S_EVENT", argo.DefaultEnableEventList(), ","), "Enable ArgoCD to use k8s event. For disabling all events, set the value as `none`. (e.g --enable-k8s-event=none), For enabling specific events, set the value as `event reason`. (e.g --enable-k8s-event=StatusRefreshed,ResourceCreated)")
 	command.Flags().BoolVar(&hydratorEnabled, "hydrator-enabled", env.ParseBoolFromEnv("ARGOCD_HYDRATOR_ENABLED", false), "Feature flag to enable Hydrator. Default (\"false\")")
+	command.Flags().DurationVar(&hydrationCoalescingWindow, "hydrator-coalescing-window", env.ParseDurationFromEnv("ARGOCD_APPLICATION_CONTROLLER_HYDRATOR_COALESCING_WINDOW", 0, 0, math.MaxInt64), "Delay hydration by this duration after it is first requested, so that dry commits pushed in the meantime are hydrated in a single commit. The delay is not extended by later requests. Disabled if 0.")
+	command.Flags().IntVar(&clusterSyncConcurrency, "cluster-sync-concurrency", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_CLUSTER_SYNC_CONCURRENCY", 0, 0, math.MaxInt32), "Maximum number of sync operations running at the same time on a destination cluster, the other ones are queued. Unlimited if 0.")
+	command.Flags().IntVar(&projectSyncConcurrency, "project-sync-concurrency", env.ParseNumFromEnv("ARGOCD_APPLICATION_CONTROLLER_PROJECT_SYNC_CONCURRENCY", 0, 0, math.MaxInt32), "Maximum number of sync operations running at the same time in a project, the other ones are queued. Unlimited if 0.")
+	command.Flags().BoolVar(&queuePrioritiesEnabled, "queue-priorities-enabled", env.ParseBoolFromEnv("ARGOCD_APPLICATION_CONTROLLER_QUEUE_PRIORITIES_ENABLED", false), "Process the applications of the refresh and operation queues by priority tier, set by the queuePriority of their project, and the requested refreshes and operations before the periodic ones")
 	cacheSource = appstatecache.AddCacheFlagsToCmd(&command, cacheutil.Options{
 		OnClientCreated: func(client *redis.Client) {
 			redisClient = client
@@ -39,6 +39,7 @@ import (
 	appsetmetrics "github.com/argoproj/argo-cd/v3/applicationset/metrics"
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	appv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/errors"
@@ -78,6 +79,13 @@ func NewCommand() *cobra.Command {
 		enableScmProviders           bool
 		webhookParallelism           int
 		tokenRefStrictMode           bool
+		cacheSource                  func() (*appstatecache.Cache, error)
+		lastKnownGoodDuration        time.Duration
+		rolloutAnalysisAllowedURLs   []string
+		ociInsecureRegistries        []string
+		enableKubernetesResource     bool
+		kubernetesResourceResources  []string
+		kubernetesResourceNamespaces []string
 	)
 	scheme := runtime.NewScheme()
 	_ = clientgoscheme.AddToScheme(scheme)
@@ -195,7 +203,14 @@ func NewCommand() *cobra.Command {
 			repoClientset := apiclient.NewRepoServerClientset(argocdRepoServer, repoServerTimeoutSeconds, tlsConfig)
 			argoCDService := services.NewArgoCDService(argoCDDB, gitSubmoduleEnabled, repoClientset, enableNewGitFileGlobbing)
 
-			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig)
+			// The information about the clusters is read from the cache of the application controller, which also
+			// stores the last known good parameters of the generators
+			clusterInfoCache, err := cacheSource()
+			errors.CheckError(err)
+			lastKnownGood := generators.NewLastKnownGood(clusterInfoCache.Cache, lastKnownGoodDuration)
+
+			resourceWatcher := generators.NewResourceWatcher(ctx)
+			topLevelGenerators := generators.GetGenerators(ctx, mgr.GetClient(), k8sClient, namespace, argoCDService, dynamicClient, scmConfig, generators.NewOCIConfig(ociInsecureRegistries), generators.NewKubernetesResourceConfig(enableKubernetesResource, kubernetesResourceResources, kubernetesResourceNamespaces), resourceWatcher, clusterInfoCache)
 
 			// start a webhook server that listens to incoming webhook payloads
 			webhookHandler, err := webhook.NewWebhookHandler(namespace, webhookParallelism, argoSettingsMgr, mgr.GetClient(), topLevelGenerators)
@@ -214,7 +229,7 @@ func NewCommand() *cobra.Command {
 				})
 
 			if err = (&controllers.ApplicationSetReconciler{
-				Generators:                 topLevelGenerators,
+				Generators:                 lastKnownGood.Wrap(topLevelGenerators),
 				Client:                     mgr.GetClient(),
 				Scheme:                     mgr.GetScheme(),
 				Recorder:                   mgr.GetEventRecorderFor("applicationset-controller"),
@@ -230,6 +245,9 @@ func NewCommand() *cobra.Command {
 				GlobalPreservedAnnotations: globalPreservedAnnotations,
 				GlobalPreservedLabels:      globalPreservedLabels,
 				Metrics:                    &metrics,
+				ResourceWatcher:            resourceWatcher,
+				LastKnownGood:              lastKnownGood,
+				RolloutAnalysisAllowedURLs: rolloutAnalysisAllowedURLs,
 			}).SetupWithManager(mgr, enableProgressiveSyncs, maxConcurrentReconciliations); err != nil {
 				log.Error(err, "unable to create controller", "controller", "ApplicationSet")
 				os.Exit(1)
@@ -259,6 +277,10 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&cmdutil.LogFormat, "logformat", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_LOGFORMAT", "json"), "Set the logging format. One of: json|text")
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_LOGLEVEL", "info"), "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().StringSliceVar(&allowedScmProviders, "allowed-scm-providers", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ALLOWED_SCM_PROVIDERS", []string{}, ","), "The list of allowed custom SCM provider API URLs. This restriction does not apply to SCM or PR generators which do not accept a custom API URL. (Default: Empty = all)")
+	command.Flags().StringSliceVar(&ociInsecureRegistries, "oci-insecure-registries", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_OCI_INSECURE_REGISTRIES", []string{}, ","), "The list of registry hosts which OCI generators may access with insecure TLS or plain HTTP. (Default: Empty = none)")
+	command.Flags().BoolVar(&enableKubernetesResource, "enable-kubernetes-resource-generator", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_KUBERNETES_RESOURCE_GENERATOR", false), "Enable the KubernetesResource generator, which reads resources of the clusters with the permissions of the ApplicationSet controller (Default: false)")
+	command.Flags().StringSliceVar(&kubernetesResourceResources, "kubernetes-resource-generator-allowed-resources", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_KUBERNETES_RESOURCE_GENERATOR_ALLOWED_RESOURCES", []string{}, ","), "The list of kinds of resources KubernetesResource generators may read, formatted as <kind>.<group> or as <kind> for the core group. Globs are supported. (Default: Empty = none)")
+	command.Flags().StringSliceVar(&kubernetesResourceNamespaces, "kubernetes-resource-generator-allowed-namespaces", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_KUBERNETES_RESOURCE_GENERATOR_ALLOWED_NAMESPACES", []string{}, ","), "The list of namespaces whose resources KubernetesResource generators may read. Globs are supported, and '*' is required to read the resources of all namespaces at once. (Default: Empty = none)")
 	command.Flags().BoolVar(&enableScmProviders, "enable-scm-providers", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_SCM_PROVIDERS", true), "Enable retrieving information from SCM providers, used by the SCM and PR generators (Default: true)")
 	command.Flags().BoolVar(&dryRun, "dry-run", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_DRY_RUN", false), "Enable dry run mode")
 	command.Flags().BoolVar(&tokenRefStrictMode, "token-ref-strict-mode", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_TOKENREF_STRICT_MODE", false), fmt.Sprintf("Set to true to require secrets referenced by SCM providers to have the %s=%s label set (Default: false)", common.LabelKeySecretType, common.LabelValueSecretTypeSCMCreds))
@@ -273,6 +295,9 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringSliceVar(&globalPreservedLabels, "preserved-labels", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GLOBAL_PRESERVED_LABELS", []string{}, ","), "Sets global preserved field values for labels")
 	command.Flags().IntVar(&webhookParallelism, "webhook-parallelism-limit", env.ParseNumFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_WEBHOOK_PARALLELISM_LIMIT", 50, 1, 1000), "Number of webhook requests processed concurrently")
 	command.Flags().StringSliceVar(&metricsAplicationsetLabels, "metrics-applicationset-labels", []string{}, "List of Application labels that will be added to the argocd_applicationset_labels metric")
+	command.Flags().DurationVar(&lastKnownGoodDuration, "generators-last-known-good-duration", env.ParseDurationFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_GENERATORS_LAST_KNOWN_GOOD_DURATION", 0, 0, math.MaxInt64), "Duration during which the last parameters successfully generated by a generator are used when it fails transiently. Disabled when 0 (Default: 0)")
+	command.Flags().StringSliceVar(&rolloutAnalysisAllowedURLs, "rollout-analysis-allowed-urls", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ROLLOUT_ANALYSIS_ALLOWED_URLS", []string{}, ","), "The list of URLs the analyses of RollingSync steps may call. An analysis URL is allowed if it has the scheme and host of one of these URLs, and its path is below the path of that URL. (Default: Empty = analyses are disabled)")
+	cacheSource = appstatecache.AddCacheFlagsToCmd(&command)
 	return &command
 }
 
@@ -16,6 +16,7 @@ import (
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
 	"github.com/argoproj/argo-cd/v3/commitserver"
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/commitserver/commit"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/askpass"
@@ -33,6 +34,9 @@ func NewCommand() *cobra.Command {
 		listenPort  int
 		metricsPort int
 		metricsHost string
+
+		signingKeyPath   string
+		signingKeyFormat string
 	)
 	command := &cobra.Command{
 		Use:   "argocd-commit-server",
@@ -57,7 +61,19 @@ func NewCommand() *cobra.Command {
 			askPassServer := askpass.NewServer(askpass.CommitServerSocketPath)
 			go func() { errors.CheckError(askPassServer.Run()) }()
 
-			server := commitserver.NewServer(askPassServer, metricsServer)
+			var signingKey *commit.SigningKey
+			if signingKeyPath != "" {
+				if signingKeyFormat == commit.SigningKeyFormatOpenPGP {
+					// git invokes gpg to sign commits, which needs to find the imported key in our keyring.
+					errors.CheckError(os.Setenv("GNUPGHOME", common.GetGnuPGHomePath()))
+				}
+				var err error
+				signingKey, err = commit.LoadSigningKey(signingKeyFormat, signingKeyPath)
+				errors.CheckError(err)
+				log.Infof("Signing commits with %s key %s", signingKey.Format, signingKey.Key)
+			}
+
+			server := commitserver.NewServer(askPassServer, metricsServer, signingKey)
 			grpc := server.CreateGRPC()
 
 			listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", listenHost, listenPort))
@@ -112,6 +128,8 @@ func NewCommand() *cobra.Command {
 	command.Flags().IntVar(&listenPort, "port", common.DefaultPortCommitServer, "Listen on given port for incoming connections")
 	command.Flags().StringVar(&metricsHost, "metrics-address", env.StringFromEnv("ARGOCD_COMMIT_SERVER_METRICS_LISTEN_ADDRESS", common.DefaultAddressCommitServerMetrics), "Listen on given address for metrics")
 	command.Flags().IntVar(&metricsPort, "metrics-port", common.DefaultPortCommitServerMetrics, "Start metrics server on given port")
+	command.Flags().StringVar(&signingKeyPath, "signing-key-path", env.StringFromEnv("ARGOCD_COMMIT_SERVER_SIGNING_KEY_PATH", ""), "Path to the private key to sign hydrated commits with. Commits are not signed if empty")
+	command.Flags().StringVar(&signingKeyFormat, "signing-key-format", env.StringFromEnv("ARGOCD_COMMIT_SERVER_SIGNING_KEY_FORMAT", commit.SigningKeyFormatOpenPGP), "Format of the signing key. One of: openpgp|ssh")
 
 	return command
 }
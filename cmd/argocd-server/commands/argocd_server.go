@@ -21,6 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
@@ -70,6 +71,7 @@ func NewCommand() *cobra.Command {
 		baseHRef                 string
 		rootPath                 string
 		repoServerAddress        string
+		commitServerAddress      string
 		dexServerAddress         string
 		disableAuth              bool
 		contentTypes             string
@@ -95,6 +97,10 @@ func NewCommand() *cobra.Command {
 		scmRootCAPath            string
 		allowedScmProviders      []string
 		enableScmProviders       bool
+		ociInsecureRegistries    []string
+		enableKubernetesResource bool
+		kubernetesResources      []string
+		kubernetesNamespaces     []string
 
 		// argocd k8s event logging flag
 		enableK8sEvent []string
@@ -203,6 +209,10 @@ func NewCommand() *cobra.Command {
 			}
 
 			repoclientset := apiclient.NewRepoServerClientset(repoServerAddress, repoServerTimeoutSeconds, tlsConfig)
+			var commitClientset commitclient.Clientset
+			if hydratorEnabled {
+				commitClientset = commitclient.NewCommitServerClientset(commitServerAddress)
+			}
 			if rootPath != "" {
 				if baseHRef != "" && baseHRef != rootPath {
 					log.Warnf("--basehref and --rootpath had conflict: basehref: %s rootpath: %s", baseHRef, rootPath)
@@ -229,6 +239,7 @@ func NewCommand() *cobra.Command {
 				KubeClientset:           kubeclientset,
 				AppClientset:            appClientSet,
 				RepoClientset:           repoclientset,
+				CommitClientset:         commitClientset,
 				DexServerAddr:           dexServerAddress,
 				DexTLSConfig:            dexTLSConfig,
 				DisableAuth:             disableAuth,
@@ -255,6 +266,10 @@ func NewCommand() *cobra.Command {
 				ScmRootCAPath:            scmRootCAPath,
 				AllowedScmProviders:      allowedScmProviders,
 				EnableScmProviders:       enableScmProviders,
+				OCIInsecureRegistries:    ociInsecureRegistries,
+				EnableKubernetesResource: enableKubernetesResource,
+				KubernetesResources:      kubernetesResources,
+				KubernetesNamespaces:     kubernetesNamespaces,
 			}
 
 			stats.RegisterStackDumper()
@@ -301,6 +316,7 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&cmdutil.LogLevel, "loglevel", env.StringFromEnv("ARGOCD_SERVER_LOG_LEVEL", "info"), "Set the logging level. One of: debug|info|warn|error")
 	command.Flags().IntVar(&glogLevel, "gloglevel", 0, "Set the glog logging level")
 	command.Flags().StringVar(&repoServerAddress, "repo-server", env.StringFromEnv("ARGOCD_SERVER_REPO_SERVER", common.DefaultRepoServerAddr), "Repo server address")
+	command.Flags().StringVar(&commitServerAddress, "commit-server", env.StringFromEnv("ARGOCD_SERVER_COMMIT_SERVER", common.DefaultCommitServerAddr), "Commit server address, used to preview hydration if the hydrator is enabled")
 	command.Flags().StringVar(&dexServerAddress, "dex-server", env.StringFromEnv("ARGOCD_SERVER_DEX_SERVER", common.DefaultDexServerAddr), "Dex server address")
 	command.Flags().BoolVar(&disableAuth, "disable-auth", env.ParseBoolFromEnv("ARGOCD_SERVER_DISABLE_AUTH", false), "Disable client authentication")
 	command.Flags().StringVar(&contentTypes, "api-content-types", env.StringFromEnv("ARGOCD_API_CONTENT_TYPES", "application/json", env.StringFromEnvOpts{AllowEmpty: true}), "Semicolon separated list of allowed content types for non GET api requests. Any content type is allowed if empty.")
@@ -332,6 +348,10 @@ func NewCommand() *cobra.Command {
 	command.Flags().StringVar(&scmRootCAPath, "appset-scm-root-ca-path", env.StringFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_SCM_ROOT_CA_PATH", ""), "Provide Root CA Path for self-signed TLS Certificates")
 	command.Flags().BoolVar(&enableScmProviders, "appset-enable-scm-providers", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_SCM_PROVIDERS", true), "Enable retrieving information from SCM providers, used by the SCM and PR generators (Default: true)")
 	command.Flags().StringSliceVar(&allowedScmProviders, "appset-allowed-scm-providers", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ALLOWED_SCM_PROVIDERS", []string{}, ","), "The list of allowed custom SCM provider API URLs. This restriction does not apply to SCM or PR generators which do not accept a custom API URL. (Default: Empty = all)")
+	command.Flags().StringSliceVar(&ociInsecureRegistries, "appset-oci-insecure-registries", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_OCI_INSECURE_REGISTRIES", []string{}, ","), "The list of registry hosts which OCI generators may access with insecure TLS or plain HTTP. (Default: Empty = none)")
+	command.Flags().BoolVar(&enableKubernetesResource, "appset-enable-kubernetes-resource-generator", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_KUBERNETES_RESOURCE_GENERATOR", false), "Enable the KubernetesResource generator, which reads resources of the clusters with the permissions of the ApplicationSet controller (Default: false)")
+	command.Flags().StringSliceVar(&kubernetesResources, "appset-kubernetes-resource-generator-allowed-resources", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_KUBERNETES_RESOURCE_GENERATOR_ALLOWED_RESOURCES", []string{}, ","), "The list of kinds of resources KubernetesResource generators may read, formatted as <kind>.<group> or as <kind> for the core group. Globs are supported. (Default: Empty = none)")
+	command.Flags().StringSliceVar(&kubernetesNamespaces, "appset-kubernetes-resource-generator-allowed-namespaces", env.StringsFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_KUBERNETES_RESOURCE_GENERATOR_ALLOWED_NAMESPACES", []string{}, ","), "The list of namespaces whose resources KubernetesResource generators may read. Globs are supported, and '*' is required to read the resources of all namespaces at once. (Default: Empty = none)")
 	command.Flags().BoolVar(&enableNewGitFileGlobbing, "appset-enable-new-git-file-globbing", env.ParseBoolFromEnv("ARGOCD_APPLICATIONSET_CONTROLLER_ENABLE_NEW_GIT_FILE_GLOBBING", false), "Enable new globbing in Git files generator.")
 
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 	"time"
@@ -14,6 +16,7 @@ import (
 	"github.com/redis/go-redis/v9"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
@@ -36,6 +39,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
+	secutil "github.com/argoproj/argo-cd/v3/util/security"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 	"github.com/argoproj/argo-cd/v3/util/text/label"
 )
@@ -62,6 +66,7 @@ argocd admin cluster namespaces my-cluster `,
 	command.AddCommand(NewGenClusterConfigCommand(pathOpts))
 	command.AddCommand(NewClusterStatsCommand(clientOpts))
 	command.AddCommand(NewClusterShardsCommand(clientOpts))
+	command.AddCommand(NewClusterShardPlanCommand(clientOpts))
 	namespacesCommand := NewClusterNamespacesCommand()
 	namespacesCommand.AddCommand(NewClusterEnableNamespacedMode())
 	namespacesCommand.AddCommand(NewClusterDisableNamespacedMode())
@@ -76,6 +81,8 @@ type ClusterWithInfo struct {
 	Shard int
 	// Namespaces holds list of namespaces managed by Argo CD in the cluster
 	Namespaces []string
+	// Load holds the load of the cluster used by the load-based sharding algorithm
+	Load *sharding.ClusterLoad
 }
 
 func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClient versioned.Interface, replicas int, shardingAlgorithm string, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), shard int, redisName string, redisHaProxyName string, redisCompressionStr string) ([]ClusterWithInfo, error) {
@@ -90,38 +97,15 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 	if err != nil {
 		return nil, err
 	}
-	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm)
+	cache, err := getAppStateCache(ctx, kubeClient, namespace, portForwardRedis, cacheSrc, redisName, redisHaProxyName, redisCompressionStr)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterShardingCache := sharding.NewClusterSharding(argoDB, shard, replicas, shardingAlgorithm, cache)
 	clusterShardingCache.Init(clustersList, appItems)
 	clusterShards := clusterShardingCache.GetDistribution()
 
-	var cache *appstatecache.Cache
-	if portForwardRedis {
-		overrides := clientcmd.ConfigOverrides{}
-		redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + redisHaProxyName
-		redisPodLabelSelector := common.LabelKeyAppName + "=" + redisName
-		port, err := kubeutil.PortForward(6379, namespace, &overrides,
-			redisHaProxyPodLabelSelector, redisPodLabelSelector)
-		if err != nil {
-			return nil, err
-		}
-
-		redisOptions := &redis.Options{Addr: fmt.Sprintf("localhost:%d", port)}
-		if err = common.SetOptionalRedisPasswordFromKubeConfig(ctx, kubeClient, namespace, redisOptions); err != nil {
-			log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
-		}
-		client := redis.NewClient(redisOptions)
-		compressionType, err := cacheutil.CompressionTypeFromString(redisCompressionStr)
-		if err != nil {
-			return nil, err
-		}
-		cache = appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), time.Hour)
-	} else {
-		cache, err = cacheSrc()
-		if err != nil {
-			return nil, err
-		}
-	}
-
 	apps := appItems.Items
 	clusters := make([]ClusterWithInfo, len(clustersList.Items))
 
@@ -160,13 +144,41 @@ func loadClusters(ctx context.Context, kubeClient kubernetes.Interface, appClien
 				namespaces = append(namespaces, ns)
 			}
 			_ = cache.GetClusterInfo(cluster.Server, &cluster.Info)
-			clusters[batchStart+i] = ClusterWithInfo{cluster, clusterShard, namespaces}
+			load, _ := sharding.GetClusterLoad(cache, cluster.Server)
+			clusters[batchStart+i] = ClusterWithInfo{cluster, clusterShard, namespaces, load}
 			return nil
 		})
 	}
 	return clusters, nil
 }
 
+// getAppStateCache returns the app state cache of the controller, port-forwarding redis from the given namespace if
+// requested.
+func getAppStateCache(ctx context.Context, kubeClient kubernetes.Interface, namespace string, portForwardRedis bool, cacheSrc func() (*appstatecache.Cache, error), redisName string, redisHaProxyName string, redisCompressionStr string) (*appstatecache.Cache, error) {
+	if !portForwardRedis {
+		return cacheSrc()
+	}
+	overrides := clientcmd.ConfigOverrides{}
+	redisHaProxyPodLabelSelector := common.LabelKeyAppName + "=" + redisHaProxyName
+	redisPodLabelSelector := common.LabelKeyAppName + "=" + redisName
+	port, err := kubeutil.PortForward(6379, namespace, &overrides,
+		redisHaProxyPodLabelSelector, redisPodLabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	redisOptions := &redis.Options{Addr: fmt.Sprintf("localhost:%d", port)}
+	if err = common.SetOptionalRedisPasswordFromKubeConfig(ctx, kubeClient, namespace, redisOptions); err != nil {
+		log.Warnf("Failed to fetch & set redis password for namespace %s: %v", namespace, err)
+	}
+	client := redis.NewClient(redisOptions)
+	compressionType, err := cacheutil.CompressionTypeFromString(redisCompressionStr)
+	if err != nil {
+		return nil, err
+	}
+	return appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewRedisCache(client, time.Hour, compressionType)), time.Hour), nil
+}
+
 func getControllerReplicas(ctx context.Context, kubeClient *kubernetes.Clientset, namespace string, appControllerName string) (int, error) {
 	appControllerPodLabelSelector := common.LabelKeyAppName + "=" + appControllerName
 	controllerPods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
@@ -221,7 +233,7 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing, load-based] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
@@ -233,6 +245,193 @@ func NewClusterShardsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comm
 	return &command
 }
 
+// shardingAlgorithmParamKey is the key of the sharding algorithm of the application controller in argocd-cmd-params-cm
+const shardingAlgorithmParamKey = "controller.sharding.algorithm"
+
+var shardingAlgorithms = []string{
+	common.LegacyShardingAlgorithm,
+	common.RoundRobinShardingAlgorithm,
+	common.ConsistentHashingWithBoundedLoadsAlgorithm,
+	common.LoadBasedShardingAlgorithm,
+}
+
+// getShardingAlgorithm returns the sharding algorithm configured for the application controller
+func getShardingAlgorithm(ctx context.Context, kubeClient kubernetes.Interface, namespace string) (string, error) {
+	cm, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDCmdParamsConfigMapName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("error getting %s: %w", common.ArgoCDCmdParamsConfigMapName, err)
+	}
+	if cm != nil && cm.Data[shardingAlgorithmParamKey] != "" {
+		return cm.Data[shardingAlgorithmParamKey], nil
+	}
+	return common.DefaultShardingAlgorithm, nil
+}
+
+// getApplicationNamespaces returns the namespaces Applications may be created in besides the Argo CD namespace, as
+// configured in the argocd-cmd-params-cm ConfigMap.
+func getApplicationNamespaces(ctx context.Context, kubeClient kubernetes.Interface, namespace string) ([]string, error) {
+	cm, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDCmdParamsConfigMapName, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("error getting %s: %w", common.ArgoCDCmdParamsConfigMapName, err)
+	}
+	var applicationNamespaces []string
+	if cm != nil {
+		for _, ns := range strings.Split(cm.Data[applicationNamespacesCmdParamsKey], ",") {
+			if ns = strings.TrimSpace(ns); ns != "" {
+				applicationNamespaces = append(applicationNamespaces, ns)
+			}
+		}
+	}
+	return applicationNamespaces, nil
+}
+
+// listApplications lists the Applications in the Argo CD namespace and in the namespaces matching applicationNamespaces.
+func listApplications(ctx context.Context, appClient versioned.Interface, namespace string, applicationNamespaces []string) ([]v1alpha1.Application, error) {
+	appItems, err := appClient.ArgoprojV1alpha1().Applications(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing applications: %w", err)
+	}
+	var apps []v1alpha1.Application
+	for _, app := range appItems.Items {
+		if secutil.IsNamespaceEnabled(app.Namespace, namespace, applicationNamespaces) {
+			apps = append(apps, app)
+		}
+	}
+	return apps, nil
+}
+
+func NewClusterShardPlanCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		replicas                  int
+		shardingAlgorithm         string
+		proposedReplicas          int
+		proposedShardingAlgorithm string
+		applicationNamespaces     []string
+		clientConfig              clientcmd.ClientConfig
+		cacheSrc                  func() (*appstatecache.Cache, error)
+		portForwardRedis          bool
+	)
+	command := cobra.Command{
+		Use:   "shard-plan",
+		Short: "Preview which clusters move between controller shards when changing the number of replicas or the sharding method",
+		Example: `
+#Preview the assignment of clusters to shards when scaling the application controller to 3 replicas
+argocd admin cluster shard-plan --proposed-replicas 3
+
+#Preview the assignment of clusters to shards when switching to the round-robin sharding method
+argocd admin cluster shard-plan --proposed-sharding-method round-robin`,
+		Run: func(cmd *cobra.Command, _ []string) {
+			ctx := cmd.Context()
+
+			log.SetLevel(log.WarnLevel)
+
+			clientCfg, err := clientConfig.ClientConfig()
+			errors.CheckError(err)
+			namespace, _, err := clientConfig.Namespace()
+			errors.CheckError(err)
+			kubeClient := kubernetes.NewForConfigOrDie(clientCfg)
+			appClient := versioned.NewForConfigOrDie(clientCfg)
+
+			if replicas == 0 {
+				replicas, err = getControllerReplicas(ctx, kubeClient, namespace, clientOpts.AppControllerName)
+				errors.CheckError(err)
+			}
+			if shardingAlgorithm == "" {
+				shardingAlgorithm, err = getShardingAlgorithm(ctx, kubeClient, namespace)
+				errors.CheckError(err)
+			}
+			if len(applicationNamespaces) == 0 {
+				applicationNamespaces, err = getApplicationNamespaces(ctx, kubeClient, namespace)
+				errors.CheckError(err)
+			}
+			if proposedReplicas == 0 {
+				proposedReplicas = replicas
+			}
+			if proposedShardingAlgorithm == "" {
+				proposedShardingAlgorithm = shardingAlgorithm
+			}
+			if replicas < 1 || proposedReplicas < 1 {
+				log.Fatalf("The number of replicas must be at least 1")
+			}
+			for _, algorithm := range []string{shardingAlgorithm, proposedShardingAlgorithm} {
+				if !slices.Contains(shardingAlgorithms, algorithm) {
+					log.Fatalf("Unsupported sharding method %q. Supported sharding methods are: %s", algorithm, strings.Join(shardingAlgorithms, ", "))
+				}
+			}
+
+			settingsMgr := settings.NewSettingsManager(ctx, kubeClient, namespace)
+			argoDB := db.NewDB(namespace, settingsMgr, kubeClient)
+			clustersList, err := argoDB.ListClusters(ctx)
+			errors.CheckError(err)
+			apps, err := listApplications(ctx, appClient, namespace, applicationNamespaces)
+			errors.CheckError(err)
+			cache, err := getAppStateCache(ctx, kubeClient, namespace, portForwardRedis, cacheSrc, clientOpts.RedisName, clientOpts.RedisHaProxyName, clientOpts.RedisCompression)
+			errors.CheckError(err)
+
+			loads := map[string]int64{}
+			resourcesCounts := map[string]int64{}
+			for _, cluster := range clustersList.Items {
+				var info v1alpha1.ClusterInfo
+				if err := cache.GetClusterInfo(cluster.Server, &info); err == nil {
+					resourcesCounts[cluster.Server] = info.CacheInfo.ResourcesCount
+				}
+				if load, err := sharding.GetClusterLoad(cache, cluster.Server); err == nil && load != nil {
+					loads[cluster.Server] = load.Load
+				}
+			}
+
+			clusters := clustersList.Items
+			current := sharding.PlanShards(clusters, apps, loads, resourcesCounts, shardingAlgorithm, replicas)
+			proposed := sharding.PlanShards(clusters, apps, loads, resourcesCounts, proposedShardingAlgorithm, proposedReplicas)
+			printShardPlan(current, proposed, clusters, len(apps))
+
+			fmt.Printf("\nPer-shard load of each sharding method with %d replicas:\n\n", proposedReplicas)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "SHARDING METHOD\tSHARD\tCLUSTERS COUNT\tAPPS COUNT\tRESOURCES COUNT\tLOAD\n")
+			for _, algorithm := range shardingAlgorithms {
+				plan := sharding.PlanShards(clusters, apps, loads, resourcesCounts, algorithm, proposedReplicas)
+				for shard, shardLoad := range plan.ShardLoads {
+					_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\n", algorithm, shard, shardLoad.ClustersCount, shardLoad.AppsCount, shardLoad.ResourcesCount, shardLoad.Load)
+				}
+			}
+			_ = w.Flush()
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(&command)
+	command.Flags().IntVar(&replicas, "replicas", 0, "Current application controller replicas count. Inferred from number of running controller pods if not specified")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", "", "Current sharding method. Read from the argocd-cmd-params-cm ConfigMap if not specified. Supported sharding methods are : [legacy, round-robin, consistent-hashing, load-based] ")
+	command.Flags().IntVar(&proposedReplicas, "proposed-replicas", 0, "Proposed application controller replicas count. Defaults to the current replicas count")
+	command.Flags().StringVar(&proposedShardingAlgorithm, "proposed-sharding-method", "", "Proposed sharding method. Defaults to the current sharding method")
+	command.Flags().StringSliceVar(&applicationNamespaces, "application-namespaces", []string{}, fmt.Sprintf("Comma separated list of namespace globs of the applications to take into account, in addition to the Argo CD namespace. If not provided, value from '%s' in %s will be used", applicationNamespacesCmdParamsKey, common.ArgoCDCmdParamsConfigMapName))
+	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
+	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
+
+	// parse all added flags so far to get the redis-compression flag that was added by AddCacheFlagsToCmd() above
+	// we can ignore unchecked error here as the command will be parsed again and checked when command.Execute() is run later
+	//nolint:errcheck
+	command.ParseFlags(os.Args[1:])
+	return &command
+}
+
+func printShardPlan(current, proposed *sharding.ShardPlan, clusters []v1alpha1.Cluster, appsCount int) {
+	fmt.Printf("Current: %s sharding method with %d replicas\n", current.ShardingAlgorithm, current.Replicas)
+	fmt.Printf("Proposed: %s sharding method with %d replicas\n\n", proposed.ShardingAlgorithm, proposed.Replicas)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "SERVER\tNAME\tAPPS COUNT\tCURRENT SHARD\tPROPOSED SHARD\n")
+	for _, cluster := range clusters {
+		currentShard, proposedShard := strconv.Itoa(current.Shards[cluster.Server]), strconv.Itoa(proposed.Shards[cluster.Server])
+		if currentShard != proposedShard {
+			proposedShard += " (moved)"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", cluster.Server, cluster.Name, current.AppsCount(cluster.Server), currentShard, proposedShard)
+	}
+	_ = w.Flush()
+
+	movedClusters, movedApps := current.MovedClusters(proposed)
+	fmt.Printf("\n%d of %d clusters and %d of %d applications would move to another shard\n", len(movedClusters), len(clusters), movedApps, appsCount)
+}
+
 func printStatsSummary(clusters []ClusterWithInfo) {
 	totalResourcesCount := int64(0)
 	resourcesCountByShard := map[int]int64{}
@@ -477,7 +676,10 @@ argocd admin cluster stats
 argocd admin cluster stats --shard=1
 
 #In a multi-cluster environment to print stats for a specific cluster say(target-cluster)
-argocd admin cluster stats target-cluster`,
+argocd admin cluster stats target-cluster
+
+#Preview the assignment of clusters to shards by the load-based sharding method, with the load of each cluster
+argocd admin cluster stats --sharding-method load-based`,
 		Run: func(cmd *cobra.Command, _ []string) {
 			ctx := cmd.Context()
 
@@ -498,9 +700,21 @@ argocd admin cluster stats target-cluster`,
 			errors.CheckError(err)
 
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			_, _ = fmt.Fprintf(w, "SERVER\tSHARD\tCONNECTION\tNAMESPACES COUNT\tAPPS COUNT\tRESOURCES COUNT\n")
-			for _, cluster := range clusters {
-				_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%d\t%d\n", cluster.Server, cluster.Shard, cluster.Info.ConnectionState.Status, len(cluster.Namespaces), cluster.Info.ApplicationsCount, cluster.Info.CacheInfo.ResourcesCount)
+			if shardingAlgorithm == common.LoadBasedShardingAlgorithm {
+				_, _ = fmt.Fprintf(w, "SERVER\tSHARD\tCONNECTION\tNAMESPACES COUNT\tAPPS COUNT\tRESOURCES COUNT\tEVENTS PER MINUTE\tLOAD\n")
+				for _, cluster := range clusters {
+					var eventsPerMinute, load string
+					if cluster.Load != nil {
+						eventsPerMinute = strconv.FormatInt(cluster.Load.EventsPerMinute, 10)
+						load = strconv.FormatInt(cluster.Load.Load, 10)
+					}
+					_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%d\t%d\t%s\t%s\n", cluster.Server, cluster.Shard, cluster.Info.ConnectionState.Status, len(cluster.Namespaces), cluster.Info.ApplicationsCount, cluster.Info.CacheInfo.ResourcesCount, eventsPerMinute, load)
+				}
+			} else {
+				_, _ = fmt.Fprintf(w, "SERVER\tSHARD\tCONNECTION\tNAMESPACES COUNT\tAPPS COUNT\tRESOURCES COUNT\n")
+				for _, cluster := range clusters {
+					_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%d\t%d\n", cluster.Server, cluster.Shard, cluster.Info.ConnectionState.Status, len(cluster.Namespaces), cluster.Info.ApplicationsCount, cluster.Info.CacheInfo.ResourcesCount)
+				}
 			}
 			_ = w.Flush()
 		},
@@ -508,7 +722,7 @@ argocd admin cluster stats target-cluster`,
 	clientConfig = cli.AddKubectlFlagsToCmd(&command)
 	command.Flags().IntVar(&shard, "shard", -1, "Cluster shard filter")
 	command.Flags().IntVar(&replicas, "replicas", 0, "Application controller replicas count. Inferred from number of running controller pods if not specified")
-	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing] ")
+	command.Flags().StringVar(&shardingAlgorithm, "sharding-method", common.DefaultShardingAlgorithm, "Sharding method. Defaults: legacy. Supported sharding methods are : [legacy, round-robin, consistent-hashing, load-based] ")
 	command.Flags().BoolVar(&portForwardRedis, "port-forward-redis", true, "Automatically port-forward ha proxy redis from current namespace?")
 	cacheSrc = appstatecache.AddCacheFlagsToCmd(&command)
 
@@ -79,3 +79,34 @@ func Test_loadClusters(t *testing.T) {
 	}}
 	assert.Equal(t, expected, clusters)
 }
+
+func Test_listApplications(t *testing.T) {
+	newApp := func(name, namespace string) *v1alpha1.Application {
+		return &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	}
+	cmdParamsCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "argocd-cmd-params-cm", Namespace: "argocd"},
+		Data:       map[string]string{"application.namespaces": "team-*, other"},
+	}
+	ctx := t.Context()
+	applicationNamespaces, err := getApplicationNamespaces(ctx, fake.NewClientset(cmdParamsCM), "argocd")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"team-*", "other"}, applicationNamespaces)
+
+	appClient := fakeapps.NewSimpleClientset(newApp("control-plane", "argocd"), newApp("team", "team-a"), newApp("disabled", "default"))
+	apps, err := listApplications(ctx, appClient, "argocd", applicationNamespaces)
+	require.NoError(t, err)
+	var names []string
+	for _, app := range apps {
+		names = append(names, app.Name)
+	}
+	assert.ElementsMatch(t, []string{"control-plane", "team"}, names)
+
+	applicationNamespaces, err = getApplicationNamespaces(ctx, fake.NewClientset(), "argocd")
+	require.NoError(t, err)
+	assert.Empty(t, applicationNamespaces)
+	apps, err = listApplications(ctx, appClient, "argocd", applicationNamespaces)
+	require.NoError(t, err)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "control-plane", apps[0].Name)
+}
@@ -103,6 +103,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationAddSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationRemoveSourceCommand(clientOpts))
 	command.AddCommand(NewApplicationConfirmDeletionCommand(clientOpts))
+	command.AddCommand(NewApplicationHydrateCommand(clientOpts))
 	return command
 }
 
@@ -653,6 +654,22 @@ func NewApplicationLogsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	return command
 }
 
+// formatAssignedSyncWindow formats a sync window as its kind followed by its schedule and duration, date ranges and
+// calendar, for the ones it has
+func formatAssignedSyncWindow(w *argoappv1.SyncWindow) string {
+	s := w.Kind
+	if w.Schedule != "" || w.Duration != "" {
+		s += ":" + w.Schedule + ":" + w.Duration
+	}
+	for _, dateRange := range w.DateRanges {
+		s += ":" + dateRange.Start + "/" + dateRange.End
+	}
+	if w.Calendar != nil {
+		s += ":calendar " + w.Calendar.ConfigMap + "/" + w.Calendar.Key + " (unresolved)"
+	}
+	return s
+}
+
 func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *argoappv1.SyncWindows) {
 	fmt.Printf(printOpFmtStr, "Name:", app.QualifiedName())
 	fmt.Printf(printOpFmtStr, "Project:", app.Spec.GetProject())
@@ -696,9 +713,16 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 		} else {
 			status = "Sync Allowed"
 		}
+		unresolved := false
 		for _, w := range *windows {
-			s := w.Kind + ":" + w.Schedule + ":" + w.Duration
-			wds = append(wds, s)
+			wds = append(wds, formatAssignedSyncWindow(w))
+			if w.Calendar != nil {
+				unresolved = true
+			}
+		}
+		// The calendars are only read by the API server, so the events of a calendar are not taken into account here.
+		if unresolved {
+			status += " (calendars unresolved)"
 		}
 	} else {
 		status = "Sync Allowed"
@@ -3520,3 +3544,56 @@ func NewApplicationConfirmDeletionCommand(clientOpts *argocdclient.ClientOptions
 	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Namespace of the target application where the source will be appended")
 	return command
 }
+
+// NewApplicationHydrateCommand returns a new instance of an `argocd app hydrate` command
+func NewApplicationHydrateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		appNamespace string
+		dryRun       bool
+	)
+	command := &cobra.Command{
+		Use:   "hydrate APPNAME",
+		Short: "Hydrate an application's dry source",
+		Example: templates.Examples(`
+  # Request the hydration of an application
+  argocd app hydrate my-app
+
+  # Print the diff hydration would commit to the hydrated branch, without committing it
+  argocd app hydrate my-app --dry-run
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+			resp, err := appIf.Hydrate(ctx, &application.ApplicationHydrateRequest{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				DryRun:       &dryRun,
+			})
+			errors.CheckError(err)
+
+			if !dryRun {
+				fmt.Printf("Application '%s' hydration requested\n", appName)
+				return
+			}
+			fmt.Printf("Dry SHA:        %s\n", resp.GetDrySHA())
+			fmt.Printf("Target Branch:  %s\n", resp.GetTargetBranch())
+			fmt.Printf("Paths:          %s\n", strings.Join(resp.GetPaths(), ","))
+			if resp.GetDiff() == "" {
+				fmt.Println("\nNo changes to the hydrated manifests")
+				return
+			}
+			fmt.Printf("\n%s", resp.GetDiff())
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only hydrate an application in namespace")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the diff of the hydrated manifests against the hydrated branch instead of committing them")
+	return command
+}
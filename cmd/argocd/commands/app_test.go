@@ -751,6 +751,15 @@ Health Status:      Progressing
 	assert.Equalf(t, expectation, output, "Incorrect print app summary output %q, should be %q", output, expectation)
 }
 
+func TestFormatAssignedSyncWindow(t *testing.T) {
+	assert.Equal(t, "allow:0 0 * * *:24h", formatAssignedSyncWindow(&v1alpha1.SyncWindow{Kind: "allow", Schedule: "0 0 * * *", Duration: "24h"}))
+	assert.Equal(t, "deny:2025-12-15/2026-01-05:calendar holidays/holidays.ics (unresolved)", formatAssignedSyncWindow(&v1alpha1.SyncWindow{
+		Kind:       "deny",
+		DateRanges: []v1alpha1.SyncWindowDateRange{{Start: "2025-12-15", End: "2026-01-05"}},
+		Calendar:   &v1alpha1.SyncWindowCalendar{ConfigMap: "holidays", Key: "holidays.ics"},
+	}))
+}
+
 func TestPrintAppSummaryTable_MultipleSources(t *testing.T) {
 	output, _ := captureOutput(func() error {
 		app := &v1alpha1.Application{
@@ -2190,6 +2199,10 @@ func (c *fakeAppServiceClient) Sync(_ context.Context, _ *applicationpkg.Applica
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) Hydrate(_ context.Context, _ *applicationpkg.ApplicationHydrateRequest, _ ...grpc.CallOption) (*applicationpkg.ApplicationHydrateResponse, error) {
+	return nil, nil
+}
+
 func (c *fakeAppServiceClient) ManagedResources(_ context.Context, _ *applicationpkg.ResourcesQuery, _ ...grpc.CallOption) (*applicationpkg.ManagedResourcesResponse, error) {
 	return nil, nil
 }
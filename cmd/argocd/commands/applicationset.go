@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -10,15 +11,18 @@ import (
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/admin"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
 	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
 	cmdutil "github.com/argoproj/argo-cd/v3/cmd/util"
+	"github.com/argoproj/argo-cd/v3/common"
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	arogappsetv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/cli"
 	"github.com/argoproj/argo-cd/v3/util/errors"
 	"github.com/argoproj/argo-cd/v3/util/grpc"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
@@ -37,6 +41,15 @@ var appSetExample = templates.Examples(`
 
 	# Delete an ApplicationSet
 	argocd appset delete APPSETNAME (APPSETNAME...)
+
+	# Promote the next step of the RollingSync strategy of an ApplicationSet
+	argocd appset promote APPSETNAME
+
+	# Show the changes an ApplicationSet stored in a file or at given URL would make to its Applications
+	argocd appset diff <filename or URL>
+
+	# Approve the deletions of Applications exceeding the maxDeletions of an ApplicationSet
+	argocd appset approve-deletion APPSETNAME
 	`)
 
 // NewAppSetCommand returns a new instance of an `argocd appset` command
@@ -55,6 +68,9 @@ func NewAppSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewApplicationSetListCommand(clientOpts))
 	command.AddCommand(NewApplicationSetDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationSetGenerateCommand(clientOpts))
+	command.AddCommand(NewApplicationSetPromoteCommand(clientOpts))
+	command.AddCommand(NewApplicationSetDiffCommand(clientOpts))
+	command.AddCommand(NewApplicationSetApproveDeletionCommand(clientOpts))
 	return command
 }
 
@@ -100,6 +116,12 @@ func NewApplicationSetGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.
 					_ = w.Flush()
 					fmt.Println()
 				}
+				if len(appSet.Status.RolloutSteps) > 0 {
+					w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+					printAppSetRolloutSteps(w, appSet)
+					_ = w.Flush()
+					fmt.Println()
+				}
 				if showParams {
 					printHelmParams(appSet.Spec.Template.Spec.GetSource().Helm)
 				}
@@ -384,6 +406,175 @@ func NewApplicationSetDeleteCommand(clientOpts *argocdclient.ClientOptions) *cob
 	return command
 }
 
+// NewApplicationSetPromoteCommand returns a new instance of an `argocd appset promote` command
+func NewApplicationSetPromoteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var step int32
+	command := &cobra.Command{
+		Use:   "promote APPSETNAME",
+		Short: "Promote a step of the RollingSync strategy of an ApplicationSet",
+		Long:  "Approve a step of the RollingSync strategy requiring approval, or complete a paused or degraded step",
+		Example: templates.Examples(`
+	# Promote the first step awaiting approval, paused or degraded
+	argocd appset promote APPSETNAME
+
+	# Promote the second step
+	argocd appset promote APPSETNAME --step 2
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], "")
+
+			appSet, err := appIf.Promote(ctx, &applicationset.ApplicationSetPromoteRequest{
+				Name:            appSetName,
+				AppsetNamespace: appSetNs,
+				Step:            step,
+			})
+			errors.CheckError(err)
+			fmt.Printf("ApplicationSet '%s' step %s promoted\n", appSet.QualifiedName(), appSet.Annotations[common.AnnotationApplicationSetPromote])
+		},
+	}
+	command.Flags().Int32Var(&step, "step", 0, "Number of the step to promote, starting at 1. Defaults to the first step awaiting approval, paused or degraded")
+	return command
+}
+
+// NewApplicationSetApproveDeletionCommand returns a new instance of an `argocd appset approve-deletion` command
+func NewApplicationSetApproveDeletionCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "approve-deletion APPSETNAME",
+		Short: "Approve the deletions of Applications exceeding the maxDeletions of an ApplicationSet",
+		Long:  "Approve the deletions of Applications paused because they exceed the maxDeletions of the sync policy of an ApplicationSet. The Applications are deleted on the next reconcile of the ApplicationSet.",
+		Example: templates.Examples(`
+	# Approve the deletions of Applications awaiting approval
+	argocd appset approve-deletion APPSETNAME
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], "")
+
+			appSet, err := appIf.ApproveDeletion(ctx, &applicationset.ApplicationSetApproveDeletionRequest{
+				Name:            appSetName,
+				AppsetNamespace: appSetNs,
+			})
+			errors.CheckError(err)
+			fmt.Printf("ApplicationSet '%s' deletions approved (deletion set %s)\n", appSet.QualifiedName(), appSet.Annotations[common.AnnotationApplicationSetApproveDeletion])
+		},
+	}
+	return command
+}
+
+// NewApplicationSetDiffCommand returns a new instance of an `argocd appset diff` command
+func NewApplicationSetDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output       string
+		exitCode     bool
+		diffExitCode int
+	)
+	command := &cobra.Command{
+		Use:   "diff <filename or URL>",
+		Short: "Show the Applications an ApplicationSet would create, update or delete",
+		Long:  "Compare the Applications generated by an ApplicationSet with the live Applications, and show the Applications the ApplicationSet controller would create, update or delete.",
+		Example: templates.Examples(`
+	# Show the changes to the Applications of an ApplicationSet
+	argocd appset diff <filename or URL>
+
+	# Show the changes as JSON, without failing when there are changes
+	argocd appset diff <filename or URL> -o json --exit-code=false
+`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			argocdClient := headless.NewClientOrDie(clientOpts, c)
+			appsets, err := cmdutil.ConstructApplicationSet(args[0])
+			errors.CheckError(err)
+
+			if len(appsets) != 1 {
+				fmt.Printf("Input file must contain one ApplicationSet")
+				os.Exit(1)
+			}
+			appset := appsets[0]
+			if appset.Name == "" {
+				errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("Error comparing apps of ApplicationSet %s. ApplicationSet does not have Name field set", appset))
+			}
+
+			conn, appIf := argocdClient.NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+
+			resp, err := appIf.Diff(ctx, &applicationset.ApplicationSetDiffRequest{ApplicationSet: appset})
+			errors.CheckError(err)
+
+			foundDiffs := false
+			for _, app := range resp.Applications {
+				if app.Action != "unchanged" {
+					foundDiffs = true
+				}
+			}
+
+			switch output {
+			case "yaml", "json":
+				cobra.CheckErr(admin.PrintResources(output, os.Stdout, resp))
+			case "":
+				for _, app := range resp.Applications {
+					if app.Action == "unchanged" {
+						continue
+					}
+					fmt.Printf("\n===== %s Application %s/%s ======\n", app.Action, app.Namespace, app.Name)
+					if app.Action == "conflict" {
+						fmt.Println("An Application which is not owned by the ApplicationSet already exists with this name")
+						continue
+					}
+					live, err := unmarshalApplicationState(app.LiveState)
+					errors.CheckError(err)
+					target, err := unmarshalApplicationState(app.TargetState)
+					errors.CheckError(err)
+					_ = cli.PrintDiff(app.Name, live, target)
+				}
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+
+			if foundDiffs && exitCode {
+				os.Exit(diffExitCode)
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
+	command.Flags().BoolVar(&exitCode, "exit-code", true, "Return non-zero exit code when there is a diff. May also return non-zero exit code if there is an error.")
+	command.Flags().IntVar(&diffExitCode, "diff-exit-code", 1, "Return specified exit code when there is a diff. Typical error code is 20.")
+	return command
+}
+
+// unmarshalApplicationState returns the Application state returned by the diff API, or nil if there is none
+func unmarshalApplicationState(state string) (*unstructured.Unstructured, error) {
+	if state == "" {
+		return nil, nil
+	}
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal([]byte(state), &obj.Object); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
 // Print simple list of application names
 func printApplicationSetNames(apps []arogappsetv1.ApplicationSet) {
 	for _, app := range apps {
@@ -476,6 +667,13 @@ func printAppSetConditions(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
 	}
 }
 
+func printAppSetRolloutSteps(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
+	_, _ = fmt.Fprintf(w, "STEP\tSTATUS\tMESSAGE\tLAST TRANSITION\n")
+	for _, item := range appSet.Status.RolloutSteps {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", item.Step, item.Status, item.Message, item.LastTransitionTime)
+	}
+}
+
 func hasAppSetChanged(appReq, appRes *arogappsetv1.ApplicationSet, upsert bool) bool {
 	// upsert==false, no change occurred from create command
 	if !upsert {
@@ -4,6 +4,7 @@ import (
 	"io"
 	"os"
 	"testing"
+	"text/tabwriter"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -282,3 +283,25 @@ SyncPolicy:         <none>
 		})
 	}
 }
+
+func TestPrintAppSetRolloutSteps(t *testing.T) {
+	appSet := &v1alpha1.ApplicationSet{
+		Status: v1alpha1.ApplicationSetStatus{
+			RolloutSteps: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Status: v1alpha1.RolloutStepStatusHealthy, Message: "Applications of the step are Healthy."},
+				{Step: "2", Status: v1alpha1.RolloutStepStatusAwaitingApproval, Message: "Step requires approval, waiting for it to be promoted."},
+			},
+		},
+	}
+	output, err := captureOutput(func() error {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		printAppSetRolloutSteps(w, appSet)
+		return w.Flush()
+	})
+	require.NoError(t, err)
+	expectation := `STEP  STATUS            MESSAGE                                                 LAST TRANSITION
+1     Healthy           Applications of the step are Healthy.                   <nil>
+2     AwaitingApproval  Step requires approval, waiting for it to be promoted.  <nil>
+`
+	assert.Equal(t, expectation, output)
+}
@@ -3,9 +3,11 @@ package commands
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -34,7 +36,10 @@ argocd proj windows add my-project \
 argocd proj windows delete <project-name> <window-id>
 
 #List project sync windows
-argocd proj windows list <project-name>`,
+argocd proj windows list <project-name>
+
+#List the upcoming periods blocked by the deny sync windows of a project
+argocd proj windows blocked <project-name>`,
 		Run: func(c *cobra.Command, args []string) {
 			c.HelpFunc()(c, args)
 			os.Exit(1)
@@ -43,6 +48,7 @@ argocd proj windows list <project-name>`,
 	roleCommand.AddCommand(NewProjectWindowsDisableManualSyncCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsEnableManualSyncCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsAddWindowCommand(clientOpts))
+	roleCommand.AddCommand(NewProjectWindowsBlockedCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsDeleteCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsListCommand(clientOpts))
 	roleCommand.AddCommand(NewProjectWindowsUpdateCommand(clientOpts))
@@ -151,6 +157,9 @@ func NewProjectWindowsAddWindowCommand(clientOpts *argocdclient.ClientOptions) *
 		timeZone     string
 		andOperator  bool
 		description  string
+		dateRanges   []string
+		calendarCM   string
+		calendarKey  string
 	)
 	command := &cobra.Command{
 		Use:   "add PROJECT",
@@ -174,6 +183,16 @@ argocd proj windows add PROJECT \
     --clusters "prod,staging" \
     --manual-sync \
     --description "Ticket 123"
+
+#Add a deny sync window for the year-end change freeze and the holidays of a calendar
+argocd proj windows add PROJECT \
+    --kind deny \
+    --date-range 2025-12-15/2026-01-05 \
+    --calendar-configmap holidays \
+    --calendar-key holidays.ics \
+    --applications "*" \
+    --time-zone "Europe/Paris" \
+    --description "Change freeze"
 	`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -189,7 +208,20 @@ argocd proj windows add PROJECT \
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
-			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, andOperator, description)
+			var windowDateRanges []v1alpha1.SyncWindowDateRange
+			for _, dateRange := range dateRanges {
+				start, end, ok := strings.Cut(dateRange, "/")
+				if !ok {
+					errors.CheckError(fmt.Errorf("invalid date range '%s': expected START/END", dateRange))
+				}
+				windowDateRanges = append(windowDateRanges, v1alpha1.SyncWindowDateRange{Start: start, End: end})
+			}
+			var calendar *v1alpha1.SyncWindowCalendar
+			if calendarCM != "" || calendarKey != "" {
+				calendar = &v1alpha1.SyncWindowCalendar{ConfigMap: calendarCM, Key: calendarKey}
+			}
+
+			err = proj.Spec.AddWindow(kind, schedule, duration, applications, namespaces, clusters, manualSync, timeZone, andOperator, description, windowDateRanges, calendar)
 			errors.CheckError(err)
 
 			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
@@ -206,10 +238,102 @@ argocd proj windows add PROJECT \
 	command.Flags().StringVar(&timeZone, "time-zone", "UTC", "Time zone of the sync window")
 	command.Flags().BoolVar(&andOperator, "use-and-operator", false, "Use AND operator for matching applications, namespaces and clusters instead of the default OR operator")
 	command.Flags().StringVar(&description, "description", "", `Sync window description`)
+	command.Flags().StringArrayVar(&dateRanges, "date-range", []string{}, "Period during which the sync window is active, as START/END dates or times in the time zone of the window, the end date being included. Can be repeated (e.g. --date-range 2025-12-15/2026-01-05)")
+	command.Flags().StringVar(&calendarCM, "calendar-configmap", "", "ConfigMap holding an iCalendar file whose events the sync window is active during. The ConfigMap must be labeled with app.kubernetes.io/part-of: argocd")
+	command.Flags().StringVar(&calendarKey, "calendar-key", "", "Key of the iCalendar file in the ConfigMap")
 
 	return command
 }
 
+// NewProjectWindowsBlockedCommand returns a new instance of an `argocd proj windows blocked` command
+func NewProjectWindowsBlockedCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		until  string
+		output string
+	)
+	command := &cobra.Command{
+		Use:   "blocked PROJECT",
+		Short: "List the upcoming periods blocked by the deny sync windows of a project",
+		Long:  "List the upcoming periods during which the deny sync windows of a project are active, including the events of the calendars referenced by the windows. Syncs may also be blocked outside of the allow windows.",
+		Example: `
+#List the periods blocked during the next 30 days
+argocd proj windows blocked PROJECT
+
+#List the periods blocked until the end of next week in yaml format
+argocd proj windows blocked PROJECT --until 168h -o yaml`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			projName := args[0]
+			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
+			defer utilio.Close(conn)
+
+			res, err := projIf.ListSyncWindowPeriods(ctx, &projectpkg.SyncWindowPeriodsQuery{Name: projName, Until: until})
+			errors.CheckError(err)
+
+			var windows []*projectpkg.SyncWindowPeriods
+			for _, window := range res.Windows {
+				if window.Window != nil && window.Window.Kind == "deny" {
+					windows = append(windows, window)
+				}
+			}
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(windows, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printSyncWindowPeriods(windows)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVar(&until, "until", "720h", "Duration from now until which the blocked periods are listed, at most a year (e.g. --until 168h)")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// Print table of the periods of the sync windows, ordered by start
+func printSyncWindowPeriods(windows []*projectpkg.SyncWindowPeriods) {
+	type row struct {
+		id     int32
+		window *v1alpha1.SyncWindow
+		period *v1alpha1.SyncWindowPeriod
+	}
+	var rows []row
+	for _, window := range windows {
+		for _, period := range window.Periods {
+			rows = append(rows, row{id: window.Id, window: window.Window, period: period})
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].period.Start.Before(&rows[j].period.Start)
+	})
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	headers := []any{"ID", "START", "END", "DESCRIPTION", "APPLICATIONS", "NAMESPACES", "CLUSTERS", "MANUALSYNC"}
+	fmtStr := strings.Repeat("%s\t", len(headers)) + "\n"
+	fmt.Fprintf(w, fmtStr, headers...)
+	for _, r := range rows {
+		vals := []any{
+			strconv.Itoa(int(r.id)),
+			r.period.Start.Format(time.RFC3339),
+			r.period.End.Format(time.RFC3339),
+			r.period.Description,
+			formatListOutput(r.window.Applications),
+			formatListOutput(r.window.Namespaces),
+			formatListOutput(r.window.Clusters),
+			formatBoolEnabledOutput(r.window.ManualSync),
+		}
+		fmt.Fprintf(w, fmtStr, vals...)
+	}
+	_ = w.Flush()
+}
+
 // NewProjectWindowsDeleteCommand returns a new instance of an `argocd proj windows delete` command
 func NewProjectWindowsDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command := &cobra.Command{
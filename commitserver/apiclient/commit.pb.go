@@ -12,6 +12,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	math "math"
 	math_bits "math/bits"
 )
@@ -41,10 +42,22 @@ type CommitHydratedManifestsRequest struct {
 	// CommitMessage is the commit message to use when committing changes.
 	CommitMessage string `protobuf:"bytes,5,opt,name=commitMessage,proto3" json:"commitMessage,omitempty"`
 	// Paths contains the paths to write hydrated manifests to, along with the manifests and commands to execute.
-	Paths                []*PathDetails `protobuf:"bytes,6,rep,name=paths,proto3" json:"paths,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Paths []*PathDetails `protobuf:"bytes,6,rep,name=paths,proto3" json:"paths,omitempty"`
+	// PullRequest, if set, opens a pull request from the target branch to the sync branch after pushing the commit.
+	PullRequest *v1alpha1.HydratePullRequest `protobuf:"bytes,7,opt,name=pullRequest,proto3" json:"pullRequest,omitempty"`
+	// DryRun, if set, writes the hydrated manifests without committing or pushing them, and returns the diff against the
+	// current content of the target branch instead.
+	DryRun bool `protobuf:"varint,8,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// HydrationRequestedAt is the time hydration was first requested for any of the apps being hydrated. It is used to
+	// observe how long hydrations are queued, e.g. while dry commits are being coalesced.
+	HydrationRequestedAt *v1.Time `protobuf:"bytes,9,opt,name=hydrationRequestedAt,proto3" json:"hydrationRequestedAt,omitempty"`
+	// DrySourcePaths contains the paths of the dry sources in the repository. It is only set if dry commits are coalesced,
+	// in which case the dry commits which changed any of them since the previous hydration are listed in the hydrated
+	// commit.
+	DrySourcePaths       []string `protobuf:"bytes,10,rep,name=drySourcePaths,proto3" json:"drySourcePaths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CommitHydratedManifestsRequest) Reset()         { *m = CommitHydratedManifestsRequest{} }
@@ -122,6 +135,34 @@ func (m *CommitHydratedManifestsRequest) GetPaths() []*PathDetails {
 	return nil
 }
 
+func (m *CommitHydratedManifestsRequest) GetPullRequest() *v1alpha1.HydratePullRequest {
+	if m != nil {
+		return m.PullRequest
+	}
+	return nil
+}
+
+func (m *CommitHydratedManifestsRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *CommitHydratedManifestsRequest) GetHydrationRequestedAt() *v1.Time {
+	if m != nil {
+		return m.HydrationRequestedAt
+	}
+	return nil
+}
+
+func (m *CommitHydratedManifestsRequest) GetDrySourcePaths() []string {
+	if m != nil {
+		return m.DrySourcePaths
+	}
+	return nil
+}
+
 // PathDetails holds information about hydrated manifests to be written to a particular path in the hydrated manifests
 // commit.
 type PathDetails struct {
@@ -130,10 +171,14 @@ type PathDetails struct {
 	// Manifests contains the manifests to write to the path.
 	Manifests []*HydratedManifestDetails `protobuf:"bytes,2,rep,name=manifests,proto3" json:"manifests,omitempty"`
 	// Commands contains the commands executed when hydrating the manifests.
-	Commands             []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Commands []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Layout specifies how the manifests are written to the path. Defaults to a single manifest.yaml file.
+	Layout *v1alpha1.HydratedManifestLayout `protobuf:"bytes,4,opt,name=layout,proto3" json:"layout,omitempty"`
+	// Sources contains the dry sources the manifests were hydrated from, if the dry source has multiple sources.
+	Sources              []*DrySourceDetails `protobuf:"bytes,5,rep,name=sources,proto3" json:"sources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *PathDetails) Reset()         { *m = PathDetails{} }
@@ -190,6 +235,105 @@ func (m *PathDetails) GetCommands() []string {
 	return nil
 }
 
+func (m *PathDetails) GetLayout() *v1alpha1.HydratedManifestLayout {
+	if m != nil {
+		return m.Layout
+	}
+	return nil
+}
+
+func (m *PathDetails) GetSources() []*DrySourceDetails {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+// DrySourceDetails contains information about a dry source the manifests of a path were hydrated from.
+type DrySourceDetails struct {
+	// RepoURL is the URL of the repository of the source.
+	RepoURL string `protobuf:"bytes,1,opt,name=repoURL,proto3" json:"repoURL,omitempty"`
+	// Path is the path of the source within the repository.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Chart is the name of the Helm chart of the source.
+	Chart string `protobuf:"bytes,3,opt,name=chart,proto3" json:"chart,omitempty"`
+	// TargetRevision is the revision of the source as specified in the Application.
+	TargetRevision string `protobuf:"bytes,4,opt,name=targetRevision,proto3" json:"targetRevision,omitempty"`
+	// Revision is the resolved revision of the source, e.g. a commit SHA or a chart version.
+	Revision             string   `protobuf:"bytes,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrySourceDetails) Reset()         { *m = DrySourceDetails{} }
+func (m *DrySourceDetails) String() string { return proto.CompactTextString(m) }
+func (*DrySourceDetails) ProtoMessage()    {}
+func (*DrySourceDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{2}
+}
+func (m *DrySourceDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrySourceDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrySourceDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrySourceDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrySourceDetails.Merge(m, src)
+}
+func (m *DrySourceDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrySourceDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrySourceDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrySourceDetails proto.InternalMessageInfo
+
+func (m *DrySourceDetails) GetRepoURL() string {
+	if m != nil {
+		return m.RepoURL
+	}
+	return ""
+}
+
+func (m *DrySourceDetails) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *DrySourceDetails) GetChart() string {
+	if m != nil {
+		return m.Chart
+	}
+	return ""
+}
+
+func (m *DrySourceDetails) GetTargetRevision() string {
+	if m != nil {
+		return m.TargetRevision
+	}
+	return ""
+}
+
+func (m *DrySourceDetails) GetRevision() string {
+	if m != nil {
+		return m.Revision
+	}
+	return ""
+}
+
 // ManifestDetails contains the hydrated manifests.
 type HydratedManifestDetails struct {
 	// ManifestJSON is the hydrated manifest as JSON.
@@ -203,7 +347,7 @@ func (m *HydratedManifestDetails) Reset()         { *m = HydratedManifestDetails
 func (m *HydratedManifestDetails) String() string { return proto.CompactTextString(m) }
 func (*HydratedManifestDetails) ProtoMessage()    {}
 func (*HydratedManifestDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf3a3abbc35e3069, []int{2}
+	return fileDescriptor_cf3a3abbc35e3069, []int{3}
 }
 func (m *HydratedManifestDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,7 +386,11 @@ func (m *HydratedManifestDetails) GetManifestJSON() string {
 // ManifestsResponse is the response to the ManifestsRequest.
 type CommitHydratedManifestsResponse struct {
 	// HydratedSha is the commit SHA of the hydrated manifests commit.
-	HydratedSha          string   `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	HydratedSha string `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	// PullRequestURL is the URL of the pull request opened or updated for the commit, if a pull request was requested.
+	PullRequestURL string `protobuf:"bytes,2,opt,name=pullRequestURL,proto3" json:"pullRequestURL,omitempty"`
+	// Diff is the diff of the hydrated manifests against the current content of the target branch. Only set for dry runs.
+	Diff                 string   `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -252,7 +400,7 @@ func (m *CommitHydratedManifestsResponse) Reset()         { *m = CommitHydratedM
 func (m *CommitHydratedManifestsResponse) String() string { return proto.CompactTextString(m) }
 func (*CommitHydratedManifestsResponse) ProtoMessage()    {}
 func (*CommitHydratedManifestsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cf3a3abbc35e3069, []int{3}
+	return fileDescriptor_cf3a3abbc35e3069, []int{4}
 }
 func (m *CommitHydratedManifestsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,9 +436,24 @@ func (m *CommitHydratedManifestsResponse) GetHydratedSha() string {
 	return ""
 }
 
+func (m *CommitHydratedManifestsResponse) GetPullRequestURL() string {
+	if m != nil {
+		return m.PullRequestURL
+	}
+	return ""
+}
+
+func (m *CommitHydratedManifestsResponse) GetDiff() string {
+	if m != nil {
+		return m.Diff
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CommitHydratedManifestsRequest)(nil), "CommitHydratedManifestsRequest")
 	proto.RegisterType((*PathDetails)(nil), "PathDetails")
+	proto.RegisterType((*DrySourceDetails)(nil), "DrySourceDetails")
 	proto.RegisterType((*HydratedManifestDetails)(nil), "HydratedManifestDetails")
 	proto.RegisterType((*CommitHydratedManifestsResponse)(nil), "CommitHydratedManifestsResponse")
 }
@@ -298,35 +461,50 @@ func init() {
 func init() { proto.RegisterFile("commitserver/commit/commit.proto", fileDescriptor_cf3a3abbc35e3069) }
 
 var fileDescriptor_cf3a3abbc35e3069 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xe5, 0x24, 0x8d, 0xc8, 0xa4, 0xbd, 0xec, 0x81, 0x5a, 0x39, 0xb8, 0x96, 0xc5, 0x21,
-	0x17, 0xd6, 0x6a, 0x22, 0xb8, 0x71, 0x69, 0x38, 0x54, 0x88, 0x02, 0x72, 0x6e, 0xa8, 0x12, 0xda,
-	0xae, 0x07, 0x7b, 0x69, 0xec, 0x5d, 0x76, 0x37, 0x96, 0xf2, 0x3e, 0x3c, 0x0c, 0x47, 0x1e, 0x01,
-	0xe5, 0x49, 0x90, 0xd7, 0x36, 0x8d, 0x91, 0x42, 0x4e, 0x9e, 0xf9, 0x67, 0xf4, 0xcd, 0xe8, 0xf7,
-	0x2c, 0x84, 0x5c, 0x16, 0x85, 0xb0, 0x06, 0x75, 0x85, 0x3a, 0x6e, 0x92, 0xf6, 0x43, 0x95, 0x96,
-	0x56, 0xce, 0xde, 0x67, 0xc2, 0xe6, 0xdb, 0x07, 0xca, 0x65, 0x11, 0x33, 0x9d, 0x49, 0xa5, 0xe5,
-	0x37, 0x17, 0xbc, 0xe4, 0x69, 0x5c, 0x2d, 0x63, 0xf5, 0x98, 0xc5, 0x4c, 0x09, 0x13, 0x33, 0xa5,
-	0x36, 0x82, 0x33, 0x2b, 0x64, 0x19, 0x57, 0xd7, 0x6c, 0xa3, 0x72, 0x76, 0x1d, 0x67, 0x58, 0xa2,
-	0x66, 0x16, 0xd3, 0x86, 0x16, 0xfd, 0x18, 0x40, 0xb0, 0x72, 0xf8, 0xdb, 0x5d, 0xea, 0x0a, 0x77,
-	0xac, 0x14, 0x5f, 0xd1, 0x58, 0x93, 0xe0, 0xf7, 0x2d, 0x1a, 0x4b, 0xee, 0x61, 0xa4, 0x51, 0x49,
-	0xdf, 0x0b, 0xbd, 0xf9, 0x74, 0x71, 0x4b, 0x9f, 0xe6, 0xd3, 0x6e, 0xbe, 0x0b, 0xbe, 0xf0, 0x94,
-	0x56, 0x4b, 0xaa, 0x1e, 0x33, 0x5a, 0xcf, 0xa7, 0x07, 0xf3, 0x69, 0x37, 0x9f, 0x26, 0xa8, 0xa4,
-	0x11, 0x56, 0xea, 0x5d, 0xe2, 0xa8, 0x24, 0x00, 0x30, 0xbb, 0x92, 0xdf, 0x68, 0x56, 0xf2, 0xdc,
-	0x1f, 0x84, 0xde, 0x7c, 0x92, 0x1c, 0x28, 0x24, 0x82, 0x73, 0xcb, 0x74, 0x86, 0xb6, 0xed, 0x18,
-	0xba, 0x8e, 0x9e, 0x46, 0x9e, 0xc3, 0x38, 0xd5, 0xbb, 0x75, 0xce, 0xfc, 0x91, 0xab, 0xb6, 0x19,
-	0x79, 0x01, 0x17, 0x8d, 0x75, 0x77, 0x68, 0x0c, 0xcb, 0xd0, 0x3f, 0x73, 0xe5, 0xbe, 0x48, 0x22,
-	0x38, 0x53, 0xcc, 0xe6, 0xc6, 0x1f, 0x87, 0xc3, 0xf9, 0x74, 0x71, 0x4e, 0x3f, 0x31, 0x9b, 0xbf,
-	0x45, 0xcb, 0xc4, 0xc6, 0x24, 0x4d, 0x29, 0xda, 0xc2, 0xf4, 0x40, 0x25, 0x04, 0x46, 0xb5, 0xee,
-	0x2c, 0x99, 0x24, 0x2e, 0x26, 0xaf, 0x61, 0x52, 0x74, 0xd6, 0xf9, 0x03, 0x87, 0xf2, 0xe9, 0xbf,
-	0xa6, 0x76, 0xd8, 0xa7, 0x56, 0x32, 0x83, 0x67, 0xf5, 0x3e, 0xac, 0x4c, 0x8d, 0x3f, 0x0c, 0x87,
-	0xf3, 0x49, 0xf2, 0x37, 0x8f, 0xde, 0xc0, 0xe5, 0x11, 0x42, 0xed, 0x4b, 0xc7, 0x78, 0xb7, 0xfe,
-	0xf8, 0xa1, 0x5d, 0xa5, 0xa7, 0x45, 0x2b, 0xb8, 0x3a, 0xfa, 0x6f, 0x8d, 0x92, 0xa5, 0x41, 0x12,
-	0xc2, 0x34, 0x6f, 0x8b, 0xb5, 0x7f, 0x0d, 0xe5, 0x50, 0x5a, 0x14, 0x70, 0xd1, 0x40, 0xd6, 0xa8,
-	0x2b, 0xc1, 0x91, 0xdc, 0xc3, 0xe5, 0x11, 0x2a, 0xb9, 0xa2, 0xff, 0xbf, 0xa5, 0x59, 0x48, 0x4f,
-	0x2c, 0x74, 0xb3, 0xfa, 0xb9, 0x0f, 0xbc, 0x5f, 0xfb, 0xc0, 0xfb, 0xbd, 0x0f, 0xbc, 0xcf, 0xaf,
-	0x4e, 0x1c, 0x7b, 0xef, 0xb5, 0x30, 0x25, 0xf8, 0x46, 0x60, 0x69, 0x1f, 0xc6, 0xee, 0xb8, 0x97,
-	0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x83, 0xe8, 0xd7, 0x4e, 0x03, 0x00, 0x00,
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x6e, 0x13, 0x3b,
+	0x18, 0x86, 0x35, 0xf9, 0x6b, 0xe3, 0xb4, 0x47, 0xe7, 0x58, 0xd5, 0xe9, 0xa8, 0x8b, 0x74, 0x14,
+	0x1d, 0x1d, 0x45, 0x20, 0x3c, 0x6a, 0x0a, 0x88, 0x0d, 0x0b, 0xda, 0x2e, 0x2a, 0xd4, 0x42, 0xe4,
+	0x96, 0x0d, 0xaa, 0x40, 0xee, 0x8c, 0x9b, 0x31, 0x9d, 0x19, 0x1b, 0xdb, 0x19, 0x29, 0x2b, 0x6e,
+	0x81, 0x35, 0x57, 0xc2, 0x25, 0xb0, 0xe4, 0x12, 0x50, 0xaf, 0x04, 0xd9, 0xe3, 0x49, 0x26, 0x81,
+	0xd2, 0x05, 0xac, 0xe2, 0xef, 0x67, 0x3e, 0xbf, 0x7e, 0xfc, 0xc6, 0x20, 0x88, 0x78, 0x96, 0x31,
+	0xad, 0xa8, 0x2c, 0xa8, 0x0c, 0xcb, 0xc0, 0xfd, 0x20, 0x21, 0xb9, 0xe6, 0x3b, 0x27, 0x13, 0xa6,
+	0x93, 0xe9, 0x25, 0x8a, 0x78, 0x16, 0x12, 0x39, 0xe1, 0x42, 0xf2, 0x77, 0x76, 0xf1, 0x20, 0x8a,
+	0xc3, 0x62, 0x3f, 0x14, 0xd7, 0x93, 0x90, 0x08, 0xa6, 0x42, 0x22, 0x44, 0xca, 0x22, 0xa2, 0x19,
+	0xcf, 0xc3, 0x62, 0x8f, 0xa4, 0x22, 0x21, 0x7b, 0xe1, 0x84, 0xe6, 0x54, 0x12, 0x4d, 0x63, 0x37,
+	0xed, 0xe1, 0xf5, 0x13, 0x85, 0x18, 0x37, 0x5f, 0x64, 0x24, 0x4a, 0x58, 0x4e, 0xe5, 0x6c, 0x31,
+	0x22, 0xa3, 0x9a, 0x84, 0xc5, 0x0f, 0x5f, 0x0d, 0x3e, 0xb7, 0x40, 0xff, 0xd0, 0x8a, 0x3a, 0x9e,
+	0xc5, 0xb6, 0x70, 0x4a, 0x72, 0x76, 0x45, 0x95, 0x56, 0x98, 0xbe, 0x9f, 0x52, 0xa5, 0xe1, 0x05,
+	0x68, 0x49, 0x2a, 0xb8, 0xef, 0x05, 0xde, 0xb0, 0x37, 0x3a, 0x46, 0x0b, 0xd5, 0xa8, 0x52, 0x6d,
+	0x17, 0x6f, 0xa3, 0x18, 0x15, 0xfb, 0x48, 0x5c, 0x4f, 0x90, 0xd9, 0x12, 0xd5, 0x54, 0xa3, 0x4a,
+	0x35, 0xc2, 0x54, 0x70, 0xc5, 0x34, 0x97, 0x33, 0x6c, 0xa7, 0xc2, 0x3e, 0x00, 0x6a, 0x96, 0x47,
+	0x07, 0x92, 0xe4, 0x51, 0xe2, 0x37, 0x02, 0x6f, 0xd8, 0xc5, 0xb5, 0x0c, 0x1c, 0x80, 0x0d, 0x4d,
+	0xe4, 0x84, 0x6a, 0xd7, 0xd1, 0xb4, 0x1d, 0x4b, 0x39, 0xf8, 0x2f, 0xe8, 0xc4, 0x72, 0x76, 0x96,
+	0x10, 0xbf, 0x65, 0xab, 0x2e, 0x82, 0xff, 0x81, 0xcd, 0x12, 0xf8, 0x29, 0x55, 0x8a, 0x4c, 0xa8,
+	0xdf, 0xb6, 0xe5, 0xe5, 0x24, 0x1c, 0x80, 0xb6, 0x20, 0x3a, 0x51, 0x7e, 0x27, 0x68, 0x0e, 0x7b,
+	0xa3, 0x0d, 0x34, 0x26, 0x3a, 0x39, 0xa2, 0x9a, 0xb0, 0x54, 0xe1, 0xb2, 0x04, 0x25, 0xe8, 0x89,
+	0x69, 0x9a, 0x3a, 0x24, 0xfe, 0x9a, 0x45, 0x31, 0xfe, 0x3d, 0x14, 0x0e, 0xf8, 0x78, 0x31, 0x17,
+	0xd7, 0x37, 0x71, 0xa7, 0xc2, 0xd3, 0xdc, 0x5f, 0x0f, 0xbc, 0xe1, 0x3a, 0x76, 0x11, 0x7c, 0x03,
+	0xb6, 0x12, 0xfb, 0x29, 0xe3, 0xb9, 0xeb, 0xa5, 0xf1, 0x33, 0xed, 0x77, 0xad, 0xa8, 0x7b, 0xa8,
+	0xf4, 0x01, 0xaa, 0xfb, 0x60, 0xa1, 0xc4, 0xf8, 0x00, 0x15, 0x7b, 0xe8, 0x9c, 0x65, 0x14, 0xff,
+	0x74, 0x0e, 0xfc, 0x1f, 0xfc, 0x65, 0xf8, 0xf1, 0xa9, 0x8c, 0xe8, 0xd8, 0x82, 0x01, 0x41, 0x73,
+	0xd8, 0xc5, 0x2b, 0xd9, 0xc1, 0xc7, 0x06, 0xe8, 0xd5, 0x50, 0x41, 0x08, 0x5a, 0x06, 0x96, 0xf5,
+	0x49, 0x17, 0xdb, 0x35, 0x7c, 0x0c, 0xba, 0x59, 0xe5, 0x27, 0xbf, 0x61, 0xf9, 0xfa, 0x68, 0xd5,
+	0x69, 0x15, 0xeb, 0x45, 0x2b, 0xdc, 0x01, 0xeb, 0xe6, 0x92, 0x48, 0x1e, 0x2b, 0xbf, 0x69, 0x77,
+	0x9f, 0xc7, 0x30, 0x05, 0x9d, 0x94, 0xcc, 0xf8, 0x54, 0xdb, 0xdb, 0xee, 0x8d, 0xce, 0xff, 0xc8,
+	0x35, 0xcc, 0xd5, 0x9c, 0xd8, 0xd9, 0xd8, 0xed, 0x01, 0xef, 0x83, 0x35, 0x65, 0x0f, 0xad, 0xfc,
+	0xb6, 0xd5, 0xff, 0x0f, 0x3a, 0xaa, 0x38, 0x54, 0xc2, 0xab, 0x8e, 0xc1, 0x27, 0x0f, 0xfc, 0xbd,
+	0x5a, 0x85, 0x3e, 0x58, 0x33, 0x4e, 0x7f, 0x85, 0x4f, 0x1c, 0x9a, 0x2a, 0x9c, 0x13, 0x6b, 0xd4,
+	0x88, 0x6d, 0x81, 0x76, 0x94, 0x10, 0xa9, 0x9d, 0xd1, 0xcb, 0xc0, 0xdc, 0x49, 0xe9, 0x78, 0x4c,
+	0x0b, 0xa6, 0x18, 0xcf, 0x9d, 0xd3, 0x57, 0xb2, 0x86, 0x9b, 0xac, 0x3a, 0x4a, 0xb3, 0xcf, 0xe3,
+	0xc1, 0x53, 0xb0, 0x7d, 0x0b, 0x79, 0xf3, 0x27, 0xab, 0xd8, 0x3f, 0x3f, 0x7b, 0xf9, 0xc2, 0xe9,
+	0x5c, 0xca, 0x0d, 0x3e, 0x80, 0xdd, 0x5b, 0x1f, 0x0a, 0x25, 0x78, 0xae, 0x28, 0x0c, 0x40, 0x2f,
+	0x71, 0x45, 0xf3, 0x67, 0x2c, 0xa7, 0xd4, 0x53, 0xe6, 0x1c, 0x35, 0x8b, 0x1b, 0x24, 0xe5, 0xd9,
+	0x57, 0xb2, 0x86, 0x4c, 0xcc, 0xae, 0xae, 0x1c, 0x04, 0xbb, 0x1e, 0x65, 0x60, 0xb3, 0x14, 0x70,
+	0x46, 0x65, 0xc1, 0x22, 0x0a, 0x2f, 0xc0, 0xf6, 0x2d, 0x8a, 0xe0, 0x2e, 0xfa, 0xf5, 0xa3, 0xb6,
+	0x13, 0xa0, 0x3b, 0x0e, 0x73, 0x70, 0xf8, 0xe5, 0xa6, 0xef, 0x7d, 0xbd, 0xe9, 0x7b, 0xdf, 0x6e,
+	0xfa, 0xde, 0xeb, 0x47, 0x77, 0xbc, 0xd5, 0x4b, 0x8f, 0x3d, 0x11, 0x2c, 0x4a, 0x19, 0xcd, 0xf5,
+	0x65, 0xc7, 0xbe, 0xb2, 0xfb, 0xdf, 0x07, 0x00, 0x52, 0xdb, 0xd9, 0xd9, 0x0d, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -435,6 +613,49 @@ func (m *CommitHydratedManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DrySourcePaths) > 0 {
+		for iNdEx := len(m.DrySourcePaths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DrySourcePaths[iNdEx])
+			copy(dAtA[i:], m.DrySourcePaths[iNdEx])
+			i = encodeVarintCommit(dAtA, i, uint64(len(m.DrySourcePaths[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.HydrationRequestedAt != nil {
+		{
+			size, err := m.HydrationRequestedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.Paths) > 0 {
 		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -516,6 +737,32 @@ func (m *PathDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCommit(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Layout != nil {
+		{
+			size, err := m.Layout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -549,6 +796,68 @@ func (m *PathDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DrySourceDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrySourceDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrySourceDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Revision) > 0 {
+		i -= len(m.Revision)
+		copy(dAtA[i:], m.Revision)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Revision)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TargetRevision) > 0 {
+		i -= len(m.TargetRevision)
+		copy(dAtA[i:], m.TargetRevision)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.TargetRevision)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Chart) > 0 {
+		i -= len(m.Chart)
+		copy(dAtA[i:], m.Chart)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Chart)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RepoURL) > 0 {
+		i -= len(m.RepoURL)
+		copy(dAtA[i:], m.RepoURL)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.RepoURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *HydratedManifestDetails) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -607,6 +916,20 @@ func (m *CommitHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Diff) > 0 {
+		i -= len(m.Diff)
+		copy(dAtA[i:], m.Diff)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Diff)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PullRequestURL) > 0 {
+		i -= len(m.PullRequestURL)
+		copy(dAtA[i:], m.PullRequestURL)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.PullRequestURL)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.HydratedSha) > 0 {
 		i -= len(m.HydratedSha)
 		copy(dAtA[i:], m.HydratedSha)
@@ -660,6 +983,23 @@ func (m *CommitHydratedManifestsRequest) Size() (n int) {
 			n += 1 + l + sovCommit(uint64(l))
 		}
 	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.HydrationRequestedAt != nil {
+		l = m.HydrationRequestedAt.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if len(m.DrySourcePaths) > 0 {
+		for _, s := range m.DrySourcePaths {
+			l = len(s)
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -688,6 +1028,48 @@ func (m *PathDetails) Size() (n int) {
 			n += 1 + l + sovCommit(uint64(l))
 		}
 	}
+	if m.Layout != nil {
+		l = m.Layout.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DrySourceDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Chart)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.TargetRevision)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -720,6 +1102,14 @@ func (m *CommitHydratedManifestsResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCommit(uint64(l))
 	}
+	l = len(m.PullRequestURL)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Diff)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -959,21 +1349,145 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCommit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PullRequest == nil {
+				m.PullRequest = &v1alpha1.HydratePullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydrationRequestedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HydrationRequestedAt == nil {
+				m.HydrationRequestedAt = &v1.Time{}
+			}
+			if err := m.HydrationRequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySourcePaths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrySourcePaths = append(m.DrySourcePaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
 
 	if iNdEx > l {
@@ -1108,6 +1622,287 @@ func (m *PathDetails) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Layout == nil {
+				m.Layout = &v1alpha1.HydratedManifestLayout{}
+			}
+			if err := m.Layout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, &DrySourceDetails{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrySourceDetails) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrySourceDetails: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrySourceDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chart = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
@@ -1274,6 +2069,70 @@ func (m *CommitHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.HydratedSha = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullRequestURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
+	"slices"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -17,24 +20,31 @@ import (
 
 // Service is the service that handles commit requests.
 type Service struct {
-	gitCredsStore     git.CredsStore
-	metricsServer     *metrics.Server
-	repoClientFactory RepoClientFactory
+	gitCredsStore             git.CredsStore
+	metricsServer             *metrics.Server
+	repoClientFactory         RepoClientFactory
+	pullRequestServiceFactory PullRequestServiceFactory
+	// signingKey is the key hydrated commits are signed with. Commits are not signed if nil.
+	signingKey *SigningKey
 }
 
-// NewService returns a new instance of the commit service.
-func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *Service {
+// NewService returns a new instance of the commit service. If signingKey is not nil, all commits are signed with it.
+func NewService(gitCredsStore git.CredsStore, metricsServer *metrics.Server, signingKey *SigningKey) *Service {
 	return &Service{
-		gitCredsStore:     gitCredsStore,
-		metricsServer:     metricsServer,
-		repoClientFactory: NewRepoClientFactory(gitCredsStore, metricsServer),
+		gitCredsStore:             gitCredsStore,
+		metricsServer:             metricsServer,
+		repoClientFactory:         NewRepoClientFactory(gitCredsStore, metricsServer),
+		pullRequestServiceFactory: NewPullRequestServiceFactory(),
+		signingKey:                signingKey,
 	}
 }
 
 // CommitHydratedManifests handles a commit request. It clones the repository, checks out the sync branch, checks out
 // the target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and
-// pushes the changes. It returns the hydrated revision SHA and an error if one occurred.
-func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
+// pushes the changes. If requested, it then opens a pull request from the target branch to the sync branch. It returns
+// the hydrated revision SHA, the pull request URL, and an error if one occurred. Dry runs return the diff of the
+// changes instead of committing them.
+func (s *Service) CommitHydratedManifests(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.CommitHydratedManifestsResponse, error) {
 	// This method is intentionally short. It's a wrapper around handleCommitRequest that adds metrics and logging.
 	// Keep logic here minimal and put most of the logic in handleCommitRequest.
 	startTime := time.Now()
@@ -46,6 +56,10 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 		repoURL = r.Repo.Repo
 	}
 
+	if r.HydrationRequestedAt != nil && !r.DryRun {
+		s.metricsServer.ObserveHydrationQueueDuration(repoURL, startTime.Sub(r.HydrationRequestedAt.Time))
+	}
+
 	var err error
 	s.metricsServer.IncPendingCommitRequest(repoURL)
 	defer func() {
@@ -60,42 +74,62 @@ func (s *Service) CommitHydratedManifests(_ context.Context, r *apiclient.Commit
 
 	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "drySHA": r.DrySha})
 
-	out, sha, err := s.handleCommitRequest(logCtx, r)
+	var out, sha, pullRequestURL, diff string
+	if r.DryRun {
+		out, diff, err = s.handleDryRunRequest(logCtx, r)
+	} else {
+		out, sha, pullRequestURL, err = s.handleCommitRequest(ctx, logCtx, r)
+	}
 	if err != nil {
 		logCtx.WithError(err).WithField("output", out).Error("failed to handle commit request")
 
-		// No need to wrap this error, sufficient context is build in handleCommitRequest.
+		// No need to wrap this error, sufficient context is build in handleCommitRequest and handleDryRunRequest.
 		return &apiclient.CommitHydratedManifestsResponse{}, err
 	}
 
 	logCtx.Info("Successfully handled commit request")
 	return &apiclient.CommitHydratedManifestsResponse{
-		HydratedSha: sha,
+		HydratedSha:    sha,
+		PullRequestURL: pullRequestURL,
+		Diff:           diff,
 	}, nil
 }
 
-// handleCommitRequest handles the commit request. It clones the repository, checks out the sync branch, checks out the
-// target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and pushes
-// the changes. It returns the output of the git commands and an error if one occurred.
-func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, error) {
+// validateCommitRequest validates the fields of a commit request which are required to commit to the repository.
+func validateCommitRequest(r *apiclient.CommitHydratedManifestsRequest) error {
 	if r.Repo == nil {
-		return "", "", errors.New("repo is required")
+		return errors.New("repo is required")
 	}
 	if r.Repo.Repo == "" {
-		return "", "", errors.New("repo URL is required")
+		return errors.New("repo URL is required")
 	}
 	if r.TargetBranch == "" {
-		return "", "", errors.New("target branch is required")
+		return errors.New("target branch is required")
 	}
 	if r.SyncBranch == "" {
-		return "", "", errors.New("sync branch is required")
+		return errors.New("sync branch is required")
+	}
+	if r.PullRequest != nil && r.TargetBranch == r.SyncBranch {
+		return errors.New("target branch must differ from the sync branch to open a pull request")
+	}
+	return nil
+}
+
+// handleCommitRequest handles the commit request. It clones the repository, checks out the sync branch, checks out the
+// target branch, clears the repository contents, writes the manifests to the repository, commits the changes, and pushes
+// the changes. If a pull request is requested, the target branch is instead recreated from the sync branch and
+// force-pushed, and a pull request is opened from it to the sync branch unless one is already open. It returns the
+// output of the git commands, the commit SHA, the pull request URL, and an error if one occurred.
+func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, string, error) {
+	if err := validateCommitRequest(r); err != nil {
+		return "", "", "", err
 	}
 
 	logCtx = logCtx.WithField("repo", r.Repo.Repo)
 	logCtx.Debug("Initiating git client")
 	gitClient, dirPath, cleanup, err := s.initGitClient(logCtx, r)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to init git client: %w", err)
+		return "", "", "", fmt.Errorf("failed to init git client: %w", err)
 	}
 	defer cleanup()
 
@@ -103,40 +137,235 @@ func (s *Service) handleCommitRequest(logCtx *log.Entry, r *apiclient.CommitHydr
 	var out string
 	out, err = gitClient.CheckoutOrOrphan(r.SyncBranch, false)
 	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout sync branch: %w", err)
+		return out, "", "", fmt.Errorf("failed to checkout sync branch: %w", err)
 	}
 
-	logCtx.Debugf("Checking out target branch %s", r.TargetBranch)
-	out, err = gitClient.CheckoutOrNew(r.TargetBranch, r.SyncBranch, false)
-	if err != nil {
-		return out, "", fmt.Errorf("failed to checkout target branch: %w", err)
+	targetBranch := r.TargetBranch
+	var syncSHA string
+	if r.PullRequest != nil {
+		// The target branch is recreated from the sync branch for every dry commit, so that the single pull request
+		// opened from it only holds the latest hydrated manifests, without the history of the pull requests merged
+		// before it, e.g. when they are squash merged. Remember the sync branch SHA to find out later whether there is
+		// anything to open a pull request for.
+		syncSHA, err = gitClient.CommitSHA()
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to get sync branch commit SHA: %w", err)
+		}
+	} else {
+		logCtx.Debugf("Checking out target branch %s", targetBranch)
+		out, err = gitClient.CheckoutOrNew(targetBranch, r.SyncBranch, false)
+		if err != nil {
+			return out, "", "", fmt.Errorf("failed to checkout target branch: %w", err)
+		}
 	}
 
+	drySHAs := getIncludedDrySHAs(logCtx, gitClient, dirPath, r.DrySha, r.DrySourcePaths)
+
 	logCtx.Debug("Clearing repo contents")
 	out, err = gitClient.RemoveContents()
 	if err != nil {
-		return out, "", fmt.Errorf("failed to clear repo: %w", err)
+		return out, "", "", fmt.Errorf("failed to clear repo: %w", err)
 	}
 
 	logCtx.Debug("Writing manifests")
-	err = WriteForPaths(dirPath, r.Repo.Repo, r.DrySha, r.Paths)
+	err = WriteForPaths(dirPath, r.Repo.Repo, r.DrySha, drySHAs, r.Paths)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to write manifests: %w", err)
+		return "", "", "", fmt.Errorf("failed to write manifests: %w", err)
 	}
 
 	logCtx.Debug("Committing and pushing changes")
-	out, err = gitClient.CommitAndPush(r.TargetBranch, r.CommitMessage)
+	if r.PullRequest != nil {
+		out, err = gitClient.CommitAndForcePush(targetBranch, getCommitMessage(r.CommitMessage, drySHAs))
+	} else {
+		out, err = gitClient.CommitAndPush(targetBranch, getCommitMessage(r.CommitMessage, drySHAs))
+	}
 	if err != nil {
-		return out, "", fmt.Errorf("failed to commit and push: %w", err)
+		return out, "", "", fmt.Errorf("failed to commit and push: %w", err)
+	}
+	if len(drySHAs) > 1 {
+		s.metricsServer.AddCoalescedDryCommits(r.Repo.Repo, len(drySHAs)-1)
 	}
 
 	logCtx.Debug("Getting commit SHA")
 	sha, err := gitClient.CommitSHA()
 	if err != nil {
-		return "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+		return "", "", "", fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+
+	if r.PullRequest == nil {
+		return "", sha, "", nil
 	}
 
-	return "", sha, nil
+	changedFiles, err := gitClient.ChangedFiles(syncSHA, sha)
+	if err != nil {
+		return "", sha, "", fmt.Errorf("failed to compare target branch with sync branch: %w", err)
+	}
+	// The hydrator metadata and READMEs change with every dry commit, so only the manifests are compared.
+	if !slices.ContainsFunc(changedFiles, isManifestPath) {
+		logCtx.Debug("Manifests of the target branch do not differ from the sync branch, not opening a pull request")
+		return "", sha, "", nil
+	}
+
+	logCtx.Debugf("Opening pull request from %s to %s", targetBranch, r.SyncBranch)
+	pullRequestURL, err := s.openPullRequest(ctx, r, targetBranch)
+	if err != nil {
+		return "", sha, "", fmt.Errorf("failed to open pull request: %w", err)
+	}
+
+	return "", sha, pullRequestURL, nil
+}
+
+// handleDryRunRequest handles a dry run of a commit request. It clones the repository, checks out the target branch (or
+// the branch a pull request would be opened from), or the sync branch if it does not exist yet, clears the repository contents, and writes the manifests to
+// the repository like handleCommitRequest does, with the data of Secrets masked on both sides. Instead of committing the
+// changes, it returns the output of the git commands, the diff of the changes, and an error if one occurred. Nothing is
+// pushed to the repository.
+func (s *Service) handleDryRunRequest(logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, string, error) {
+	if err := validateCommitRequest(r); err != nil {
+		return "", "", err
+	}
+
+	logCtx = logCtx.WithFields(log.Fields{"repo": r.Repo.Repo, "dryRun": true})
+	logCtx.Debug("Initiating git client")
+	gitClient, dirPath, cleanup, err := s.initGitClient(logCtx, r)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to init git client: %w", err)
+	}
+	defer cleanup()
+
+	refs, err := gitClient.LsRefs()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to list branches: %w", err)
+	}
+
+	// A target branch which does not exist yet is created from the sync branch, which in turn is created as an orphan
+	// branch if it does not exist either. In that case the diff is against an empty tree. The branch a pull request is
+	// opened from is always recreated from the sync branch.
+	branches := []string{r.TargetBranch, r.SyncBranch}
+	if r.PullRequest != nil {
+		branches = []string{r.SyncBranch}
+	}
+	// The diff must not reveal the data of Secrets. They are compared as they are, but masked on both sides of the diff.
+	masker := newSecretMasker()
+	var drySHAs []string
+	for _, branch := range branches {
+		if !slices.Contains(refs.Branches, branch) {
+			continue
+		}
+		logCtx.Debugf("Checking out branch %s", branch)
+		var out string
+		out, err = gitClient.Checkout(branch, false)
+		if err != nil {
+			return out, "", fmt.Errorf("failed to checkout branch: %w", err)
+		}
+		drySHAs = getIncludedDrySHAs(logCtx, gitClient, dirPath, r.DrySha, r.DrySourcePaths)
+
+		// The masked Secrets of the branch are committed locally, so that the diff is against them.
+		var masked bool
+		masked, err = masker.maskFiles(dirPath)
+		if err != nil {
+			return "", "", err
+		}
+		if masked {
+			logCtx.Debug("Committing masked secrets")
+			out, err = gitClient.Commit("Mask secrets")
+			if err != nil {
+				return out, "", fmt.Errorf("failed to commit masked secrets: %w", err)
+			}
+		}
+
+		logCtx.Debug("Clearing repo contents")
+		out, err = gitClient.RemoveContents()
+		if err != nil {
+			return out, "", fmt.Errorf("failed to clear repo: %w", err)
+		}
+		break
+	}
+
+	paths, err := masker.maskPaths(r.Paths)
+	if err != nil {
+		return "", "", err
+	}
+	logCtx.Debug("Writing manifests")
+	err = WriteForPaths(dirPath, r.Repo.Repo, r.DrySha, drySHAs, paths)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to write manifests: %w", err)
+	}
+
+	logCtx.Debug("Diffing changes")
+	diff, err := gitClient.DiffWorkingTree()
+	if err != nil {
+		return diff, "", fmt.Errorf("failed to diff changes: %w", err)
+	}
+	return "", diff, nil
+}
+
+// getIncludedDrySHAs returns the dry commits included in hydrating drySha, newest first. These are the commits changing
+// the dry source paths since the dry commit the checked out branch was last hydrated from, which were not hydrated on
+// their own because hydration was requested for several dry commits in quick succession. If dry commits are not
+// coalesced, no more than drySha itself is included, or the previous dry commit can not be determined, nil is returned.
+func getIncludedDrySHAs(logCtx *log.Entry, gitClient git.Client, dirPath string, drySha string, drySourcePaths []string) []string {
+	if len(drySourcePaths) == 0 {
+		return nil
+	}
+	previous, err := readMetadata(dirPath)
+	if err != nil {
+		logCtx.WithError(err).Debug("Could not read hydrator metadata of the previous hydration")
+		return nil
+	}
+	if previous.DrySHA == "" || previous.DrySHA == drySha || !git.IsCommitSHA(previous.DrySHA) || !git.IsCommitSHA(drySha) {
+		return nil
+	}
+	drySHAs, err := gitClient.ListCommits(previous.DrySHA, drySha, drySourcePaths)
+	if err != nil {
+		logCtx.WithError(err).Warn("Failed to list the dry commits included in the hydration")
+		return nil
+	}
+	// The hydrated dry commit is always included, even if it did not change the dry source paths itself.
+	if len(drySHAs) == 0 || drySHAs[0] != drySha {
+		drySHAs = append([]string{drySha}, drySHAs...)
+	}
+	if len(drySHAs) < 2 {
+		return nil
+	}
+	return drySHAs
+}
+
+// getCommitMessage appends the included dry commits to the commit message if there is more than one.
+func getCommitMessage(message string, drySHAs []string) string {
+	if len(drySHAs) < 2 {
+		return message
+	}
+	var sb strings.Builder
+	sb.WriteString(message)
+	sb.WriteString("\n\nIncludes dry commits:\n")
+	for _, sha := range drySHAs {
+		sb.WriteString("- " + sha + "\n")
+	}
+	return sb.String()
+}
+
+// isManifestPath returns whether the path of a hydrated file is the path of a manifest rather than of the hydrator
+// metadata or of a README.
+func isManifestPath(p string) bool {
+	name := path.Base(p)
+	return name != hydratorMetadataFileName && name != readmeFileName
+}
+
+// openPullRequest opens a pull request from the given branch to the sync branch, or finds the one which is already
+// open, and returns its URL.
+func (s *Service) openPullRequest(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest, branch string) (string, error) {
+	svc, err := s.pullRequestServiceFactory.NewService(ctx, r.Repo, r.PullRequest)
+	if err != nil {
+		return "", fmt.Errorf("failed to create pull request service: %w", err)
+	}
+	title := "[Argo CD Bot] promote hydrated manifests to " + r.SyncBranch
+	description := fmt.Sprintf("This pull request promotes the manifests hydrated by Argo CD on `%s` to `%s`.\n\nIt was opened for the manifests hydrated from %s at %s.", branch, r.SyncBranch, r.Repo.Repo, r.DrySha)
+	pullRequest, err := svc.Open(ctx, branch, r.SyncBranch, title, description)
+	if err != nil {
+		return "", err
+	}
+	return pullRequest.URL, nil
 }
 
 // initGitClient initializes a git client for the given repository and returns the client, the path to the directory where
@@ -202,6 +431,31 @@ func (s *Service) initGitClient(logCtx *log.Entry, r *apiclient.CommitHydratedMa
 		return nil, "", nil, fmt.Errorf("failed to set author: %w", err)
 	}
 
+	if s.signingKey != nil {
+		key := s.signingKey.Key
+		if s.signingKey.Format == SigningKeyFormatSSH {
+			// The copy of the key must not outlive the request, so it is removed along with the temp dir.
+			key, err = copySSHSigningKey(s.signingKey.Key)
+			if err != nil {
+				cleanupOrLog()
+				return nil, "", nil, fmt.Errorf("failed to copy signing key: %w", err)
+			}
+			cleanupDir := cleanupOrLog
+			cleanupOrLog = func() {
+				cleanupDir()
+				if err := os.Remove(key); err != nil {
+					logCtx.WithError(err).Error("failed to remove signing key copy")
+				}
+			}
+		}
+		logCtx.Debugf("Signing commits with %s key %s", s.signingKey.Format, key)
+		_, err = gitClient.SetSigningKey(s.signingKey.Format, key)
+		if err != nil {
+			cleanupOrLog()
+			return nil, "", nil, fmt.Errorf("failed to set signing key: %w", err)
+		}
+	}
+
 	return gitClient, dirPath, cleanupOrLog, nil
 }
 
@@ -209,6 +463,19 @@ type hydratorMetadataFile struct {
 	RepoURL  string   `json:"repoURL"`
 	DrySHA   string   `json:"drySha"`
 	Commands []string `json:"commands"`
+	// Sources is only set if the manifests were hydrated from multiple dry sources.
+	Sources []hydratorMetadataSource `json:"sources,omitempty"`
+	// DrySHAs is only set if the manifests include more than one dry commit. It lists them newest first.
+	DrySHAs []string `json:"drySHAs,omitempty"`
+}
+
+// hydratorMetadataSource records a dry source along with the revision it was resolved to when hydrating.
+type hydratorMetadataSource struct {
+	RepoURL        string `json:"repoURL"`
+	Path           string `json:"path,omitempty"`
+	Chart          string `json:"chart,omitempty"`
+	TargetRevision string `json:"targetRevision"`
+	Revision       string `json:"revision"`
 }
 
 // TODO: make this configurable via ConfigMap.
@@ -223,4 +490,12 @@ git clone {{ .RepoURL }}
 git checkout {{ .DrySHA }}
 {{ range $command := .Commands -}}
 {{ $command }}
-{{ end -}}` + "```"
+{{ end -}}` + "```" + `
+{{- if .Sources }}
+
+The manifests were hydrated from the following sources:
+
+{{ range $source := .Sources -}}
+* {{ $source.RepoURL }}{{ if $source.Chart }} chart {{ $source.Chart }}{{ end }}{{ if $source.Path }} path {{ $source.Path }}{{ end }} at {{ $source.Revision }}
+{{ end -}}
+{{- end }}`
@@ -1,12 +1,19 @@
 package commit
 
 import (
+	"encoding/base64"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	prmocks "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request/mocks"
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/commitserver/commit/mocks"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
@@ -109,6 +116,379 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		require.NotNil(t, resp)
 		assert.Equal(t, "it-worked!", resp.HydratedSha)
 	})
+
+	t.Run("coalesced dry commits", func(t *testing.T) {
+		t.Parallel()
+
+		previousSHA := "1111111111111111111111111111111111111111"
+		intermediateSHA := "2222222222222222222222222222222222222222"
+		drySHA := "3333333333333333333333333333333333333333"
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo:           validRequest.Repo,
+			TargetBranch:   validRequest.TargetBranch,
+			SyncBranch:     validRequest.SyncBranch,
+			DrySha:         drySHA,
+			CommitMessage:  validRequest.CommitMessage,
+			DrySourcePaths: []string{"guestbook"},
+		}
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		var rootPath string
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("CheckoutOrNew", "main", "env/test", false).Run(func(_ mock.Arguments) {
+			// The target branch was last hydrated from previousSHA.
+			require.NoError(t, writeMetadata(rootPath, hydratorMetadataFile{DrySHA: previousSHA}))
+		}).Return("", nil).Once()
+		mockGitClient.On("ListCommits", previousSHA, drySHA, []string{"guestbook"}).Return([]string{intermediateSHA}, nil).Once()
+		mockGitClient.On("RemoveContents").Return("", nil).Once()
+		mockGitClient.On("CommitAndPush", "main", mock.Anything).Run(func(args mock.Arguments) {
+			assert.Equal(t, "test commit message\n\nIncludes dry commits:\n- "+drySHA+"\n- "+intermediateSHA+"\n", args.String(1))
+			metadata, err := readMetadata(rootPath)
+			require.NoError(t, err)
+			assert.Equal(t, drySHA, metadata.DrySHA)
+			assert.Equal(t, []string{drySHA, intermediateSHA}, metadata.DrySHAs)
+		}).Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("it-worked!", nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			rootPath = args.String(1)
+		}).Return(mockGitClient, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "it-worked!", resp.HydratedSha)
+	})
+
+	t.Run("dry commits not coalesced", func(t *testing.T) {
+		t.Parallel()
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo:          validRequest.Repo,
+			TargetBranch:  validRequest.TargetBranch,
+			SyncBranch:    validRequest.SyncBranch,
+			DrySha:        "3333333333333333333333333333333333333333",
+			CommitMessage: validRequest.CommitMessage,
+		}
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		var rootPath string
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("CheckoutOrNew", "main", "env/test", false).Run(func(_ mock.Arguments) {
+			require.NoError(t, writeMetadata(rootPath, hydratorMetadataFile{DrySHA: "1111111111111111111111111111111111111111"}))
+		}).Return("", nil).Once()
+		// The dry commits are not listed.
+		mockGitClient.On("RemoveContents").Return("", nil).Once()
+		mockGitClient.On("CommitAndPush", "main", "test commit message").Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("it-worked!", nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			rootPath = args.String(1)
+		}).Return(mockGitClient, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), request)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "it-worked!", resp.HydratedSha)
+	})
+
+	t.Run("signed commits", func(t *testing.T) {
+		t.Parallel()
+
+		keyPath := filepath.Join(t.TempDir(), "id_ed25519")
+		require.NoError(t, os.WriteFile(keyPath, []byte("private key"), 0o444))
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		service.signingKey = &SigningKey{Format: SigningKeyFormatSSH, Key: keyPath}
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		var keyCopy string
+		mockGitClient.On("SetSigningKey", "ssh", mock.Anything).Run(func(args mock.Arguments) {
+			keyCopy = args.String(1)
+			st, err := os.Stat(keyCopy)
+			require.NoError(t, err)
+			assert.Equal(t, os.FileMode(0o600), st.Mode().Perm())
+		}).Return("", nil).Once()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("CheckoutOrNew", "main", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("RemoveContents").Return("", nil).Once()
+		mockGitClient.On("CommitAndPush", "main", "test commit message").Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("it-worked!", nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), validRequest)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "it-worked!", resp.HydratedSha)
+		assert.NotEqual(t, keyPath, keyCopy)
+		assert.NoFileExists(t, keyCopy)
+		assert.FileExists(t, keyPath)
+	})
+
+	t.Run("failed to set signing key", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		service.signingKey = &SigningKey{Format: SigningKeyFormatOpenPGP, Key: "A8EA2C2E6208308E01A4832FDFB0F0543C6320DD"}
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("SetSigningKey", "openpgp", "A8EA2C2E6208308E01A4832FDFB0F0543C6320DD").Return("", assert.AnError).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		_, err := service.CommitHydratedManifests(t.Context(), validRequest)
+		require.ErrorIs(t, err, assert.AnError)
+		assert.ErrorContains(t, err, "failed to set signing key")
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+
+		dryRunRequest := &apiclient.CommitHydratedManifestsRequest{
+			Repo:          validRequest.Repo,
+			TargetBranch:  validRequest.TargetBranch,
+			SyncBranch:    validRequest.SyncBranch,
+			CommitMessage: validRequest.CommitMessage,
+			DryRun:        true,
+		}
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("LsRefs").Return(&git.Refs{Branches: []string{"env/test", "main"}}, nil).Once()
+		mockGitClient.On("Checkout", "main", false).Return("", nil).Once()
+		mockGitClient.On("RemoveContents").Return("", nil).Once()
+		mockGitClient.On("DiffWorkingTree").Return("diff --git a/hydrator.metadata b/hydrator.metadata", nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), dryRunRequest)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "diff --git a/hydrator.metadata b/hydrator.metadata", resp.Diff)
+		assert.Empty(t, resp.HydratedSha)
+	})
+
+	t.Run("dry run against sync branch", func(t *testing.T) {
+		t.Parallel()
+
+		dryRunRequest := &apiclient.CommitHydratedManifestsRequest{
+			Repo:          validRequest.Repo,
+			TargetBranch:  validRequest.TargetBranch,
+			SyncBranch:    validRequest.SyncBranch,
+			CommitMessage: validRequest.CommitMessage,
+			DryRun:        true,
+		}
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("LsRefs").Return(&git.Refs{Branches: []string{"env/test"}}, nil).Once()
+		mockGitClient.On("Checkout", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("RemoveContents").Return("", nil).Once()
+		mockGitClient.On("DiffWorkingTree").Return("diff", nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), dryRunRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "diff", resp.Diff)
+	})
+
+	t.Run("dry run without existing branches", func(t *testing.T) {
+		t.Parallel()
+
+		dryRunRequest := &apiclient.CommitHydratedManifestsRequest{
+			Repo:          validRequest.Repo,
+			TargetBranch:  validRequest.TargetBranch,
+			SyncBranch:    validRequest.SyncBranch,
+			CommitMessage: validRequest.CommitMessage,
+			DryRun:        true,
+		}
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("LsRefs").Return(&git.Refs{}, nil).Once()
+		mockGitClient.On("DiffWorkingTree").Return("diff", nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.CommitHydratedManifests(t.Context(), dryRunRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "diff", resp.Diff)
+	})
+
+	pullRequestRequest := &apiclient.CommitHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "https://github.com/argoproj/argocd-example-apps.git",
+		},
+		TargetBranch:  "env/test-next",
+		SyncBranch:    "env/test",
+		DrySha:        "abc1234def5678",
+		CommitMessage: "test commit message",
+		PullRequest:   &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderGitHub},
+	}
+
+	t.Run("pull request requires distinct branches", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo:         pullRequestRequest.Repo,
+			TargetBranch: "env/test",
+			SyncBranch:   "env/test",
+			PullRequest:  pullRequestRequest.PullRequest,
+		}
+		_, err := service.CommitHydratedManifests(t.Context(), request)
+		require.Error(t, err)
+		assert.ErrorContains(t, err, "target branch must differ from the sync branch")
+	})
+
+	t.Run("happy path with pull request", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("sync-sha", nil).Once()
+		mockGitClient.On("RemoveContents").Return("", nil).Once()
+		mockGitClient.On("CommitAndForcePush", "env/test-next", "test commit message").Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("it-worked!", nil).Once()
+		mockGitClient.On("ChangedFiles", "sync-sha", "it-worked!").Return([]string{"hydrator.metadata", "manifest.yaml"}, nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		mockPullRequestService := prmocks.NewPullRequestOpener(t)
+		mockPullRequestService.On("Open", mock.Anything, "env/test-next", "env/test", mock.Anything, mock.Anything).
+			Return(&pull_request.PullRequest{URL: "https://github.com/argoproj/argocd-example-apps/pull/1"}, nil).Once()
+		mockPullRequestServiceFactory := mocks.NewPullRequestServiceFactory(t)
+		mockPullRequestServiceFactory.On("NewService", mock.Anything, pullRequestRequest.Repo, pullRequestRequest.PullRequest).Return(mockPullRequestService, nil).Once()
+		service.pullRequestServiceFactory = mockPullRequestServiceFactory
+
+		resp, err := service.CommitHydratedManifests(t.Context(), pullRequestRequest)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "it-worked!", resp.HydratedSha)
+		assert.Equal(t, "https://github.com/argoproj/argocd-example-apps/pull/1", resp.PullRequestURL)
+	})
+
+	t.Run("no pull request without changes", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("RemoveContents").Return("", nil).Once()
+		mockGitClient.On("CommitAndForcePush", "env/test-next", "test commit message").Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("sync-sha", nil).Twice()
+		mockGitClient.On("ChangedFiles", "sync-sha", "sync-sha").Return([]string{}, nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+		service.pullRequestServiceFactory = mocks.NewPullRequestServiceFactory(t)
+
+		resp, err := service.CommitHydratedManifests(t.Context(), pullRequestRequest)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "sync-sha", resp.HydratedSha)
+		assert.Empty(t, resp.PullRequestURL)
+	})
+
+	t.Run("no pull request without changes of the manifests", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.On("Init").Return(nil).Once()
+		mockGitClient.On("Fetch", mock.Anything).Return(nil).Once()
+		mockGitClient.On("SetAuthor", "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.On("CheckoutOrOrphan", "env/test", false).Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("sync-sha", nil).Once()
+		mockGitClient.On("RemoveContents").Return("", nil).Once()
+		mockGitClient.On("CommitAndForcePush", "env/test-next", "test commit message").Return("", nil).Once()
+		mockGitClient.On("CommitSHA").Return("hydrated-sha", nil).Once()
+		mockGitClient.On("ChangedFiles", "sync-sha", "hydrated-sha").Return([]string{"README.md", "hydrator.metadata", "prod/README.md", "prod/hydrator.metadata"}, nil).Once()
+		mockRepoClientFactory.On("NewClient", mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+		service.pullRequestServiceFactory = mocks.NewPullRequestServiceFactory(t)
+
+		resp, err := service.CommitHydratedManifests(t.Context(), pullRequestRequest)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, "hydrated-sha", resp.HydratedSha)
+		assert.Empty(t, resp.PullRequestURL)
+	})
+}
+
+func Test_CommitHydratedManifests_DryRunMasksSecrets(t *testing.T) {
+	secret := func(password string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": "credentials", "namespace": "default"},
+			"data": map[string]any{
+				"username": base64.StdEncoding.EncodeToString([]byte("admin")),
+				"password": base64.StdEncoding.EncodeToString([]byte(password)),
+			},
+		}}
+	}
+
+	// The branch holds the manifests of a previous hydration.
+	originDir := t.TempDir()
+	runGit := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = originDir
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	runGit("init", "--initial-branch", "env/test")
+	require.NoError(t, os.MkdirAll(filepath.Join(originDir, "prod"), 0o755))
+	require.NoError(t, writeYAMLFile(filepath.Join(originDir, "prod", manifestFileName), secret("old-password")))
+	runGit("add", ".")
+	runGit("commit", "-m", "Previous hydration")
+
+	manifestJSON, err := secret("new-password").MarshalJSON()
+	require.NoError(t, err)
+	request := &apiclient.CommitHydratedManifestsRequest{
+		Repo:         &v1alpha1.Repository{Repo: "file://" + originDir},
+		TargetBranch: "env/test",
+		SyncBranch:   "env/test",
+		DrySha:       "abc123",
+		DryRun:       true,
+		Paths: []*apiclient.PathDetails{{
+			Path:      "prod",
+			Manifests: []*apiclient.HydratedManifestDetails{{ManifestJSON: string(manifestJSON)}},
+		}},
+	}
+
+	service := NewService(git.NoopCredsStore{}, metrics.NewMetricsServer(), nil)
+	resp, err := service.CommitHydratedManifests(t.Context(), request)
+	require.NoError(t, err)
+
+	for _, value := range []string{"admin", "old-password", "new-password"} {
+		assert.NotContains(t, resp.Diff, value)
+		assert.NotContains(t, resp.Diff, base64.StdEncoding.EncodeToString([]byte(value)))
+	}
+	// The changed password still shows up as changed, while the unchanged username does not.
+	assert.Contains(t, resp.Diff, "-  password: ++++++++\n")
+	assert.Contains(t, resp.Diff, "+  password: ++++++++++++\n")
+	assert.NotContains(t, resp.Diff, "-  username")
+	assert.NotContains(t, resp.Diff, "+  username")
 }
 
 func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
@@ -116,7 +496,7 @@ func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
 
 	metricsServer := metrics.NewMetricsServer()
 	mockCredsStore := git.NoopCredsStore{}
-	service := NewService(mockCredsStore, metricsServer)
+	service := NewService(mockCredsStore, metricsServer, nil)
 	mockRepoClientFactory := mocks.NewRepoClientFactory(t)
 	service.repoClientFactory = mockRepoClientFactory
 
@@ -5,21 +5,44 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
 	"text/template"
 
+	securejoin "github.com/cyphar/filepath-securejoin"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
 )
 
+const (
+	manifestFileName         = "manifest.yaml"
+	kustomizationFileName    = "kustomization.yaml"
+	hydratorMetadataFileName = "hydrator.metadata"
+	readmeFileName           = "README.md"
+)
+
+// manifestWriter writes the hydrated manifests to dirPath and returns the paths of the files it wrote, relative to
+// dirPath.
+type manifestWriter func(dirPath string, manifests []*apiclient.HydratedManifestDetails) ([]string, error)
+
+// getManifestWriter returns the manifestWriter for the given layout. A nil layout selects the single file writer.
+func getManifestWriter(layout *v1alpha1.HydratedManifestLayout) manifestWriter {
+	if layout.IsSplitPerResource() {
+		return writeManifestsPerResource
+	}
+	return writeSingleManifestFile
+}
+
 // WriteForPaths writes the manifests, hydrator.metadata, and README.md files for each path in the provided paths. It
-// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA.
-func WriteForPaths(rootPath string, repoUrl string, drySha string, paths []*apiclient.PathDetails) error { //nolint:revive //FIXME(var-naming)
+// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA. drySHAs lists all dry commits
+// included in the hydration, if there is more than one.
+func WriteForPaths(rootPath string, repoUrl string, drySha string, drySHAs []string, paths []*apiclient.PathDetails) error { //nolint:revive //FIXME(var-naming)
 	// Write the top-level readme.
-	err := writeMetadata(rootPath, hydratorMetadataFile{DrySHA: drySha, RepoURL: repoUrl})
+	err := writeMetadata(rootPath, hydratorMetadataFile{DrySHA: drySha, DrySHAs: drySHAs, RepoURL: repoUrl})
 	if err != nil {
 		return fmt.Errorf("failed to write top-level hydrator metadata: %w", err)
 	}
@@ -37,17 +60,35 @@ func WriteForPaths(rootPath string, repoUrl string, drySha string, paths []*apic
 		}
 
 		// Write the manifests
-		err = writeManifests(fullHydratePath, p.Manifests)
+		var manifestFiles []string
+		manifestFiles, err = getManifestWriter(p.Layout)(fullHydratePath, p.Manifests)
 		if err != nil {
 			return fmt.Errorf("failed to write manifests: %w", err)
 		}
 
+		if p.Layout != nil && p.Layout.Kustomization {
+			err = writeKustomization(fullHydratePath, manifestFiles)
+			if err != nil {
+				return fmt.Errorf("failed to write kustomization: %w", err)
+			}
+		}
+
 		// Write hydrator.metadata containing information about the hydration process.
 		hydratorMetadata := hydratorMetadataFile{
 			Commands: p.Commands,
 			DrySHA:   drySha,
+			DrySHAs:  drySHAs,
 			RepoURL:  repoUrl,
 		}
+		for _, source := range p.Sources {
+			hydratorMetadata.Sources = append(hydratorMetadata.Sources, hydratorMetadataSource{
+				RepoURL:        source.RepoURL,
+				Path:           source.Path,
+				Chart:          source.Chart,
+				TargetRevision: source.TargetRevision,
+				Revision:       source.Revision,
+			})
+		}
 		err = writeMetadata(fullHydratePath, hydratorMetadata)
 		if err != nil {
 			return fmt.Errorf("failed to write hydrator metadata: %w", err)
@@ -69,7 +110,7 @@ func writeMetadata(dirPath string, metadata hydratorMetadataFile) error {
 		return fmt.Errorf("failed to marshal hydrator metadata: %w", err)
 	}
 	// No need to use SecureJoin here, as the path is already sanitized.
-	hydratorMetadataPath := path.Join(dirPath, "hydrator.metadata")
+	hydratorMetadataPath := path.Join(dirPath, hydratorMetadataFileName)
 	err = os.WriteFile(hydratorMetadataPath, hydratorMetadataJSON, os.ModePerm)
 	if err != nil {
 		return fmt.Errorf("failed to write hydrator metadata: %w", err)
@@ -77,6 +118,21 @@ func writeMetadata(dirPath string, metadata hydratorMetadataFile) error {
 	return nil
 }
 
+// readMetadata reads the root-level hydrator.metadata file written by a previous hydration.
+func readMetadata(dirPath string) (hydratorMetadataFile, error) {
+	var metadata hydratorMetadataFile
+	// No need to use SecureJoin here, as the path is already sanitized.
+	data, err := os.ReadFile(path.Join(dirPath, hydratorMetadataFileName))
+	if err != nil {
+		return metadata, fmt.Errorf("failed to read hydrator metadata: %w", err)
+	}
+	err = json.Unmarshal(data, &metadata)
+	if err != nil {
+		return metadata, fmt.Errorf("failed to unmarshal hydrator metadata: %w", err)
+	}
+	return metadata, nil
+}
+
 // writeReadme writes the readme to the README.md file.
 func writeReadme(dirPath string, metadata hydratorMetadataFile) error {
 	readmeTemplate := template.New("readme")
@@ -86,7 +142,7 @@ func writeReadme(dirPath string, metadata hydratorMetadataFile) error {
 	}
 	// Create writer to template into
 	// No need to use SecureJoin here, as the path is already sanitized.
-	readmePath := path.Join(dirPath, "README.md")
+	readmePath := path.Join(dirPath, readmeFileName)
 	readmeFile, err := os.Create(readmePath)
 	if err != nil && !os.IsExist(err) {
 		return fmt.Errorf("failed to create README file: %w", err)
@@ -102,14 +158,101 @@ func writeReadme(dirPath string, metadata hydratorMetadataFile) error {
 	return nil
 }
 
+// writeSingleManifestFile is the manifestWriter for the default layout, which writes all manifests to manifest.yaml.
+func writeSingleManifestFile(dirPath string, manifests []*apiclient.HydratedManifestDetails) ([]string, error) {
+	err := writeManifests(dirPath, manifests)
+	if err != nil {
+		return nil, err
+	}
+	return []string{manifestFileName}, nil
+}
+
 // writeManifests writes the manifests to the manifest.yaml file, truncating the file if it exists and appending the
 // manifests in the order they are provided.
 func writeManifests(dirPath string, manifests []*apiclient.HydratedManifestDetails) error {
-	// If the file exists, truncate it.
+	objs := make([]*unstructured.Unstructured, 0, len(manifests))
+	for _, m := range manifests {
+		obj, err := unmarshalManifest(m)
+		if err != nil {
+			return err
+		}
+		objs = append(objs, obj)
+	}
 	// No need to use SecureJoin here, as the path is already sanitized.
-	manifestPath := path.Join(dirPath, "manifest.yaml")
+	return writeYAMLFile(path.Join(dirPath, manifestFileName), objs...)
+}
 
-	file, err := os.OpenFile(manifestPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
+// writeManifestsPerResource is the manifestWriter for the SplitPerResource layout. Each manifest is written to its own
+// <kind>-<name>.yaml file. Namespaced resources are written to a subdirectory named after their namespace, while
+// cluster-scoped resources are written to dirPath itself. If two resources of different API groups would share a file
+// name, the group is added to the kind to tell them apart.
+func writeManifestsPerResource(dirPath string, manifests []*apiclient.HydratedManifestDetails) ([]string, error) {
+	written := make([]string, 0, len(manifests))
+	seen := make(map[string]bool, len(manifests))
+	for _, m := range manifests {
+		obj, err := unmarshalManifest(m)
+		if err != nil {
+			return nil, err
+		}
+		if obj.GetName() == "" || obj.GetKind() == "" {
+			return nil, fmt.Errorf("manifest must have a kind and a name to be written to its own file (kind %q, name %q)", obj.GetKind(), obj.GetName())
+		}
+		// The kind, name and namespace come from the manifest, so they must not be able to escape dirPath.
+		if strings.ContainsAny(obj.GetKind()+obj.GetName()+obj.GetNamespace(), `/\`) {
+			return nil, fmt.Errorf("manifest %s %s/%s contains a path separator in its kind, name or namespace", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+		}
+
+		kind := strings.ToLower(obj.GetKind())
+		fileName := path.Join(obj.GetNamespace(), fmt.Sprintf("%s-%s.yaml", kind, obj.GetName()))
+		if seen[fileName] {
+			group := obj.GroupVersionKind().Group
+			fileName = path.Join(obj.GetNamespace(), fmt.Sprintf("%s.%s-%s.yaml", kind, group, obj.GetName()))
+			if group == "" || seen[fileName] {
+				return nil, fmt.Errorf("duplicate manifest for %s %s/%s", obj.GetKind(), obj.GetNamespace(), obj.GetName())
+			}
+		}
+		seen[fileName] = true
+
+		resourceDir, err := files.SecureMkdirAll(dirPath, obj.GetNamespace(), os.ModePerm)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create namespace directory: %w", err)
+		}
+		filePath, err := securejoin.SecureJoin(resourceDir, path.Base(fileName))
+		if err != nil {
+			return nil, fmt.Errorf("failed to construct manifest file path: %w", err)
+		}
+		err = writeYAMLFile(filePath, obj)
+		if err != nil {
+			return nil, err
+		}
+		written = append(written, fileName)
+	}
+	return written, nil
+}
+
+// writeKustomization writes a kustomization.yaml file listing the given resources.
+func writeKustomization(dirPath string, resources []string) error {
+	kustomization := map[string]any{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  resources,
+	}
+	// No need to use SecureJoin here, as the path is already sanitized.
+	return writeYAMLFile(path.Join(dirPath, kustomizationFileName), &unstructured.Unstructured{Object: kustomization})
+}
+
+func unmarshalManifest(m *apiclient.HydratedManifestDetails) (*unstructured.Unstructured, error) {
+	obj := &unstructured.Unstructured{}
+	err := json.Unmarshal([]byte(m.ManifestJSON), obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+	}
+	return obj, nil
+}
+
+// writeYAMLFile writes the objects to filePath as a multi-document YAML file, truncating the file if it exists.
+func writeYAMLFile(filePath string, objs ...*unstructured.Unstructured) error {
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
 	if err != nil {
 		return fmt.Errorf("failed to open manifest file: %w", err)
 	}
@@ -129,12 +272,7 @@ func writeManifests(dirPath string, manifests []*apiclient.HydratedManifestDetai
 	}()
 	enc.SetIndent(2)
 
-	for _, m := range manifests {
-		obj := &unstructured.Unstructured{}
-		err = json.Unmarshal([]byte(m.ManifestJSON), obj)
-		if err != nil {
-			return fmt.Errorf("failed to unmarshal manifest: %w", err)
-		}
+	for _, obj := range objs {
 		err = enc.Encode(&obj.Object)
 		if err != nil {
 			return fmt.Errorf("failed to encode manifest: %w", err)
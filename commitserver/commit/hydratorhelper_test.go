@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 )
 
 func TestWriteForPaths(t *testing.T) {
@@ -35,7 +36,7 @@ func TestWriteForPaths(t *testing.T) {
 		},
 	}
 
-	err := WriteForPaths(dir, repoURL, drySha, paths)
+	err := WriteForPaths(dir, repoURL, drySha, nil, paths)
 	require.NoError(t, err)
 
 	// Check if the top-level hydrator.metadata exists and contains the repo URL and dry SHA
@@ -80,6 +81,74 @@ func TestWriteForPaths(t *testing.T) {
 	}
 }
 
+func TestWriteForPaths_DrySHAs(t *testing.T) {
+	dir := t.TempDir()
+
+	drySHAs := []string{"def456", "abc123"}
+	paths := []*apiclient.PathDetails{
+		{
+			Path: "path1",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Pod","apiVersion":"v1"}`},
+			},
+		},
+	}
+
+	err := WriteForPaths(dir, "https://github.com/example/repo", "def456", drySHAs, paths)
+	require.NoError(t, err)
+
+	topMetadata, err := readMetadata(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "def456", topMetadata.DrySHA)
+	assert.Equal(t, drySHAs, topMetadata.DrySHAs)
+
+	pathMetadata, err := readMetadata(path.Join(dir, "path1"))
+	require.NoError(t, err)
+	assert.Equal(t, drySHAs, pathMetadata.DrySHAs)
+}
+
+func TestWriteForPaths_MultipleSources(t *testing.T) {
+	dir := t.TempDir()
+
+	repoURL := "https://github.com/example/values"
+	paths := []*apiclient.PathDetails{
+		{
+			Path: "path1",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Pod","apiVersion":"v1"}`},
+			},
+			Commands: []string{"helm template . --values values.yaml"},
+			Sources: []*apiclient.DrySourceDetails{
+				{RepoURL: "oci://registry.example.com/charts", Chart: "nginx", TargetRevision: "15.*", Revision: "15.1.0"},
+				{RepoURL: repoURL, TargetRevision: "main", Revision: "abc123"},
+			},
+		},
+	}
+
+	err := WriteForPaths(dir, repoURL, "abc123", nil, paths)
+	require.NoError(t, err)
+
+	metadataBytes, err := os.ReadFile(path.Join(dir, "path1", "hydrator.metadata"))
+	require.NoError(t, err)
+	var readMetadata hydratorMetadataFile
+	err = json.Unmarshal(metadataBytes, &readMetadata)
+	require.NoError(t, err)
+	assert.Equal(t, []hydratorMetadataSource{
+		{RepoURL: "oci://registry.example.com/charts", Chart: "nginx", TargetRevision: "15.*", Revision: "15.1.0"},
+		{RepoURL: repoURL, TargetRevision: "main", Revision: "abc123"},
+	}, readMetadata.Sources)
+
+	readmeBytes, err := os.ReadFile(path.Join(dir, "path1", "README.md"))
+	require.NoError(t, err)
+	assert.Contains(t, string(readmeBytes), "* oci://registry.example.com/charts chart nginx at 15.1.0")
+	assert.Contains(t, string(readmeBytes), "* https://github.com/example/values at abc123")
+
+	// The top-level metadata only records the dry SHA.
+	topMetadataBytes, err := os.ReadFile(path.Join(dir, "hydrator.metadata"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(topMetadataBytes), "sources")
+}
+
 func TestWriteMetadata(t *testing.T) {
 	dir := t.TempDir()
 
@@ -133,3 +202,110 @@ func TestWriteManifests(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, string(manifestBytes), "kind")
 }
+
+func TestWriteForPaths_SplitPerResource(t *testing.T) {
+	dir := t.TempDir()
+
+	paths := []*apiclient.PathDetails{
+		{
+			Path: "path1",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Namespace","apiVersion":"v1","metadata":{"name":"guestbook"}}`},
+				{ManifestJSON: `{"kind":"Deployment","apiVersion":"apps/v1","metadata":{"name":"guestbook-ui","namespace":"guestbook"}}`},
+			},
+			Layout: &v1alpha1.HydratedManifestLayout{Type: v1alpha1.HydratedManifestLayoutSplitPerResource, Kustomization: true},
+		},
+	}
+
+	err := WriteForPaths(dir, "https://github.com/example/repo", "abc123", nil, paths)
+	require.NoError(t, err)
+
+	assert.NoFileExists(t, path.Join(dir, "path1", "manifest.yaml"))
+	assert.FileExists(t, path.Join(dir, "path1", "hydrator.metadata"))
+	assert.FileExists(t, path.Join(dir, "path1", "README.md"))
+
+	namespaceBytes, err := os.ReadFile(path.Join(dir, "path1", "namespace-guestbook.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(namespaceBytes), "kind: Namespace")
+
+	deploymentBytes, err := os.ReadFile(path.Join(dir, "path1", "guestbook", "deployment-guestbook-ui.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(deploymentBytes), "kind: Deployment")
+
+	kustomizationBytes, err := os.ReadFile(path.Join(dir, "path1", "kustomization.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, `apiVersion: kustomize.config.k8s.io/v1beta1
+kind: Kustomization
+resources:
+  - namespace-guestbook.yaml
+  - guestbook/deployment-guestbook-ui.yaml
+`, string(kustomizationBytes))
+}
+
+func TestWriteForPaths_SingleFileKustomization(t *testing.T) {
+	dir := t.TempDir()
+
+	paths := []*apiclient.PathDetails{
+		{
+			Path: ".",
+			Manifests: []*apiclient.HydratedManifestDetails{
+				{ManifestJSON: `{"kind":"Pod","apiVersion":"v1","metadata":{"name":"pod"}}`},
+			},
+			Layout: &v1alpha1.HydratedManifestLayout{Kustomization: true},
+		},
+	}
+
+	err := WriteForPaths(dir, "https://github.com/example/repo", "abc123", nil, paths)
+	require.NoError(t, err)
+
+	assert.FileExists(t, path.Join(dir, "manifest.yaml"))
+	kustomizationBytes, err := os.ReadFile(path.Join(dir, "kustomization.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(kustomizationBytes), "- manifest.yaml")
+}
+
+func TestWriteManifestsPerResource(t *testing.T) {
+	t.Run("disambiguates kinds of different groups", func(t *testing.T) {
+		dir := t.TempDir()
+
+		manifests := []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"kind":"Certificate","apiVersion":"cert-manager.io/v1","metadata":{"name":"cert","namespace":"default"}}`},
+			{ManifestJSON: `{"kind":"Certificate","apiVersion":"example.com/v1","metadata":{"name":"cert","namespace":"default"}}`},
+		}
+
+		written, err := writeManifestsPerResource(dir, manifests)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"default/certificate-cert.yaml", "default/certificate.example.com-cert.yaml"}, written)
+		for _, f := range written {
+			assert.FileExists(t, path.Join(dir, f))
+		}
+	})
+
+	t.Run("fails on duplicate resources", func(t *testing.T) {
+		manifests := []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"cm","namespace":"default"}}`},
+			{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"cm","namespace":"default"}}`},
+		}
+
+		_, err := writeManifestsPerResource(t.TempDir(), manifests)
+		assert.ErrorContains(t, err, "duplicate manifest")
+	})
+
+	t.Run("fails on unnamed resources", func(t *testing.T) {
+		manifests := []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"generateName":"cm-"}}`},
+		}
+
+		_, err := writeManifestsPerResource(t.TempDir(), manifests)
+		assert.ErrorContains(t, err, "must have a kind and a name")
+	})
+
+	t.Run("rejects path separators", func(t *testing.T) {
+		manifests := []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"kind":"ConfigMap","apiVersion":"v1","metadata":{"name":"cm","namespace":"../../etc"}}`},
+		}
+
+		_, err := writeManifestsPerResource(t.TempDir(), manifests)
+		assert.ErrorContains(t, err, "path separator")
+	})
+}
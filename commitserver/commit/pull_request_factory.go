@@ -0,0 +1,164 @@
+package commit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
+	"github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// PullRequestServiceFactory is a factory for creating the services used to open pull requests against a repository.
+type PullRequestServiceFactory interface {
+	NewService(ctx context.Context, repo *v1alpha1.Repository, pullRequest *v1alpha1.HydratePullRequest) (pull_request.PullRequestOpener, error)
+}
+
+type pullRequestServiceFactory struct{}
+
+// NewPullRequestServiceFactory returns a new instance of the pull request service factory.
+func NewPullRequestServiceFactory() PullRequestServiceFactory {
+	return &pullRequestServiceFactory{}
+}
+
+// NewService creates a service for the SCM provider of the pull request, authenticated with the credentials of the
+// repository. The owner and name of the repository are taken from the repository URL.
+func (f *pullRequestServiceFactory) NewService(ctx context.Context, repo *v1alpha1.Repository, pullRequest *v1alpha1.HydratePullRequest) (pull_request.PullRequestOpener, error) {
+	baseURL, segments, err := parseRepoURL(repo.Repo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse repo URL %q: %w", repo.Repo, err)
+	}
+
+	api := pullRequest.API
+	var svc pull_request.PullRequestService
+	switch pullRequest.Provider {
+	case v1alpha1.HydratePullRequestProviderGitHub:
+		if len(segments) != 2 {
+			return nil, fmt.Errorf("expected a GitHub repo URL of the form <host>/<owner>/<repo>, got %q", repo.Repo)
+		}
+		if api == "" && baseURL != "https://github.com" {
+			api = baseURL + "/api/v3"
+		}
+		if repo.GithubAppPrivateKey != "" {
+			auth := github_app_auth.Authentication{
+				Id:                repo.GithubAppId,
+				InstallationId:    repo.GithubAppInstallationId,
+				EnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
+				PrivateKey:        repo.GithubAppPrivateKey,
+			}
+			svc, err = pull_request.NewGithubAppService(auth, api, segments[0], segments[1], nil)
+		} else {
+			svc, err = pull_request.NewGithubService(repo.Password, api, segments[0], segments[1], nil)
+		}
+	case v1alpha1.HydratePullRequestProviderGitLab:
+		if api == "" {
+			api = baseURL
+		}
+		svc, err = pull_request.NewGitLabService(repo.Password, api, strings.Join(segments, "/"), nil, "", "", repo.Insecure, nil)
+	case v1alpha1.HydratePullRequestProviderGitea:
+		if len(segments) != 2 {
+			return nil, fmt.Errorf("expected a Gitea repo URL of the form <host>/<owner>/<repo>, got %q", repo.Repo)
+		}
+		if api == "" {
+			api = baseURL
+		}
+		svc, err = pull_request.NewGiteaService(repo.Password, api, segments[0], segments[1], nil, repo.Insecure)
+	case v1alpha1.HydratePullRequestProviderBitbucketServer:
+		// HTTP clone URLs are of the form <host>/scm/<project>/<repo>.
+		if len(segments) == 3 && segments[0] == "scm" {
+			segments = segments[1:]
+		}
+		if len(segments) != 2 {
+			return nil, fmt.Errorf("expected a Bitbucket Server repo URL of the form <host>/scm/<project>/<repo>, got %q", repo.Repo)
+		}
+		if api == "" {
+			api = baseURL
+		}
+		if repo.BearerToken != "" {
+			svc, err = pull_request.NewBitbucketServiceBearerToken(ctx, repo.BearerToken, api, segments[0], segments[1], "", repo.Insecure, nil)
+		} else {
+			svc, err = pull_request.NewBitbucketServiceBasicAuth(ctx, repo.Username, repo.Password, api, segments[0], segments[1], "", repo.Insecure, nil)
+		}
+	case v1alpha1.HydratePullRequestProviderBitbucketCloud:
+		if len(segments) != 2 {
+			return nil, fmt.Errorf("expected a Bitbucket Cloud repo URL of the form <host>/<workspace>/<repo>, got %q", repo.Repo)
+		}
+		if repo.BearerToken != "" {
+			svc, err = pull_request.NewBitbucketCloudServiceBearerToken(api, repo.BearerToken, segments[0], segments[1])
+		} else {
+			svc, err = pull_request.NewBitbucketCloudServiceBasicAuth(api, repo.Username, repo.Password, segments[0], segments[1])
+		}
+	case v1alpha1.HydratePullRequestProviderAzureDevOps:
+		// HTTPS URLs are of the form <host>/<organization>/<project>/_git/<repo>, SSH URLs of the form
+		// <host>:v3/<organization>/<project>/<repo>.
+		var azureSegments []string
+		for i, segment := range segments {
+			if segment == "_git" || (i == 0 && segment == "v3") {
+				continue
+			}
+			azureSegments = append(azureSegments, segment)
+		}
+		if len(azureSegments) != 3 {
+			return nil, fmt.Errorf("expected an Azure DevOps repo URL of the form <host>/<organization>/<project>/_git/<repo>, got %q", repo.Repo)
+		}
+		svc, err = pull_request.NewAzureDevOpsService(repo.Password, api, azureSegments[0], azureSegments[1], azureSegments[2], nil)
+	default:
+		return nil, fmt.Errorf("unsupported pull request provider %q", pullRequest.Provider)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s pull request service: %w", pullRequest.Provider, err)
+	}
+
+	opener, ok := svc.(pull_request.PullRequestOpener)
+	if !ok {
+		return nil, fmt.Errorf("%s pull request service cannot open pull requests", pullRequest.Provider)
+	}
+	return opener, nil
+}
+
+// parseRepoURL returns the base URL (scheme and host) of a repository URL, along with the segments of its path without
+// the .git suffix. SSH URLs are assumed to be served over HTTPS by the same host.
+func parseRepoURL(repoURL string) (string, []string, error) {
+	var baseURL, repoPath string
+	if !strings.Contains(repoURL, "://") {
+		// SCP-like SSH URL, e.g. git@github.com:owner/repo.git
+		userHost, p, ok := strings.Cut(repoURL, ":")
+		if !ok {
+			return "", nil, errors.New("unrecognized repo URL format")
+		}
+		_, host, found := strings.Cut(userHost, "@")
+		if !found {
+			host = userHost
+		}
+		baseURL = "https://" + host
+		repoPath = p
+	} else {
+		u, err := url.Parse(repoURL)
+		if err != nil {
+			return "", nil, err
+		}
+		scheme := u.Scheme
+		hostname := u.Host
+		if scheme != "http" && scheme != "https" {
+			scheme = "https"
+			hostname = u.Hostname()
+		}
+		baseURL = scheme + "://" + hostname
+		repoPath = u.Path
+	}
+
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	var segments []string
+	for _, segment := range strings.Split(repoPath, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) == 0 {
+		return "", nil, errors.New("repo URL has no path")
+	}
+	return baseURL, segments, nil
+}
@@ -0,0 +1,109 @@
+package commit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func Test_parseRepoURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		repoURL          string
+		expectedBaseURL  string
+		expectedSegments []string
+	}{
+		{
+			name:             "https",
+			repoURL:          "https://github.com/argoproj/argocd-example-apps.git",
+			expectedBaseURL:  "https://github.com",
+			expectedSegments: []string{"argoproj", "argocd-example-apps"},
+		},
+		{
+			name:             "http with port and user",
+			repoURL:          "http://user@gitea.example.com:3000/org/repo",
+			expectedBaseURL:  "http://gitea.example.com:3000",
+			expectedSegments: []string{"org", "repo"},
+		},
+		{
+			name:             "scp-like ssh",
+			repoURL:          "git@gitlab.com:group/subgroup/project.git",
+			expectedBaseURL:  "https://gitlab.com",
+			expectedSegments: []string{"group", "subgroup", "project"},
+		},
+		{
+			name:             "ssh with port",
+			repoURL:          "ssh://git@bitbucket.example.com:7999/PROJECT/repo.git",
+			expectedBaseURL:  "https://bitbucket.example.com",
+			expectedSegments: []string{"PROJECT", "repo"},
+		},
+		{
+			name:             "azure devops",
+			repoURL:          "https://org@dev.azure.com/org/project/_git/repo",
+			expectedBaseURL:  "https://dev.azure.com",
+			expectedSegments: []string{"org", "project", "_git", "repo"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			baseURL, segments, err := parseRepoURL(tc.repoURL)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedBaseURL, baseURL)
+			assert.Equal(t, tc.expectedSegments, segments)
+		})
+	}
+
+	t.Run("no path", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := parseRepoURL("https://github.com/")
+		assert.ErrorContains(t, err, "repo URL has no path")
+	})
+}
+
+func Test_pullRequestServiceFactory_NewService(t *testing.T) {
+	t.Parallel()
+
+	factory := NewPullRequestServiceFactory()
+
+	t.Run("unsupported provider", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git"}
+		_, err := factory.NewService(t.Context(), repo, &v1alpha1.HydratePullRequest{Provider: "Unknown"})
+		assert.ErrorContains(t, err, `unsupported pull request provider "Unknown"`)
+	})
+
+	t.Run("unexpected repo URL", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argo-cd/tree/master"}
+		_, err := factory.NewService(t.Context(), repo, &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderGitHub})
+		assert.ErrorContains(t, err, "expected a GitHub repo URL")
+	})
+
+	t.Run("github", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &v1alpha1.Repository{Repo: "https://github.com/argoproj/argocd-example-apps.git", Password: "token"}
+		svc, err := factory.NewService(t.Context(), repo, &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderGitHub})
+		require.NoError(t, err)
+		assert.NotNil(t, svc)
+	})
+
+	t.Run("azure devops", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &v1alpha1.Repository{Repo: "https://dev.azure.com/org/project/_git/repo", Password: "token"}
+		svc, err := factory.NewService(t.Context(), repo, &v1alpha1.HydratePullRequest{Provider: v1alpha1.HydratePullRequestProviderAzureDevOps})
+		require.NoError(t, err)
+		assert.NotNil(t, svc)
+	})
+}
@@ -0,0 +1,166 @@
+package commit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+)
+
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// secretMasker replaces the values of Secrets with strings of "+" characters, like the API server does when it diffs
+// resources. The same masker has to be applied to both sides of a diff: it always replaces a value of a Secret key with
+// the same mask, so that the diff still shows which values changed without revealing any of them.
+type secretMasker struct {
+	// masks holds the masks of the values of each Secret key, by the ID of the key
+	masks map[string]map[string]string
+}
+
+func newSecretMasker() *secretMasker {
+	return &secretMasker{masks: map[string]map[string]string{}}
+}
+
+// mask masks the data and stringData of obj if it is a Secret. It returns whether obj is a Secret.
+func (m *secretMasker) mask(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	if gvk.Group != "" || gvk.Kind != "Secret" {
+		return false
+	}
+	secretID := obj.GetNamespace() + "/" + obj.GetName()
+	for _, field := range []string{"data", "stringData"} {
+		data, ok := obj.Object[field].(map[string]any)
+		if !ok {
+			continue
+		}
+		for key, value := range data {
+			data[key] = m.maskValue(secretID+"/"+field+"/"+key, fmt.Sprint(value))
+		}
+	}
+	// The last applied configuration contains the data of the Secret as well.
+	if annotations := obj.GetAnnotations(); annotations[lastAppliedConfigAnnotation] != "" {
+		annotations[lastAppliedConfigAnnotation] = m.maskValue(secretID+"/"+lastAppliedConfigAnnotation, annotations[lastAppliedConfigAnnotation])
+		obj.SetAnnotations(annotations)
+	}
+	return true
+}
+
+func (m *secretMasker) maskValue(keyID string, value string) string {
+	masks, ok := m.masks[keyID]
+	if !ok {
+		masks = map[string]string{}
+		m.masks[keyID] = masks
+	}
+	mask, ok := masks[value]
+	if !ok {
+		// Every other value of the key gets a longer mask.
+		mask = strings.Repeat("+", 8+4*len(masks))
+		masks[value] = mask
+	}
+	return mask
+}
+
+// maskPaths returns a copy of paths in which the Secrets are masked.
+func (m *secretMasker) maskPaths(paths []*apiclient.PathDetails) ([]*apiclient.PathDetails, error) {
+	maskedPaths := make([]*apiclient.PathDetails, 0, len(paths))
+	for _, p := range paths {
+		manifests := make([]*apiclient.HydratedManifestDetails, 0, len(p.Manifests))
+		for _, manifest := range p.Manifests {
+			obj, err := unmarshalManifest(manifest)
+			if err != nil {
+				return nil, err
+			}
+			if m.mask(obj) {
+				maskedJSON, err := obj.MarshalJSON()
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal masked manifest: %w", err)
+				}
+				manifest = &apiclient.HydratedManifestDetails{ManifestJSON: string(maskedJSON)}
+			}
+			manifests = append(manifests, manifest)
+		}
+		maskedPaths = append(maskedPaths, &apiclient.PathDetails{
+			Path:      p.Path,
+			Manifests: manifests,
+			Commands:  p.Commands,
+			Layout:    p.Layout,
+			Sources:   p.Sources,
+		})
+	}
+	return maskedPaths, nil
+}
+
+// maskFiles masks the Secrets in the YAML files below rootPath, rewriting them the way the hydrator writes manifests. It
+// returns whether any file was changed. Files which are not made of YAML objects are left untouched, as are symlinks.
+func (m *secretMasker) maskFiles(rootPath string) (bool, error) {
+	changed := false
+	err := filepath.WalkDir(rootPath, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || (filepath.Ext(filePath) != ".yaml" && filepath.Ext(filePath) != ".yml") {
+			return nil
+		}
+		objs, err := readYAMLFile(filePath)
+		if err != nil {
+			log.WithError(err).Debugf("Not masking Secrets in %s", filePath)
+			return nil
+		}
+		hasSecrets := false
+		for _, obj := range objs {
+			if m.mask(obj) {
+				hasSecrets = true
+			}
+		}
+		if !hasSecrets {
+			return nil
+		}
+		changed = true
+		return writeYAMLFile(filePath, objs...)
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to mask secrets: %w", err)
+	}
+	return changed, nil
+}
+
+// readYAMLFile reads the objects of a multi-document YAML file.
+func readYAMLFile(filePath string) ([]*unstructured.Unstructured, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open manifest file: %w", err)
+	}
+	defer file.Close()
+
+	var objs []*unstructured.Unstructured
+	dec := yaml.NewDecoder(file)
+	for {
+		var obj map[string]any
+		err = dec.Decode(&obj)
+		if errors.Is(err, io.EOF) {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode manifest: %w", err)
+		}
+		if obj == nil {
+			return nil, errors.New("manifest file contains an empty document")
+		}
+		objs = append(objs, &unstructured.Unstructured{Object: obj})
+	}
+}
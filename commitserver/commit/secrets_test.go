@@ -0,0 +1,109 @@
+package commit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+)
+
+func newSecret(data map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]any{"name": "credentials", "namespace": "default"},
+		"data":       data,
+	}}
+}
+
+func TestSecretMasker_Mask(t *testing.T) {
+	masker := newSecretMasker()
+
+	live := newSecret(map[string]any{"username": "YWRtaW4=", "password": "b2xk"})
+	assert.True(t, masker.mask(live))
+	assert.Equal(t, map[string]any{"username": "++++++++", "password": "++++++++"}, live.Object["data"])
+
+	target := newSecret(map[string]any{"username": "YWRtaW4=", "password": "bmV3"})
+	target.Object["stringData"] = map[string]any{"token": "secret"}
+	target.SetAnnotations(map[string]string{lastAppliedConfigAnnotation: `{"data":{"password":"bmV3"}}`, "team": "a"})
+	assert.True(t, masker.mask(target))
+	assert.Equal(t, map[string]any{"username": "++++++++", "password": "++++++++++++"}, target.Object["data"])
+	assert.Equal(t, map[string]any{"token": "++++++++"}, target.Object["stringData"])
+	assert.Equal(t, map[string]string{lastAppliedConfigAnnotation: "++++++++", "team": "a"}, target.GetAnnotations())
+
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "config"},
+		"data":       map[string]any{"password": "b2xk"},
+	}}
+	assert.False(t, masker.mask(configMap))
+	assert.Equal(t, map[string]any{"password": "b2xk"}, configMap.Object["data"])
+}
+
+func TestSecretMasker_MaskPaths(t *testing.T) {
+	secretJSON, err := newSecret(map[string]any{"password": "b2xk"}).MarshalJSON()
+	require.NoError(t, err)
+	paths := []*apiclient.PathDetails{{
+		Path: "prod",
+		Manifests: []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: string(secretJSON)},
+			{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config"},"data":{"password":"b2xk"}}`},
+		},
+		Commands: []string{"helm template ."},
+	}}
+
+	maskedPaths, err := newSecretMasker().maskPaths(paths)
+	require.NoError(t, err)
+	require.Len(t, maskedPaths, 1)
+	assert.Equal(t, "prod", maskedPaths[0].Path)
+	assert.Equal(t, []string{"helm template ."}, maskedPaths[0].Commands)
+	require.Len(t, maskedPaths[0].Manifests, 2)
+	assert.JSONEq(t, `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"credentials","namespace":"default"},"data":{"password":"++++++++"}}`, maskedPaths[0].Manifests[0].ManifestJSON)
+	assert.Same(t, paths[0].Manifests[1], maskedPaths[0].Manifests[1])
+	// The request itself is left as it is.
+	assert.Equal(t, string(secretJSON), paths[0].Manifests[0].ManifestJSON)
+}
+
+func TestSecretMasker_MaskFiles(t *testing.T) {
+	dir := t.TempDir()
+	configMapYAML := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\ndata:\n  password: b2xk\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "configmap.yaml"), []byte(configMapYAML), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("password: b2xk\n"), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "prod"), 0o755))
+	require.NoError(t, writeYAMLFile(filepath.Join(dir, "prod", manifestFileName), newSecret(map[string]any{"password": "b2xk"})))
+	outsideDir := t.TempDir()
+	require.NoError(t, writeYAMLFile(filepath.Join(outsideDir, "secret.yaml"), newSecret(map[string]any{"password": "b2xk"})))
+	require.NoError(t, os.Symlink(filepath.Join(outsideDir, "secret.yaml"), filepath.Join(dir, "link.yaml")))
+
+	masked, err := newSecretMasker().maskFiles(dir)
+	require.NoError(t, err)
+	assert.True(t, masked)
+
+	data, err := os.ReadFile(filepath.Join(dir, "prod", manifestFileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "password: ++++++++")
+	assert.NotContains(t, string(data), "b2xk")
+
+	for path, expected := range map[string]string{
+		filepath.Join(dir, "configmap.yaml"): configMapYAML,
+		filepath.Join(dir, "README.md"):      "password: b2xk\n",
+	} {
+		data, err = os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(data))
+	}
+	// Symlinks are not followed.
+	data, err = os.ReadFile(filepath.Join(outsideDir, "secret.yaml"))
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "b2xk")
+
+	masked, err = newSecretMasker().maskFiles(t.TempDir())
+	require.NoError(t, err)
+	assert.False(t, masked)
+}
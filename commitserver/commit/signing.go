@@ -0,0 +1,65 @@
+package commit
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/argoproj/argo-cd/v3/util/gpg"
+)
+
+const (
+	// SigningKeyFormatOpenPGP is the format of GnuPG signing keys.
+	SigningKeyFormatOpenPGP = "openpgp"
+	// SigningKeyFormatSSH is the format of SSH signing keys.
+	SigningKeyFormatSSH = "ssh"
+)
+
+// SigningKey is the key the commit server signs hydrated commits with.
+type SigningKey struct {
+	// Format is the signature format, passed to git as gpg.format. Either "openpgp" or "ssh".
+	Format string
+	// Key identifies the key: the fingerprint of a key in the GnuPG keyring for the openpgp format, or the path to a
+	// private key file for the ssh format.
+	Key string
+}
+
+// LoadSigningKey loads the private key at keyPath for signing commits. GnuPG keys are imported into the keyring of the
+// commit server. SSH keys are only checked to be readable, they are copied for every commit by copySSHSigningKey.
+func LoadSigningKey(format, keyPath string) (*SigningKey, error) {
+	switch format {
+	case SigningKeyFormatOpenPGP:
+		fingerprint, err := gpg.ImportSigningKey(keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load GnuPG signing key: %w", err)
+		}
+		return &SigningKey{Format: format, Key: fingerprint}, nil
+	case SigningKeyFormatSSH:
+		if _, err := os.ReadFile(keyPath); err != nil {
+			return nil, fmt.Errorf("failed to read SSH signing key: %w", err)
+		}
+		return &SigningKey{Format: format, Key: keyPath}, nil
+	default:
+		return nil, fmt.Errorf("unsupported signing key format %q, must be one of: %s|%s", format, SigningKeyFormatOpenPGP, SigningKeyFormatSSH)
+	}
+}
+
+// copySSHSigningKey copies the SSH private key at keyPath to a temporary file only readable by the commit server, since
+// ssh-keygen refuses to use private keys with more permissive modes, which is commonly the case for keys mounted from a
+// Secret. It returns the path to the copy, which the caller must remove once done signing.
+func copySSHSigningKey(keyPath string) (string, error) {
+	keyData, err := os.ReadFile(keyPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read SSH signing key: %w", err)
+	}
+	f, err := os.CreateTemp("", "commit-signing-key")
+	if err != nil {
+		return "", fmt.Errorf("failed to create SSH signing key file: %w", err)
+	}
+	defer f.Close()
+	// CreateTemp already creates the file with mode 0600.
+	if _, err = f.Write(keyData); err != nil {
+		_ = os.Remove(f.Name())
+		return "", fmt.Errorf("failed to write SSH signing key file: %w", err)
+	}
+	return f.Name(), nil
+}
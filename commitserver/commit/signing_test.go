@@ -0,0 +1,62 @@
+package commit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+func TestLoadSigningKey(t *testing.T) {
+	t.Run("ssh", func(t *testing.T) {
+		keyPath := filepath.Join(t.TempDir(), "id_ed25519")
+		require.NoError(t, os.WriteFile(keyPath, []byte("private key"), 0o444))
+
+		signingKey, err := LoadSigningKey(SigningKeyFormatSSH, keyPath)
+		require.NoError(t, err)
+		assert.Equal(t, &SigningKey{Format: SigningKeyFormatSSH, Key: keyPath}, signingKey)
+	})
+
+	t.Run("ssh key does not exist", func(t *testing.T) {
+		_, err := LoadSigningKey(SigningKeyFormatSSH, filepath.Join(t.TempDir(), "missing"))
+		assert.ErrorContains(t, err, "failed to read SSH signing key")
+	})
+
+	t.Run("openpgp", func(t *testing.T) {
+		// Intentionally avoid using t.TempDir, its long paths can exceed the maximum length of the gpg-agent socket path.
+		gnuPGHome, err := os.MkdirTemp("", "")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = os.RemoveAll(gnuPGHome) })
+		t.Setenv(common.EnvGnuPGHome, gnuPGHome)
+
+		signingKey, err := LoadSigningKey(SigningKeyFormatOpenPGP, "../../util/gpg/testdata/signing.asc")
+		require.NoError(t, err)
+		assert.Equal(t, &SigningKey{Format: SigningKeyFormatOpenPGP, Key: "A8EA2C2E6208308E01A4832FDFB0F0543C6320DD"}, signingKey)
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		_, err := LoadSigningKey("x509", "/tmp/key")
+		assert.ErrorContains(t, err, `unsupported signing key format "x509"`)
+	})
+}
+
+func TestCopySSHSigningKey(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "id_ed25519")
+	require.NoError(t, os.WriteFile(keyPath, []byte("private key"), 0o444))
+
+	keyCopy, err := copySSHSigningKey(keyPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.Remove(keyCopy) })
+	assert.NotEqual(t, keyPath, keyCopy)
+
+	st, err := os.Stat(keyCopy)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), st.Mode().Perm())
+	keyData, err := os.ReadFile(keyCopy)
+	require.NoError(t, err)
+	assert.Equal(t, "private key", string(keyData))
+}
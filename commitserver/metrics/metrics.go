@@ -18,6 +18,8 @@ type Server struct {
 	commitRequestHistogram     *prometheus.HistogramVec
 	userInfoRequestHistogram   *prometheus.HistogramVec
 	commitRequestCounter       *prometheus.CounterVec
+	hydrationQueueHistogram    *prometheus.HistogramVec
+	coalescedDryCommitsCounter *prometheus.CounterVec
 }
 
 // GitRequestType is the type of git request
@@ -105,6 +107,25 @@ func NewMetricsServer() *Server {
 	)
 	registry.MustRegister(commitRequestCounter)
 
+	hydrationQueueHistogram := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "argocd_commitserver_hydration_queue_duration_seconds",
+			Help:    "Time between hydration being requested and the commit request being received, in seconds.",
+			Buckets: []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800},
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(hydrationQueueHistogram)
+
+	coalescedDryCommitsCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_commitserver_coalesced_dry_commits_total",
+			Help: "Number of dry commits included in a hydrated commit of a later dry commit",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(coalescedDryCommitsCounter)
+
 	return &Server{
 		handler:                    promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
 		commitPendingRequestsGauge: commitPendingRequestsGauge,
@@ -113,6 +134,8 @@ func NewMetricsServer() *Server {
 		commitRequestHistogram:     commitRequestHistogram,
 		userInfoRequestHistogram:   userInfoRequestHistogram,
 		commitRequestCounter:       commitRequestCounter,
+		hydrationQueueHistogram:    hydrationQueueHistogram,
+		coalescedDryCommitsCounter: coalescedDryCommitsCounter,
 	}
 }
 
@@ -155,3 +178,13 @@ func (m *Server) ObserveUserInfoRequestDuration(repo string, credentialType stri
 func (m *Server) IncCommitRequest(repo string, rt CommitResponseType) {
 	m.commitRequestCounter.WithLabelValues(repo, string(rt)).Inc()
 }
+
+// ObserveHydrationQueueDuration observes the time between hydration being requested and the commit request being received
+func (m *Server) ObserveHydrationQueueDuration(repo string, duration time.Duration) {
+	m.hydrationQueueHistogram.WithLabelValues(repo).Observe(duration.Seconds())
+}
+
+// AddCoalescedDryCommits adds to the number of dry commits included in a hydrated commit of a later dry commit
+func (m *Server) AddCoalescedDryCommits(repo string, count int) {
+	m.coalescedDryCommitsCounter.WithLabelValues(repo).Add(float64(count))
+}
@@ -18,9 +18,9 @@ type ArgoCDCommitServer struct {
 	commitService *commit.Service
 }
 
-// NewServer returns a new instance of the commit server.
-func NewServer(gitCredsStore git.CredsStore, metricsServer *metrics.Server) *ArgoCDCommitServer {
-	return &ArgoCDCommitServer{commitService: commit.NewService(gitCredsStore, metricsServer)}
+// NewServer returns a new instance of the commit server. If signingKey is not nil, all commits are signed with it.
+func NewServer(gitCredsStore git.CredsStore, metricsServer *metrics.Server, signingKey *commit.SigningKey) *ArgoCDCommitServer {
+	return &ArgoCDCommitServer{commitService: commit.NewService(gitCredsStore, metricsServer, signingKey)}
 }
 
 // CreateGRPC creates a new gRPC server.
@@ -136,6 +136,10 @@ const (
 	// cluster changes, this algorithm minimises the changes between shard and clusters assignments.
 	ConsistentHashingWithBoundedLoadsAlgorithm = "consistent-hashing"
 
+	// LoadBasedShardingAlgorithm balances the clusters across all shards by their load, measured from the number of
+	// resources in the cluster cache and the rate of watch events received from the cluster.
+	LoadBasedShardingAlgorithm = "load-based"
+
 	DefaultShardingAlgorithm = LegacyShardingAlgorithm
 )
 
@@ -218,6 +222,10 @@ const (
 	// AnnotationKeyAppSkipReconcile tells the Application to skip the Application controller reconcile.
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
+	// AnnotationKeyApplicationSharding is set on a cluster secret to spread the Applications targeting the cluster
+	// across all application controller shards, instead of assigning the cluster to a single shard.
+	// Enabled when the value is "true".
+	AnnotationKeyApplicationSharding = "argocd.argoproj.io/application-sharding"
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -262,6 +270,12 @@ const (
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
 	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy or round-robin
 	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
+	// EnvControllerShardingLoadHysteresis is the percentage by which the measured load of a cluster must change before
+	// the load-based sharding algorithm takes the change into account (default: 20)
+	EnvControllerShardingLoadHysteresis = "ARGOCD_CONTROLLER_SHARDING_LOAD_HYSTERESIS"
+	// EnvControllerShardingLoadStabilizationWindow is the minimum duration between two changes of the load of a cluster
+	// taken into account by the load-based sharding algorithm (default: 10m)
+	EnvControllerShardingLoadStabilizationWindow = "ARGOCD_CONTROLLER_SHARDING_LOAD_STABILIZATION_WINDOW"
 	// EnvEnableDynamicClusterDistribution enables dynamic sharding (ALPHA)
 	EnvEnableDynamicClusterDistribution = "ARGOCD_ENABLE_DYNAMIC_CLUSTER_DISTRIBUTION"
 	// EnvEnableGRPCTimeHistogramEnv enables gRPC metrics collection
@@ -403,6 +417,10 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetPromote is an annotation that is added when a step of the RollingSync strategy of an ApplicationSet is promoted. Its value is the number of the step. The ApplicationSet controller will remove this annotation once the promotion is recorded in the status.
+	AnnotationApplicationSetPromote = "argocd.argoproj.io/application-set-promote"
+	// AnnotationApplicationSetApproveDeletion is an annotation that is added when the deletions of Applications exceeding the maxDeletions of an ApplicationSet are approved. Its value is the digest of the deletions awaiting approval recorded in the ResourcesUpToDate condition, the approval is ignored if it does not match the pending deletions. The ApplicationSet controller will remove this annotation once the Applications are deleted.
+	AnnotationApplicationSetApproveDeletion = "argocd.argoproj.io/application-set-approve-deletion"
 )
 
 // gRPC settings
@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/labels"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// getAppDependencies returns the Applications an application depends on. Only the Applications in the namespace and
+// the project of the application are considered, the names of the dependencies which do not match any of them are
+// returned as missing.
+func (ctrl *ApplicationController) getAppDependencies(app *appv1.Application) ([]*appv1.Application, []string, error) {
+	apps, err := ctrl.appLister.Applications(app.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing applications: %w", err)
+	}
+
+	dependencies := map[string]*appv1.Application{}
+	var missing []string
+	for _, dependency := range app.Spec.DependsOn {
+		found := false
+		for _, candidate := range apps {
+			if candidate.Name == app.Name || candidate.Spec.GetProject() != app.Spec.GetProject() {
+				continue
+			}
+			matches, err := dependency.Matches(candidate)
+			if err != nil {
+				return nil, nil, err
+			}
+			if matches {
+				found = true
+				dependencies[candidate.Name] = candidate
+			}
+		}
+		if !found && dependency.Name != "" && dependency.Name != app.Name {
+			missing = append(missing, dependency.Name)
+		}
+	}
+
+	res := make([]*appv1.Application, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		res = append(res, dependency)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res, missing, nil
+}
+
+// dependencyNotReadyReason returns why an Application is not ready for the applications which depend on it to be
+// synced, or an empty string if it is synced to its target revision and healthy.
+func dependencyNotReadyReason(app *appv1.Application) string {
+	switch {
+	case app.Operation != nil || (app.Status.OperationState != nil && !app.Status.OperationState.Phase.Completed()):
+		return "is being synced"
+	case app.Status.Sync.Status == "":
+		return "has not been compared yet"
+	case app.Status.Sync.Status != appv1.SyncStatusCodeSynced:
+		return "is " + string(app.Status.Sync.Status)
+	case !currentSourceEqualsSyncedSource(app) || !reflect.DeepEqual(app.Spec.Destination, app.Status.Sync.ComparedTo.Destination):
+		return "has not been synced to its target revision yet"
+	case app.Status.Health.Status != health.HealthStatusHealthy:
+		return "is " + string(app.Status.Health.Status)
+	}
+	return ""
+}
+
+// findAppDependencyCycle returns the names of the Applications forming a dependency cycle through the application, or
+// nil if there is none.
+func (ctrl *ApplicationController) findAppDependencyCycle(app *appv1.Application) ([]string, error) {
+	visited := map[string]bool{}
+	var visit func(current *appv1.Application, path []string) ([]string, error)
+	visit = func(current *appv1.Application, path []string) ([]string, error) {
+		dependencies, _, err := ctrl.getAppDependencies(current)
+		if err != nil {
+			return nil, err
+		}
+		for _, dependency := range dependencies {
+			if dependency.Name == app.Name {
+				return append(path, app.Name), nil
+			}
+			if visited[dependency.Name] {
+				continue
+			}
+			visited[dependency.Name] = true
+			cycle, err := visit(dependency, append(path, dependency.Name))
+			if err != nil || cycle != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+	return visit(app, []string{app.Name})
+}
+
+// dependencyNotReadyCondition returns a DependencyNotReady condition listing the dependencies of the application which
+// are missing or not ready, or nil if the application can be synced.
+func (ctrl *ApplicationController) dependencyNotReadyCondition(app *appv1.Application) *appv1.ApplicationCondition {
+	if len(app.Spec.DependsOn) == 0 {
+		return nil
+	}
+	dependencies, missing, err := ctrl.getAppDependencies(app)
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionDependencyNotReady, Message: err.Error()}
+	}
+
+	var messages []string
+	for _, name := range missing {
+		messages = append(messages, fmt.Sprintf("Application '%s' not found in project '%s'", name, app.Spec.GetProject()))
+	}
+	for _, dependency := range dependencies {
+		if reason := dependencyNotReadyReason(dependency); reason != "" {
+			messages = append(messages, fmt.Sprintf("Application '%s' %s", dependency.Name, reason))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	// A cycle only matters when a dependency is not ready, the applications of the cycle are then waiting for each other
+	cycle, err := ctrl.findAppDependencyCycle(app)
+	if err != nil {
+		return &appv1.ApplicationCondition{Type: appv1.ApplicationConditionDependencyNotReady, Message: err.Error()}
+	}
+	if cycle != nil {
+		messages = append(messages, "dependency cycle detected: "+strings.Join(cycle, " -> "))
+	}
+	return &appv1.ApplicationCondition{
+		Type:    appv1.ApplicationConditionDependencyNotReady,
+		Message: "Waiting for dependencies: " + strings.Join(messages, "; "),
+	}
+}
+
+// requestDependentAppsRefresh requests a refresh of the applications depending on the given Application, so that
+// their syncs held until it is ready are resumed.
+func (ctrl *ApplicationController) requestDependentAppsRefresh(app *appv1.Application) {
+	apps, err := ctrl.appLister.Applications(app.Namespace).List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to list applications depending on '%s': %v", app.QualifiedName(), err)
+		return
+	}
+	for _, dependent := range apps {
+		if dependent.Name == app.Name || dependent.Spec.GetProject() != app.Spec.GetProject() {
+			continue
+		}
+		for _, dependency := range dependent.Spec.DependsOn {
+			if matches, err := dependency.Matches(app); err == nil && matches {
+				ctrl.requestAppRefresh(dependent.QualifiedName(), CompareWithRecent.Pointer(), nil)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newFakeDependencyApp(name string, appLabels map[string]string, syncStatus v1alpha1.SyncStatusCode, healthStatus health.HealthStatusCode) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.Labels = appLabels
+	app.Status.Sync = v1alpha1.SyncStatus{
+		Status: syncStatus,
+		ComparedTo: v1alpha1.ComparedTo{
+			Source:      *app.Spec.Source,
+			Destination: app.Spec.Destination,
+		},
+	}
+	app.Status.Health.Status = healthStatus
+	return app
+}
+
+func newFakeDependentApp(dependsOn ...v1alpha1.ApplicationDependency) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Spec.DependsOn = dependsOn
+	return app
+}
+
+func TestDependencyNotReadyCondition(t *testing.T) {
+	platform := map[string]string{"tier": "platform"}
+
+	t.Run("no dependencies", func(t *testing.T) {
+		app := newFakeApp()
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+		assert.Nil(t, ctrl.dependencyNotReadyCondition(app))
+	})
+
+	t.Run("dependencies ready", func(t *testing.T) {
+		app := newFakeDependentApp(
+			v1alpha1.ApplicationDependency{Name: "cni"},
+			v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: platform}},
+		)
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{
+			app,
+			newFakeDependencyApp("cni", nil, v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+			newFakeDependencyApp("cert-manager", platform, v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy),
+		}}, nil)
+		assert.Nil(t, ctrl.dependencyNotReadyCondition(app))
+	})
+
+	t.Run("dependencies not ready", func(t *testing.T) {
+		app := newFakeDependentApp(
+			v1alpha1.ApplicationDependency{Name: "cni"},
+			v1alpha1.ApplicationDependency{Name: "ingress"},
+			v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: platform}},
+		)
+		app.Labels = platform
+		outdated := newFakeDependencyApp("cert-manager", platform, v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+		outdated.Spec.Source.TargetRevision = "v2"
+		otherProject := newFakeDependencyApp("monitoring", platform, v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+		otherProject.Spec.Project = "other"
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{
+			app,
+			newFakeDependencyApp("cni", nil, v1alpha1.SyncStatusCodeSynced, health.HealthStatusProgressing),
+			outdated,
+			otherProject,
+		}}, nil)
+
+		cond := ctrl.dependencyNotReadyCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, v1alpha1.ApplicationConditionDependencyNotReady, cond.Type)
+		assert.Equal(t, "Waiting for dependencies: Application 'ingress' not found in project 'default'; "+
+			"Application 'cert-manager' has not been synced to its target revision yet; Application 'cni' is Progressing", cond.Message)
+	})
+
+	t.Run("dependency cycle", func(t *testing.T) {
+		app := newFakeDependentApp(v1alpha1.ApplicationDependency{Name: "cni"})
+		cni := newFakeDependencyApp("cni", nil, v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy)
+		cni.Spec.DependsOn = []v1alpha1.ApplicationDependency{{Name: "my-app"}}
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, cni}}, nil)
+
+		cond := ctrl.dependencyNotReadyCondition(app)
+		require.NotNil(t, cond)
+		assert.Equal(t, "Waiting for dependencies: Application 'cni' is OutOfSync; dependency cycle detected: my-app -> cni -> my-app", cond.Message)
+	})
+}
+
+func TestDependencyNotReadyReason(t *testing.T) {
+	app := newFakeDependencyApp("cni", nil, v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+	assert.Empty(t, dependencyNotReadyReason(app))
+
+	app.Status.Sync.Status = ""
+	assert.Equal(t, "has not been compared yet", dependencyNotReadyReason(app))
+
+	app.Status.Sync.Status = v1alpha1.SyncStatusCodeOutOfSync
+	assert.Equal(t, "is OutOfSync", dependencyNotReadyReason(app))
+
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	assert.Equal(t, "is being synced", dependencyNotReadyReason(app))
+}
+
+func TestAutoSyncDependencyNotReady(t *testing.T) {
+	app := newFakeDependentApp(v1alpha1.ApplicationDependency{Name: "cni"})
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{
+		app,
+		newFakeDependencyApp("cni", nil, v1alpha1.SyncStatusCodeOutOfSync, health.HealthStatusHealthy),
+	}}, nil)
+	syncStatus := v1alpha1.SyncStatus{
+		Status:   v1alpha1.SyncStatusCodeOutOfSync,
+		Revision: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+	}
+	cond, _ := ctrl.autoSync(app, &syncStatus, []v1alpha1.ResourceStatus{{Name: "guestbook", Kind: kube.DeploymentKind, Status: v1alpha1.SyncStatusCodeOutOfSync}}, true)
+	require.NotNil(t, cond)
+	assert.Equal(t, v1alpha1.ApplicationConditionDependencyNotReady, cond.Type)
+	assert.Equal(t, "Waiting for dependencies: Application 'cni' is OutOfSync", cond.Message)
+
+	app, err := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(test.FakeArgoCDNamespace).Get(t.Context(), "my-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, app.Operation)
+}
+
+func TestProcessRequestedAppOperation_DependencyNotReady(t *testing.T) {
+	app := newFakeDependentApp(v1alpha1.ApplicationDependency{Name: "cni"})
+	app.Operation = &v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{
+		app,
+		&defaultProj,
+		newFakeDependencyApp("cni", nil, v1alpha1.SyncStatusCodeSynced, health.HealthStatusDegraded),
+	}}, nil)
+	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+	receivedPatch := map[string]any{}
+	fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+		}
+		return true, &v1alpha1.Application{}, nil
+	})
+
+	ctrl.processRequestedAppOperation(app)
+
+	assert.NotContains(t, receivedPatch["status"], "operationState")
+	require.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, v1alpha1.ApplicationConditionDependencyNotReady, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Waiting for dependencies: Application 'cni' is Degraded", app.Status.Conditions[0].Message)
+}
+
+func TestRequestDependentAppsRefresh(t *testing.T) {
+	app := newFakeDependentApp(v1alpha1.ApplicationDependency{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "platform"}}})
+	cni := newFakeDependencyApp("cni", map[string]string{"tier": "platform"}, v1alpha1.SyncStatusCodeSynced, health.HealthStatusHealthy)
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, cni}}, nil)
+
+	ctrl.requestDependentAppsRefresh(cni)
+
+	ok, level := ctrl.isRefreshRequested(ctrl.toAppKey(app.QualifiedName()))
+	assert.True(t, ok)
+	assert.Equal(t, CompareWithRecent, level)
+	ok, _ = ctrl.isRefreshRequested(ctrl.toAppKey(cni.QualifiedName()))
+	assert.False(t, ok)
+}
@@ -145,6 +145,19 @@ type ApplicationController struct {
 	deploymentInformer                informerv1.DeploymentInformer
 
 	hydrator *hydrator.Hydrator
+	// hydrationCoalescingWindow delays hydration so that dry commits pushed in quick succession are hydrated together. It
+	// is not extended by later requests, so hydration is delayed by at most the window.
+	hydrationCoalescingWindow time.Duration
+	// hydrationRateLimiter is the rate limiter of the hydration queue, which also applies to delayed hydrations.
+	hydrationRateLimiter workqueue.TypedRateLimiter[hydrator.HydrationQueueKey]
+
+	// clusterSyncConcurrency and projectSyncConcurrency limit how many sync operations run at the same time on a
+	// destination cluster and in a project, the other ones are queued. Unlimited if 0.
+	clusterSyncConcurrency int
+	projectSyncConcurrency int
+	// syncQueueMutex serializes the admission of the sync operations of this shard. The shards are not coordinated, see
+	// syncQueuedReason.
+	syncQueueMutex sync.Mutex
 }
 
 // NewApplicationController creates new instance of ApplicationController.
@@ -180,6 +193,10 @@ func NewApplicationController(
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
 	enableK8sEvent []string,
 	hydratorEnabled bool,
+	hydrationCoalescingWindow time.Duration,
+	clusterSyncConcurrency int,
+	projectSyncConcurrency int,
+	queuePrioritiesEnabled bool,
 ) (*ApplicationController, error) {
 	log.Infof("appResyncPeriod=%v, appHardResyncPeriod=%v, appResyncJitter=%v", appResyncPeriod, appHardResyncPeriod, appResyncJitter)
 	db := db.NewDB(namespace, settingsMgr, kubeClientset)
@@ -187,6 +204,7 @@ func NewApplicationController(
 		rateLimiterConfig = ratelimiter.GetDefaultAppRateLimiterConfig()
 		log.Info("Using default workqueue rate limiter config")
 	}
+	hydrationRateLimiter := ratelimiter.NewCustomAppControllerRateLimiter[hydrator.HydrationQueueKey](rateLimiterConfig)
 	ctrl := ApplicationController{
 		cache:                             argoCache,
 		namespace:                         namespace,
@@ -198,7 +216,8 @@ func NewApplicationController(
 		projectRefreshQueue:               workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "project_reconciliation_queue"}),
 		appComparisonTypeRefreshQueue:     workqueue.NewTypedRateLimitingQueue(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig)),
 		appHydrateQueue:                   workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[string]{Name: "app_hydration_queue"}),
-		hydrationQueue:                    workqueue.NewTypedRateLimitingQueueWithConfig(ratelimiter.NewCustomAppControllerRateLimiter[hydrator.HydrationQueueKey](rateLimiterConfig), workqueue.TypedRateLimitingQueueConfig[hydrator.HydrationQueueKey]{Name: "manifest_hydration_queue"}),
+		hydrationQueue:                    workqueue.NewTypedRateLimitingQueueWithConfig(hydrationRateLimiter, workqueue.TypedRateLimitingQueueConfig[hydrator.HydrationQueueKey]{Name: "manifest_hydration_queue"}),
+		hydrationRateLimiter:              hydrationRateLimiter,
 		db:                                db,
 		statusRefreshTimeout:              appResyncPeriod,
 		statusHardRefreshTimeout:          appHardResyncPeriod,
@@ -217,9 +236,16 @@ func NewApplicationController(
 		dynamicClusterDistributionEnabled: dynamicClusterDistributionEnabled,
 		ignoreNormalizerOpts:              ignoreNormalizerOpts,
 		metricsClusterLabels:              metricsClusterLabels,
+		hydrationCoalescingWindow:         hydrationCoalescingWindow,
+		clusterSyncConcurrency:            clusterSyncConcurrency,
+		projectSyncConcurrency:            projectSyncConcurrency,
+	}
+	if queuePrioritiesEnabled {
+		ctrl.appRefreshQueue = ctrl.newAppPriorityQueue("app_reconciliation_queue", rateLimiterConfig)
+		ctrl.appOperationQueue = ctrl.newAppPriorityQueue("app_operation_processing_queue", rateLimiterConfig)
 	}
 	if hydratorEnabled {
-		ctrl.hydrator = hydrator.NewHydrator(&ctrl, appResyncPeriod, commitClientset)
+		ctrl.hydrator = hydrator.NewHydrator(&ctrl, appResyncPeriod, commitClientset, hydrationCoalescingWindow > 0)
 	}
 	if kubectlParallelismLimit > 0 {
 		ctrl.kubectlSemaphore = semaphore.NewWeighted(kubectlParallelismLimit)
@@ -335,10 +361,44 @@ func NewApplicationController(
 	ctrl.deploymentInformer = deploymentInformer
 	ctrl.appStateManager = appStateManager
 	ctrl.stateCache = stateCache
+	clusterSharding.SetAppShardingChangedHandler(ctrl.refreshClusterApps)
+	clusterSharding.SetDistributionChangedHandler(ctrl.handleClusterDistributionChange)
 
 	return &ctrl, nil
 }
 
+// handleClusterDistributionChange is called when the cluster moves to or away from this shard. The shard losing the
+// cluster releases its cache, and the shard gaining it refreshes its applications, which would otherwise only be
+// processed once the informer resyncs.
+func (ctrl *ApplicationController) handleClusterDistributionChange(c *appv1.Cluster) {
+	ctrl.stateCache.ReleaseCluster(c)
+	ctrl.refreshClusterApps(c)
+}
+
+// refreshClusterApps requests a refresh of the applications targeting the cluster which are processed by this shard.
+// It is called when application-level sharding is enabled or disabled for the cluster, or when the cluster moves between
+// shards: the applications which moved to this shard would otherwise only be processed once the informer resyncs.
+func (ctrl *ApplicationController) refreshClusterApps(c *appv1.Cluster) {
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to list applications targeting cluster %s: %v", c.Server, err)
+		return
+	}
+	for _, app := range apps {
+		if app.Spec.Destination.Server != c.Server && (app.Spec.Destination.Name == "" || app.Spec.Destination.Name != c.Name) {
+			continue
+		}
+		if !ctrl.canProcessApp(app) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(app)
+		if err == nil {
+			ctrl.appRefreshQueue.AddRateLimited(key)
+			ctrl.clusterSharding.AddApp(app)
+		}
+	}
+}
+
 func (ctrl *ApplicationController) InvalidateProjectsCache(names ...string) {
 	if len(names) > 0 {
 		for _, name := range names {
@@ -912,6 +972,7 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
+	go wait.Until(ctrl.updateClusterLoads, sharding.LoadUpdateInterval, ctx.Done())
 
 	for i := 0; i < statusProcessors; i++ {
 		go wait.Until(func() {
@@ -1358,6 +1419,28 @@ func (ctrl *ApplicationController) setAppCondition(app *appv1.Application, condi
 	}
 }
 
+// removeAppCondition removes the conditions of the given type from the application, if any
+func (ctrl *ApplicationController) removeAppCondition(app *appv1.Application, conditionType appv1.ApplicationConditionType) {
+	if len(app.Status.GetConditions(map[appv1.ApplicationConditionType]bool{conditionType: true})) == 0 {
+		return
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+
+	app.Status.SetConditions([]appv1.ApplicationCondition{}, map[appv1.ApplicationConditionType]bool{conditionType: true})
+
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"conditions": app.Status.Conditions,
+		},
+	})
+	if err == nil {
+		_, err = ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace).Patch(context.Background(), app.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	}
+	if err != nil {
+		logCtx.Errorf("Unable to remove application condition: %v", err)
+	}
+}
+
 func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Application) {
 	logCtx := log.WithFields(applog.GetAppLogFields(app))
 	var state *appv1.OperationState
@@ -1388,6 +1471,15 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 		terminating = state.Phase == synccommon.OperationTerminating
 		// Failed  operation with retry strategy might have be in-progress and has completion time
 		switch {
+		case state.Phase == appv1.OperationQueued:
+			if !ctrl.startOrQueueSyncOperation(app, state) {
+				logCtx.Debugf("Sync operation is still queued: %s", state.Message)
+				return
+			}
+			if ctrl.syncTimeout != time.Duration(0) {
+				ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), ctrl.syncTimeout)
+			}
+			logCtx.Infof("Started queued operation: %v", state.Operation)
 		case state.FinishedAt != nil && !terminating:
 			retryAt, err := app.Status.OperationState.Operation.Retry.NextRetryAt(state.FinishedAt.Time, state.RetryCount)
 			if err != nil {
@@ -1417,8 +1509,24 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 			logCtx.Infof("Resuming in-progress operation. phase: %s, message: %s", state.Phase, state.Message)
 		}
 	} else {
+		if app.Operation.Sync != nil {
+			// The sync is held until the applications it depends on are ready, it is processed again when they change
+			if depCond := ctrl.dependencyNotReadyCondition(app); depCond != nil {
+				logCtx.Infof("Holding sync operation: %s", depCond.Message)
+				ctrl.setAppCondition(app, *depCond)
+				return
+			}
+			ctrl.removeAppCondition(app, appv1.ApplicationConditionDependencyNotReady)
+		}
 		state = &appv1.OperationState{Phase: synccommon.OperationRunning, Operation: *app.Operation, StartedAt: metav1.Now()}
-		ctrl.setOperationState(app, state)
+		if app.Operation.Sync != nil {
+			if !ctrl.startOrQueueSyncOperation(app, state) {
+				logCtx.Infof("Queued new operation: %s", state.Message)
+				return
+			}
+		} else {
+			ctrl.setOperationState(app, state)
+		}
 		if ctrl.syncTimeout != time.Duration(0) {
 			// Schedule a check during which the timeout would be checked.
 			ctrl.appOperationQueue.AddAfter(ctrl.toAppKey(app.QualifiedName()), ctrl.syncTimeout)
@@ -1483,6 +1591,10 @@ func (ctrl *ApplicationController) processRequestedAppOperation(app *appv1.Appli
 
 	ctrl.setOperationState(app, state)
 	ts.AddCheckpoint("final_set_operation_state")
+	if state.Phase.Completed() {
+		// A slot was released for the queued sync operations
+		ctrl.requeueQueuedSyncOperations()
+	}
 	if state.Phase.Completed() && (app.Operation.Sync != nil && !app.Operation.Sync.DryRun) {
 		// if we just completed an operation, force a refresh so that UI will report up-to-date
 		// sync/health information
@@ -1757,20 +1869,24 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 		app.Status.Summary = tree.GetSummary(app)
 	}
 
-	canSync, _ := project.Spec.SyncWindows.Matches(app).CanSync(false)
+	canSync := false
+	if windows, err := argo.GetSyncWindows(project, ctrl.settingsMgr, time.Now(), time.Now()); err != nil {
+		logCtx.Warnf("Failed to resolve sync windows: %v", err)
+	} else {
+		canSync, _ = windows.Matches(app).CanSync(false)
+	}
 	if canSync {
 		syncErrCond, opDuration := ctrl.autoSync(app, compareResult.syncStatus, compareResult.resources, compareResult.revisionUpdated)
 		setOpDuration = opDuration
+		evaluatedTypes := map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true}
+		if app.Operation == nil {
+			// The dependencies of a requested operation are checked, and reported, when the operation is processed
+			evaluatedTypes[appv1.ApplicationConditionDependencyNotReady] = true
+		}
 		if syncErrCond != nil {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{*syncErrCond},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
+			app.Status.SetConditions([]appv1.ApplicationCondition{*syncErrCond}, evaluatedTypes)
 		} else {
-			app.Status.SetConditions(
-				[]appv1.ApplicationCondition{},
-				map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionSyncError: true},
-			)
+			app.Status.SetConditions([]appv1.ApplicationCondition{}, evaluatedTypes)
 		}
 	} else {
 		logCtx.Info("Sync prevented by sync window")
@@ -2176,6 +2292,12 @@ func (ctrl *ApplicationController) autoSync(app *appv1.Application, syncStatus *
 		}
 	}
 
+	if depCond := ctrl.dependencyNotReadyCondition(app); depCond != nil {
+		logCtx.Infof("Skipping auto-sync: %s", depCond.Message)
+		return depCond, 0
+	}
+	ts.AddCheckpoint("dependencies_check_ms")
+
 	appIf := ctrl.applicationClientset.ArgoprojV1alpha1().Applications(app.Namespace)
 	ts.AddCheckpoint("get_applications_ms")
 	start := time.Now()
@@ -2315,7 +2437,7 @@ func (ctrl *ApplicationController) canProcessApp(obj any) bool {
 	if err != nil {
 		return ctrl.clusterSharding.IsManagedCluster(nil)
 	}
-	return ctrl.clusterSharding.IsManagedCluster(destCluster)
+	return ctrl.clusterSharding.IsManagedApp(app, destCluster)
 }
 
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister) {
@@ -2391,6 +2513,8 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				return nil, nil
 			},
+			syncClusterIndex: ctrl.syncClusterIndexFunc,
+			syncProjectIndex: ctrl.syncProjectIndexFunc,
 		},
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
@@ -2410,6 +2534,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 			},
 			UpdateFunc: func(old, new any) {
+				ctrl.requeueReleasedSyncOperations(old, new)
 				if !ctrl.canProcessApp(new) {
 					return
 				}
@@ -2429,6 +2554,10 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 						log.WithFields(applog.GetAppLogFields(newApp)).Info("Enabled automated sync")
 						compareWith = CompareWithLatest.Pointer()
 					}
+					if dependencyNotReadyReason(oldApp) != dependencyNotReadyReason(newApp) || !reflect.DeepEqual(oldApp.Labels, newApp.Labels) {
+						// Resume the syncs held until the application is ready
+						ctrl.requestDependentAppsRefresh(newApp)
+					}
 					if ctrl.statusRefreshJitter != 0 && oldApp.ResourceVersion == newApp.ResourceVersion {
 						// Handler is refreshing the apps, add a random jitter to spread the load and avoid spikes
 						jitter := time.Duration(float64(ctrl.statusRefreshJitter) * rand.Float64())
@@ -2446,6 +2575,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				ctrl.clusterSharding.UpdateApp(newApp)
 			},
 			DeleteFunc: func(obj any) {
+				ctrl.requeueReleasedSyncOperations(obj, nil)
 				if !ctrl.canProcessApp(obj) {
 					return
 				}
@@ -2482,6 +2612,22 @@ func (ctrl *ApplicationController) projectErrorToCondition(err error, app *appv1
 	return condition
 }
 
+// updateClusterLoads measures the load of the clusters managed by this shard for the load-based sharding algorithm.
+func (ctrl *ApplicationController) updateClusterLoads() {
+	eventsCount := ctrl.stateCache.GetClustersEventsCount()
+	samples := make(map[string]sharding.ClusterLoadSample)
+	for _, info := range ctrl.stateCache.GetClustersInfo() {
+		if info.LastCacheSyncTime == nil || info.SyncError != nil {
+			continue
+		}
+		samples[info.Server] = sharding.ClusterLoadSample{
+			ResourcesCount: int64(info.ResourcesCount),
+			EventsCount:    eventsCount[info.Server],
+		}
+	}
+	ctrl.clusterSharding.UpdateClusterLoads(samples)
+}
+
 func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Context) {
 	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.namespace)
 	go updater.Run(ctx)
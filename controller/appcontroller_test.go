@@ -38,6 +38,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	kubetesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
 	"sigs.k8s.io/yaml"
 
 	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
@@ -190,11 +191,15 @@ func newFakeControllerWithResync(data *fakeData, appResyncPeriod time.Duration,
 		normalizers.IgnoreNormalizerOpts{},
 		testEnableEventList,
 		false,
+		0,
+		0,
+		0,
+		false,
 	)
 	db := &dbmocks.ArgoDB{}
 	db.On("GetApplicationControllerReplicas").Return(1)
 	// Setting a default sharding algorithm for the tests where we cannot set it.
-	ctrl.clusterSharding = sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm)
+	ctrl.clusterSharding = sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -2309,6 +2314,19 @@ func Test_canProcessApp(t *testing.T) {
 	})
 }
 
+func Test_refreshClusterApps(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{app}}, nil)
+	// without delay, so that the queued applications can be counted right away
+	ctrl.appRefreshQueue = workqueue.NewTypedRateLimitingQueue(workqueue.NewTypedItemExponentialFailureRateLimiter[string](0, 0))
+
+	ctrl.refreshClusterApps(&v1alpha1.Cluster{Server: "https://other:6443", Name: "other"})
+	assert.Equal(t, 0, ctrl.appRefreshQueue.Len())
+
+	ctrl.refreshClusterApps(&v1alpha1.Cluster{Server: app.Spec.Destination.Server})
+	assert.Equal(t, 1, ctrl.appRefreshQueue.Len())
+}
+
 func Test_canProcessAppSkipReconcileAnnotation(t *testing.T) {
 	appSkipReconcileInvalid := newFakeApp()
 	appSkipReconcileInvalid.Annotations = map[string]string{common.AnnotationKeyAppSkipReconcile: "invalid-value"}
@@ -151,10 +151,16 @@ type LiveStateCache interface {
 	Run(ctx context.Context) error
 	// Returns information about monitored clusters
 	GetClustersInfo() []clustercache.ClusterInfo
+	// Returns the number of watch events received from each monitored cluster
+	GetClustersEventsCount() map[string]int64
+	// Returns the number of nodes of each monitored cluster
+	GetClustersNodesCount() map[string]int64
 	// Init must be executed before cache can be used
 	Init() error
 	// UpdateShard will update the shard of ClusterSharding when the shard has changed.
 	UpdateShard(shard int) bool
+	// ReleaseCluster drops the cache of the cluster if it is no longer handled by this shard.
+	ReleaseCluster(cluster *appv1.Cluster)
 }
 
 type ObjectUpdatedHandler = func(managedByApp map[string]bool, ref corev1.ObjectReference)
@@ -235,6 +241,13 @@ type liveStateCache struct {
 	clusters      map[string]clustercache.ClusterCache
 	cacheSettings cacheSettings
 	lock          sync.RWMutex
+
+	eventsCount     map[string]int64
+	eventsCountLock sync.Mutex
+
+	// nodes holds the keys of the Nodes of each cluster, so that they can be counted without iterating the cached resources
+	nodes     map[string]map[kube.ResourceKey]bool
+	nodesLock sync.Mutex
 }
 
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
@@ -556,6 +569,9 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 			}
 
 			gvk := un.GroupVersionKind()
+			if isNode(gvk.GroupKind()) {
+				c.addNode(cluster.Server, kube.GetResourceKey(un))
+			}
 
 			if cacheSettings.ignoreResourceUpdatesEnabled && shouldHashManifest(appName, gvk, un) {
 				hash, err := generateManifestHash(un, nil, cacheSettings.resourceOverrides, c.ignoreNormalizerOpts)
@@ -586,6 +602,9 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 			ref = newRes.Ref
 		} else {
 			ref = oldRes.Ref
+			if key := oldRes.ResourceKey(); isNode(key.GroupKind()) {
+				c.removeNode(cluster.Server, key)
+			}
 		}
 
 		c.lock.RLock()
@@ -627,6 +646,12 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 	_ = clusterCache.OnEvent(func(_ watch.EventType, un *unstructured.Unstructured) {
 		gvk := un.GroupVersionKind()
 		c.metricsServer.IncClusterEventsCount(cluster.Server, gvk.Group, gvk.Kind)
+		c.eventsCountLock.Lock()
+		if c.eventsCount == nil {
+			c.eventsCount = make(map[string]int64)
+		}
+		c.eventsCount[cluster.Server]++
+		c.eventsCountLock.Unlock()
 	})
 
 	_ = clusterCache.OnProcessEventsHandler(func(duration time.Duration, processedEventsNumber int) {
@@ -657,7 +682,8 @@ func (c *liveStateCache) invalidate(cacheSettings cacheSettings) {
 	clusters := c.clusters
 	c.lock.Unlock()
 
-	for _, clust := range clusters {
+	for server, clust := range clusters {
+		c.resetNodes(server)
 		clust.Invalidate(clustercache.SetSettings(cacheSettings.clusterSettings))
 	}
 	log.Info("live state cache invalidated")
@@ -812,7 +838,7 @@ func (c *liveStateCache) Run(ctx context.Context) error {
 }
 
 func (c *liveStateCache) canHandleCluster(cluster *appv1.Cluster) bool {
-	return c.clusterSharding.IsManagedCluster(cluster)
+	return c.clusterSharding.IsCachedCluster(cluster)
 }
 
 func (c *liveStateCache) handleAddEvent(cluster *appv1.Cluster) {
@@ -846,10 +872,7 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 	c.lock.Unlock()
 	if ok {
 		if !c.canHandleCluster(newCluster) {
-			cluster.Invalidate()
-			c.lock.Lock()
-			delete(c.clusters, newCluster.Server)
-			c.lock.Unlock()
+			c.dropCluster(newCluster.Server)
 			return
 		}
 
@@ -876,6 +899,7 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 		}
 
 		if len(updateSettings) > 0 || forceInvalidate {
+			c.resetNodes(newCluster.Server)
 			cluster.Invalidate(updateSettings...)
 			go func() {
 				// warm up cluster cache
@@ -886,16 +910,31 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 }
 
 func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
-	c.lock.RLock()
 	c.clusterSharding.Delete(clusterServer)
-	cluster, ok := c.clusters[clusterServer]
-	c.lock.RUnlock()
+	c.dropCluster(clusterServer)
+}
+
+// ReleaseCluster drops the cache of the cluster if it is no longer handled by this shard. It is called when the
+// sharding distribution moves the cluster, since the cluster itself has not changed then.
+func (c *liveStateCache) ReleaseCluster(cluster *appv1.Cluster) {
+	if !c.canHandleCluster(cluster) {
+		c.dropCluster(cluster.Server)
+	}
+}
+
+// dropCluster invalidates and forgets the cache of the cluster, along with the events and nodes counted for it.
+func (c *liveStateCache) dropCluster(server string) {
+	c.lock.Lock()
+	cluster, ok := c.clusters[server]
+	delete(c.clusters, server)
+	c.lock.Unlock()
 	if ok {
 		cluster.Invalidate()
-		c.lock.Lock()
-		delete(c.clusters, clusterServer)
-		c.lock.Unlock()
 	}
+	c.resetNodes(server)
+	c.eventsCountLock.Lock()
+	delete(c.eventsCount, server)
+	c.eventsCountLock.Unlock()
 }
 
 func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
@@ -915,6 +954,64 @@ func (c *liveStateCache) GetClustersInfo() []clustercache.ClusterInfo {
 	return res
 }
 
+func (c *liveStateCache) GetClustersEventsCount() map[string]int64 {
+	c.eventsCountLock.Lock()
+	defer c.eventsCountLock.Unlock()
+	res := make(map[string]int64, len(c.eventsCount))
+	for server, count := range c.eventsCount {
+		res[server] = count
+	}
+	return res
+}
+
+func (c *liveStateCache) GetClustersNodesCount() map[string]int64 {
+	c.lock.RLock()
+	servers := make([]string, 0, len(c.clusters))
+	for server := range c.clusters {
+		servers = append(servers, server)
+	}
+	c.lock.RUnlock()
+
+	c.nodesLock.Lock()
+	defer c.nodesLock.Unlock()
+	res := make(map[string]int64, len(servers))
+	for _, server := range servers {
+		res[server] = int64(len(c.nodes[server]))
+	}
+	return res
+}
+
+func isNode(gk schema.GroupKind) bool {
+	return gk.Group == "" && gk.Kind == "Node"
+}
+
+// addNode records a Node of the cluster. It is called whenever the cluster cache lists or watches a Node.
+func (c *liveStateCache) addNode(server string, key kube.ResourceKey) {
+	c.nodesLock.Lock()
+	defer c.nodesLock.Unlock()
+	if c.nodes == nil {
+		c.nodes = make(map[string]map[kube.ResourceKey]bool)
+	}
+	if c.nodes[server] == nil {
+		c.nodes[server] = make(map[kube.ResourceKey]bool)
+	}
+	c.nodes[server][key] = true
+}
+
+// removeNode forgets a Node of the cluster which has been removed from the cluster cache.
+func (c *liveStateCache) removeNode(server string, key kube.ResourceKey) {
+	c.nodesLock.Lock()
+	defer c.nodesLock.Unlock()
+	delete(c.nodes[server], key)
+}
+
+// resetNodes forgets the Nodes of the cluster when its cache is invalidated, since the next sync lists them again.
+func (c *liveStateCache) resetNodes(server string) {
+	c.nodesLock.Lock()
+	defer c.nodesLock.Unlock()
+	delete(c.nodes, server)
+}
+
 func (c *liveStateCache) GetClusterCache(server *appv1.Cluster) (clustercache.ClusterCache, error) {
 	return c.getSyncedCluster(server)
 }
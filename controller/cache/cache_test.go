@@ -49,7 +49,7 @@ func TestHandleModEvent_HasChanges(_ *testing.T) {
 		clusters: map[string]cache.ClusterCache{
 			"https://mycluster": clusterCache,
 		},
-		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
+		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm, nil),
 	}
 
 	clustersCache.handleModEvent(&appv1.Cluster{
@@ -77,7 +77,7 @@ func TestHandleModEvent_ClusterExcluded(t *testing.T) {
 		settingsMgr:   &argosettings.SettingsManager{},
 		metricsServer: &metrics.MetricsServer{},
 		// returns a shard that never process any cluster
-		clusterSharding:  sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
+		clusterSharding:  sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm, nil),
 		resourceTracking: nil,
 		clusters:         map[string]cache.ClusterCache{"https://mycluster": clusterCache},
 		cacheSettings:    cacheSettings{},
@@ -106,7 +106,7 @@ func TestHandleModEvent_NoChanges(_ *testing.T) {
 		clusters: map[string]cache.ClusterCache{
 			"https://mycluster": clusterCache,
 		},
-		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
+		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm, nil),
 	}
 
 	clustersCache.handleModEvent(&appv1.Cluster{
@@ -123,7 +123,7 @@ func TestHandleAddEvent_ClusterExcluded(t *testing.T) {
 	db.On("GetApplicationControllerReplicas").Return(1)
 	clustersCache := liveStateCache{
 		clusters:        map[string]cache.ClusterCache{},
-		clusterSharding: sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm),
+		clusterSharding: sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm, nil),
 	}
 	clustersCache.handleAddEvent(&appv1.Cluster{
 		Server: "https://mycluster",
@@ -148,7 +148,7 @@ func TestHandleDeleteEvent_CacheDeadlock(t *testing.T) {
 		clusters: map[string]cache.ClusterCache{
 			testCluster.Server: gitopsEngineClusterCache,
 		},
-		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
+		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm, nil),
 		settingsMgr:     settingsMgr,
 		// Set the lock here so we can reference it later
 		//nolint:govet // We need to overwrite here to have access to the lock
@@ -746,3 +746,61 @@ func Test_GetVersionsInfo_error_redacted(t *testing.T) {
 	require.Error(t, err)
 	assert.NotContains(t, err.Error(), "password")
 }
+
+func TestGetClustersNodesCount(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate", mock.Anything).Return(nil)
+	db := &dbmocks.ArgoDB{}
+	db.On("GetApplicationControllerReplicas").Return(1)
+	c := liveStateCache{
+		clusters: map[string]cache.ClusterCache{
+			"https://cluster1": clusterCache,
+			"https://cluster2": clusterCache,
+		},
+		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm, nil),
+	}
+	node1 := kube.NewResourceKey("", "Node", "", "node1")
+	node2 := kube.NewResourceKey("", "Node", "", "node2")
+
+	c.addNode("https://cluster1", node1)
+	c.addNode("https://cluster1", node2)
+	// a Node seen again on relist is only counted once
+	c.addNode("https://cluster1", node1)
+	assert.Equal(t, map[string]int64{"https://cluster1": 2, "https://cluster2": 0}, c.GetClustersNodesCount())
+
+	c.removeNode("https://cluster1", node2)
+	assert.Equal(t, map[string]int64{"https://cluster1": 1, "https://cluster2": 0}, c.GetClustersNodesCount())
+
+	c.handleDeleteEvent("https://cluster1")
+	assert.Equal(t, map[string]int64{"https://cluster2": 0}, c.GetClustersNodesCount())
+	assert.Empty(t, c.nodes)
+}
+
+func TestReleaseCluster(t *testing.T) {
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("Invalidate", mock.Anything).Return(nil).Once()
+	db := &dbmocks.ArgoDB{}
+	db.On("GetApplicationControllerReplicas").Return(2)
+	clusterSharding := sharding.NewClusterSharding(db, 0, 2, common.DefaultShardingAlgorithm, nil)
+	shard := int64(0)
+	cluster := &appv1.Cluster{Server: "https://mycluster", Shard: &shard}
+	clusterSharding.Add(cluster)
+	c := liveStateCache{
+		clusters:        map[string]cache.ClusterCache{cluster.Server: clusterCache},
+		clusterSharding: clusterSharding,
+		eventsCount:     map[string]int64{cluster.Server: 10},
+	}
+
+	// the cache of a cluster still handled by this shard is kept
+	c.ReleaseCluster(cluster)
+	assert.Len(t, c.clusters, 1)
+
+	moved := cluster.DeepCopy()
+	otherShard := int64(1)
+	moved.Shard = &otherShard
+	clusterSharding.Update(cluster, moved)
+	c.ReleaseCluster(moved)
+	assert.Empty(t, c.clusters)
+	assert.Empty(t, c.GetClustersEventsCount())
+	clusterCache.AssertExpectations(t)
+}
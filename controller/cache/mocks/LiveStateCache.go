@@ -99,6 +99,52 @@ func (_c *LiveStateCache_GetClusterCache_Call) RunAndReturn(run func(server *v1a
 	return _c
 }
 
+// GetClustersEventsCount provides a mock function for the type LiveStateCache
+func (_mock *LiveStateCache) GetClustersEventsCount() map[string]int64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClustersEventsCount")
+	}
+
+	var r0 map[string]int64
+	if returnFunc, ok := ret.Get(0).(func() map[string]int64); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+	return r0
+}
+
+// LiveStateCache_GetClustersEventsCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClustersEventsCount'
+type LiveStateCache_GetClustersEventsCount_Call struct {
+	*mock.Call
+}
+
+// GetClustersEventsCount is a helper method to define mock.On call
+func (_e *LiveStateCache_Expecter) GetClustersEventsCount() *LiveStateCache_GetClustersEventsCount_Call {
+	return &LiveStateCache_GetClustersEventsCount_Call{Call: _e.mock.On("GetClustersEventsCount")}
+}
+
+func (_c *LiveStateCache_GetClustersEventsCount_Call) Run(run func()) *LiveStateCache_GetClustersEventsCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LiveStateCache_GetClustersEventsCount_Call) Return(stringToInt64 map[string]int64) *LiveStateCache_GetClustersEventsCount_Call {
+	_c.Call.Return(stringToInt64)
+	return _c
+}
+
+func (_c *LiveStateCache_GetClustersEventsCount_Call) RunAndReturn(run func() map[string]int64) *LiveStateCache_GetClustersEventsCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetClustersInfo provides a mock function for the type LiveStateCache
 func (_mock *LiveStateCache) GetClustersInfo() []cache.ClusterInfo {
 	ret := _mock.Called()
@@ -145,6 +191,52 @@ func (_c *LiveStateCache_GetClustersInfo_Call) RunAndReturn(run func() []cache.C
 	return _c
 }
 
+// GetClustersNodesCount provides a mock function for the type LiveStateCache
+func (_mock *LiveStateCache) GetClustersNodesCount() map[string]int64 {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClustersNodesCount")
+	}
+
+	var r0 map[string]int64
+	if returnFunc, ok := ret.Get(0).(func() map[string]int64); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+	return r0
+}
+
+// LiveStateCache_GetClustersNodesCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClustersNodesCount'
+type LiveStateCache_GetClustersNodesCount_Call struct {
+	*mock.Call
+}
+
+// GetClustersNodesCount is a helper method to define mock.On call
+func (_e *LiveStateCache_Expecter) GetClustersNodesCount() *LiveStateCache_GetClustersNodesCount_Call {
+	return &LiveStateCache_GetClustersNodesCount_Call{Call: _e.mock.On("GetClustersNodesCount")}
+}
+
+func (_c *LiveStateCache_GetClustersNodesCount_Call) Run(run func()) *LiveStateCache_GetClustersNodesCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *LiveStateCache_GetClustersNodesCount_Call) Return(stringToInt64 map[string]int64) *LiveStateCache_GetClustersNodesCount_Call {
+	_c.Call.Return(stringToInt64)
+	return _c
+}
+
+func (_c *LiveStateCache_GetClustersNodesCount_Call) RunAndReturn(run func() map[string]int64) *LiveStateCache_GetClustersNodesCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetManagedLiveObjs provides a mock function for the type LiveStateCache
 func (_mock *LiveStateCache) GetManagedLiveObjs(destCluster *v1alpha1.Cluster, a *v1alpha1.Application, targetObjs []*unstructured.Unstructured) (map[kube.ResourceKey]*unstructured.Unstructured, error) {
 	ret := _mock.Called(destCluster, a, targetObjs)
@@ -561,6 +653,40 @@ func (_c *LiveStateCache_IterateResources_Call) RunAndReturn(run func(server *v1
 	return _c
 }
 
+// ReleaseCluster provides a mock function for the type LiveStateCache
+func (_mock *LiveStateCache) ReleaseCluster(cluster *v1alpha1.Cluster) {
+	_mock.Called(cluster)
+	return
+}
+
+// LiveStateCache_ReleaseCluster_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseCluster'
+type LiveStateCache_ReleaseCluster_Call struct {
+	*mock.Call
+}
+
+// ReleaseCluster is a helper method to define mock.On call
+//   - cluster
+func (_e *LiveStateCache_Expecter) ReleaseCluster(cluster interface{}) *LiveStateCache_ReleaseCluster_Call {
+	return &LiveStateCache_ReleaseCluster_Call{Call: _e.mock.On("ReleaseCluster", cluster)}
+}
+
+func (_c *LiveStateCache_ReleaseCluster_Call) Run(run func(cluster *v1alpha1.Cluster)) *LiveStateCache_ReleaseCluster_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*v1alpha1.Cluster))
+	})
+	return _c
+}
+
+func (_c *LiveStateCache_ReleaseCluster_Call) Return() *LiveStateCache_ReleaseCluster_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *LiveStateCache_ReleaseCluster_Call) RunAndReturn(run func(cluster *v1alpha1.Cluster)) *LiveStateCache_ReleaseCluster_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Run provides a mock function for the type LiveStateCache
 func (_mock *LiveStateCache) Run(ctx context.Context) error {
 	ret := _mock.Called(ctx)
@@ -31,8 +31,15 @@ const (
 
 var clusterInfoTimeout = env.ParseDurationFromEnv(EnvClusterInfoTimeout, defaultSecretUpdateInterval, defaultSecretUpdateInterval, 1*time.Minute)
 
+// clustersInfoSource returns the information about the clusters monitored by the application controller
+type clustersInfoSource interface {
+	metrics.HasClustersInfo
+	// GetClustersNodesCount returns the number of nodes of each monitored cluster
+	GetClustersNodesCount() map[string]int64
+}
+
 type clusterInfoUpdater struct {
-	infoSource    metrics.HasClustersInfo
+	infoSource    clustersInfoSource
 	db            db.ArgoDB
 	appLister     v1alpha1.ApplicationNamespaceLister
 	cache         *appstatecache.Cache
@@ -43,7 +50,7 @@ type clusterInfoUpdater struct {
 }
 
 func NewClusterInfoUpdater(
-	infoSource metrics.HasClustersInfo,
+	infoSource clustersInfoSource,
 	db db.ArgoDB,
 	appLister v1alpha1.ApplicationNamespaceLister,
 	cache *appstatecache.Cache,
@@ -85,6 +92,7 @@ func (c *clusterInfoUpdater) updateClusters() {
 		info := clustersInfo[i]
 		infoByServer[info.Server] = &info
 	}
+	nodesCountByServer := c.infoSource.GetClustersNodesCount()
 	clusters, err := c.db.ListClusters(ctx)
 	if err != nil {
 		log.Warnf("Failed to save clusters info: %v", err)
@@ -103,7 +111,7 @@ func (c *clusterInfoUpdater) updateClusters() {
 	_ = kube.RunAllAsync(len(clustersFiltered), func(i int) error {
 		cluster := clustersFiltered[i]
 		clusterInfo := infoByServer[cluster.Server]
-		if err := c.updateClusterInfo(ctx, cluster, clusterInfo); err != nil {
+		if err := c.updateClusterInfo(ctx, cluster, clusterInfo, nodesCountByServer[cluster.Server]); err != nil {
 			log.Warnf("Failed to save cluster info: %v", err)
 		} else if err := updateClusterLabels(ctx, clusterInfo, cluster, c.db.UpdateCluster); err != nil {
 			log.Warnf("Failed to update cluster labels: %v", err)
@@ -113,17 +121,17 @@ func (c *clusterInfoUpdater) updateClusters() {
 	log.Debugf("Successfully saved info of %d clusters", len(clustersFiltered))
 }
 
-func (c *clusterInfoUpdater) updateClusterInfo(ctx context.Context, cluster appv1.Cluster, info *cache.ClusterInfo) error {
+func (c *clusterInfoUpdater) updateClusterInfo(ctx context.Context, cluster appv1.Cluster, info *cache.ClusterInfo, nodesCount int64) error {
 	apps, err := c.appLister.List(labels.Everything())
 	if err != nil {
 		return fmt.Errorf("error while fetching the apps list: %w", err)
 	}
 
-	updated := c.getUpdatedClusterInfo(ctx, apps, cluster, info, metav1.Now())
+	updated := c.getUpdatedClusterInfo(ctx, apps, cluster, info, nodesCount, metav1.Now())
 	return c.cache.SetClusterInfo(cluster.Server, &updated)
 }
 
-func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*appv1.Application, cluster appv1.Cluster, info *cache.ClusterInfo, now metav1.Time) appv1.ClusterInfo {
+func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*appv1.Application, cluster appv1.Cluster, info *cache.ClusterInfo, nodesCount int64, now metav1.Time) appv1.ClusterInfo {
 	var appCount int64
 	for _, a := range apps {
 		if c.projGetter != nil {
@@ -156,6 +164,7 @@ func (c *clusterInfoUpdater) getUpdatedClusterInfo(ctx context.Context, apps []*
 			clusterInfo.CacheInfo.LastCacheSyncTime = &syncTime
 			clusterInfo.CacheInfo.APIsCount = int64(info.APIsCount)
 			clusterInfo.CacheInfo.ResourcesCount = int64(info.ResourcesCount)
+			clusterInfo.CacheInfo.NodesCount = nodesCount
 		default:
 			clusterInfo.ConnectionState.Status = appv1.ConnectionStatusFailed
 			clusterInfo.ConnectionState.Message = info.SyncError.Error()
@@ -35,13 +35,14 @@ func TestClusterSecretUpdater(t *testing.T) {
 	now := time.Now()
 
 	tests := []struct {
-		LastCacheSyncTime *time.Time
-		SyncError         error
-		ExpectedStatus    v1alpha1.ConnectionStatus
+		LastCacheSyncTime  *time.Time
+		SyncError          error
+		ExpectedStatus     v1alpha1.ConnectionStatus
+		ExpectedNodesCount int64
 	}{
-		{nil, nil, v1alpha1.ConnectionStatusUnknown},
-		{&now, nil, v1alpha1.ConnectionStatusSuccessful},
-		{&now, errors.New("sync failed"), v1alpha1.ConnectionStatusFailed},
+		{nil, nil, v1alpha1.ConnectionStatusUnknown, 0},
+		{&now, nil, v1alpha1.ConnectionStatusSuccessful, 3},
+		{&now, errors.New("sync failed"), v1alpha1.ConnectionStatusFailed, 0},
 	}
 
 	emptyArgoCDConfigMap := &corev1.ConfigMap{
@@ -90,7 +91,7 @@ func TestClusterSecretUpdater(t *testing.T) {
 		lister := applisters.NewApplicationLister(appInformer.GetIndexer()).Applications(fakeNamespace)
 		updater := NewClusterInfoUpdater(nil, argoDB, lister, appCache, nil, nil, fakeNamespace)
 
-		err = updater.updateClusterInfo(t.Context(), *cluster, info)
+		err = updater.updateClusterInfo(t.Context(), *cluster, info, 3)
 		require.NoError(t, err, "Invoking updateClusterInfo failed.")
 
 		var clusterInfo v1alpha1.ClusterInfo
@@ -98,6 +99,7 @@ func TestClusterSecretUpdater(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, updatedK8sVersion, clusterInfo.ServerVersion)
 		assert.Equal(t, test.ExpectedStatus, clusterInfo.ConnectionState.Status)
+		assert.Equal(t, test.ExpectedNodesCount, clusterInfo.CacheInfo.NodesCount)
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -14,6 +15,7 @@ import (
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/git"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 )
 
@@ -21,11 +23,7 @@ import (
 // hydrator from having direct access to the app controller, and 2) it allows for easy mocking of dependencies in tests.
 // If you add something here, be sure that it is something the app controller needs to provide to the hydrator.
 type Dependencies interface {
-	// TODO: determine if we actually need to get the app, or if all the stuff we need the app for is done already on
-	//       the app controller side.
-	GetProcessableAppProj(app *appv1.Application) (*appv1.AppProject, error)
-	GetProcessableApps() (*appv1.ApplicationList, error)
-	GetRepoObjs(app *appv1.Application, source appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)
+	RenderDependencies
 	GetWriteCredentials(ctx context.Context, repoURL string, project string) (*appv1.Repository, error)
 	RequestAppRefresh(appName string, appNamespace string) error
 	// TODO: only allow access to the hydrator status
@@ -33,17 +31,31 @@ type Dependencies interface {
 	AddHydrationQueueItem(key HydrationQueueKey)
 }
 
+// RenderDependencies is the subset of Dependencies needed to render hydrated manifests without committing them. Besides
+// the app controller, it is implemented by the API server to preview hydration.
+type RenderDependencies interface {
+	// TODO: determine if we actually need to get the app, or if all the stuff we need the app for is done already on
+	//       the app controller side.
+	GetProcessableAppProj(app *appv1.Application) (*appv1.AppProject, error)
+	GetProcessableApps() (*appv1.ApplicationList, error)
+	GetRepoObjs(app *appv1.Application, sources []appv1.ApplicationSource, revisions []string, project *appv1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error)
+}
+
 type Hydrator struct {
 	dependencies         Dependencies
 	statusRefreshTimeout time.Duration
 	commitClientset      commitclient.Clientset
+	// coalesceDryCommits is set if the dry commits pushed while hydration is delayed are hydrated together, in which
+	// case they are listed in the hydrated commit.
+	coalesceDryCommits bool
 }
 
-func NewHydrator(dependencies Dependencies, statusRefreshTimeout time.Duration, commitClientset commitclient.Clientset) *Hydrator {
+func NewHydrator(dependencies Dependencies, statusRefreshTimeout time.Duration, commitClientset commitclient.Clientset, coalesceDryCommits bool) *Hydrator {
 	return &Hydrator{
 		dependencies:         dependencies,
 		statusRefreshTimeout: statusRefreshTimeout,
 		commitClientset:      commitClientset,
+		coalesceDryCommits:   coalesceDryCommits,
 	}
 }
 
@@ -114,7 +126,7 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey HydrationQueueKey) (pr
 		"destinationBranch":    hydrationKey.DestinationBranch,
 	})
 
-	relevantApps, drySHA, hydratedSHA, err := h.hydrateAppsLatestCommit(logCtx, hydrationKey)
+	relevantApps, drySHA, hydratedSHA, pullRequestURL, err := h.hydrateAppsLatestCommit(logCtx, hydrationKey)
 	if drySHA != "" {
 		logCtx = logCtx.WithField("drySHA", drySHA)
 	}
@@ -147,12 +159,14 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey HydrationQueueKey) (pr
 			DrySHA:         drySHA,
 			HydratedSHA:    hydratedSHA,
 			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			PullRequestURL: pullRequestURL,
 		}
 		app.Status.SourceHydrator.CurrentOperation = operation
 		app.Status.SourceHydrator.LastSuccessfulOperation = &appv1.SuccessfulHydrateOperation{
 			DrySHA:         drySHA,
 			HydratedSHA:    hydratedSHA,
 			SourceHydrator: app.Status.SourceHydrator.CurrentOperation.SourceHydrator,
+			PullRequestURL: pullRequestURL,
 		}
 		h.dependencies.PersistAppHydratorStatus(origApp, &app.Status.SourceHydrator)
 		// Request a refresh since we pushed a new commit.
@@ -164,23 +178,23 @@ func (h *Hydrator) ProcessHydrationQueueItem(hydrationKey HydrationQueueKey) (pr
 	return
 }
 
-func (h *Hydrator) hydrateAppsLatestCommit(logCtx *log.Entry, hydrationKey HydrationQueueKey) ([]*appv1.Application, string, string, error) {
-	relevantApps, err := h.getRelevantAppsForHydration(logCtx, hydrationKey)
+func (h *Hydrator) hydrateAppsLatestCommit(logCtx *log.Entry, hydrationKey HydrationQueueKey) ([]*appv1.Application, string, string, string, error) {
+	relevantApps, err := getRelevantAppsForHydration(logCtx, h.dependencies, hydrationKey)
 	if err != nil {
-		return nil, "", "", fmt.Errorf("failed to get relevant apps for hydration: %w", err)
+		return nil, "", "", "", fmt.Errorf("failed to get relevant apps for hydration: %w", err)
 	}
 
-	dryRevision, hydratedRevision, err := h.hydrate(logCtx, relevantApps)
+	dryRevision, hydratedRevision, pullRequestURL, err := h.hydrate(logCtx, relevantApps)
 	if err != nil {
-		return relevantApps, dryRevision, "", fmt.Errorf("failed to hydrate apps: %w", err)
+		return relevantApps, dryRevision, "", "", fmt.Errorf("failed to hydrate apps: %w", err)
 	}
 
-	return relevantApps, dryRevision, hydratedRevision, nil
+	return relevantApps, dryRevision, hydratedRevision, pullRequestURL, nil
 }
 
-func (h *Hydrator) getRelevantAppsForHydration(logCtx *log.Entry, hydrationKey HydrationQueueKey) ([]*appv1.Application, error) {
+func getRelevantAppsForHydration(logCtx *log.Entry, dependencies RenderDependencies, hydrationKey HydrationQueueKey) ([]*appv1.Application, error) {
 	// Get all apps
-	apps, err := h.dependencies.GetProcessableApps()
+	apps, err := dependencies.GetProcessableApps()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list apps: %w", err)
 	}
@@ -205,7 +219,7 @@ func (h *Hydrator) getRelevantAppsForHydration(logCtx *log.Entry, hydrationKey H
 		}
 
 		var proj *appv1.AppProject
-		proj, err = h.dependencies.GetProcessableAppProj(&app)
+		proj, err = dependencies.GetProcessableAppProj(&app)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get project %q for app %q: %w", app.Spec.Project, app.QualifiedName(), err)
 		}
@@ -215,6 +229,9 @@ func (h *Hydrator) getRelevantAppsForHydration(logCtx *log.Entry, hydrationKey H
 			logCtx.Warnf("App %q is not permitted to use source %q", app.QualifiedName(), app.Spec.Source.String())
 			continue
 		}
+		if !drySourcesPermitted(logCtx, proj, &app) {
+			continue
+		}
 
 		uniqueDestinationKey := uniqueHydrationDestination{
 			sourceRepoURL:        app.Spec.SourceHydrator.DrySource.RepoURL,
@@ -233,61 +250,220 @@ func (h *Hydrator) getRelevantAppsForHydration(logCtx *log.Entry, hydrationKey H
 	return relevantApps, nil
 }
 
-func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application) (string, string, error) {
+// drySourcesPermitted returns true if the project of the app permits all the sources the app is hydrated from.
+func drySourcesPermitted(logCtx *log.Entry, proj *appv1.AppProject, app *appv1.Application) bool {
+	for _, source := range app.Spec.SourceHydrator.GetDrySources() {
+		if !proj.IsSourcePermitted(source) {
+			logCtx.Warnf("App %q is not permitted to use dry source %q", app.QualifiedName(), source.RepoURL)
+			return false
+		}
+	}
+	return true
+}
+
+// getDrySourcesAndRevisions returns the sources the app is hydrated from along with the revisions to hydrate them at.
+// drySHA is the commit the dry source was already resolved to for another app, if any. Sources using the repository and
+// target revision of the dry source are pinned to it, so that all apps are hydrated from the same commit. The index of
+// the first of those sources is returned, so that the dry SHA can be taken from its manifest response.
+func getDrySourcesAndRevisions(app *appv1.Application, drySHA string) ([]appv1.ApplicationSource, []string, int, error) {
+	drySource := app.Spec.SourceHydrator.DrySource
+	// Copy the sources, so that pinning a revision does not modify the app spec.
+	sources := slices.Clone(app.Spec.SourceHydrator.GetDrySources())
+	revisions := make([]string, len(sources))
+	drySourceIndex := -1
+	for i, source := range sources {
+		if !git.SameURL(source.RepoURL, drySource.RepoURL) || source.TargetRevision != drySource.TargetRevision {
+			continue
+		}
+		if drySourceIndex == -1 {
+			drySourceIndex = i
+		}
+		if drySHA != "" {
+			// Also pin the target revision, since it is what $ref value files are resolved against.
+			sources[i].TargetRevision = drySHA
+			revisions[i] = drySHA
+		}
+	}
+	if drySourceIndex == -1 {
+		return nil, nil, -1, fmt.Errorf("none of the dry sources uses repo %q at revision %q", drySource.RepoURL, drySource.TargetRevision)
+	}
+	return sources, revisions, drySourceIndex, nil
+}
+
+func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application) (string, string, string, error) {
 	if len(apps) == 0 {
-		return "", "", nil
+		return "", "", "", nil
+	}
+	manifestsRequest, project, err := getManifestsRequest(h.dependencies, apps)
+	if err != nil {
+		return "", "", "", err
+	}
+	targetRevision := manifestsRequest.DrySha
+	manifestsRequest.HydrationRequestedAt = getHydrationRequestedAt(apps)
+	if h.coalesceDryCommits {
+		manifestsRequest.DrySourcePaths = getDrySourcePaths(apps)
+	}
+
+	repo, err := h.dependencies.GetWriteCredentials(context.Background(), manifestsRequest.Repo.Repo, project)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to get hydrator credentials: %w", err)
+	}
+	if repo == nil {
+		// Try without credentials.
+		logCtx.Warn("no credentials found for repo, continuing without credentials")
+	} else {
+		manifestsRequest.Repo = repo
 	}
+
+	closer, commitService, err := h.commitClientset.NewCommitServerClient()
+	if err != nil {
+		return targetRevision, "", "", fmt.Errorf("failed to create commit service: %w", err)
+	}
+	defer utilio.Close(closer)
+	resp, err := commitService.CommitHydratedManifests(context.Background(), manifestsRequest)
+	if err != nil {
+		return targetRevision, "", "", fmt.Errorf("failed to commit hydrated manifests: %w", err)
+	}
+	return targetRevision, resp.HydratedSha, resp.PullRequestURL, nil
+}
+
+// getHydrationRequestedAt returns the earliest time hydration was requested for any of the apps which are waiting to be
+// hydrated, or nil if none is.
+func getHydrationRequestedAt(apps []*appv1.Application) *metav1.Time {
+	var requestedAt *metav1.Time
+	for _, app := range apps {
+		operation := app.Status.SourceHydrator.CurrentOperation
+		if operation == nil || operation.Phase != appv1.HydrateOperationPhaseHydrating {
+			continue
+		}
+		if requestedAt == nil || operation.StartedAt.Before(requestedAt) {
+			requestedAt = operation.StartedAt.DeepCopy()
+		}
+	}
+	return requestedAt
+}
+
+// getDrySourcePaths returns the paths of the sources the apps are hydrated from in the repository of the dry source.
+func getDrySourcePaths(apps []*appv1.Application) []string {
+	var paths []string
+	for _, app := range apps {
+		drySource := app.Spec.SourceHydrator.DrySource
+		for _, source := range app.Spec.SourceHydrator.GetDrySources() {
+			if !git.SameURL(source.RepoURL, drySource.RepoURL) || source.TargetRevision != drySource.TargetRevision {
+				continue
+			}
+			path := source.Path
+			if path == "" {
+				path = "."
+			}
+			if !slices.Contains(paths, path) {
+				paths = append(paths, path)
+			}
+		}
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// DryRun renders the manifests of all apps sharing the hydration key of the given app, like the hydrator would, but
+// does not commit them. It returns the request the hydrator would send to the commit server, without repository
+// credentials, along with the project whose write credentials would be used. An empty project means that global
+// credentials are required.
+func DryRun(dependencies RenderDependencies, app *appv1.Application) (*commitclient.CommitHydratedManifestsRequest, string, error) {
+	if app.Spec.SourceHydrator == nil {
+		return nil, "", fmt.Errorf("app %q does not use the source hydrator", app.QualifiedName())
+	}
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	apps, err := getRelevantAppsForHydration(logCtx, dependencies, getHydrationQueueKey(app))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get relevant apps for hydration: %w", err)
+	}
+	if !slices.ContainsFunc(apps, func(a *appv1.Application) bool { return a.QualifiedName() == app.QualifiedName() }) {
+		return nil, "", fmt.Errorf("app %q is not permitted to be hydrated from its dry sources", app.QualifiedName())
+	}
+	manifestsRequest, project, err := getManifestsRequest(dependencies, apps)
+	if err != nil {
+		return nil, "", err
+	}
+	manifestsRequest.DryRun = true
+	return manifestsRequest, project, nil
+}
+
+// getManifestsRequest renders the manifests of the apps, which must share a hydration key, and returns the request to
+// commit them. The repository of the request does not contain credentials. The project whose write credentials should
+// be used is returned along with it: if all the apps are under the same project, that project, otherwise an empty
+// string to indicate that we need global creds.
+func getManifestsRequest(dependencies RenderDependencies, apps []*appv1.Application) (*commitclient.CommitHydratedManifestsRequest, string, error) {
 	repoURL := apps[0].Spec.SourceHydrator.DrySource.RepoURL
 	syncBranch := apps[0].Spec.SourceHydrator.SyncSource.TargetBranch
 	targetBranch := apps[0].Spec.GetHydrateToSource().TargetRevision
+	var pullRequest *appv1.HydratePullRequest
+	if apps[0].Spec.SourceHydrator.HydrateTo != nil {
+		pullRequest = apps[0].Spec.SourceHydrator.HydrateTo.PullRequest
+	}
 	var paths []*commitclient.PathDetails
 	projects := make(map[string]bool, len(apps))
 	var targetRevision string
 	// TODO: parallelize this loop
 	for _, app := range apps {
-		project, err := h.dependencies.GetProcessableAppProj(app)
+		project, err := dependencies.GetProcessableAppProj(app)
 		if err != nil {
-			return "", "", fmt.Errorf("failed to get project: %w", err)
+			return nil, "", fmt.Errorf("failed to get project: %w", err)
 		}
 		projects[project.Name] = true
-		drySource := appv1.ApplicationSource{
-			RepoURL:        app.Spec.SourceHydrator.DrySource.RepoURL,
-			Path:           app.Spec.SourceHydrator.DrySource.Path,
-			TargetRevision: app.Spec.SourceHydrator.DrySource.TargetRevision,
-		}
-		if targetRevision == "" {
-			targetRevision = app.Spec.SourceHydrator.DrySource.TargetRevision
+		drySources, dryRevisions, drySourceIndex, err := getDrySourcesAndRevisions(app, targetRevision)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get dry sources for app %q: %w", app.QualifiedName(), err)
 		}
 
 		// TODO: enable signature verification
-		objs, resp, err := h.dependencies.GetRepoObjs(app, drySource, targetRevision, project)
+		objs, resps, err := dependencies.GetRepoObjs(app, drySources, dryRevisions, project)
 		if err != nil {
-			return "", "", fmt.Errorf("failed to get repo objects for app %q: %w", app.QualifiedName(), err)
+			return nil, "", fmt.Errorf("failed to get repo objects for app %q: %w", app.QualifiedName(), err)
+		}
+		if len(resps) != len(drySources) {
+			return nil, "", fmt.Errorf("expected %d manifest responses for app %q, got %d", len(drySources), app.QualifiedName(), len(resps))
 		}
 
 		// This should be the DRY SHA. We set it here so that after processing the first app, all apps are hydrated
 		// using the same SHA.
-		targetRevision = resp.Revision
+		targetRevision = resps[drySourceIndex].Revision
 
 		// Set up a ManifestsRequest
 		manifestDetails := make([]*commitclient.HydratedManifestDetails, len(objs))
 		for i, obj := range objs {
 			objJSON, err := json.Marshal(obj)
 			if err != nil {
-				return "", "", fmt.Errorf("failed to marshal object: %w", err)
+				return nil, "", fmt.Errorf("failed to marshal object: %w", err)
 			}
 			manifestDetails[i] = &commitclient.HydratedManifestDetails{ManifestJSON: string(objJSON)}
 		}
 
+		var commands []string
+		var sourceDetails []*commitclient.DrySourceDetails
+		for i, resp := range resps {
+			commands = append(commands, resp.Commands...)
+			if app.Spec.SourceHydrator.DrySource.HasSources() {
+				source := app.Spec.SourceHydrator.DrySource.Sources[i]
+				sourceDetails = append(sourceDetails, &commitclient.DrySourceDetails{
+					RepoURL:        source.RepoURL,
+					Path:           source.Path,
+					Chart:          source.Chart,
+					TargetRevision: source.TargetRevision,
+					Revision:       resp.Revision,
+				})
+			}
+		}
+
 		paths = append(paths, &commitclient.PathDetails{
 			Path:      app.Spec.SourceHydrator.SyncSource.Path,
 			Manifests: manifestDetails,
-			Commands:  resp.Commands,
+			Commands:  commands,
+			Layout:    app.Spec.SourceHydrator.GetHydrateToLayout(),
+			Sources:   sourceDetails,
 		})
 	}
 
-	// If all the apps are under the same project, use that project. Otherwise, use an empty string to indicate that we
-	// need global creds.
 	project := ""
 	if len(projects) == 1 {
 		for p := range projects {
@@ -295,37 +471,15 @@ func (h *Hydrator) hydrate(logCtx *log.Entry, apps []*appv1.Application) (string
 		}
 	}
 
-	repo, err := h.dependencies.GetWriteCredentials(context.Background(), repoURL, project)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to get hydrator credentials: %w", err)
-	}
-	if repo == nil {
-		// Try without credentials.
-		repo = &appv1.Repository{
-			Repo: repoURL,
-		}
-		logCtx.Warn("no credentials found for repo, continuing without credentials")
-	}
-
-	manifestsRequest := commitclient.CommitHydratedManifestsRequest{
-		Repo:          repo,
+	return &commitclient.CommitHydratedManifestsRequest{
+		Repo:          &appv1.Repository{Repo: repoURL},
 		SyncBranch:    syncBranch,
 		TargetBranch:  targetBranch,
 		DrySha:        targetRevision,
 		CommitMessage: "[Argo CD Bot] hydrate " + targetRevision,
 		Paths:         paths,
-	}
-
-	closer, commitService, err := h.commitClientset.NewCommitServerClient()
-	if err != nil {
-		return targetRevision, "", fmt.Errorf("failed to create commit service: %w", err)
-	}
-	defer utilio.Close(closer)
-	resp, err := commitService.CommitHydratedManifests(context.Background(), &manifestsRequest)
-	if err != nil {
-		return targetRevision, "", fmt.Errorf("failed to commit hydrated manifests: %w", err)
-	}
-	return targetRevision, resp.HydratedSha, nil
+		PullRequest:   pullRequest,
+	}, project, nil
 }
 
 // appNeedsHydration answers if application needs manifests hydrated.
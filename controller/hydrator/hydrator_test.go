@@ -5,9 +5,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 )
 
 func Test_appNeedsHydration(t *testing.T) {
@@ -60,6 +63,36 @@ func Test_appNeedsHydration(t *testing.T) {
 			expectedNeedsHydration: true,
 			expectedMessage:        "spec.sourceHydrator differs",
 		},
+		{
+			name: "spec.sourceHydrator layout differs",
+			app: &v1alpha1.Application{
+				Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{SyncSource: v1alpha1.SyncSource{
+					Layout: &v1alpha1.HydratedManifestLayout{Type: v1alpha1.HydratedManifestLayoutSplitPerResource},
+				}}},
+				Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: &v1alpha1.HydrateOperation{
+					SourceHydrator: v1alpha1.SourceHydrator{},
+				}}},
+			},
+			timeout:                1 * time.Hour,
+			expectedNeedsHydration: true,
+			expectedMessage:        "spec.sourceHydrator differs",
+		},
+		{
+			name: "spec.sourceHydrator dry sources differ",
+			app: &v1alpha1.Application{
+				Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{DrySource: v1alpha1.DrySource{
+					Sources: v1alpha1.ApplicationSources{{RepoURL: "https://charts.example.com", Chart: "guestbook", TargetRevision: "1.0.1"}},
+				}}},
+				Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: &v1alpha1.HydrateOperation{
+					SourceHydrator: v1alpha1.SourceHydrator{DrySource: v1alpha1.DrySource{
+						Sources: v1alpha1.ApplicationSources{{RepoURL: "https://charts.example.com", Chart: "guestbook", TargetRevision: "1.0.0"}},
+					}},
+				}}},
+			},
+			timeout:                1 * time.Hour,
+			expectedNeedsHydration: true,
+			expectedMessage:        "spec.sourceHydrator differs",
+		},
 		{
 			name: "hydration failed more than two minutes ago",
 			app: &v1alpha1.Application{
@@ -101,3 +134,238 @@ func Test_appNeedsHydration(t *testing.T) {
 		})
 	}
 }
+
+func Test_getDrySourcesAndRevisions(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(drySource v1alpha1.DrySource) *v1alpha1.Application {
+		return &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{DrySource: drySource}}}
+	}
+
+	t.Run("single dry source", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApp(v1alpha1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "HEAD", Path: "guestbook"})
+		sources, revisions, drySourceIndex, err := getDrySourcesAndRevisions(app, "")
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.ApplicationSource{{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "HEAD", Path: "guestbook"}}, sources)
+		assert.Equal(t, []string{""}, revisions)
+		assert.Equal(t, 0, drySourceIndex)
+
+		sources, revisions, _, err = getDrySourcesAndRevisions(app, "abc123")
+		require.NoError(t, err)
+		assert.Equal(t, "abc123", sources[0].TargetRevision)
+		assert.Equal(t, []string{"abc123"}, revisions)
+		assert.Equal(t, "HEAD", app.Spec.SourceHydrator.DrySource.TargetRevision)
+	})
+
+	t.Run("multiple dry sources", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApp(v1alpha1.DrySource{
+			RepoURL:        "https://github.com/argoproj/values",
+			TargetRevision: "main",
+			Sources: v1alpha1.ApplicationSources{
+				{RepoURL: "oci://registry.example.com/charts", Chart: "guestbook", TargetRevision: "1.0.0"},
+				{RepoURL: "https://github.com/argoproj/values.git", TargetRevision: "main", Ref: "values"},
+				{RepoURL: "https://github.com/argoproj/values", TargetRevision: "main", Path: "extra"},
+			},
+		})
+		sources, revisions, drySourceIndex, err := getDrySourcesAndRevisions(app, "abc123")
+		require.NoError(t, err)
+		assert.Equal(t, 1, drySourceIndex)
+		assert.Equal(t, []string{"", "abc123", "abc123"}, revisions)
+		assert.Equal(t, "1.0.0", sources[0].TargetRevision)
+		assert.Equal(t, "abc123", sources[1].TargetRevision)
+		assert.Equal(t, "abc123", sources[2].TargetRevision)
+		assert.Equal(t, "main", app.Spec.SourceHydrator.DrySource.Sources[1].TargetRevision)
+	})
+
+	t.Run("no source uses the dry source repo", func(t *testing.T) {
+		t.Parallel()
+
+		app := newApp(v1alpha1.DrySource{
+			RepoURL:        "https://github.com/argoproj/values",
+			TargetRevision: "main",
+			Sources: v1alpha1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/values", TargetRevision: "stable", Ref: "values"},
+			},
+		})
+		_, _, _, err := getDrySourcesAndRevisions(app, "")
+		assert.ErrorContains(t, err, `none of the dry sources uses repo "https://github.com/argoproj/values" at revision "main"`)
+	})
+}
+
+// fakeRenderDependencies renders a ConfigMap named after the app for each dry source.
+type fakeRenderDependencies struct {
+	apps     []v1alpha1.Application
+	projects map[string]*v1alpha1.AppProject
+}
+
+func (d *fakeRenderDependencies) GetProcessableAppProj(app *v1alpha1.Application) (*v1alpha1.AppProject, error) {
+	return d.projects[app.Spec.Project], nil
+}
+
+func (d *fakeRenderDependencies) GetProcessableApps() (*v1alpha1.ApplicationList, error) {
+	return &v1alpha1.ApplicationList{Items: d.apps}, nil
+}
+
+func (d *fakeRenderDependencies) GetRepoObjs(app *v1alpha1.Application, sources []v1alpha1.ApplicationSource, revisions []string, _ *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
+	var objs []*unstructured.Unstructured
+	var resps []*apiclient.ManifestResponse
+	for i := range sources {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("v1")
+		obj.SetKind("ConfigMap")
+		obj.SetName(app.Name)
+		objs = append(objs, obj)
+		revision := revisions[i]
+		if revision == "" {
+			revision = "abc123"
+		}
+		resps = append(resps, &apiclient.ManifestResponse{Revision: revision, Commands: []string{"kustomize build ."}})
+	}
+	return objs, resps, nil
+}
+
+func Test_getHydrationRequestedAt(t *testing.T) {
+	t.Parallel()
+
+	now := metav1.NewTime(time.Now())
+	oneMinuteAgo := metav1.NewTime(now.Add(-1 * time.Minute))
+	oneHourAgo := metav1.NewTime(now.Add(-1 * time.Hour))
+	newApp := func(operation *v1alpha1.HydrateOperation) *v1alpha1.Application {
+		return &v1alpha1.Application{Status: v1alpha1.ApplicationStatus{SourceHydrator: v1alpha1.SourceHydratorStatus{CurrentOperation: operation}}}
+	}
+
+	t.Run("no app waiting for hydration", func(t *testing.T) {
+		t.Parallel()
+
+		apps := []*v1alpha1.Application{
+			newApp(nil),
+			newApp(&v1alpha1.HydrateOperation{StartedAt: oneHourAgo, Phase: v1alpha1.HydrateOperationPhaseHydrated}),
+		}
+		assert.Nil(t, getHydrationRequestedAt(apps))
+	})
+
+	t.Run("earliest request of apps waiting for hydration", func(t *testing.T) {
+		t.Parallel()
+
+		apps := []*v1alpha1.Application{
+			newApp(&v1alpha1.HydrateOperation{StartedAt: now, Phase: v1alpha1.HydrateOperationPhaseHydrating}),
+			newApp(&v1alpha1.HydrateOperation{StartedAt: oneHourAgo, Phase: v1alpha1.HydrateOperationPhaseFailed}),
+			newApp(&v1alpha1.HydrateOperation{StartedAt: oneMinuteAgo, Phase: v1alpha1.HydrateOperationPhaseHydrating}),
+		}
+		requestedAt := getHydrationRequestedAt(apps)
+		require.NotNil(t, requestedAt)
+		assert.True(t, oneMinuteAgo.Equal(requestedAt))
+	})
+}
+
+func Test_getDrySourcePaths(t *testing.T) {
+	t.Parallel()
+
+	repoURL := "https://github.com/argoproj/argocd-example-apps"
+	apps := []*v1alpha1.Application{
+		{Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{
+			DrySource: v1alpha1.DrySource{RepoURL: repoURL, TargetRevision: "HEAD", Path: "guestbook"},
+		}}},
+		{Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{
+			DrySource: v1alpha1.DrySource{RepoURL: repoURL + ".git", TargetRevision: "HEAD", Sources: v1alpha1.ApplicationSources{
+				{RepoURL: "https://charts.example.com", Chart: "nginx", TargetRevision: "1.0.0"},
+				{RepoURL: repoURL, TargetRevision: "HEAD", Path: "helm-guestbook"},
+				{RepoURL: repoURL, TargetRevision: "HEAD", Path: "guestbook"},
+			}},
+		}}},
+	}
+	assert.Equal(t, []string{"guestbook", "helm-guestbook"}, getDrySourcePaths(apps))
+
+	apps = append(apps, &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{SourceHydrator: &v1alpha1.SourceHydrator{
+		DrySource: v1alpha1.DrySource{RepoURL: repoURL, TargetRevision: "HEAD"},
+	}}})
+	assert.Equal(t, []string{".", "guestbook", "helm-guestbook"}, getDrySourcePaths(apps))
+}
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+
+	newApp := func(name, project, path string) v1alpha1.Application {
+		return v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec: v1alpha1.ApplicationSpec{
+				Project: project,
+				SourceHydrator: &v1alpha1.SourceHydrator{
+					DrySource:  v1alpha1.DrySource{RepoURL: "https://github.com/argoproj/argocd-example-apps", TargetRevision: "HEAD", Path: name},
+					SyncSource: v1alpha1.SyncSource{TargetBranch: "env/test", Path: path},
+				},
+			},
+		}
+	}
+	permissiveProject := func(name string) *v1alpha1.AppProject {
+		return &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"*"}},
+		}
+	}
+
+	t.Run("renders all apps of the hydration key", func(t *testing.T) {
+		t.Parallel()
+
+		otherBranch := newApp("other-branch", "default", "other")
+		otherBranch.Spec.SourceHydrator.SyncSource.TargetBranch = "env/prod"
+		deps := &fakeRenderDependencies{
+			apps:     []v1alpha1.Application{newApp("guestbook", "default", "guestbook"), newApp("helm-guestbook", "default", "helm-guestbook"), otherBranch},
+			projects: map[string]*v1alpha1.AppProject{"default": permissiveProject("default")},
+		}
+
+		request, project, err := DryRun(deps, &deps.apps[0])
+		require.NoError(t, err)
+		assert.Equal(t, "default", project)
+		assert.True(t, request.DryRun)
+		assert.Equal(t, "abc123", request.DrySha)
+		assert.Equal(t, "env/test", request.SyncBranch)
+		assert.Equal(t, "env/test", request.TargetBranch)
+		assert.Equal(t, "https://github.com/argoproj/argocd-example-apps", request.Repo.Repo)
+		require.Len(t, request.Paths, 2)
+		assert.Equal(t, "guestbook", request.Paths[0].Path)
+		assert.Equal(t, "helm-guestbook", request.Paths[1].Path)
+		assert.Equal(t, []string{"kustomize build ."}, request.Paths[1].Commands)
+		require.Len(t, request.Paths[1].Manifests, 1)
+		assert.JSONEq(t, `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"helm-guestbook"}}`, request.Paths[1].Manifests[0].ManifestJSON)
+	})
+
+	t.Run("apps in different projects require global credentials", func(t *testing.T) {
+		t.Parallel()
+
+		deps := &fakeRenderDependencies{
+			apps:     []v1alpha1.Application{newApp("guestbook", "default", "guestbook"), newApp("helm-guestbook", "other", "helm-guestbook")},
+			projects: map[string]*v1alpha1.AppProject{"default": permissiveProject("default"), "other": permissiveProject("other")},
+		}
+
+		_, project, err := DryRun(deps, &deps.apps[1])
+		require.NoError(t, err)
+		assert.Empty(t, project)
+	})
+
+	t.Run("source not permitted", func(t *testing.T) {
+		t.Parallel()
+
+		deps := &fakeRenderDependencies{
+			apps: []v1alpha1.Application{newApp("guestbook", "default", "guestbook")},
+			projects: map[string]*v1alpha1.AppProject{"default": {
+				ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "argocd"},
+				Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"https://github.com/argoproj/other"}},
+			}},
+		}
+
+		_, _, err := DryRun(deps, &deps.apps[0])
+		assert.ErrorContains(t, err, `app "argocd/guestbook" is not permitted to be hydrated from its dry sources`)
+	})
+
+	t.Run("source hydrator not configured", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := DryRun(&fakeRenderDependencies{}, &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"}})
+		assert.ErrorContains(t, err, `app "argocd/guestbook" does not use the source hydrator`)
+	})
+}
@@ -31,10 +31,7 @@ func (ctrl *ApplicationController) GetProcessableApps() (*appv1.ApplicationList,
 	return ctrl.getAppList(metav1.ListOptions{})
 }
 
-func (ctrl *ApplicationController) GetRepoObjs(origApp *appv1.Application, drySource appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
-	drySources := []appv1.ApplicationSource{drySource}
-	dryRevisions := []string{revision}
-
+func (ctrl *ApplicationController) GetRepoObjs(origApp *appv1.Application, drySources []appv1.ApplicationSource, dryRevisions []string, project *appv1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
 	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get app instance label key: %w", err)
@@ -48,6 +45,12 @@ func (ctrl *ApplicationController) GetRepoObjs(origApp *appv1.Application, drySo
 	//
 	// The long-term solution will probably be to persist the synced _dry_ revision and use that for the comparison.
 	delete(app.Annotations, appv1.AnnotationKeyManifestGeneratePaths)
+	if len(drySources) > 1 {
+		// Multiple dry sources are rendered like the sources of a multi-source app, which is required for $ref value
+		// files to be resolved.
+		app.Spec.SourceHydrator = nil
+		app.Spec.Sources = drySources
+	}
 
 	// FIXME: use cache and revision cache
 	objs, resp, _, err := ctrl.appStateManager.GetRepoObjs(app, drySources, appLabelKey, dryRevisions, true, true, false, project, false, false)
@@ -55,11 +58,11 @@ func (ctrl *ApplicationController) GetRepoObjs(origApp *appv1.Application, drySo
 		return nil, nil, fmt.Errorf("failed to get repo objects: %w", err)
 	}
 
-	if len(resp) != 1 {
-		return nil, nil, fmt.Errorf("expected one manifest response, got %d", len(resp))
+	if len(resp) != len(drySources) {
+		return nil, nil, fmt.Errorf("expected %d manifest responses, got %d", len(drySources), len(resp))
 	}
 
-	return objs, resp[0], nil
+	return objs, resp, nil
 }
 
 func (ctrl *ApplicationController) GetWriteCredentials(ctx context.Context, repoURL string, project string) (*appv1.Repository, error) {
@@ -86,5 +89,13 @@ func (ctrl *ApplicationController) PersistAppHydratorStatus(orig *appv1.Applicat
 }
 
 func (ctrl *ApplicationController) AddHydrationQueueItem(key hydrator.HydrationQueueKey) {
+	if ctrl.hydrationCoalescingWindow > 0 {
+		// The queue only keeps the earliest time an item is added after, so hydration happens once the window has passed
+		// since the first request, covering all dry commits pushed in the meantime. Later requests do not reset the window,
+		// so that hydration is not postponed indefinitely by frequent commits. AddAfter bypasses the rate limiter, so the
+		// item is delayed by its backoff instead if that is longer.
+		ctrl.hydrationQueue.AddAfter(key, max(ctrl.hydrationCoalescingWindow, ctrl.hydrationRateLimiter.When(key)))
+		return
+	}
 	ctrl.hydrationQueue.AddRateLimited(key)
 }
@@ -42,6 +42,7 @@ type MetricsServer struct {
 	redisRequestHistogram             *prometheus.HistogramVec
 	resourceEventsProcessingHistogram *prometheus.HistogramVec
 	resourceEventsNumberGauge         *prometheus.GaugeVec
+	appQueueDepthGauge                *prometheus.GaugeVec
 	registry                          *prometheus.Registry
 	hostname                          string
 	cron                              *cron.Cron
@@ -67,6 +68,13 @@ var (
 		nil,
 	)
 
+	descAppSyncQueueDepth = prometheus.NewDesc(
+		"argocd_app_sync_queue_depth",
+		"Number of sync operations queued until fewer of them run on their destination cluster or in their project.",
+		[]string{"dest_server", "dest_name", "project"},
+		nil,
+	)
+
 	syncCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "argocd_app_sync_total",
@@ -146,6 +154,11 @@ var (
 		Name: "argocd_resource_events_processed_in_batch",
 		Help: "Number of resource events processed in batch",
 	}, []string{"server"})
+
+	appQueueDepthGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "argocd_app_queue_depth",
+		Help: "Number of applications waiting in the application controller queues per priority tier, when queue priorities are enabled.",
+	}, []string{"name", "priority", "requested"})
 )
 
 // NewMetricsServer returns a new prometheus server which collects application metrics
@@ -197,6 +210,7 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 	registry.MustRegister(redisRequestHistogram)
 	registry.MustRegister(resourceEventsProcessingHistogram)
 	registry.MustRegister(resourceEventsNumberGauge)
+	registry.MustRegister(appQueueDepthGauge)
 
 	kubectlMetricsServer := kubectl.NewKubectlMetrics()
 	kubectlMetricsServer.RegisterWithClientGo()
@@ -219,6 +233,7 @@ func NewMetricsServer(addr string, appLister applister.ApplicationLister, appFil
 		redisRequestHistogram:             redisRequestHistogram,
 		resourceEventsProcessingHistogram: resourceEventsProcessingHistogram,
 		resourceEventsNumberGauge:         resourceEventsNumberGauge,
+		appQueueDepthGauge:                appQueueDepthGauge,
 		hostname:                          hostname,
 		// This cron is used to expire the metrics cache.
 		// Currently clearing the metrics cache is logging and deleting from the map
@@ -295,6 +310,12 @@ func (m *MetricsServer) ObserveResourceEventsProcessingDuration(server string, d
 	m.resourceEventsNumberGauge.WithLabelValues(server).Set(float64(processedEventsNumber))
 }
 
+// SetAppQueueDepth sets the number of applications of a priority tier waiting in a queue, requested tells whether a
+// refresh or an operation was requested for them
+func (m *MetricsServer) SetAppQueueDepth(queue string, priority string, requested bool, depth int) {
+	m.appQueueDepthGauge.WithLabelValues(queue, priority, strconv.FormatBool(requested)).Set(float64(depth))
+}
+
 // IncReconcile increments the reconcile counter for an application
 func (m *MetricsServer) IncReconcile(app *argoappv1.Application, duration time.Duration) {
 	m.reconcileHistogram.WithLabelValues(app.Namespace, app.Spec.Destination.Server).Observe(duration.Seconds())
@@ -367,6 +388,7 @@ func (c *appCollector) Describe(ch chan<- *prometheus.Desc) {
 		ch <- descAppConditions
 	}
 	ch <- descAppInfo
+	ch <- descAppSyncQueueDepth
 }
 
 // Collect implements the prometheus.Collector interface
@@ -376,11 +398,18 @@ func (c *appCollector) Collect(ch chan<- prometheus.Metric) {
 		log.Warnf("Failed to collect applications: %v", err)
 		return
 	}
+	queueDepth := make(map[[3]string]int)
 	for _, app := range apps {
 		if c.appFilter(app) {
 			c.collectApps(ch, app)
+			if app.Status.OperationState != nil && app.Status.OperationState.Phase == argoappv1.OperationQueued {
+				queueDepth[[3]string{app.Spec.Destination.Server, app.Spec.Destination.Name, app.Spec.GetProject()}]++
+			}
 		}
 	}
+	for key, count := range queueDepth {
+		ch <- prometheus.MustNewConstMetric(descAppSyncQueueDepth, prometheus.GaugeValue, float64(count), key[0], key[1], key[2])
+	}
 }
 
 func boolFloat64(b bool) float64 {
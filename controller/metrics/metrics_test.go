@@ -400,6 +400,49 @@ argocd_app_condition{condition="ExcludedResourceWarning",name="my-app-4",namespa
 	}
 }
 
+func TestMetricsSyncQueueDepth(t *testing.T) {
+	queuedApp := func(name string) string {
+		return strings.Replace(fakeApp, "name: my-app", "name: "+name, 1) + `
+  operationState:
+    operation:
+      sync: {}
+    phase: Queued
+    startedAt: 2018-09-21T23:50:25Z
+`
+	}
+	expectedResponse := `
+# HELP argocd_app_sync_queue_depth Number of sync operations queued until fewer of them run on their destination cluster or in their project.
+# TYPE argocd_app_sync_queue_depth gauge
+argocd_app_sync_queue_depth{dest_name="",dest_server="https://localhost:6443",project="important-project"} 2
+`
+	testApp(t, []string{fakeApp, queuedApp("queued-1"), queuedApp("queued-2")}, expectedResponse)
+}
+
+func TestMetricsAppQueueDepth(t *testing.T) {
+	cancel, appLister := newFakeLister()
+	defer cancel()
+	metricsServ, err := NewMetricsServer("localhost:8082", appLister, appFilter, noOpHealthCheck, []string{}, []string{})
+	require.NoError(t, err)
+
+	expectedResponse := `
+# HELP argocd_app_queue_depth Number of applications waiting in the application controller queues per priority tier, when queue priorities are enabled.
+# TYPE argocd_app_queue_depth gauge
+argocd_app_queue_depth{name="app_reconciliation_queue",priority="high",requested="true"} 2
+argocd_app_queue_depth{name="app_reconciliation_queue",priority="normal",requested="false"} 0
+`
+
+	metricsServ.SetAppQueueDepth("app_reconciliation_queue", "high", true, 2)
+	metricsServ.SetAppQueueDepth("app_reconciliation_queue", "normal", false, 1)
+	metricsServ.SetAppQueueDepth("app_reconciliation_queue", "normal", false, 0)
+
+	req, err := http.NewRequest(http.MethodGet, "/metrics", nil)
+	require.NoError(t, err)
+	rr := httptest.NewRecorder()
+	metricsServ.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assertMetricsPrinted(t, expectedResponse, rr.Body.String())
+}
+
 func TestMetricsSyncCounter(t *testing.T) {
 	cancel, appLister := newFakeLister()
 	defer cancel()
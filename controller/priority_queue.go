@@ -0,0 +1,317 @@
+package controller
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/pkg/ratelimiter"
+)
+
+// appQueueAgingPeriod is the time after which an application waiting in the refresh or operation queue is handed out
+// as if it had the next higher priority, so that the applications of the lower tiers are not starved by the ones of
+// the higher tiers
+const appQueueAgingPeriod = time.Minute
+
+// appQueuePriorityTiers are the priority tiers of the applications in the refresh and operation queues, from the lowest
+// to the highest
+var appQueuePriorityTiers = []string{appv1.QueuePriorityLow, appv1.QueuePriorityNormal, appv1.QueuePriorityHigh}
+
+// appQueuePriorityTier returns the index of the priority tier of the application. The project of the application sets
+// the highest tier of its applications, the normal tier by default, and the queue priority annotation of an application
+// may only lower it: the users allowed to update an application cannot put it ahead of the other projects.
+func appQueuePriorityTier(app *appv1.Application, proj *appv1.AppProject) int {
+	tier := slices.Index(appQueuePriorityTiers, appv1.QueuePriorityNormal)
+	if proj != nil {
+		if projectTier := slices.Index(appQueuePriorityTiers, proj.Spec.QueuePriority); projectTier >= 0 {
+			tier = projectTier
+		}
+	}
+	if appTier := slices.Index(appQueuePriorityTiers, app.GetAnnotations()[appv1.AnnotationKeyQueuePriority]); appTier >= 0 {
+		tier = min(tier, appTier)
+	}
+	return tier
+}
+
+// appQueuePriority returns the priority of an application in the refresh and operation queues. The applications are
+// ordered by priority tier, and within a tier the applications for which a refresh or an operation was requested, e.g.
+// by a user or a webhook, come before the ones refreshed periodically.
+func appQueuePriority(app *appv1.Application, proj *appv1.AppProject) int {
+	priority := appQueuePriorityTier(app, proj) * 2
+	if _, requested := app.IsRefreshRequested(); requested || app.Operation != nil {
+		priority++
+	}
+	return priority
+}
+
+// newAppPriorityQueue returns a priority queue of application keys, reporting its depth per priority to the metrics
+// server.
+func (ctrl *ApplicationController) newAppPriorityQueue(name string, rateLimiterConfig *ratelimiter.AppControllerRateLimiterConfig) workqueue.TypedRateLimitingInterface[string] {
+	return newPriorityQueue(
+		len(appQueuePriorityTiers)*2,
+		ratelimiter.NewCustomAppControllerRateLimiter[string](rateLimiterConfig),
+		appQueueAgingPeriod,
+		func(key string) int {
+			app := &appv1.Application{}
+			var proj *appv1.AppProject
+			if ctrl.appInformer != nil {
+				if obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(key); err == nil && exists {
+					if informerApp, ok := obj.(*appv1.Application); ok {
+						app = informerApp
+						// An application whose project cannot be retrieved gets the normal tier at most
+						proj, _ = ctrl.getAppProj(app)
+					}
+				}
+			}
+			return appQueuePriority(app, proj)
+		},
+		func(priority int, depth int) {
+			if ctrl.metricsServer != nil {
+				ctrl.metricsServer.SetAppQueueDepth(name, appQueuePriorityTiers[priority/2], priority%2 == 1, depth)
+			}
+		},
+	)
+}
+
+// priorityQueue is a rate limiting work queue which hands out the items of the highest priority first, and the items
+// of the same priority in the order they were added. The priority of a waiting item is raised by one every aging
+// period, so that the items of the lower priorities are eventually handed out. Like the client-go work queues, an item
+// is queued at most once and is not handed out again until it is marked as done.
+type priorityQueue struct {
+	cond *sync.Cond
+	// queues holds the queued items by priority. An item is moved to a higher priority by queueing it again, the entries
+	// which do not match the priority of the item in the queued map are skipped.
+	queues [][]queuedItem
+	// queued holds the priority of the items to process
+	queued map[string]int
+	// processing holds the items handed out which are not done yet
+	processing map[string]bool
+	// delayed holds the pending additions of the items added after a delay, at most one per item
+	delayed      map[string]*delayedItem
+	depths       []int
+	shuttingDown bool
+
+	agingPeriod   time.Duration
+	clock         clock.PassiveClock
+	rateLimiter   workqueue.TypedRateLimiter[string]
+	priority      func(item string) int
+	onDepthChange func(priority int, depth int)
+}
+
+type queuedItem struct {
+	item    string
+	addedAt time.Time
+}
+
+type delayedItem struct {
+	timer   *time.Timer
+	readyAt time.Time
+}
+
+var _ workqueue.TypedRateLimitingInterface[string] = &priorityQueue{}
+
+// newPriorityQueue returns a priority queue with the given number of priorities. The priority of an item, between 0
+// and priorities-1, is evaluated when it is added. The items do not age if agingPeriod is 0.
+func newPriorityQueue(priorities int, rateLimiter workqueue.TypedRateLimiter[string], agingPeriod time.Duration, priority func(item string) int, onDepthChange func(priority int, depth int)) *priorityQueue {
+	return &priorityQueue{
+		cond:          sync.NewCond(&sync.Mutex{}),
+		queues:        make([][]queuedItem, priorities),
+		queued:        map[string]int{},
+		processing:    map[string]bool{},
+		delayed:       map[string]*delayedItem{},
+		depths:        make([]int, priorities),
+		agingPeriod:   agingPeriod,
+		clock:         clock.RealClock{},
+		rateLimiter:   rateLimiter,
+		priority:      priority,
+		onDepthChange: onDepthChange,
+	}
+}
+
+func (q *priorityQueue) setDepth(priority int, delta int) {
+	q.depths[priority] += delta
+	if q.onDepthChange != nil {
+		q.onDepthChange(priority, q.depths[priority])
+	}
+}
+
+// Add queues the item, or raises its priority if it is already queued with a lower one
+func (q *priorityQueue) Add(item string) {
+	priority := min(max(q.priority(item), 0), len(q.queues)-1)
+
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	current, isQueued := q.queued[item]
+	if isQueued && current >= priority {
+		return
+	}
+	q.queued[item] = priority
+	if q.processing[item] {
+		// The item is queued when it is done
+		return
+	}
+	if isQueued {
+		q.setDepth(current, -1)
+	}
+	q.queues[priority] = append(q.queues[priority], queuedItem{item: item, addedAt: q.clock.Now()})
+	q.setDepth(priority, 1)
+	q.cond.Signal()
+}
+
+// AddAfter queues the item after the given duration. An item has at most one pending addition: the earliest one is
+// kept, so that an item requeued repeatedly does not accumulate timers.
+func (q *priorityQueue) AddAfter(item string, duration time.Duration) {
+	if duration <= 0 {
+		q.Add(item)
+		return
+	}
+
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	readyAt := q.clock.Now().Add(duration)
+	if pending, ok := q.delayed[item]; ok {
+		// A pending addition whose timer cannot be stopped is already being added
+		if !pending.readyAt.After(readyAt) || !pending.timer.Stop() {
+			return
+		}
+	}
+	delayed := &delayedItem{readyAt: readyAt}
+	delayed.timer = time.AfterFunc(duration, func() {
+		q.cond.L.Lock()
+		if q.delayed[item] == delayed {
+			delete(q.delayed, item)
+		}
+		q.cond.L.Unlock()
+		q.Add(item)
+	})
+	q.delayed[item] = delayed
+}
+
+// AddRateLimited queues the item once the rate limiter allows it
+func (q *priorityQueue) AddRateLimited(item string) {
+	q.AddAfter(item, q.rateLimiter.When(item))
+}
+
+func (q *priorityQueue) Forget(item string) {
+	q.rateLimiter.Forget(item)
+}
+
+func (q *priorityQueue) NumRequeues(item string) int {
+	return q.rateLimiter.NumRequeues(item)
+}
+
+// Len returns the number of queued items
+func (q *priorityQueue) Len() int {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	count := 0
+	for _, depth := range q.depths {
+		count += depth
+	}
+	return count
+}
+
+// dropFront removes the first entry of the queue of the given priority
+func (q *priorityQueue) dropFront(priority int) {
+	q.queues[priority][0] = queuedItem{}
+	q.queues[priority] = q.queues[priority][1:]
+}
+
+// pop returns the queued item of the highest priority, raised by the time it has waited, or false if there is none.
+// The items of the same priority age alike, so only the first item of each priority is considered.
+func (q *priorityQueue) pop() (string, bool) {
+	now := q.clock.Now()
+	next, nextPriority := -1, -1
+	for priority := len(q.queues) - 1; priority >= 0; priority-- {
+		// Skips the entries of the items which were moved to another priority, handed out or are being processed
+		for len(q.queues[priority]) > 0 {
+			item := q.queues[priority][0].item
+			if current, isQueued := q.queued[item]; isQueued && current == priority && !q.processing[item] {
+				break
+			}
+			q.dropFront(priority)
+		}
+		if len(q.queues[priority]) == 0 {
+			continue
+		}
+		agedPriority := priority
+		if q.agingPeriod > 0 {
+			agedPriority += int(now.Sub(q.queues[priority][0].addedAt) / q.agingPeriod)
+		}
+		if agedPriority > nextPriority {
+			next, nextPriority = priority, agedPriority
+		}
+	}
+	if next < 0 {
+		return "", false
+	}
+	item := q.queues[next][0].item
+	q.dropFront(next)
+	delete(q.queued, item)
+	q.setDepth(next, -1)
+	return item, true
+}
+
+// Get blocks until an item can be processed, and returns the one of the highest priority. It returns true if the queue
+// is shutting down.
+func (q *priorityQueue) Get() (string, bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	for {
+		if item, ok := q.pop(); ok {
+			q.processing[item] = true
+			return item, false
+		}
+		if q.shuttingDown {
+			return "", true
+		}
+		q.cond.Wait()
+	}
+}
+
+// Done marks the item as processed, and queues it again if it was added in the meantime
+func (q *priorityQueue) Done(item string) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	delete(q.processing, item)
+	if priority, isQueued := q.queued[item]; isQueued {
+		q.queues[priority] = append(q.queues[priority], queuedItem{item: item, addedAt: q.clock.Now()})
+		q.setDepth(priority, 1)
+	}
+	// Wakes up Get, as well as ShutDownWithDrain once all the items are done
+	q.cond.Broadcast()
+}
+
+func (q *priorityQueue) ShutDown() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+// ShutDownWithDrain shuts the queue down and waits for the items being processed to be done
+func (q *priorityQueue) ShutDownWithDrain() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.shuttingDown = true
+	q.cond.Broadcast()
+	for len(q.processing) > 0 {
+		q.cond.Wait()
+	}
+}
+
+func (q *priorityQueue) ShuttingDown() bool {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return q.shuttingDown
+}
@@ -0,0 +1,173 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestAppQueuePriority(t *testing.T) {
+	newApp := func(annotations map[string]string) *v1alpha1.Application {
+		return &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "my-app", Annotations: annotations}}
+	}
+	newProject := func(queuePriority string) *v1alpha1.AppProject {
+		return &v1alpha1.AppProject{Spec: v1alpha1.AppProjectSpec{QueuePriority: queuePriority}}
+	}
+	assert.Equal(t, 2, appQueuePriority(newApp(nil), nil))
+	assert.Equal(t, 2, appQueuePriority(newApp(map[string]string{v1alpha1.AnnotationKeyQueuePriority: "urgent"}), nil))
+	assert.Equal(t, 0, appQueuePriority(newApp(map[string]string{v1alpha1.AnnotationKeyQueuePriority: v1alpha1.QueuePriorityLow}), nil))
+	assert.Equal(t, 4, appQueuePriority(newApp(nil), newProject(v1alpha1.QueuePriorityHigh)))
+	assert.Equal(t, 5, appQueuePriority(newApp(map[string]string{
+		v1alpha1.AnnotationKeyQueuePriority: v1alpha1.QueuePriorityHigh,
+		v1alpha1.AnnotationKeyRefresh:       string(v1alpha1.RefreshTypeNormal),
+	}), newProject(v1alpha1.QueuePriorityHigh)))
+
+	// The annotation of an application may lower its tier, but not raise it above the tier of its project
+	assert.Equal(t, 2, appQueuePriority(newApp(map[string]string{v1alpha1.AnnotationKeyQueuePriority: v1alpha1.QueuePriorityHigh}), nil))
+	assert.Equal(t, 0, appQueuePriority(newApp(map[string]string{v1alpha1.AnnotationKeyQueuePriority: v1alpha1.QueuePriorityHigh}), newProject(v1alpha1.QueuePriorityLow)))
+	assert.Equal(t, 2, appQueuePriority(newApp(map[string]string{v1alpha1.AnnotationKeyQueuePriority: v1alpha1.QueuePriorityNormal}), newProject(v1alpha1.QueuePriorityHigh)))
+
+	app := newApp(map[string]string{v1alpha1.AnnotationKeyQueuePriority: v1alpha1.QueuePriorityLow})
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	assert.Equal(t, 1, appQueuePriority(app, newProject(v1alpha1.QueuePriorityHigh)))
+}
+
+func TestPriorityQueue(t *testing.T) {
+	var priorities map[string]int
+	var depths map[int]int
+	newQueue := func() *priorityQueue {
+		depths = map[int]int{}
+		return newPriorityQueue(3, workqueue.DefaultTypedControllerRateLimiter[string](), 0, func(item string) int {
+			return priorities[item]
+		}, func(priority int, depth int) {
+			depths[priority] = depth
+		})
+	}
+	get := func(t *testing.T, q *priorityQueue) string {
+		t.Helper()
+		item, shutdown := q.Get()
+		assert.False(t, shutdown)
+		q.Done(item)
+		return item
+	}
+
+	t.Run("items are handed out by priority", func(t *testing.T) {
+		priorities = map[string]int{"low-1": 0, "low-2": 0, "normal": 1, "high": 2}
+		q := newQueue()
+		for _, item := range []string{"low-1", "normal", "low-2", "high"} {
+			q.Add(item)
+		}
+		assert.Equal(t, 4, q.Len())
+		assert.Equal(t, map[int]int{0: 2, 1: 1, 2: 1}, depths)
+
+		assert.Equal(t, "high", get(t, q))
+		assert.Equal(t, "normal", get(t, q))
+		assert.Equal(t, "low-1", get(t, q))
+		assert.Equal(t, "low-2", get(t, q))
+		assert.Equal(t, 0, q.Len())
+		assert.Equal(t, map[int]int{0: 0, 1: 0, 2: 0}, depths)
+	})
+
+	t.Run("queued item priority is raised", func(t *testing.T) {
+		priorities = map[string]int{"a": 0, "b": 0}
+		q := newQueue()
+		q.Add("a")
+		q.Add("b")
+		priorities["b"] = 2
+		q.Add("b")
+		priorities["b"] = 0
+		q.Add("b")
+		assert.Equal(t, 2, q.Len())
+		assert.Equal(t, map[int]int{0: 1, 2: 1}, depths)
+
+		assert.Equal(t, "b", get(t, q))
+		assert.Equal(t, "a", get(t, q))
+		assert.Equal(t, 0, q.Len())
+	})
+
+	t.Run("item added while processed is queued when done", func(t *testing.T) {
+		priorities = map[string]int{"a": 1}
+		q := newQueue()
+		q.Add("a")
+		item, _ := q.Get()
+		assert.Equal(t, "a", item)
+		q.Add("a")
+		assert.Equal(t, 0, q.Len())
+
+		q.Done("a")
+		assert.Equal(t, 1, q.Len())
+		assert.Equal(t, "a", get(t, q))
+	})
+
+	t.Run("item is added after a delay", func(t *testing.T) {
+		priorities = map[string]int{"a": 1}
+		q := newQueue()
+		q.AddAfter("a", 10*time.Millisecond)
+		assert.Equal(t, 0, q.Len())
+		assert.Eventually(t, func() bool {
+			return q.Len() == 1
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("item has a single pending delayed addition", func(t *testing.T) {
+		priorities = map[string]int{"a": 1}
+		q := newQueue()
+		q.AddAfter("a", time.Hour)
+		q.AddAfter("a", 2*time.Hour)
+		q.AddAfter("a", 10*time.Millisecond)
+		q.AddAfter("a", time.Hour)
+		q.cond.L.Lock()
+		assert.Len(t, q.delayed, 1)
+		q.cond.L.Unlock()
+
+		assert.Eventually(t, func() bool {
+			return q.Len() == 1
+		}, time.Second, time.Millisecond)
+		q.cond.L.Lock()
+		assert.Empty(t, q.delayed)
+		q.cond.L.Unlock()
+	})
+
+	t.Run("waiting items age", func(t *testing.T) {
+		priorities = map[string]int{"low": 0, "normal": 1, "high": 2}
+		q := newQueue()
+		q.agingPeriod = time.Minute
+		fakeClock := testingclock.NewFakePassiveClock(time.Now())
+		q.clock = fakeClock
+		q.Add("low")
+		fakeClock.SetTime(fakeClock.Now().Add(150 * time.Second))
+		q.Add("normal")
+		q.Add("high")
+
+		// The low item waited for two aging periods: it comes before the normal item, and after the high item which has
+		// the same priority by now
+		assert.Equal(t, "high", get(t, q))
+		assert.Equal(t, "low", get(t, q))
+		assert.Equal(t, "normal", get(t, q))
+
+		q.Add("low")
+		fakeClock.SetTime(fakeClock.Now().Add(210 * time.Second))
+		q.Add("high")
+		assert.Equal(t, "low", get(t, q))
+		assert.Equal(t, "high", get(t, q))
+	})
+
+	t.Run("shut down", func(t *testing.T) {
+		priorities = map[string]int{"a": 1}
+		q := newQueue()
+		q.Add("a")
+		q.ShutDown()
+		assert.True(t, q.ShuttingDown())
+		q.Add("b")
+
+		assert.Equal(t, "a", get(t, q))
+		_, shutdown := q.Get()
+		assert.True(t, shutdown)
+	})
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/lru"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	// maxCachedResourcePolicyPrograms is the maximum number of compiled resource policy conditions kept in the cache
+	maxCachedResourcePolicyPrograms = 1000
+	// resourcePolicyCostLimit bounds the cost of evaluating a resource policy condition against a single manifest, so
+	// that a condition iterating over large manifests cannot stall the reconciliation of the application
+	resourcePolicyCostLimit = 1000000
+)
+
+// resourcePolicyEnv returns the CEL environment the resource policy conditions are compiled in. The manifest is
+// available as the 'object' variable, like in the validation rules of Kubernetes.
+var resourcePolicyEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(cel.Variable("object", cel.DynType), cel.OptionalTypes())
+})
+
+// resourcePolicyPrograms caches the compiled conditions of the resource policies, by condition. The least recently
+// used conditions are evicted, so that conditions removed from the projects do not stay cached.
+var resourcePolicyPrograms = lru.New(maxCachedResourcePolicyPrograms)
+
+func compileResourcePolicyCondition(condition string) (cel.Program, error) {
+	if program, ok := resourcePolicyPrograms.Get(condition); ok {
+		return program.(cel.Program), nil
+	}
+	env, err := resourcePolicyEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(condition)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) && !ast.OutputType().IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("condition must return a bool, not %s", ast.OutputType())
+	}
+	program, err := env.Program(ast, cel.CostLimit(resourcePolicyCostLimit))
+	if err != nil {
+		return nil, err
+	}
+	resourcePolicyPrograms.Add(condition, program)
+	return program, nil
+}
+
+// checkResourcePolicies evaluates the resource policies of the project against the target objects of an application,
+// and returns a condition for each object violating a policy. The condition of a policy is a CEL expression evaluated
+// with the object as the 'object' variable, e.g. 'object.?spec.?template.?spec.?hostNetwork.orValue(false) != true'.
+func checkResourcePolicies(project *v1alpha1.AppProject, targetObjs []*unstructured.Unstructured, now metav1.Time) []v1alpha1.ApplicationCondition {
+	var conditions []v1alpha1.ApplicationCondition
+	for i := range project.Spec.ResourcePolicies {
+		policy := &project.Spec.ResourcePolicies[i]
+		conditionType := v1alpha1.ApplicationConditionPolicyViolationWarning
+		if policy.IsEnforced() {
+			conditionType = v1alpha1.ApplicationConditionPolicyViolationError
+		}
+
+		program, err := compileResourcePolicyCondition(policy.Condition)
+		if err != nil {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:               conditionType,
+				Message:            fmt.Sprintf("Resource policy '%s' of project '%s' has an invalid condition: %v", policy.Name, project.Name, err),
+				LastTransitionTime: &now,
+			})
+			continue
+		}
+
+		for _, obj := range targetObjs {
+			gvk := obj.GroupVersionKind()
+			if !policy.AppliesTo(gvk.GroupKind()) {
+				continue
+			}
+			var violation string
+			result, _, err := program.Eval(map[string]any{"object": obj.Object})
+			switch {
+			case err != nil:
+				violation = fmt.Sprintf("error evaluating condition: %v", err)
+			case result.Value() != true:
+				violation = policy.Message
+				if violation == "" {
+					violation = fmt.Sprintf("condition '%s' is not satisfied", policy.Condition)
+				}
+			default:
+				continue
+			}
+			name := obj.GetName()
+			if obj.GetNamespace() != "" {
+				name = obj.GetNamespace() + "/" + name
+			}
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:               conditionType,
+				Message:            fmt.Sprintf("Resource %s/%s %s violates policy '%s': %s", gvk.Group, gvk.Kind, name, policy.Name, violation),
+				LastTransitionTime: &now,
+			})
+		}
+	}
+	return conditions
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func TestCheckResourcePolicies(t *testing.T) {
+	now := metav1.Now()
+	deployment := test.NewDeployment()
+	hostNetworkDeployment := test.NewDeployment()
+	hostNetworkDeployment.SetName("host-network")
+	require.NoError(t, unstructured.SetNestedField(hostNetworkDeployment.Object, true, "spec", "template", "spec", "hostNetwork"))
+	configMap := test.NewConfigMap()
+	targetObjs := []*unstructured.Unstructured{deployment, hostNetworkDeployment, configMap}
+
+	newProject := func(policies ...v1alpha1.ResourcePolicy) *v1alpha1.AppProject {
+		proj := defaultProj.DeepCopy()
+		proj.Spec.ResourcePolicies = policies
+		return proj
+	}
+
+	t.Run("no policies", func(t *testing.T) {
+		assert.Empty(t, checkResourcePolicies(newProject(), targetObjs, now))
+	})
+
+	t.Run("enforced policy", func(t *testing.T) {
+		conditions := checkResourcePolicies(newProject(v1alpha1.ResourcePolicy{
+			Name:      "no-host-network",
+			Kinds:     []metav1.GroupKind{{Group: "apps", Kind: "Deployment"}},
+			Condition: "object.?spec.?template.?spec.?hostNetwork.orValue(false) != true",
+			Message:   "host network is not allowed",
+		}), targetObjs, now)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionPolicyViolationError, conditions[0].Type)
+		assert.Equal(t, "Resource apps/Deployment host-network violates policy 'no-host-network': host network is not allowed", conditions[0].Message)
+	})
+
+	t.Run("warning policy applied to all kinds", func(t *testing.T) {
+		conditions := checkResourcePolicies(newProject(v1alpha1.ResourcePolicy{
+			Name:      "team-label",
+			Condition: "object.?metadata.?labels.?team.hasValue()",
+			Mode:      v1alpha1.ResourcePolicyModeWarn,
+		}), targetObjs, now)
+		require.Len(t, conditions, 3)
+		for _, condition := range conditions {
+			assert.Equal(t, v1alpha1.ApplicationConditionPolicyViolationWarning, condition.Type)
+		}
+		assert.Equal(t, "Resource /ConfigMap my-configmap violates policy 'team-label': condition 'object.?metadata.?labels.?team.hasValue()' is not satisfied", conditions[2].Message)
+	})
+
+	t.Run("evaluation error", func(t *testing.T) {
+		conditions := checkResourcePolicies(newProject(v1alpha1.ResourcePolicy{
+			Name:      "replicas",
+			Kinds:     []metav1.GroupKind{{Group: "apps", Kind: "Deployment"}},
+			Condition: "object.spec.missing.replicas > 1",
+		}), []*unstructured.Unstructured{deployment}, now)
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "Resource apps/Deployment nginx-deployment violates policy 'replicas': error evaluating condition: ")
+	})
+
+	t.Run("invalid condition", func(t *testing.T) {
+		conditions := checkResourcePolicies(newProject(v1alpha1.ResourcePolicy{
+			Name:      "invalid",
+			Condition: "object.spec.replicas >",
+		}), targetObjs, now)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, v1alpha1.ApplicationConditionPolicyViolationError, conditions[0].Type)
+		assert.Contains(t, conditions[0].Message, "Resource policy 'invalid' of project 'default' has an invalid condition: ")
+	})
+
+	t.Run("condition not returning a bool", func(t *testing.T) {
+		conditions := checkResourcePolicies(newProject(v1alpha1.ResourcePolicy{
+			Name:      "name-size",
+			Condition: "object.metadata.name.size()",
+		}), targetObjs, now)
+		require.Len(t, conditions, 1)
+		assert.Equal(t, "Resource policy 'name-size' of project 'default' has an invalid condition: condition must return a bool, not int", conditions[0].Message)
+	})
+
+	t.Run("condition exceeding the cost limit", func(t *testing.T) {
+		digits := "[0, 1, 2, 3, 4, 5, 6, 7, 8, 9]"
+		condition := digits + ".all(a, " + digits + ".all(b, " + digits + ".all(c, " + digits + ".all(d, " + digits + ".all(e, " + digits + ".all(f, a + b + c + d + e + f >= 0))))))"
+		conditions := checkResourcePolicies(newProject(v1alpha1.ResourcePolicy{
+			Name:      "expensive",
+			Condition: condition,
+		}), []*unstructured.Unstructured{configMap}, now)
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "Resource /ConfigMap my-configmap violates policy 'expensive': error evaluating condition: ")
+		assert.Contains(t, conditions[0].Message, "cost limit exceeded")
+	})
+}
+
+func TestCompileResourcePolicyCondition(t *testing.T) {
+	program, err := compileResourcePolicyCondition("object.kind == 'ConfigMap'")
+	require.NoError(t, err)
+	cached, err := compileResourcePolicyCondition("object.kind == 'ConfigMap'")
+	require.NoError(t, err)
+	assert.Same(t, program, cached)
+	assert.LessOrEqual(t, resourcePolicyPrograms.Len(), maxCachedResourcePolicyPrograms)
+}
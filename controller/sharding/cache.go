@@ -1,10 +1,13 @@
 package sharding
 
 import (
+	"maps"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/db"
 )
@@ -18,9 +21,14 @@ type ClusterShardingCache interface {
 	DeleteApp(a *v1alpha1.Application)
 	UpdateApp(a *v1alpha1.Application)
 	IsManagedCluster(c *v1alpha1.Cluster) bool
+	IsCachedCluster(c *v1alpha1.Cluster) bool
+	IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool
 	GetDistribution() map[string]int
 	GetAppDistribution() map[string]int
 	UpdateShard(shard int) bool
+	UpdateClusterLoads(samples map[string]ClusterLoadSample)
+	SetAppShardingChangedHandler(handler func(c *v1alpha1.Cluster))
+	SetDistributionChangedHandler(handler func(c *v1alpha1.Cluster))
 }
 
 type ClusterSharding struct {
@@ -29,11 +37,27 @@ type ClusterSharding struct {
 	Shards          map[string]int
 	Clusters        map[string]*v1alpha1.Cluster
 	Apps            map[string]*v1alpha1.Application
+	Loads           map[string]int64
 	lock            sync.RWMutex
 	getClusterShard DistributionFunction
+	// computeDistribution returns the shards of all clusters at once. It is set for the algorithms which compute the
+	// whole distribution to get the shard of a single cluster, so that it is not computed again for each cluster.
+	computeDistribution func() map[string]int
+	// loadCache is only set if the load-based algorithm is used
+	loadCache     ClusterLoadCache
+	eventsSamples map[string]eventsSample
+	// onAppShardingChanged is called when application-level sharding is enabled or disabled for a cluster
+	onAppShardingChanged func(c *v1alpha1.Cluster)
+	// handoffs holds the time until which the clusters moved to this shard because of a change of the loads are not
+	// managed yet, so that the shard previously managing them has released them in the meantime.
+	handoffs map[string]time.Time
+	// onDistributionChanged is called when a cluster moves to or away from this shard
+	onDistributionChanged func(c *v1alpha1.Cluster)
+	// movedClusters holds the clusters which moved to or away from this shard and have not been notified yet
+	movedClusters []*v1alpha1.Cluster
 }
 
-func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string) ClusterShardingCache {
+func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string, loadCache ClusterLoadCache) ClusterShardingCache {
 	log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
 	clusterSharding := &ClusterSharding{
 		Shard:    shard,
@@ -41,11 +65,22 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 		Shards:   make(map[string]int),
 		Clusters: make(map[string]*v1alpha1.Cluster),
 		Apps:     make(map[string]*v1alpha1.Application),
+		Loads:    make(map[string]int64),
 	}
 	distributionFunction := NoShardingDistributionFunction()
 	if replicas > 1 {
 		log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
-		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), shardingAlgorithm, replicas)
+		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), clusterSharding.getClusterLoadAccessor(), shardingAlgorithm, replicas)
+		if shardingAlgorithm == common.LoadBasedShardingAlgorithm {
+			clusterSharding.computeDistribution = func() map[string]int {
+				return createLoadBasedDistribution(replicas, clusterSharding.getClusterAccessor(), clusterSharding.getClusterLoadAccessor())
+			}
+			if loadCache != nil {
+				clusterSharding.loadCache = loadCache
+				clusterSharding.eventsSamples = make(map[string]eventsSample)
+				clusterSharding.handoffs = make(map[string]time.Time)
+			}
+		}
 	} else {
 		log.Info("Processing all cluster shards")
 	}
@@ -67,10 +102,45 @@ func (sharding *ClusterSharding) IsManagedCluster(c *v1alpha1.Cluster) bool {
 		log.Warnf("The cluster %s has no assigned shard.", c.Server)
 	}
 	log.Debugf("Checking if cluster %s with clusterShard %d should be processed by shard %d", c.Server, clusterShard, sharding.Shard)
+	if until, ok := sharding.handoffs[c.Server]; ok && clusterShard == sharding.Shard && loadCurrentTime().Before(until) {
+		log.Debugf("Cluster %s is handed off to shard %d until %s", c.Server, sharding.Shard, until)
+		return false
+	}
 	return clusterShard == sharding.Shard
 }
 
+// IsCachedCluster returns whether or not the cache of the cluster should be maintained by a given shard. This is the
+// case for the clusters managed by the shard, and for the clusters whose applications are spread across all shards.
+func (sharding *ClusterSharding) IsCachedCluster(c *v1alpha1.Cluster) bool {
+	if sharding.Replicas > 1 && IsAppShardedCluster(c) {
+		return true
+	}
+	return sharding.IsManagedCluster(c)
+}
+
+// IsManagedApp returns whether or not the application targeting the given cluster should be processed by a given
+// shard. Applications are processed by the shard managing their cluster, unless the cluster uses application-level
+// sharding.
+func (sharding *ClusterSharding) IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool {
+	if sharding.Replicas > 1 && IsAppShardedCluster(c) {
+		appShard := GetAppShard(a, sharding.Replicas)
+		log.Debugf("Checking if application %s with shard %d should be processed by shard %d", a.QualifiedName(), appShard, sharding.Shard)
+		return appShard == sharding.Shard
+	}
+	return sharding.IsManagedCluster(c)
+}
+
 func (sharding *ClusterSharding) Init(clusters *v1alpha1.ClusterList, apps *v1alpha1.ApplicationList) {
+	var loads map[string]int64
+	if sharding.loadCache != nil {
+		servers := make([]string, 0, len(clusters.Items))
+		for _, c := range clusters.Items {
+			servers = append(servers, c.Server)
+		}
+		loads = sharding.getClusterLoads(servers)
+	}
+
+	defer sharding.notifyDistributionChange()
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
 	newClusters := make(map[string]*v1alpha1.Cluster, len(clusters.Items))
@@ -79,6 +149,9 @@ func (sharding *ClusterSharding) Init(clusters *v1alpha1.ClusterList, apps *v1al
 		newClusters[c.Server] = &cluster
 	}
 	sharding.Clusters = newClusters
+	if loads != nil {
+		sharding.Loads = loads
+	}
 
 	newApps := make(map[string]*v1alpha1.Application, len(apps.Items))
 	for i := range apps.Items {
@@ -90,6 +163,9 @@ func (sharding *ClusterSharding) Init(clusters *v1alpha1.ClusterList, apps *v1al
 }
 
 func (sharding *ClusterSharding) Add(c *v1alpha1.Cluster) {
+	var old *v1alpha1.Cluster
+	defer func() { sharding.notifyAppShardingChange(old, c) }()
+	defer sharding.notifyDistributionChange()
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
 
@@ -103,22 +179,27 @@ func (sharding *ClusterSharding) Add(c *v1alpha1.Cluster) {
 }
 
 func (sharding *ClusterSharding) Delete(clusterServer string) {
+	defer sharding.notifyDistributionChange()
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
 	if _, ok := sharding.Clusters[clusterServer]; ok {
 		delete(sharding.Clusters, clusterServer)
 		delete(sharding.Shards, clusterServer)
+		delete(sharding.handoffs, clusterServer)
 		sharding.updateDistribution()
 	}
 }
 
 func (sharding *ClusterSharding) Update(oldCluster *v1alpha1.Cluster, newCluster *v1alpha1.Cluster) {
+	defer sharding.notifyAppShardingChange(oldCluster, newCluster)
+	defer sharding.notifyDistributionChange()
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
 
 	if _, ok := sharding.Clusters[oldCluster.Server]; ok && oldCluster.Server != newCluster.Server {
 		delete(sharding.Clusters, oldCluster.Server)
 		delete(sharding.Shards, oldCluster.Server)
+		delete(sharding.handoffs, oldCluster.Server)
 	}
 	sharding.Clusters[newCluster.Server] = newCluster
 	if hasShardingUpdates(oldCluster, newCluster) {
@@ -141,6 +222,10 @@ func (sharding *ClusterSharding) GetDistribution() map[string]int {
 }
 
 func (sharding *ClusterSharding) updateDistribution() {
+	var computedShards map[string]int
+	if sharding.computeDistribution != nil {
+		computedShards = sharding.computeDistribution()
+	}
 	for k, c := range sharding.Clusters {
 		shard := 0
 		if c.Shard != nil {
@@ -150,6 +235,8 @@ func (sharding *ClusterSharding) updateDistribution() {
 			} else {
 				log.Warnf("Specified cluster shard (%d) for cluster: %s is greater than the number of available shard (%d). Using shard 0.", requestedShard, c.Server, sharding.Replicas)
 			}
+		} else if computedShards != nil {
+			shard = computedShards[c.ID]
 		} else {
 			shard = sharding.getClusterShard(c)
 		}
@@ -158,6 +245,10 @@ func (sharding *ClusterSharding) updateDistribution() {
 		switch {
 		case ok && existingShard != shard:
 			log.Infof("Cluster %s has changed shard from %d to %d", k, existingShard, shard)
+			// clusters handed off to this shard are notified once the handoff is complete
+			if !sharding.handOff(c, shard) && (existingShard == sharding.Shard || shard == sharding.Shard) {
+				sharding.movedClusters = append(sharding.movedClusters, c)
+			}
 		case !ok:
 			log.Infof("Cluster %s has been assigned to shard %d", k, shard)
 		default:
@@ -167,6 +258,92 @@ func (sharding *ClusterSharding) updateDistribution() {
 	}
 }
 
+// handOff delays managing a cluster moved to this shard by the load-based algorithm by LoadHandoffGracePeriod. Shards
+// do not read the loads at the same time, so the shard previously managing the cluster may still do so until it reads
+// them next. Clusters moved to their shard manually are managed right away. It returns whether the cluster has been
+// handed off. A write lock should be acquired before calling handOff.
+func (sharding *ClusterSharding) handOff(c *v1alpha1.Cluster, shard int) bool {
+	if sharding.handoffs == nil {
+		return false
+	}
+	if shard != sharding.Shard || c.Shard != nil {
+		delete(sharding.handoffs, c.Server)
+		return false
+	}
+	until := loadCurrentTime().Add(LoadHandoffGracePeriod)
+	log.Infof("Cluster %s will be processed by shard %d from %s", c.Server, shard, until)
+	sharding.handoffs[c.Server] = until
+	time.AfterFunc(LoadHandoffGracePeriod, func() {
+		sharding.completeHandOff(c.Server, until)
+	})
+	return true
+}
+
+// completeHandOff notifies that the cluster handed off to this shard until the given time is now managed by it, unless
+// the handoff has been cancelled or superseded in the meantime.
+func (sharding *ClusterSharding) completeHandOff(server string, until time.Time) {
+	defer sharding.notifyDistributionChange()
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+	if current, ok := sharding.handoffs[server]; !ok || !current.Equal(until) {
+		return
+	}
+	delete(sharding.handoffs, server)
+	if c, ok := sharding.Clusters[server]; ok && sharding.Shards[server] == sharding.Shard {
+		log.Infof("Cluster %s has been handed off to shard %d", server, sharding.Shard)
+		sharding.movedClusters = append(sharding.movedClusters, c)
+	}
+}
+
+// SetAppShardingChangedHandler sets the function called when application-level sharding is enabled or disabled for a
+// cluster, since the applications targeting it then move between shards without any change of the applications.
+func (sharding *ClusterSharding) SetAppShardingChangedHandler(handler func(c *v1alpha1.Cluster)) {
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+	sharding.onAppShardingChanged = handler
+}
+
+// notifyAppShardingChange calls the app sharding changed handler if application-level sharding was enabled or disabled
+// for the cluster. It must be called without holding the lock, since the handler checks which applications are
+// managed by the shard.
+func (sharding *ClusterSharding) notifyAppShardingChange(oldCluster, newCluster *v1alpha1.Cluster) {
+	sharding.lock.RLock()
+	handler := sharding.onAppShardingChanged
+	sharding.lock.RUnlock()
+	if handler == nil || sharding.Replicas <= 1 || oldCluster == nil || newCluster == nil {
+		return
+	}
+	if IsAppShardedCluster(oldCluster) != IsAppShardedCluster(newCluster) {
+		log.Infof("Application-level sharding of cluster %s has changed", newCluster.Server)
+		handler(newCluster)
+	}
+}
+
+// SetDistributionChangedHandler sets the function called when a cluster moves to or away from this shard, so that the
+// shard losing the cluster releases its cache and the shard gaining it processes its applications right away.
+func (sharding *ClusterSharding) SetDistributionChangedHandler(handler func(c *v1alpha1.Cluster)) {
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+	sharding.onDistributionChanged = handler
+}
+
+// notifyDistributionChange calls the distribution changed handler for the clusters which moved to or away from this
+// shard since it was last called. It must be called without holding the lock, since the handler checks which clusters
+// are managed by the shard.
+func (sharding *ClusterSharding) notifyDistributionChange() {
+	sharding.lock.Lock()
+	handler := sharding.onDistributionChanged
+	moved := sharding.movedClusters
+	sharding.movedClusters = nil
+	sharding.lock.Unlock()
+	if handler == nil {
+		return
+	}
+	for _, c := range moved {
+		handler(c)
+	}
+}
+
 // hasShardingUpdates returns true if the sharding distribution has explicitly changed
 func hasShardingUpdates(old, new *v1alpha1.Cluster) bool {
 	if old == nil || new == nil {
@@ -201,6 +378,13 @@ func (sharding *ClusterSharding) getClusterAccessor() clusterAccessor {
 	}
 }
 
+// A read lock should be acquired before calling getClusterLoadAccessor.
+func (sharding *ClusterSharding) getClusterLoadAccessor() clusterLoadAccessor {
+	return func() map[string]int64 {
+		return sharding.Loads
+	}
+}
+
 // A read lock should be acquired before calling getAppAccessor.
 func (sharding *ClusterSharding) getAppAccessor() appAccessor {
 	return func() []*v1alpha1.Application {
@@ -213,6 +397,7 @@ func (sharding *ClusterSharding) getAppAccessor() appAccessor {
 }
 
 func (sharding *ClusterSharding) AddApp(a *v1alpha1.Application) {
+	defer sharding.notifyDistributionChange()
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
 
@@ -226,6 +411,7 @@ func (sharding *ClusterSharding) AddApp(a *v1alpha1.Application) {
 }
 
 func (sharding *ClusterSharding) DeleteApp(a *v1alpha1.Application) {
+	defer sharding.notifyDistributionChange()
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
 	if _, ok := sharding.Apps[a.Name]; ok {
@@ -235,6 +421,7 @@ func (sharding *ClusterSharding) DeleteApp(a *v1alpha1.Application) {
 }
 
 func (sharding *ClusterSharding) UpdateApp(a *v1alpha1.Application) {
+	defer sharding.notifyDistributionChange()
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
 
@@ -276,3 +463,77 @@ func (sharding *ClusterSharding) UpdateShard(shard int) bool {
 	}
 	return false
 }
+
+// UpdateClusterLoads shares the loads measured by this shard with the other shards, then updates the distribution
+// with the loads measured by all shards. It does nothing unless the load-based algorithm is used. It must not be called
+// concurrently.
+func (sharding *ClusterSharding) UpdateClusterLoads(samples map[string]ClusterLoadSample) {
+	if sharding.loadCache == nil {
+		return
+	}
+	now := loadCurrentTime()
+	for server, sample := range samples {
+		// clusters with application-level sharding are cached by all shards, but only measured by their own shard
+		if !sharding.IsManagedCluster(&v1alpha1.Cluster{Server: server}) {
+			continue
+		}
+		previous, ok := sharding.eventsSamples[server]
+		sharding.eventsSamples[server] = eventsSample{count: sample.EventsCount, time: now}
+		// the rate of events can only be measured from the second sample on
+		if !ok || sample.EventsCount < previous.count || !now.After(previous.time) {
+			continue
+		}
+		eventsPerMinute := int64(float64(sample.EventsCount-previous.count) / now.Sub(previous.time).Minutes())
+
+		current, err := GetClusterLoad(sharding.loadCache, server)
+		if err != nil {
+			log.Warnf("Failed to get load of cluster %s: %v", server, err)
+			continue
+		}
+		next := nextClusterLoad(current, sample.ResourcesCount, eventsPerMinute, now)
+		if err := sharding.loadCache.SetItem(clusterLoadKey(server), next, clusterLoadCacheExpiration, false); err != nil {
+			log.Warnf("Failed to save load of cluster %s: %v", server, err)
+		}
+	}
+
+	// forget the samples of the clusters which are no longer measured by this shard, since they were removed or moved
+	// to another shard
+	for server := range sharding.eventsSamples {
+		if _, ok := samples[server]; !ok || !sharding.IsManagedCluster(&v1alpha1.Cluster{Server: server}) {
+			delete(sharding.eventsSamples, server)
+		}
+	}
+
+	sharding.lock.RLock()
+	servers := make([]string, 0, len(sharding.Clusters))
+	for server := range sharding.Clusters {
+		servers = append(servers, server)
+	}
+	sharding.lock.RUnlock()
+	loads := sharding.getClusterLoads(servers)
+
+	defer sharding.notifyDistributionChange()
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+	if !maps.Equal(loads, sharding.Loads) {
+		log.Debugf("Updating sharding distribution with new cluster loads")
+		sharding.Loads = loads
+		sharding.updateDistribution()
+	}
+}
+
+// getClusterLoads returns the loads of the given clusters which have been measured.
+func (sharding *ClusterSharding) getClusterLoads(servers []string) map[string]int64 {
+	loads := make(map[string]int64, len(servers))
+	for _, server := range servers {
+		load, err := GetClusterLoad(sharding.loadCache, server)
+		if err != nil {
+			log.Warnf("Failed to get load of cluster %s: %v", server, err)
+			continue
+		}
+		if load != nil {
+			loads[server] = load.Load
+		}
+	}
+	return loads
+}
@@ -1,10 +1,13 @@
 package sharding
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
 )
@@ -12,7 +15,7 @@ import (
 func setupTestSharding(shard int, replicas int) *ClusterSharding {
 	shardingAlgorithm := "legacy" // we are using the legacy algorithm as it is deterministic based on the cluster id which is easier to test
 	db := &dbmocks.ArgoDB{}
-	return NewClusterSharding(db, shard, replicas, shardingAlgorithm).(*ClusterSharding)
+	return NewClusterSharding(db, shard, replicas, shardingAlgorithm, nil).(*ClusterSharding)
 }
 
 func TestNewClusterSharding(t *testing.T) {
@@ -68,7 +71,7 @@ func TestClusterSharding_AddRoundRobin_Redistributes(t *testing.T) {
 
 	db := &dbmocks.ArgoDB{}
 
-	sharding := NewClusterSharding(db, shard, replicas, "round-robin").(*ClusterSharding)
+	sharding := NewClusterSharding(db, shard, replicas, "round-robin", nil).(*ClusterSharding)
 
 	clusterA := &v1alpha1.Cluster{
 		ID:     "1",
@@ -509,3 +512,90 @@ func TestHasShardingUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterSharding_IsManagedApp(t *testing.T) {
+	replicas := 2
+	clusters := &v1alpha1.ClusterList{
+		Items: []v1alpha1.Cluster{
+			{
+				ID:     "1",
+				Server: "https://kubernetes.default.svc",
+			},
+			{
+				ID:          "2",
+				Server:      "https://127.0.0.1:6443",
+				Annotations: map[string]string{common.AnnotationKeyApplicationSharding: "true"},
+			},
+		},
+	}
+	var apps []v1alpha1.Application
+	for i := 0; i < 10; i++ {
+		apps = append(apps, createApp(fmt.Sprintf("app%d", i), "https://127.0.0.1:6443"))
+	}
+	inClusterApp := createApp("in-cluster-app", "https://kubernetes.default.svc")
+
+	sharding0 := setupTestSharding(0, replicas)
+	sharding0.Init(clusters, &v1alpha1.ApplicationList{Items: apps})
+	sharding1 := setupTestSharding(1, replicas)
+	sharding1.Init(clusters, &v1alpha1.ApplicationList{Items: apps})
+
+	// the cluster with application-level sharding is still assigned to a single shard
+	assert.False(t, sharding0.IsManagedCluster(&clusters.Items[1]))
+	assert.True(t, sharding1.IsManagedCluster(&clusters.Items[1]))
+	// but cached by all shards
+	assert.True(t, sharding0.IsCachedCluster(&clusters.Items[1]))
+	assert.True(t, sharding1.IsCachedCluster(&clusters.Items[1]))
+	assert.True(t, sharding0.IsCachedCluster(&clusters.Items[0]))
+	assert.False(t, sharding1.IsCachedCluster(&clusters.Items[0]))
+
+	// and its applications are spread across all shards
+	appsCountByShard := map[int]int{}
+	for i := range apps {
+		app := &apps[i]
+		managed0, managed1 := sharding0.IsManagedApp(app, &clusters.Items[1]), sharding1.IsManagedApp(app, &clusters.Items[1])
+		assert.NotEqual(t, managed0, managed1, "app %s must be managed by exactly one shard", app.Name)
+		if managed0 {
+			appsCountByShard[0]++
+		} else {
+			appsCountByShard[1]++
+		}
+	}
+	assert.Positive(t, appsCountByShard[0])
+	assert.Positive(t, appsCountByShard[1])
+
+	// applications of other clusters are processed by the shard of their cluster
+	assert.True(t, sharding0.IsManagedApp(&inClusterApp, &clusters.Items[0]))
+	assert.False(t, sharding1.IsManagedApp(&inClusterApp, &clusters.Items[0]))
+
+	t.Run("cluster assigned to a shard", func(t *testing.T) {
+		cluster := clusters.Items[1].DeepCopy()
+		cluster.Shard = ptr.To(int64(1))
+		for i := range apps {
+			assert.False(t, sharding0.IsManagedApp(&apps[i], cluster))
+			assert.True(t, sharding1.IsManagedApp(&apps[i], cluster))
+		}
+		assert.False(t, sharding0.IsCachedCluster(cluster))
+	})
+
+	t.Run("application-level sharding toggled", func(t *testing.T) {
+		var changed []string
+		sharding0.SetAppShardingChangedHandler(func(c *v1alpha1.Cluster) {
+			changed = append(changed, c.Server)
+		})
+		defer sharding0.SetAppShardingChangedHandler(nil)
+
+		disabled := clusters.Items[1].DeepCopy()
+		disabled.Annotations = nil
+		sharding0.Update(&clusters.Items[1], disabled)
+		assert.Equal(t, []string{disabled.Server}, changed)
+
+		sharding0.Add(&clusters.Items[1])
+		assert.Equal(t, []string{disabled.Server, disabled.Server}, changed)
+
+		// other changes of the cluster do not move its applications
+		renamed := clusters.Items[1].DeepCopy()
+		renamed.Name = "renamed"
+		sharding0.Update(&clusters.Items[1], renamed)
+		assert.Len(t, changed, 2)
+	})
+}
@@ -0,0 +1,200 @@
+package sharding
+
+import (
+	stderrors "errors"
+	"math"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+// Make it overridable for testing
+var loadCurrentTime = time.Now
+
+var (
+	// LoadHysteresis is the percentage by which the measured load of a cluster must deviate from its current load for
+	// the current load to be updated.
+	LoadHysteresis = env.ParseNumFromEnv(common.EnvControllerShardingLoadHysteresis, 20, 0, 100)
+	// LoadStabilizationWindow is the minimum duration between two updates of the load of a cluster.
+	LoadStabilizationWindow = env.ParseDurationFromEnv(common.EnvControllerShardingLoadStabilizationWindow, 10*time.Minute, 0, math.MaxInt64)
+)
+
+const (
+	// LoadUpdateInterval is the interval at which each shard measures the load of the clusters it manages.
+	LoadUpdateInterval = time.Minute
+	// LoadHandoffGracePeriod is the duration a shard waits before managing a cluster moved to it because of a change
+	// of the loads. Every shard reads the loads once per LoadUpdateInterval, so the shard previously managing the
+	// cluster has released it by then.
+	LoadHandoffGracePeriod = 2 * LoadUpdateInterval
+
+	clusterLoadCacheExpiration = 24 * time.Hour
+)
+
+// ClusterLoad is the load of a cluster, as measured by the shard managing it. Loads are shared between the shards
+// through the cache, so that all shards compute the same distribution.
+type ClusterLoad struct {
+	// Load is the weight of the cluster in the distribution. It is only updated when the measured load deviates from
+	// it by more than LoadHysteresis percent, and at most once per LoadStabilizationWindow, so that clusters do not
+	// flap between shards.
+	Load int64
+	// ResourcesCount is the number of resources in the cluster cache at the last measurement.
+	ResourcesCount int64
+	// EventsPerMinute is the rate of watch events received from the cluster at the last measurement.
+	EventsPerMinute int64
+	// UpdatedAt is the time Load was last updated.
+	UpdatedAt time.Time
+}
+
+// ClusterLoadSample is a measurement of the load of a cluster by the shard managing it.
+type ClusterLoadSample struct {
+	// ResourcesCount is the number of resources in the cluster cache.
+	ResourcesCount int64
+	// EventsCount is the number of watch events received from the cluster since its cache was created.
+	EventsCount int64
+}
+
+// ClusterLoadCache stores the loads of clusters. It must be shared by all shards, and is implemented by the app state
+// cache.
+type ClusterLoadCache interface {
+	GetItem(key string, item any) error
+	SetItem(key string, item any, expiration time.Duration, delete bool) error
+}
+
+type eventsSample struct {
+	count int64
+	time  time.Time
+}
+
+func clusterLoadKey(server string) string {
+	return "cluster|load|" + server
+}
+
+// GetClusterLoad returns the load of a cluster, or nil if it has not been measured yet.
+func GetClusterLoad(cache ClusterLoadCache, server string) (*ClusterLoad, error) {
+	load := &ClusterLoad{}
+	err := cache.GetItem(clusterLoadKey(server), load)
+	if stderrors.Is(err, cacheutil.ErrCacheMiss) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return load, nil
+}
+
+// nextClusterLoad returns the load of a cluster after a new measurement. The current load is kept until the measured
+// load deviates from it by more than LoadHysteresis percent, and for at least LoadStabilizationWindow.
+func nextClusterLoad(current *ClusterLoad, resourcesCount, eventsPerMinute int64, now time.Time) *ClusterLoad {
+	next := &ClusterLoad{
+		Load:            resourcesCount + eventsPerMinute,
+		ResourcesCount:  resourcesCount,
+		EventsPerMinute: eventsPerMinute,
+		UpdatedAt:       now,
+	}
+	if current == nil {
+		return next
+	}
+	deviation := math.Abs(float64(next.Load - current.Load))
+	if deviation <= float64(current.Load)*float64(LoadHysteresis)/100 || now.Sub(current.UpdatedAt) < LoadStabilizationWindow {
+		next.Load = current.Load
+		next.UpdatedAt = current.UpdatedAt
+	}
+	return next
+}
+
+// createLoadBasedDistribution assigns each cluster to the least loaded shard, starting with the most loaded clusters.
+// Clusters which have not been measured yet count as the average measured load, or as 1 if no cluster has been
+// measured. Clusters with a manually assigned shard are kept on that shard.
+func createLoadBasedDistribution(replicas int, getCluster clusterAccessor, getLoads clusterLoadAccessor) map[string]int {
+	clusters := getSortedClustersList(getCluster)
+	var loads map[string]int64
+	if getLoads != nil {
+		loads = getLoads()
+	}
+
+	var measuredLoad, measuredCount int64
+	for _, c := range clusters {
+		if load, ok := loads[c.Server]; ok {
+			measuredLoad += load
+			measuredCount++
+		}
+	}
+	defaultLoad := int64(1)
+	if measuredCount > 0 && measuredLoad/measuredCount > 1 {
+		defaultLoad = measuredLoad / measuredCount
+	}
+	clusterLoad := func(c *v1alpha1.Cluster) int64 {
+		load, ok := loads[c.Server]
+		if !ok {
+			return defaultLoad
+		}
+		// empty clusters still cost a watch on each API
+		return max(load, 1)
+	}
+
+	shardIndexedByCluster := make(map[string]int, len(clusters))
+	shardLoads := make([]int64, replicas)
+	var unassigned []*v1alpha1.Cluster
+	for _, c := range clusters {
+		if c.Shard != nil && int(*c.Shard) < replicas {
+			shard := int(*c.Shard)
+			shardIndexedByCluster[c.ID] = shard
+			shardLoads[shard] += clusterLoad(c)
+		} else {
+			unassigned = append(unassigned, c)
+		}
+	}
+
+	// clusters are sorted by ID, so the sort is stable and all shards compute the same distribution
+	sort.SliceStable(unassigned, func(i, j int) bool {
+		return clusterLoad(unassigned[i]) > clusterLoad(unassigned[j])
+	})
+	for _, c := range unassigned {
+		shard := 0
+		for i := 1; i < replicas; i++ {
+			if shardLoads[i] < shardLoads[shard] {
+				shard = i
+			}
+		}
+		shardIndexedByCluster[c.ID] = shard
+		shardLoads[shard] += clusterLoad(c)
+	}
+	return shardIndexedByCluster
+}
+
+// LoadBasedDistributionFunction returns a DistributionFunction balancing the load of the clusters across all shards.
+// The load of a cluster is the number of resources in its cache plus the number of watch events received from it per
+// minute, measured by the shard managing it. Since a single cluster can be much more expensive to manage than others,
+// this distributes the work of the controller more evenly than the algorithms only counting clusters or applications.
+// Loads only change by steps larger than the configured hysteresis, so clusters do not move between shards because
+// of small fluctuations.
+func LoadBasedDistributionFunction(clusters clusterAccessor, loads clusterLoadAccessor, replicas int) DistributionFunction {
+	return func(c *v1alpha1.Cluster) int {
+		if replicas > 0 {
+			if c == nil { // in-cluster does not necessarily have a secret assigned. So we are receiving a nil cluster here.
+				return 0
+			}
+			// if Shard is manually set and the assigned value is lower than the number of replicas,
+			// then its value is returned otherwise it is the default calculated value
+			if c.Shard != nil && int(*c.Shard) < replicas {
+				return int(*c.Shard)
+			}
+			shardIndexedByCluster := createLoadBasedDistribution(replicas, clusters, loads)
+			shard, ok := shardIndexedByCluster[c.ID]
+			if !ok {
+				log.Warnf("Cluster with id=%s not found in cluster map.", c.ID)
+				return -1
+			}
+			log.Debugf("Cluster with id=%s will be processed by shard %d", c.ID, shard)
+			return shard
+		}
+		log.Warnf("The number of replicas (%d) is lower than 1", replicas)
+		return -1
+	}
+}
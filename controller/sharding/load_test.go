@@ -0,0 +1,237 @@
+package sharding
+
+import (
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+func getClusterLoadAccessor(loads map[string]int64) clusterLoadAccessor {
+	return func() map[string]int64 { return loads }
+}
+
+func TestLoadBasedDistributionFunction(t *testing.T) {
+	clusters, _, cluster1, cluster2, cluster3, cluster4, cluster5 := createTestClusters()
+
+	t.Run("balances by load", func(t *testing.T) {
+		loads := getClusterLoadAccessor(map[string]int64{
+			cluster1.Server: 50000,
+			cluster2.Server: 100,
+			cluster3.Server: 100,
+			cluster4.Server: 100,
+			cluster5.Server: 100,
+		})
+		distributionFunction := LoadBasedDistributionFunction(clusters, loads, 2)
+		assert.Equal(t, 0, distributionFunction(&cluster1))
+		assert.Equal(t, 1, distributionFunction(&cluster2))
+		assert.Equal(t, 1, distributionFunction(&cluster3))
+		assert.Equal(t, 1, distributionFunction(&cluster4))
+		assert.Equal(t, 1, distributionFunction(&cluster5))
+	})
+
+	t.Run("balances by count without loads", func(t *testing.T) {
+		distributionFunction := LoadBasedDistributionFunction(clusters, getClusterLoadAccessor(nil), 2)
+		assert.Equal(t, 0, distributionFunction(&cluster1))
+		assert.Equal(t, 1, distributionFunction(&cluster2))
+		assert.Equal(t, 0, distributionFunction(&cluster3))
+		assert.Equal(t, 1, distributionFunction(&cluster4))
+		assert.Equal(t, 0, distributionFunction(&cluster5))
+	})
+
+	t.Run("unmeasured clusters count as the average load", func(t *testing.T) {
+		loads := getClusterLoadAccessor(map[string]int64{
+			cluster1.Server: 1000,
+			cluster2.Server: 3000,
+		})
+		distributionFunction := LoadBasedDistributionFunction(clusters, loads, 2)
+		// cluster2 (3000) goes first, then clusters 3 to 5 (2000 each) and cluster1 (1000)
+		assert.Equal(t, 0, distributionFunction(&cluster2))
+		assert.Equal(t, 1, distributionFunction(&cluster3))
+		assert.Equal(t, 1, distributionFunction(&cluster4))
+		assert.Equal(t, 0, distributionFunction(&cluster5))
+		assert.Equal(t, 1, distributionFunction(&cluster1))
+	})
+
+	t.Run("no replicas", func(t *testing.T) {
+		distributionFunction := LoadBasedDistributionFunction(clusters, getClusterLoadAccessor(nil), 0)
+		assert.Equal(t, -1, distributionFunction(&cluster1))
+	})
+
+	t.Run("in-cluster", func(t *testing.T) {
+		distributionFunction := LoadBasedDistributionFunction(clusters, getClusterLoadAccessor(nil), 2)
+		assert.Equal(t, 0, distributionFunction(nil))
+	})
+}
+
+func TestLoadBasedDistributionFunctionWithFixedShard(t *testing.T) {
+	var fixedShard int64 = 1
+	cluster1 := createCluster("cluster1", "1")
+	cluster1.Shard = &fixedShard
+	cluster2 := createCluster("cluster2", "2")
+	cluster3 := createCluster("cluster3", "3")
+	clusters := getClusterAccessor([]v1alpha1.Cluster{cluster1, cluster2, cluster3})
+	loads := getClusterLoadAccessor(map[string]int64{
+		cluster1.Server: 1000,
+		cluster2.Server: 10,
+		cluster3.Server: 10,
+	})
+
+	distributionFunction := LoadBasedDistributionFunction(clusters, loads, 2)
+	assert.Equal(t, 1, distributionFunction(&cluster1))
+	// the load of cluster1 is taken into account for its shard
+	assert.Equal(t, 0, distributionFunction(&cluster2))
+	assert.Equal(t, 0, distributionFunction(&cluster3))
+}
+
+func Test_nextClusterLoad(t *testing.T) {
+	hysteresis, window := LoadHysteresis, LoadStabilizationWindow
+	defer func() {
+		LoadHysteresis, LoadStabilizationWindow = hysteresis, window
+	}()
+	LoadHysteresis = 20
+	LoadStabilizationWindow = 10 * time.Minute
+
+	now := time.Now()
+	current := &ClusterLoad{Load: 1000, ResourcesCount: 900, EventsPerMinute: 100, UpdatedAt: now.Add(-time.Hour)}
+
+	t.Run("first measurement", func(t *testing.T) {
+		next := nextClusterLoad(nil, 900, 100, now)
+		assert.Equal(t, &ClusterLoad{Load: 1000, ResourcesCount: 900, EventsPerMinute: 100, UpdatedAt: now}, next)
+	})
+
+	t.Run("within hysteresis", func(t *testing.T) {
+		next := nextClusterLoad(current, 1100, 50, now)
+		assert.Equal(t, &ClusterLoad{Load: 1000, ResourcesCount: 1100, EventsPerMinute: 50, UpdatedAt: current.UpdatedAt}, next)
+	})
+
+	t.Run("beyond hysteresis", func(t *testing.T) {
+		next := nextClusterLoad(current, 1100, 200, now)
+		assert.Equal(t, &ClusterLoad{Load: 1300, ResourcesCount: 1100, EventsPerMinute: 200, UpdatedAt: now}, next)
+	})
+
+	t.Run("within stabilization window", func(t *testing.T) {
+		recent := *current
+		recent.UpdatedAt = now.Add(-time.Minute)
+		next := nextClusterLoad(&recent, 1100, 200, now)
+		assert.Equal(t, &ClusterLoad{Load: 1000, ResourcesCount: 1100, EventsPerMinute: 200, UpdatedAt: recent.UpdatedAt}, next)
+	})
+}
+
+func TestClusterSharding_UpdateClusterLoads(t *testing.T) {
+	defer func() { loadCurrentTime = time.Now }()
+	now := time.Now()
+	loadCurrentTime = func() time.Time { return now }
+
+	cache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour)
+	cluster1 := createCluster("cluster1", "1")
+	cluster2 := createCluster("cluster2", "2")
+	cluster3 := createCluster("cluster3", "3")
+	sharding := NewClusterSharding(nil, 0, 2, common.LoadBasedShardingAlgorithm, cache)
+	sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{cluster1, cluster2, cluster3}}, &v1alpha1.ApplicationList{})
+
+	// clusters are distributed by count as long as no load has been measured
+	assert.Equal(t, map[string]int{cluster1.Server: 0, cluster2.Server: 1, cluster3.Server: 0}, sharding.GetDistribution())
+
+	// the first sample only records the number of events
+	sharding.UpdateClusterLoads(map[string]ClusterLoadSample{
+		cluster1.Server: {ResourcesCount: 10, EventsCount: 0},
+		cluster2.Server: {ResourcesCount: 10, EventsCount: 0},
+		cluster3.Server: {ResourcesCount: 1000, EventsCount: 100},
+	})
+	load, err := GetClusterLoad(cache, cluster3.Server)
+	require.NoError(t, err)
+	assert.Nil(t, load)
+
+	var moved []string
+	sharding.SetDistributionChangedHandler(func(c *v1alpha1.Cluster) {
+		moved = append(moved, c.Server)
+	})
+	now = now.Add(time.Minute)
+	sharding.UpdateClusterLoads(map[string]ClusterLoadSample{
+		cluster1.Server: {ResourcesCount: 10, EventsCount: 0},
+		cluster2.Server: {ResourcesCount: 10, EventsCount: 0},
+		cluster3.Server: {ResourcesCount: 1000, EventsCount: 160},
+	})
+	load, err = GetClusterLoad(cache, cluster3.Server)
+	require.NoError(t, err)
+	require.NotNil(t, load)
+	assert.Equal(t, int64(1060), load.Load)
+	assert.Equal(t, int64(60), load.EventsPerMinute)
+	assert.Equal(t, map[string]int{cluster1.Server: 1, cluster2.Server: 1, cluster3.Server: 0}, sharding.GetDistribution())
+	// cluster1 moved away from this shard, which releases it right away
+	assert.Equal(t, []string{cluster1.Server}, moved)
+
+	// the events of the clusters which are no longer managed by this shard are no longer sampled
+	now = now.Add(time.Minute)
+	sharding.UpdateClusterLoads(map[string]ClusterLoadSample{
+		cluster1.Server: {ResourcesCount: 10, EventsCount: 0},
+		cluster3.Server: {ResourcesCount: 1000, EventsCount: 220},
+	})
+	assert.Equal(t, []string{cluster3.Server}, slices.Collect(maps.Keys(sharding.(*ClusterSharding).eventsSamples)))
+
+	t.Run("other algorithms ignore loads", func(t *testing.T) {
+		cache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour)
+		sharding := NewClusterSharding(nil, 0, 2, common.RoundRobinShardingAlgorithm, cache)
+		sharding.UpdateClusterLoads(map[string]ClusterLoadSample{cluster1.Server: {ResourcesCount: 10}})
+		now = now.Add(time.Minute)
+		sharding.UpdateClusterLoads(map[string]ClusterLoadSample{cluster1.Server: {ResourcesCount: 10}})
+		load, err := GetClusterLoad(cache, cluster1.Server)
+		require.NoError(t, err)
+		assert.Nil(t, load)
+	})
+}
+
+func TestClusterSharding_LoadHandoff(t *testing.T) {
+	defer func() { loadCurrentTime = time.Now }()
+	now := time.Now()
+	loadCurrentTime = func() time.Time { return now }
+
+	cache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Hour)
+	cluster1 := createCluster("cluster1", "1")
+	cluster2 := createCluster("cluster2", "2")
+	cluster3 := createCluster("cluster3", "3")
+	sharding := NewClusterSharding(nil, 1, 2, common.LoadBasedShardingAlgorithm, cache)
+	sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{cluster1, cluster2, cluster3}}, &v1alpha1.ApplicationList{})
+	assert.Equal(t, map[string]int{cluster1.Server: 0, cluster2.Server: 1, cluster3.Server: 0}, sharding.GetDistribution())
+	assert.True(t, sharding.IsManagedCluster(&cluster2))
+
+	for server, load := range map[string]int64{cluster1.Server: 10, cluster2.Server: 10, cluster3.Server: 1000} {
+		require.NoError(t, cache.SetItem(clusterLoadKey(server), &ClusterLoad{Load: load, UpdatedAt: now}, time.Hour, false))
+	}
+	var moved []string
+	sharding.SetDistributionChangedHandler(func(c *v1alpha1.Cluster) {
+		moved = append(moved, c.Server)
+	})
+	sharding.UpdateClusterLoads(nil)
+	assert.Equal(t, map[string]int{cluster1.Server: 1, cluster2.Server: 1, cluster3.Server: 0}, sharding.GetDistribution())
+
+	// cluster1 moved to this shard, but is only managed once the previous shard has released it
+	assert.False(t, sharding.IsManagedCluster(&cluster1))
+	assert.True(t, sharding.IsManagedCluster(&cluster2))
+	assert.Empty(t, moved)
+	until := now.Add(LoadHandoffGracePeriod)
+	now = until
+	assert.True(t, sharding.IsManagedCluster(&cluster1))
+
+	// the move is notified once the handoff is complete, unless it has been superseded
+	sharding.(*ClusterSharding).completeHandOff(cluster1.Server, until.Add(-time.Second))
+	assert.Empty(t, moved)
+	sharding.(*ClusterSharding).completeHandOff(cluster1.Server, until)
+	assert.Equal(t, []string{cluster1.Server}, moved)
+	assert.True(t, sharding.IsManagedCluster(&cluster1))
+
+	// clusters added later are managed right away
+	cluster4 := createCluster("cluster4", "4")
+	sharding.Add(&cluster4)
+	assert.Equal(t, 1, sharding.GetDistribution()[cluster4.Server])
+	assert.True(t, sharding.IsManagedCluster(&cluster4))
+}
@@ -0,0 +1,119 @@
+package sharding
+
+import (
+	"slices"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// ShardPlan is the assignment of clusters to controller shards computed by a sharding algorithm.
+type ShardPlan struct {
+	// ShardingAlgorithm is the algorithm which computed the plan
+	ShardingAlgorithm string
+	// Replicas is the number of controller replicas the clusters are distributed across
+	Replicas int
+	// Shards holds the shard of each cluster, by cluster server
+	Shards map[string]int
+	// ShardLoads holds the load assigned to each shard, indexed by shard number
+	ShardLoads []ShardLoad
+	// appsCount holds the number of apps targeting each cluster, by cluster server
+	appsCount map[string]int64
+	// appShards holds the shard processing each app targeting a known cluster, by app namespace and name
+	appShards map[string]int
+}
+
+// ShardLoad is the portion of the clusters, apps and resources a shard is responsible for.
+type ShardLoad struct {
+	ClustersCount  int
+	AppsCount      int64
+	ResourcesCount int64
+	// Load is the sum of the loads of the clusters of the shard, as used by the load-based algorithm
+	Load int64
+}
+
+// PlanShards computes the shard of each cluster the way the application controller would, using the given sharding
+// algorithm and number of replicas. loads holds the load of each cluster by server, as returned by GetClusterLoad, and
+// resourcesCounts the number of resources in the cache of each cluster. Both are used to compute the load of each
+// shard, and loads to assign clusters with the load-based algorithm.
+func PlanShards(clusters []v1alpha1.Cluster, apps []v1alpha1.Application, loads, resourcesCounts map[string]int64, shardingAlgorithm string, replicas int) *ShardPlan {
+	getClusters := func() []*v1alpha1.Cluster {
+		clusterPtrs := make([]*v1alpha1.Cluster, len(clusters))
+		for i := range clusters {
+			clusterPtrs[i] = &clusters[i]
+		}
+		return clusterPtrs
+	}
+	getApps := func() []*v1alpha1.Application {
+		appPtrs := make([]*v1alpha1.Application, len(apps))
+		for i := range apps {
+			appPtrs[i] = &apps[i]
+		}
+		return appPtrs
+	}
+	getLoads := func() map[string]int64 {
+		return loads
+	}
+	distributionFunction := GetDistributionFunction(getClusters, getApps, getLoads, shardingAlgorithm, replicas)
+
+	plan := &ShardPlan{
+		ShardingAlgorithm: shardingAlgorithm,
+		Replicas:          replicas,
+		Shards:            make(map[string]int, len(clusters)),
+		ShardLoads:        make([]ShardLoad, max(replicas, 1)),
+		appsCount:         getAppDistribution(getClusters, getApps),
+		appShards:         make(map[string]int, len(apps)),
+	}
+	clustersByServer := make(map[string]*v1alpha1.Cluster, len(clusters))
+	for i := range clusters {
+		c := &clusters[i]
+		clustersByServer[c.Server] = c
+		// like ClusterSharding, clusters pinned to a shard which does not exist are processed by shard 0
+		shard := 0
+		if c.Shard == nil || int(*c.Shard) < replicas {
+			shard = max(distributionFunction(c), 0)
+		}
+		plan.Shards[c.Server] = shard
+		plan.ShardLoads[shard].ClustersCount++
+		plan.ShardLoads[shard].ResourcesCount += resourcesCounts[c.Server]
+		plan.ShardLoads[shard].Load += loads[c.Server]
+	}
+	for i := range apps {
+		a := &apps[i]
+		c, ok := clustersByServer[a.Spec.Destination.Server]
+		if !ok {
+			continue
+		}
+		shard := plan.Shards[c.Server]
+		if replicas > 1 && IsAppShardedCluster(c) {
+			shard = GetAppShard(a, replicas)
+		}
+		plan.appShards[a.Namespace+"/"+a.Name] = shard
+		plan.ShardLoads[shard].AppsCount++
+	}
+	return plan
+}
+
+// AppsCount returns the number of apps targeting the given cluster.
+func (p *ShardPlan) AppsCount(server string) int64 {
+	return p.appsCount[server]
+}
+
+// MovedClusters returns the servers of the clusters which are assigned to a different shard by the proposed plan, and
+// the number of apps processed by a different shard. Apps move with their cluster, unless the cluster uses
+// application-level sharding. Servers are sorted.
+func (p *ShardPlan) MovedClusters(proposed *ShardPlan) ([]string, int64) {
+	var servers []string
+	for server, shard := range p.Shards {
+		if proposedShard, ok := proposed.Shards[server]; ok && proposedShard != shard {
+			servers = append(servers, server)
+		}
+	}
+	slices.Sort(servers)
+	var appsCount int64
+	for app, shard := range p.appShards {
+		if proposedShard, ok := proposed.appShards[app]; ok && proposedShard != shard {
+			appsCount++
+		}
+	}
+	return servers, appsCount
+}
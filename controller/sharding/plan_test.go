@@ -0,0 +1,83 @@
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestPlanShards(t *testing.T) {
+	_, _, cluster1, cluster2, cluster3, cluster4, cluster5 := createTestClusters()
+	clusters := []v1alpha1.Cluster{cluster1, cluster2, cluster3, cluster4, cluster5}
+	apps := []v1alpha1.Application{
+		createApp("app1", cluster1.Server),
+		createApp("app2", cluster1.Server),
+		createApp("app3", cluster3.Server),
+		createApp("app4", cluster4.Server),
+		createApp("app5", cluster4.Server),
+		createApp("app6", cluster4.Server),
+	}
+	resourcesCounts := map[string]int64{cluster1.Server: 100, cluster3.Server: 300}
+	loads := map[string]int64{cluster1.Server: 150, cluster3.Server: 310}
+
+	current := PlanShards(clusters, apps, loads, resourcesCounts, common.RoundRobinShardingAlgorithm, 2)
+	assert.Equal(t, map[string]int{cluster1.Server: 0, cluster2.Server: 1, cluster3.Server: 0, cluster4.Server: 1, cluster5.Server: 0}, current.Shards)
+	assert.Equal(t, []ShardLoad{
+		{ClustersCount: 3, AppsCount: 3, ResourcesCount: 400, Load: 460},
+		{ClustersCount: 2, AppsCount: 3},
+	}, current.ShardLoads)
+	assert.Equal(t, int64(3), current.AppsCount(cluster4.Server))
+
+	proposed := PlanShards(clusters, apps, loads, resourcesCounts, common.RoundRobinShardingAlgorithm, 3)
+	assert.Equal(t, map[string]int{cluster1.Server: 0, cluster2.Server: 1, cluster3.Server: 2, cluster4.Server: 0, cluster5.Server: 1}, proposed.Shards)
+
+	movedClusters, movedApps := current.MovedClusters(proposed)
+	assert.Equal(t, []string{cluster3.Server, cluster4.Server, cluster5.Server}, movedClusters)
+	assert.Equal(t, int64(4), movedApps)
+
+	movedClusters, movedApps = current.MovedClusters(current)
+	assert.Empty(t, movedClusters)
+	assert.Zero(t, movedApps)
+}
+
+func TestPlanShards_PinnedShard(t *testing.T) {
+	cluster1 := createCluster("cluster1", "1")
+	cluster1.Shard = ptr.To(int64(1))
+	cluster2 := createCluster("cluster2", "2")
+	cluster2.Shard = ptr.To(int64(5))
+
+	plan := PlanShards([]v1alpha1.Cluster{cluster1, cluster2}, nil, nil, nil, common.LegacyShardingAlgorithm, 2)
+	// clusters pinned to a shard which does not exist are processed by shard 0
+	assert.Equal(t, map[string]int{cluster1.Server: 1, cluster2.Server: 0}, plan.Shards)
+}
+
+func TestPlanShards_AppShardedCluster(t *testing.T) {
+	cluster1 := createCluster("cluster1", "1")
+	cluster2 := createCluster("cluster2", "2")
+	cluster2.Annotations = map[string]string{common.AnnotationKeyApplicationSharding: "true"}
+	clusters := []v1alpha1.Cluster{cluster1, cluster2}
+	apps := []v1alpha1.Application{createApp("app0", cluster1.Server)}
+	for i := 1; i <= 4; i++ {
+		apps = append(apps, createApp(fmt.Sprintf("app%d", i), cluster2.Server))
+	}
+
+	plan := PlanShards(clusters, apps, nil, nil, common.RoundRobinShardingAlgorithm, 2)
+	assert.Equal(t, map[string]int{cluster1.Server: 0, cluster2.Server: 1}, plan.Shards)
+	// the apps of cluster2 are spread across both shards
+	assert.Equal(t, []ShardLoad{
+		{ClustersCount: 1, AppsCount: 3},
+		{ClustersCount: 1, AppsCount: 2},
+	}, plan.ShardLoads)
+
+	// without application-level sharding, all apps of cluster2 move with it
+	clusters[1].Annotations = nil
+	proposed := PlanShards(clusters, apps, nil, nil, common.RoundRobinShardingAlgorithm, 2)
+	movedClusters, movedApps := plan.MovedClusters(proposed)
+	assert.Empty(t, movedClusters)
+	assert.Equal(t, int64(2), movedApps)
+}
@@ -49,6 +49,7 @@ type (
 	ClusterFilterFunction func(c *v1alpha1.Cluster) bool
 	clusterAccessor       func() []*v1alpha1.Cluster
 	appAccessor           func() []*v1alpha1.Application
+	clusterLoadAccessor   func() map[string]int64
 )
 
 // shardApplicationControllerMapping stores the mapping of Shard Number to Application Controller in ConfigMap.
@@ -82,7 +83,7 @@ func GetClusterFilter(_ db.ArgoDB, distributionFunction DistributionFunction, re
 
 // GetDistributionFunction returns which DistributionFunction should be used based on the passed algorithm and
 // the current datas.
-func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
+func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, loads clusterLoadAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
 	log.Debugf("Using filter function:  %s", shardingAlgorithm)
 	distributionFunction := LegacyDistributionFunction(replicasCount)
 	switch shardingAlgorithm {
@@ -92,6 +93,8 @@ func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardin
 		distributionFunction = LegacyDistributionFunction(replicasCount)
 	case common.ConsistentHashingWithBoundedLoadsAlgorithm:
 		distributionFunction = ConsistentHashingWithBoundedLoadsDistributionFunction(clusters, apps, replicasCount)
+	case common.LoadBasedShardingAlgorithm:
+		distributionFunction = LoadBasedDistributionFunction(clusters, loads, replicasCount)
 	default:
 		log.Warnf("distribution type %s is not supported, defaulting to %s", shardingAlgorithm, common.DefaultShardingAlgorithm)
 	}
@@ -249,6 +252,29 @@ func getAppDistribution(getCluster clusterAccessor, getApps appAccessor) map[str
 	return appDistribution
 }
 
+// IsAppShardedCluster returns whether the applications targeting the cluster are spread across all shards instead of
+// being processed by the shard of the cluster. Application-level sharding is enabled by annotating the cluster secret,
+// and does not apply to clusters manually assigned to a shard.
+func IsAppShardedCluster(c *v1alpha1.Cluster) bool {
+	if c == nil || c.Shard != nil {
+		return false
+	}
+	enabled, _ := strconv.ParseBool(c.Annotations[common.AnnotationKeyApplicationSharding])
+	return enabled
+}
+
+// GetAppShard returns the shard processing an application targeting a cluster with application-level sharding.
+// Like the legacy distribution, the shard is based on a hash of the application's namespace and name, so that an
+// application does not move between shards when other applications are added or removed.
+func GetAppShard(a *v1alpha1.Application, replicas int) int {
+	if replicas <= 0 {
+		return -1
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(a.Namespace + "/" + a.Name))
+	return int(h.Sum32() % uint32(replicas))
+}
+
 // NoShardingDistributionFunction returns a DistributionFunction that will process all cluster by shard 0
 // the function is created for API compatibility purposes and is not supposed to be activated.
 func NoShardingDistributionFunction() DistributionFunction {
@@ -454,7 +480,7 @@ func getDefaultShardMappingData(replicas int) []shardApplicationControllerMappin
 	return shardMappingData
 }
 
-func GetClusterSharding(kubeClient kubernetes.Interface, settingsMgr *settings.SettingsManager, shardingAlgorithm string, enableDynamicClusterDistribution bool) (ClusterShardingCache, error) {
+func GetClusterSharding(kubeClient kubernetes.Interface, settingsMgr *settings.SettingsManager, shardingAlgorithm string, enableDynamicClusterDistribution bool, loadCache ClusterLoadCache) (ClusterShardingCache, error) {
 	var replicasCount int
 	if enableDynamicClusterDistribution {
 		applicationControllerName := env.StringFromEnv(common.EnvAppControllerName, common.DefaultApplicationControllerName)
@@ -507,5 +533,5 @@ func GetClusterSharding(kubeClient kubernetes.Interface, settingsMgr *settings.S
 		shardNumber = 0
 	}
 	db := db.NewDB(settingsMgr.GetNamespace(), settingsMgr, kubeClient)
-	return NewClusterSharding(db, shardNumber, replicasCount, shardingAlgorithm), nil
+	return NewClusterSharding(db, shardNumber, replicasCount, shardingAlgorithm, loadCache), nil
 }
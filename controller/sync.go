@@ -39,6 +39,7 @@ import (
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
 	"github.com/argoproj/argo-cd/v3/util/lua"
 	"github.com/argoproj/argo-cd/v3/util/rand"
+	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
 var syncIdPrefix uint64
@@ -178,7 +179,7 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 		state.Message = fmt.Sprintf("Failed to load application project: %v", err)
 		return
 	} else {
-		isBlocked, err := syncWindowPreventsSync(app, proj)
+		isBlocked, err := syncWindowPreventsSync(app, proj, m.settingsMgr)
 		if isBlocked {
 			// If the operation is currently running, simply let the user know the sync is blocked by a current sync window
 			if state.Phase == common.OperationRunning {
@@ -209,10 +210,11 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 	syncRes.Revision = compareResult.syncStatus.Revision
 	syncRes.Revisions = compareResult.syncStatus.Revisions
 
-	// If there are any comparison or spec errors error conditions do not perform the operation
+	// If there are any comparison, spec or enforced resource policy errors conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:  true,
-		v1alpha1.ApplicationConditionInvalidSpecError: true,
+		v1alpha1.ApplicationConditionComparisonError:      true,
+		v1alpha1.ApplicationConditionInvalidSpecError:     true,
+		v1alpha1.ApplicationConditionPolicyViolationError: true,
 	}); len(errConditions) > 0 {
 		state.Phase = common.OperationError
 		state.Message = argo.FormatAppConditions(errConditions)
@@ -586,8 +588,14 @@ func delayBetweenSyncWaves(_ common.SyncPhase, _ int, finalWave bool) error {
 	return nil
 }
 
-func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject) (bool, error) {
-	window := proj.Spec.SyncWindows.Matches(app)
+func syncWindowPreventsSync(app *v1alpha1.Application, proj *v1alpha1.AppProject, settingsMgr *settings.SettingsManager) (bool, error) {
+	now := time.Now()
+	windows, err := argo.GetSyncWindows(proj, settingsMgr, now, now)
+	if err != nil {
+		// prevents sync because the calendar of a sync window cannot be resolved
+		return true, err
+	}
+	window := windows.Matches(app)
 	isManual := false
 	if app.Status.OperationState != nil {
 		isManual = !app.Status.OperationState.Operation.InitiatedBy.Automated
@@ -0,0 +1,192 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+const (
+	// syncClusterIndex indexes the applications with a sync operation running or queued by the server of their
+	// destination cluster
+	syncClusterIndex = "syncCluster"
+	// syncProjectIndex indexes the applications with a sync operation running or queued by project
+	syncProjectIndex = "syncProject"
+)
+
+// isSyncConcurrencyLimited returns whether the number of sync operations running at the same time on a cluster or in a
+// project is limited
+func (ctrl *ApplicationController) isSyncConcurrencyLimited() bool {
+	return ctrl.clusterSyncConcurrency > 0 || ctrl.projectSyncConcurrency > 0
+}
+
+// hasSyncOperationInProgress returns whether the application has a sync operation which is running or queued
+func hasSyncOperationInProgress(app *appv1.Application) bool {
+	state := app.Status.OperationState
+	return state != nil && !state.Phase.Completed() && state.Operation.Sync != nil
+}
+
+// syncClusterIndexFunc indexes the application by the server of its destination cluster if it has a sync operation in
+// progress. The destination is resolved when the application is updated, so that the sync operations of a cluster
+// are counted without resolving the destination of every application.
+func (ctrl *ApplicationController) syncClusterIndexFunc(obj any) ([]string, error) {
+	app, ok := obj.(*appv1.Application)
+	if !ok || !hasSyncOperationInProgress(app) || !ctrl.isAppNamespaceAllowed(app) {
+		return nil, nil
+	}
+	destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
+	if err != nil {
+		return nil, nil
+	}
+	return []string{destCluster.Server}, nil
+}
+
+// syncProjectIndexFunc indexes the application by project if it has a sync operation in progress
+func (ctrl *ApplicationController) syncProjectIndexFunc(obj any) ([]string, error) {
+	app, ok := obj.(*appv1.Application)
+	if !ok || !hasSyncOperationInProgress(app) || !ctrl.isAppNamespaceAllowed(app) {
+		return nil, nil
+	}
+	return []string{app.Spec.GetProject()}, nil
+}
+
+// isSyncOperationAhead returns whether the application has a sync operation which is running, or which was queued
+// before the operation of the application with the given qualified name queued at the given time.
+func isSyncOperationAhead(app *appv1.Application, qualifiedName string, queuedAt metav1.Time) bool {
+	if !hasSyncOperationInProgress(app) || app.QualifiedName() == qualifiedName {
+		return false
+	}
+	state := app.Status.OperationState
+	if state.Phase != appv1.OperationQueued {
+		return true
+	}
+	return state.StartedAt.Before(&queuedAt) || (state.StartedAt.Equal(&queuedAt) && app.QualifiedName() < qualifiedName)
+}
+
+// countSyncOperationsAhead returns the number of sync operations ahead of the operation of the application among the
+// applications indexed with the given value.
+func (ctrl *ApplicationController) countSyncOperationsAhead(indexName string, indexedValue string, app *appv1.Application, queuedAt metav1.Time) (int, error) {
+	objs, err := ctrl.appInformer.GetIndexer().ByIndex(indexName, indexedValue)
+	if err != nil {
+		return 0, fmt.Errorf("error listing applications with a sync operation in progress: %w", err)
+	}
+	count := 0
+	for _, obj := range objs {
+		if other, ok := obj.(*appv1.Application); ok && isSyncOperationAhead(other, app.QualifiedName(), queuedAt) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// syncQueuedReason returns why the sync operation of the application queued at the given time has to wait, or an empty
+// string if it can start. The operations queued before it are counted along with the running ones, so that the queued
+// operations of a cluster or a project start in order.
+//
+// The operations are counted from the applications of the informer, i.e. the operations run by every shard, but they
+// are only admitted one at a time within a shard. All the applications of a cluster are managed by the same shard, so
+// the limit per cluster is exact, whereas shards starting operations of the same project at the same time may exceed
+// the limit per project until their statuses are synchronized.
+func (ctrl *ApplicationController) syncQueuedReason(app *appv1.Application, queuedAt metav1.Time) (string, error) {
+	var server string
+	clusterSyncs, projectSyncs := 0, 0
+	if ctrl.clusterSyncConcurrency > 0 {
+		destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, ctrl.db)
+		if err != nil {
+			return "", err
+		}
+		server = destCluster.Server
+		if clusterSyncs, err = ctrl.countSyncOperationsAhead(syncClusterIndex, server, app, queuedAt); err != nil {
+			return "", err
+		}
+	}
+	if ctrl.projectSyncConcurrency > 0 {
+		var err error
+		if projectSyncs, err = ctrl.countSyncOperationsAhead(syncProjectIndex, app.Spec.GetProject(), app, queuedAt); err != nil {
+			return "", err
+		}
+	}
+
+	switch {
+	case ctrl.clusterSyncConcurrency > 0 && clusterSyncs >= ctrl.clusterSyncConcurrency:
+		return fmt.Sprintf("waiting for the sync operations running or queued before it on cluster '%s' to complete (%d/%d)", server, clusterSyncs, ctrl.clusterSyncConcurrency), nil
+	case ctrl.projectSyncConcurrency > 0 && projectSyncs >= ctrl.projectSyncConcurrency:
+		return fmt.Sprintf("waiting for the sync operations running or queued before it in project '%s' to complete (%d/%d)", app.Spec.GetProject(), projectSyncs, ctrl.projectSyncConcurrency), nil
+	}
+	return "", nil
+}
+
+// startOrQueueSyncOperation sets the phase of the sync operation of the application to Running, or to Queued if too
+// many sync operations already run on its destination cluster or in its project. It returns whether the operation
+// was started.
+func (ctrl *ApplicationController) startOrQueueSyncOperation(app *appv1.Application, state *appv1.OperationState) bool {
+	if !ctrl.isSyncConcurrencyLimited() {
+		state.Phase = synccommon.OperationRunning
+		ctrl.setOperationState(app, state)
+		return true
+	}
+
+	// The operations are admitted one at a time, so that the running ones are counted accurately. The lock is local to
+	// the shard, see syncQueuedReason.
+	ctrl.syncQueueMutex.Lock()
+	defer ctrl.syncQueueMutex.Unlock()
+
+	reason, err := ctrl.syncQueuedReason(app, state.StartedAt)
+	if err != nil {
+		// The operation is started and fails if the destination is invalid
+		log.WithFields(applog.GetAppLogFields(app)).Warnf("Failed to check the sync concurrency limits: %v", err)
+	}
+	if reason != "" {
+		state.Phase = appv1.OperationQueued
+		state.Message = reason
+		ctrl.setOperationState(app, state)
+		return false
+	}
+	state.Phase = synccommon.OperationRunning
+	state.Message = ""
+	state.StartedAt = metav1.Now()
+	ctrl.setOperationState(app, state)
+	return true
+}
+
+// requeueQueuedSyncOperations adds the applications with a queued sync operation to the operation queue, so that they
+// can start once a running operation has completed.
+func (ctrl *ApplicationController) requeueQueuedSyncOperations() {
+	if !ctrl.isSyncConcurrencyLimited() {
+		return
+	}
+	indexer := ctrl.appInformer.GetIndexer()
+	for _, project := range indexer.ListIndexFuncValues(syncProjectIndex) {
+		objs, err := indexer.ByIndex(syncProjectIndex, project)
+		if err != nil {
+			log.Warnf("Failed to list applications with a queued sync operation: %v", err)
+			return
+		}
+		for _, obj := range objs {
+			if app, ok := obj.(*appv1.Application); ok && app.Status.OperationState.Phase == appv1.OperationQueued {
+				ctrl.appOperationQueue.Add(ctrl.toAppKey(app.QualifiedName()))
+			}
+		}
+	}
+}
+
+// requeueReleasedSyncOperations requeues the queued sync operations when an update of an application, or its deletion
+// when new is nil, releases a running or queued sync operation. The application may be processed by another shard, and
+// still have held a slot of the concurrency limit of its project.
+func (ctrl *ApplicationController) requeueReleasedSyncOperations(old any, new any) {
+	oldApp, ok := old.(*appv1.Application)
+	if !ok || !hasSyncOperationInProgress(oldApp) {
+		return
+	}
+	if newApp, ok := new.(*appv1.Application); ok && hasSyncOperationInProgress(newApp) {
+		return
+	}
+	ctrl.requeueQueuedSyncOperations()
+}
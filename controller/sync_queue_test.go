@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	synccommon "github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-cd/v3/test"
+)
+
+func newFakeSyncingApp(name string, phase synccommon.OperationPhase, startedAt time.Time) *v1alpha1.Application {
+	app := newFakeApp()
+	app.Name = name
+	app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+	app.Status.OperationState = &v1alpha1.OperationState{Operation: *app.Operation, Phase: phase, StartedAt: metav1.NewTime(startedAt)}
+	return app
+}
+
+func TestIsSyncOperationAhead(t *testing.T) {
+	queuedAt := metav1.NewTime(time.Now().Truncate(time.Second))
+	qualifiedName := test.FakeArgoCDNamespace + "/my-app"
+
+	assert.True(t, isSyncOperationAhead(newFakeSyncingApp("running", synccommon.OperationRunning, queuedAt.Add(time.Minute)), qualifiedName, queuedAt))
+	assert.True(t, isSyncOperationAhead(newFakeSyncingApp("queued", v1alpha1.OperationQueued, queuedAt.Add(-time.Minute)), qualifiedName, queuedAt))
+	assert.True(t, isSyncOperationAhead(newFakeSyncingApp("a-app", v1alpha1.OperationQueued, queuedAt.Time), qualifiedName, queuedAt))
+	assert.False(t, isSyncOperationAhead(newFakeSyncingApp("z-app", v1alpha1.OperationQueued, queuedAt.Time), qualifiedName, queuedAt))
+	assert.False(t, isSyncOperationAhead(newFakeSyncingApp("queued", v1alpha1.OperationQueued, queuedAt.Add(time.Minute)), qualifiedName, queuedAt))
+	assert.False(t, isSyncOperationAhead(newFakeSyncingApp("succeeded", synccommon.OperationSucceeded, queuedAt.Add(-time.Minute)), qualifiedName, queuedAt))
+	assert.False(t, isSyncOperationAhead(newFakeApp(), test.FakeArgoCDNamespace+"/other-app", queuedAt))
+}
+
+func TestSyncIndexes(t *testing.T) {
+	running := newFakeSyncingApp("running", synccommon.OperationRunning, time.Now())
+	queued := newFakeSyncingApp("queued", v1alpha1.OperationQueued, time.Now())
+	queued.Spec.Project = "other"
+	succeeded := newFakeSyncingApp("succeeded", synccommon.OperationSucceeded, time.Now())
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{running, queued, succeeded, newFakeApp()}}, nil)
+
+	indexer := ctrl.appInformer.GetIndexer()
+	objs, err := indexer.ByIndex(syncClusterIndex, "https://localhost:6443")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"running", "queued"}, appNames(objs))
+	objs, err = indexer.ByIndex(syncProjectIndex, "default")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"running"}, appNames(objs))
+	objs, err = indexer.ByIndex(syncProjectIndex, "other")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"queued"}, appNames(objs))
+}
+
+func appNames(objs []any) []string {
+	var names []string
+	for _, obj := range objs {
+		names = append(names, obj.(*v1alpha1.Application).Name)
+	}
+	return names
+}
+
+func TestSyncQueuedReason(t *testing.T) {
+	now := time.Now()
+	app := newFakeSyncingApp("my-app", v1alpha1.OperationQueued, now)
+	otherProject := newFakeSyncingApp("other-project", synccommon.OperationRunning, now.Add(-time.Minute))
+	otherProject.Spec.Project = "other"
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{
+		app,
+		newFakeSyncingApp("running", synccommon.OperationRunning, now.Add(-time.Minute)),
+		newFakeSyncingApp("queued-later", v1alpha1.OperationQueued, now.Add(time.Minute)),
+		otherProject,
+	}}, nil)
+
+	t.Run("unlimited", func(t *testing.T) {
+		ctrl.clusterSyncConcurrency, ctrl.projectSyncConcurrency = 0, 0
+		assert.False(t, ctrl.isSyncConcurrencyLimited())
+	})
+
+	t.Run("cluster limit reached", func(t *testing.T) {
+		ctrl.clusterSyncConcurrency, ctrl.projectSyncConcurrency = 2, 0
+		reason, err := ctrl.syncQueuedReason(app, app.Status.OperationState.StartedAt)
+		require.NoError(t, err)
+		assert.Equal(t, "waiting for the sync operations running or queued before it on cluster 'https://localhost:6443' to complete (2/2)", reason)
+	})
+
+	t.Run("project limit reached", func(t *testing.T) {
+		ctrl.clusterSyncConcurrency, ctrl.projectSyncConcurrency = 3, 1
+		reason, err := ctrl.syncQueuedReason(app, app.Status.OperationState.StartedAt)
+		require.NoError(t, err)
+		assert.Equal(t, "waiting for the sync operations running or queued before it in project 'default' to complete (1/1)", reason)
+	})
+
+	t.Run("below limits", func(t *testing.T) {
+		ctrl.clusterSyncConcurrency, ctrl.projectSyncConcurrency = 3, 2
+		reason, err := ctrl.syncQueuedReason(app, app.Status.OperationState.StartedAt)
+		require.NoError(t, err)
+		assert.Empty(t, reason)
+	})
+}
+
+func TestProcessRequestedAppOperation_SyncConcurrency(t *testing.T) {
+	newController := func(t *testing.T, apps ...runtime.Object) (*ApplicationController, *[]map[string]any) {
+		t.Helper()
+		ctrl := newFakeController(&fakeData{apps: append(apps, &defaultProj)}, nil)
+		ctrl.clusterSyncConcurrency = 1
+		fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
+		var receivedPatches []map[string]any
+		fakeAppCs.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+			if patchAction, ok := action.(kubetesting.PatchAction); ok {
+				receivedPatch := map[string]any{}
+				require.NoError(t, json.Unmarshal(patchAction.GetPatch(), &receivedPatch))
+				receivedPatches = append(receivedPatches, receivedPatch)
+			}
+			return true, &v1alpha1.Application{}, nil
+		})
+		return ctrl, &receivedPatches
+	}
+
+	t.Run("new operation is queued", func(t *testing.T) {
+		app := newFakeApp()
+		app.Operation = &v1alpha1.Operation{Sync: &v1alpha1.SyncOperation{}}
+		ctrl, receivedPatches := newController(t, app, newFakeSyncingApp("running", synccommon.OperationRunning, time.Now().Add(-time.Minute)))
+
+		ctrl.processRequestedAppOperation(app)
+
+		require.Len(t, *receivedPatches, 1)
+		phase, _, _ := unstructured.NestedString((*receivedPatches)[0], "status", "operationState", "phase")
+		assert.Equal(t, string(v1alpha1.OperationQueued), phase)
+		message, _, _ := unstructured.NestedString((*receivedPatches)[0], "status", "operationState", "message")
+		assert.Equal(t, "waiting for the sync operations running or queued before it on cluster 'https://localhost:6443' to complete (1/1)", message)
+	})
+
+	t.Run("queued operation is started", func(t *testing.T) {
+		app := newFakeSyncingApp("my-app", v1alpha1.OperationQueued, time.Now().Add(-time.Minute))
+		ctrl, receivedPatches := newController(t, app, newFakeSyncingApp("succeeded", synccommon.OperationSucceeded, time.Now().Add(-time.Hour)))
+
+		ctrl.processRequestedAppOperation(app)
+
+		require.NotEmpty(t, *receivedPatches)
+		phase, _, _ := unstructured.NestedString((*receivedPatches)[0], "status", "operationState", "phase")
+		assert.Equal(t, string(synccommon.OperationRunning), phase)
+	})
+}
+
+func TestRequeueReleasedSyncOperations(t *testing.T) {
+	now := time.Now()
+	running := newFakeSyncingApp("running", synccommon.OperationRunning, now.Add(-time.Minute))
+	succeeded := newFakeSyncingApp("running", synccommon.OperationSucceeded, now.Add(-time.Minute))
+	ctrl := newFakeController(&fakeData{apps: []runtime.Object{
+		newFakeSyncingApp("queued", v1alpha1.OperationQueued, now),
+	}}, nil)
+	ctrl.clusterSyncConcurrency = 1
+
+	ctrl.requeueReleasedSyncOperations(running, running)
+	ctrl.requeueReleasedSyncOperations(succeeded, succeeded)
+	ctrl.requeueReleasedSyncOperations(newFakeApp(), nil)
+	assert.Equal(t, 0, ctrl.appOperationQueue.Len())
+
+	ctrl.requeueReleasedSyncOperations(running, succeeded)
+	assert.Equal(t, 1, ctrl.appOperationQueue.Len())
+
+	key, _ := ctrl.appOperationQueue.Get()
+	ctrl.appOperationQueue.Done(key)
+	require.Equal(t, 0, ctrl.appOperationQueue.Len())
+	ctrl.requeueReleasedSyncOperations(running, nil)
+	assert.Equal(t, 1, ctrl.appOperationQueue.Len())
+}
@@ -324,6 +324,25 @@ func TestSyncWindowDeniesSync(t *testing.T) {
 		assert.Equal(t, common.OperationRunning, opState.Phase)
 		assert.Contains(t, opState.Message, opMessage)
 	})
+
+	t.Run("will prevent the sync if the calendar of a sync window cannot be resolved", func(t *testing.T) {
+		// given a project with a sync window referencing a missing calendar
+		t.Parallel()
+		f := setup()
+		project := f.project.DeepCopy()
+		project.Spec.SyncWindows = v1alpha1.SyncWindows{{
+			Kind:         "allow",
+			Applications: []string{"*"},
+			Calendar:     &v1alpha1.SyncWindowCalendar{ConfigMap: "holidays", Key: "holidays.ics"},
+		}}
+
+		// when
+		isBlocked, err := syncWindowPreventsSync(f.application, project, f.controller.settingsMgr)
+
+		// then
+		assert.True(t, isBlocked)
+		require.ErrorContains(t, err, "error getting ConfigMap 'holidays'")
+	})
 }
 
 func TestNormalizeTargetResources(t *testing.T) {
@@ -1129,6 +1129,156 @@ func (m *ApplicationSyncRequest) GetRevisions() []string {
 	return nil
 }
 
+// ApplicationHydrateRequest is a request to hydrate the manifests of an application using the source hydrator
+type ApplicationHydrateRequest struct {
+	Name *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	// DryRun renders the manifests and returns their diff against the hydrated branch instead of committing them
+	DryRun               *bool    `protobuf:"varint,2,opt,name=dryRun" json:"dryRun,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,3,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,4,opt,name=project" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationHydrateRequest) Reset()         { *m = ApplicationHydrateRequest{} }
+func (m *ApplicationHydrateRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationHydrateRequest) ProtoMessage()    {}
+func (*ApplicationHydrateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{14}
+}
+func (m *ApplicationHydrateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationHydrateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationHydrateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationHydrateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHydrateRequest.Merge(m, src)
+}
+func (m *ApplicationHydrateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationHydrateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHydrateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHydrateRequest proto.InternalMessageInfo
+
+func (m *ApplicationHydrateRequest) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateRequest) GetDryRun() bool {
+	if m != nil && m.DryRun != nil {
+		return *m.DryRun
+	}
+	return false
+}
+
+func (m *ApplicationHydrateRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+// ApplicationHydrateResponse is the result of a hydration request
+type ApplicationHydrateResponse struct {
+	// DrySHA is the commit of the dry source the manifests were rendered from. Only set for dry runs.
+	DrySHA *string `protobuf:"bytes,1,opt,name=drySHA" json:"drySHA,omitempty"`
+	// TargetBranch is the branch the manifests would be committed to. Only set for dry runs.
+	TargetBranch *string `protobuf:"bytes,2,opt,name=targetBranch" json:"targetBranch,omitempty"`
+	// Paths are the paths of all applications rendered to the target branch. Only set for dry runs.
+	Paths []string `protobuf:"bytes,3,rep,name=paths" json:"paths,omitempty"`
+	// Diff is the diff of the rendered manifests against the current content of the target branch. Only set for dry
+	// runs.
+	Diff                 *string  `protobuf:"bytes,4,opt,name=diff" json:"diff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationHydrateResponse) Reset()         { *m = ApplicationHydrateResponse{} }
+func (m *ApplicationHydrateResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationHydrateResponse) ProtoMessage()    {}
+func (*ApplicationHydrateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{15}
+}
+func (m *ApplicationHydrateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationHydrateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationHydrateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationHydrateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHydrateResponse.Merge(m, src)
+}
+func (m *ApplicationHydrateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationHydrateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHydrateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHydrateResponse proto.InternalMessageInfo
+
+func (m *ApplicationHydrateResponse) GetDrySHA() string {
+	if m != nil && m.DrySHA != nil {
+		return *m.DrySHA
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateResponse) GetTargetBranch() string {
+	if m != nil && m.TargetBranch != nil {
+		return *m.TargetBranch
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateResponse) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *ApplicationHydrateResponse) GetDiff() string {
+	if m != nil && m.Diff != nil {
+		return *m.Diff
+	}
+	return ""
+}
+
 // ApplicationUpdateSpecRequest is a request to update application spec
 type ApplicationUpdateSpecRequest struct {
 	Name                 *string                   `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
@@ -1145,7 +1295,7 @@ func (m *ApplicationUpdateSpecRequest) Reset()         { *m = ApplicationUpdateS
 func (m *ApplicationUpdateSpecRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationUpdateSpecRequest) ProtoMessage()    {}
 func (*ApplicationUpdateSpecRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{14}
+	return fileDescriptor_df6e82b174b5eaec, []int{16}
 }
 func (m *ApplicationUpdateSpecRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1225,7 +1375,7 @@ func (m *ApplicationPatchRequest) Reset()         { *m = ApplicationPatchRequest
 func (m *ApplicationPatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPatchRequest) ProtoMessage()    {}
 func (*ApplicationPatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{15}
+	return fileDescriptor_df6e82b174b5eaec, []int{17}
 }
 func (m *ApplicationPatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1305,7 +1455,7 @@ func (m *ApplicationRollbackRequest) Reset()         { *m = ApplicationRollbackR
 func (m *ApplicationRollbackRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationRollbackRequest) ProtoMessage()    {}
 func (*ApplicationRollbackRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{16}
+	return fileDescriptor_df6e82b174b5eaec, []int{18}
 }
 func (m *ApplicationRollbackRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1394,7 +1544,7 @@ func (m *ApplicationResourceRequest) Reset()         { *m = ApplicationResourceR
 func (m *ApplicationResourceRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceRequest) ProtoMessage()    {}
 func (*ApplicationResourceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{17}
+	return fileDescriptor_df6e82b174b5eaec, []int{19}
 }
 func (m *ApplicationResourceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1499,7 +1649,7 @@ func (m *ApplicationResourcePatchRequest) Reset()         { *m = ApplicationReso
 func (m *ApplicationResourcePatchRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourcePatchRequest) ProtoMessage()    {}
 func (*ApplicationResourcePatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{18}
+	return fileDescriptor_df6e82b174b5eaec, []int{20}
 }
 func (m *ApplicationResourcePatchRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +1768,7 @@ func (m *ApplicationResourceDeleteRequest) Reset()         { *m = ApplicationRes
 func (m *ApplicationResourceDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceDeleteRequest) ProtoMessage()    {}
 func (*ApplicationResourceDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{19}
+	return fileDescriptor_df6e82b174b5eaec, []int{21}
 }
 func (m *ApplicationResourceDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1729,7 +1879,7 @@ func (m *ResourceActionParameters) Reset()         { *m = ResourceActionParamete
 func (m *ResourceActionParameters) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionParameters) ProtoMessage()    {}
 func (*ResourceActionParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{20}
+	return fileDescriptor_df6e82b174b5eaec, []int{22}
 }
 func (m *ResourceActionParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1792,7 +1942,7 @@ func (m *ResourceActionRunRequest) Reset()         { *m = ResourceActionRunReque
 func (m *ResourceActionRunRequest) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionRunRequest) ProtoMessage()    {}
 func (*ResourceActionRunRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{21}
+	return fileDescriptor_df6e82b174b5eaec, []int{23}
 }
 func (m *ResourceActionRunRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1902,7 +2052,7 @@ func (m *ResourceActionsListResponse) Reset()         { *m = ResourceActionsList
 func (m *ResourceActionsListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResourceActionsListResponse) ProtoMessage()    {}
 func (*ResourceActionsListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{22}
+	return fileDescriptor_df6e82b174b5eaec, []int{24}
 }
 func (m *ResourceActionsListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1949,7 +2099,7 @@ func (m *ApplicationResourceResponse) Reset()         { *m = ApplicationResource
 func (m *ApplicationResourceResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationResourceResponse) ProtoMessage()    {}
 func (*ApplicationResourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{23}
+	return fileDescriptor_df6e82b174b5eaec, []int{25}
 }
 func (m *ApplicationResourceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2012,7 +2162,7 @@ func (m *ApplicationPodLogsQuery) Reset()         { *m = ApplicationPodLogsQuery
 func (m *ApplicationPodLogsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationPodLogsQuery) ProtoMessage()    {}
 func (*ApplicationPodLogsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{24}
+	return fileDescriptor_df6e82b174b5eaec, []int{26}
 }
 func (m *ApplicationPodLogsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2176,7 +2326,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{25}
+	return fileDescriptor_df6e82b174b5eaec, []int{27}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2253,7 +2403,7 @@ func (m *OperationTerminateRequest) Reset()         { *m = OperationTerminateReq
 func (m *OperationTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateRequest) ProtoMessage()    {}
 func (*OperationTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{26}
+	return fileDescriptor_df6e82b174b5eaec, []int{28}
 }
 func (m *OperationTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2316,7 +2466,7 @@ func (m *ApplicationSyncWindowsQuery) Reset()         { *m = ApplicationSyncWind
 func (m *ApplicationSyncWindowsQuery) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsQuery) ProtoMessage()    {}
 func (*ApplicationSyncWindowsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{27}
+	return fileDescriptor_df6e82b174b5eaec, []int{29}
 }
 func (m *ApplicationSyncWindowsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2379,7 +2529,7 @@ func (m *ApplicationSyncWindowsResponse) Reset()         { *m = ApplicationSyncW
 func (m *ApplicationSyncWindowsResponse) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindowsResponse) ProtoMessage()    {}
 func (*ApplicationSyncWindowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{28}
+	return fileDescriptor_df6e82b174b5eaec, []int{30}
 }
 func (m *ApplicationSyncWindowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2430,20 +2580,21 @@ func (m *ApplicationSyncWindowsResponse) GetCanSync() bool {
 }
 
 type ApplicationSyncWindow struct {
-	Kind                 *string  `protobuf:"bytes,1,req,name=kind" json:"kind,omitempty"`
-	Schedule             *string  `protobuf:"bytes,2,req,name=schedule" json:"schedule,omitempty"`
-	Duration             *string  `protobuf:"bytes,3,req,name=duration" json:"duration,omitempty"`
-	ManualSync           *bool    `protobuf:"varint,4,req,name=manualSync" json:"manualSync,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Kind                 *string                         `protobuf:"bytes,1,req,name=kind" json:"kind,omitempty"`
+	Schedule             *string                         `protobuf:"bytes,2,req,name=schedule" json:"schedule,omitempty"`
+	Duration             *string                         `protobuf:"bytes,3,req,name=duration" json:"duration,omitempty"`
+	ManualSync           *bool                           `protobuf:"varint,4,req,name=manualSync" json:"manualSync,omitempty"`
+	DateRanges           []*v1alpha1.SyncWindowDateRange `protobuf:"bytes,5,rep,name=dateRanges" json:"dateRanges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
 func (m *ApplicationSyncWindow) Reset()         { *m = ApplicationSyncWindow{} }
 func (m *ApplicationSyncWindow) String() string { return proto.CompactTextString(m) }
 func (*ApplicationSyncWindow) ProtoMessage()    {}
 func (*ApplicationSyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{29}
+	return fileDescriptor_df6e82b174b5eaec, []int{31}
 }
 func (m *ApplicationSyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2500,6 +2651,13 @@ func (m *ApplicationSyncWindow) GetManualSync() bool {
 	return false
 }
 
+func (m *ApplicationSyncWindow) GetDateRanges() []*v1alpha1.SyncWindowDateRange {
+	if m != nil {
+		return m.DateRanges
+	}
+	return nil
+}
+
 type OperationTerminateResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -2510,7 +2668,7 @@ func (m *OperationTerminateResponse) Reset()         { *m = OperationTerminateRe
 func (m *OperationTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*OperationTerminateResponse) ProtoMessage()    {}
 func (*OperationTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{30}
+	return fileDescriptor_df6e82b174b5eaec, []int{32}
 }
 func (m *OperationTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2557,7 +2715,7 @@ func (m *ResourcesQuery) Reset()         { *m = ResourcesQuery{} }
 func (m *ResourcesQuery) String() string { return proto.CompactTextString(m) }
 func (*ResourcesQuery) ProtoMessage()    {}
 func (*ResourcesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{31}
+	return fileDescriptor_df6e82b174b5eaec, []int{33}
 }
 func (m *ResourcesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2653,7 +2811,7 @@ func (m *ManagedResourcesResponse) Reset()         { *m = ManagedResourcesRespon
 func (m *ManagedResourcesResponse) String() string { return proto.CompactTextString(m) }
 func (*ManagedResourcesResponse) ProtoMessage()    {}
 func (*ManagedResourcesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{32}
+	return fileDescriptor_df6e82b174b5eaec, []int{34}
 }
 func (m *ManagedResourcesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2703,7 +2861,7 @@ func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
 func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
 func (*LinkInfo) ProtoMessage()    {}
 func (*LinkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{33}
+	return fileDescriptor_df6e82b174b5eaec, []int{35}
 }
 func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2771,7 +2929,7 @@ func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
 func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
 func (*LinksResponse) ProtoMessage()    {}
 func (*LinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{34}
+	return fileDescriptor_df6e82b174b5eaec, []int{36}
 }
 func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2820,7 +2978,7 @@ func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
 func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppLinksRequest) ProtoMessage()    {}
 func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{35}
+	return fileDescriptor_df6e82b174b5eaec, []int{37}
 }
 func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2885,6 +3043,8 @@ func init() {
 	proto.RegisterType((*ApplicationDeleteRequest)(nil), "application.ApplicationDeleteRequest")
 	proto.RegisterType((*SyncOptions)(nil), "application.SyncOptions")
 	proto.RegisterType((*ApplicationSyncRequest)(nil), "application.ApplicationSyncRequest")
+	proto.RegisterType((*ApplicationHydrateRequest)(nil), "application.ApplicationHydrateRequest")
+	proto.RegisterType((*ApplicationHydrateResponse)(nil), "application.ApplicationHydrateResponse")
 	proto.RegisterType((*ApplicationUpdateSpecRequest)(nil), "application.ApplicationUpdateSpecRequest")
 	proto.RegisterType((*ApplicationPatchRequest)(nil), "application.ApplicationPatchRequest")
 	proto.RegisterType((*ApplicationRollbackRequest)(nil), "application.ApplicationRollbackRequest")
@@ -2914,182 +3074,189 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 2801 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x8c, 0x1c, 0x47,
-	0x15, 0xa6, 0x66, 0x76, 0x76, 0x67, 0xdf, 0xec, 0x7a, 0xed, 0x8a, 0xbd, 0x74, 0xc6, 0x1b, 0x33,
-	0x69, 0xdb, 0xf1, 0x64, 0xed, 0x9d, 0xb1, 0x37, 0x01, 0x92, 0x4d, 0x22, 0x70, 0xd6, 0x8e, 0xb3,
-	0xb0, 0x76, 0x4c, 0xaf, 0x83, 0x51, 0x38, 0x40, 0xa5, 0xbb, 0x76, 0xa6, 0xd9, 0x9e, 0xee, 0x76,
-	0x77, 0xcf, 0x84, 0x55, 0xc8, 0x25, 0x88, 0x5b, 0x04, 0x02, 0x82, 0xc4, 0x01, 0x01, 0x4a, 0x14,
-	0x09, 0x21, 0x10, 0x17, 0x84, 0x90, 0x10, 0x12, 0x1c, 0x40, 0x70, 0x40, 0x8a, 0x40, 0xe2, 0x8c,
-	0x22, 0xc4, 0x0d, 0xb8, 0xe4, 0x8c, 0x50, 0x55, 0x57, 0x75, 0x57, 0xcf, 0x4f, 0xcf, 0x2c, 0x33,
-	0x28, 0xbe, 0xf5, 0xab, 0xa9, 0x7a, 0xef, 0x7b, 0xaf, 0x5e, 0xbd, 0xf7, 0xea, 0xd5, 0xc0, 0xb9,
-	0x90, 0x06, 0x3d, 0x1a, 0x34, 0x89, 0xef, 0x3b, 0xb6, 0x49, 0x22, 0xdb, 0x73, 0xd5, 0xef, 0x86,
-	0x1f, 0x78, 0x91, 0x87, 0x2b, 0xca, 0x50, 0x75, 0xad, 0xe5, 0x79, 0x2d, 0x87, 0x36, 0x89, 0x6f,
-	0x37, 0x89, 0xeb, 0x7a, 0x11, 0x1f, 0x0e, 0xe3, 0xa9, 0x55, 0xfd, 0xe0, 0x89, 0xb0, 0x61, 0x7b,
-	0xfc, 0x57, 0xd3, 0x0b, 0x68, 0xb3, 0x77, 0xa5, 0xd9, 0xa2, 0x2e, 0x0d, 0x48, 0x44, 0x2d, 0x31,
-	0xe7, 0xf1, 0x74, 0x4e, 0x87, 0x98, 0x6d, 0xdb, 0xa5, 0xc1, 0x61, 0xd3, 0x3f, 0x68, 0xb1, 0x81,
-	0xb0, 0xd9, 0xa1, 0x11, 0x19, 0xb6, 0x6a, 0xb7, 0x65, 0x47, 0xed, 0xee, 0xcb, 0x0d, 0xd3, 0xeb,
-	0x34, 0x49, 0xd0, 0xf2, 0xfc, 0xc0, 0xfb, 0x12, 0xff, 0xd8, 0x30, 0xad, 0x66, 0xef, 0xb1, 0x94,
-	0x81, 0xaa, 0x4b, 0xef, 0x0a, 0x71, 0xfc, 0x36, 0x19, 0xe4, 0x76, 0x7d, 0x0c, 0xb7, 0x80, 0xfa,
-	0x9e, 0xb0, 0x0d, 0xff, 0xb4, 0x23, 0x2f, 0x38, 0x54, 0x3e, 0x63, 0x36, 0xfa, 0xfb, 0x08, 0x8e,
-	0x5f, 0x4d, 0xe5, 0x7d, 0xa6, 0x4b, 0x83, 0x43, 0x8c, 0x61, 0xce, 0x25, 0x1d, 0xaa, 0xa1, 0x1a,
-	0xaa, 0x2f, 0x1a, 0xfc, 0x1b, 0x6b, 0xb0, 0x10, 0xd0, 0xfd, 0x80, 0x86, 0x6d, 0xad, 0xc0, 0x87,
-	0x25, 0x89, 0xab, 0x50, 0x66, 0xc2, 0xa9, 0x19, 0x85, 0x5a, 0xb1, 0x56, 0xac, 0x2f, 0x1a, 0x09,
-	0x8d, 0xeb, 0xb0, 0x12, 0xd0, 0xd0, 0xeb, 0x06, 0x26, 0xfd, 0x2c, 0x0d, 0x42, 0xdb, 0x73, 0xb5,
-	0x39, 0xbe, 0xba, 0x7f, 0x98, 0x71, 0x09, 0xa9, 0x43, 0xcd, 0xc8, 0x0b, 0xb4, 0x12, 0x9f, 0x92,
-	0xd0, 0x0c, 0x0f, 0x03, 0xae, 0xcd, 0xc7, 0x78, 0xd8, 0x37, 0xd6, 0x61, 0x89, 0xf8, 0xfe, 0x2d,
-	0xd2, 0xa1, 0xa1, 0x4f, 0x4c, 0xaa, 0x2d, 0xf0, 0xdf, 0x32, 0x63, 0x0c, 0xb3, 0x40, 0xa2, 0x95,
-	0x39, 0x30, 0x49, 0xea, 0xdb, 0xb0, 0x78, 0xcb, 0xb3, 0xe8, 0x68, 0x75, 0xfb, 0xd9, 0x17, 0x06,
-	0xd9, 0xeb, 0xbf, 0x43, 0x70, 0xca, 0xa0, 0x3d, 0x9b, 0xe1, 0xbf, 0x49, 0x23, 0x62, 0x91, 0x88,
-	0xf4, 0x73, 0x2c, 0x24, 0x1c, 0xab, 0x50, 0x0e, 0xc4, 0x64, 0xad, 0xc0, 0xc7, 0x13, 0x7a, 0x40,
-	0x5a, 0x31, 0x5f, 0x99, 0xd8, 0x84, 0x92, 0xc4, 0x35, 0xa8, 0xc4, 0xb6, 0xdc, 0x71, 0x2d, 0xfa,
-	0x65, 0x6e, 0xbd, 0x92, 0xa1, 0x0e, 0xe1, 0x35, 0x58, 0xec, 0xc5, 0x76, 0xde, 0xb1, 0xb8, 0x15,
-	0x4b, 0x46, 0x3a, 0xa0, 0xff, 0x03, 0xc1, 0x19, 0xc5, 0x07, 0x0c, 0xb1, 0x33, 0xd7, 0x7b, 0xd4,
-	0x8d, 0xc2, 0xd1, 0x0a, 0x5d, 0x82, 0x13, 0x72, 0x13, 0xfb, 0xed, 0x34, 0xf8, 0x03, 0x53, 0x51,
-	0x1d, 0x94, 0x2a, 0xaa, 0x63, 0x4c, 0x11, 0x49, 0xbf, 0xb8, 0x73, 0x4d, 0xa8, 0xa9, 0x0e, 0x0d,
-	0x18, 0xaa, 0x94, 0x6f, 0xa8, 0xf9, 0x8c, 0xa1, 0xf4, 0x77, 0x11, 0x68, 0x8a, 0xa2, 0x37, 0x89,
-	0x6b, 0xef, 0xd3, 0x30, 0x9a, 0x74, 0xcf, 0xd0, 0x0c, 0xf7, 0xac, 0x0e, 0x2b, 0xb1, 0x56, 0xb7,
-	0xd9, 0x79, 0x64, 0xf1, 0x47, 0x2b, 0xd5, 0x8a, 0xf5, 0xa2, 0xd1, 0x3f, 0xcc, 0xf6, 0x4e, 0xca,
-	0x0c, 0xb5, 0x79, 0xee, 0xc6, 0xe9, 0x80, 0xfe, 0x30, 0x2c, 0x3e, 0x67, 0x3b, 0x74, 0xbb, 0xdd,
-	0x75, 0x0f, 0xf0, 0x49, 0x28, 0x99, 0xec, 0x83, 0xeb, 0xb0, 0x64, 0xc4, 0x84, 0xfe, 0x4d, 0x04,
-	0x0f, 0x8f, 0xd2, 0xfa, 0xae, 0x1d, 0xb5, 0xd9, 0xfa, 0x70, 0x94, 0xfa, 0x66, 0x9b, 0x9a, 0x07,
-	0x61, 0xb7, 0x23, 0x5d, 0x56, 0xd2, 0xd3, 0xa9, 0xaf, 0xff, 0x18, 0x41, 0x7d, 0x2c, 0xa6, 0xbb,
-	0x01, 0xf1, 0x7d, 0x1a, 0xe0, 0xe7, 0xa0, 0x74, 0x8f, 0xfd, 0xc0, 0x0f, 0x68, 0x65, 0xb3, 0xd1,
-	0x50, 0x03, 0xfc, 0x58, 0x2e, 0xcf, 0x7f, 0xc8, 0x88, 0x97, 0xe3, 0x86, 0x34, 0x4f, 0x81, 0xf3,
-	0x59, 0xcd, 0xf0, 0x49, 0xac, 0xc8, 0xe6, 0xf3, 0x69, 0xcf, 0xce, 0xc3, 0x9c, 0x4f, 0x82, 0x48,
-	0x3f, 0x05, 0x0f, 0x64, 0x8f, 0x87, 0xef, 0xb9, 0x21, 0xd5, 0x7f, 0x95, 0xf5, 0xa6, 0xed, 0x80,
-	0x92, 0x88, 0x1a, 0xf4, 0x5e, 0x97, 0x86, 0x11, 0x3e, 0x00, 0x35, 0xe7, 0x70, 0xab, 0x56, 0x36,
-	0x77, 0x1a, 0x69, 0xd0, 0x6e, 0xc8, 0xa0, 0xcd, 0x3f, 0xbe, 0x60, 0x5a, 0x8d, 0xde, 0x63, 0x0d,
-	0xff, 0xa0, 0xd5, 0x60, 0x29, 0x20, 0x83, 0x4c, 0xa6, 0x00, 0x55, 0x55, 0x43, 0xe5, 0x8e, 0x57,
-	0x61, 0xbe, 0xeb, 0x87, 0x34, 0x88, 0xb8, 0x66, 0x65, 0x43, 0x50, 0x6c, 0xff, 0x7a, 0xc4, 0xb1,
-	0x2d, 0x12, 0xc5, 0xfb, 0x53, 0x36, 0x12, 0x5a, 0xff, 0x75, 0x16, 0xfd, 0x8b, 0xbe, 0xf5, 0x41,
-	0xa1, 0x57, 0x51, 0x16, 0xb2, 0x28, 0x55, 0x0f, 0x2a, 0x66, 0x3d, 0xe8, 0xe7, 0x59, 0xfc, 0xd7,
-	0xa8, 0x43, 0x53, 0xfc, 0xc3, 0x9c, 0x59, 0x83, 0x05, 0x93, 0x84, 0x26, 0xb1, 0xa4, 0x14, 0x49,
-	0xb2, 0x40, 0xe6, 0x07, 0x9e, 0x4f, 0x5a, 0x9c, 0xd3, 0x6d, 0xcf, 0xb1, 0xcd, 0x43, 0x21, 0x6e,
-	0xf0, 0x87, 0x01, 0xc7, 0x9f, 0xcb, 0x77, 0xfc, 0x52, 0x16, 0xf6, 0x59, 0xa8, 0xec, 0x1d, 0xba,
-	0xe6, 0x0b, 0x7e, 0x7c, 0xb8, 0x4f, 0x42, 0xc9, 0x8e, 0x68, 0x27, 0xd4, 0x10, 0x3f, 0xd8, 0x31,
-	0xa1, 0xff, 0xa7, 0x04, 0xab, 0x8a, 0x6e, 0x6c, 0x41, 0x9e, 0x66, 0x79, 0x51, 0x6a, 0x15, 0xe6,
-	0xad, 0xe0, 0xd0, 0xe8, 0xba, 0xc2, 0x01, 0x04, 0xc5, 0x04, 0xfb, 0x41, 0xd7, 0x8d, 0xe1, 0x97,
-	0x8d, 0x98, 0xc0, 0xfb, 0x50, 0x0e, 0x23, 0x56, 0x65, 0xb4, 0x0e, 0x39, 0xf0, 0xca, 0xe6, 0xa7,
-	0xa6, 0xdb, 0x74, 0x06, 0x7d, 0x4f, 0x70, 0x34, 0x12, 0xde, 0xf8, 0x1e, 0x8b, 0x69, 0x71, 0xa0,
-	0x0b, 0xb5, 0x85, 0x5a, 0xb1, 0x5e, 0xd9, 0xdc, 0x9b, 0x5e, 0xd0, 0x0b, 0x3e, 0xab, 0x90, 0x94,
-	0x0c, 0x66, 0xa4, 0x52, 0x58, 0x18, 0xed, 0x88, 0xf8, 0x10, 0x8a, 0x6a, 0x20, 0x1d, 0xc0, 0x9f,
-	0x83, 0x92, 0xed, 0xee, 0x7b, 0xa1, 0xb6, 0xc8, 0xc1, 0x3c, 0x3b, 0x1d, 0x98, 0x1d, 0x77, 0xdf,
-	0x33, 0x62, 0x86, 0xf8, 0x1e, 0x2c, 0x07, 0x34, 0x0a, 0x0e, 0xa5, 0x15, 0x34, 0xe0, 0x76, 0xfd,
-	0xf4, 0x74, 0x12, 0x0c, 0x95, 0xa5, 0x91, 0x95, 0x80, 0xb7, 0xa0, 0x12, 0xa6, 0x3e, 0xa6, 0x55,
-	0xb8, 0x40, 0x2d, 0xc3, 0x48, 0xf1, 0x41, 0x43, 0x9d, 0x3c, 0xe0, 0xdd, 0x4b, 0xf9, 0xde, 0xbd,
-	0x3c, 0x36, 0xab, 0x1d, 0x9b, 0x20, 0xab, 0xad, 0xf4, 0x67, 0xb5, 0x7f, 0x23, 0x58, 0x1b, 0x08,
-	0x4e, 0x7b, 0x3e, 0xcd, 0x3d, 0x06, 0x04, 0xe6, 0x42, 0x9f, 0x9a, 0x3c, 0x53, 0x55, 0x36, 0x6f,
-	0xce, 0x2c, 0x5a, 0x71, 0xb9, 0x9c, 0x75, 0x5e, 0x40, 0x9d, 0x32, 0x2e, 0xfc, 0x00, 0xc1, 0x87,
-	0x15, 0x99, 0xb7, 0x49, 0x64, 0xb6, 0xf3, 0x94, 0x65, 0xe7, 0x97, 0xcd, 0x11, 0x79, 0x39, 0x26,
-	0x98, 0x55, 0xf9, 0xc7, 0x9d, 0x43, 0x9f, 0x01, 0x64, 0xbf, 0xa4, 0x03, 0x53, 0x16, 0x4f, 0x3f,
-	0x41, 0x50, 0x55, 0x63, 0xb8, 0xe7, 0x38, 0x2f, 0x13, 0xf3, 0x20, 0x0f, 0xe4, 0x31, 0x28, 0xd8,
-	0x16, 0x47, 0x58, 0x34, 0x0a, 0xb6, 0x75, 0xc4, 0x60, 0xd4, 0x0f, 0x77, 0x3e, 0x1f, 0xee, 0x42,
-	0x16, 0xee, 0xfb, 0x7d, 0x70, 0x65, 0x48, 0xc8, 0x81, 0xbb, 0x06, 0x8b, 0x6e, 0x5f, 0x21, 0x9b,
-	0x0e, 0x0c, 0x29, 0x60, 0x0b, 0x03, 0x05, 0xac, 0x06, 0x0b, 0xbd, 0xe4, 0x9a, 0xc3, 0x7e, 0x96,
-	0x24, 0x53, 0xb1, 0x15, 0x78, 0x5d, 0x5f, 0x18, 0x3d, 0x26, 0x18, 0x8a, 0x03, 0xdb, 0x65, 0x25,
-	0x39, 0x47, 0xc1, 0xbe, 0x8f, 0x7e, 0xb1, 0xc9, 0xa8, 0xfd, 0xd3, 0x02, 0x7c, 0x64, 0x88, 0xda,
-	0x63, 0xfd, 0xe9, 0xfe, 0xd0, 0x3d, 0xf1, 0xea, 0x85, 0x91, 0x5e, 0x5d, 0x1e, 0xe7, 0xd5, 0x8b,
-	0xf9, 0xf6, 0x82, 0xac, 0xbd, 0x7e, 0x54, 0x80, 0xda, 0x10, 0x7b, 0x8d, 0x2f, 0x27, 0xee, 0x1b,
-	0x83, 0xed, 0x7b, 0x81, 0xf0, 0x92, 0xb2, 0x11, 0x13, 0xec, 0x9c, 0x79, 0x81, 0xdf, 0x26, 0x2e,
-	0xf7, 0x8e, 0xb2, 0x21, 0xa8, 0x29, 0x4d, 0x75, 0x0d, 0x34, 0x69, 0x9e, 0xab, 0x66, 0x1c, 0xa4,
-	0x02, 0xd2, 0xa1, 0x11, 0x0d, 0xc2, 0x51, 0x21, 0xaa, 0x47, 0x9c, 0x2e, 0x95, 0x21, 0x8a, 0x13,
-	0xfa, 0x3f, 0x0b, 0xfd, 0x6c, 0x8c, 0xae, 0x7b, 0xff, 0x1b, 0x7a, 0x15, 0xe6, 0x09, 0x47, 0x2b,
-	0x5c, 0x53, 0x50, 0x03, 0x26, 0x2d, 0xe7, 0x9b, 0x74, 0x31, 0x9b, 0x2f, 0x09, 0x68, 0xc1, 0x08,
-	0x93, 0x6a, 0xc0, 0x2b, 0x91, 0xf3, 0x99, 0xf4, 0x34, 0xca, 0xfe, 0xc6, 0x48, 0x36, 0xfa, 0xd7,
-	0x10, 0x9c, 0xce, 0x2e, 0x0b, 0x77, 0xed, 0x30, 0x92, 0xb7, 0x18, 0xbc, 0x0f, 0x0b, 0xb1, 0x2a,
-	0x71, 0x0d, 0x5a, 0xd9, 0xdc, 0x9d, 0xb6, 0x32, 0xc9, 0xec, 0xad, 0x64, 0xae, 0x3f, 0x09, 0xa7,
-	0x87, 0x86, 0x63, 0x01, 0xa3, 0x0a, 0x65, 0x59, 0x8d, 0x89, 0xdd, 0x4f, 0x68, 0xfd, 0xed, 0xb9,
-	0x6c, 0x6e, 0xf4, 0xac, 0x5d, 0xaf, 0x95, 0xd3, 0x98, 0xc8, 0xf7, 0x18, 0xb6, 0x1b, 0x9e, 0xa5,
-	0xf4, 0x20, 0x24, 0xc9, 0xd6, 0x99, 0x9e, 0x1b, 0x11, 0xdb, 0xa5, 0x81, 0x48, 0xdf, 0xe9, 0x00,
-	0xdb, 0xe9, 0xd0, 0x76, 0x4d, 0xba, 0x47, 0x4d, 0xcf, 0xb5, 0x42, 0xee, 0x32, 0x45, 0x23, 0x33,
-	0x86, 0x9f, 0x87, 0x45, 0x4e, 0xdf, 0xb1, 0x3b, 0x71, 0xbe, 0xaa, 0x6c, 0xae, 0x37, 0xe2, 0x66,
-	0x61, 0x43, 0x6d, 0x16, 0xa6, 0x36, 0xec, 0xd0, 0x88, 0x34, 0x7a, 0x57, 0x1a, 0x6c, 0x85, 0x91,
-	0x2e, 0x66, 0x58, 0x22, 0x62, 0x3b, 0xbb, 0xb6, 0xcb, 0x2b, 0x64, 0x26, 0x2a, 0x1d, 0x60, 0xde,
-	0xb8, 0xef, 0x39, 0x8e, 0xf7, 0x8a, 0x3c, 0xe0, 0x31, 0xc5, 0x56, 0x75, 0xdd, 0xc8, 0x76, 0xb8,
-	0xfc, 0xd8, 0xd7, 0xd2, 0x01, 0xbe, 0xca, 0x76, 0x22, 0x1a, 0x88, 0x93, 0x2d, 0xa8, 0xc4, 0xdf,
-	0x2b, 0x71, 0xff, 0x4b, 0x06, 0x96, 0xf8, 0x64, 0x2c, 0xa9, 0x27, 0xa3, 0xff, 0xb4, 0x2d, 0x0f,
-	0x69, 0xe2, 0xf0, 0x76, 0x20, 0xed, 0xd9, 0x5e, 0x97, 0x15, 0x7f, 0xbc, 0x46, 0x92, 0xf4, 0xc0,
-	0x69, 0x59, 0xc9, 0x3f, 0x2d, 0xc7, 0xb3, 0xa7, 0x85, 0x97, 0xf0, 0x91, 0xd9, 0xde, 0x26, 0x21,
-	0xd5, 0x4e, 0x70, 0xd6, 0xe9, 0x80, 0xfe, 0x1b, 0x04, 0xe5, 0x5d, 0xaf, 0x75, 0xdd, 0x8d, 0x82,
-	0x43, 0x7e, 0xd9, 0xf3, 0xdc, 0x88, 0xba, 0xd2, 0x9b, 0x24, 0xc9, 0xb6, 0x28, 0xb2, 0x3b, 0x74,
-	0x2f, 0x22, 0x1d, 0x5f, 0x94, 0x8a, 0x47, 0xda, 0xa2, 0x64, 0x31, 0x33, 0x9b, 0x43, 0xc2, 0x88,
-	0x87, 0x9c, 0xb2, 0xc1, 0xbf, 0x99, 0x82, 0xc9, 0x84, 0xbd, 0x28, 0x10, 0xf1, 0x26, 0x33, 0xa6,
-	0x3a, 0x60, 0x29, 0xc6, 0x26, 0x48, 0xbd, 0x03, 0x0f, 0x26, 0x77, 0x98, 0x3b, 0x34, 0xe8, 0xd8,
-	0x2e, 0xc9, 0x4f, 0x42, 0x13, 0x74, 0x29, 0x73, 0xae, 0xd0, 0x5e, 0xe6, 0x48, 0xb2, 0x2b, 0xc1,
-	0x5d, 0xdb, 0xb5, 0xbc, 0x57, 0x72, 0x8e, 0xd6, 0x74, 0x02, 0xff, 0x9c, 0x6d, 0x34, 0x2a, 0x12,
-	0x93, 0x38, 0xf0, 0x3c, 0x2c, 0xb3, 0x88, 0xd1, 0xa3, 0xe2, 0x07, 0x11, 0x94, 0xf4, 0x51, 0x3d,
-	0x9f, 0x94, 0x87, 0x91, 0x5d, 0x88, 0x77, 0x61, 0x85, 0x84, 0xa1, 0xdd, 0x72, 0xa9, 0x25, 0x79,
-	0x15, 0x26, 0xe6, 0xd5, 0xbf, 0x34, 0xee, 0x1e, 0xf0, 0x19, 0x62, 0xbf, 0x25, 0xa9, 0x7f, 0x15,
-	0xc1, 0xa9, 0xa1, 0x4c, 0x92, 0x73, 0x85, 0x94, 0x3c, 0x52, 0x85, 0x72, 0x68, 0xb6, 0xa9, 0xd5,
-	0x75, 0x64, 0x5e, 0x4c, 0x68, 0xf6, 0x9b, 0xd5, 0x8d, 0x77, 0x5f, 0xe4, 0xb1, 0x84, 0xc6, 0x67,
-	0x00, 0x3a, 0xc4, 0xed, 0x12, 0x87, 0x43, 0x98, 0xe3, 0x10, 0x94, 0x11, 0x7d, 0x0d, 0xaa, 0xc3,
-	0x5c, 0x47, 0xb4, 0xaa, 0xfe, 0x85, 0xe0, 0x98, 0x0c, 0xb9, 0x62, 0x77, 0xeb, 0xb0, 0xa2, 0x98,
-	0xe1, 0x56, 0xba, 0xd1, 0xfd, 0xc3, 0x63, 0xc2, 0xa9, 0xf4, 0x92, 0x62, 0xf6, 0xad, 0xa0, 0x97,
-	0xe9, 0xf6, 0x4f, 0x9c, 0x70, 0xd1, 0x8c, 0xca, 0xe0, 0xaf, 0x80, 0x76, 0x93, 0xb8, 0xa4, 0x45,
-	0xad, 0x44, 0xed, 0xc4, 0xc5, 0xbe, 0xa8, 0xf6, 0x5c, 0xa6, 0xee, 0x70, 0x24, 0x15, 0xa3, 0xbd,
-	0xbf, 0x2f, 0xfb, 0x37, 0x01, 0x94, 0x77, 0x6d, 0xf7, 0x60, 0xc7, 0xdd, 0xf7, 0x98, 0xc6, 0x91,
-	0x1d, 0x39, 0xd2, 0xba, 0x31, 0x81, 0x8f, 0x43, 0xb1, 0x1b, 0x38, 0xc2, 0x03, 0xd8, 0x27, 0xae,
-	0x41, 0xc5, 0xa2, 0xa1, 0x19, 0xd8, 0xbe, 0xd8, 0x7f, 0xde, 0xfb, 0x56, 0x86, 0xd8, 0x3e, 0xd8,
-	0xa6, 0xe7, 0x6e, 0x3b, 0x24, 0x0c, 0x65, 0x7a, 0x4a, 0x06, 0xf4, 0xa7, 0x61, 0x99, 0xc9, 0x4c,
-	0xd5, 0xbc, 0x98, 0x55, 0xf3, 0x54, 0x06, 0xbe, 0x84, 0x27, 0x11, 0x13, 0x78, 0x80, 0x55, 0x05,
-	0x57, 0x7d, 0x5f, 0x30, 0x99, 0xb0, 0x1e, 0x2b, 0x0e, 0xcb, 0xae, 0x43, 0x5b, 0xbe, 0x9b, 0x7f,
-	0x3d, 0x0b, 0x58, 0x3d, 0x27, 0x34, 0xe8, 0xd9, 0x26, 0xc5, 0xdf, 0x42, 0x30, 0xc7, 0x44, 0xe3,
-	0x87, 0x46, 0x1d, 0x4b, 0xee, 0xaf, 0xd5, 0xd9, 0xdd, 0xe7, 0x99, 0x34, 0x7d, 0xed, 0xf5, 0xbf,
-	0xfc, 0xfd, 0xdb, 0x85, 0x55, 0x7c, 0x92, 0x3f, 0xf4, 0xf5, 0xae, 0xa8, 0x8f, 0x6e, 0x21, 0x7e,
-	0x03, 0x01, 0x16, 0x55, 0x92, 0xf2, 0x14, 0x82, 0x2f, 0x8e, 0x82, 0x38, 0xe4, 0xc9, 0xa4, 0xfa,
-	0x90, 0x92, 0x55, 0x1a, 0xa6, 0x17, 0x50, 0x96, 0x43, 0xf8, 0x04, 0x0e, 0x60, 0x9d, 0x03, 0x38,
-	0x87, 0xf5, 0x61, 0x00, 0x9a, 0xaf, 0x32, 0x8b, 0xbe, 0xd6, 0xa4, 0xb1, 0xdc, 0xb7, 0x10, 0x94,
-	0xee, 0xf2, 0xab, 0xd0, 0x18, 0x23, 0xed, 0xcd, 0xcc, 0x48, 0x5c, 0x1c, 0x47, 0xab, 0x9f, 0xe5,
-	0x48, 0x1f, 0xc2, 0xa7, 0x25, 0xd2, 0x30, 0x0a, 0x28, 0xe9, 0x64, 0x00, 0x5f, 0x46, 0xf8, 0x1d,
-	0x04, 0xf3, 0x71, 0x0f, 0x1c, 0x9f, 0x1f, 0x85, 0x32, 0xd3, 0x23, 0xaf, 0xce, 0xae, 0xa1, 0xac,
-	0x3f, 0xca, 0x31, 0x9e, 0xd5, 0x87, 0x6e, 0xe7, 0x56, 0xa6, 0xdd, 0xfc, 0x26, 0x82, 0xe2, 0x0d,
-	0x3a, 0xd6, 0xdf, 0x66, 0x08, 0x6e, 0xc0, 0x80, 0x43, 0xb6, 0x1a, 0xbf, 0x8d, 0xe0, 0xc1, 0x1b,
-	0x34, 0x1a, 0x9e, 0x1e, 0x71, 0x7d, 0x7c, 0xce, 0x12, 0x6e, 0x77, 0x71, 0x82, 0x99, 0x49, 0x5e,
-	0x68, 0x72, 0x64, 0x8f, 0xe2, 0x0b, 0x79, 0x4e, 0x18, 0x1e, 0xba, 0xe6, 0x2b, 0x02, 0xc7, 0x1f,
-	0x11, 0x1c, 0xef, 0x7f, 0xf2, 0xc4, 0x7a, 0xdf, 0x1d, 0x65, 0xc8, 0x8b, 0x68, 0xf5, 0xd6, 0xb4,
-	0x51, 0x36, 0xcb, 0x54, 0xbf, 0xca, 0x91, 0x3f, 0x85, 0x9f, 0xcc, 0x43, 0x9e, 0x34, 0x14, 0x9b,
-	0xaf, 0xca, 0xcf, 0xd7, 0xf8, 0xf3, 0x3c, 0x87, 0xfd, 0x27, 0x04, 0x27, 0x25, 0xdf, 0xed, 0x36,
-	0x09, 0xa2, 0x6b, 0x94, 0x55, 0xd8, 0xe1, 0x44, 0xfa, 0x4c, 0x99, 0x35, 0x54, 0x79, 0xfa, 0x75,
-	0xae, 0xcb, 0x27, 0xf0, 0x33, 0x47, 0xd6, 0xc5, 0x64, 0x6c, 0x2c, 0x01, 0xfb, 0x75, 0x04, 0x4b,
-	0x37, 0x68, 0x74, 0x33, 0x69, 0x6a, 0x9f, 0x9f, 0xe8, 0xa1, 0xac, 0xba, 0xd6, 0x50, 0xfe, 0x15,
-	0x20, 0x7f, 0x4a, 0x5c, 0x64, 0x83, 0x83, 0xbb, 0x80, 0xcf, 0xe7, 0x81, 0x4b, 0x1b, 0xe9, 0x6f,
-	0x21, 0x38, 0xa5, 0x82, 0x48, 0x1f, 0x18, 0x3f, 0x7a, 0xb4, 0x67, 0x3b, 0xf1, 0xf8, 0x37, 0x06,
-	0xdd, 0x26, 0x47, 0x77, 0x49, 0x1f, 0xee, 0xc0, 0x9d, 0x01, 0x14, 0x5b, 0x68, 0xbd, 0x8e, 0xf0,
-	0x6f, 0x11, 0xcc, 0xc7, 0x3d, 0xe5, 0xd1, 0x36, 0xca, 0x3c, 0x88, 0xcd, 0x32, 0x1a, 0x88, 0xdd,
-	0xae, 0x5e, 0x1e, 0x6e, 0x50, 0x75, 0xbd, 0x74, 0xd5, 0x06, 0xb7, 0x72, 0x36, 0x8c, 0xfd, 0x02,
-	0x01, 0xa4, 0x7d, 0x71, 0xfc, 0x68, 0xbe, 0x1e, 0x4a, 0xef, 0xbc, 0x3a, 0xdb, 0xce, 0xb8, 0xde,
-	0xe0, 0xfa, 0xd4, 0xab, 0xb5, 0xdc, 0x18, 0xe2, 0x53, 0x73, 0x2b, 0xee, 0xa1, 0xff, 0x10, 0x41,
-	0x89, 0xb7, 0x23, 0xf1, 0xb9, 0x51, 0x98, 0xd5, 0x6e, 0xe5, 0x2c, 0x4d, 0xff, 0x08, 0x87, 0x5a,
-	0xdb, 0xcc, 0x0b, 0xc4, 0x5b, 0x68, 0x1d, 0xf7, 0x60, 0x3e, 0x6e, 0x00, 0x8e, 0x76, 0x8f, 0x4c,
-	0x83, 0xb0, 0x5a, 0xcb, 0x29, 0x0c, 0x62, 0x47, 0x15, 0x39, 0x60, 0x7d, 0x5c, 0x0e, 0x98, 0x63,
-	0x61, 0x1a, 0x9f, 0xcd, 0x0b, 0xe2, 0xff, 0x07, 0xc3, 0x5c, 0xe4, 0xe8, 0xce, 0xeb, 0xb5, 0x71,
-	0x79, 0x80, 0x59, 0xe7, 0xbb, 0x08, 0x8e, 0xf7, 0x17, 0xd7, 0xf8, 0xf4, 0xd0, 0x3e, 0x95, 0xc8,
-	0x49, 0x59, 0x2b, 0x8e, 0x2a, 0xcc, 0xf5, 0x4f, 0x72, 0x14, 0x5b, 0xf8, 0x89, 0xb1, 0x27, 0xe3,
-	0x96, 0x8c, 0x3a, 0x8c, 0xd1, 0x46, 0xfa, 0xc8, 0xf7, 0x4b, 0x04, 0x4b, 0x92, 0xef, 0x9d, 0x80,
-	0xd2, 0x7c, 0x58, 0xb3, 0x3b, 0x08, 0x4c, 0x96, 0xfe, 0x34, 0x87, 0xff, 0x31, 0xfc, 0xf8, 0x84,
-	0xf0, 0x25, 0xec, 0x8d, 0x88, 0x21, 0xfd, 0x3d, 0x82, 0x13, 0x77, 0x63, 0xbf, 0xff, 0x80, 0xf0,
-	0x6f, 0x73, 0xfc, 0xcf, 0xe0, 0xa7, 0x72, 0xea, 0xbc, 0x71, 0x6a, 0x5c, 0x46, 0xf8, 0x67, 0x08,
-	0xca, 0xf2, 0x71, 0x08, 0x5f, 0x18, 0x79, 0x30, 0xb2, 0xcf, 0x47, 0xb3, 0x74, 0x66, 0x51, 0xd4,
-	0xe8, 0xe7, 0x72, 0xd3, 0xa9, 0x90, 0xcf, 0x1c, 0xfa, 0x4d, 0x04, 0x38, 0xb9, 0x33, 0x27, 0xb7,
-	0x68, 0xfc, 0x48, 0x46, 0xd4, 0xc8, 0xc6, 0x4c, 0xf5, 0xc2, 0xd8, 0x79, 0xd9, 0x54, 0xba, 0x9e,
-	0x9b, 0x4a, 0xbd, 0x44, 0xfe, 0xd7, 0x11, 0x54, 0x6e, 0xd0, 0xe4, 0x0e, 0x92, 0x63, 0xcb, 0xec,
-	0xdb, 0x56, 0xb5, 0x3e, 0x7e, 0xa2, 0x40, 0x74, 0x89, 0x23, 0x7a, 0x04, 0xe7, 0x9b, 0x4a, 0x02,
-	0xf8, 0x1e, 0x82, 0xe5, 0xdb, 0xaa, 0x8b, 0xe2, 0x4b, 0xe3, 0x24, 0x65, 0x22, 0xf9, 0xe4, 0xb8,
-	0x1e, 0xe3, 0xb8, 0x36, 0xf4, 0x89, 0x70, 0x6d, 0x89, 0x67, 0xa2, 0xef, 0xa3, 0xf8, 0x12, 0xdb,
-	0xd7, 0xed, 0xfe, 0x5f, 0xed, 0x96, 0xd3, 0x34, 0xd7, 0x1f, 0xe7, 0xf8, 0x1a, 0xf8, 0xd2, 0x24,
-	0xf8, 0x9a, 0xa2, 0x05, 0x8e, 0xbf, 0x83, 0xe0, 0x04, 0x7f, 0xec, 0x50, 0x19, 0xe3, 0xbc, 0x0e,
-	0x7f, 0xfa, 0x34, 0x32, 0x41, 0x8a, 0xf9, 0x38, 0x07, 0x75, 0x45, 0x3f, 0x12, 0x28, 0xe6, 0xff,
-	0xdf, 0x40, 0x70, 0x4c, 0xe6, 0x33, 0xb1, 0xb1, 0x1b, 0xe3, 0x6c, 0x76, 0xd4, 0xfc, 0x27, 0x3c,
-	0x6d, 0x7d, 0x32, 0x4f, 0x7b, 0x07, 0xc1, 0x82, 0x68, 0xf3, 0xe7, 0x54, 0x09, 0xca, 0x3b, 0x40,
-	0xb5, 0xaf, 0xbd, 0x21, 0xfa, 0xc0, 0xfa, 0xe7, 0xb9, 0xd8, 0x17, 0x71, 0x33, 0x4f, 0xac, 0xef,
-	0x59, 0x61, 0xf3, 0x55, 0xd1, 0x84, 0x7d, 0xad, 0xe9, 0x78, 0xad, 0xf0, 0x25, 0x1d, 0xe7, 0xe6,
-	0x42, 0x36, 0xe7, 0x32, 0xc2, 0x11, 0x2c, 0x32, 0xbf, 0xe0, 0x3d, 0x13, 0x5c, 0xeb, 0xeb, 0xb0,
-	0x0c, 0xb4, 0x53, 0xaa, 0xd5, 0x81, 0x1e, 0x4c, 0x9a, 0xfc, 0xc4, 0x0d, 0x16, 0x3f, 0x9c, 0x2b,
-	0x96, 0x0b, 0x7a, 0x03, 0xc1, 0x09, 0xd5, 0xd1, 0x63, 0xf1, 0x13, 0xbb, 0x79, 0x1e, 0x0a, 0x51,
-	0x4f, 0xe3, 0xf5, 0x89, 0x7c, 0x88, 0xc3, 0x79, 0xf6, 0xb9, 0x3f, 0xbc, 0x77, 0x06, 0xbd, 0xfb,
-	0xde, 0x19, 0xf4, 0xb7, 0xf7, 0xce, 0xa0, 0x97, 0x9e, 0x98, 0xec, 0x5f, 0xce, 0xa6, 0x63, 0x53,
-	0x37, 0x52, 0xd9, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff, 0x20, 0x14, 0xaf, 0x73, 0xcb, 0x2d, 0x00,
+	// 2913 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x8f, 0x1c, 0x47,
+	0x15, 0xa7, 0x66, 0x76, 0x76, 0x67, 0xdf, 0xf8, 0xb3, 0x62, 0x9b, 0xce, 0x78, 0x63, 0x36, 0x6d,
+	0x3b, 0xde, 0xac, 0xbd, 0x33, 0xf6, 0x26, 0x40, 0xb2, 0x49, 0x04, 0xf6, 0xda, 0xb1, 0x0d, 0x6b,
+	0xc7, 0xe9, 0x75, 0x30, 0x0a, 0x07, 0xa8, 0x74, 0xd7, 0xce, 0x34, 0xdb, 0xd3, 0xdd, 0xae, 0xae,
+	0x99, 0xb0, 0x0a, 0xb9, 0x44, 0xe2, 0x82, 0x22, 0x10, 0x10, 0x24, 0x0e, 0x08, 0x50, 0xa2, 0x48,
+	0x08, 0x81, 0xb8, 0x20, 0x84, 0x84, 0x90, 0xe0, 0x00, 0x82, 0x03, 0x52, 0x04, 0xff, 0x00, 0x0a,
+	0x88, 0x1b, 0x70, 0x89, 0x38, 0x22, 0x54, 0xd5, 0x55, 0xfd, 0x31, 0x1f, 0x3d, 0xb3, 0xcc, 0xa0,
+	0xe4, 0xd6, 0xaf, 0xa6, 0xea, 0xbd, 0xdf, 0x7b, 0xf5, 0xea, 0xbd, 0xaa, 0xf7, 0x06, 0xce, 0x44,
+	0x94, 0xf5, 0x28, 0x6b, 0x92, 0x30, 0xf4, 0x5c, 0x9b, 0x70, 0x37, 0xf0, 0xb3, 0xdf, 0x8d, 0x90,
+	0x05, 0x3c, 0xc0, 0xb5, 0xcc, 0x50, 0x7d, 0xa9, 0x15, 0x04, 0x2d, 0x8f, 0x36, 0x49, 0xe8, 0x36,
+	0x89, 0xef, 0x07, 0x5c, 0x0e, 0x47, 0xf1, 0xd4, 0xba, 0xb9, 0xfb, 0x44, 0xd4, 0x70, 0x03, 0xf9,
+	0xab, 0x1d, 0x30, 0xda, 0xec, 0x5d, 0x6a, 0xb6, 0xa8, 0x4f, 0x19, 0xe1, 0xd4, 0x51, 0x73, 0x1e,
+	0x4f, 0xe7, 0x74, 0x88, 0xdd, 0x76, 0x7d, 0xca, 0xf6, 0x9a, 0xe1, 0x6e, 0x4b, 0x0c, 0x44, 0xcd,
+	0x0e, 0xe5, 0x64, 0xd8, 0xaa, 0xad, 0x96, 0xcb, 0xdb, 0xdd, 0x97, 0x1a, 0x76, 0xd0, 0x69, 0x12,
+	0xd6, 0x0a, 0x42, 0x16, 0x7c, 0x51, 0x7e, 0xac, 0xd9, 0x4e, 0xb3, 0xf7, 0x58, 0xca, 0x20, 0xab,
+	0x4b, 0xef, 0x12, 0xf1, 0xc2, 0x36, 0x19, 0xe4, 0x76, 0x6d, 0x0c, 0x37, 0x46, 0xc3, 0x40, 0xd9,
+	0x46, 0x7e, 0xba, 0x3c, 0x60, 0x7b, 0x99, 0xcf, 0x98, 0x8d, 0xf9, 0x1e, 0x82, 0x23, 0x97, 0x53,
+	0x79, 0xcf, 0x77, 0x29, 0xdb, 0xc3, 0x18, 0xe6, 0x7c, 0xd2, 0xa1, 0x06, 0x5a, 0x46, 0x2b, 0x8b,
+	0x96, 0xfc, 0xc6, 0x06, 0x2c, 0x30, 0xba, 0xc3, 0x68, 0xd4, 0x36, 0x4a, 0x72, 0x58, 0x93, 0xb8,
+	0x0e, 0x55, 0x21, 0x9c, 0xda, 0x3c, 0x32, 0xca, 0xcb, 0xe5, 0x95, 0x45, 0x2b, 0xa1, 0xf1, 0x0a,
+	0x1c, 0x66, 0x34, 0x0a, 0xba, 0xcc, 0xa6, 0x9f, 0xa1, 0x2c, 0x72, 0x03, 0xdf, 0x98, 0x93, 0xab,
+	0xfb, 0x87, 0x05, 0x97, 0x88, 0x7a, 0xd4, 0xe6, 0x01, 0x33, 0x2a, 0x72, 0x4a, 0x42, 0x0b, 0x3c,
+	0x02, 0xb8, 0x31, 0x1f, 0xe3, 0x11, 0xdf, 0xd8, 0x84, 0x03, 0x24, 0x0c, 0x6f, 0x93, 0x0e, 0x8d,
+	0x42, 0x62, 0x53, 0x63, 0x41, 0xfe, 0x96, 0x1b, 0x13, 0x98, 0x15, 0x12, 0xa3, 0x2a, 0x81, 0x69,
+	0xd2, 0xdc, 0x84, 0xc5, 0xdb, 0x81, 0x43, 0x47, 0xab, 0xdb, 0xcf, 0xbe, 0x34, 0xc8, 0xde, 0xfc,
+	0x2d, 0x82, 0xe3, 0x16, 0xed, 0xb9, 0x02, 0xff, 0x2d, 0xca, 0x89, 0x43, 0x38, 0xe9, 0xe7, 0x58,
+	0x4a, 0x38, 0xd6, 0xa1, 0xca, 0xd4, 0x64, 0xa3, 0x24, 0xc7, 0x13, 0x7a, 0x40, 0x5a, 0xb9, 0x58,
+	0x99, 0xd8, 0x84, 0x9a, 0xc4, 0xcb, 0x50, 0x8b, 0x6d, 0x79, 0xd3, 0x77, 0xe8, 0x97, 0xa4, 0xf5,
+	0x2a, 0x56, 0x76, 0x08, 0x2f, 0xc1, 0x62, 0x2f, 0xb6, 0xf3, 0x4d, 0x47, 0x5a, 0xb1, 0x62, 0xa5,
+	0x03, 0xe6, 0xdf, 0x11, 0x9c, 0xca, 0xf8, 0x80, 0xa5, 0x76, 0xe6, 0x5a, 0x8f, 0xfa, 0x3c, 0x1a,
+	0xad, 0xd0, 0x05, 0x38, 0xaa, 0x37, 0xb1, 0xdf, 0x4e, 0x83, 0x3f, 0x08, 0x15, 0xb3, 0x83, 0x5a,
+	0xc5, 0xec, 0x98, 0x50, 0x44, 0xd3, 0x2f, 0xdc, 0xbc, 0xaa, 0xd4, 0xcc, 0x0e, 0x0d, 0x18, 0xaa,
+	0x52, 0x6c, 0xa8, 0xf9, 0x9c, 0xa1, 0xcc, 0x77, 0x10, 0x18, 0x19, 0x45, 0x6f, 0x11, 0xdf, 0xdd,
+	0xa1, 0x11, 0x9f, 0x74, 0xcf, 0xd0, 0x0c, 0xf7, 0x6c, 0x05, 0x0e, 0xc7, 0x5a, 0xdd, 0x11, 0xe7,
+	0x51, 0xc4, 0x1f, 0xa3, 0xb2, 0x5c, 0x5e, 0x29, 0x5b, 0xfd, 0xc3, 0x62, 0xef, 0xb4, 0xcc, 0xc8,
+	0x98, 0x97, 0x6e, 0x9c, 0x0e, 0x98, 0x0f, 0xc3, 0xe2, 0xb3, 0xae, 0x47, 0x37, 0xdb, 0x5d, 0x7f,
+	0x17, 0x1f, 0x83, 0x8a, 0x2d, 0x3e, 0xa4, 0x0e, 0x07, 0xac, 0x98, 0x30, 0xbf, 0x81, 0xe0, 0xe1,
+	0x51, 0x5a, 0xdf, 0x73, 0x79, 0x5b, 0xac, 0x8f, 0x46, 0xa9, 0x6f, 0xb7, 0xa9, 0xbd, 0x1b, 0x75,
+	0x3b, 0xda, 0x65, 0x35, 0x3d, 0x9d, 0xfa, 0xe6, 0x8f, 0x10, 0xac, 0x8c, 0xc5, 0x74, 0x8f, 0x91,
+	0x30, 0xa4, 0x0c, 0x3f, 0x0b, 0x95, 0xfb, 0xe2, 0x07, 0x79, 0x40, 0x6b, 0xeb, 0x8d, 0x46, 0x36,
+	0xc0, 0x8f, 0xe5, 0x72, 0xe3, 0x43, 0x56, 0xbc, 0x1c, 0x37, 0xb4, 0x79, 0x4a, 0x92, 0xcf, 0x89,
+	0x1c, 0x9f, 0xc4, 0x8a, 0x62, 0xbe, 0x9c, 0x76, 0x65, 0x1e, 0xe6, 0x42, 0xc2, 0xb8, 0x79, 0x1c,
+	0x1e, 0xc8, 0x1f, 0x8f, 0x30, 0xf0, 0x23, 0x6a, 0xfe, 0x32, 0xef, 0x4d, 0x9b, 0x8c, 0x12, 0x4e,
+	0x2d, 0x7a, 0xbf, 0x4b, 0x23, 0x8e, 0x77, 0x21, 0x9b, 0x73, 0xa4, 0x55, 0x6b, 0xeb, 0x37, 0x1b,
+	0x69, 0xd0, 0x6e, 0xe8, 0xa0, 0x2d, 0x3f, 0x3e, 0x6f, 0x3b, 0x8d, 0xde, 0x63, 0x8d, 0x70, 0xb7,
+	0xd5, 0x10, 0x29, 0x20, 0x87, 0x4c, 0xa7, 0x80, 0xac, 0xaa, 0x56, 0x96, 0x3b, 0x3e, 0x01, 0xf3,
+	0xdd, 0x30, 0xa2, 0x8c, 0x4b, 0xcd, 0xaa, 0x96, 0xa2, 0xc4, 0xfe, 0xf5, 0x88, 0xe7, 0x3a, 0x84,
+	0xc7, 0xfb, 0x53, 0xb5, 0x12, 0xda, 0xfc, 0x55, 0x1e, 0xfd, 0x0b, 0xa1, 0xf3, 0x7e, 0xa1, 0xcf,
+	0xa2, 0x2c, 0xe5, 0x51, 0x66, 0x3d, 0xa8, 0x9c, 0xf7, 0xa0, 0x9f, 0xe5, 0xf1, 0x5f, 0xa5, 0x1e,
+	0x4d, 0xf1, 0x0f, 0x73, 0x66, 0x03, 0x16, 0x6c, 0x12, 0xd9, 0xc4, 0xd1, 0x52, 0x34, 0x29, 0x02,
+	0x59, 0xc8, 0x82, 0x90, 0xb4, 0x24, 0xa7, 0x3b, 0x81, 0xe7, 0xda, 0x7b, 0x4a, 0xdc, 0xe0, 0x0f,
+	0x03, 0x8e, 0x3f, 0x57, 0xec, 0xf8, 0x95, 0x3c, 0xec, 0xd3, 0x50, 0xdb, 0xde, 0xf3, 0xed, 0xe7,
+	0xc2, 0xf8, 0x70, 0x1f, 0x83, 0x8a, 0xcb, 0x69, 0x27, 0x32, 0x90, 0x3c, 0xd8, 0x31, 0x61, 0xfe,
+	0xa7, 0x02, 0x27, 0x32, 0xba, 0x89, 0x05, 0x45, 0x9a, 0x15, 0x45, 0xa9, 0x13, 0x30, 0xef, 0xb0,
+	0x3d, 0xab, 0xeb, 0x2b, 0x07, 0x50, 0x94, 0x10, 0x1c, 0xb2, 0xae, 0x1f, 0xc3, 0xaf, 0x5a, 0x31,
+	0x81, 0x77, 0xa0, 0x1a, 0x71, 0x46, 0x38, 0x6d, 0xed, 0x49, 0xe0, 0xb5, 0xf5, 0x4f, 0x4d, 0xb7,
+	0xe9, 0x02, 0xfa, 0xb6, 0xe2, 0x68, 0x25, 0xbc, 0xf1, 0x7d, 0x11, 0xd3, 0xe2, 0x40, 0x17, 0x19,
+	0x0b, 0xcb, 0xe5, 0x95, 0xda, 0xfa, 0xf6, 0xf4, 0x82, 0x9e, 0x0b, 0x29, 0xcb, 0x65, 0x30, 0x2b,
+	0x95, 0x22, 0xc2, 0x68, 0x47, 0xc5, 0x87, 0x48, 0xdd, 0x06, 0xd2, 0x01, 0xfc, 0x59, 0xa8, 0xb8,
+	0xfe, 0x4e, 0x10, 0x19, 0x8b, 0x12, 0xcc, 0x95, 0xe9, 0xc0, 0xdc, 0xf4, 0x77, 0x02, 0x2b, 0x66,
+	0x88, 0xef, 0xc3, 0x41, 0x46, 0x39, 0xdb, 0xd3, 0x56, 0x30, 0x40, 0xda, 0xf5, 0xd3, 0xd3, 0x49,
+	0xb0, 0xb2, 0x2c, 0xad, 0xbc, 0x04, 0xbc, 0x01, 0xb5, 0x28, 0xf5, 0x31, 0xa3, 0x26, 0x05, 0x1a,
+	0x39, 0x46, 0x19, 0x1f, 0xb4, 0xb2, 0x93, 0x07, 0xbc, 0xfb, 0x40, 0xb1, 0x77, 0x1f, 0x1c, 0x9b,
+	0xd5, 0x0e, 0x4d, 0x90, 0xd5, 0x0e, 0xf7, 0x67, 0xb5, 0xaf, 0x20, 0x78, 0x30, 0x73, 0x00, 0x6e,
+	0xec, 0x39, 0x8c, 0x14, 0x9f, 0xee, 0xd4, 0xcf, 0x4b, 0x39, 0x3f, 0x9f, 0x2e, 0x4d, 0xbd, 0x86,
+	0xa0, 0x3e, 0x0c, 0x47, 0x9c, 0x01, 0x94, 0xd0, 0xed, 0x1b, 0x97, 0xd5, 0xd5, 0x51, 0x51, 0x42,
+	0x28, 0x27, 0xac, 0x45, 0xf9, 0x15, 0x46, 0x7c, 0x5b, 0x5f, 0x98, 0x73, 0x63, 0xf2, 0x00, 0x12,
+	0xde, 0xd6, 0x57, 0xe6, 0x98, 0x10, 0xaa, 0x39, 0xee, 0xce, 0x8e, 0xc2, 0x21, 0xbf, 0xcd, 0x7f,
+	0x21, 0x58, 0x1a, 0x88, 0xd4, 0xdb, 0x21, 0x2d, 0x8c, 0x09, 0x04, 0xe6, 0xa2, 0x90, 0xda, 0x32,
+	0x6d, 0xd7, 0xd6, 0x6f, 0xcd, 0x2c, 0x74, 0x4b, 0xb9, 0x92, 0x75, 0x51, 0x76, 0x99, 0x32, 0x48,
+	0x7e, 0x1f, 0xc1, 0x87, 0x33, 0x32, 0xef, 0x10, 0x6e, 0xb7, 0x8b, 0x94, 0x8d, 0x6d, 0x29, 0x0d,
+	0x5d, 0x52, 0xb6, 0xb4, 0xdb, 0xc2, 0xc5, 0xe4, 0xc7, 0xdd, 0xbd, 0x50, 0x00, 0x14, 0xbf, 0xa4,
+	0x03, 0x53, 0xde, 0x24, 0x7f, 0x9c, 0x77, 0x0c, 0x2b, 0xf0, 0xbc, 0x97, 0x88, 0xbd, 0x5b, 0x04,
+	0xf2, 0x10, 0x94, 0x5c, 0x47, 0x22, 0x2c, 0x5b, 0x25, 0xd7, 0xd9, 0x67, 0x64, 0xee, 0x87, 0x3b,
+	0x5f, 0x0c, 0x77, 0x21, 0x0f, 0xf7, 0xbd, 0x3e, 0xb8, 0x3a, 0x3e, 0x16, 0xc0, 0x5d, 0x82, 0x45,
+	0xbf, 0xef, 0x56, 0x9f, 0x0e, 0x0c, 0xb9, 0xcd, 0x97, 0x06, 0x6e, 0xf3, 0x06, 0x2c, 0xf4, 0x92,
+	0x37, 0x9f, 0xf8, 0x59, 0x93, 0x42, 0xc5, 0x16, 0x0b, 0xba, 0xa1, 0x32, 0x7a, 0x4c, 0x08, 0x14,
+	0xbb, 0xae, 0x2f, 0xde, 0x27, 0x12, 0x85, 0xf8, 0xde, 0xff, 0x2b, 0x2f, 0xa7, 0xf6, 0x4f, 0x4a,
+	0xf0, 0x91, 0x21, 0x6a, 0x8f, 0xf5, 0xa7, 0x0f, 0x86, 0xee, 0x89, 0x57, 0x2f, 0x8c, 0xf4, 0xea,
+	0xea, 0x38, 0xaf, 0x5e, 0x2c, 0xb6, 0x17, 0xe4, 0xed, 0xf5, 0xc3, 0x12, 0x2c, 0x0f, 0xb1, 0xd7,
+	0xf8, 0xbb, 0xd5, 0x07, 0xc6, 0x60, 0x3b, 0x01, 0x53, 0x5e, 0x52, 0xb5, 0x62, 0x42, 0x9c, 0xb3,
+	0x80, 0x85, 0x6d, 0xe2, 0x4b, 0xef, 0xa8, 0x5a, 0x8a, 0x9a, 0xd2, 0x54, 0x57, 0xc1, 0xd0, 0xe6,
+	0xb9, 0x6c, 0xc7, 0x41, 0x8a, 0x91, 0x0e, 0xe5, 0x94, 0x45, 0xa3, 0x42, 0x54, 0x8f, 0x78, 0x5d,
+	0xaa, 0x43, 0x94, 0x24, 0xcc, 0x7f, 0x94, 0xfa, 0xd9, 0x58, 0x5d, 0xff, 0x83, 0x6f, 0xe8, 0x13,
+	0x30, 0x4f, 0x24, 0x5a, 0xe5, 0x9a, 0x8a, 0x1a, 0x30, 0x69, 0xb5, 0xd8, 0xa4, 0x8b, 0xf9, 0xcb,
+	0x03, 0x01, 0x83, 0x8d, 0x30, 0xa9, 0x01, 0xf2, 0x5a, 0x76, 0x36, 0x97, 0x9e, 0x46, 0xd9, 0xdf,
+	0x1a, 0xc9, 0x46, 0xdc, 0x2b, 0x4e, 0xe6, 0x97, 0x45, 0x5b, 0x6e, 0xc4, 0x93, 0x84, 0xbe, 0x03,
+	0x0b, 0xb1, 0x2a, 0xf1, 0x85, 0xbc, 0xb6, 0xbe, 0x35, 0xed, 0x35, 0x2d, 0xb7, 0xb7, 0x9a, 0xb9,
+	0xf9, 0x24, 0x9c, 0x1c, 0x1a, 0x8e, 0x15, 0x8c, 0x3a, 0x54, 0xf5, 0xd5, 0x54, 0xed, 0x7e, 0x42,
+	0x9b, 0x6f, 0xcd, 0xe5, 0x73, 0x63, 0xe0, 0x6c, 0x05, 0xad, 0x82, 0x2a, 0x4d, 0xb1, 0xc7, 0x88,
+	0xdd, 0x08, 0x9c, 0x4c, 0x41, 0x46, 0x93, 0x62, 0x9d, 0x1d, 0xf8, 0x9c, 0xb8, 0x3e, 0x65, 0x2a,
+	0x7d, 0xa7, 0x03, 0x62, 0xa7, 0x23, 0xd7, 0xb7, 0xe9, 0x36, 0xb5, 0x03, 0xdf, 0x89, 0xa4, 0xcb,
+	0x94, 0xad, 0xdc, 0x18, 0xbe, 0x01, 0x8b, 0x92, 0xbe, 0xeb, 0x76, 0xe2, 0x7c, 0x55, 0x5b, 0x5f,
+	0x6d, 0xc4, 0x95, 0xd3, 0x46, 0xb6, 0x72, 0x9a, 0xda, 0xb0, 0x43, 0x39, 0x69, 0xf4, 0x2e, 0x35,
+	0xc4, 0x0a, 0x2b, 0x5d, 0x2c, 0xb0, 0x70, 0xe2, 0x7a, 0x5b, 0xae, 0x2f, 0x9f, 0x0b, 0x42, 0x54,
+	0x3a, 0x20, 0xbc, 0x71, 0x27, 0xf0, 0xbc, 0xe0, 0x65, 0x7d, 0xc0, 0x63, 0x4a, 0xac, 0xea, 0xfa,
+	0xdc, 0xf5, 0xa4, 0xfc, 0xd8, 0xd7, 0xd2, 0x01, 0xb9, 0xca, 0xf5, 0x38, 0x65, 0xea, 0x64, 0x2b,
+	0x2a, 0xf1, 0xf7, 0x9a, 0x1c, 0x4d, 0x02, 0x4b, 0x7c, 0x32, 0x0e, 0x64, 0x4f, 0x46, 0xff, 0x69,
+	0x3b, 0x38, 0xa4, 0xa2, 0x25, 0x6b, 0xa3, 0xb4, 0xe7, 0x06, 0x5d, 0x71, 0x13, 0x96, 0x77, 0x24,
+	0x4d, 0x0f, 0x9c, 0x96, 0xc3, 0xc5, 0xa7, 0xe5, 0x48, 0xfe, 0xb4, 0xc8, 0xf7, 0x0c, 0xb7, 0xdb,
+	0x9b, 0x24, 0xa2, 0xc6, 0x51, 0xc9, 0x3a, 0x1d, 0x30, 0x7f, 0x8d, 0xa0, 0xba, 0x15, 0xb4, 0xae,
+	0xf9, 0x9c, 0xed, 0x09, 0x26, 0x62, 0xe7, 0xa8, 0xaf, 0xbd, 0x49, 0x93, 0x62, 0x8b, 0xb8, 0xdb,
+	0xa1, 0xdb, 0x9c, 0x74, 0x42, 0x75, 0x55, 0xdc, 0xd7, 0x16, 0x25, 0x8b, 0x85, 0xd9, 0x3c, 0x12,
+	0x71, 0x19, 0x72, 0xaa, 0x96, 0xfc, 0x16, 0x0a, 0x26, 0x13, 0xb6, 0x39, 0x53, 0xf1, 0x26, 0x37,
+	0x96, 0x75, 0xc0, 0x4a, 0x8c, 0x4d, 0x91, 0x66, 0x07, 0x1e, 0x4c, 0x1e, 0x74, 0x77, 0x29, 0xeb,
+	0xb8, 0xfe, 0x98, 0x27, 0xc0, 0x04, 0x25, 0xdb, 0x82, 0x7a, 0x42, 0x90, 0x3b, 0x92, 0xe2, 0x7d,
+	0x74, 0xcf, 0xf5, 0x9d, 0xe0, 0xe5, 0x82, 0xa3, 0x35, 0x9d, 0xc0, 0x3f, 0xe5, 0xab, 0xae, 0x19,
+	0x89, 0x49, 0x1c, 0xb8, 0x01, 0x07, 0x45, 0xc4, 0xe8, 0x51, 0xf5, 0x83, 0x0a, 0x4a, 0xe6, 0xa8,
+	0x02, 0x58, 0xca, 0xc3, 0xca, 0x2f, 0xc4, 0x5b, 0x70, 0x98, 0x44, 0x91, 0xdb, 0xf2, 0xa9, 0xa3,
+	0x79, 0x95, 0x26, 0xe6, 0xd5, 0xbf, 0x34, 0x2e, 0xa5, 0xc8, 0x19, 0x6a, 0xbf, 0x35, 0x69, 0xfe,
+	0x1b, 0xc1, 0xf1, 0xa1, 0x4c, 0x92, 0x73, 0x85, 0x32, 0x79, 0x44, 0xd4, 0xfc, 0xed, 0x36, 0x75,
+	0xba, 0x9e, 0xce, 0x8b, 0x09, 0x2d, 0x7e, 0x73, 0xba, 0xf1, 0xee, 0xab, 0x3c, 0x96, 0xd0, 0xf8,
+	0x14, 0x40, 0x87, 0xf8, 0x5d, 0xe2, 0x49, 0x08, 0x73, 0x12, 0x42, 0x66, 0x04, 0xdf, 0x07, 0x90,
+	0xd5, 0x2c, 0xe2, 0xb7, 0x68, 0x5c, 0x57, 0xad, 0xad, 0x3f, 0x3f, 0x7d, 0x7d, 0x21, 0xd6, 0xe4,
+	0xaa, 0xe6, 0x6c, 0x65, 0x84, 0x98, 0x4b, 0x50, 0x1f, 0xe6, 0xad, 0xaa, 0x54, 0xf8, 0x4f, 0x04,
+	0x87, 0x74, 0x94, 0x57, 0x0e, 0xb5, 0x02, 0x87, 0x33, 0x82, 0x6e, 0xa7, 0xbe, 0xd5, 0x3f, 0x3c,
+	0x26, 0x82, 0x6b, 0xc7, 0x2c, 0xe7, 0x7b, 0x35, 0xbd, 0x5c, 0xb7, 0x65, 0xe2, 0x1c, 0x8f, 0x66,
+	0x74, 0xf3, 0xfe, 0x32, 0x18, 0xb7, 0x88, 0x4f, 0x5a, 0xd4, 0x49, 0xd4, 0x4e, 0xbc, 0xfa, 0x0b,
+	0xd9, 0x9a, 0xd7, 0xd4, 0x15, 0xa6, 0xe4, 0x92, 0xea, 0xee, 0xec, 0xe8, 0xfa, 0x19, 0x83, 0xea,
+	0x96, 0xeb, 0xef, 0x8a, 0x32, 0x8c, 0xd0, 0x98, 0xbb, 0xdc, 0xd3, 0xd6, 0x8d, 0x09, 0x7c, 0x04,
+	0xca, 0x5d, 0xe6, 0x29, 0xa7, 0x13, 0x9f, 0xa2, 0xf7, 0xe0, 0xd0, 0xc8, 0x66, 0x6e, 0xa8, 0x5c,
+	0x4e, 0xf6, 0x1e, 0x32, 0x43, 0x62, 0x1f, 0x5c, 0x3b, 0xf0, 0x37, 0x3d, 0x12, 0x45, 0x3a, 0x23,
+	0x26, 0x03, 0xe6, 0xd3, 0x70, 0x50, 0xc8, 0x4c, 0xd5, 0x3c, 0x9f, 0x57, 0xf3, 0x78, 0x0e, 0xbe,
+	0x86, 0xa7, 0x11, 0x13, 0x78, 0x40, 0x5c, 0x44, 0x2e, 0x87, 0xa1, 0x62, 0x32, 0xe1, 0x15, 0xb0,
+	0x3c, 0x2c, 0xa1, 0x0f, 0xad, 0x65, 0xac, 0xff, 0xf5, 0x0c, 0xe0, 0xec, 0xd1, 0xa4, 0xac, 0xe7,
+	0xda, 0x14, 0x7f, 0x13, 0xc1, 0x9c, 0x10, 0x8d, 0x1f, 0x1a, 0x15, 0x09, 0xa4, 0xbf, 0xd6, 0x67,
+	0x57, 0x42, 0x10, 0xd2, 0xcc, 0xa5, 0xd7, 0xfe, 0xfc, 0xb7, 0x6f, 0x95, 0x4e, 0xe0, 0x63, 0xb2,
+	0xd1, 0xda, 0xbb, 0x94, 0x6d, 0x7a, 0x46, 0xf8, 0x75, 0x04, 0x58, 0x5d, 0xcc, 0x32, 0xad, 0x28,
+	0x7c, 0x7e, 0x14, 0xc4, 0x21, 0x2d, 0xab, 0xfa, 0x43, 0x99, 0x44, 0xd6, 0xb0, 0x03, 0x46, 0x45,
+	0xda, 0x92, 0x13, 0x24, 0x80, 0x55, 0x09, 0xe0, 0x0c, 0x36, 0x87, 0x01, 0x68, 0xbe, 0x22, 0x2c,
+	0xfa, 0x6a, 0x93, 0xc6, 0x72, 0xdf, 0x44, 0x50, 0xb9, 0x27, 0x5f, 0x5f, 0x63, 0x8c, 0xb4, 0x3d,
+	0x33, 0x23, 0x49, 0x71, 0x12, 0xad, 0x79, 0x5a, 0x22, 0x7d, 0x08, 0x9f, 0xd4, 0x48, 0x23, 0xce,
+	0x28, 0xe9, 0xe4, 0x00, 0x5f, 0x44, 0xf8, 0x6d, 0x04, 0xf3, 0x71, 0x0f, 0x02, 0x9f, 0x1d, 0x85,
+	0x32, 0xd7, 0xa3, 0xa8, 0xcf, 0xae, 0xa0, 0x6f, 0x3e, 0x2a, 0x31, 0x9e, 0x36, 0x87, 0x6e, 0xe7,
+	0x46, 0xae, 0xdc, 0xff, 0x06, 0x82, 0xf2, 0x75, 0x3a, 0xd6, 0xdf, 0x66, 0x08, 0x6e, 0xc0, 0x80,
+	0x43, 0xb6, 0x1a, 0xbf, 0x85, 0xe0, 0xc1, 0xeb, 0x94, 0x0f, 0xcf, 0xc8, 0x78, 0x65, 0x7c, 0x9a,
+	0x54, 0x6e, 0x77, 0x7e, 0x82, 0x99, 0x49, 0x5e, 0x68, 0x4a, 0x64, 0x8f, 0xe2, 0x73, 0x45, 0x4e,
+	0x28, 0xca, 0xb3, 0x2f, 0x2b, 0x1c, 0x7f, 0x40, 0x70, 0xa4, 0xbf, 0xe5, 0x8c, 0xcd, 0xbe, 0x67,
+	0xd1, 0x90, 0x8e, 0x74, 0xfd, 0xf6, 0xb4, 0x51, 0x36, 0xcf, 0xd4, 0xbc, 0x2c, 0x91, 0x3f, 0x85,
+	0x9f, 0x2c, 0x42, 0x9e, 0x14, 0x74, 0x9b, 0xaf, 0xe8, 0xcf, 0x57, 0x9b, 0x1d, 0xc5, 0x02, 0xff,
+	0x11, 0xc1, 0x31, 0xcd, 0x77, 0xb3, 0x4d, 0x18, 0xbf, 0x4a, 0x39, 0x71, 0xbd, 0x68, 0x22, 0x7d,
+	0xa6, 0xcc, 0x1a, 0x59, 0x79, 0xe6, 0x35, 0xa9, 0xcb, 0x27, 0xf0, 0x33, 0xfb, 0xd6, 0xc5, 0x16,
+	0x6c, 0x1c, 0x05, 0xfb, 0x35, 0x04, 0x07, 0xae, 0x53, 0x7e, 0x2b, 0x69, 0x2a, 0x9c, 0x9d, 0xa8,
+	0x51, 0x59, 0x5f, 0x6a, 0x64, 0xfe, 0x95, 0xa1, 0x7f, 0x4a, 0x5c, 0x64, 0x4d, 0x82, 0x3b, 0x87,
+	0xcf, 0x16, 0x81, 0x4b, 0x1b, 0x19, 0x6f, 0x22, 0x38, 0x9e, 0x05, 0x91, 0x36, 0x78, 0x3f, 0xba,
+	0xbf, 0xb6, 0xa9, 0x6a, 0xbe, 0x8e, 0x41, 0xb7, 0x2e, 0xd1, 0x5d, 0x30, 0x87, 0x3b, 0x70, 0x67,
+	0x00, 0xc5, 0x06, 0x5a, 0x5d, 0x41, 0xf8, 0x37, 0x08, 0xe6, 0xe3, 0x32, 0xf6, 0x68, 0x1b, 0xe5,
+	0x1a, 0x92, 0xb3, 0x8c, 0x06, 0x6a, 0xb7, 0xeb, 0x17, 0x87, 0x1b, 0x34, 0xbb, 0x5e, 0xbb, 0x6a,
+	0x43, 0x5a, 0x39, 0x1f, 0xc6, 0x7e, 0x8e, 0x00, 0xd2, 0x52, 0x3c, 0x7e, 0xb4, 0x58, 0x8f, 0x4c,
+	0xb9, 0xbe, 0x3e, 0xdb, 0x62, 0xbc, 0xd9, 0x90, 0xfa, 0xac, 0xd4, 0x97, 0x0b, 0x63, 0x48, 0x48,
+	0xed, 0x8d, 0xb8, 0x6c, 0xff, 0x03, 0x04, 0x15, 0x59, 0x01, 0xc5, 0x67, 0x46, 0x61, 0xce, 0x16,
+	0x48, 0x67, 0x69, 0xfa, 0x47, 0x24, 0xd4, 0xe5, 0x0d, 0xb4, 0xba, 0x5e, 0x18, 0x8b, 0x7b, 0x30,
+	0x1f, 0xd7, 0x1c, 0x47, 0xbb, 0x47, 0xae, 0x26, 0x59, 0x5f, 0x2e, 0xb8, 0x18, 0xc4, 0x8e, 0xaa,
+	0x72, 0xc0, 0xea, 0xb8, 0x1c, 0x30, 0x27, 0x1f, 0x10, 0xa7, 0x8b, 0x82, 0xf8, 0xff, 0xc1, 0x30,
+	0xe7, 0x25, 0xba, 0xb3, 0xe6, 0xf2, 0xb8, 0x3c, 0xb0, 0x81, 0x56, 0xf1, 0x57, 0x11, 0x2c, 0xa8,
+	0x4e, 0x14, 0x7e, 0x64, 0x14, 0xd0, 0x7c, 0xcb, 0xac, 0x7e, 0x6e, 0xec, 0x3c, 0x65, 0x27, 0xe5,
+	0x4d, 0xe6, 0xe9, 0x22, 0x24, 0xed, 0x78, 0x91, 0x00, 0xf3, 0x1d, 0x04, 0x47, 0xfa, 0x6f, 0xfa,
+	0xf8, 0xe4, 0xd0, 0x3a, 0x9d, 0x4a, 0x90, 0xf9, 0x2d, 0x1d, 0xf5, 0x4a, 0x30, 0x3f, 0x29, 0x81,
+	0x6c, 0xe0, 0x27, 0xc6, 0x1e, 0xd3, 0xdb, 0x3a, 0x04, 0x0a, 0x46, 0x6b, 0x69, 0xc7, 0xf7, 0x17,
+	0x08, 0x0e, 0x68, 0xbe, 0x77, 0x19, 0xa5, 0xc5, 0xb0, 0x66, 0x77, 0x2a, 0x85, 0x2c, 0xf3, 0x69,
+	0x09, 0xff, 0x63, 0xf8, 0xf1, 0x09, 0xe1, 0x6b, 0xd8, 0x6b, 0x5c, 0x20, 0xfd, 0x1d, 0x82, 0xa3,
+	0xf7, 0xe2, 0x43, 0xf8, 0x3e, 0xe1, 0xdf, 0x94, 0xf8, 0x9f, 0xc1, 0x4f, 0x15, 0x5c, 0x3a, 0xc7,
+	0xa9, 0x71, 0x11, 0xe1, 0x9f, 0x22, 0xa8, 0xea, 0xe6, 0x18, 0x1e, 0xe9, 0x84, 0x7d, 0xed, 0xb3,
+	0x59, 0x9e, 0x2c, 0x75, 0xc3, 0x32, 0xcf, 0x14, 0xe6, 0x76, 0x25, 0x5f, 0x38, 0xf4, 0x1b, 0x08,
+	0x70, 0xf2, 0x80, 0x4f, 0x9e, 0xf4, 0x7d, 0x07, 0x6d, 0x64, 0x61, 0xaa, 0x7e, 0x6e, 0xec, 0xbc,
+	0x7c, 0x5e, 0x5f, 0x2d, 0xcc, 0xeb, 0x41, 0x22, 0xff, 0x6b, 0x08, 0x6a, 0xd7, 0x69, 0xf2, 0x20,
+	0x2a, 0xb0, 0x65, 0xbe, 0xb7, 0x57, 0x5f, 0x19, 0x3f, 0x51, 0x21, 0xba, 0x20, 0x11, 0x3d, 0x82,
+	0x8b, 0x4d, 0xa5, 0x01, 0x7c, 0x17, 0xc1, 0xc1, 0x3b, 0x59, 0x17, 0xc5, 0x17, 0xc6, 0x49, 0xca,
+	0xa5, 0x95, 0xc9, 0x71, 0x3d, 0x26, 0x71, 0xad, 0x99, 0x13, 0xe1, 0xda, 0x50, 0x6d, 0xb2, 0xef,
+	0xa1, 0xf8, 0x45, 0xdd, 0x57, 0xed, 0xff, 0x5f, 0xed, 0x56, 0xd0, 0x34, 0x30, 0x1f, 0x97, 0xf8,
+	0x1a, 0xf8, 0xc2, 0x24, 0xf8, 0x9a, 0xaa, 0x05, 0x80, 0xbf, 0x8d, 0xe0, 0xa8, 0x6c, 0xf6, 0x64,
+	0x19, 0xe3, 0xa2, 0x0e, 0x47, 0xda, 0x1a, 0x9a, 0x20, 0xdf, 0x7d, 0x5c, 0x82, 0xba, 0x64, 0xee,
+	0x0b, 0x94, 0xf0, 0xff, 0xaf, 0x23, 0x38, 0xa4, 0x93, 0xab, 0xda, 0xd8, 0xb5, 0x71, 0x36, 0xdb,
+	0x6f, 0x32, 0x56, 0x9e, 0xb6, 0x3a, 0x99, 0xa7, 0xbd, 0x8d, 0x60, 0x41, 0xb5, 0x39, 0x0a, 0xae,
+	0x2c, 0x99, 0x3e, 0x48, 0xbd, 0xaf, 0xd6, 0xa2, 0xea, 0xe0, 0xe6, 0xe7, 0xa4, 0xd8, 0x17, 0x70,
+	0xb3, 0x48, 0x6c, 0x18, 0x38, 0x51, 0xf3, 0x15, 0x55, 0x84, 0x7e, 0xb5, 0xe9, 0x05, 0xad, 0xe8,
+	0x45, 0x13, 0x17, 0x26, 0x66, 0x31, 0xe7, 0x22, 0xc2, 0x1c, 0x16, 0x85, 0x5f, 0xc8, 0x02, 0x0e,
+	0xce, 0x1b, 0x61, 0x48, 0x6d, 0xa7, 0x5e, 0x1f, 0x28, 0x08, 0xa5, 0xc9, 0x4f, 0x3d, 0xa7, 0xf1,
+	0xc3, 0x85, 0x62, 0xa5, 0xa0, 0xd7, 0x11, 0x1c, 0xcd, 0x3a, 0x7a, 0x2c, 0x7e, 0x62, 0x37, 0x2f,
+	0x42, 0xa1, 0x2e, 0xf7, 0x78, 0x75, 0x22, 0x1f, 0x92, 0x70, 0xae, 0x3c, 0xfb, 0xfb, 0x77, 0x4f,
+	0xa1, 0x77, 0xde, 0x3d, 0x85, 0xfe, 0xf2, 0xee, 0x29, 0xf4, 0xe2, 0x13, 0x93, 0xfd, 0xe5, 0xdd,
+	0xf6, 0x5c, 0xea, 0xf3, 0x2c, 0xfb, 0xff, 0x0e, 0x00, 0x49, 0x46, 0x21, 0xed, 0xd8, 0x2f, 0x00,
 	0x00,
 }
 
@@ -3135,6 +3302,8 @@ type ApplicationServiceClient interface {
 	Delete(ctx context.Context, in *ApplicationDeleteRequest, opts ...grpc.CallOption) (*ApplicationResponse, error)
 	// Sync syncs an application to its target state
 	Sync(ctx context.Context, in *ApplicationSyncRequest, opts ...grpc.CallOption) (*v1alpha1.Application, error)
+	// Hydrate hydrates the manifests of an application using the source hydrator
+	Hydrate(ctx context.Context, in *ApplicationHydrateRequest, opts ...grpc.CallOption) (*ApplicationHydrateResponse, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
 	// ResourceTree returns resource tree
@@ -3354,6 +3523,15 @@ func (c *applicationServiceClient) Sync(ctx context.Context, in *ApplicationSync
 	return out, nil
 }
 
+func (c *applicationServiceClient) Hydrate(ctx context.Context, in *ApplicationHydrateRequest, opts ...grpc.CallOption) (*ApplicationHydrateResponse, error) {
+	out := new(ApplicationHydrateResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/Hydrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error) {
 	out := new(ManagedResourcesResponse)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/ManagedResources", in, out, opts...)
@@ -3549,6 +3727,8 @@ type ApplicationServiceServer interface {
 	Delete(context.Context, *ApplicationDeleteRequest) (*ApplicationResponse, error)
 	// Sync syncs an application to its target state
 	Sync(context.Context, *ApplicationSyncRequest) (*v1alpha1.Application, error)
+	// Hydrate hydrates the manifests of an application using the source hydrator
+	Hydrate(context.Context, *ApplicationHydrateRequest) (*ApplicationHydrateResponse, error)
 	// ManagedResources returns list of managed resources
 	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
 	// ResourceTree returns resource tree
@@ -3626,6 +3806,9 @@ func (*UnimplementedApplicationServiceServer) Delete(ctx context.Context, req *A
 func (*UnimplementedApplicationServiceServer) Sync(ctx context.Context, req *ApplicationSyncRequest) (*v1alpha1.Application, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
 }
+func (*UnimplementedApplicationServiceServer) Hydrate(ctx context.Context, req *ApplicationHydrateRequest) (*ApplicationHydrateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hydrate not implemented")
+}
 func (*UnimplementedApplicationServiceServer) ManagedResources(ctx context.Context, req *ResourcesQuery) (*ManagedResourcesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ManagedResources not implemented")
 }
@@ -3951,6 +4134,24 @@ func _ApplicationService_Sync_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_Hydrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationHydrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).Hydrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/Hydrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).Hydrate(ctx, req.(*ApplicationHydrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_ManagedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourcesQuery)
 	if err := dec(in); err != nil {
@@ -4247,6 +4448,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Sync",
 			Handler:    _ApplicationService_Sync_Handler,
 		},
+		{
+			MethodName: "Hydrate",
+			Handler:    _ApplicationService_Hydrate_Handler,
+		},
 		{
 			MethodName: "ManagedResources",
 			Handler:    _ApplicationService_ManagedResources_Handler,
@@ -5246,7 +5451,7 @@ func (m *ApplicationSyncRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationHydrateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5256,12 +5461,12 @@ func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationHydrateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationHydrateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5275,38 +5480,24 @@ func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 	}
-	if m.Validate != nil {
+	if m.DryRun != nil {
 		i--
-		if *m.Validate {
+		if *m.DryRun {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
-	}
-	if m.Spec == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("spec")
-	} else {
-		{
-			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplication(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.Name == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
@@ -5320,7 +5511,7 @@ func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPatchRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationHydrateResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5330,12 +5521,12 @@ func (m *ApplicationPatchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationPatchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationHydrateResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationPatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationHydrateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5344,51 +5535,40 @@ func (m *ApplicationPatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Project != nil {
-		i -= len(*m.Project)
-		copy(dAtA[i:], *m.Project)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.AppNamespace != nil {
-		i -= len(*m.AppNamespace)
-		copy(dAtA[i:], *m.AppNamespace)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+	if m.Diff != nil {
+		i -= len(*m.Diff)
+		copy(dAtA[i:], *m.Diff)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Diff)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 	}
-	if m.PatchType == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
-	} else {
-		i -= len(*m.PatchType)
-		copy(dAtA[i:], *m.PatchType)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PatchType)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarintApplication(dAtA, i, uint64(len(m.Paths[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Patch == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
-	} else {
-		i -= len(*m.Patch)
-		copy(dAtA[i:], *m.Patch)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Patch)))
+	if m.TargetBranch != nil {
+		i -= len(*m.TargetBranch)
+		copy(dAtA[i:], *m.TargetBranch)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.TargetBranch)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Name == nil {
-		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	} else {
-		i -= len(*m.Name)
-		copy(dAtA[i:], *m.Name)
-		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+	if m.DrySHA != nil {
+		i -= len(*m.DrySHA)
+		copy(dAtA[i:], *m.DrySHA)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.DrySHA)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationRollbackRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationUpdateSpecRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5398,12 +5578,12 @@ func (m *ApplicationRollbackRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ApplicationRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationUpdateSpecRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationUpdateSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5417,28 +5597,170 @@ func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		copy(dAtA[i:], *m.Project)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x2a
 	}
 	if m.AppNamespace != nil {
 		i -= len(*m.AppNamespace)
 		copy(dAtA[i:], *m.AppNamespace)
 		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
 		i--
-		dAtA[i] = 0x32
-	}
-	if m.Prune != nil {
-		i--
-		if *m.Prune {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if m.DryRun != nil {
+	if m.Validate != nil {
 		i--
-		if *m.DryRun {
+		if *m.Validate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Spec == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("spec")
+	} else {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PatchType == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patchType")
+	} else {
+		i -= len(*m.PatchType)
+		copy(dAtA[i:], *m.PatchType)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.PatchType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Patch == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("patch")
+	} else {
+		i -= len(*m.Patch)
+		copy(dAtA[i:], *m.Patch)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Patch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationRollbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationRollbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationRollbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Prune != nil {
+		i--
+		if *m.Prune {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DryRun != nil {
+		i--
+		if *m.DryRun {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -6445,6 +6767,20 @@ func (m *ApplicationSyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DateRanges) > 0 {
+		for iNdEx := len(m.DateRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DateRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.ManualSync == nil {
 		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("manualSync")
 	} else {
@@ -7242,6 +7578,63 @@ func (m *ApplicationSyncRequest) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationHydrateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.DryRun != nil {
+		n += 2
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationHydrateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrySHA != nil {
+		l = len(*m.DrySHA)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.TargetBranch != nil {
+		l = len(*m.TargetBranch)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	if m.Diff != nil {
+		l = len(*m.Diff)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ApplicationUpdateSpecRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7794,6 +8187,12 @@ func (m *ApplicationSyncWindow) Size() (n int) {
 	if m.ManualSync != nil {
 		n += 2
 	}
+	if len(m.DateRanges) > 0 {
+		for _, e := range m.DateRanges {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10401,7 +10800,408 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infos = append(m.Infos, &v1alpha1.Info{})
+			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &v1alpha1.RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncOptions == nil {
+				m.SyncOptions = &SyncOptions{}
+			}
+			if err := m.SyncOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 14:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SourcePositions = append(m.SourcePositions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApplication
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthApplication
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SourcePositions) == 0 {
+					m.SourcePositions = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplication
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SourcePositions = append(m.SourcePositions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePositions", wireType)
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationHydrateRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationHydrateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationHydrateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10411,29 +11211,18 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 9:
+			b := bool(v != 0)
+			m.DryRun = &b
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10443,31 +11232,30 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Infos = append(m.Infos, &v1alpha1.Info{})
-			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10477,33 +11265,84 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &v1alpha1.RetryStrategy{}
-			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationHydrateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationHydrateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationHydrateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -10513,31 +11352,28 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncOptions == nil {
-				m.SyncOptions = &SyncOptions{}
-			}
-			if err := m.SyncOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.DrySHA = &s
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10566,11 +11402,11 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.AppNamespace = &s
+			m.TargetBranch = &s
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10598,88 +11434,11 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Project = &s
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 14:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.SourcePositions = append(m.SourcePositions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthApplication
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthApplication
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.SourcePositions) == 0 {
-					m.SourcePositions = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplication
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.SourcePositions = append(m.SourcePositions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourcePositions", wireType)
-			}
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10707,7 +11466,8 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.Diff = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10725,9 +11485,6 @@ func (m *ApplicationSyncRequest) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
-	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
-	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -14610,6 +15367,40 @@ func (m *ApplicationSyncWindow) Unmarshal(dAtA []byte) error {
 			b := bool(v != 0)
 			m.ManualSync = &b
 			hasFields[0] |= uint64(0x00000008)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateRanges = append(m.DateRanges, &v1alpha1.SyncWindowDateRange{})
+			if err := m.DateRanges[len(m.DateRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
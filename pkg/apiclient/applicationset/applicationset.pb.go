@@ -483,6 +483,385 @@ func (m *ApplicationSetGenerateResponse) GetApplications() []*v1alpha1.Applicati
 	return nil
 }
 
+// ApplicationSetDiffRequest is a request to compare the Applications generated by an applicationset with the live ones
+type ApplicationSetDiffRequest struct {
+	// the applicationset
+	ApplicationSet       *v1alpha1.ApplicationSet `protobuf:"bytes,1,opt,name=applicationSet,proto3" json:"applicationSet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ApplicationSetDiffRequest) Reset()         { *m = ApplicationSetDiffRequest{} }
+func (m *ApplicationSetDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetDiffRequest) ProtoMessage()    {}
+func (*ApplicationSetDiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{8}
+}
+func (m *ApplicationSetDiffRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetDiffRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetDiffRequest.Merge(m, src)
+}
+func (m *ApplicationSetDiffRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetDiffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetDiffRequest proto.InternalMessageInfo
+
+func (m *ApplicationSetDiffRequest) GetApplicationSet() *v1alpha1.ApplicationSet {
+	if m != nil {
+		return m.ApplicationSet
+	}
+	return nil
+}
+
+// ApplicationSetFieldDiff is a difference of a field between the live and the generated Application
+type ApplicationSetFieldDiff struct {
+	// the path of the field, e.g. spec.source.targetRevision
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// the JSON value of the field in the live Application, empty if it is not set
+	LiveValue string `protobuf:"bytes,2,opt,name=liveValue,proto3" json:"liveValue,omitempty"`
+	// the JSON value of the field in the generated Application, empty if it is not set
+	TargetValue          string   `protobuf:"bytes,3,opt,name=targetValue,proto3" json:"targetValue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetFieldDiff) Reset()         { *m = ApplicationSetFieldDiff{} }
+func (m *ApplicationSetFieldDiff) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetFieldDiff) ProtoMessage()    {}
+func (*ApplicationSetFieldDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{9}
+}
+func (m *ApplicationSetFieldDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetFieldDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetFieldDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetFieldDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetFieldDiff.Merge(m, src)
+}
+func (m *ApplicationSetFieldDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetFieldDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetFieldDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetFieldDiff proto.InternalMessageInfo
+
+func (m *ApplicationSetFieldDiff) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ApplicationSetFieldDiff) GetLiveValue() string {
+	if m != nil {
+		return m.LiveValue
+	}
+	return ""
+}
+
+func (m *ApplicationSetFieldDiff) GetTargetValue() string {
+	if m != nil {
+		return m.TargetValue
+	}
+	return ""
+}
+
+// ApplicationSetApplicationDiff is the change the ApplicationSet controller would make to an Application
+type ApplicationSetApplicationDiff struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// the action of the ApplicationSet controller: create, update, delete, unchanged or conflict
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	// the differences of the fields managed by the ApplicationSet controller
+	Fields []*ApplicationSetFieldDiff `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	// the JSON of the live Application, empty if it does not exist
+	LiveState string `protobuf:"bytes,5,opt,name=liveState,proto3" json:"liveState,omitempty"`
+	// the JSON of the generated Application, empty if it is deleted
+	TargetState          string   `protobuf:"bytes,6,opt,name=targetState,proto3" json:"targetState,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetApplicationDiff) Reset()         { *m = ApplicationSetApplicationDiff{} }
+func (m *ApplicationSetApplicationDiff) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetApplicationDiff) ProtoMessage()    {}
+func (*ApplicationSetApplicationDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{10}
+}
+func (m *ApplicationSetApplicationDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetApplicationDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetApplicationDiff.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetApplicationDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetApplicationDiff.Merge(m, src)
+}
+func (m *ApplicationSetApplicationDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetApplicationDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetApplicationDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetApplicationDiff proto.InternalMessageInfo
+
+func (m *ApplicationSetApplicationDiff) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSetApplicationDiff) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ApplicationSetApplicationDiff) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ApplicationSetApplicationDiff) GetFields() []*ApplicationSetFieldDiff {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+func (m *ApplicationSetApplicationDiff) GetLiveState() string {
+	if m != nil {
+		return m.LiveState
+	}
+	return ""
+}
+
+func (m *ApplicationSetApplicationDiff) GetTargetState() string {
+	if m != nil {
+		return m.TargetState
+	}
+	return ""
+}
+
+// ApplicationSetDiffResponse is a response for applicationset diff request
+type ApplicationSetDiffResponse struct {
+	Applications         []*ApplicationSetApplicationDiff `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ApplicationSetDiffResponse) Reset()         { *m = ApplicationSetDiffResponse{} }
+func (m *ApplicationSetDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetDiffResponse) ProtoMessage()    {}
+func (*ApplicationSetDiffResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{11}
+}
+func (m *ApplicationSetDiffResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetDiffResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetDiffResponse.Merge(m, src)
+}
+func (m *ApplicationSetDiffResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetDiffResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetDiffResponse proto.InternalMessageInfo
+
+func (m *ApplicationSetDiffResponse) GetApplications() []*ApplicationSetApplicationDiff {
+	if m != nil {
+		return m.Applications
+	}
+	return nil
+}
+
+// ApplicationSetPromoteRequest is a request to promote a step of the RollingSync strategy of an applicationset
+type ApplicationSetPromoteRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The application set namespace. Default empty is argocd control plane namespace
+	AppsetNamespace string `protobuf:"bytes,2,opt,name=appsetNamespace,proto3" json:"appsetNamespace,omitempty"`
+	// the number of the step to promote, starting at 1. Default 0 is the first step awaiting approval, paused or degraded
+	Step                 int32    `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetPromoteRequest) Reset()         { *m = ApplicationSetPromoteRequest{} }
+func (m *ApplicationSetPromoteRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetPromoteRequest) ProtoMessage()    {}
+func (*ApplicationSetPromoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{12}
+}
+func (m *ApplicationSetPromoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetPromoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetPromoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetPromoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetPromoteRequest.Merge(m, src)
+}
+func (m *ApplicationSetPromoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetPromoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetPromoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetPromoteRequest proto.InternalMessageInfo
+
+func (m *ApplicationSetPromoteRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSetPromoteRequest) GetAppsetNamespace() string {
+	if m != nil {
+		return m.AppsetNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationSetPromoteRequest) GetStep() int32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+// ApplicationSetApproveDeletionRequest is a request to approve the deletions of Applications exceeding the maxDeletions of an applicationset
+type ApplicationSetApproveDeletionRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The application set namespace. Default empty is argocd control plane namespace
+	AppsetNamespace      string   `protobuf:"bytes,2,opt,name=appsetNamespace,proto3" json:"appsetNamespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetApproveDeletionRequest) Reset()         { *m = ApplicationSetApproveDeletionRequest{} }
+func (m *ApplicationSetApproveDeletionRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetApproveDeletionRequest) ProtoMessage()    {}
+func (*ApplicationSetApproveDeletionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{13}
+}
+func (m *ApplicationSetApproveDeletionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetApproveDeletionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetApproveDeletionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetApproveDeletionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetApproveDeletionRequest.Merge(m, src)
+}
+func (m *ApplicationSetApproveDeletionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetApproveDeletionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetApproveDeletionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetApproveDeletionRequest proto.InternalMessageInfo
+
+func (m *ApplicationSetApproveDeletionRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSetApproveDeletionRequest) GetAppsetNamespace() string {
+	if m != nil {
+		return m.AppsetNamespace
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ApplicationSetGetQuery)(nil), "applicationset.ApplicationSetGetQuery")
 	proto.RegisterType((*ApplicationSetListQuery)(nil), "applicationset.ApplicationSetListQuery")
@@ -492,6 +871,12 @@ func init() {
 	proto.RegisterType((*ApplicationSetTreeQuery)(nil), "applicationset.ApplicationSetTreeQuery")
 	proto.RegisterType((*ApplicationSetGenerateRequest)(nil), "applicationset.ApplicationSetGenerateRequest")
 	proto.RegisterType((*ApplicationSetGenerateResponse)(nil), "applicationset.ApplicationSetGenerateResponse")
+	proto.RegisterType((*ApplicationSetDiffRequest)(nil), "applicationset.ApplicationSetDiffRequest")
+	proto.RegisterType((*ApplicationSetFieldDiff)(nil), "applicationset.ApplicationSetFieldDiff")
+	proto.RegisterType((*ApplicationSetApplicationDiff)(nil), "applicationset.ApplicationSetApplicationDiff")
+	proto.RegisterType((*ApplicationSetDiffResponse)(nil), "applicationset.ApplicationSetDiffResponse")
+	proto.RegisterType((*ApplicationSetPromoteRequest)(nil), "applicationset.ApplicationSetPromoteRequest")
+	proto.RegisterType((*ApplicationSetApproveDeletionRequest)(nil), "applicationset.ApplicationSetApproveDeletionRequest")
 }
 
 func init() {
@@ -499,49 +884,66 @@ func init() {
 }
 
 var fileDescriptor_eacb9df0ce5738fa = []byte{
-	// 664 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcf, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x99, 0xb6, 0x6c, 0xb7, 0xd3, 0xa2, 0x30, 0x60, 0xbb, 0x46, 0x5d, 0x97, 0x80, 0xb5,
-	0xb6, 0x76, 0x42, 0x5b, 0x4f, 0xf5, 0xe4, 0x0f, 0x28, 0x85, 0x22, 0x9a, 0x15, 0x05, 0x3d, 0xc8,
-	0x34, 0xfb, 0x48, 0x63, 0xb3, 0xc9, 0x38, 0x33, 0x09, 0x94, 0xe2, 0x45, 0xf0, 0xec, 0x41, 0xf4,
-	0x0f, 0xd0, 0x8b, 0x7f, 0x80, 0x77, 0x0f, 0x5e, 0x3c, 0x0a, 0xfe, 0x03, 0x52, 0xfc, 0x33, 0x3c,
-	0x48, 0x26, 0xd9, 0x6d, 0x33, 0xec, 0x8f, 0x82, 0xd1, 0x5b, 0x5e, 0x66, 0xf2, 0xde, 0x67, 0xbe,
-	0xef, 0xe5, 0xcb, 0xe0, 0x65, 0x09, 0x22, 0x05, 0xe1, 0x30, 0xce, 0xc3, 0xc0, 0x63, 0x2a, 0x88,
-	0x23, 0x09, 0xca, 0x08, 0x29, 0x17, 0xb1, 0x8a, 0xc9, 0x99, 0xf2, 0x5b, 0xeb, 0xa2, 0x1f, 0xc7,
-	0x7e, 0x08, 0x0e, 0xe3, 0x81, 0xc3, 0xa2, 0x28, 0x56, 0xf9, 0x4a, 0xbe, 0xdb, 0xda, 0xf1, 0x03,
-	0xb5, 0x97, 0xec, 0x52, 0x2f, 0xee, 0x3a, 0x4c, 0xf8, 0x31, 0x17, 0xf1, 0x73, 0xfd, 0xb0, 0xea,
-	0x75, 0x9c, 0x74, 0xc3, 0xe1, 0xfb, 0x7e, 0xf6, 0xa5, 0x3c, 0x59, 0xcb, 0x49, 0xd7, 0x58, 0xc8,
-	0xf7, 0xd8, 0x9a, 0xe3, 0x43, 0x04, 0x82, 0x29, 0xe8, 0xe4, 0xd9, 0xec, 0x47, 0x78, 0xfe, 0xd6,
-	0xf1, 0xbe, 0x36, 0xa8, 0x2d, 0x50, 0x0f, 0x12, 0x10, 0x07, 0x84, 0xe0, 0xa9, 0x88, 0x75, 0xa1,
-	0x81, 0x5a, 0x68, 0x69, 0xc6, 0xd5, 0xcf, 0x64, 0x09, 0x9f, 0x65, 0x9c, 0x4b, 0x50, 0xf7, 0x58,
-	0x17, 0x24, 0x67, 0x1e, 0x34, 0x26, 0xf4, 0xb2, 0xf9, 0xda, 0x3e, 0xc4, 0x0b, 0xe5, 0xbc, 0x3b,
-	0x81, 0x2c, 0x12, 0x5b, 0xb8, 0x9e, 0x31, 0x83, 0xa7, 0x64, 0x03, 0xb5, 0x26, 0x97, 0x66, 0xdc,
-	0x7e, 0x9c, 0xad, 0x49, 0x08, 0xc1, 0x53, 0xb1, 0x28, 0x32, 0xf7, 0xe3, 0x41, 0xc5, 0x27, 0x07,
-	0x17, 0xff, 0x84, 0xcc, 0x53, 0xb9, 0x20, 0x79, 0x26, 0x2e, 0x69, 0xe0, 0xe9, 0xa2, 0x58, 0x71,
-	0xb0, 0x5e, 0x48, 0x14, 0x36, 0xfa, 0xa0, 0x01, 0x66, 0xd7, 0x77, 0xe8, 0xb1, 0xe0, 0xb4, 0x27,
-	0xb8, 0x7e, 0x78, 0xe6, 0x75, 0x68, 0xba, 0x41, 0xf9, 0xbe, 0x4f, 0x33, 0xc1, 0xe9, 0x89, 0xcf,
-	0x69, 0x4f, 0x70, 0x6a, 0x70, 0x18, 0x35, 0xec, 0xaf, 0x08, 0x5f, 0x28, 0x6f, 0xb9, 0x23, 0x80,
-	0x29, 0x70, 0xe1, 0x45, 0x02, 0x72, 0x10, 0x15, 0xfa, 0xf7, 0x54, 0x64, 0x1e, 0xd7, 0x12, 0x2e,
-	0x41, 0xe4, 0x1a, 0xd4, 0xdd, 0x22, 0xca, 0xde, 0x77, 0xc4, 0x81, 0x9b, 0x44, 0x5a, 0xf9, 0xba,
-	0x5b, 0x44, 0xf6, 0x53, 0xf3, 0x10, 0x77, 0x21, 0x84, 0xe3, 0x43, 0xfc, 0xdd, 0x28, 0x3d, 0x36,
-	0x47, 0xe9, 0xa1, 0x00, 0xa8, 0x62, 0x46, 0xdf, 0x21, 0x7c, 0xc9, 0x1c, 0xfe, 0xfc, 0xef, 0x18,
-	0xac, 0x7e, 0xfb, 0x3f, 0xa8, 0xdf, 0x06, 0x65, 0xbf, 0x41, 0xb8, 0x39, 0x8c, 0xab, 0x18, 0xe3,
-	0x2e, 0x9e, 0x3b, 0xd9, 0x32, 0xfd, 0x1f, 0xcd, 0xae, 0x6f, 0x57, 0x86, 0xe5, 0x96, 0xd2, 0xaf,
-	0xff, 0x9e, 0xc6, 0xe7, 0xca, 0x44, 0x6d, 0x10, 0x69, 0xe0, 0x01, 0xf9, 0x88, 0xf0, 0xe4, 0x16,
-	0x28, 0xb2, 0x48, 0x0d, 0x6b, 0x1b, 0xec, 0x2a, 0x56, 0xa5, 0xca, 0xd9, 0x8b, 0xaf, 0x7e, 0xfc,
-	0x7a, 0x3b, 0xd1, 0x22, 0x4d, 0xed, 0x95, 0xe9, 0x9a, 0xe1, 0xaf, 0xd2, 0x39, 0xcc, 0x46, 0xe2,
-	0x25, 0x79, 0x8f, 0x70, 0xbd, 0xa7, 0x21, 0x59, 0x1d, 0x87, 0x5a, 0x9a, 0x01, 0x8b, 0x9e, 0x76,
-	0x7b, 0xde, 0x1a, 0x7b, 0x45, 0x33, 0x5d, 0xb1, 0x5b, 0xc3, 0x98, 0x7a, 0x16, 0xbc, 0x89, 0x96,
-	0xc9, 0x07, 0x84, 0xa7, 0x32, 0x67, 0x24, 0x57, 0x47, 0x57, 0xe9, 0xbb, 0xa7, 0x75, 0xbf, 0x4a,
-	0x01, 0xb3, 0xb4, 0xf6, 0x65, 0x0d, 0x7c, 0x9e, 0x2c, 0x0c, 0x01, 0x26, 0x9f, 0x11, 0xae, 0xe5,
-	0xae, 0x44, 0x56, 0x46, 0x63, 0x96, 0xbc, 0xab, 0xe2, 0x5e, 0x3b, 0x1a, 0xf3, 0x9a, 0x3d, 0x0c,
-	0x73, 0xd3, 0x34, 0xb1, 0xd7, 0x08, 0xd7, 0x72, 0x1f, 0x1a, 0x87, 0x5d, 0x72, 0x2b, 0x6b, 0xcc,
-	0x28, 0xf7, 0x1b, 0x5d, 0x0c, 0xdf, 0xf2, 0xb8, 0xe1, 0xfb, 0x82, 0xf0, 0x9c, 0x0b, 0x32, 0x4e,
-	0x84, 0x07, 0x99, 0x75, 0x8d, 0xeb, 0x75, 0xdf, 0xde, 0xaa, 0xed, 0x75, 0x96, 0xd6, 0xbe, 0xa1,
-	0x99, 0x29, 0xb9, 0x3e, 0x9a, 0xd9, 0x11, 0x05, 0xef, 0xaa, 0x12, 0x00, 0xb7, 0xb7, 0xbf, 0x1d,
-	0x35, 0xd1, 0xf7, 0xa3, 0x26, 0xfa, 0x79, 0xd4, 0x44, 0x4f, 0x6e, 0x9e, 0xee, 0x02, 0xe2, 0x85,
-	0x01, 0x44, 0xe6, 0x8d, 0x67, 0xb7, 0xa6, 0xaf, 0x1d, 0x1b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xc5, 0x6a, 0x1d, 0x1e, 0x20, 0x09, 0x00, 0x00,
+	// 942 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xd7, 0x34, 0xa9, 0x9b, 0x4c, 0x22, 0x2a, 0x8d, 0x44, 0xeb, 0x2e, 0xc6, 0x98, 0x11, 0xa4,
+	0x69, 0x52, 0xef, 0xca, 0x49, 0x4e, 0xe1, 0x80, 0xf8, 0x23, 0xaa, 0x4a, 0x11, 0x6a, 0xd7, 0xa8,
+	0x48, 0x70, 0x40, 0xd3, 0xf5, 0xb3, 0xb3, 0x74, 0xbd, 0x3b, 0xcc, 0x8e, 0x57, 0xaa, 0x22, 0x2e,
+	0x48, 0x70, 0x45, 0x88, 0x3f, 0x1f, 0x00, 0x2e, 0x7c, 0x00, 0x0e, 0xdc, 0x38, 0x70, 0xe1, 0x08,
+	0xe2, 0x0b, 0xa0, 0x88, 0x13, 0x9f, 0x02, 0xcd, 0xec, 0xac, 0xed, 0x9d, 0xd8, 0xde, 0x48, 0x2c,
+	0x70, 0x9b, 0xbf, 0xef, 0xfd, 0x7e, 0x6f, 0x7e, 0xef, 0xed, 0x5b, 0xbc, 0x97, 0x82, 0xc8, 0x40,
+	0x78, 0x8c, 0xf3, 0x28, 0x0c, 0x98, 0x0c, 0x93, 0x38, 0x05, 0x69, 0x4d, 0x5d, 0x2e, 0x12, 0x99,
+	0x90, 0x67, 0xca, 0xab, 0x4e, 0x6b, 0x94, 0x24, 0xa3, 0x08, 0x3c, 0xc6, 0x43, 0x8f, 0xc5, 0x71,
+	0x22, 0xf3, 0x9d, 0xfc, 0xb4, 0x73, 0x32, 0x0a, 0xe5, 0xe9, 0xe4, 0xb1, 0x1b, 0x24, 0x63, 0x8f,
+	0x89, 0x51, 0xc2, 0x45, 0xf2, 0xa1, 0x1e, 0x74, 0x83, 0x81, 0x97, 0x1d, 0x7a, 0xfc, 0xc9, 0x48,
+	0xdd, 0x4c, 0xe7, 0x7d, 0x79, 0x59, 0x8f, 0x45, 0xfc, 0x94, 0xf5, 0xbc, 0x11, 0xc4, 0x20, 0x98,
+	0x84, 0x41, 0x6e, 0x8d, 0x3e, 0xc2, 0x37, 0x5e, 0x9b, 0x9d, 0xeb, 0x83, 0xbc, 0x07, 0xf2, 0xe1,
+	0x04, 0xc4, 0x53, 0x42, 0xf0, 0x7a, 0xcc, 0xc6, 0xd0, 0x44, 0x1d, 0xb4, 0xbb, 0xe9, 0xeb, 0x31,
+	0xd9, 0xc5, 0xd7, 0x19, 0xe7, 0x29, 0xc8, 0xb7, 0xd9, 0x18, 0x52, 0xce, 0x02, 0x68, 0x5e, 0xd1,
+	0xdb, 0xf6, 0x32, 0x3d, 0xc3, 0x37, 0xcb, 0x76, 0x4f, 0xc2, 0xd4, 0x18, 0x76, 0xf0, 0x86, 0xc2,
+	0x0c, 0x81, 0x4c, 0x9b, 0xa8, 0xb3, 0xb6, 0xbb, 0xe9, 0x4f, 0xe7, 0x6a, 0x2f, 0x85, 0x08, 0x02,
+	0x99, 0x08, 0x63, 0x79, 0x3a, 0x5f, 0xe4, 0x7c, 0x6d, 0xb1, 0xf3, 0xef, 0x91, 0xcd, 0xca, 0x87,
+	0x94, 0xab, 0xe0, 0x92, 0x26, 0xbe, 0x66, 0x9c, 0x19, 0x62, 0xc5, 0x94, 0x48, 0x6c, 0xbd, 0x83,
+	0x06, 0xb0, 0x75, 0x70, 0xe2, 0xce, 0x02, 0xee, 0x16, 0x01, 0xd7, 0x83, 0x0f, 0x82, 0x81, 0x9b,
+	0x1d, 0xba, 0xfc, 0xc9, 0xc8, 0x55, 0x01, 0x77, 0xe7, 0xae, 0xbb, 0x45, 0xc0, 0x5d, 0x0b, 0x87,
+	0xe5, 0x83, 0xfe, 0x8c, 0xf0, 0x73, 0xe5, 0x23, 0x6f, 0x08, 0x60, 0x12, 0x7c, 0xf8, 0x68, 0x02,
+	0xe9, 0x22, 0x54, 0xe8, 0xdf, 0x47, 0x45, 0x6e, 0xe0, 0xc6, 0x84, 0xa7, 0x20, 0xf2, 0x18, 0x6c,
+	0xf8, 0x66, 0xa6, 0xd6, 0x07, 0xe2, 0xa9, 0x3f, 0x89, 0x75, 0xe4, 0x37, 0x7c, 0x33, 0xa3, 0xef,
+	0xdb, 0x24, 0xde, 0x84, 0x08, 0x66, 0x24, 0xfe, 0x99, 0x94, 0xde, 0xb5, 0xa5, 0xf4, 0x8e, 0x00,
+	0xa8, 0x43, 0xa3, 0x5f, 0x23, 0xfc, 0xbc, 0x2d, 0xfe, 0x3c, 0x3b, 0x16, 0x47, 0xbf, 0xff, 0x1f,
+	0x44, 0xbf, 0x0f, 0x92, 0x7e, 0x8e, 0x70, 0x7b, 0x19, 0x2e, 0x23, 0xe3, 0x31, 0xde, 0x9e, 0x7f,
+	0x32, 0x9d, 0x47, 0x5b, 0x07, 0xf7, 0x6b, 0x83, 0xe5, 0x97, 0xcc, 0xd3, 0x2f, 0x10, 0xbe, 0x65,
+	0x3d, 0x70, 0x38, 0x1c, 0xfe, 0xbf, 0x51, 0x1a, 0xdb, 0xb2, 0x78, 0x2b, 0x84, 0x68, 0xa0, 0x70,
+	0x29, 0x59, 0x70, 0x26, 0x4f, 0x0b, 0x59, 0xa8, 0x31, 0x69, 0xe1, 0xcd, 0x28, 0xcc, 0xe0, 0x11,
+	0x8b, 0x26, 0x85, 0x20, 0x66, 0x0b, 0xa4, 0x83, 0xb7, 0x24, 0x13, 0x23, 0x90, 0xf9, 0x7e, 0x5e,
+	0x57, 0xe6, 0x97, 0xe8, 0x5f, 0x17, 0xc4, 0x32, 0x37, 0x2b, 0xbc, 0x5e, 0x10, 0x63, 0x0b, 0x6f,
+	0xc6, 0x96, 0x0c, 0x67, 0x0b, 0x2a, 0x9d, 0x58, 0xa0, 0xee, 0x1b, 0x87, 0x66, 0x46, 0x5e, 0xc5,
+	0x8d, 0xa1, 0x22, 0x93, 0x36, 0xd7, 0xf5, 0xbb, 0xde, 0x76, 0xad, 0xef, 0xc6, 0x12, 0xe2, 0xbe,
+	0xb9, 0x56, 0x90, 0xed, 0x4b, 0x26, 0xa1, 0x79, 0x75, 0x46, 0x56, 0x2f, 0xcc, 0xc8, 0xe6, 0xfb,
+	0x8d, 0x79, 0xb2, 0x7a, 0x89, 0x26, 0xd8, 0x59, 0xf4, 0xdc, 0x46, 0x7c, 0x0f, 0x17, 0x8a, 0xaf,
+	0xbb, 0x1a, 0xa4, 0x15, 0x2d, 0x4b, 0x60, 0x1c, 0xb7, 0xca, 0xc7, 0x1f, 0x88, 0x64, 0x9c, 0xd4,
+	0x54, 0x41, 0xd4, 0xed, 0x54, 0x02, 0xd7, 0x51, 0xbe, 0xea, 0xeb, 0x31, 0x1d, 0xe0, 0x97, 0x2e,
+	0x00, 0x14, 0x49, 0x06, 0xba, 0x72, 0xa9, 0x0c, 0xa8, 0xc3, 0xf3, 0xc1, 0x57, 0xdb, 0xf8, 0xd9,
+	0xb2, 0x9b, 0x3e, 0x88, 0x2c, 0x0c, 0x80, 0x7c, 0x87, 0xf0, 0xda, 0x3d, 0x90, 0x64, 0x67, 0x75,
+	0xd8, 0x8a, 0xcf, 0xb1, 0x53, 0x6b, 0x32, 0xd1, 0x9d, 0x4f, 0x7e, 0xff, 0xf3, 0xcb, 0x2b, 0x1d,
+	0xd2, 0xd6, 0x4d, 0x46, 0xd6, 0xb3, 0x1a, 0x93, 0xd4, 0x3b, 0x53, 0x44, 0x3f, 0x26, 0xdf, 0x20,
+	0xbc, 0x51, 0x14, 0x1f, 0xd2, 0xad, 0x82, 0x5a, 0x2a, 0x9e, 0x8e, 0x7b, 0xd9, 0xe3, 0xb9, 0xac,
+	0xe8, 0xbe, 0xc6, 0xf4, 0x32, 0xed, 0x2c, 0xc3, 0x54, 0xf4, 0x2e, 0xc7, 0x68, 0x8f, 0x7c, 0x86,
+	0xf0, 0xba, 0xce, 0xba, 0x3b, 0xab, 0xbd, 0xcc, 0xd5, 0x29, 0x67, 0xef, 0x32, 0x47, 0x0d, 0x98,
+	0xdb, 0x1a, 0xcc, 0x8b, 0xb4, 0xb5, 0x0c, 0xcc, 0x20, 0x1c, 0x0e, 0x15, 0x90, 0x6f, 0x11, 0x5e,
+	0x57, 0xbd, 0x0d, 0xa9, 0x48, 0xd2, 0x69, 0xff, 0xe3, 0x3c, 0xa8, 0xf3, 0x25, 0x95, 0x59, 0xfa,
+	0x82, 0x06, 0x7b, 0x8b, 0xdc, 0x5c, 0x02, 0x96, 0xfc, 0x80, 0x70, 0x23, 0xef, 0x2b, 0xc8, 0xfe,
+	0x6a, 0x98, 0xa5, 0xee, 0xa3, 0x66, 0xd1, 0x79, 0x1a, 0xe6, 0x1d, 0xba, 0x0c, 0xe6, 0xb1, 0xdd,
+	0x86, 0x7c, 0x8a, 0x70, 0x23, 0xef, 0x24, 0xaa, 0x60, 0x97, 0xfa, 0x0d, 0xa7, 0x22, 0xa7, 0xa6,
+	0x8f, 0x6c, 0xb2, 0x60, 0xaf, 0x2a, 0x0b, 0x7e, 0x44, 0xf8, 0x9a, 0x29, 0x48, 0xe4, 0xee, 0x6a,
+	0xdb, 0xe5, 0xba, 0x55, 0x73, 0x00, 0x7b, 0x1a, 0xef, 0x3e, 0xdd, 0x59, 0x8d, 0xd7, 0xe3, 0x39,
+	0x08, 0x25, 0xcf, 0xdf, 0x10, 0xbe, 0x6e, 0x55, 0x36, 0x72, 0x54, 0x59, 0xa9, 0x17, 0x14, 0xc2,
+	0x9a, 0xa9, 0x1c, 0x6b, 0x2a, 0x47, 0xd4, 0xab, 0xa0, 0xc2, 0x72, 0x30, 0xdd, 0x81, 0x41, 0xa3,
+	0x38, 0xfd, 0x84, 0xf0, 0xb6, 0x0f, 0x69, 0x32, 0x11, 0x01, 0xa8, 0x5e, 0xb0, 0x2a, 0xf5, 0xa6,
+	0xfd, 0x62, 0xbd, 0xa9, 0xa7, 0xcc, 0xd2, 0x23, 0xcd, 0xc3, 0x25, 0x77, 0x2b, 0x78, 0x08, 0x83,
+	0xb7, 0x2b, 0x05, 0xc0, 0xeb, 0xf7, 0x7f, 0x39, 0x6f, 0xa3, 0x5f, 0xcf, 0xdb, 0xe8, 0x8f, 0xf3,
+	0x36, 0x7a, 0xef, 0x95, 0xcb, 0xfd, 0xd1, 0x05, 0x51, 0x08, 0xb1, 0xfd, 0x0b, 0xf9, 0xb8, 0xa1,
+	0xff, 0xe3, 0x0e, 0xff, 0x1e, 0x00, 0x72, 0xf0, 0xfe, 0xc2, 0x71, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -560,12 +962,18 @@ type ApplicationSetServiceClient interface {
 	Get(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 	// Generate generates
 	Generate(ctx context.Context, in *ApplicationSetGenerateRequest, opts ...grpc.CallOption) (*ApplicationSetGenerateResponse, error)
+	// Diff compares the Applications generated by an applicationset with the live Applications
+	Diff(ctx context.Context, in *ApplicationSetDiffRequest, opts ...grpc.CallOption) (*ApplicationSetDiffResponse, error)
 	//List returns list of applicationset
 	List(ctx context.Context, in *ApplicationSetListQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetList, error)
 	//Create creates an applicationset
 	Create(ctx context.Context, in *ApplicationSetCreateRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 	// Delete deletes an application set
 	Delete(ctx context.Context, in *ApplicationSetDeleteRequest, opts ...grpc.CallOption) (*ApplicationSetResponse, error)
+	// Promote approves a step of the RollingSync strategy, or completes a paused or degraded step
+	Promote(ctx context.Context, in *ApplicationSetPromoteRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
+	// ApproveDeletion approves the deletions of Applications exceeding the maxDeletions of an applicationset
+	ApproveDeletion(ctx context.Context, in *ApplicationSetApproveDeletionRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 	// ResourceTree returns resource tree
 	ResourceTree(ctx context.Context, in *ApplicationSetTreeQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetTree, error)
 }
@@ -596,6 +1004,15 @@ func (c *applicationSetServiceClient) Generate(ctx context.Context, in *Applicat
 	return out, nil
 }
 
+func (c *applicationSetServiceClient) Diff(ctx context.Context, in *ApplicationSetDiffRequest, opts ...grpc.CallOption) (*ApplicationSetDiffResponse, error) {
+	out := new(ApplicationSetDiffResponse)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/Diff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationSetServiceClient) List(ctx context.Context, in *ApplicationSetListQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetList, error) {
 	out := new(v1alpha1.ApplicationSetList)
 	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/List", in, out, opts...)
@@ -623,6 +1040,24 @@ func (c *applicationSetServiceClient) Delete(ctx context.Context, in *Applicatio
 	return out, nil
 }
 
+func (c *applicationSetServiceClient) Promote(ctx context.Context, in *ApplicationSetPromoteRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
+	out := new(v1alpha1.ApplicationSet)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/Promote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationSetServiceClient) ApproveDeletion(ctx context.Context, in *ApplicationSetApproveDeletionRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
+	out := new(v1alpha1.ApplicationSet)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/ApproveDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationSetServiceClient) ResourceTree(ctx context.Context, in *ApplicationSetTreeQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetTree, error) {
 	out := new(v1alpha1.ApplicationSetTree)
 	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/ResourceTree", in, out, opts...)
@@ -638,12 +1073,18 @@ type ApplicationSetServiceServer interface {
 	Get(context.Context, *ApplicationSetGetQuery) (*v1alpha1.ApplicationSet, error)
 	// Generate generates
 	Generate(context.Context, *ApplicationSetGenerateRequest) (*ApplicationSetGenerateResponse, error)
+	// Diff compares the Applications generated by an applicationset with the live Applications
+	Diff(context.Context, *ApplicationSetDiffRequest) (*ApplicationSetDiffResponse, error)
 	//List returns list of applicationset
 	List(context.Context, *ApplicationSetListQuery) (*v1alpha1.ApplicationSetList, error)
 	//Create creates an applicationset
 	Create(context.Context, *ApplicationSetCreateRequest) (*v1alpha1.ApplicationSet, error)
 	// Delete deletes an application set
 	Delete(context.Context, *ApplicationSetDeleteRequest) (*ApplicationSetResponse, error)
+	// Promote approves a step of the RollingSync strategy, or completes a paused or degraded step
+	Promote(context.Context, *ApplicationSetPromoteRequest) (*v1alpha1.ApplicationSet, error)
+	// ApproveDeletion approves the deletions of Applications exceeding the maxDeletions of an applicationset
+	ApproveDeletion(context.Context, *ApplicationSetApproveDeletionRequest) (*v1alpha1.ApplicationSet, error)
 	// ResourceTree returns resource tree
 	ResourceTree(context.Context, *ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error)
 }
@@ -658,6 +1099,9 @@ func (*UnimplementedApplicationSetServiceServer) Get(ctx context.Context, req *A
 func (*UnimplementedApplicationSetServiceServer) Generate(ctx context.Context, req *ApplicationSetGenerateRequest) (*ApplicationSetGenerateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Generate not implemented")
 }
+func (*UnimplementedApplicationSetServiceServer) Diff(ctx context.Context, req *ApplicationSetDiffRequest) (*ApplicationSetDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diff not implemented")
+}
 func (*UnimplementedApplicationSetServiceServer) List(ctx context.Context, req *ApplicationSetListQuery) (*v1alpha1.ApplicationSetList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
@@ -667,6 +1111,12 @@ func (*UnimplementedApplicationSetServiceServer) Create(ctx context.Context, req
 func (*UnimplementedApplicationSetServiceServer) Delete(ctx context.Context, req *ApplicationSetDeleteRequest) (*ApplicationSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedApplicationSetServiceServer) Promote(ctx context.Context, req *ApplicationSetPromoteRequest) (*v1alpha1.ApplicationSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Promote not implemented")
+}
+func (*UnimplementedApplicationSetServiceServer) ApproveDeletion(ctx context.Context, req *ApplicationSetApproveDeletionRequest) (*v1alpha1.ApplicationSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDeletion not implemented")
+}
 func (*UnimplementedApplicationSetServiceServer) ResourceTree(ctx context.Context, req *ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourceTree not implemented")
 }
@@ -711,6 +1161,24 @@ func _ApplicationSetService_Generate_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationSetService_Diff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).Diff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/Diff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).Diff(ctx, req.(*ApplicationSetDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationSetService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationSetListQuery)
 	if err := dec(in); err != nil {
@@ -765,6 +1233,42 @@ func _ApplicationSetService_Delete_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationSetService_Promote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetPromoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).Promote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/Promote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).Promote(ctx, req.(*ApplicationSetPromoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationSetService_ApproveDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetApproveDeletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).ApproveDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/ApproveDeletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).ApproveDeletion(ctx, req.(*ApplicationSetApproveDeletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationSetService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplicationSetTreeQuery)
 	if err := dec(in); err != nil {
@@ -795,6 +1299,10 @@ var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Generate",
 			Handler:    _ApplicationSetService_Generate_Handler,
 		},
+		{
+			MethodName: "Diff",
+			Handler:    _ApplicationSetService_Diff_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _ApplicationSetService_List_Handler,
@@ -807,6 +1315,14 @@ var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationSetService_Delete_Handler,
 		},
+		{
+			MethodName: "Promote",
+			Handler:    _ApplicationSetService_Promote_Handler,
+		},
+		{
+			MethodName: "ApproveDeletion",
+			Handler:    _ApplicationSetService_ApproveDeletion_Handler,
+		},
 		{
 			MethodName: "ResourceTree",
 			Handler:    _ApplicationSetService_ResourceTree_Handler,
@@ -1174,186 +1690,1441 @@ func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationset(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ApplicationSetDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ApplicationSetGetQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
-	}
-	l = len(m.AppsetNamespace)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *ApplicationSetDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ApplicationSetListQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ApplicationSetDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Projects) > 0 {
-		for _, s := range m.Projects {
-			l = len(s)
-			n += 1 + l + sovApplicationset(uint64(l))
-		}
-	}
-	l = len(m.Selector)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
-	}
-	l = len(m.AppsetNamespace)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ApplicationSet != nil {
+		{
+			size, err := m.ApplicationSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationset(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Project)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
-	}
-	if m.Applicationset != nil {
-		l = m.Applicationset.Size()
-		n += 1 + l + sovApplicationset(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ApplicationSetFieldDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ApplicationSetCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ApplicationSetFieldDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetFieldDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Applicationset != nil {
-		l = m.Applicationset.Size()
-		n += 1 + l + sovApplicationset(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Upsert {
-		n += 2
+	if len(m.TargetValue) > 0 {
+		i -= len(m.TargetValue)
+		copy(dAtA[i:], m.TargetValue)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.TargetValue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.DryRun {
-		n += 2
+	if len(m.LiveValue) > 0 {
+		i -= len(m.LiveValue)
+		copy(dAtA[i:], m.LiveValue)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.LiveValue)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSetDeleteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationSetApplicationDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetApplicationDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetApplicationDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.AppsetNamespace)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
+	if len(m.TargetState) > 0 {
+		i -= len(m.TargetState)
+		copy(dAtA[i:], m.TargetState)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.TargetState)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.LiveState) > 0 {
+		i -= len(m.LiveState)
+		copy(dAtA[i:], m.LiveState)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.LiveState)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *ApplicationSetTreeQuery) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fields[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.AppsetNamespace)
-	if l > 0 {
-		n += 1 + l + sovApplicationset(uint64(l))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationSetGenerateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationSetDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ApplicationSet != nil {
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Applications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationset(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetPromoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetPromoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetPromoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Step != 0 {
+		i = encodeVarintApplicationset(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AppsetNamespace) > 0 {
+		i -= len(m.AppsetNamespace)
+		copy(dAtA[i:], m.AppsetNamespace)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.AppsetNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetApproveDeletionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetApproveDeletionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetApproveDeletionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AppsetNamespace) > 0 {
+		i -= len(m.AppsetNamespace)
+		copy(dAtA[i:], m.AppsetNamespace)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.AppsetNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationset(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ApplicationSetGetQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetListQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projects) > 0 {
+		for _, s := range m.Projects {
+			l = len(s)
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	l = len(m.Selector)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.Applicationset != nil {
+		l = m.Applicationset.Size()
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Applicationset != nil {
+		l = m.Applicationset.Size()
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetDeleteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetTreeQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetGenerateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApplicationSet != nil {
 		l = m.ApplicationSet.Size()
 		n += 1 + l + sovApplicationset(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
-}
+	return n
+}
+
+func (m *ApplicationSetGenerateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Applications) > 0 {
+		for _, e := range m.Applications {
+			l = e.Size()
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ApplicationSet != nil {
+		l = m.ApplicationSet.Size()
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetFieldDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.LiveValue)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.TargetValue)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetApplicationDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	l = len(m.LiveState)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.TargetState)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Applications) > 0 {
+		for _, e := range m.Applications {
+			l = e.Size()
+			n += 1 + l + sovApplicationset(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetPromoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.Step != 0 {
+		n += 1 + sovApplicationset(uint64(m.Step))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationSetApproveDeletionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovApplicationset(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApplicationset(x uint64) (n int) {
+	return sovApplicationset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ApplicationSetGetQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetGetQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetGetQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetListQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetListQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetListQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applicationset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Applicationset == nil {
+				m.Applicationset = &v1alpha1.ApplicationSet{}
+			}
+			if err := m.Applicationset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applicationset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Applicationset == nil {
+				m.Applicationset = &v1alpha1.ApplicationSet{}
+			}
+			if err := m.Applicationset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upsert = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetDeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTreeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTreeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTreeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func (m *ApplicationSetGenerateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Applications) > 0 {
-		for _, e := range m.Applications {
-			l = e.Size()
-			n += 1 + l + sovApplicationset(uint64(l))
+	return nil
+}
+func (m *ApplicationSetGenerateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetGenerateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetGenerateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApplicationSet == nil {
+				m.ApplicationSet = &v1alpha1.ApplicationSet{}
+			}
+			if err := m.ApplicationSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovApplicationset(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozApplicationset(x uint64) (n int) {
-	return sovApplicationset(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ApplicationSetGetQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetGenerateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1376,17 +3147,17 @@ func (m *ApplicationSetGetQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetGetQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetGenerateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetGetQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetGenerateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationset
@@ -1396,29 +3167,82 @@ func (m *ApplicationSetGetQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationset
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Applications = append(m.Applications, &v1alpha1.Application{})
+			if err := m.Applications[len(m.Applications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationset
@@ -1428,23 +3252,27 @@ func (m *ApplicationSetGetQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationset
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			if m.ApplicationSet == nil {
+				m.ApplicationSet = &v1alpha1.ApplicationSet{}
+			}
+			if err := m.ApplicationSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1468,7 +3296,7 @@ func (m *ApplicationSetGetQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetListQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetFieldDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1491,15 +3319,15 @@ func (m *ApplicationSetListQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetListQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetFieldDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetListQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetFieldDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1527,11 +3355,11 @@ func (m *ApplicationSetListQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Projects = append(m.Projects, string(dAtA[iNdEx:postIndex]))
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1559,11 +3387,11 @@ func (m *ApplicationSetListQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.LiveValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1591,7 +3419,7 @@ func (m *ApplicationSetListQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			m.TargetValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1615,7 +3443,7 @@ func (m *ApplicationSetListQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetResponse) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetApplicationDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1638,15 +3466,15 @@ func (m *ApplicationSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetApplicationDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetApplicationDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1674,13 +3502,13 @@ func (m *ApplicationSetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Applicationset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationset
@@ -1690,82 +3518,59 @@ func (m *ApplicationSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Applicationset == nil {
-				m.Applicationset = &v1alpha1.ApplicationSet{}
-			}
-			if err := m.Applicationset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationset(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationset
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetCreateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationset
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetCreateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Applicationset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1792,18 +3597,16 @@ func (m *ApplicationSetCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Applicationset == nil {
-				m.Applicationset = &v1alpha1.ApplicationSet{}
-			}
-			if err := m.Applicationset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fields = append(m.Fields, &ApplicationSetFieldDiff{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveState", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationset
@@ -1813,17 +3616,29 @@ func (m *ApplicationSetCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Upsert = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiveState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetState", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationset
@@ -1833,12 +3648,24 @@ func (m *ApplicationSetCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationset(dAtA[iNdEx:])
@@ -1861,7 +3688,7 @@ func (m *ApplicationSetCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetDeleteRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetDiffResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1884,17 +3711,17 @@ func (m *ApplicationSetDeleteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetDeleteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetDiffResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationset
@@ -1904,55 +3731,25 @@ func (m *ApplicationSetDeleteRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationset
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationset
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplicationset
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationset
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Applications = append(m.Applications, &ApplicationSetApplicationDiff{})
+			if err := m.Applications[len(m.Applications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1976,7 +3773,7 @@ func (m *ApplicationSetDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTreeQuery) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetPromoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1999,10 +3796,10 @@ func (m *ApplicationSetTreeQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTreeQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetPromoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTreeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetPromoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2069,6 +3866,25 @@ func (m *ApplicationSetTreeQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationset(dAtA[iNdEx:])
@@ -2091,7 +3907,7 @@ func (m *ApplicationSetTreeQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetGenerateRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetApproveDeletionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2114,17 +3930,17 @@ func (m *ApplicationSetGenerateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetGenerateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetApproveDeletionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetGenerateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetApproveDeletionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationset
@@ -2134,84 +3950,29 @@ func (m *ApplicationSetGenerateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApplicationSet == nil {
-				m.ApplicationSet = &v1alpha1.ApplicationSet{}
-			}
-			if err := m.ApplicationSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationset(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthApplicationset
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetGenerateResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationset
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetGenerateResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetGenerateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationset
@@ -2221,25 +3982,23 @@ func (m *ApplicationSetGenerateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationset
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationset
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Applications = append(m.Applications, &v1alpha1.Application{})
-			if err := m.Applications[len(m.Applications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -519,6 +519,175 @@ func (m *SyncWindowsResponse) GetWindows() []*v1alpha1.SyncWindow {
 	return nil
 }
 
+// SyncWindowPeriodsQuery is a query for the upcoming periods of time during which the sync windows of a project are active
+type SyncWindowPeriodsQuery struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// until is the duration from now until which the periods are listed, e.g. 720h. Defaults to 30 days.
+	Until                string   `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncWindowPeriodsQuery) Reset()         { *m = SyncWindowPeriodsQuery{} }
+func (m *SyncWindowPeriodsQuery) String() string { return proto.CompactTextString(m) }
+func (*SyncWindowPeriodsQuery) ProtoMessage()    {}
+func (*SyncWindowPeriodsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{9}
+}
+func (m *SyncWindowPeriodsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowPeriodsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWindowPeriodsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWindowPeriodsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowPeriodsQuery.Merge(m, src)
+}
+func (m *SyncWindowPeriodsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowPeriodsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowPeriodsQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowPeriodsQuery proto.InternalMessageInfo
+
+func (m *SyncWindowPeriodsQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SyncWindowPeriodsQuery) GetUntil() string {
+	if m != nil {
+		return m.Until
+	}
+	return ""
+}
+
+// SyncWindowPeriods holds the upcoming periods of time during which a sync window is active
+type SyncWindowPeriods struct {
+	// id is the index of the sync window in the project
+	Id                   int32                        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Window               *v1alpha1.SyncWindow         `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	Periods              []*v1alpha1.SyncWindowPeriod `protobuf:"bytes,3,rep,name=periods,proto3" json:"periods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *SyncWindowPeriods) Reset()         { *m = SyncWindowPeriods{} }
+func (m *SyncWindowPeriods) String() string { return proto.CompactTextString(m) }
+func (*SyncWindowPeriods) ProtoMessage()    {}
+func (*SyncWindowPeriods) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{10}
+}
+func (m *SyncWindowPeriods) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowPeriods) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWindowPeriods.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWindowPeriods) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowPeriods.Merge(m, src)
+}
+func (m *SyncWindowPeriods) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowPeriods) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowPeriods.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowPeriods proto.InternalMessageInfo
+
+func (m *SyncWindowPeriods) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SyncWindowPeriods) GetWindow() *v1alpha1.SyncWindow {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *SyncWindowPeriods) GetPeriods() []*v1alpha1.SyncWindowPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+type SyncWindowPeriodsResponse struct {
+	Windows              []*SyncWindowPeriods `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SyncWindowPeriodsResponse) Reset()         { *m = SyncWindowPeriodsResponse{} }
+func (m *SyncWindowPeriodsResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncWindowPeriodsResponse) ProtoMessage()    {}
+func (*SyncWindowPeriodsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5f0a51496972c9e2, []int{11}
+}
+func (m *SyncWindowPeriodsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowPeriodsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncWindowPeriodsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncWindowPeriodsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowPeriodsResponse.Merge(m, src)
+}
+func (m *SyncWindowPeriodsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowPeriodsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowPeriodsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowPeriodsResponse proto.InternalMessageInfo
+
+func (m *SyncWindowPeriodsResponse) GetWindows() []*SyncWindowPeriods {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 type GlobalProjectsResponse struct {
 	Items                []*v1alpha1.AppProject `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -530,7 +699,7 @@ func (m *GlobalProjectsResponse) Reset()         { *m = GlobalProjectsResponse{}
 func (m *GlobalProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*GlobalProjectsResponse) ProtoMessage()    {}
 func (*GlobalProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{9}
+	return fileDescriptor_5f0a51496972c9e2, []int{12}
 }
 func (m *GlobalProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +749,7 @@ func (m *DetailedProjectsResponse) Reset()         { *m = DetailedProjectsRespon
 func (m *DetailedProjectsResponse) String() string { return proto.CompactTextString(m) }
 func (*DetailedProjectsResponse) ProtoMessage()    {}
 func (*DetailedProjectsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{10}
+	return fileDescriptor_5f0a51496972c9e2, []int{13}
 }
 func (m *DetailedProjectsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +817,7 @@ func (m *ListProjectLinksRequest) Reset()         { *m = ListProjectLinksRequest
 func (m *ListProjectLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListProjectLinksRequest) ProtoMessage()    {}
 func (*ListProjectLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5f0a51496972c9e2, []int{11}
+	return fileDescriptor_5f0a51496972c9e2, []int{14}
 }
 func (m *ListProjectLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,6 +863,9 @@ func init() {
 	proto.RegisterType((*EmptyResponse)(nil), "project.EmptyResponse")
 	proto.RegisterType((*SyncWindowsQuery)(nil), "project.SyncWindowsQuery")
 	proto.RegisterType((*SyncWindowsResponse)(nil), "project.SyncWindowsResponse")
+	proto.RegisterType((*SyncWindowPeriodsQuery)(nil), "project.SyncWindowPeriodsQuery")
+	proto.RegisterType((*SyncWindowPeriods)(nil), "project.SyncWindowPeriods")
+	proto.RegisterType((*SyncWindowPeriodsResponse)(nil), "project.SyncWindowPeriodsResponse")
 	proto.RegisterType((*GlobalProjectsResponse)(nil), "project.GlobalProjectsResponse")
 	proto.RegisterType((*DetailedProjectsResponse)(nil), "project.DetailedProjectsResponse")
 	proto.RegisterType((*ListProjectLinksRequest)(nil), "project.ListProjectLinksRequest")
@@ -702,70 +874,78 @@ func init() {
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 1007 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0x96, 0x93, 0x36, 0xbb, 0x7d, 0x2d, 0xa5, 0xcc, 0xee, 0x76, 0xdd, 0xd0, 0x1f, 0x61, 0xd0,
-	0x56, 0x51, 0xa1, 0xb6, 0xda, 0x80, 0xb4, 0x82, 0x13, 0xdb, 0xad, 0x02, 0x52, 0x0f, 0xe0, 0x82,
-	0x40, 0x1c, 0x40, 0x8e, 0xfd, 0x94, 0x9d, 0x8d, 0x63, 0x1b, 0xcf, 0x24, 0xdb, 0x10, 0xf5, 0x82,
-	0x04, 0x48, 0x1c, 0x38, 0xc0, 0x89, 0x0b, 0x47, 0xfe, 0x0f, 0x6e, 0x1c, 0x91, 0xf8, 0x07, 0x50,
-	0xc5, 0x1f, 0x82, 0x66, 0x3c, 0x76, 0xe2, 0xa4, 0xe6, 0x87, 0x1a, 0x38, 0x65, 0x3c, 0x7e, 0xfe,
-	0xbe, 0xef, 0xbd, 0x79, 0xf3, 0xcd, 0x04, 0xb6, 0x39, 0x26, 0x43, 0x4c, 0xec, 0x38, 0x89, 0x9e,
-	0xa2, 0x27, 0xb2, 0x5f, 0x2b, 0x4e, 0x22, 0x11, 0x91, 0x5b, 0xfa, 0xb1, 0xbe, 0xdd, 0x8d, 0xa2,
-	0x6e, 0x80, 0xb6, 0x1b, 0x33, 0xdb, 0x0d, 0xc3, 0x48, 0xb8, 0x82, 0x45, 0x21, 0x4f, 0xc3, 0xea,
-	0xb4, 0xf7, 0x90, 0x5b, 0x2c, 0x52, 0x6f, 0xbd, 0x28, 0x41, 0x7b, 0x78, 0x64, 0x77, 0x31, 0xc4,
-	0xc4, 0x15, 0xe8, 0xeb, 0x98, 0xb3, 0x2e, 0x13, 0x4f, 0x06, 0x1d, 0xcb, 0x8b, 0xfa, 0xb6, 0x9b,
-	0x74, 0x23, 0x89, 0xac, 0x06, 0x87, 0x9e, 0x6f, 0x0f, 0x5b, 0x76, 0xdc, 0xeb, 0xca, 0xef, 0xb9,
-	0xed, 0xc6, 0x71, 0xc0, 0x3c, 0x85, 0x6f, 0x0f, 0x8f, 0xdc, 0x20, 0x7e, 0xe2, 0xce, 0xa3, 0x9d,
-	0xfc, 0x0d, 0x9a, 0xce, 0x6a, 0x1a, 0x6b, 0x6a, 0x9c, 0x82, 0xd0, 0xef, 0x0c, 0xb8, 0xfb, 0x6e,
-	0x9a, 0xe0, 0x49, 0x82, 0xae, 0x40, 0x07, 0x3f, 0x1b, 0x20, 0x17, 0xa4, 0x03, 0x59, 0xe2, 0xa6,
-	0xd1, 0x30, 0x9a, 0xab, 0xc7, 0x6f, 0x5b, 0x13, 0x3e, 0x2b, 0xe3, 0x53, 0x83, 0x4f, 0x3d, 0xdf,
-	0x1a, 0xb6, 0xac, 0xb8, 0xd7, 0xb5, 0xa4, 0x7a, 0x6b, 0x9a, 0x25, 0x53, 0x6f, 0xbd, 0x15, 0xc7,
-	0x9a, 0xc7, 0xc9, 0x80, 0xc9, 0x26, 0xd4, 0x06, 0x31, 0xc7, 0x44, 0x98, 0x95, 0x86, 0xd1, 0xbc,
-	0xed, 0xe8, 0x27, 0xda, 0x83, 0x2d, 0x1d, 0xfb, 0x7e, 0xd4, 0xc3, 0xf0, 0x31, 0x06, 0x38, 0x11,
-	0x66, 0x16, 0x85, 0xad, 0x4c, 0xe0, 0x08, 0x2c, 0x25, 0x51, 0x80, 0x0a, 0x6c, 0xc5, 0x51, 0x63,
-	0xb2, 0x01, 0x55, 0xe6, 0x0a, 0xb3, 0xda, 0x30, 0x9a, 0x55, 0x47, 0x0e, 0xc9, 0x3a, 0x54, 0x98,
-	0x6f, 0x2e, 0xa9, 0x98, 0x0a, 0xf3, 0xe9, 0x0f, 0x46, 0x91, 0xad, 0x58, 0x86, 0x72, 0xb6, 0x06,
-	0xac, 0xfa, 0xc8, 0xbd, 0x84, 0xc5, 0x32, 0x51, 0x4d, 0x3a, 0x3d, 0x95, 0xeb, 0xa9, 0x4e, 0xe9,
-	0xd9, 0x86, 0x15, 0xbc, 0x88, 0x59, 0x82, 0xfc, 0x9d, 0x50, 0x89, 0xa8, 0x3a, 0x93, 0x09, 0xad,
-	0x6d, 0x39, 0xd7, 0xf6, 0x6a, 0xbe, 0x38, 0x4a, 0x9a, 0x83, 0x3c, 0x8e, 0x42, 0x8e, 0xe4, 0x2e,
-	0x2c, 0x0b, 0x39, 0xa1, 0x35, 0xa5, 0x0f, 0x94, 0xc2, 0x9a, 0x8e, 0x7e, 0x6f, 0x80, 0xc9, 0x48,
-	0xf2, 0x87, 0x6e, 0x1f, 0x75, 0x90, 0x1a, 0xd3, 0xcf, 0x73, 0xc4, 0x0f, 0x62, 0xff, 0xff, 0x5d,
-	0x6e, 0xfa, 0x3c, 0x3c, 0x77, 0xda, 0x8f, 0xc5, 0x28, 0x4b, 0x83, 0xee, 0xc3, 0xc6, 0xf9, 0x28,
-	0xf4, 0x3e, 0x64, 0xa1, 0x1f, 0x3d, 0xe3, 0xe5, 0xa2, 0x47, 0x70, 0x67, 0x2a, 0x2e, 0xaf, 0x42,
-	0x07, 0x6e, 0x3d, 0x4b, 0xa7, 0x4c, 0xa3, 0x51, 0xbd, 0xb9, 0xe6, 0x09, 0x87, 0x93, 0x01, 0xd3,
-	0x0b, 0xd8, 0x6c, 0x07, 0x51, 0xc7, 0x0d, 0x74, 0x36, 0x13, 0xf6, 0x4f, 0x60, 0x99, 0x09, 0xec,
-	0x2f, 0x88, 0x7b, 0xaa, 0x5e, 0x29, 0x2c, 0xfd, 0xb9, 0x0a, 0xe6, 0x63, 0x14, 0x2e, 0x0b, 0xd0,
-	0x9f, 0x23, 0x8f, 0x61, 0xbd, 0x5b, 0x90, 0xb5, 0x70, 0x15, 0x33, 0xf8, 0xd3, 0x0d, 0x52, 0xf9,
-	0xaf, 0xfc, 0x20, 0x80, 0xb5, 0x04, 0xe3, 0x88, 0x33, 0x11, 0x25, 0x0c, 0xb9, 0x59, 0x5d, 0x44,
-	0x4e, 0x4e, 0x86, 0x38, 0x72, 0x0a, 0xe8, 0xc4, 0x85, 0xdb, 0x5e, 0x30, 0xe0, 0x02, 0x13, 0x6e,
-	0x2e, 0x29, 0xa6, 0xd3, 0x9b, 0x31, 0x9d, 0xa4, 0x68, 0x4e, 0x0e, 0x4b, 0x0f, 0xe1, 0xfe, 0x19,
-	0xe3, 0x42, 0x27, 0x7a, 0xc6, 0xc2, 0x1e, 0xcf, 0x36, 0xdc, 0x35, 0x7d, 0x7e, 0xfc, 0xe3, 0x1a,
-	0xac, 0xeb, 0xd8, 0x73, 0x4c, 0x86, 0xcc, 0x43, 0xf2, 0x8d, 0x01, 0xab, 0xa9, 0x23, 0x29, 0x07,
-	0x20, 0xd4, 0xca, 0x4e, 0xa7, 0x52, 0xcf, 0xaa, 0xef, 0x5c, 0x1b, 0x93, 0xef, 0xba, 0x87, 0x5f,
-	0xfc, 0xf6, 0xc7, 0xf7, 0x95, 0x63, 0x7a, 0xa8, 0xce, 0xaa, 0xe1, 0x51, 0x76, 0xde, 0x71, 0x7b,
-	0xac, 0x47, 0x97, 0xb6, 0xf4, 0x2a, 0x6e, 0x8f, 0xe5, 0xcf, 0xa5, 0xad, 0xdc, 0xe5, 0x0d, 0xe3,
-	0x80, 0x7c, 0x65, 0xc0, 0x6a, 0x6a, 0xc6, 0x7f, 0x25, 0xa6, 0x60, 0xd7, 0xf5, 0xcd, 0x3c, 0xa6,
-	0xb8, 0xf7, 0xdf, 0x54, 0x2a, 0x5e, 0x3f, 0x68, 0xfd, 0x2b, 0x15, 0xf6, 0x98, 0xb9, 0xe2, 0x92,
-	0x7c, 0x6b, 0x40, 0x2d, 0xcd, 0x99, 0xcc, 0x25, 0x5b, 0xac, 0xc5, 0xc2, 0xba, 0x94, 0xbe, 0xa8,
-	0x04, 0xdf, 0xa3, 0x1b, 0xb3, 0x82, 0x65, 0x65, 0xbe, 0x34, 0x60, 0x49, 0xae, 0x34, 0xb9, 0x37,
-	0x2b, 0x47, 0xb9, 0x5a, 0xfd, 0x6c, 0x51, 0x32, 0x24, 0x09, 0x35, 0x95, 0x14, 0x42, 0xe6, 0xa4,
-	0x90, 0x0b, 0x20, 0x6d, 0x14, 0x33, 0xb6, 0x51, 0x26, 0xea, 0xa5, 0x7c, 0xba, 0xcc, 0x67, 0x68,
-	0x53, 0x31, 0x51, 0xd2, 0x98, 0x5f, 0x25, 0xd9, 0xb1, 0x97, 0xb6, 0xaf, 0xbf, 0x24, 0x5f, 0x1b,
-	0x50, 0x6d, 0x63, 0x29, 0xd7, 0xe2, 0xd6, 0x61, 0x4f, 0x49, 0xda, 0x22, 0xf7, 0x4b, 0x24, 0x91,
-	0x31, 0xbc, 0xd0, 0x46, 0x51, 0x74, 0xed, 0x32, 0x59, 0x7b, 0xf9, 0xf4, 0xf5, 0x2e, 0x4f, 0x2d,
-	0xc5, 0xd6, 0x24, 0xfb, 0x65, 0x05, 0x48, 0x6d, 0x32, 0x5f, 0x80, 0x9f, 0x0c, 0xa8, 0xa5, 0x27,
-	0xeb, 0x7c, 0x67, 0x16, 0x4e, 0xdc, 0x05, 0x56, 0xa4, 0xa5, 0x34, 0x1e, 0xd6, 0x9b, 0xa5, 0x5b,
-	0xc9, 0xea, 0xa3, 0x70, 0x7d, 0x57, 0xb8, 0x96, 0x12, 0x2d, 0x3b, 0xf6, 0x23, 0xa8, 0xa5, 0x1b,
-	0xb5, 0xac, 0x34, 0x65, 0x1b, 0x57, 0xd7, 0xff, 0xa0, 0xb4, 0xfe, 0x4f, 0x01, 0x64, 0x97, 0x9e,
-	0x0e, 0x31, 0x2c, 0x2f, 0xfc, 0x8e, 0x95, 0xde, 0x97, 0x65, 0x86, 0x96, 0xbc, 0x2f, 0x5b, 0xc3,
-	0x23, 0x4b, 0x7d, 0xa2, 0x3a, 0x7c, 0x5f, 0x91, 0x34, 0xc8, 0x6e, 0x59, 0xd9, 0x31, 0x45, 0x1f,
-	0xc3, 0x9d, 0x36, 0x8a, 0xa9, 0xcb, 0xc1, 0xb9, 0x90, 0xa5, 0xdf, 0xca, 0x49, 0x67, 0xef, 0x17,
-	0xf5, 0xed, 0xeb, 0x5e, 0xe5, 0xc9, 0xbd, 0xa2, 0x78, 0x1f, 0x90, 0x97, 0xcb, 0x78, 0xf9, 0x28,
-	0xf4, 0xf4, 0xdd, 0x80, 0xc4, 0xb0, 0x22, 0xc5, 0x2a, 0x5b, 0x27, 0x8d, 0x1c, 0xb7, 0xc4, 0xf1,
-	0xeb, 0xf5, 0xc2, 0x42, 0xea, 0x57, 0x9a, 0xf7, 0x81, 0xe2, 0xdd, 0x23, 0x3b, 0x65, 0xbc, 0x81,
-	0x0c, 0x7f, 0xf4, 0xe8, 0x97, 0xab, 0x5d, 0xe3, 0xd7, 0xab, 0x5d, 0xe3, 0xf7, 0xab, 0x5d, 0xe3,
-	0xe3, 0xd7, 0xfe, 0xd9, 0xdf, 0x09, 0x2f, 0x60, 0x18, 0xe6, 0xff, 0x6a, 0x3a, 0x35, 0x75, 0xf1,
-	0x6f, 0xfd, 0x19, 0x00, 0x00, 0xff, 0xff, 0xae, 0xc9, 0xe8, 0xa8, 0xf6, 0x0c, 0x00, 0x00,
+	// 1122 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdd, 0x6e, 0xdc, 0x44,
+	0x14, 0x96, 0xb3, 0xc9, 0xa6, 0x39, 0x69, 0x43, 0x3a, 0x4d, 0xd2, 0xcd, 0x92, 0x9f, 0x65, 0x50,
+	0xa3, 0x55, 0x4a, 0x6c, 0x25, 0x29, 0x52, 0x55, 0xae, 0x48, 0x1a, 0x05, 0xa4, 0x08, 0xb5, 0x0e,
+	0x08, 0xc4, 0x05, 0xe0, 0xd8, 0x47, 0x9b, 0xe9, 0x3a, 0xb6, 0xb1, 0x67, 0xb7, 0x59, 0xa2, 0xdc,
+	0x20, 0x01, 0x12, 0x17, 0x48, 0xc0, 0x15, 0x2f, 0xc0, 0x03, 0xf0, 0x06, 0xdc, 0x71, 0x89, 0xc4,
+	0x0b, 0xa0, 0x88, 0x7b, 0x5e, 0x01, 0xcd, 0x8f, 0xbd, 0xeb, 0x6c, 0x06, 0x8a, 0xb2, 0x70, 0xb5,
+	0xe3, 0xd9, 0x33, 0xdf, 0xf7, 0x9d, 0x1f, 0x9f, 0x39, 0x86, 0xa5, 0x0c, 0xd3, 0x2e, 0xa6, 0x4e,
+	0x92, 0xc6, 0xcf, 0xd0, 0xe7, 0xf9, 0xaf, 0x9d, 0xa4, 0x31, 0x8f, 0xc9, 0xa4, 0x7e, 0xac, 0x2f,
+	0xb5, 0xe2, 0xb8, 0x15, 0xa2, 0xe3, 0x25, 0xcc, 0xf1, 0xa2, 0x28, 0xe6, 0x1e, 0x67, 0x71, 0x94,
+	0x29, 0xb3, 0x3a, 0x6d, 0x3f, 0xcc, 0x6c, 0x16, 0xcb, 0x7f, 0xfd, 0x38, 0x45, 0xa7, 0xbb, 0xe9,
+	0xb4, 0x30, 0xc2, 0xd4, 0xe3, 0x18, 0x68, 0x9b, 0x83, 0x16, 0xe3, 0xc7, 0x9d, 0x23, 0xdb, 0x8f,
+	0x4f, 0x1c, 0x2f, 0x6d, 0xc5, 0x02, 0x59, 0x2e, 0x36, 0xfc, 0xc0, 0xe9, 0x6e, 0x3b, 0x49, 0xbb,
+	0x25, 0xce, 0x67, 0x8e, 0x97, 0x24, 0x21, 0xf3, 0x25, 0xbe, 0xd3, 0xdd, 0xf4, 0xc2, 0xe4, 0xd8,
+	0x1b, 0x46, 0xdb, 0xfd, 0x07, 0x34, 0xed, 0xd5, 0x20, 0xd6, 0xc0, 0x5a, 0x81, 0xd0, 0xef, 0x2c,
+	0x98, 0x7b, 0xa2, 0x1c, 0xdc, 0x4d, 0xd1, 0xe3, 0xe8, 0xe2, 0xa7, 0x1d, 0xcc, 0x38, 0x39, 0x82,
+	0xdc, 0xf1, 0x9a, 0xd5, 0xb0, 0x9a, 0xd3, 0x5b, 0x6f, 0xd9, 0x7d, 0x3e, 0x3b, 0xe7, 0x93, 0x8b,
+	0x8f, 0xfd, 0xc0, 0xee, 0x6e, 0xdb, 0x49, 0xbb, 0x65, 0x0b, 0xf5, 0xf6, 0x20, 0x4b, 0xae, 0xde,
+	0x7e, 0x33, 0x49, 0x34, 0x8f, 0x9b, 0x03, 0x93, 0x05, 0xa8, 0x76, 0x92, 0x0c, 0x53, 0x5e, 0x1b,
+	0x6b, 0x58, 0xcd, 0x1b, 0xae, 0x7e, 0xa2, 0x6d, 0x58, 0xd4, 0xb6, 0xef, 0xc6, 0x6d, 0x8c, 0x1e,
+	0x63, 0x88, 0x7d, 0x61, 0xb5, 0xb2, 0xb0, 0xa9, 0x3e, 0x1c, 0x81, 0xf1, 0x34, 0x0e, 0x51, 0x82,
+	0x4d, 0xb9, 0x72, 0x4d, 0x66, 0xa1, 0xc2, 0x3c, 0x5e, 0xab, 0x34, 0xac, 0x66, 0xc5, 0x15, 0x4b,
+	0x32, 0x03, 0x63, 0x2c, 0xa8, 0x8d, 0x4b, 0x9b, 0x31, 0x16, 0xd0, 0x1f, 0xac, 0x32, 0x5b, 0x39,
+	0x0c, 0x66, 0xb6, 0x06, 0x4c, 0x07, 0x98, 0xf9, 0x29, 0x4b, 0x84, 0xa3, 0x9a, 0x74, 0x70, 0xab,
+	0xd0, 0x53, 0x19, 0xd0, 0xb3, 0x04, 0x53, 0x78, 0x9a, 0xb0, 0x14, 0xb3, 0xb7, 0x23, 0x29, 0xa2,
+	0xe2, 0xf6, 0x37, 0xb4, 0xb6, 0x89, 0x42, 0xdb, 0x6b, 0x30, 0x37, 0x28, 0xcd, 0xc5, 0x2c, 0x89,
+	0xa3, 0x0c, 0xc9, 0x1c, 0x4c, 0x70, 0xb1, 0xa1, 0x35, 0xa9, 0x07, 0x4a, 0xe1, 0xa6, 0xb6, 0x7e,
+	0xda, 0xc1, 0xb4, 0x27, 0xf8, 0x23, 0xef, 0x04, 0xb5, 0x91, 0x5c, 0xd3, 0xcf, 0x0a, 0xc4, 0xf7,
+	0x92, 0xe0, 0xff, 0x4d, 0x37, 0x7d, 0x09, 0x6e, 0xed, 0x9d, 0x24, 0xbc, 0x97, 0xbb, 0x41, 0xd7,
+	0x60, 0xf6, 0xb0, 0x17, 0xf9, 0xef, 0xb3, 0x28, 0x88, 0x9f, 0x67, 0x66, 0xd1, 0x3d, 0xb8, 0x33,
+	0x60, 0x57, 0x44, 0xe1, 0x08, 0x26, 0x9f, 0xab, 0xad, 0x9a, 0xd5, 0xa8, 0x5c, 0x5f, 0x73, 0x9f,
+	0xc3, 0xcd, 0x81, 0xe9, 0x0e, 0x2c, 0xf4, 0xb7, 0x9f, 0x60, 0xca, 0xe2, 0xc0, 0x2c, 0x54, 0xe4,
+	0xa5, 0x13, 0x71, 0x16, 0xea, 0x6a, 0x50, 0x0f, 0xf4, 0x4f, 0x0b, 0x6e, 0x0f, 0x81, 0xe8, 0x5c,
+	0x8b, 0xd3, 0x13, 0x22, 0xd7, 0xe4, 0x13, 0xa8, 0x2a, 0x52, 0x79, 0x78, 0x94, 0xce, 0x68, 0x5c,
+	0x72, 0x0c, 0x93, 0x89, 0x22, 0xaf, 0x55, 0x64, 0xbc, 0xde, 0x19, 0x15, 0x85, 0xf2, 0xc9, 0xcd,
+	0xe1, 0xe9, 0x53, 0x58, 0x1c, 0x72, 0xb8, 0x48, 0xdb, 0x83, 0xcb, 0x69, 0xab, 0xdb, 0x79, 0xc7,
+	0x1d, 0x3e, 0x54, 0x24, 0xe2, 0x14, 0x16, 0xf6, 0xc3, 0xf8, 0xc8, 0x0b, 0x75, 0x59, 0xf5, 0xf1,
+	0x3e, 0x82, 0x09, 0xc6, 0xf1, 0x64, 0x44, 0x45, 0x30, 0x50, 0xb8, 0x0a, 0x96, 0xfe, 0x5c, 0x81,
+	0xda, 0x63, 0xe4, 0x1e, 0x0b, 0x31, 0x18, 0x22, 0x4f, 0x60, 0xa6, 0x55, 0x92, 0x35, 0x72, 0x15,
+	0x97, 0xf0, 0x07, 0xdf, 0xd4, 0xb1, 0xff, 0xaa, 0x31, 0x87, 0x70, 0x33, 0xc5, 0x24, 0xce, 0x18,
+	0x8f, 0x53, 0x86, 0x79, 0xb9, 0x5c, 0x93, 0xc8, 0xcd, 0x11, 0x7b, 0x6e, 0x09, 0x9d, 0x78, 0x70,
+	0xc3, 0x0f, 0x3b, 0x19, 0xc7, 0x34, 0xab, 0x8d, 0x4b, 0xa6, 0xbd, 0xeb, 0x31, 0xed, 0x2a, 0x34,
+	0xb7, 0x80, 0xa5, 0x1b, 0x70, 0xf7, 0x80, 0x65, 0x5c, 0x3b, 0x7a, 0xc0, 0xa2, 0x76, 0x96, 0x77,
+	0xbe, 0x2b, 0xde, 0xe3, 0xad, 0x9f, 0x6e, 0xc1, 0x8c, 0xb6, 0x3d, 0xc4, 0xb4, 0xcb, 0x7c, 0x24,
+	0x5f, 0x5b, 0x30, 0xad, 0xae, 0x06, 0xd9, 0x8a, 0x09, 0x2d, 0x8a, 0xd6, 0x78, 0x79, 0xd4, 0x97,
+	0xaf, 0xb4, 0x29, 0xda, 0xdf, 0xc3, 0xcf, 0x7f, 0xfb, 0xe3, 0xfb, 0xb1, 0xad, 0x47, 0xd6, 0x3a,
+	0xdd, 0x90, 0x73, 0x43, 0x77, 0x33, 0x9f, 0x3d, 0x32, 0xe7, 0x4c, 0xaf, 0xce, 0x1d, 0x71, 0x6f,
+	0x64, 0xce, 0x99, 0xf8, 0x39, 0x77, 0x64, 0xa7, 0x27, 0x5f, 0x5a, 0x30, 0xad, 0x6e, 0xc5, 0xbf,
+	0x13, 0x53, 0xba, 0x37, 0xeb, 0x0b, 0x85, 0x4d, 0xb9, 0x09, 0xbf, 0x21, 0x55, 0xbc, 0xbe, 0xbe,
+	0xfd, 0xaf, 0x24, 0x38, 0x67, 0xcc, 0xe3, 0xe7, 0xe4, 0x1b, 0x0b, 0xaa, 0xca, 0x67, 0x32, 0xe4,
+	0x6c, 0x39, 0x16, 0x23, 0xab, 0x52, 0xfa, 0xb2, 0x14, 0x3c, 0x4f, 0x67, 0x2f, 0x0b, 0x7e, 0x64,
+	0xad, 0x93, 0x2f, 0x2c, 0x18, 0x17, 0x99, 0x26, 0xf3, 0x97, 0xe5, 0xc8, 0xae, 0x5d, 0x3f, 0x18,
+	0x95, 0x0c, 0x41, 0x42, 0x6b, 0x52, 0x0a, 0x21, 0x43, 0x52, 0xc8, 0x29, 0x90, 0x7d, 0xe4, 0x97,
+	0xda, 0x86, 0x49, 0xd4, 0x2b, 0xc5, 0xb6, 0xa9, 0xcf, 0xd0, 0xa6, 0x64, 0xa2, 0xa4, 0x31, 0x9c,
+	0x25, 0x51, 0xb1, 0xe7, 0x4e, 0xa0, 0x4f, 0x92, 0xaf, 0x2c, 0xa8, 0xec, 0xa3, 0x91, 0x6b, 0x74,
+	0x79, 0x58, 0x95, 0x92, 0x16, 0xc9, 0x5d, 0x83, 0x24, 0x72, 0x06, 0xb7, 0xf7, 0x91, 0x97, 0xbb,
+	0xb6, 0x49, 0xd6, 0x6a, 0xb1, 0x7d, 0x75, 0x97, 0xa7, 0xb6, 0x64, 0x6b, 0x92, 0x35, 0x53, 0x00,
+	0x54, 0x9b, 0x2c, 0x12, 0xf0, 0xa3, 0x05, 0x55, 0x35, 0xe2, 0x0c, 0x57, 0x66, 0x69, 0xf4, 0x19,
+	0x61, 0x44, 0xb6, 0xa5, 0xc6, 0x8d, 0x7a, 0xd3, 0xf8, 0x2a, 0xd9, 0x27, 0xc8, 0xbd, 0xc0, 0xe3,
+	0x9e, 0x2d, 0x45, 0x8b, 0x8a, 0xfd, 0x00, 0xaa, 0xea, 0x45, 0x35, 0x85, 0xc6, 0xf4, 0xe2, 0xea,
+	0xf8, 0xaf, 0x1b, 0xe3, 0xff, 0x0c, 0x40, 0x54, 0xe9, 0x5e, 0x17, 0x23, 0x73, 0xe0, 0x97, 0x6d,
+	0xf5, 0xe1, 0x22, 0x3c, 0xb4, 0xfd, 0x38, 0x45, 0xbb, 0xbb, 0x69, 0xcb, 0x23, 0xb2, 0xc2, 0xd7,
+	0x24, 0x49, 0x83, 0xac, 0x98, 0xc2, 0x8e, 0x0a, 0xfd, 0x0c, 0xee, 0xec, 0x23, 0x1f, 0x98, 0xd2,
+	0x0e, 0xb9, 0x08, 0xfd, 0xe2, 0x15, 0x57, 0xbb, 0x9a, 0x9f, 0xea, 0x4b, 0x57, 0xfd, 0x55, 0x38,
+	0x77, 0x5f, 0xf2, 0xde, 0x23, 0xaf, 0x9a, 0x78, 0xb3, 0x5e, 0xe4, 0xeb, 0xd9, 0x80, 0x7c, 0x6b,
+	0xc1, 0xbc, 0x50, 0x3b, 0x3c, 0x64, 0xad, 0x9a, 0x47, 0x0b, 0xa5, 0x82, 0x9a, 0x0d, 0x0a, 0x2d,
+	0x3a, 0xad, 0xe4, 0xfe, 0x0b, 0x68, 0x71, 0xf4, 0x08, 0x44, 0x12, 0x98, 0x12, 0x92, 0xe4, 0x55,
+	0x43, 0x1a, 0x05, 0x8b, 0xe1, 0x16, 0xaa, 0xd7, 0x4b, 0xc5, 0xa5, 0xff, 0xd2, 0xfc, 0xf7, 0x24,
+	0xff, 0x2a, 0x59, 0x36, 0xf1, 0x87, 0xc2, 0x7c, 0x67, 0xe7, 0x97, 0x8b, 0x15, 0xeb, 0xd7, 0x8b,
+	0x15, 0xeb, 0xf7, 0x8b, 0x15, 0xeb, 0xc3, 0x07, 0x2f, 0xf6, 0xad, 0xe9, 0x87, 0x0c, 0xa3, 0xe2,
+	0x93, 0xf7, 0xa8, 0x2a, 0xbf, 0x0a, 0xb7, 0xff, 0x1a, 0x00, 0xea, 0x15, 0x39, 0xb0, 0x13, 0x0f,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -802,6 +982,8 @@ type ProjectServiceClient interface {
 	ListEvents(ctx context.Context, in *ProjectQuery, opts ...grpc.CallOption) (*v1.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(ctx context.Context, in *SyncWindowsQuery, opts ...grpc.CallOption) (*SyncWindowsResponse, error)
+	// ListSyncWindowPeriods returns the upcoming periods of time during which the sync windows of a project are active
+	ListSyncWindowPeriods(ctx context.Context, in *SyncWindowPeriodsQuery, opts ...grpc.CallOption) (*SyncWindowPeriodsResponse, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error)
 }
@@ -913,6 +1095,15 @@ func (c *projectServiceClient) GetSyncWindowsState(ctx context.Context, in *Sync
 	return out, nil
 }
 
+func (c *projectServiceClient) ListSyncWindowPeriods(ctx context.Context, in *SyncWindowPeriodsQuery, opts ...grpc.CallOption) (*SyncWindowPeriodsResponse, error) {
+	out := new(SyncWindowPeriodsResponse)
+	err := c.cc.Invoke(ctx, "/project.ProjectService/ListSyncWindowPeriods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *projectServiceClient) ListLinks(ctx context.Context, in *ListProjectLinksRequest, opts ...grpc.CallOption) (*application.LinksResponse, error) {
 	out := new(application.LinksResponse)
 	err := c.cc.Invoke(ctx, "/project.ProjectService/ListLinks", in, out, opts...)
@@ -946,6 +1137,8 @@ type ProjectServiceServer interface {
 	ListEvents(context.Context, *ProjectQuery) (*v1.EventList, error)
 	// GetSchedulesState returns true if there are any active sync syncWindows
 	GetSyncWindowsState(context.Context, *SyncWindowsQuery) (*SyncWindowsResponse, error)
+	// ListSyncWindowPeriods returns the upcoming periods of time during which the sync windows of a project are active
+	ListSyncWindowPeriods(context.Context, *SyncWindowPeriodsQuery) (*SyncWindowPeriodsResponse, error)
 	// ListLinks returns all deep links for the particular project
 	ListLinks(context.Context, *ListProjectLinksRequest) (*application.LinksResponse, error)
 }
@@ -987,6 +1180,9 @@ func (*UnimplementedProjectServiceServer) ListEvents(ctx context.Context, req *P
 func (*UnimplementedProjectServiceServer) GetSyncWindowsState(ctx context.Context, req *SyncWindowsQuery) (*SyncWindowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSyncWindowsState not implemented")
 }
+func (*UnimplementedProjectServiceServer) ListSyncWindowPeriods(ctx context.Context, req *SyncWindowPeriodsQuery) (*SyncWindowPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSyncWindowPeriods not implemented")
+}
 func (*UnimplementedProjectServiceServer) ListLinks(ctx context.Context, req *ListProjectLinksRequest) (*application.LinksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
 }
@@ -1193,6 +1389,24 @@ func _ProjectService_GetSyncWindowsState_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProjectService_ListSyncWindowPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncWindowPeriodsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectServiceServer).ListSyncWindowPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/project.ProjectService/ListSyncWindowPeriods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectServiceServer).ListSyncWindowPeriods(ctx, req.(*SyncWindowPeriodsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProjectService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectLinksRequest)
 	if err := dec(in); err != nil {
@@ -1259,6 +1473,10 @@ var _ProjectService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSyncWindowsState",
 			Handler:    _ProjectService_GetSyncWindowsState_Handler,
 		},
+		{
+			MethodName: "ListSyncWindowPeriods",
+			Handler:    _ProjectService_ListSyncWindowPeriods_Handler,
+		},
 		{
 			MethodName: "ListLinks",
 			Handler:    _ProjectService_ListLinks_Handler,
@@ -1639,6 +1857,146 @@ func (m *SyncWindowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWindowPeriodsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowPeriodsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowPeriodsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Until) > 0 {
+		i -= len(m.Until)
+		copy(dAtA[i:], m.Until)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Until)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProject(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWindowPeriods) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowPeriods) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowPeriods) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProject(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWindowPeriodsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowPeriodsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowPeriodsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProject(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GlobalProjectsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1977,18 +2335,81 @@ func (m *SyncWindowsResponse) Size() (n int) {
 	return n
 }
 
-func (m *GlobalProjectsResponse) Size() (n int) {
+func (m *SyncWindowPeriodsQuery) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovProject(uint64(l))
-		}
-	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	l = len(m.Until)
+	if l > 0 {
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncWindowPeriods) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovProject(uint64(m.Id))
+	}
+	if m.Window != nil {
+		l = m.Window.Size()
+		n += 1 + l + sovProject(uint64(l))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncWindowPeriodsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GlobalProjectsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2994,6 +3415,346 @@ func (m *SyncWindowsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SyncWindowPeriodsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowPeriodsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowPeriodsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Until = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowPeriods) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowPeriods: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowPeriods: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Window == nil {
+				m.Window = &v1alpha1.SyncWindow{}
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Periods = append(m.Periods, &v1alpha1.SyncWindowPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowPeriodsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProject
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowPeriodsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowPeriodsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Windows = append(m.Windows, &SyncWindowPeriods{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProject(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProject
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GlobalProjectsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
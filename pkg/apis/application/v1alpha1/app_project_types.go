@@ -251,6 +251,29 @@ func (proj *AppProject) ValidateProject() error {
 		roleNames[role.Name] = true
 	}
 
+	resourcePolicyNames := make(map[string]bool)
+	for _, policy := range proj.Spec.ResourcePolicies {
+		if policy.Name == "" {
+			return status.Errorf(codes.InvalidArgument, "resource policy has no name")
+		}
+		if _, ok := resourcePolicyNames[policy.Name]; ok {
+			return status.Errorf(codes.AlreadyExists, "resource policy '%s' already exists", policy.Name)
+		}
+		if policy.Condition == "" {
+			return status.Errorf(codes.InvalidArgument, "resource policy '%s' has no condition", policy.Name)
+		}
+		if policy.Mode != "" && policy.Mode != ResourcePolicyModeEnforce && policy.Mode != ResourcePolicyModeWarn {
+			return status.Errorf(codes.InvalidArgument, "resource policy '%s' has an invalid mode '%s', must be '%s' or '%s'", policy.Name, policy.Mode, ResourcePolicyModeEnforce, ResourcePolicyModeWarn)
+		}
+		resourcePolicyNames[policy.Name] = true
+	}
+
+	switch proj.Spec.QueuePriority {
+	case "", QueuePriorityHigh, QueuePriorityNormal, QueuePriorityLow:
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid queue priority '%s', must be '%s', '%s' or '%s'", proj.Spec.QueuePriority, QueuePriorityHigh, QueuePriorityNormal, QueuePriorityLow)
+	}
+
 	if proj.Spec.SyncWindows.HasWindows() {
 		existingWindows := make(map[string]bool)
 		for _, window := range proj.Spec.SyncWindows {
@@ -608,3 +631,14 @@ func (proj AppProject) IsAppNamespacePermitted(app *Application, controllerNs st
 
 	return glob.MatchStringInList(proj.Spec.SourceNamespaces, app.Namespace, glob.REGEXP)
 }
+
+// AppliesTo returns whether the resource policy applies to the resources of the given group and kind
+func (p *ResourcePolicy) AppliesTo(gk schema.GroupKind) bool {
+	return len(p.Kinds) == 0 || isResourceInList(metav1.GroupKind{Group: gk.Group, Kind: gk.Kind}, p.Kinds)
+}
+
+// IsEnforced returns whether the applications with manifests violating the resource policy are prevented from being
+// synced
+func (p *ResourcePolicy) IsEnforced() bool {
+	return p.Mode != ResourcePolicyModeWarn
+}
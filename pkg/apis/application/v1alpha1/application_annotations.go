@@ -12,4 +12,19 @@ const (
 	// absolute path means an absolute path within the repository and the relative path is relative to the application
 	// source path within the repository.
 	AnnotationKeyManifestGeneratePaths = "argocd.argoproj.io/manifest-generate-paths"
+
+	// AnnotationKeyQueuePriority is the annotation key which lowers the priority tier of the app in the refresh and
+	// operation queues of the application controller, when queue priorities are enabled. The tier cannot be raised
+	// above the queuePriority of the project of the app.
+	// Might take values 'high'/'normal'/'low'.
+	AnnotationKeyQueuePriority = "argocd.argoproj.io/queue-priority"
+)
+
+const (
+	// QueuePriorityHigh is the priority tier of the apps processed before the other ones
+	QueuePriorityHigh = "high"
+	// QueuePriorityNormal is the default priority tier
+	QueuePriorityNormal = "normal"
+	// QueuePriorityLow is the priority tier of the apps processed after the other ones
+	QueuePriorityLow = "low"
 )
@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/util/security"
@@ -85,15 +86,66 @@ type ApplicationPreservedFields struct {
 type ApplicationSetStrategy struct {
 	Type        string                         `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
 	RollingSync *ApplicationSetRolloutStrategy `json:"rollingSync,omitempty" protobuf:"bytes,2,opt,name=rollingSync"`
-	// RollingUpdate *ApplicationSetRolloutStrategy `json:"rollingUpdate,omitempty" protobuf:"bytes,3,opt,name=rollingUpdate"`
+	// RollingUpdate applies the changes to the spec of the generated Applications step by step. Only the steps of the
+	// strategy are used.
+	RollingUpdate *ApplicationSetRolloutStrategy `json:"rollingUpdate,omitempty" protobuf:"bytes,3,opt,name=rollingUpdate"`
 }
 type ApplicationSetRolloutStrategy struct {
 	Steps []ApplicationSetRolloutStep `json:"steps,omitempty" protobuf:"bytes,1,opt,name=steps"`
+	// RollbackOnFailure rolls the Applications updated by the rollout back to their previous revision when a step
+	// becomes Degraded, and halts the rollout until the step is promoted or the ApplicationSet is modified
+	RollbackOnFailure bool `json:"rollbackOnFailure,omitempty" protobuf:"varint,2,opt,name=rollbackOnFailure"`
 }
 
 type ApplicationSetRolloutStep struct {
 	MatchExpressions []ApplicationMatchExpression `json:"matchExpressions,omitempty" protobuf:"bytes,1,opt,name=matchExpressions"`
 	MaxUpdate        *intstr.IntOrString          `json:"maxUpdate,omitempty" protobuf:"bytes,2,opt,name=maxUpdate"`
+	// PauseDuration is the soak time the Applications of the step must remain Healthy before the next step starts,
+	// e.g. 30m or 1h
+	PauseDuration string `json:"pauseDuration,omitempty" protobuf:"bytes,3,opt,name=pauseDuration"`
+	// RequireApproval holds the step until it is promoted with `argocd appset promote`
+	RequireApproval bool `json:"requireApproval,omitempty" protobuf:"varint,4,opt,name=requireApproval"`
+	// Analysis is a health check run while the step is paused. A failed check halts the rollout.
+	Analysis *ApplicationSetRolloutAnalysis `json:"analysis,omitempty" protobuf:"bytes,5,opt,name=analysis"`
+}
+
+// ApplicationSetRolloutAnalysis is an HTTP health check deciding whether a rollout step may complete. The URL receives
+// a POST request with the ApplicationSet, the step and its Applications as JSON. Any response status other than 2xx
+// fails the check.
+type ApplicationSetRolloutAnalysis struct {
+	// URL is the endpoint of the health check
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Interval is the interval at which the health check is run while the step is paused, e.g. 30s. Defaults to 1m.
+	Interval string `json:"interval,omitempty" protobuf:"bytes,2,opt,name=interval"`
+}
+
+const defaultRolloutAnalysisInterval = time.Minute
+
+// GetPauseDuration returns the soak time of the step, or 0 if it has none.
+func (s *ApplicationSetRolloutStep) GetPauseDuration() (time.Duration, error) {
+	if s.PauseDuration == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(s.PauseDuration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid pauseDuration %q: %w", s.PauseDuration, err)
+	}
+	return duration, nil
+}
+
+// GetInterval returns the interval at which the health check is run.
+func (a *ApplicationSetRolloutAnalysis) GetInterval() (time.Duration, error) {
+	if a.Interval == "" {
+		return defaultRolloutAnalysisInterval, nil
+	}
+	interval, err := time.ParseDuration(a.Interval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid analysis interval %q: %w", a.Interval, err)
+	}
+	if interval <= 0 {
+		return 0, fmt.Errorf("invalid analysis interval %q: must be positive", a.Interval)
+	}
+	return interval, nil
 }
 
 type ApplicationMatchExpression struct {
@@ -135,6 +187,9 @@ type ApplicationSetSyncPolicy struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:Enum=create-only;create-update;create-delete;sync
 	ApplicationsSync *ApplicationsSyncPolicy `json:"applicationsSync,omitempty" protobuf:"bytes,2,opt,name=applicationsSync,casttype=ApplicationsSyncPolicy"`
+	// MaxDeletions is the maximum number of Applications deleted by a reconcile, either an absolute number or a percentage of the Applications of the ApplicationSet.
+	// When more Applications would be deleted, none of them is deleted until the deletions are approved.
+	MaxDeletions *intstr.IntOrString `json:"maxDeletions,omitempty" protobuf:"bytes,3,opt,name=maxDeletions"`
 }
 
 // ApplicationSetIgnoreDifferences configures how the ApplicationSet controller will ignore differences in live
@@ -201,6 +256,12 @@ type ApplicationSetGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	OCI *OCIGenerator `json:"oci,omitempty" protobuf:"bytes,11,name=oci"`
+
+	HelmRepository *HelmRepositoryGenerator `json:"helmRepository,omitempty" protobuf:"bytes,12,name=helmRepository"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,13,name=kubernetesResource"`
 }
 
 // ApplicationSetNestedGenerator represents a generator nested within a combination-type generator (MatrixGenerator or
@@ -223,6 +284,12 @@ type ApplicationSetNestedGenerator struct {
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,9,name=selector"`
 
 	Plugin *PluginGenerator `json:"plugin,omitempty" protobuf:"bytes,10,name=plugin"`
+
+	OCI *OCIGenerator `json:"oci,omitempty" protobuf:"bytes,11,name=oci"`
+
+	HelmRepository *HelmRepositoryGenerator `json:"helmRepository,omitempty" protobuf:"bytes,12,name=helmRepository"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,13,name=kubernetesResource"`
 }
 
 type ApplicationSetNestedGenerators []ApplicationSetNestedGenerator
@@ -242,6 +309,12 @@ type ApplicationSetTerminalGenerator struct {
 
 	// Selector allows to post-filter all generator.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,8,name=selector"`
+
+	OCI *OCIGenerator `json:"oci,omitempty" protobuf:"bytes,9,name=oci"`
+
+	HelmRepository *HelmRepositoryGenerator `json:"helmRepository,omitempty" protobuf:"bytes,10,name=helmRepository"`
+
+	KubernetesResource *KubernetesResourceGenerator `json:"kubernetesResource,omitempty" protobuf:"bytes,11,name=kubernetesResource"`
 }
 
 type ApplicationSetTerminalGenerators []ApplicationSetTerminalGenerator
@@ -260,6 +333,9 @@ func (g ApplicationSetTerminalGenerators) toApplicationSetNestedGenerators() []A
 			ClusterDecisionResource: terminalGenerator.ClusterDecisionResource,
 			PullRequest:             terminalGenerator.PullRequest,
 			Plugin:                  terminalGenerator.Plugin,
+			OCI:                     terminalGenerator.OCI,
+			HelmRepository:          terminalGenerator.HelmRepository,
+			KubernetesResource:      terminalGenerator.KubernetesResource,
 			Selector:                terminalGenerator.Selector,
 		}
 	}
@@ -384,6 +460,20 @@ type ClusterGenerator struct {
 
 	// returns the clusters a single 'clusters' value in the template
 	FlatList bool `json:"flatList,omitempty" protobuf:"bytes,4,name=flatList"`
+
+	// Info adds the information the application controller records about each cluster, such as its Kubernetes version
+	// and the API versions it serves, to the parameters
+	Info bool `json:"info,omitempty" protobuf:"varint,5,opt,name=info"`
+	// RequiredAPIVersions restricts the clusters to those serving all the given API versions, as recorded by the
+	// application controller, e.g. gateway.networking.k8s.io/v1
+	RequiredAPIVersions []string `json:"requiredAPIVersions,omitempty" protobuf:"bytes,6,rep,name=requiredAPIVersions"`
+	// SkipUnreachable skips the clusters the application controller failed to connect to
+	SkipUnreachable bool `json:"skipUnreachable,omitempty" protobuf:"varint,7,opt,name=skipUnreachable"`
+}
+
+// UsesClusterInfo returns whether the generator relies on the information recorded about the clusters
+func (g *ClusterGenerator) UsesClusterInfo() bool {
+	return g.Info || len(g.RequiredAPIVersions) > 0 || g.SkipUnreachable
 }
 
 // DuckType defines a generator to match against clusters registered with ArgoCD.
@@ -402,6 +492,32 @@ type DuckTypeGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,6,name=values"`
 }
 
+// KubernetesResourceGenerator generates parameters from the Kubernetes resources of any kind. The ApplicationSet is
+// reconciled as soon as one of the resources changes.
+type KubernetesResourceGenerator struct {
+	// APIVersion is the group and version of the resources, e.g. example.com/v1
+	APIVersion string `json:"apiVersion" protobuf:"bytes,1,opt,name=apiVersion"`
+	// Kind is the kind of the resources, e.g. Tenant
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Namespace is the namespace of the resources. The resources of all namespaces are selected if it is not set.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// Cluster is the name or the server URL of the Argo CD cluster to read the resources from. The resources are read
+	// from the cluster the ApplicationSet controller runs in if it is not set.
+	Cluster string `json:"cluster,omitempty" protobuf:"bytes,4,opt,name=cluster"`
+	// LabelSelector selects the resources by label
+	LabelSelector metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,5,opt,name=labelSelector"`
+	// Condition is an expr expression evaluated against each resource, e.g. `spec.enabled && status?.phase == "Ready"`.
+	// Only the resources for which it is true are generated for.
+	Condition string `json:"condition,omitempty" protobuf:"bytes,6,opt,name=condition"`
+	// Params maps parameter names to JSONPath expressions evaluated against each resource, e.g. `{.spec.owner}`
+	Params map[string]string `json:"params,omitempty" protobuf:"bytes,7,rep,name=params"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,8,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,9,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,10,name=values"`
+}
+
 type GitGenerator struct {
 	RepoURL             string                      `json:"repoURL" protobuf:"bytes,1,name=repoURL"`
 	Directories         []GitDirectoryGeneratorItem `json:"directories,omitempty" protobuf:"bytes,2,name=directories"`
@@ -793,6 +909,72 @@ type PluginGenerator struct {
 	Values map[string]string `json:"values,omitempty" protobuf:"bytes,5,name=values"`
 }
 
+// OCIGenerator generates parameters from the repositories and tags of an OCI registry.
+type OCIGenerator struct {
+	// RepoURL is the registry path to scan, e.g. oci://ghcr.io/myorg/charts. The repositories under this path are
+	// listed with the catalog API of the registry, unless Repositories is set.
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Repositories are the repositories to scan, relative to RepoURL. They must be set for registries which do not
+	// support the catalog API.
+	Repositories []string `json:"repositories,omitempty" protobuf:"bytes,2,rep,name=repositories"`
+	// Filters select the repositories and tags to generate for. A tag is generated for if it passes any of the filters.
+	Filters []OCIGeneratorFilter `json:"filters,omitempty" protobuf:"bytes,3,rep,name=filters"`
+	// LatestOnly generates only the tag with the highest semantic version of each repository, among the tags passing
+	// the filters. Tags which are not semantic versions are ignored.
+	LatestOnly bool `json:"latestOnly,omitempty" protobuf:"varint,4,opt,name=latestOnly"`
+	// Credentials for Basic auth
+	BasicAuth *OCIGeneratorBasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,5,opt,name=basicAuth"`
+	// Allow self-signed TLS / Certificates; default: false
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,6,opt,name=insecure"`
+	// PlainHTTP connects to the registry over HTTP instead of HTTPS; default: false
+	PlainHTTP bool `json:"plainHTTP,omitempty" protobuf:"varint,7,opt,name=plainHTTP"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,8,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,9,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,10,name=values"`
+}
+
+// OCIGeneratorFilter is a single repository and tag filter.
+// If multiple filter types are set on a single struct, they will be AND'd together. All filters must
+// pass for a tag to be included.
+type OCIGeneratorFilter struct {
+	// RepositoryMatch is a regular expression matched against the repository name, relative to RepoURL.
+	RepositoryMatch *string `json:"repositoryMatch,omitempty" protobuf:"bytes,1,opt,name=repositoryMatch"`
+	// TagMatch is a regular expression matched against the tag.
+	TagMatch *string `json:"tagMatch,omitempty" protobuf:"bytes,2,opt,name=tagMatch"`
+	// TagSemver is a semantic version constraint matched against the tag, e.g. ">=1.0.0 <2.0.0". Tags which are not
+	// semantic versions do not pass it.
+	TagSemver *string `json:"tagSemver,omitempty" protobuf:"bytes,3,opt,name=tagSemver"`
+}
+
+// OCIGeneratorBasicAuth defines the credentials used to access an OCI registry.
+type OCIGeneratorBasicAuth struct {
+	// Username for Basic auth
+	Username string `json:"username" protobuf:"bytes,1,opt,name=username"`
+	// Password (or access token) reference.
+	PasswordRef *SecretRef `json:"passwordRef" protobuf:"bytes,2,opt,name=passwordRef"`
+}
+
+// HelmRepositoryGenerator generates parameters from the charts of a Helm repository index.
+type HelmRepositoryGenerator struct {
+	// RepoURL is the URL of the Helm repository. The credentials stored for the repository in Argo CD are used to
+	// access it.
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// Charts are the names of the charts to generate for. All the charts of the index are generated for by default.
+	Charts []string `json:"charts,omitempty" protobuf:"bytes,2,rep,name=charts"`
+	// VersionConstraint is a semantic version constraint the chart versions must match, e.g. ">=1.0.0 <2.0.0".
+	// Defaults to any version which is not a pre-release.
+	VersionConstraint string `json:"versionConstraint,omitempty" protobuf:"bytes,3,opt,name=versionConstraint"`
+	// AllVersions generates every version matching VersionConstraint instead of only the latest one.
+	AllVersions bool `json:"allVersions,omitempty" protobuf:"varint,4,opt,name=allVersions"`
+	// Standard parameters.
+	RequeueAfterSeconds *int64                 `json:"requeueAfterSeconds,omitempty" protobuf:"varint,5,opt,name=requeueAfterSeconds"`
+	Template            ApplicationSetTemplate `json:"template,omitempty" protobuf:"bytes,6,opt,name=template"`
+	// Values contains key/value pairs which are passed directly as parameters to the template
+	Values map[string]string `json:"values,omitempty" protobuf:"bytes,7,name=values"`
+}
+
 // ApplicationSetStatus defines the observed state of ApplicationSet
 type ApplicationSetStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
@@ -801,6 +983,8 @@ type ApplicationSetStatus struct {
 	ApplicationStatus []ApplicationSetApplicationStatus `json:"applicationStatus,omitempty" protobuf:"bytes,2,name=applicationStatus"`
 	// Resources is a list of Applications resources managed by this application set.
 	Resources []ResourceStatus `json:"resources,omitempty" protobuf:"bytes,3,opt,name=resources"`
+	// RolloutSteps contains the status of each step of the RollingSync strategy
+	RolloutSteps []ApplicationSetRolloutStepStatus `json:"rolloutSteps,omitempty" protobuf:"bytes,4,rep,name=rolloutSteps"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
@@ -861,6 +1045,10 @@ const (
 	ApplicationSetReasonApplicationSetModified           = "ApplicationSetModified"
 	ApplicationSetReasonApplicationSetRolloutComplete    = "ApplicationSetRolloutComplete"
 	ApplicationSetReasonSyncApplicationError             = "SyncApplicationError"
+	ApplicationSetReasonRolloutDegraded                  = "RolloutDegraded"
+	ApplicationSetReasonRolloutRolledBack                = "RolloutRolledBack"
+	ApplicationSetReasonDeletionThresholdExceeded        = "DeletionThresholdExceeded"
+	ApplicationSetReasonParametersStale                  = "ParametersStale"
 )
 
 // ApplicationSetApplicationStatus contains details about each Application managed by the ApplicationSet
@@ -879,6 +1067,48 @@ type ApplicationSetApplicationStatus struct {
 	TargetRevisions []string `json:"targetRevisions" protobuf:"bytes,6,opt,name=targetrevisions"`
 }
 
+// ApplicationSetRolloutStepStatus contains details about a step of the RollingSync strategy
+type ApplicationSetRolloutStepStatus struct {
+	// Step is the number of the step, starting at 1
+	Step string `json:"step" protobuf:"bytes,1,opt,name=step"`
+	// Status is the status of the step: (Waiting, AwaitingApproval, Progressing, Paused, Healthy, Degraded)
+	Status string `json:"status" protobuf:"bytes,2,opt,name=status"`
+	// Message contains human-readable message indicating details about the status
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// LastTransitionTime is the time the status was last updated
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,4,opt,name=lastTransitionTime"`
+	// Approved is true once a step requiring approval has been promoted
+	Approved bool `json:"approved,omitempty" protobuf:"varint,5,opt,name=approved"`
+	// Rollback contains details about the rollback triggered by the failure of the step
+	Rollback *ApplicationSetRolloutStepRollback `json:"rollback,omitempty" protobuf:"bytes,6,opt,name=rollback"`
+	// LastAnalysisTime is the time the analysis of the paused step was last run
+	LastAnalysisTime *metav1.Time `json:"lastAnalysisTime,omitempty" protobuf:"bytes,7,opt,name=lastAnalysisTime"`
+}
+
+// ApplicationSetRolloutStepRollback contains details about the rollback of the Applications updated by a rollout
+type ApplicationSetRolloutStepRollback struct {
+	// Applications are the names of the Applications rolled back to their previous revision
+	Applications []string `json:"applications,omitempty" protobuf:"bytes,1,rep,name=applications"`
+	// Generation is the generation of the ApplicationSet whose rollout was rolled back
+	Generation int64 `json:"generation" protobuf:"varint,2,opt,name=generation"`
+	// PendingApplications are the names of the Applications whose rollback could not be started yet, e.g. because
+	// another operation was in progress. Their rollback is retried until it starts.
+	PendingApplications []string `json:"pendingApplications,omitempty" protobuf:"bytes,3,rep,name=pendingApplications"`
+	// RolloutStartTime is the time the rolled back rollout started, the Applications are rolled back to their last
+	// deployment before it
+	RolloutStartTime *metav1.Time `json:"rolloutStartTime,omitempty" protobuf:"bytes,4,opt,name=rolloutStartTime"`
+}
+
+// Rollout step statuses
+const (
+	RolloutStepStatusWaiting          = "Waiting"
+	RolloutStepStatusAwaitingApproval = "AwaitingApproval"
+	RolloutStepStatusProgressing      = "Progressing"
+	RolloutStepStatusPaused           = "Paused"
+	RolloutStepStatusHealthy          = "Healthy"
+	RolloutStepStatusDegraded         = "Degraded"
+)
+
 // ApplicationSetList contains a list of ApplicationSet
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:object:root=true
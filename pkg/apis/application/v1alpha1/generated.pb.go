@@ -263,10 +263,38 @@ func (m *ApplicationCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationCondition proto.InternalMessageInfo
 
+func (m *ApplicationDependency) Reset()      { *m = ApplicationDependency{} }
+func (*ApplicationDependency) ProtoMessage() {}
+func (*ApplicationDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{8}
+}
+func (m *ApplicationDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDependency.Merge(m, src)
+}
+func (m *ApplicationDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDependency proto.InternalMessageInfo
+
 func (m *ApplicationDestination) Reset()      { *m = ApplicationDestination{} }
 func (*ApplicationDestination) ProtoMessage() {}
 func (*ApplicationDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{8}
+	return fileDescriptor_c078c3c476799f44, []int{9}
 }
 func (m *ApplicationDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -294,7 +322,7 @@ var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
 func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
 func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{9}
+	return fileDescriptor_c078c3c476799f44, []int{10}
 }
 func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -322,7 +350,7 @@ var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageIn
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{10}
+	return fileDescriptor_c078c3c476799f44, []int{11}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -350,7 +378,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationMatchExpression) Reset()      { *m = ApplicationMatchExpression{} }
 func (*ApplicationMatchExpression) ProtoMessage() {}
 func (*ApplicationMatchExpression) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{11}
+	return fileDescriptor_c078c3c476799f44, []int{12}
 }
 func (m *ApplicationMatchExpression) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +406,7 @@ var xxx_messageInfo_ApplicationMatchExpression proto.InternalMessageInfo
 func (m *ApplicationPreservedFields) Reset()      { *m = ApplicationPreservedFields{} }
 func (*ApplicationPreservedFields) ProtoMessage() {}
 func (*ApplicationPreservedFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{12}
+	return fileDescriptor_c078c3c476799f44, []int{13}
 }
 func (m *ApplicationPreservedFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +434,7 @@ var xxx_messageInfo_ApplicationPreservedFields proto.InternalMessageInfo
 func (m *ApplicationSet) Reset()      { *m = ApplicationSet{} }
 func (*ApplicationSet) ProtoMessage() {}
 func (*ApplicationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{13}
+	return fileDescriptor_c078c3c476799f44, []int{14}
 }
 func (m *ApplicationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +462,7 @@ var xxx_messageInfo_ApplicationSet proto.InternalMessageInfo
 func (m *ApplicationSetApplicationStatus) Reset()      { *m = ApplicationSetApplicationStatus{} }
 func (*ApplicationSetApplicationStatus) ProtoMessage() {}
 func (*ApplicationSetApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{14}
+	return fileDescriptor_c078c3c476799f44, []int{15}
 }
 func (m *ApplicationSetApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -462,7 +490,7 @@ var xxx_messageInfo_ApplicationSetApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSetCondition) Reset()      { *m = ApplicationSetCondition{} }
 func (*ApplicationSetCondition) ProtoMessage() {}
 func (*ApplicationSetCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{15}
+	return fileDescriptor_c078c3c476799f44, []int{16}
 }
 func (m *ApplicationSetCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +518,7 @@ var xxx_messageInfo_ApplicationSetCondition proto.InternalMessageInfo
 func (m *ApplicationSetGenerator) Reset()      { *m = ApplicationSetGenerator{} }
 func (*ApplicationSetGenerator) ProtoMessage() {}
 func (*ApplicationSetGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{16}
+	return fileDescriptor_c078c3c476799f44, []int{17}
 }
 func (m *ApplicationSetGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +546,7 @@ var xxx_messageInfo_ApplicationSetGenerator proto.InternalMessageInfo
 func (m *ApplicationSetList) Reset()      { *m = ApplicationSetList{} }
 func (*ApplicationSetList) ProtoMessage() {}
 func (*ApplicationSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{17}
+	return fileDescriptor_c078c3c476799f44, []int{18}
 }
 func (m *ApplicationSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -546,7 +574,7 @@ var xxx_messageInfo_ApplicationSetList proto.InternalMessageInfo
 func (m *ApplicationSetNestedGenerator) Reset()      { *m = ApplicationSetNestedGenerator{} }
 func (*ApplicationSetNestedGenerator) ProtoMessage() {}
 func (*ApplicationSetNestedGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{18}
+	return fileDescriptor_c078c3c476799f44, []int{19}
 }
 func (m *ApplicationSetNestedGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,7 +604,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Reset() {
 }
 func (*ApplicationSetResourceIgnoreDifferences) ProtoMessage() {}
 func (*ApplicationSetResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{19}
+	return fileDescriptor_c078c3c476799f44, []int{20}
 }
 func (m *ApplicationSetResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -601,10 +629,38 @@ func (m *ApplicationSetResourceIgnoreDifferences) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetResourceIgnoreDifferences proto.InternalMessageInfo
 
+func (m *ApplicationSetRolloutAnalysis) Reset()      { *m = ApplicationSetRolloutAnalysis{} }
+func (*ApplicationSetRolloutAnalysis) ProtoMessage() {}
+func (*ApplicationSetRolloutAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{21}
+}
+func (m *ApplicationSetRolloutAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetRolloutAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutAnalysis.Merge(m, src)
+}
+func (m *ApplicationSetRolloutAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutAnalysis proto.InternalMessageInfo
+
 func (m *ApplicationSetRolloutStep) Reset()      { *m = ApplicationSetRolloutStep{} }
 func (*ApplicationSetRolloutStep) ProtoMessage() {}
 func (*ApplicationSetRolloutStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{20}
+	return fileDescriptor_c078c3c476799f44, []int{22}
 }
 func (m *ApplicationSetRolloutStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,10 +685,66 @@ func (m *ApplicationSetRolloutStep) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationSetRolloutStep proto.InternalMessageInfo
 
+func (m *ApplicationSetRolloutStepRollback) Reset()      { *m = ApplicationSetRolloutStepRollback{} }
+func (*ApplicationSetRolloutStepRollback) ProtoMessage() {}
+func (*ApplicationSetRolloutStepRollback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{23}
+}
+func (m *ApplicationSetRolloutStepRollback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutStepRollback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetRolloutStepRollback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutStepRollback.Merge(m, src)
+}
+func (m *ApplicationSetRolloutStepRollback) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutStepRollback) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutStepRollback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutStepRollback proto.InternalMessageInfo
+
+func (m *ApplicationSetRolloutStepStatus) Reset()      { *m = ApplicationSetRolloutStepStatus{} }
+func (*ApplicationSetRolloutStepStatus) ProtoMessage() {}
+func (*ApplicationSetRolloutStepStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{24}
+}
+func (m *ApplicationSetRolloutStepStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutStepStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationSetRolloutStepStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutStepStatus.Merge(m, src)
+}
+func (m *ApplicationSetRolloutStepStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutStepStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutStepStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutStepStatus proto.InternalMessageInfo
+
 func (m *ApplicationSetRolloutStrategy) Reset()      { *m = ApplicationSetRolloutStrategy{} }
 func (*ApplicationSetRolloutStrategy) ProtoMessage() {}
 func (*ApplicationSetRolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{21}
+	return fileDescriptor_c078c3c476799f44, []int{25}
 }
 func (m *ApplicationSetRolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +772,7 @@ var xxx_messageInfo_ApplicationSetRolloutStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSpec) Reset()      { *m = ApplicationSetSpec{} }
 func (*ApplicationSetSpec) ProtoMessage() {}
 func (*ApplicationSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{22}
+	return fileDescriptor_c078c3c476799f44, []int{26}
 }
 func (m *ApplicationSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +800,7 @@ var xxx_messageInfo_ApplicationSetSpec proto.InternalMessageInfo
 func (m *ApplicationSetStatus) Reset()      { *m = ApplicationSetStatus{} }
 func (*ApplicationSetStatus) ProtoMessage() {}
 func (*ApplicationSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{23}
+	return fileDescriptor_c078c3c476799f44, []int{27}
 }
 func (m *ApplicationSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -716,7 +828,7 @@ var xxx_messageInfo_ApplicationSetStatus proto.InternalMessageInfo
 func (m *ApplicationSetStrategy) Reset()      { *m = ApplicationSetStrategy{} }
 func (*ApplicationSetStrategy) ProtoMessage() {}
 func (*ApplicationSetStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{24}
+	return fileDescriptor_c078c3c476799f44, []int{28}
 }
 func (m *ApplicationSetStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,7 +856,7 @@ var xxx_messageInfo_ApplicationSetStrategy proto.InternalMessageInfo
 func (m *ApplicationSetSyncPolicy) Reset()      { *m = ApplicationSetSyncPolicy{} }
 func (*ApplicationSetSyncPolicy) ProtoMessage() {}
 func (*ApplicationSetSyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{25}
+	return fileDescriptor_c078c3c476799f44, []int{29}
 }
 func (m *ApplicationSetSyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +884,7 @@ var xxx_messageInfo_ApplicationSetSyncPolicy proto.InternalMessageInfo
 func (m *ApplicationSetTemplate) Reset()      { *m = ApplicationSetTemplate{} }
 func (*ApplicationSetTemplate) ProtoMessage() {}
 func (*ApplicationSetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{26}
+	return fileDescriptor_c078c3c476799f44, []int{30}
 }
 func (m *ApplicationSetTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -800,7 +912,7 @@ var xxx_messageInfo_ApplicationSetTemplate proto.InternalMessageInfo
 func (m *ApplicationSetTemplateMeta) Reset()      { *m = ApplicationSetTemplateMeta{} }
 func (*ApplicationSetTemplateMeta) ProtoMessage() {}
 func (*ApplicationSetTemplateMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{27}
+	return fileDescriptor_c078c3c476799f44, []int{31}
 }
 func (m *ApplicationSetTemplateMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +940,7 @@ var xxx_messageInfo_ApplicationSetTemplateMeta proto.InternalMessageInfo
 func (m *ApplicationSetTerminalGenerator) Reset()      { *m = ApplicationSetTerminalGenerator{} }
 func (*ApplicationSetTerminalGenerator) ProtoMessage() {}
 func (*ApplicationSetTerminalGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{28}
+	return fileDescriptor_c078c3c476799f44, []int{32}
 }
 func (m *ApplicationSetTerminalGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,7 +968,7 @@ var xxx_messageInfo_ApplicationSetTerminalGenerator proto.InternalMessageInfo
 func (m *ApplicationSetTree) Reset()      { *m = ApplicationSetTree{} }
 func (*ApplicationSetTree) ProtoMessage() {}
 func (*ApplicationSetTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{29}
+	return fileDescriptor_c078c3c476799f44, []int{33}
 }
 func (m *ApplicationSetTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +996,7 @@ var xxx_messageInfo_ApplicationSetTree proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{30}
+	return fileDescriptor_c078c3c476799f44, []int{34}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -912,7 +1024,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{31}
+	return fileDescriptor_c078c3c476799f44, []int{35}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -940,7 +1052,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{32}
+	return fileDescriptor_c078c3c476799f44, []int{36}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,7 +1080,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{33}
+	return fileDescriptor_c078c3c476799f44, []int{37}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -996,7 +1108,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{34}
+	return fileDescriptor_c078c3c476799f44, []int{38}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1024,7 +1136,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{35}
+	return fileDescriptor_c078c3c476799f44, []int{39}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1164,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSourcePluginParameter) Reset()      { *m = ApplicationSourcePluginParameter{} }
 func (*ApplicationSourcePluginParameter) ProtoMessage() {}
 func (*ApplicationSourcePluginParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{36}
+	return fileDescriptor_c078c3c476799f44, []int{40}
 }
 func (m *ApplicationSourcePluginParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1080,7 +1192,7 @@ var xxx_messageInfo_ApplicationSourcePluginParameter proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{37}
+	return fileDescriptor_c078c3c476799f44, []int{41}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1220,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{38}
+	return fileDescriptor_c078c3c476799f44, []int{42}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,7 +1248,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{39}
+	return fileDescriptor_c078c3c476799f44, []int{43}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1164,7 +1276,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{40}
+	return fileDescriptor_c078c3c476799f44, []int{44}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1304,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{41}
+	return fileDescriptor_c078c3c476799f44, []int{45}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1220,7 +1332,7 @@ var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{42}
+	return fileDescriptor_c078c3c476799f44, []int{46}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1360,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *BasicAuthBitbucketServer) Reset()      { *m = BasicAuthBitbucketServer{} }
 func (*BasicAuthBitbucketServer) ProtoMessage() {}
 func (*BasicAuthBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{43}
+	return fileDescriptor_c078c3c476799f44, []int{47}
 }
 func (m *BasicAuthBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1276,7 +1388,7 @@ var xxx_messageInfo_BasicAuthBitbucketServer proto.InternalMessageInfo
 func (m *BearerTokenBitbucket) Reset()      { *m = BearerTokenBitbucket{} }
 func (*BearerTokenBitbucket) ProtoMessage() {}
 func (*BearerTokenBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{44}
+	return fileDescriptor_c078c3c476799f44, []int{48}
 }
 func (m *BearerTokenBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1304,7 +1416,7 @@ var xxx_messageInfo_BearerTokenBitbucket proto.InternalMessageInfo
 func (m *BearerTokenBitbucketCloud) Reset()      { *m = BearerTokenBitbucketCloud{} }
 func (*BearerTokenBitbucketCloud) ProtoMessage() {}
 func (*BearerTokenBitbucketCloud) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{45}
+	return fileDescriptor_c078c3c476799f44, []int{49}
 }
 func (m *BearerTokenBitbucketCloud) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1444,7 @@ var xxx_messageInfo_BearerTokenBitbucketCloud proto.InternalMessageInfo
 func (m *ChartDetails) Reset()      { *m = ChartDetails{} }
 func (*ChartDetails) ProtoMessage() {}
 func (*ChartDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{46}
+	return fileDescriptor_c078c3c476799f44, []int{50}
 }
 func (m *ChartDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1472,7 @@ var xxx_messageInfo_ChartDetails proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{47}
+	return fileDescriptor_c078c3c476799f44, []int{51}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1388,7 +1500,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{48}
+	return fileDescriptor_c078c3c476799f44, []int{52}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1416,7 +1528,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{49}
+	return fileDescriptor_c078c3c476799f44, []int{53}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,7 +1556,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterGenerator) Reset()      { *m = ClusterGenerator{} }
 func (*ClusterGenerator) ProtoMessage() {}
 func (*ClusterGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{50}
+	return fileDescriptor_c078c3c476799f44, []int{54}
 }
 func (m *ClusterGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1584,7 @@ var xxx_messageInfo_ClusterGenerator proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{51}
+	return fileDescriptor_c078c3c476799f44, []int{55}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1612,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{52}
+	return fileDescriptor_c078c3c476799f44, []int{56}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1528,7 +1640,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{53}
+	return fileDescriptor_c078c3c476799f44, []int{57}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1556,7 +1668,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{54}
+	return fileDescriptor_c078c3c476799f44, []int{58}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1696,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{55}
+	return fileDescriptor_c078c3c476799f44, []int{59}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1724,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{56}
+	return fileDescriptor_c078c3c476799f44, []int{60}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1640,7 +1752,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConfigMapKeyRef) Reset()      { *m = ConfigMapKeyRef{} }
 func (*ConfigMapKeyRef) ProtoMessage() {}
 func (*ConfigMapKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{57}
+	return fileDescriptor_c078c3c476799f44, []int{61}
 }
 func (m *ConfigMapKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1668,7 +1780,7 @@ var xxx_messageInfo_ConfigMapKeyRef proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{58}
+	return fileDescriptor_c078c3c476799f44, []int{62}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1808,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *DrySource) Reset()      { *m = DrySource{} }
 func (*DrySource) ProtoMessage() {}
 func (*DrySource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{59}
+	return fileDescriptor_c078c3c476799f44, []int{63}
 }
 func (m *DrySource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1724,7 +1836,7 @@ var xxx_messageInfo_DrySource proto.InternalMessageInfo
 func (m *DuckTypeGenerator) Reset()      { *m = DuckTypeGenerator{} }
 func (*DuckTypeGenerator) ProtoMessage() {}
 func (*DuckTypeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{60}
+	return fileDescriptor_c078c3c476799f44, []int{64}
 }
 func (m *DuckTypeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1864,7 @@ var xxx_messageInfo_DuckTypeGenerator proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{61}
+	return fileDescriptor_c078c3c476799f44, []int{65}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1892,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ErrApplicationNotAllowedToUseProject) Reset()      { *m = ErrApplicationNotAllowedToUseProject{} }
 func (*ErrApplicationNotAllowedToUseProject) ProtoMessage() {}
 func (*ErrApplicationNotAllowedToUseProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{62}
+	return fileDescriptor_c078c3c476799f44, []int{66}
 }
 func (m *ErrApplicationNotAllowedToUseProject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +1920,7 @@ var xxx_messageInfo_ErrApplicationNotAllowedToUseProject proto.InternalMessageIn
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{63}
+	return fileDescriptor_c078c3c476799f44, []int{67}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +1948,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GitDirectoryGeneratorItem) Reset()      { *m = GitDirectoryGeneratorItem{} }
 func (*GitDirectoryGeneratorItem) ProtoMessage() {}
 func (*GitDirectoryGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{64}
+	return fileDescriptor_c078c3c476799f44, []int{68}
 }
 func (m *GitDirectoryGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +1976,7 @@ var xxx_messageInfo_GitDirectoryGeneratorItem proto.InternalMessageInfo
 func (m *GitFileGeneratorItem) Reset()      { *m = GitFileGeneratorItem{} }
 func (*GitFileGeneratorItem) ProtoMessage() {}
 func (*GitFileGeneratorItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{65}
+	return fileDescriptor_c078c3c476799f44, []int{69}
 }
 func (m *GitFileGeneratorItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +2004,7 @@ var xxx_messageInfo_GitFileGeneratorItem proto.InternalMessageInfo
 func (m *GitGenerator) Reset()      { *m = GitGenerator{} }
 func (*GitGenerator) ProtoMessage() {}
 func (*GitGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{66}
+	return fileDescriptor_c078c3c476799f44, []int{70}
 }
 func (m *GitGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1920,7 +2032,7 @@ var xxx_messageInfo_GitGenerator proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{67}
+	return fileDescriptor_c078c3c476799f44, []int{71}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2060,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{68}
+	return fileDescriptor_c078c3c476799f44, []int{72}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2088,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{69}
+	return fileDescriptor_c078c3c476799f44, []int{73}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2116,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{70}
+	return fileDescriptor_c078c3c476799f44, []int{74}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2144,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{71}
+	return fileDescriptor_c078c3c476799f44, []int{75}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2172,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{72}
+	return fileDescriptor_c078c3c476799f44, []int{76}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2085,10 +2197,38 @@ func (m *HelmParameter) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 
+func (m *HelmRepositoryGenerator) Reset()      { *m = HelmRepositoryGenerator{} }
+func (*HelmRepositoryGenerator) ProtoMessage() {}
+func (*HelmRepositoryGenerator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{77}
+}
+func (m *HelmRepositoryGenerator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HelmRepositoryGenerator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HelmRepositoryGenerator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HelmRepositoryGenerator.Merge(m, src)
+}
+func (m *HelmRepositoryGenerator) XXX_Size() int {
+	return m.Size()
+}
+func (m *HelmRepositoryGenerator) XXX_DiscardUnknown() {
+	xxx_messageInfo_HelmRepositoryGenerator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HelmRepositoryGenerator proto.InternalMessageInfo
+
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{73}
+	return fileDescriptor_c078c3c476799f44, []int{78}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2256,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{74}
+	return fileDescriptor_c078c3c476799f44, []int{79}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2284,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *HydrateOperation) Reset()      { *m = HydrateOperation{} }
 func (*HydrateOperation) ProtoMessage() {}
 func (*HydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{75}
+	return fileDescriptor_c078c3c476799f44, []int{80}
 }
 func (m *HydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2169,10 +2309,38 @@ func (m *HydrateOperation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HydrateOperation proto.InternalMessageInfo
 
+func (m *HydratePullRequest) Reset()      { *m = HydratePullRequest{} }
+func (*HydratePullRequest) ProtoMessage() {}
+func (*HydratePullRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{81}
+}
+func (m *HydratePullRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydratePullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydratePullRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydratePullRequest.Merge(m, src)
+}
+func (m *HydratePullRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydratePullRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydratePullRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydratePullRequest proto.InternalMessageInfo
+
 func (m *HydrateTo) Reset()      { *m = HydrateTo{} }
 func (*HydrateTo) ProtoMessage() {}
 func (*HydrateTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{76}
+	return fileDescriptor_c078c3c476799f44, []int{82}
 }
 func (m *HydrateTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2197,10 +2365,38 @@ func (m *HydrateTo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HydrateTo proto.InternalMessageInfo
 
+func (m *HydratedManifestLayout) Reset()      { *m = HydratedManifestLayout{} }
+func (*HydratedManifestLayout) ProtoMessage() {}
+func (*HydratedManifestLayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{83}
+}
+func (m *HydratedManifestLayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HydratedManifestLayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HydratedManifestLayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HydratedManifestLayout.Merge(m, src)
+}
+func (m *HydratedManifestLayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *HydratedManifestLayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_HydratedManifestLayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HydratedManifestLayout proto.InternalMessageInfo
+
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{77}
+	return fileDescriptor_c078c3c476799f44, []int{84}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2424,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{78}
+	return fileDescriptor_c078c3c476799f44, []int{85}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2452,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{79}
+	return fileDescriptor_c078c3c476799f44, []int{86}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2480,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{80}
+	return fileDescriptor_c078c3c476799f44, []int{87}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2508,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{81}
+	return fileDescriptor_c078c3c476799f44, []int{88}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2536,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{82}
+	return fileDescriptor_c078c3c476799f44, []int{89}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2365,10 +2561,38 @@ func (m *KnownTypeField) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 
+func (m *KubernetesResourceGenerator) Reset()      { *m = KubernetesResourceGenerator{} }
+func (*KubernetesResourceGenerator) ProtoMessage() {}
+func (*KubernetesResourceGenerator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{90}
+}
+func (m *KubernetesResourceGenerator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KubernetesResourceGenerator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KubernetesResourceGenerator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubernetesResourceGenerator.Merge(m, src)
+}
+func (m *KubernetesResourceGenerator) XXX_Size() int {
+	return m.Size()
+}
+func (m *KubernetesResourceGenerator) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubernetesResourceGenerator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KubernetesResourceGenerator proto.InternalMessageInfo
+
 func (m *KustomizeGvk) Reset()      { *m = KustomizeGvk{} }
 func (*KustomizeGvk) ProtoMessage() {}
 func (*KustomizeGvk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{83}
+	return fileDescriptor_c078c3c476799f44, []int{91}
 }
 func (m *KustomizeGvk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2620,7 @@ var xxx_messageInfo_KustomizeGvk proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{84}
+	return fileDescriptor_c078c3c476799f44, []int{92}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2648,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *KustomizePatch) Reset()      { *m = KustomizePatch{} }
 func (*KustomizePatch) ProtoMessage() {}
 func (*KustomizePatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{85}
+	return fileDescriptor_c078c3c476799f44, []int{93}
 }
 func (m *KustomizePatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2676,7 @@ var xxx_messageInfo_KustomizePatch proto.InternalMessageInfo
 func (m *KustomizeReplica) Reset()      { *m = KustomizeReplica{} }
 func (*KustomizeReplica) ProtoMessage() {}
 func (*KustomizeReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{86}
+	return fileDescriptor_c078c3c476799f44, []int{94}
 }
 func (m *KustomizeReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2704,7 @@ var xxx_messageInfo_KustomizeReplica proto.InternalMessageInfo
 func (m *KustomizeResId) Reset()      { *m = KustomizeResId{} }
 func (*KustomizeResId) ProtoMessage() {}
 func (*KustomizeResId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{87}
+	return fileDescriptor_c078c3c476799f44, []int{95}
 }
 func (m *KustomizeResId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2732,7 @@ var xxx_messageInfo_KustomizeResId proto.InternalMessageInfo
 func (m *KustomizeSelector) Reset()      { *m = KustomizeSelector{} }
 func (*KustomizeSelector) ProtoMessage() {}
 func (*KustomizeSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{88}
+	return fileDescriptor_c078c3c476799f44, []int{96}
 }
 func (m *KustomizeSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2760,7 @@ var xxx_messageInfo_KustomizeSelector proto.InternalMessageInfo
 func (m *ListGenerator) Reset()      { *m = ListGenerator{} }
 func (*ListGenerator) ProtoMessage() {}
 func (*ListGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{89}
+	return fileDescriptor_c078c3c476799f44, []int{97}
 }
 func (m *ListGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2788,7 @@ var xxx_messageInfo_ListGenerator proto.InternalMessageInfo
 func (m *ManagedNamespaceMetadata) Reset()      { *m = ManagedNamespaceMetadata{} }
 func (*ManagedNamespaceMetadata) ProtoMessage() {}
 func (*ManagedNamespaceMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{90}
+	return fileDescriptor_c078c3c476799f44, []int{98}
 }
 func (m *ManagedNamespaceMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2592,7 +2816,7 @@ var xxx_messageInfo_ManagedNamespaceMetadata proto.InternalMessageInfo
 func (m *MatrixGenerator) Reset()      { *m = MatrixGenerator{} }
 func (*MatrixGenerator) ProtoMessage() {}
 func (*MatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{91}
+	return fileDescriptor_c078c3c476799f44, []int{99}
 }
 func (m *MatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2844,7 @@ var xxx_messageInfo_MatrixGenerator proto.InternalMessageInfo
 func (m *MergeGenerator) Reset()      { *m = MergeGenerator{} }
 func (*MergeGenerator) ProtoMessage() {}
 func (*MergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{92}
+	return fileDescriptor_c078c3c476799f44, []int{100}
 }
 func (m *MergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2872,7 @@ var xxx_messageInfo_MergeGenerator proto.InternalMessageInfo
 func (m *NestedMatrixGenerator) Reset()      { *m = NestedMatrixGenerator{} }
 func (*NestedMatrixGenerator) ProtoMessage() {}
 func (*NestedMatrixGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{93}
+	return fileDescriptor_c078c3c476799f44, []int{101}
 }
 func (m *NestedMatrixGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +2900,7 @@ var xxx_messageInfo_NestedMatrixGenerator proto.InternalMessageInfo
 func (m *NestedMergeGenerator) Reset()      { *m = NestedMergeGenerator{} }
 func (*NestedMergeGenerator) ProtoMessage() {}
 func (*NestedMergeGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{94}
+	return fileDescriptor_c078c3c476799f44, []int{102}
 }
 func (m *NestedMergeGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2701,10 +2925,94 @@ func (m *NestedMergeGenerator) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NestedMergeGenerator proto.InternalMessageInfo
 
+func (m *OCIGenerator) Reset()      { *m = OCIGenerator{} }
+func (*OCIGenerator) ProtoMessage() {}
+func (*OCIGenerator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{103}
+}
+func (m *OCIGenerator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIGenerator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCIGenerator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIGenerator.Merge(m, src)
+}
+func (m *OCIGenerator) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIGenerator) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIGenerator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIGenerator proto.InternalMessageInfo
+
+func (m *OCIGeneratorBasicAuth) Reset()      { *m = OCIGeneratorBasicAuth{} }
+func (*OCIGeneratorBasicAuth) ProtoMessage() {}
+func (*OCIGeneratorBasicAuth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{104}
+}
+func (m *OCIGeneratorBasicAuth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIGeneratorBasicAuth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCIGeneratorBasicAuth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIGeneratorBasicAuth.Merge(m, src)
+}
+func (m *OCIGeneratorBasicAuth) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIGeneratorBasicAuth) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIGeneratorBasicAuth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIGeneratorBasicAuth proto.InternalMessageInfo
+
+func (m *OCIGeneratorFilter) Reset()      { *m = OCIGeneratorFilter{} }
+func (*OCIGeneratorFilter) ProtoMessage() {}
+func (*OCIGeneratorFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{105}
+}
+func (m *OCIGeneratorFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OCIGeneratorFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCIGeneratorFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIGeneratorFilter.Merge(m, src)
+}
+func (m *OCIGeneratorFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIGeneratorFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIGeneratorFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIGeneratorFilter proto.InternalMessageInfo
+
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{95}
+	return fileDescriptor_c078c3c476799f44, []int{106}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +3040,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{96}
+	return fileDescriptor_c078c3c476799f44, []int{107}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +3068,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{97}
+	return fileDescriptor_c078c3c476799f44, []int{108}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +3096,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OptionalArray) Reset()      { *m = OptionalArray{} }
 func (*OptionalArray) ProtoMessage() {}
 func (*OptionalArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{98}
+	return fileDescriptor_c078c3c476799f44, []int{109}
 }
 func (m *OptionalArray) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +3124,7 @@ var xxx_messageInfo_OptionalArray proto.InternalMessageInfo
 func (m *OptionalMap) Reset()      { *m = OptionalMap{} }
 func (*OptionalMap) ProtoMessage() {}
 func (*OptionalMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{99}
+	return fileDescriptor_c078c3c476799f44, []int{110}
 }
 func (m *OptionalMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +3152,7 @@ var xxx_messageInfo_OptionalMap proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{100}
+	return fileDescriptor_c078c3c476799f44, []int{111}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +3180,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{101}
+	return fileDescriptor_c078c3c476799f44, []int{112}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +3208,7 @@ var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{102}
+	return fileDescriptor_c078c3c476799f44, []int{113}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3236,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *PluginConfigMapRef) Reset()      { *m = PluginConfigMapRef{} }
 func (*PluginConfigMapRef) ProtoMessage() {}
 func (*PluginConfigMapRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{103}
+	return fileDescriptor_c078c3c476799f44, []int{114}
 }
 func (m *PluginConfigMapRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3264,7 @@ var xxx_messageInfo_PluginConfigMapRef proto.InternalMessageInfo
 func (m *PluginGenerator) Reset()      { *m = PluginGenerator{} }
 func (*PluginGenerator) ProtoMessage() {}
 func (*PluginGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{104}
+	return fileDescriptor_c078c3c476799f44, []int{115}
 }
 func (m *PluginGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3292,7 @@ var xxx_messageInfo_PluginGenerator proto.InternalMessageInfo
 func (m *PluginInput) Reset()      { *m = PluginInput{} }
 func (*PluginInput) ProtoMessage() {}
 func (*PluginInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{105}
+	return fileDescriptor_c078c3c476799f44, []int{116}
 }
 func (m *PluginInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3320,7 @@ var xxx_messageInfo_PluginInput proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{106}
+	return fileDescriptor_c078c3c476799f44, []int{117}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3348,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *PullRequestGenerator) Reset()      { *m = PullRequestGenerator{} }
 func (*PullRequestGenerator) ProtoMessage() {}
 func (*PullRequestGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{107}
+	return fileDescriptor_c078c3c476799f44, []int{118}
 }
 func (m *PullRequestGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3376,7 @@ var xxx_messageInfo_PullRequestGenerator proto.InternalMessageInfo
 func (m *PullRequestGeneratorAzureDevOps) Reset()      { *m = PullRequestGeneratorAzureDevOps{} }
 func (*PullRequestGeneratorAzureDevOps) ProtoMessage() {}
 func (*PullRequestGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{108}
+	return fileDescriptor_c078c3c476799f44, []int{119}
 }
 func (m *PullRequestGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3404,7 @@ var xxx_messageInfo_PullRequestGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucket) Reset()      { *m = PullRequestGeneratorBitbucket{} }
 func (*PullRequestGeneratorBitbucket) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{109}
+	return fileDescriptor_c078c3c476799f44, []int{120}
 }
 func (m *PullRequestGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3432,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucket proto.InternalMessageInfo
 func (m *PullRequestGeneratorBitbucketServer) Reset()      { *m = PullRequestGeneratorBitbucketServer{} }
 func (*PullRequestGeneratorBitbucketServer) ProtoMessage() {}
 func (*PullRequestGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{110}
+	return fileDescriptor_c078c3c476799f44, []int{121}
 }
 func (m *PullRequestGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3152,7 +3460,7 @@ var xxx_messageInfo_PullRequestGeneratorBitbucketServer proto.InternalMessageInf
 func (m *PullRequestGeneratorFilter) Reset()      { *m = PullRequestGeneratorFilter{} }
 func (*PullRequestGeneratorFilter) ProtoMessage() {}
 func (*PullRequestGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{111}
+	return fileDescriptor_c078c3c476799f44, []int{122}
 }
 func (m *PullRequestGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3488,7 @@ var xxx_messageInfo_PullRequestGeneratorFilter proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitLab) Reset()      { *m = PullRequestGeneratorGitLab{} }
 func (*PullRequestGeneratorGitLab) ProtoMessage() {}
 func (*PullRequestGeneratorGitLab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{112}
+	return fileDescriptor_c078c3c476799f44, []int{123}
 }
 func (m *PullRequestGeneratorGitLab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3516,7 @@ var xxx_messageInfo_PullRequestGeneratorGitLab proto.InternalMessageInfo
 func (m *PullRequestGeneratorGitea) Reset()      { *m = PullRequestGeneratorGitea{} }
 func (*PullRequestGeneratorGitea) ProtoMessage() {}
 func (*PullRequestGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{113}
+	return fileDescriptor_c078c3c476799f44, []int{124}
 }
 func (m *PullRequestGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3544,7 @@ var xxx_messageInfo_PullRequestGeneratorGitea proto.InternalMessageInfo
 func (m *PullRequestGeneratorGithub) Reset()      { *m = PullRequestGeneratorGithub{} }
 func (*PullRequestGeneratorGithub) ProtoMessage() {}
 func (*PullRequestGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{114}
+	return fileDescriptor_c078c3c476799f44, []int{125}
 }
 func (m *PullRequestGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3572,7 @@ var xxx_messageInfo_PullRequestGeneratorGithub proto.InternalMessageInfo
 func (m *RefTarget) Reset()      { *m = RefTarget{} }
 func (*RefTarget) ProtoMessage() {}
 func (*RefTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{115}
+	return fileDescriptor_c078c3c476799f44, []int{126}
 }
 func (m *RefTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3600,7 @@ var xxx_messageInfo_RefTarget proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{116}
+	return fileDescriptor_c078c3c476799f44, []int{127}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3628,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{117}
+	return fileDescriptor_c078c3c476799f44, []int{128}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3656,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{118}
+	return fileDescriptor_c078c3c476799f44, []int{129}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3684,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{119}
+	return fileDescriptor_c078c3c476799f44, []int{130}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3712,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{120}
+	return fileDescriptor_c078c3c476799f44, []int{131}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3740,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{121}
+	return fileDescriptor_c078c3c476799f44, []int{132}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3768,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{122}
+	return fileDescriptor_c078c3c476799f44, []int{133}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3796,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{123}
+	return fileDescriptor_c078c3c476799f44, []int{134}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3824,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{124}
+	return fileDescriptor_c078c3c476799f44, []int{135}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3852,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{125}
+	return fileDescriptor_c078c3c476799f44, []int{136}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3880,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{126}
+	return fileDescriptor_c078c3c476799f44, []int{137}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3908,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{127}
+	return fileDescriptor_c078c3c476799f44, []int{138}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +3936,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{128}
+	return fileDescriptor_c078c3c476799f44, []int{139}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +3964,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{129}
+	return fileDescriptor_c078c3c476799f44, []int{140}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3684,7 +3992,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{130}
+	return fileDescriptor_c078c3c476799f44, []int{141}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3709,10 +4017,38 @@ func (m *ResourceOverride) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 
+func (m *ResourcePolicy) Reset()      { *m = ResourcePolicy{} }
+func (*ResourcePolicy) ProtoMessage() {}
+func (*ResourcePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{142}
+}
+func (m *ResourcePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResourcePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ResourcePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourcePolicy.Merge(m, src)
+}
+func (m *ResourcePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResourcePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourcePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResourcePolicy proto.InternalMessageInfo
+
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{131}
+	return fileDescriptor_c078c3c476799f44, []int{143}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3740,7 +4076,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{132}
+	return fileDescriptor_c078c3c476799f44, []int{144}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3768,7 +4104,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{133}
+	return fileDescriptor_c078c3c476799f44, []int{145}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3796,7 +4132,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{134}
+	return fileDescriptor_c078c3c476799f44, []int{146}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,7 +4160,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{135}
+	return fileDescriptor_c078c3c476799f44, []int{147}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3852,7 +4188,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{136}
+	return fileDescriptor_c078c3c476799f44, []int{148}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3880,7 +4216,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *SCMProviderGenerator) Reset()      { *m = SCMProviderGenerator{} }
 func (*SCMProviderGenerator) ProtoMessage() {}
 func (*SCMProviderGenerator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{137}
+	return fileDescriptor_c078c3c476799f44, []int{149}
 }
 func (m *SCMProviderGenerator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3908,7 +4244,7 @@ var xxx_messageInfo_SCMProviderGenerator proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAWSCodeCommit) Reset()      { *m = SCMProviderGeneratorAWSCodeCommit{} }
 func (*SCMProviderGeneratorAWSCodeCommit) ProtoMessage() {}
 func (*SCMProviderGeneratorAWSCodeCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{138}
+	return fileDescriptor_c078c3c476799f44, []int{150}
 }
 func (m *SCMProviderGeneratorAWSCodeCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3936,7 +4272,7 @@ var xxx_messageInfo_SCMProviderGeneratorAWSCodeCommit proto.InternalMessageInfo
 func (m *SCMProviderGeneratorAzureDevOps) Reset()      { *m = SCMProviderGeneratorAzureDevOps{} }
 func (*SCMProviderGeneratorAzureDevOps) ProtoMessage() {}
 func (*SCMProviderGeneratorAzureDevOps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{139}
+	return fileDescriptor_c078c3c476799f44, []int{151}
 }
 func (m *SCMProviderGeneratorAzureDevOps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3964,7 +4300,7 @@ var xxx_messageInfo_SCMProviderGeneratorAzureDevOps proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucket) Reset()      { *m = SCMProviderGeneratorBitbucket{} }
 func (*SCMProviderGeneratorBitbucket) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{140}
+	return fileDescriptor_c078c3c476799f44, []int{152}
 }
 func (m *SCMProviderGeneratorBitbucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3992,7 +4328,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucket proto.InternalMessageInfo
 func (m *SCMProviderGeneratorBitbucketServer) Reset()      { *m = SCMProviderGeneratorBitbucketServer{} }
 func (*SCMProviderGeneratorBitbucketServer) ProtoMessage() {}
 func (*SCMProviderGeneratorBitbucketServer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{141}
+	return fileDescriptor_c078c3c476799f44, []int{153}
 }
 func (m *SCMProviderGeneratorBitbucketServer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,7 +4356,7 @@ var xxx_messageInfo_SCMProviderGeneratorBitbucketServer proto.InternalMessageInf
 func (m *SCMProviderGeneratorFilter) Reset()      { *m = SCMProviderGeneratorFilter{} }
 func (*SCMProviderGeneratorFilter) ProtoMessage() {}
 func (*SCMProviderGeneratorFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{142}
+	return fileDescriptor_c078c3c476799f44, []int{154}
 }
 func (m *SCMProviderGeneratorFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,7 +4384,7 @@ var xxx_messageInfo_SCMProviderGeneratorFilter proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitea) Reset()      { *m = SCMProviderGeneratorGitea{} }
 func (*SCMProviderGeneratorGitea) ProtoMessage() {}
 func (*SCMProviderGeneratorGitea) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{143}
+	return fileDescriptor_c078c3c476799f44, []int{155}
 }
 func (m *SCMProviderGeneratorGitea) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4412,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitea proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGithub) Reset()      { *m = SCMProviderGeneratorGithub{} }
 func (*SCMProviderGeneratorGithub) ProtoMessage() {}
 func (*SCMProviderGeneratorGithub) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{144}
+	return fileDescriptor_c078c3c476799f44, []int{156}
 }
 func (m *SCMProviderGeneratorGithub) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4104,7 +4440,7 @@ var xxx_messageInfo_SCMProviderGeneratorGithub proto.InternalMessageInfo
 func (m *SCMProviderGeneratorGitlab) Reset()      { *m = SCMProviderGeneratorGitlab{} }
 func (*SCMProviderGeneratorGitlab) ProtoMessage() {}
 func (*SCMProviderGeneratorGitlab) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{145}
+	return fileDescriptor_c078c3c476799f44, []int{157}
 }
 func (m *SCMProviderGeneratorGitlab) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4132,7 +4468,7 @@ var xxx_messageInfo_SCMProviderGeneratorGitlab proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{146}
+	return fileDescriptor_c078c3c476799f44, []int{158}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,7 +4496,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{147}
+	return fileDescriptor_c078c3c476799f44, []int{159}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4188,7 +4524,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SourceHydrator) Reset()      { *m = SourceHydrator{} }
 func (*SourceHydrator) ProtoMessage() {}
 func (*SourceHydrator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{148}
+	return fileDescriptor_c078c3c476799f44, []int{160}
 }
 func (m *SourceHydrator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4216,7 +4552,7 @@ var xxx_messageInfo_SourceHydrator proto.InternalMessageInfo
 func (m *SourceHydratorStatus) Reset()      { *m = SourceHydratorStatus{} }
 func (*SourceHydratorStatus) ProtoMessage() {}
 func (*SourceHydratorStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{149}
+	return fileDescriptor_c078c3c476799f44, []int{161}
 }
 func (m *SourceHydratorStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4244,7 +4580,7 @@ var xxx_messageInfo_SourceHydratorStatus proto.InternalMessageInfo
 func (m *SuccessfulHydrateOperation) Reset()      { *m = SuccessfulHydrateOperation{} }
 func (*SuccessfulHydrateOperation) ProtoMessage() {}
 func (*SuccessfulHydrateOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{150}
+	return fileDescriptor_c078c3c476799f44, []int{162}
 }
 func (m *SuccessfulHydrateOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4272,7 +4608,7 @@ var xxx_messageInfo_SuccessfulHydrateOperation proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{151}
+	return fileDescriptor_c078c3c476799f44, []int{163}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4300,7 +4636,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{152}
+	return fileDescriptor_c078c3c476799f44, []int{164}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4328,7 +4664,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{153}
+	return fileDescriptor_c078c3c476799f44, []int{165}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4356,7 +4692,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{154}
+	return fileDescriptor_c078c3c476799f44, []int{166}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4384,7 +4720,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{155}
+	return fileDescriptor_c078c3c476799f44, []int{167}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4412,7 +4748,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncSource) Reset()      { *m = SyncSource{} }
 func (*SyncSource) ProtoMessage() {}
 func (*SyncSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{156}
+	return fileDescriptor_c078c3c476799f44, []int{168}
 }
 func (m *SyncSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4440,7 +4776,7 @@ var xxx_messageInfo_SyncSource proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{157}
+	return fileDescriptor_c078c3c476799f44, []int{169}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4468,7 +4804,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{158}
+	return fileDescriptor_c078c3c476799f44, []int{170}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4496,7 +4832,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{159}
+	return fileDescriptor_c078c3c476799f44, []int{171}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4524,7 +4860,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{160}
+	return fileDescriptor_c078c3c476799f44, []int{172}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4552,7 +4888,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{161}
+	return fileDescriptor_c078c3c476799f44, []int{173}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4577,10 +4913,94 @@ func (m *SyncWindow) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 
+func (m *SyncWindowCalendar) Reset()      { *m = SyncWindowCalendar{} }
+func (*SyncWindowCalendar) ProtoMessage() {}
+func (*SyncWindowCalendar) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{174}
+}
+func (m *SyncWindowCalendar) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowCalendar) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWindowCalendar) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowCalendar.Merge(m, src)
+}
+func (m *SyncWindowCalendar) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowCalendar) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowCalendar.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowCalendar proto.InternalMessageInfo
+
+func (m *SyncWindowDateRange) Reset()      { *m = SyncWindowDateRange{} }
+func (*SyncWindowDateRange) ProtoMessage() {}
+func (*SyncWindowDateRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{175}
+}
+func (m *SyncWindowDateRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowDateRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWindowDateRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowDateRange.Merge(m, src)
+}
+func (m *SyncWindowDateRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowDateRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowDateRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowDateRange proto.InternalMessageInfo
+
+func (m *SyncWindowPeriod) Reset()      { *m = SyncWindowPeriod{} }
+func (*SyncWindowPeriod) ProtoMessage() {}
+func (*SyncWindowPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c078c3c476799f44, []int{176}
+}
+func (m *SyncWindowPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncWindowPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SyncWindowPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncWindowPeriod.Merge(m, src)
+}
+func (m *SyncWindowPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncWindowPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncWindowPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncWindowPeriod proto.InternalMessageInfo
+
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{162}
+	return fileDescriptor_c078c3c476799f44, []int{177}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4608,7 +5028,7 @@ var xxx_messageInfo_TLSClientConfig proto.InternalMessageInfo
 func (m *TagFilter) Reset()      { *m = TagFilter{} }
 func (*TagFilter) ProtoMessage() {}
 func (*TagFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c078c3c476799f44, []int{163}
+	return fileDescriptor_c078c3c476799f44, []int{178}
 }
 func (m *TagFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4643,6 +5063,7 @@ func init() {
 	proto.RegisterMapType((map[string]JWTTokens)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.AppProjectStatus.JwtTokensByRoleEntry")
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationCondition")
+	proto.RegisterType((*ApplicationDependency)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDependency")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestination")
 	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationList")
@@ -4655,7 +5076,10 @@ func init() {
 	proto.RegisterType((*ApplicationSetList)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetList")
 	proto.RegisterType((*ApplicationSetNestedGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetNestedGenerator")
 	proto.RegisterType((*ApplicationSetResourceIgnoreDifferences)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetResourceIgnoreDifferences")
+	proto.RegisterType((*ApplicationSetRolloutAnalysis)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutAnalysis")
 	proto.RegisterType((*ApplicationSetRolloutStep)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutStep")
+	proto.RegisterType((*ApplicationSetRolloutStepRollback)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutStepRollback")
+	proto.RegisterType((*ApplicationSetRolloutStepStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutStepStatus")
 	proto.RegisterType((*ApplicationSetRolloutStrategy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetRolloutStrategy")
 	proto.RegisterType((*ApplicationSetSpec)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetSpec")
 	proto.RegisterType((*ApplicationSetStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ApplicationSetStatus")
@@ -4718,16 +5142,23 @@ func init() {
 	proto.RegisterType((*HelmFileParameter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmFileParameter")
 	proto.RegisterType((*HelmOptions)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmOptions")
 	proto.RegisterType((*HelmParameter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmParameter")
+	proto.RegisterType((*HelmRepositoryGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmRepositoryGenerator")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HelmRepositoryGenerator.ValuesEntry")
 	proto.RegisterType((*HostInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostInfo")
 	proto.RegisterType((*HostResourceInfo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HostResourceInfo")
 	proto.RegisterType((*HydrateOperation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateOperation")
+	proto.RegisterType((*HydratePullRequest)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydratePullRequest")
 	proto.RegisterType((*HydrateTo)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydrateTo")
+	proto.RegisterType((*HydratedManifestLayout)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.HydratedManifestLayout")
 	proto.RegisterType((*Info)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Info")
 	proto.RegisterType((*InfoItem)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.InfoItem")
 	proto.RegisterType((*JWTToken)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.JWTToken")
 	proto.RegisterType((*JWTTokens)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.JWTTokens")
 	proto.RegisterType((*JsonnetVar)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.JsonnetVar")
 	proto.RegisterType((*KnownTypeField)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.KnownTypeField")
+	proto.RegisterType((*KubernetesResourceGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.KubernetesResourceGenerator")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.KubernetesResourceGenerator.ParamsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.KubernetesResourceGenerator.ValuesEntry")
 	proto.RegisterType((*KustomizeGvk)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.KustomizeGvk")
 	proto.RegisterType((*KustomizeOptions)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.KustomizeOptions")
 	proto.RegisterType((*KustomizePatch)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.KustomizePatch")
@@ -4743,6 +5174,10 @@ func init() {
 	proto.RegisterType((*MergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.MergeGenerator")
 	proto.RegisterType((*NestedMatrixGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMatrixGenerator")
 	proto.RegisterType((*NestedMergeGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.NestedMergeGenerator")
+	proto.RegisterType((*OCIGenerator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIGenerator")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIGenerator.ValuesEntry")
+	proto.RegisterType((*OCIGeneratorBasicAuth)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIGeneratorBasicAuth")
+	proto.RegisterType((*OCIGeneratorFilter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OCIGeneratorFilter")
 	proto.RegisterType((*Operation)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.Operation")
 	proto.RegisterType((*OperationInitiator)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationInitiator")
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.OperationState")
@@ -4785,6 +5220,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceNetworkingInfo.TargetLabelsEntry")
 	proto.RegisterType((*ResourceNode)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceNode")
 	proto.RegisterType((*ResourceOverride)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceOverride")
+	proto.RegisterType((*ResourcePolicy)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourcePolicy")
 	proto.RegisterType((*ResourceRef)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceRef")
 	proto.RegisterType((*ResourceResult)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceResult")
 	proto.RegisterType((*ResourceStatus)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.ResourceStatus")
@@ -4817,6 +5253,9 @@ func init() {
 	proto.RegisterType((*SyncStrategyApply)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyApply")
 	proto.RegisterType((*SyncStrategyHook)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncStrategyHook")
 	proto.RegisterType((*SyncWindow)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindow")
+	proto.RegisterType((*SyncWindowCalendar)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindowCalendar")
+	proto.RegisterType((*SyncWindowDateRange)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindowDateRange")
+	proto.RegisterType((*SyncWindowPeriod)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.SyncWindowPeriod")
 	proto.RegisterType((*TLSClientConfig)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TLSClientConfig")
 	proto.RegisterType((*TagFilter)(nil), "github.com.argoproj.argo_cd.v3.pkg.apis.application.v1alpha1.TagFilter")
 }
@@ -4826,759 +5265,844 @@ func init() {
 }
 
 var fileDescriptor_c078c3c476799f44 = []byte{
-	// 12030 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x70, 0x24, 0xc9,
-	0x71, 0x18, 0x7b, 0x06, 0x03, 0xcc, 0x24, 0x5e, 0xbb, 0xb5, 0xbb, 0x77, 0xd8, 0xbd, 0x07, 0x56,
-	0x7d, 0xd4, 0xf1, 0x64, 0xde, 0x01, 0xba, 0x17, 0x75, 0xd6, 0xf1, 0x21, 0x3c, 0xf6, 0x81, 0x5d,
-	0x60, 0x81, 0xab, 0xc1, 0xee, 0x92, 0x47, 0x1e, 0x8f, 0x8d, 0x99, 0xc2, 0xa0, 0x17, 0x3d, 0xdd,
-	0x73, 0xdd, 0x3d, 0x58, 0xcc, 0x89, 0xa4, 0x48, 0x91, 0x94, 0x28, 0xf1, 0x69, 0x52, 0x61, 0x1d,
-	0x6d, 0x93, 0xa6, 0x2c, 0xf9, 0x15, 0x0e, 0x86, 0x68, 0xeb, 0xc3, 0x8a, 0x90, 0x14, 0x0a, 0x49,
-	0x36, 0x83, 0xb6, 0xec, 0x90, 0xcc, 0x60, 0x88, 0xb2, 0x25, 0xc2, 0xe4, 0xda, 0x0e, 0x2a, 0x1c,
-	0x21, 0x45, 0x58, 0xf6, 0x87, 0x63, 0xed, 0x70, 0x38, 0xea, 0x5d, 0xdd, 0xd3, 0x03, 0x0c, 0x16,
-	0x0d, 0xec, 0x92, 0xbc, 0xbf, 0x99, 0xca, 0xec, 0xca, 0xec, 0xea, 0xaa, 0xcc, 0xac, 0xac, 0xcc,
-	0x2c, 0x58, 0x6c, 0xb8, 0xf1, 0x46, 0x7b, 0x6d, 0xaa, 0x16, 0x34, 0xa7, 0x9d, 0xb0, 0x11, 0xb4,
-	0xc2, 0xe0, 0x06, 0xfb, 0xf1, 0x44, 0xad, 0x3e, 0xbd, 0xf5, 0xf4, 0x74, 0x6b, 0xb3, 0x31, 0xed,
-	0xb4, 0xdc, 0x68, 0xda, 0x69, 0xb5, 0x3c, 0xb7, 0xe6, 0xc4, 0x6e, 0xe0, 0x4f, 0x6f, 0x3d, 0xe9,
-	0x78, 0xad, 0x0d, 0xe7, 0xc9, 0xe9, 0x06, 0xf1, 0x49, 0xe8, 0xc4, 0xa4, 0x3e, 0xd5, 0x0a, 0x83,
-	0x38, 0x40, 0x6f, 0xd5, 0xbd, 0x4d, 0xc9, 0xde, 0xd8, 0x8f, 0x97, 0x6b, 0xf5, 0xa9, 0xad, 0xa7,
-	0xa7, 0x5a, 0x9b, 0x8d, 0x29, 0xda, 0xdb, 0x94, 0xd1, 0xdb, 0x94, 0xec, 0xed, 0xcc, 0x13, 0x06,
-	0x2f, 0x8d, 0xa0, 0x11, 0x4c, 0xb3, 0x4e, 0xd7, 0xda, 0xeb, 0xec, 0x1f, 0xfb, 0xc3, 0x7e, 0x71,
-	0x62, 0x67, 0xec, 0xcd, 0xe7, 0xa2, 0x29, 0x37, 0xa0, 0xec, 0x4d, 0xd7, 0x82, 0x90, 0x4c, 0x6f,
-	0x75, 0x31, 0x74, 0xe6, 0xa2, 0xc6, 0x21, 0xdb, 0x31, 0xf1, 0x23, 0x37, 0xf0, 0xa3, 0x27, 0x28,
-	0x0b, 0x24, 0xdc, 0x22, 0xa1, 0xf9, 0x7a, 0x06, 0x42, 0x56, 0x4f, 0xcf, 0xe8, 0x9e, 0x9a, 0x4e,
-	0x6d, 0xc3, 0xf5, 0x49, 0xd8, 0xd1, 0x8f, 0x37, 0x49, 0xec, 0x64, 0x3d, 0x35, 0xdd, 0xeb, 0xa9,
-	0xb0, 0xed, 0xc7, 0x6e, 0x93, 0x74, 0x3d, 0xf0, 0x96, 0xbd, 0x1e, 0x88, 0x6a, 0x1b, 0xa4, 0xe9,
-	0x74, 0x3d, 0xf7, 0x74, 0xaf, 0xe7, 0xda, 0xb1, 0xeb, 0x4d, 0xbb, 0x7e, 0x1c, 0xc5, 0x61, 0xfa,
-	0x21, 0xfb, 0xef, 0x59, 0x30, 0x3a, 0x73, 0xbd, 0x3a, 0xd3, 0x8e, 0x37, 0xe6, 0x02, 0x7f, 0xdd,
-	0x6d, 0xa0, 0x67, 0x61, 0xb8, 0xe6, 0xb5, 0xa3, 0x98, 0x84, 0x57, 0x9c, 0x26, 0x99, 0xb0, 0xce,
-	0x5a, 0x8f, 0x55, 0x66, 0x4f, 0x7c, 0x7d, 0x67, 0xf2, 0x0d, 0xb7, 0x76, 0x26, 0x87, 0xe7, 0x34,
-	0x08, 0x9b, 0x78, 0xe8, 0xc7, 0x60, 0x28, 0x0c, 0x3c, 0x32, 0x83, 0xaf, 0x4c, 0x14, 0xd8, 0x23,
-	0xe3, 0xe2, 0x91, 0x21, 0xcc, 0x9b, 0xb1, 0x84, 0x53, 0xd4, 0x56, 0x18, 0xac, 0xbb, 0x1e, 0x99,
-	0x28, 0x26, 0x51, 0x57, 0x78, 0x33, 0x96, 0x70, 0xfb, 0x0b, 0x05, 0x18, 0x9f, 0x69, 0xb5, 0x2e,
-	0x12, 0xc7, 0x8b, 0x37, 0xaa, 0xb1, 0x13, 0xb7, 0x23, 0xd4, 0x80, 0xc1, 0x88, 0xfd, 0x12, 0xbc,
-	0x2d, 0x8b, 0xa7, 0x07, 0x39, 0xfc, 0xf6, 0xce, 0xe4, 0xdb, 0xb2, 0x66, 0x74, 0xc3, 0x8d, 0x83,
-	0x56, 0xf4, 0x04, 0xf1, 0x1b, 0xae, 0x4f, 0xd8, 0xb8, 0x6c, 0xb0, 0x5e, 0xa7, 0xcc, 0xce, 0xe7,
-	0x82, 0x3a, 0xc1, 0xa2, 0x7b, 0xca, 0x67, 0x93, 0x44, 0x91, 0xd3, 0x20, 0xe9, 0x57, 0x5a, 0xe2,
-	0xcd, 0x58, 0xc2, 0x51, 0x08, 0xc8, 0x73, 0xa2, 0x78, 0x35, 0x74, 0xfc, 0xc8, 0xa5, 0x53, 0x7a,
-	0xd5, 0x6d, 0xf2, 0xb7, 0x1b, 0x7e, 0xea, 0x6f, 0x4c, 0xf1, 0x0f, 0x33, 0x65, 0x7e, 0x18, 0xbd,
-	0x0e, 0xe8, 0xbc, 0x99, 0xda, 0x7a, 0x72, 0x8a, 0x3e, 0x31, 0x7b, 0xdf, 0xad, 0x9d, 0x49, 0xb4,
-	0xd8, 0xd5, 0x13, 0xce, 0xe8, 0xdd, 0xfe, 0x93, 0x02, 0xc0, 0x4c, 0xab, 0xb5, 0x12, 0x06, 0x37,
-	0x48, 0x2d, 0x46, 0xef, 0x83, 0x32, 0xed, 0xaa, 0xee, 0xc4, 0x0e, 0x1b, 0x98, 0xe1, 0xa7, 0x7e,
-	0xbc, 0x3f, 0xc2, 0xcb, 0x6b, 0xf4, 0xf9, 0x25, 0x12, 0x3b, 0xb3, 0x48, 0xbc, 0x20, 0xe8, 0x36,
-	0xac, 0x7a, 0x45, 0x3e, 0x0c, 0x44, 0x2d, 0x52, 0x63, 0x83, 0x31, 0xfc, 0xd4, 0xe2, 0xd4, 0x41,
-	0x56, 0xfa, 0x94, 0xe6, 0xbc, 0xda, 0x22, 0xb5, 0xd9, 0x11, 0x41, 0x79, 0x80, 0xfe, 0xc3, 0x8c,
-	0x0e, 0xda, 0x52, 0x1f, 0x9a, 0x0f, 0xe4, 0x95, 0xdc, 0x28, 0xb2, 0x5e, 0x67, 0xc7, 0x92, 0x13,
-	0x47, 0x7e, 0x77, 0xfb, 0xdb, 0x16, 0x8c, 0x69, 0xe4, 0x45, 0x37, 0x8a, 0xd1, 0x7b, 0xba, 0x06,
-	0x77, 0xaa, 0xbf, 0xc1, 0xa5, 0x4f, 0xb3, 0xa1, 0x3d, 0x26, 0x88, 0x95, 0x65, 0x8b, 0x31, 0xb0,
-	0x4d, 0x28, 0xb9, 0x31, 0x69, 0x46, 0x13, 0x85, 0xb3, 0xc5, 0xc7, 0x86, 0x9f, 0xba, 0x98, 0xd7,
-	0x7b, 0xce, 0x8e, 0x0a, 0xa2, 0xa5, 0x05, 0xda, 0x3d, 0xe6, 0x54, 0xec, 0xbf, 0x1e, 0x35, 0xdf,
-	0x8f, 0x0e, 0x38, 0x7a, 0x12, 0x86, 0xa3, 0xa0, 0x1d, 0xd6, 0x08, 0x26, 0xad, 0x80, 0x2e, 0xac,
-	0x22, 0x9d, 0xee, 0x74, 0xc1, 0x57, 0x75, 0x33, 0x36, 0x71, 0xd0, 0xa7, 0x2d, 0x18, 0xa9, 0x93,
-	0x28, 0x76, 0x7d, 0x46, 0x5f, 0x32, 0xbf, 0x7a, 0x60, 0xe6, 0x65, 0xe3, 0xbc, 0xee, 0x7c, 0xf6,
-	0xa4, 0x78, 0x91, 0x11, 0xa3, 0x31, 0xc2, 0x09, 0xfa, 0x54, 0x70, 0xd5, 0x49, 0x54, 0x0b, 0xdd,
-	0x16, 0xfd, 0x2f, 0x44, 0x8b, 0x12, 0x5c, 0xf3, 0x1a, 0x84, 0x4d, 0x3c, 0xe4, 0x43, 0x89, 0x0a,
-	0xa6, 0x68, 0x62, 0x80, 0xf1, 0xbf, 0x70, 0x30, 0xfe, 0xc5, 0xa0, 0x52, 0x99, 0xa7, 0x47, 0x9f,
-	0xfe, 0x8b, 0x30, 0x27, 0x83, 0x3e, 0x65, 0xc1, 0x84, 0x10, 0x9c, 0x98, 0xf0, 0x01, 0xbd, 0xbe,
-	0xe1, 0xc6, 0xc4, 0x73, 0xa3, 0x78, 0xa2, 0xc4, 0x78, 0x98, 0xee, 0x6f, 0x6e, 0x5d, 0x08, 0x83,
-	0x76, 0xeb, 0xb2, 0xeb, 0xd7, 0x67, 0xcf, 0x0a, 0x4a, 0x13, 0x73, 0x3d, 0x3a, 0xc6, 0x3d, 0x49,
-	0xa2, 0xcf, 0x5b, 0x70, 0xc6, 0x77, 0x9a, 0x24, 0x6a, 0x39, 0xf4, 0xd3, 0x72, 0xf0, 0xac, 0xe7,
-	0xd4, 0x36, 0x19, 0x47, 0x83, 0x77, 0xc6, 0x91, 0x2d, 0x38, 0x3a, 0x73, 0xa5, 0x67, 0xd7, 0x78,
-	0x17, 0xb2, 0xe8, 0x57, 0x2d, 0x38, 0x1e, 0x84, 0xad, 0x0d, 0xc7, 0x27, 0x75, 0x09, 0x8d, 0x26,
-	0x86, 0xd8, 0xd2, 0x7b, 0xef, 0xc1, 0x3e, 0xd1, 0x72, 0xba, 0xdb, 0xa5, 0xc0, 0x77, 0xe3, 0x20,
-	0xac, 0x92, 0x38, 0x76, 0xfd, 0x46, 0x34, 0x7b, 0xea, 0xd6, 0xce, 0xe4, 0xf1, 0x2e, 0x2c, 0xdc,
-	0xcd, 0x0f, 0xfa, 0x69, 0x18, 0x8e, 0x3a, 0x7e, 0xed, 0xba, 0xeb, 0xd7, 0x83, 0x9b, 0xd1, 0x44,
-	0x39, 0x8f, 0xe5, 0x5b, 0x55, 0x1d, 0x8a, 0x05, 0xa8, 0x09, 0x60, 0x93, 0x5a, 0xf6, 0x87, 0xd3,
-	0x53, 0xa9, 0x92, 0xf7, 0x87, 0xd3, 0x93, 0x69, 0x17, 0xb2, 0xe8, 0xe7, 0x2d, 0x18, 0x8d, 0xdc,
-	0x86, 0xef, 0xc4, 0xed, 0x90, 0x5c, 0x26, 0x9d, 0x68, 0x02, 0x18, 0x23, 0x97, 0x0e, 0x38, 0x2a,
-	0x46, 0x97, 0xb3, 0xa7, 0x04, 0x8f, 0xa3, 0x66, 0x6b, 0x84, 0x93, 0x74, 0xb3, 0x16, 0x9a, 0x9e,
-	0xd6, 0xc3, 0xf9, 0x2e, 0x34, 0x3d, 0xa9, 0x7b, 0x92, 0x44, 0x3f, 0x05, 0xc7, 0x78, 0x93, 0x1a,
-	0xd9, 0x68, 0x62, 0x84, 0x09, 0xda, 0x93, 0xb7, 0x76, 0x26, 0x8f, 0x55, 0x53, 0x30, 0xdc, 0x85,
-	0x8d, 0x5e, 0x81, 0xc9, 0x16, 0x09, 0x9b, 0x6e, 0xbc, 0xec, 0x7b, 0x1d, 0x29, 0xbe, 0x6b, 0x41,
-	0x8b, 0xd4, 0x05, 0x3b, 0xd1, 0xc4, 0xe8, 0x59, 0xeb, 0xb1, 0xf2, 0xec, 0x9b, 0x04, 0x9b, 0x93,
-	0x2b, 0xbb, 0xa3, 0xe3, 0xbd, 0xfa, 0x43, 0x5f, 0xb3, 0xe0, 0x8c, 0x21, 0x65, 0xab, 0x24, 0xdc,
-	0x72, 0x6b, 0x64, 0xa6, 0x56, 0x0b, 0xda, 0x7e, 0x1c, 0x4d, 0x8c, 0xb1, 0x61, 0x5c, 0x3b, 0x0c,
-	0x99, 0x9f, 0x24, 0xa5, 0xe7, 0x65, 0x4f, 0x94, 0x08, 0xef, 0xc2, 0xa9, 0xfd, 0x6f, 0x0a, 0x70,
-	0x2c, 0x6d, 0x01, 0xa0, 0x7f, 0x64, 0xc1, 0xf8, 0x8d, 0x9b, 0xf1, 0x6a, 0xb0, 0x49, 0xfc, 0x68,
-	0xb6, 0x43, 0xe5, 0x34, 0xd3, 0x7d, 0xc3, 0x4f, 0xd5, 0xf2, 0xb5, 0x35, 0xa6, 0x2e, 0x25, 0xa9,
-	0x9c, 0xf3, 0xe3, 0xb0, 0x33, 0x7b, 0xbf, 0x78, 0xa7, 0xf1, 0x4b, 0xd7, 0x57, 0x4d, 0x28, 0x4e,
-	0x33, 0x75, 0xe6, 0x13, 0x16, 0x9c, 0xcc, 0xea, 0x02, 0x1d, 0x83, 0xe2, 0x26, 0xe9, 0x70, 0x4b,
-	0x18, 0xd3, 0x9f, 0xe8, 0x25, 0x28, 0x6d, 0x39, 0x5e, 0x9b, 0x08, 0x33, 0xed, 0xc2, 0xc1, 0x5e,
-	0x44, 0x71, 0x86, 0x79, 0xaf, 0x3f, 0x59, 0x78, 0xce, 0xb2, 0xff, 0xa8, 0x08, 0xc3, 0xc6, 0x47,
-	0x3b, 0x02, 0xd3, 0x33, 0x48, 0x98, 0x9e, 0x4b, 0xb9, 0xcd, 0xb7, 0x9e, 0xb6, 0xe7, 0xcd, 0x94,
-	0xed, 0xb9, 0x9c, 0x1f, 0xc9, 0x5d, 0x8d, 0x4f, 0x14, 0x43, 0x25, 0x68, 0xd1, 0x2d, 0x1a, 0xb5,
-	0x61, 0x06, 0xf2, 0xf8, 0x84, 0xcb, 0xb2, 0xbb, 0xd9, 0xd1, 0x5b, 0x3b, 0x93, 0x15, 0xf5, 0x17,
-	0x6b, 0x42, 0xf6, 0xb7, 0x2c, 0x38, 0x69, 0xf0, 0x38, 0x17, 0xf8, 0x75, 0xb6, 0xd1, 0x40, 0x67,
-	0x61, 0x20, 0xee, 0xb4, 0xe4, 0x36, 0x50, 0x8d, 0xd4, 0x6a, 0xa7, 0x45, 0x30, 0x83, 0xdc, 0xeb,
-	0xbb, 0xa4, 0xcf, 0x5b, 0x70, 0x5f, 0xb6, 0x80, 0x41, 0x8f, 0xc2, 0x20, 0xf7, 0x01, 0x88, 0xb7,
-	0xd3, 0x9f, 0x84, 0xb5, 0x62, 0x01, 0x45, 0xd3, 0x50, 0x51, 0x0a, 0x4f, 0xbc, 0xe3, 0x71, 0x81,
-	0x5a, 0xd1, 0x5a, 0x52, 0xe3, 0xd0, 0x41, 0xa3, 0x7f, 0x84, 0x09, 0xaa, 0x06, 0x8d, 0x6d, 0x9a,
-	0x19, 0xc4, 0xfe, 0xa6, 0x05, 0x6f, 0xec, 0x47, 0xec, 0x1d, 0x1e, 0x8f, 0x55, 0x38, 0x55, 0x27,
-	0xeb, 0x4e, 0xdb, 0x8b, 0x93, 0x14, 0x05, 0xd3, 0x0f, 0x89, 0x87, 0x4f, 0xcd, 0x67, 0x21, 0xe1,
-	0xec, 0x67, 0xed, 0xff, 0x6c, 0xb1, 0xed, 0xba, 0x7c, 0xad, 0x23, 0xd8, 0x3a, 0xf9, 0xc9, 0xad,
-	0xd3, 0x42, 0x6e, 0xcb, 0xb4, 0xc7, 0xde, 0xe9, 0x53, 0x16, 0x9c, 0x31, 0xb0, 0x96, 0x9c, 0xb8,
-	0xb6, 0x71, 0x6e, 0xbb, 0x15, 0x92, 0x28, 0xa2, 0x53, 0xea, 0x21, 0x43, 0x1c, 0xcf, 0x0e, 0x8b,
-	0x1e, 0x8a, 0x97, 0x49, 0x87, 0xcb, 0xe6, 0xc7, 0xa1, 0xcc, 0xd7, 0x5c, 0x10, 0x8a, 0x8f, 0xa4,
-	0xde, 0x6d, 0x59, 0xb4, 0x63, 0x85, 0x81, 0x6c, 0x18, 0x64, 0x32, 0x97, 0xca, 0x20, 0x6a, 0x26,
-	0x00, 0xfd, 0xee, 0xd7, 0x58, 0x0b, 0x16, 0x10, 0x3b, 0x4a, 0xb0, 0xb3, 0x12, 0x12, 0x36, 0x1f,
-	0xea, 0xe7, 0x5d, 0xe2, 0xd5, 0x23, 0xba, 0xad, 0x73, 0x7c, 0x3f, 0x88, 0xc5, 0x0e, 0xcd, 0xd8,
-	0xd6, 0xcd, 0xe8, 0x66, 0x6c, 0xe2, 0x50, 0xa2, 0x9e, 0xb3, 0x46, 0x3c, 0x3e, 0xa2, 0x82, 0xe8,
-	0x22, 0x6b, 0xc1, 0x02, 0x62, 0xdf, 0x2a, 0xb0, 0x0d, 0xa4, 0x92, 0x68, 0xe4, 0x28, 0xbc, 0x0f,
-	0x61, 0x42, 0x05, 0xac, 0xe4, 0x27, 0x8f, 0x49, 0x6f, 0x0f, 0xc4, 0xab, 0x29, 0x2d, 0x80, 0x73,
-	0xa5, 0xba, 0xbb, 0x17, 0xe2, 0x43, 0x45, 0x98, 0x4c, 0x3e, 0xd0, 0xa5, 0x44, 0xe8, 0x96, 0xd7,
-	0x20, 0x94, 0xf6, 0xd5, 0x19, 0xf8, 0xd8, 0xc4, 0xeb, 0x21, 0x87, 0x0b, 0x87, 0x29, 0x87, 0x4d,
-	0x35, 0x51, 0xdc, 0x43, 0x4d, 0x3c, 0xaa, 0x46, 0x7d, 0x20, 0x25, 0xf3, 0x92, 0xaa, 0xf2, 0x2c,
-	0x0c, 0x44, 0x31, 0x69, 0x4d, 0x94, 0x92, 0x62, 0xb6, 0x1a, 0x93, 0x16, 0x66, 0x10, 0xf4, 0x36,
-	0x18, 0x8f, 0x9d, 0xb0, 0x41, 0xe2, 0x90, 0x6c, 0xb9, 0xcc, 0xaf, 0xcb, 0xf6, 0xb3, 0x95, 0xd9,
-	0x13, 0xd4, 0xea, 0x5a, 0x65, 0x20, 0x2c, 0x41, 0x38, 0x8d, 0x6b, 0xff, 0xf7, 0x02, 0xdc, 0x9f,
-	0xfc, 0x04, 0x5a, 0x31, 0xbe, 0x23, 0xa1, 0x18, 0xdf, 0x6c, 0x2a, 0xc6, 0xdb, 0x3b, 0x93, 0x0f,
-	0xf4, 0x78, 0xec, 0xfb, 0x46, 0x6f, 0xa2, 0x0b, 0xa9, 0x8f, 0x30, 0xdd, 0xe5, 0x65, 0x7d, 0xa8,
-	0xc7, 0x3b, 0xa6, 0xbe, 0xd2, 0xa3, 0x30, 0x18, 0x12, 0x27, 0x0a, 0x7c, 0xf1, 0x9d, 0xd4, 0xd7,
-	0xc4, 0xac, 0x15, 0x0b, 0xa8, 0xfd, 0x8d, 0x4a, 0x7a, 0xb0, 0x2f, 0x70, 0x5f, 0x75, 0x10, 0x22,
-	0x17, 0x06, 0xd8, 0xae, 0x8d, 0x4b, 0x96, 0xcb, 0x07, 0x5b, 0x85, 0x54, 0x8b, 0xa8, 0xae, 0x67,
-	0xcb, 0xf4, 0xab, 0xd1, 0x26, 0xcc, 0x48, 0xa0, 0x6d, 0x28, 0xd7, 0xe4, 0x66, 0xaa, 0x90, 0x87,
-	0xdb, 0x51, 0x6c, 0xa5, 0x34, 0xc5, 0x11, 0x2a, 0xee, 0xd5, 0x0e, 0x4c, 0x51, 0x43, 0x04, 0x8a,
-	0x0d, 0x37, 0x16, 0x9f, 0xf5, 0x80, 0xdb, 0xe5, 0x0b, 0xae, 0xf1, 0x8a, 0x43, 0x54, 0x07, 0x5d,
-	0x70, 0x63, 0x4c, 0xfb, 0x47, 0x1f, 0xb3, 0x60, 0x38, 0xaa, 0x35, 0x57, 0xc2, 0x60, 0xcb, 0xad,
-	0x93, 0x50, 0xd8, 0x98, 0x07, 0x94, 0x6c, 0xd5, 0xb9, 0x25, 0xd9, 0xa1, 0xa6, 0xcb, 0xdd, 0x17,
-	0x1a, 0x82, 0x4d, 0xba, 0x74, 0xef, 0x75, 0xbf, 0x78, 0xf7, 0x79, 0x52, 0x63, 0x2b, 0x4e, 0xee,
-	0x99, 0xd9, 0x4c, 0x39, 0xb0, 0xcd, 0x3d, 0xdf, 0xae, 0x6d, 0xd2, 0xf5, 0xa6, 0x19, 0x7a, 0xe0,
-	0xd6, 0xce, 0xe4, 0xfd, 0x73, 0xd9, 0x34, 0x71, 0x2f, 0x66, 0xd8, 0x80, 0xb5, 0xda, 0x9e, 0x87,
-	0xc9, 0x2b, 0x6d, 0xc2, 0x3c, 0x62, 0x39, 0x0c, 0xd8, 0x8a, 0xee, 0x30, 0x35, 0x60, 0x06, 0x04,
-	0x9b, 0x74, 0xd1, 0x2b, 0x30, 0xd8, 0x74, 0xe2, 0xd0, 0xdd, 0x16, 0x6e, 0xb0, 0x03, 0xee, 0x82,
-	0x96, 0x58, 0x5f, 0x9a, 0x38, 0x53, 0xf4, 0xbc, 0x11, 0x0b, 0x42, 0xa8, 0x09, 0xa5, 0x26, 0x09,
-	0x1b, 0x64, 0xa2, 0x9c, 0x87, 0xcb, 0x7f, 0x89, 0x76, 0xa5, 0x09, 0x56, 0xa8, 0x71, 0xc5, 0xda,
-	0x30, 0xa7, 0x82, 0x5e, 0x82, 0x72, 0x44, 0x3c, 0x52, 0xa3, 0xe6, 0x51, 0x85, 0x51, 0x7c, 0xba,
-	0x4f, 0x53, 0x91, 0xda, 0x25, 0x55, 0xf1, 0x28, 0x5f, 0x60, 0xf2, 0x1f, 0x56, 0x5d, 0xd2, 0x01,
-	0x6c, 0x79, 0xed, 0x86, 0xeb, 0x4f, 0x40, 0x1e, 0x03, 0xb8, 0xc2, 0xfa, 0x4a, 0x0d, 0x20, 0x6f,
-	0xc4, 0x82, 0x90, 0xfd, 0xdf, 0x2c, 0x40, 0x49, 0xa1, 0x76, 0x04, 0x36, 0xf1, 0x2b, 0x49, 0x9b,
-	0x78, 0x31, 0x4f, 0xa3, 0xa5, 0x87, 0x59, 0xfc, 0x5b, 0x15, 0x48, 0xa9, 0x83, 0x2b, 0x24, 0x8a,
-	0x49, 0xfd, 0x75, 0x11, 0xfe, 0xba, 0x08, 0x7f, 0x5d, 0x84, 0x2b, 0x11, 0xbe, 0x96, 0x12, 0xe1,
-	0x6f, 0x37, 0x56, 0xbd, 0x8e, 0x3d, 0x78, 0x59, 0x05, 0x27, 0x98, 0x1c, 0x18, 0x08, 0x54, 0x12,
-	0x5c, 0xaa, 0x2e, 0x5f, 0xc9, 0x94, 0xd9, 0x2f, 0x27, 0x65, 0xf6, 0x41, 0x49, 0xfc, 0x30, 0x48,
-	0xe9, 0xaf, 0x59, 0xf0, 0xa6, 0xa4, 0xf4, 0x92, 0x33, 0x67, 0xa1, 0xe1, 0x07, 0x21, 0x99, 0x77,
-	0xd7, 0xd7, 0x49, 0x48, 0xfc, 0x1a, 0x89, 0x94, 0x6f, 0xc7, 0xea, 0xe5, 0xdb, 0x41, 0xcf, 0xc0,
-	0xc8, 0x8d, 0x28, 0xf0, 0x57, 0x02, 0xd7, 0x17, 0x22, 0x88, 0xee, 0x38, 0x8e, 0xdd, 0xda, 0x99,
-	0x1c, 0xa1, 0x23, 0x2a, 0xdb, 0x71, 0x02, 0x0b, 0xcd, 0xc1, 0xf1, 0x1b, 0xaf, 0xac, 0x38, 0xb1,
-	0xe1, 0x4d, 0x90, 0xfb, 0x7e, 0x76, 0x1e, 0x75, 0xe9, 0x85, 0x14, 0x10, 0x77, 0xe3, 0xdb, 0x7f,
-	0xb7, 0x00, 0xa7, 0x53, 0x2f, 0x12, 0x78, 0x5e, 0xd0, 0x8e, 0xe9, 0x9e, 0x08, 0x7d, 0xc9, 0x82,
-	0x63, 0xcd, 0xa4, 0xc3, 0x22, 0x12, 0xee, 0xee, 0x77, 0xe6, 0xa6, 0x23, 0x52, 0x1e, 0x91, 0xd9,
-	0x09, 0x31, 0x42, 0xc7, 0x52, 0x80, 0x08, 0x77, 0xf1, 0x82, 0x5e, 0x82, 0x4a, 0xd3, 0xd9, 0xbe,
-	0xda, 0xaa, 0x3b, 0xb1, 0xdc, 0x8e, 0xf6, 0xf6, 0x22, 0xb4, 0x63, 0xd7, 0x9b, 0xe2, 0x51, 0x2d,
-	0x53, 0x0b, 0x7e, 0xbc, 0x1c, 0x56, 0xe3, 0xd0, 0xf5, 0x1b, 0xdc, 0xc9, 0xb9, 0x24, 0xbb, 0xc1,
-	0xba, 0x47, 0xfb, 0x8b, 0x56, 0x5a, 0x49, 0xa9, 0xd1, 0x09, 0x9d, 0x98, 0x34, 0x3a, 0xe8, 0xfd,
-	0x50, 0xa2, 0xfb, 0x46, 0x39, 0x2a, 0xd7, 0xf3, 0xd4, 0x9c, 0xc6, 0x97, 0xd0, 0x4a, 0x94, 0xfe,
-	0x8b, 0x30, 0x27, 0x6a, 0x7f, 0xa9, 0x92, 0x36, 0x16, 0xd8, 0xd9, 0xfc, 0x53, 0x00, 0x8d, 0x60,
-	0x95, 0x34, 0x5b, 0x1e, 0x1d, 0x16, 0x8b, 0x1d, 0xf0, 0x28, 0x57, 0xc9, 0x05, 0x05, 0xc1, 0x06,
-	0x16, 0xfa, 0x05, 0x0b, 0xa0, 0x21, 0xe7, 0xbc, 0x34, 0x04, 0xae, 0xe6, 0xf9, 0x3a, 0x7a, 0x45,
-	0x69, 0x5e, 0x14, 0x41, 0x6c, 0x10, 0x47, 0x3f, 0x6b, 0x41, 0x39, 0x96, 0xec, 0x73, 0xd5, 0xb8,
-	0x9a, 0x27, 0x27, 0xf2, 0xa5, 0xb5, 0x4d, 0xa4, 0x86, 0x44, 0xd1, 0x45, 0x3f, 0x67, 0x01, 0x44,
-	0x1d, 0xbf, 0xb6, 0x12, 0x78, 0x6e, 0xad, 0x23, 0x34, 0xe6, 0xb5, 0x5c, 0xdd, 0x39, 0xaa, 0xf7,
-	0xd9, 0x31, 0x3a, 0x1a, 0xfa, 0x3f, 0x36, 0x28, 0xa3, 0x0f, 0x42, 0x39, 0x12, 0xd3, 0x4d, 0xe8,
-	0xc8, 0xd5, 0x7c, 0x9d, 0x4a, 0xbc, 0x6f, 0x21, 0x5e, 0xc5, 0x3f, 0xac, 0x68, 0xa2, 0x5f, 0xb6,
-	0x60, 0xbc, 0x95, 0x74, 0x13, 0x0a, 0x75, 0x98, 0x9f, 0x0c, 0x48, 0xb9, 0x21, 0xb9, 0xb7, 0x25,
-	0xd5, 0x88, 0xd3, 0x5c, 0x50, 0x09, 0xa8, 0x67, 0xf0, 0x72, 0x8b, 0xbb, 0x2c, 0x87, 0xb4, 0x04,
-	0xbc, 0x90, 0x06, 0xe2, 0x6e, 0x7c, 0xb4, 0x02, 0x27, 0x29, 0x77, 0x1d, 0x6e, 0x7e, 0x4a, 0xf5,
-	0x12, 0x31, 0x65, 0x58, 0x9e, 0x7d, 0x50, 0xcc, 0x10, 0x76, 0xd6, 0x91, 0xc6, 0xc1, 0x99, 0x4f,
-	0xa2, 0x3f, 0xb2, 0xe0, 0x41, 0x97, 0xa9, 0x01, 0xd3, 0x61, 0xaf, 0x35, 0x82, 0x38, 0x68, 0x27,
-	0xb9, 0xca, 0x8a, 0x5e, 0xea, 0x67, 0xf6, 0x8d, 0xe2, 0x0d, 0x1e, 0x5c, 0xd8, 0x85, 0x25, 0xbc,
-	0x2b, 0xc3, 0xe8, 0x27, 0x60, 0x54, 0xae, 0x8b, 0x15, 0x2a, 0x82, 0x99, 0xa2, 0xad, 0xcc, 0x1e,
-	0xbf, 0xb5, 0x33, 0x39, 0xba, 0x6a, 0x02, 0x70, 0x12, 0xcf, 0xfe, 0xb7, 0xc5, 0xc4, 0x29, 0x91,
-	0xf2, 0x61, 0x32, 0x71, 0x53, 0x93, 0xfe, 0x1f, 0x29, 0x3d, 0x73, 0x15, 0x37, 0xca, 0xbb, 0xa4,
-	0xc5, 0x8d, 0x6a, 0x8a, 0xb0, 0x41, 0x9c, 0x1a, 0xa5, 0xc7, 0x9d, 0xb4, 0xa7, 0x54, 0x48, 0xc0,
-	0x97, 0xf2, 0x64, 0xa9, 0xfb, 0x4c, 0xef, 0xb4, 0x60, 0xed, 0x78, 0x17, 0x08, 0x77, 0xb3, 0x84,
-	0x3e, 0x00, 0x95, 0x50, 0x45, 0xb6, 0x14, 0xf3, 0xd8, 0xaa, 0xc9, 0x69, 0x23, 0xd8, 0x51, 0x07,
-	0x40, 0x3a, 0x86, 0x45, 0x53, 0xb4, 0xff, 0x30, 0x79, 0x30, 0x66, 0xc8, 0x8e, 0x3e, 0x0e, 0xfd,
-	0x3e, 0x6d, 0xc1, 0x70, 0x18, 0x78, 0x9e, 0xeb, 0x37, 0xa8, 0x9c, 0x13, 0xca, 0xfa, 0xdd, 0x87,
-	0xa2, 0x2f, 0x85, 0x40, 0x63, 0x96, 0x35, 0xd6, 0x34, 0xb1, 0xc9, 0x80, 0xfd, 0x6d, 0x0b, 0x26,
-	0x7a, 0xc9, 0x63, 0x44, 0xe0, 0x01, 0x29, 0x6c, 0xd4, 0x50, 0x2c, 0xfb, 0xf3, 0xc4, 0x23, 0xca,
-	0x6d, 0x5e, 0x9e, 0x7d, 0x44, 0xbc, 0xe6, 0x03, 0x2b, 0xbd, 0x51, 0xf1, 0x6e, 0xfd, 0xa0, 0x17,
-	0xe1, 0x98, 0xf1, 0x5e, 0x91, 0x1a, 0x98, 0xca, 0xec, 0x14, 0x35, 0x80, 0x66, 0x52, 0xb0, 0xdb,
-	0x3b, 0x93, 0xf7, 0xa5, 0xdb, 0x84, 0xc2, 0xe8, 0xea, 0xc7, 0xfe, 0xb5, 0x42, 0xfa, 0x6b, 0x29,
-	0x5d, 0xff, 0x9a, 0xd5, 0xe5, 0x4d, 0x78, 0xe7, 0x61, 0xe8, 0x57, 0xe6, 0x77, 0x50, 0x61, 0x18,
-	0xbd, 0x71, 0xee, 0xe2, 0xb1, 0xbd, 0xfd, 0xef, 0x06, 0x60, 0x17, 0xce, 0xfa, 0x30, 0xde, 0xf7,
-	0x7d, 0x8e, 0xfa, 0x49, 0x4b, 0x1d, 0x98, 0xf1, 0x35, 0x5c, 0x3f, 0xac, 0xb1, 0xe7, 0xfb, 0xa7,
-	0x88, 0x87, 0x8e, 0x28, 0x2f, 0x7a, 0xf2, 0x68, 0x0e, 0x7d, 0xd9, 0x4a, 0x1e, 0xf9, 0xf1, 0xa0,
-	0x46, 0xf7, 0xd0, 0x78, 0x32, 0xce, 0x11, 0x39, 0x63, 0xfa, 0xf4, 0xa9, 0xd7, 0x09, 0xe3, 0x14,
-	0xc0, 0xba, 0xeb, 0x3b, 0x9e, 0xfb, 0x2a, 0xdd, 0x1d, 0x95, 0x98, 0x82, 0x67, 0x16, 0xd3, 0x79,
-	0xd5, 0x8a, 0x0d, 0x8c, 0x33, 0x7f, 0x13, 0x86, 0x8d, 0x37, 0xcf, 0x88, 0x78, 0x39, 0x69, 0x46,
-	0xbc, 0x54, 0x8c, 0x40, 0x95, 0x33, 0x6f, 0x87, 0x63, 0x69, 0x06, 0xf7, 0xf3, 0xbc, 0xfd, 0xbf,
-	0x87, 0xd2, 0x67, 0x70, 0xab, 0x24, 0x6c, 0x52, 0xd6, 0x5e, 0x77, 0x6c, 0xbd, 0xee, 0xd8, 0x7a,
-	0xdd, 0xb1, 0x65, 0x9e, 0x4d, 0x08, 0xa7, 0xcd, 0xd0, 0x11, 0x39, 0x6d, 0x12, 0x6e, 0xa8, 0x72,
-	0xee, 0x6e, 0x28, 0xfb, 0x63, 0x5d, 0x9e, 0xfb, 0xd5, 0x90, 0x10, 0x14, 0x40, 0xc9, 0x0f, 0xea,
-	0x44, 0xda, 0xb8, 0x97, 0xf2, 0x31, 0xd8, 0xae, 0x04, 0x75, 0x23, 0x5c, 0x9c, 0xfe, 0x8b, 0x30,
-	0xa7, 0x63, 0x7f, 0x74, 0x10, 0x12, 0xe6, 0x24, 0xff, 0xee, 0x3f, 0x06, 0x43, 0x21, 0x69, 0x05,
-	0x57, 0xf1, 0xa2, 0xd0, 0x65, 0x3a, 0xdb, 0x86, 0x37, 0x63, 0x09, 0xa7, 0x3a, 0xaf, 0xe5, 0xc4,
-	0x1b, 0x42, 0x99, 0x29, 0x9d, 0xb7, 0xe2, 0xc4, 0x1b, 0x98, 0x41, 0xd0, 0xdb, 0x61, 0x2c, 0x4e,
-	0x1c, 0x85, 0x8b, 0x23, 0xdf, 0xfb, 0x04, 0xee, 0x58, 0xf2, 0xa0, 0x1c, 0xa7, 0xb0, 0xd1, 0x2b,
-	0x30, 0xb0, 0x41, 0xbc, 0xa6, 0xf8, 0xf4, 0xd5, 0xfc, 0x74, 0x0d, 0x7b, 0xd7, 0x8b, 0xc4, 0x6b,
-	0x72, 0x49, 0x48, 0x7f, 0x61, 0x46, 0x8a, 0xce, 0xfb, 0xca, 0x66, 0x3b, 0x8a, 0x83, 0xa6, 0xfb,
-	0xaa, 0xf4, 0x74, 0xbe, 0x33, 0x67, 0xc2, 0x97, 0x65, 0xff, 0xdc, 0xa5, 0xa4, 0xfe, 0x62, 0x4d,
-	0x99, 0xf1, 0x51, 0x77, 0x43, 0x36, 0x65, 0x3a, 0xc2, 0x61, 0x99, 0x37, 0x1f, 0xf3, 0xb2, 0x7f,
-	0xce, 0x87, 0xfa, 0x8b, 0x35, 0x65, 0xd4, 0x51, 0xeb, 0x6f, 0x98, 0xf1, 0x70, 0x35, 0x67, 0x1e,
-	0xf8, 0xda, 0xcb, 0x5c, 0x87, 0x8f, 0x40, 0xa9, 0xb6, 0xe1, 0x84, 0xf1, 0xc4, 0x08, 0x9b, 0x34,
-	0x6a, 0x16, 0xcf, 0xd1, 0x46, 0xcc, 0x61, 0xe8, 0x21, 0x28, 0x86, 0x64, 0x9d, 0x45, 0x27, 0x1b,
-	0x71, 0x51, 0x98, 0xac, 0x63, 0xda, 0xae, 0xec, 0xb2, 0xb1, 0x9e, 0x01, 0x73, 0xbf, 0x52, 0x48,
-	0x1a, 0x76, 0xc9, 0x91, 0xe1, 0xeb, 0xa1, 0xd6, 0x0e, 0x23, 0xe9, 0x20, 0x33, 0xd6, 0x03, 0x6b,
-	0xc6, 0x12, 0x8e, 0x3e, 0x6c, 0xc1, 0xd0, 0x8d, 0x28, 0xf0, 0x7d, 0x12, 0x0b, 0x25, 0x7a, 0x2d,
-	0xe7, 0xc1, 0xba, 0xc4, 0x7b, 0xd7, 0x3c, 0x88, 0x06, 0x2c, 0xe9, 0x52, 0x76, 0xc9, 0x76, 0xcd,
-	0x6b, 0xd7, 0xbb, 0x82, 0x61, 0xce, 0xf1, 0x66, 0x2c, 0xe1, 0x14, 0xd5, 0xf5, 0x39, 0xea, 0x40,
-	0x12, 0x75, 0xc1, 0x17, 0xa8, 0x02, 0x6e, 0xff, 0x46, 0x19, 0x4e, 0x65, 0x2e, 0x1f, 0x6a, 0x72,
-	0x31, 0xa3, 0xe6, 0xbc, 0xeb, 0x11, 0x19, 0x06, 0xc6, 0x4c, 0xae, 0x6b, 0xaa, 0x15, 0x1b, 0x18,
-	0xe8, 0x67, 0x00, 0x5a, 0x4e, 0xe8, 0x34, 0x89, 0x72, 0x60, 0x1f, 0xd8, 0xb2, 0xa1, 0x7c, 0xac,
-	0xc8, 0x3e, 0xf5, 0x26, 0x5e, 0x35, 0x45, 0xd8, 0x20, 0x89, 0x9e, 0x85, 0xe1, 0x90, 0x78, 0xc4,
-	0x89, 0x58, 0xf8, 0x7b, 0x3a, 0x97, 0x07, 0x6b, 0x10, 0x36, 0xf1, 0xd0, 0xa3, 0x2a, 0x62, 0x2e,
-	0x15, 0x39, 0x94, 0x8c, 0x9a, 0x43, 0x9f, 0xb1, 0x60, 0x6c, 0xdd, 0xf5, 0x88, 0xa6, 0x2e, 0x32,
-	0x6f, 0x96, 0x0f, 0xfe, 0x92, 0xe7, 0xcd, 0x7e, 0xb5, 0x0c, 0x4d, 0x34, 0x47, 0x38, 0x45, 0x9e,
-	0x7e, 0xe6, 0x2d, 0x12, 0x32, 0xe1, 0x3b, 0x98, 0xfc, 0xcc, 0xd7, 0x78, 0x33, 0x96, 0x70, 0x34,
-	0x03, 0xe3, 0x2d, 0x27, 0x8a, 0xe6, 0x42, 0x52, 0x27, 0x7e, 0xec, 0x3a, 0x1e, 0xcf, 0x8b, 0x29,
-	0xeb, 0x70, 0xf2, 0x95, 0x24, 0x18, 0xa7, 0xf1, 0xd1, 0xbb, 0xe0, 0x7e, 0xee, 0x21, 0x5a, 0x72,
-	0xa3, 0xc8, 0xf5, 0x1b, 0x7a, 0x1a, 0x08, 0x47, 0xd9, 0xa4, 0xe8, 0xea, 0xfe, 0x85, 0x6c, 0x34,
-	0xdc, 0xeb, 0x79, 0xf4, 0x38, 0x94, 0xa3, 0x4d, 0xb7, 0x35, 0x17, 0xd6, 0x23, 0x76, 0x3a, 0x54,
-	0xd6, 0x6e, 0xd9, 0xaa, 0x68, 0xc7, 0x0a, 0x03, 0xd5, 0x60, 0x84, 0x7f, 0x12, 0x1e, 0xf2, 0x27,
-	0x24, 0xe8, 0x13, 0x3d, 0x15, 0xb9, 0x48, 0x81, 0x9d, 0xc2, 0xce, 0xcd, 0x73, 0xf2, 0xac, 0x8a,
-	0x1f, 0xad, 0x5c, 0x33, 0xba, 0xc1, 0x89, 0x4e, 0x93, 0x7b, 0xba, 0xe1, 0x3e, 0xf6, 0x74, 0xcf,
-	0xc2, 0xf0, 0x66, 0x7b, 0x8d, 0x88, 0x91, 0x17, 0x82, 0x4d, 0xcd, 0xbe, 0xcb, 0x1a, 0x84, 0x4d,
-	0x3c, 0x16, 0x6d, 0xd9, 0x72, 0xc5, 0xbf, 0x68, 0x62, 0xd4, 0x88, 0xb6, 0x5c, 0x59, 0x90, 0xcd,
-	0xd8, 0xc4, 0xa1, 0xac, 0xd1, 0xb1, 0x58, 0x25, 0x11, 0x4b, 0xa6, 0xa0, 0xc3, 0xa5, 0x58, 0xab,
-	0x4a, 0x00, 0xd6, 0x38, 0x68, 0x05, 0x4e, 0xd2, 0x3f, 0x55, 0x96, 0x02, 0x7c, 0xcd, 0xf1, 0xdc,
-	0x3a, 0x0f, 0xfd, 0x1b, 0x4f, 0xfa, 0x37, 0xab, 0x19, 0x38, 0x38, 0xf3, 0x49, 0xfb, 0x0b, 0x85,
-	0xa4, 0xe7, 0xc4, 0x14, 0x61, 0x28, 0xa2, 0x82, 0x2a, 0xbe, 0xe6, 0x84, 0xd2, 0xe0, 0x39, 0x60,
-	0x72, 0x93, 0xe8, 0xf7, 0x9a, 0x13, 0x9a, 0x22, 0x8f, 0x11, 0xc0, 0x92, 0x12, 0xba, 0x01, 0x03,
-	0xb1, 0xe7, 0xe4, 0x94, 0x0d, 0x69, 0x50, 0xd4, 0x8e, 0xac, 0xc5, 0x99, 0x08, 0x33, 0x1a, 0xe8,
-	0x41, 0xba, 0x7b, 0x5b, 0x93, 0x27, 0x6d, 0x62, 0xc3, 0xb5, 0x16, 0x61, 0xd6, 0x6a, 0xff, 0xd2,
-	0x68, 0x86, 0xd6, 0x51, 0x86, 0x00, 0x7a, 0x0a, 0x80, 0x4e, 0x9a, 0x95, 0x90, 0xac, 0xbb, 0xdb,
-	0xc2, 0x10, 0x53, 0x92, 0xed, 0x8a, 0x82, 0x60, 0x03, 0x4b, 0x3e, 0x53, 0x6d, 0xaf, 0xd3, 0x67,
-	0x0a, 0xdd, 0xcf, 0x70, 0x08, 0x36, 0xb0, 0xd0, 0x33, 0x30, 0xe8, 0x36, 0x9d, 0x86, 0x0a, 0x04,
-	0x7e, 0x90, 0x8a, 0xb4, 0x05, 0xd6, 0x72, 0x7b, 0x67, 0x72, 0x4c, 0x31, 0xc4, 0x9a, 0xb0, 0xc0,
-	0x45, 0xbf, 0x66, 0xc1, 0x48, 0x2d, 0x68, 0x36, 0x03, 0x9f, 0x6f, 0x9f, 0x85, 0x2f, 0xe0, 0xc6,
-	0x61, 0x99, 0x49, 0x53, 0x73, 0x06, 0x31, 0xee, 0x0c, 0x50, 0x69, 0x9b, 0x26, 0x08, 0x27, 0xb8,
-	0x32, 0x25, 0x5f, 0x69, 0x0f, 0xc9, 0xf7, 0x9b, 0x16, 0x1c, 0xe7, 0xcf, 0x1a, 0xbb, 0x7a, 0x91,
-	0xa1, 0x18, 0x1c, 0xf2, 0x6b, 0x75, 0x39, 0x3a, 0x94, 0xb3, 0xb7, 0x0b, 0x8e, 0xbb, 0x99, 0x44,
-	0x17, 0xe0, 0xf8, 0x7a, 0x10, 0xd6, 0x88, 0x39, 0x10, 0x42, 0x6c, 0xab, 0x8e, 0xce, 0xa7, 0x11,
-	0x70, 0xf7, 0x33, 0xe8, 0x1a, 0xdc, 0x67, 0x34, 0x9a, 0xe3, 0xc0, 0x25, 0xf7, 0xc3, 0xa2, 0xb7,
-	0xfb, 0xce, 0x67, 0x62, 0xe1, 0x1e, 0x4f, 0x27, 0x85, 0x64, 0xa5, 0x0f, 0x21, 0xf9, 0x32, 0x9c,
-	0xae, 0x75, 0x8f, 0xcc, 0x56, 0xd4, 0x5e, 0x8b, 0xb8, 0x1c, 0x2f, 0xcf, 0xfe, 0x88, 0xe8, 0xe0,
-	0xf4, 0x5c, 0x2f, 0x44, 0xdc, 0xbb, 0x0f, 0xf4, 0x7e, 0x28, 0x87, 0x84, 0x7d, 0x95, 0x48, 0xa4,
-	0xeb, 0x1d, 0xd0, 0xdb, 0xa1, 0x2d, 0x78, 0xde, 0xad, 0xd6, 0x4c, 0xa2, 0x21, 0xc2, 0x8a, 0x22,
-	0xba, 0x09, 0x43, 0x2d, 0x27, 0xae, 0x6d, 0x88, 0x24, 0xbd, 0x03, 0xfb, 0xe6, 0x15, 0x71, 0x76,
-	0x94, 0x62, 0x94, 0x3c, 0xe0, 0x44, 0xb0, 0xa4, 0x46, 0x6d, 0xb5, 0x5a, 0xd0, 0x6c, 0x05, 0x3e,
-	0xf1, 0x63, 0xa9, 0x44, 0xc6, 0xf8, 0x79, 0x87, 0x6c, 0xc5, 0x06, 0x46, 0x97, 0x2e, 0xd7, 0x68,
-	0x13, 0xc7, 0x77, 0xd1, 0xe5, 0x46, 0x6f, 0xbd, 0x9e, 0xa7, 0xca, 0x86, 0xb9, 0x15, 0xaf, 0xbb,
-	0xf1, 0x46, 0xd0, 0x8e, 0xe5, 0x2e, 0x59, 0x28, 0x2a, 0xa5, 0x6c, 0x16, 0x33, 0x70, 0x70, 0xe6,
-	0x93, 0x69, 0xcd, 0x3a, 0x7e, 0x67, 0x9a, 0xf5, 0x58, 0x1f, 0x9a, 0xb5, 0x0a, 0xa7, 0x18, 0x07,
-	0xc2, 0x4a, 0x96, 0x4e, 0xcb, 0x68, 0x02, 0x31, 0xe6, 0x55, 0x7e, 0xcb, 0x62, 0x16, 0x12, 0xce,
-	0x7e, 0xf6, 0xcc, 0x3b, 0xe0, 0x78, 0x97, 0x90, 0xdb, 0x97, 0x43, 0x72, 0x1e, 0xee, 0xcb, 0x16,
-	0x27, 0xfb, 0x72, 0x4b, 0xfe, 0x46, 0x2a, 0x2e, 0xdd, 0xd8, 0xa2, 0xf5, 0xe1, 0xe2, 0x76, 0xa0,
-	0x48, 0xfc, 0x2d, 0xa1, 0x5d, 0xcf, 0x1f, 0x6c, 0x56, 0x9f, 0xf3, 0xb7, 0xb8, 0x34, 0x64, 0x7e,
-	0xbc, 0x73, 0xfe, 0x16, 0xa6, 0x7d, 0xa3, 0xcf, 0x59, 0x89, 0x0d, 0x04, 0x77, 0x8c, 0xbf, 0xf7,
-	0x50, 0xf6, 0xa4, 0x7d, 0xef, 0x29, 0xec, 0x7f, 0x5f, 0x80, 0xb3, 0x7b, 0x75, 0xd2, 0xc7, 0xf0,
-	0x3d, 0x02, 0x83, 0x11, 0x8b, 0x34, 0x11, 0xea, 0x6a, 0x98, 0xae, 0x62, 0x1e, 0x7b, 0xf2, 0x32,
-	0x16, 0x20, 0xe4, 0x41, 0xb1, 0xe9, 0xb4, 0x84, 0xbf, 0x74, 0xe1, 0xa0, 0xf9, 0x7b, 0xf4, 0xbf,
-	0xe3, 0x2d, 0x39, 0x2d, 0x3e, 0xe7, 0x8d, 0x06, 0x4c, 0xc9, 0xa0, 0x18, 0x4a, 0x4e, 0x18, 0x3a,
-	0x32, 0xac, 0xe1, 0x72, 0x3e, 0xf4, 0x66, 0x68, 0x97, 0xfc, 0x54, 0x38, 0xd1, 0x84, 0x39, 0x31,
-	0xfb, 0x97, 0xcb, 0x89, 0x64, 0x2f, 0x16, 0xab, 0x12, 0xc1, 0xa0, 0x70, 0x93, 0x5a, 0x79, 0xa7,
-	0x4d, 0xf2, 0x6c, 0x6a, 0xe6, 0x81, 0x10, 0x35, 0x29, 0x04, 0x29, 0xf4, 0x09, 0x8b, 0x55, 0x7e,
-	0x90, 0x19, 0x74, 0x62, 0x57, 0x7f, 0x38, 0x85, 0x28, 0xcc, 0x7a, 0x12, 0xb2, 0x11, 0x9b, 0xd4,
-	0x45, 0x75, 0x1b, 0xb6, 0x9b, 0xe9, 0xae, 0x6e, 0xc3, 0x76, 0x27, 0x12, 0x8e, 0xb6, 0x33, 0x62,
-	0x52, 0x72, 0xa8, 0x1e, 0xd0, 0x47, 0x14, 0xca, 0x97, 0x2d, 0x38, 0xee, 0xa6, 0x83, 0x0b, 0xc4,
-	0x1e, 0xf8, 0x7a, 0x3e, 0x3e, 0xcd, 0xee, 0xd8, 0x05, 0x65, 0xe8, 0x74, 0x81, 0x70, 0x37, 0x33,
-	0xa8, 0x0e, 0x03, 0xae, 0xbf, 0x1e, 0x08, 0xf3, 0x6e, 0xf6, 0x60, 0x4c, 0x2d, 0xf8, 0xeb, 0x81,
-	0x5e, 0xcd, 0xf4, 0x1f, 0x66, 0xbd, 0xa3, 0x45, 0x38, 0x29, 0xf3, 0x7d, 0x2e, 0xba, 0x51, 0x1c,
-	0x84, 0x9d, 0x45, 0xb7, 0xe9, 0xc6, 0xcc, 0x34, 0x2b, 0xce, 0x4e, 0x50, 0xf5, 0x86, 0x33, 0xe0,
-	0x38, 0xf3, 0x29, 0xf4, 0x2a, 0x0c, 0xc9, 0x03, 0xfd, 0x72, 0x1e, 0xfe, 0x84, 0xee, 0xf9, 0xaf,
-	0x26, 0x53, 0x55, 0x9c, 0xe8, 0x4b, 0x82, 0xe8, 0xe3, 0x16, 0x8c, 0xf1, 0xdf, 0x17, 0x3b, 0x75,
-	0x9e, 0x62, 0x58, 0xc9, 0x23, 0x6a, 0xbf, 0x9a, 0xe8, 0x73, 0x16, 0xdd, 0xda, 0x99, 0x1c, 0x4b,
-	0xb6, 0xe1, 0x14, 0x5d, 0xfb, 0x1f, 0x8f, 0x40, 0x77, 0x08, 0x44, 0x32, 0xde, 0xc1, 0x3a, 0xea,
-	0x78, 0x07, 0xba, 0xab, 0x8c, 0x74, 0xa8, 0x42, 0x0e, 0xcb, 0x4c, 0x50, 0xd5, 0xc7, 0xd0, 0x1d,
-	0xbf, 0x86, 0x19, 0x0d, 0xd4, 0x86, 0x41, 0x5e, 0x5c, 0x4a, 0x68, 0x80, 0x83, 0x9f, 0x7c, 0x9b,
-	0x45, 0xaa, 0xb4, 0x5b, 0x8b, 0xb7, 0x62, 0x41, 0x0c, 0x6d, 0xc3, 0xd0, 0x06, 0x9f, 0x8e, 0x62,
-	0xaf, 0xb7, 0x74, 0xd0, 0xf1, 0x4d, 0xcc, 0x71, 0x3d, 0xf9, 0x44, 0x03, 0x96, 0xe4, 0x58, 0x78,
-	0x9d, 0x11, 0x00, 0xc4, 0x05, 0x49, 0x7e, 0xd9, 0x92, 0xfd, 0x47, 0xff, 0xbc, 0x0f, 0x46, 0x42,
-	0x52, 0x0b, 0xfc, 0x9a, 0xeb, 0x91, 0xfa, 0x8c, 0x3c, 0x10, 0xdb, 0x4f, 0x92, 0x1c, 0xf3, 0x26,
-	0x61, 0xa3, 0x0f, 0x9c, 0xe8, 0x91, 0xad, 0x33, 0x95, 0x38, 0x4f, 0x3f, 0x08, 0x11, 0x07, 0x1f,
-	0x8b, 0x39, 0xa5, 0xe9, 0xb3, 0x3e, 0xf9, 0x3a, 0x4b, 0xb6, 0xe1, 0x14, 0x5d, 0xf4, 0x22, 0x40,
-	0xb0, 0xc6, 0x63, 0xe8, 0x66, 0x62, 0x71, 0x0a, 0xb2, 0x9f, 0x57, 0x1d, 0xe3, 0xc9, 0xb6, 0xb2,
-	0x07, 0x6c, 0xf4, 0x86, 0x2e, 0x03, 0xf0, 0x95, 0xb3, 0xda, 0x69, 0xc9, 0x0d, 0xa1, 0xcc, 0x72,
-	0x84, 0xaa, 0x82, 0xdc, 0xde, 0x99, 0xec, 0xf6, 0x39, 0xb3, 0x40, 0x21, 0xe3, 0x71, 0xf4, 0xd3,
-	0x30, 0x14, 0xb5, 0x9b, 0x4d, 0x47, 0x9d, 0x91, 0xe4, 0x98, 0xbe, 0xcb, 0xfb, 0x35, 0x04, 0x23,
-	0x6f, 0xc0, 0x92, 0x22, 0xba, 0x41, 0x45, 0xbc, 0x90, 0x50, 0x7c, 0x15, 0x71, 0x0b, 0x85, 0x7b,
-	0x02, 0xdf, 0x22, 0x77, 0x31, 0x38, 0x03, 0xe7, 0xf6, 0xce, 0xe4, 0x7d, 0xc9, 0xf6, 0xc5, 0x40,
-	0x24, 0xd4, 0x66, 0xf6, 0x89, 0x2e, 0xc9, 0x3a, 0x5a, 0xf4, 0xb5, 0x65, 0x79, 0x97, 0xc7, 0x74,
-	0x1d, 0x2d, 0xd6, 0xdc, 0x7b, 0xcc, 0xcc, 0x87, 0xd1, 0x12, 0x9c, 0xa8, 0x05, 0x7e, 0x1c, 0x06,
-	0x9e, 0xc7, 0x6b, 0xec, 0xf1, 0xbd, 0x39, 0x3f, 0x43, 0x79, 0x40, 0xb0, 0x7d, 0x62, 0xae, 0x1b,
-	0x05, 0x67, 0x3d, 0x47, 0x6d, 0xf2, 0xb4, 0x7e, 0x18, 0xcb, 0xe5, 0x78, 0x3d, 0xd1, 0xa7, 0x90,
-	0x50, 0xca, 0xed, 0xbd, 0x87, 0xa6, 0xf0, 0x93, 0x87, 0xac, 0xe2, 0x8b, 0x3d, 0x03, 0x23, 0x64,
-	0x3b, 0x26, 0xa1, 0xef, 0x78, 0x57, 0xf1, 0xa2, 0x3c, 0xb0, 0x60, 0x0b, 0xf3, 0x9c, 0xd1, 0x8e,
-	0x13, 0x58, 0xc8, 0x56, 0x5e, 0x32, 0x23, 0x73, 0x9d, 0x7b, 0xc9, 0xa4, 0x4f, 0xcc, 0xfe, 0x6a,
-	0x31, 0x61, 0xb3, 0xde, 0x95, 0x23, 0x5d, 0x56, 0x22, 0x49, 0xd6, 0x92, 0x62, 0x00, 0xb1, 0x17,
-	0xcb, 0x93, 0xb2, 0x2a, 0x91, 0xb4, 0x6c, 0x12, 0xc2, 0x49, 0xba, 0x68, 0x13, 0x4a, 0x1b, 0x41,
-	0x14, 0xcb, 0x1d, 0xda, 0x01, 0x37, 0x83, 0x17, 0x83, 0x28, 0x66, 0x86, 0x96, 0x7a, 0x6d, 0xda,
-	0x12, 0x61, 0x4e, 0x83, 0xee, 0xfd, 0xa3, 0x0d, 0x27, 0xac, 0x47, 0x73, 0xac, 0xce, 0xc4, 0x00,
-	0xb3, 0xb0, 0x94, 0x3d, 0x5d, 0xd5, 0x20, 0x6c, 0xe2, 0xd9, 0xdf, 0xb3, 0x12, 0xa7, 0x5a, 0xd7,
-	0x59, 0xd2, 0xc0, 0x16, 0xf1, 0xa9, 0x88, 0x32, 0xc3, 0x14, 0x7f, 0x22, 0x95, 0x82, 0xfd, 0xa6,
-	0x5e, 0xe5, 0x30, 0x6f, 0xd2, 0x1e, 0xa6, 0x58, 0x17, 0x46, 0x44, 0xe3, 0x87, 0xac, 0x64, 0x2e,
-	0x7d, 0x21, 0x8f, 0xad, 0x9b, 0x59, 0x4f, 0x62, 0xcf, 0xb4, 0x7c, 0xfb, 0x73, 0x16, 0x0c, 0xcd,
-	0x3a, 0xb5, 0xcd, 0x60, 0x7d, 0x1d, 0x3d, 0x0e, 0xe5, 0x7a, 0x3b, 0x34, 0xd3, 0xfa, 0x95, 0xb3,
-	0x6a, 0x5e, 0xb4, 0x63, 0x85, 0x41, 0xa7, 0xfe, 0xba, 0x53, 0x93, 0x55, 0x25, 0x8a, 0x7c, 0xea,
-	0x9f, 0x67, 0x2d, 0x58, 0x40, 0xe8, 0xf0, 0x37, 0x9d, 0x6d, 0xf9, 0x70, 0xfa, 0x48, 0x6d, 0x49,
-	0x83, 0xb0, 0x89, 0x67, 0xff, 0x2b, 0x0b, 0x26, 0x66, 0x9d, 0xc8, 0xad, 0xcd, 0xb4, 0xe3, 0x8d,
-	0x59, 0x37, 0x5e, 0x6b, 0xd7, 0x36, 0x49, 0xcc, 0xab, 0x8f, 0x50, 0x2e, 0xdb, 0x11, 0x5d, 0x81,
-	0x6a, 0xc7, 0xac, 0xb8, 0xbc, 0x2a, 0xda, 0xb1, 0xc2, 0x40, 0xaf, 0xc2, 0x70, 0xcb, 0x89, 0xa2,
-	0x9b, 0x41, 0x58, 0xc7, 0x64, 0x3d, 0x9f, 0xfa, 0x44, 0x55, 0x52, 0x0b, 0x49, 0x8c, 0xc9, 0xba,
-	0x08, 0x50, 0xd1, 0xfd, 0x63, 0x93, 0x98, 0xfd, 0x0b, 0x16, 0x9c, 0x9c, 0x25, 0x4e, 0x48, 0x42,
-	0x56, 0xce, 0x48, 0xbd, 0x08, 0x7a, 0x05, 0xca, 0x31, 0x6d, 0xa1, 0x1c, 0x59, 0xf9, 0x72, 0xc4,
-	0x42, 0x4b, 0x56, 0x45, 0xe7, 0x58, 0x91, 0xb1, 0x3f, 0x6d, 0xc1, 0xe9, 0x2c, 0x5e, 0xe6, 0xbc,
-	0xa0, 0x5d, 0xbf, 0x1b, 0x0c, 0xfd, 0x1d, 0x0b, 0x46, 0xd8, 0x71, 0xfd, 0x3c, 0x89, 0x1d, 0xd7,
-	0xeb, 0x2a, 0xa5, 0x68, 0xf5, 0x59, 0x4a, 0xf1, 0x2c, 0x0c, 0x6c, 0x04, 0x4d, 0x92, 0x0e, 0x35,
-	0xb9, 0x18, 0x34, 0x09, 0x66, 0x10, 0xf4, 0x24, 0x9d, 0x84, 0xae, 0x1f, 0x3b, 0x74, 0x39, 0xca,
-	0xe3, 0x8c, 0x71, 0x3e, 0x01, 0x55, 0x33, 0x36, 0x71, 0xec, 0xdf, 0xab, 0xc0, 0x90, 0x88, 0x8b,
-	0xea, 0xbb, 0x1a, 0x8e, 0xf4, 0xe2, 0x14, 0x7a, 0x7a, 0x71, 0x22, 0x18, 0xac, 0xb1, 0x7a, 0xb7,
-	0xc2, 0x42, 0xbf, 0x9c, 0x4b, 0x20, 0x1d, 0x2f, 0xa1, 0xab, 0xd9, 0xe2, 0xff, 0xb1, 0x20, 0x85,
-	0x3e, 0x6b, 0xc1, 0x78, 0x2d, 0xf0, 0x7d, 0x52, 0xd3, 0xb6, 0xe3, 0x40, 0x1e, 0x1b, 0x84, 0xb9,
-	0x64, 0xa7, 0xfa, 0x24, 0x38, 0x05, 0xc0, 0x69, 0xf2, 0xe8, 0x79, 0x18, 0xe5, 0x63, 0x76, 0x2d,
-	0x71, 0x06, 0xa3, 0x2b, 0xec, 0x99, 0x40, 0x9c, 0xc4, 0x45, 0x53, 0xfc, 0x2c, 0x4b, 0xd4, 0xb2,
-	0x1b, 0xd4, 0xae, 0x6a, 0xa3, 0x8a, 0x9d, 0x81, 0x81, 0x42, 0x40, 0x21, 0x59, 0x0f, 0x49, 0xb4,
-	0x21, 0xe2, 0xc6, 0x98, 0xdd, 0x3a, 0x74, 0x67, 0x75, 0x2c, 0x70, 0x57, 0x4f, 0x38, 0xa3, 0x77,
-	0xb4, 0x29, 0xdc, 0x08, 0xe5, 0x3c, 0xe4, 0xb9, 0xf8, 0xcc, 0x3d, 0xbd, 0x09, 0x93, 0x50, 0x62,
-	0xaa, 0x8b, 0xd9, 0xcb, 0x45, 0x9e, 0x3b, 0xc9, 0x14, 0x1b, 0xe6, 0xed, 0x68, 0x1e, 0x8e, 0xa5,
-	0xea, 0x03, 0x46, 0xe2, 0xac, 0x44, 0xe5, 0xc9, 0xa5, 0x2a, 0x0b, 0x46, 0xb8, 0xeb, 0x09, 0xd3,
-	0xc5, 0x34, 0xbc, 0x87, 0x8b, 0xa9, 0xa3, 0xa2, 0x93, 0xf9, 0x29, 0xc6, 0x0b, 0xb9, 0x0c, 0x40,
-	0x5f, 0xa1, 0xc8, 0x9f, 0x4a, 0x85, 0x22, 0x8f, 0x32, 0x06, 0xae, 0xe5, 0xc3, 0xc0, 0xfe, 0xe3,
-	0x8e, 0xef, 0x66, 0x1c, 0xf1, 0xff, 0xb2, 0x40, 0x7e, 0xd7, 0x39, 0xa7, 0xb6, 0x41, 0xe8, 0x94,
-	0x41, 0x6f, 0x87, 0x31, 0xe5, 0x9d, 0xe0, 0x26, 0x91, 0xc5, 0x66, 0x8d, 0xb2, 0x9d, 0x71, 0x02,
-	0x8a, 0x53, 0xd8, 0x68, 0x1a, 0x2a, 0x74, 0x9c, 0xf8, 0xa3, 0x5c, 0xef, 0x2b, 0x0f, 0xc8, 0xcc,
-	0xca, 0x82, 0x78, 0x4a, 0xe3, 0xa0, 0x00, 0x8e, 0x7b, 0x4e, 0x14, 0x33, 0x0e, 0xaa, 0x1d, 0xbf,
-	0x76, 0x87, 0x55, 0x64, 0x58, 0x32, 0xd6, 0x62, 0xba, 0x23, 0xdc, 0xdd, 0xb7, 0xfd, 0x1f, 0x4a,
-	0x30, 0x9a, 0x90, 0x8c, 0xfb, 0x34, 0x18, 0x1e, 0x87, 0xb2, 0xd4, 0xe1, 0xe9, 0x72, 0x59, 0x4a,
-	0xd1, 0x2b, 0x0c, 0xaa, 0xb4, 0xd6, 0xb4, 0x56, 0x4d, 0x1b, 0x38, 0x86, 0xc2, 0xc5, 0x26, 0x1e,
-	0x13, 0xca, 0xb1, 0x17, 0xcd, 0x79, 0x2e, 0xf1, 0x63, 0xce, 0x66, 0x3e, 0x42, 0x79, 0x75, 0xb1,
-	0x6a, 0x76, 0xaa, 0x85, 0x72, 0x0a, 0x80, 0xd3, 0xe4, 0xd1, 0x47, 0x2d, 0x18, 0x75, 0x6e, 0x46,
-	0xba, 0x28, 0xbb, 0x08, 0x3a, 0x3e, 0xa0, 0x92, 0x4a, 0xd4, 0x79, 0xe7, 0x8e, 0xfd, 0x44, 0x13,
-	0x4e, 0x12, 0x45, 0xaf, 0x59, 0x80, 0xc8, 0x36, 0xa9, 0xc9, 0xb0, 0x68, 0xc1, 0xcb, 0x60, 0x1e,
-	0x3b, 0xf8, 0x73, 0x5d, 0xfd, 0x72, 0xa9, 0xde, 0xdd, 0x8e, 0x33, 0x78, 0x40, 0x97, 0x00, 0xd5,
-	0xdd, 0xc8, 0x59, 0xf3, 0xc8, 0x5c, 0xd0, 0x94, 0x09, 0xc4, 0xe2, 0x3c, 0xfd, 0x8c, 0x18, 0x67,
-	0x34, 0xdf, 0x85, 0x81, 0x33, 0x9e, 0x62, 0xb3, 0x2c, 0x0c, 0xb6, 0x3b, 0x57, 0x43, 0x8f, 0x69,
-	0x09, 0x73, 0x96, 0x89, 0x76, 0xac, 0x30, 0xec, 0xbf, 0x28, 0xaa, 0xa5, 0xac, 0x73, 0x00, 0x1c,
-	0x23, 0x16, 0xd9, 0xba, 0xf3, 0x58, 0x64, 0x1d, 0x29, 0xd5, 0x9d, 0x16, 0x9f, 0xc8, 0xa2, 0x2d,
-	0xdc, 0xa5, 0x2c, 0xda, 0x9f, 0xb5, 0x12, 0x25, 0xe9, 0x86, 0x9f, 0x7a, 0x31, 0xdf, 0xfc, 0x83,
-	0x29, 0x1e, 0xc5, 0x95, 0xd2, 0x2b, 0xa9, 0xe0, 0xbd, 0xc7, 0xa1, 0xbc, 0xee, 0x39, 0xac, 0x90,
-	0x0a, 0x5b, 0xa8, 0x46, 0x84, 0xd9, 0x79, 0xd1, 0x8e, 0x15, 0x06, 0x95, 0xfa, 0x46, 0xa7, 0xfb,
-	0x92, 0xda, 0xff, 0xa9, 0x08, 0xc3, 0x86, 0xc6, 0xcf, 0x34, 0xdf, 0xac, 0x7b, 0xcc, 0x7c, 0x2b,
-	0xec, 0xc3, 0x7c, 0xfb, 0x19, 0xa8, 0xd4, 0xa4, 0x36, 0xca, 0xa7, 0xc4, 0x7e, 0x5a, 0xc7, 0x69,
-	0x85, 0xa4, 0x9a, 0xb0, 0xa6, 0x89, 0x2e, 0x24, 0x32, 0x35, 0x13, 0x7e, 0x81, 0xac, 0x54, 0x4a,
-	0xa1, 0xd1, 0xba, 0x9f, 0x49, 0xc7, 0x07, 0x94, 0xf6, 0x8e, 0x0f, 0xb0, 0xbf, 0x65, 0xa9, 0x8f,
-	0x7b, 0x04, 0x25, 0x79, 0x6e, 0x24, 0x4b, 0xf2, 0x9c, 0xcb, 0x65, 0x98, 0x7b, 0xd4, 0xe2, 0xb9,
-	0x02, 0x43, 0x73, 0x41, 0xb3, 0xe9, 0xf8, 0x75, 0xf4, 0xa3, 0x30, 0x54, 0xe3, 0x3f, 0x85, 0x0f,
-	0x8d, 0x1d, 0x56, 0x0b, 0x28, 0x96, 0x30, 0xf4, 0x20, 0x0c, 0x38, 0x61, 0x43, 0xfa, 0xcd, 0x58,
-	0x10, 0xdc, 0x4c, 0xd8, 0x88, 0x30, 0x6b, 0xb5, 0xff, 0xc5, 0x00, 0xb0, 0xd8, 0x13, 0x27, 0x24,
-	0xf5, 0xd5, 0x80, 0x55, 0xc6, 0x3d, 0xd4, 0x23, 0x5e, 0xbd, 0xa9, 0xbb, 0x97, 0x8f, 0x79, 0x8d,
-	0xa3, 0xbe, 0xe2, 0x51, 0x1f, 0xf5, 0x65, 0x9f, 0xde, 0x0e, 0xdc, 0x43, 0xa7, 0xb7, 0xf6, 0x27,
-	0x2d, 0x40, 0x2a, 0x92, 0x48, 0x87, 0x57, 0x4c, 0x43, 0x45, 0x85, 0x2e, 0x09, 0x03, 0x50, 0x8b,
-	0x08, 0x09, 0xc0, 0x1a, 0xa7, 0x8f, 0x9d, 0xfc, 0x23, 0x52, 0x7e, 0x17, 0x93, 0xf9, 0x07, 0x4c,
-	0xea, 0x0b, 0x71, 0x6e, 0xff, 0x7e, 0x01, 0xee, 0xe3, 0xa6, 0xc3, 0x92, 0xe3, 0x3b, 0x0d, 0xd2,
-	0xa4, 0x5c, 0xf5, 0x1b, 0x30, 0x53, 0xa3, 0x5b, 0x48, 0x57, 0x66, 0x0b, 0x1c, 0x74, 0xed, 0xf2,
-	0x35, 0xc7, 0x57, 0xd9, 0x82, 0xef, 0xc6, 0x98, 0x75, 0x8e, 0x22, 0x28, 0xcb, 0xbb, 0x79, 0x84,
-	0x2c, 0xce, 0x89, 0x90, 0x12, 0x4b, 0x42, 0xcb, 0x12, 0xac, 0x08, 0x51, 0x55, 0xea, 0x05, 0xb5,
-	0x4d, 0x4c, 0x5a, 0x41, 0x5a, 0x95, 0x2e, 0x8a, 0x76, 0xac, 0x30, 0xec, 0x26, 0x8c, 0xcb, 0x31,
-	0x6c, 0x5d, 0x26, 0x1d, 0x4c, 0xd6, 0xa9, 0xfe, 0xa9, 0xc9, 0x26, 0xe3, 0xba, 0x20, 0xa5, 0x7f,
-	0xe6, 0x4c, 0x20, 0x4e, 0xe2, 0xca, 0x62, 0xb9, 0x85, 0xec, 0x62, 0xb9, 0xf6, 0xef, 0x5b, 0x90,
-	0x56, 0x80, 0x46, 0x69, 0x50, 0x6b, 0xd7, 0xd2, 0xa0, 0xfb, 0x28, 0xae, 0xf9, 0x1e, 0x18, 0x76,
-	0x62, 0x6a, 0xe1, 0x70, 0x6f, 0x44, 0xf1, 0xce, 0x4e, 0xd1, 0x96, 0x82, 0xba, 0xbb, 0xee, 0x32,
-	0x2f, 0x84, 0xd9, 0x9d, 0xfd, 0x9a, 0x05, 0x95, 0xf9, 0xb0, 0xb3, 0xff, 0xb4, 0xad, 0xee, 0xa4,
-	0xac, 0xc2, 0xbe, 0x92, 0xb2, 0x64, 0xda, 0x57, 0xb1, 0x57, 0xda, 0x97, 0xfd, 0xd7, 0x03, 0x70,
-	0xbc, 0x2b, 0x0f, 0x11, 0x3d, 0x07, 0x23, 0xea, 0x2b, 0x49, 0x17, 0x64, 0xc5, 0x0c, 0xe4, 0xd5,
-	0x30, 0x9c, 0xc0, 0xec, 0x63, 0xa9, 0x2e, 0xc0, 0x89, 0x90, 0xbc, 0xd2, 0x26, 0x6d, 0x32, 0xb3,
-	0x1e, 0x93, 0xb0, 0x4a, 0x6a, 0x81, 0x5f, 0xe7, 0xb5, 0x75, 0x8b, 0xb3, 0xf7, 0xdf, 0xda, 0x99,
-	0x3c, 0x81, 0xbb, 0xc1, 0x38, 0xeb, 0x19, 0xd4, 0x82, 0x51, 0xcf, 0xb4, 0x9d, 0xc5, 0x96, 0xed,
-	0x8e, 0xcc, 0x6e, 0x35, 0x5b, 0x13, 0xcd, 0x38, 0x49, 0x20, 0x69, 0x80, 0x97, 0xee, 0x92, 0x01,
-	0xfe, 0x11, 0x6d, 0x80, 0xf3, 0xb8, 0x98, 0x77, 0xe7, 0x9c, 0x87, 0xda, 0x8f, 0x05, 0x7e, 0x10,
-	0x9b, 0xfa, 0x05, 0x28, 0xcb, 0x98, 0xc1, 0xbe, 0x62, 0xed, 0xcc, 0x7e, 0x7a, 0xc8, 0xf6, 0x47,
-	0xe1, 0x8d, 0xe7, 0xc2, 0xd0, 0x18, 0xcc, 0x2b, 0x41, 0x3c, 0xe3, 0x79, 0xc1, 0x4d, 0x6a, 0xae,
-	0x5c, 0x8d, 0x88, 0xf0, 0x89, 0xd9, 0xb7, 0x0b, 0x90, 0xb1, 0xbd, 0xa4, 0x6b, 0x52, 0xdb, 0x48,
-	0x89, 0x35, 0xb9, 0x3f, 0x3b, 0x09, 0x6d, 0xf3, 0xb8, 0x4a, 0x6e, 0x0d, 0xbc, 0x2b, 0xef, 0xed,
-	0xb1, 0x0e, 0xb5, 0x54, 0x92, 0x52, 0x85, 0x5b, 0x3e, 0x05, 0xa0, 0x4d, 0x5b, 0x91, 0xfa, 0xa4,
-	0x02, 0x25, 0xb4, 0x05, 0x8c, 0x0d, 0x2c, 0xf4, 0x2c, 0x0c, 0xbb, 0x7e, 0x14, 0x3b, 0x9e, 0x77,
-	0xd1, 0xf5, 0x63, 0xe1, 0xf6, 0x55, 0x66, 0xcf, 0x82, 0x06, 0x61, 0x13, 0xef, 0xcc, 0x5b, 0x8c,
-	0xef, 0xb7, 0x9f, 0xef, 0xbe, 0x01, 0xa7, 0x2f, 0xb8, 0xb1, 0x4a, 0xd8, 0x53, 0xf3, 0x8d, 0x5a,
-	0xae, 0x4a, 0x56, 0x59, 0x3d, 0x53, 0x54, 0x8d, 0x84, 0xb9, 0x42, 0x32, 0xbf, 0x2f, 0x9d, 0x30,
-	0x67, 0xd7, 0xe0, 0xe4, 0x05, 0x37, 0x3e, 0xef, 0x7a, 0xe4, 0x10, 0x89, 0xfc, 0xee, 0x20, 0x8c,
-	0x98, 0x79, 0xec, 0xfb, 0x91, 0xec, 0x9f, 0xa6, 0x76, 0xac, 0x18, 0x08, 0x57, 0x1d, 0xfe, 0x5e,
-	0x3f, 0x70, 0x52, 0x7d, 0xf6, 0xe0, 0x1a, 0xa6, 0xac, 0xa6, 0x89, 0x4d, 0x06, 0xd0, 0x4d, 0x28,
-	0xad, 0xb3, 0xdc, 0xaf, 0x62, 0x1e, 0x61, 0x3b, 0x59, 0x83, 0xaf, 0x57, 0x2e, 0xcf, 0x1e, 0xe3,
-	0xf4, 0xa8, 0xf9, 0x11, 0x26, 0x53, 0x8e, 0x8d, 0x88, 0x7c, 0xa1, 0xd7, 0x14, 0x46, 0x2f, 0xed,
-	0x51, 0xba, 0x03, 0xed, 0x91, 0x90, 0xe5, 0x83, 0x77, 0x49, 0x96, 0xb3, 0x3c, 0xbe, 0x78, 0x83,
-	0x19, 0xc7, 0x22, 0x85, 0x68, 0x88, 0x0d, 0x82, 0x91, 0xc7, 0x97, 0x00, 0xe3, 0x34, 0x3e, 0xfa,
-	0xa0, 0xd2, 0x06, 0xe5, 0x3c, 0x9c, 0xeb, 0xe6, 0x8c, 0x3e, 0x6c, 0x45, 0xf0, 0xc9, 0x02, 0x8c,
-	0x5d, 0xf0, 0xdb, 0x2b, 0x17, 0x56, 0xda, 0x6b, 0x9e, 0x5b, 0xbb, 0x4c, 0x3a, 0x54, 0xda, 0x6f,
-	0x92, 0xce, 0xc2, 0xbc, 0x58, 0x41, 0x6a, 0xce, 0x5c, 0xa6, 0x8d, 0x98, 0xc3, 0xa8, 0xdc, 0x5a,
-	0x77, 0xfd, 0x06, 0x09, 0x5b, 0xa1, 0x2b, 0xfc, 0xde, 0x86, 0xdc, 0x3a, 0xaf, 0x41, 0xd8, 0xc4,
-	0xa3, 0x7d, 0x07, 0x37, 0x7d, 0x12, 0xa6, 0x77, 0x09, 0xcb, 0xb4, 0x11, 0x73, 0x18, 0x45, 0x8a,
-	0xc3, 0xb6, 0x70, 0x2b, 0x19, 0x48, 0xab, 0xb4, 0x11, 0x73, 0x18, 0x5d, 0xe9, 0x51, 0x7b, 0x8d,
-	0x45, 0x45, 0xa5, 0xf2, 0x95, 0xaa, 0xbc, 0x19, 0x4b, 0x38, 0x45, 0xdd, 0x24, 0x9d, 0x79, 0x27,
-	0x76, 0xd2, 0x49, 0x9d, 0x97, 0x79, 0x33, 0x96, 0x70, 0x56, 0x28, 0x38, 0x39, 0x1c, 0xdf, 0x77,
-	0x85, 0x82, 0x93, 0xec, 0xf7, 0x70, 0x4e, 0xfc, 0xed, 0x02, 0x8c, 0xbc, 0x7e, 0x9b, 0x67, 0xc6,
-	0x3d, 0x35, 0xd7, 0xe1, 0x78, 0x57, 0xf6, 0x70, 0x1f, 0x16, 0xd2, 0x9e, 0xd5, 0x1d, 0x6c, 0x0c,
-	0xc3, 0xb4, 0x63, 0x59, 0x20, 0x6f, 0x0e, 0x8e, 0xf3, 0xc5, 0x4b, 0x29, 0xb1, 0x64, 0x50, 0x95,
-	0x11, 0xce, 0x0e, 0x76, 0xae, 0xa5, 0x81, 0xb8, 0x1b, 0xdf, 0xfe, 0x94, 0x05, 0xa3, 0x89, 0x84,
-	0xee, 0x9c, 0x6c, 0x39, 0xb6, 0xba, 0x03, 0x16, 0xd1, 0xcb, 0x32, 0x2c, 0x8a, 0x4c, 0x0d, 0xeb,
-	0xd5, 0xad, 0x41, 0xd8, 0xc4, 0xb3, 0x3f, 0x57, 0x80, 0xb2, 0x8c, 0x3e, 0xea, 0x83, 0x95, 0x4f,
-	0x58, 0x30, 0xaa, 0x0e, 0xd3, 0x98, 0xf7, 0xb3, 0x90, 0x47, 0x7e, 0x19, 0xe5, 0x40, 0xf9, 0x4f,
-	0xfc, 0xf5, 0x40, 0x6f, 0x2c, 0xb0, 0x49, 0x0c, 0x27, 0x69, 0xa3, 0x6b, 0x00, 0x51, 0x27, 0x8a,
-	0x49, 0xd3, 0xf0, 0xc3, 0xda, 0xc6, 0x2c, 0x9b, 0xaa, 0x05, 0x21, 0xa1, 0x73, 0xea, 0x4a, 0x50,
-	0x27, 0x55, 0x85, 0xa9, 0x2d, 0x3c, 0xdd, 0x86, 0x8d, 0x9e, 0xec, 0x5f, 0x2f, 0xc0, 0xb1, 0x34,
-	0x4b, 0xe8, 0xdd, 0x30, 0x22, 0xa9, 0x1b, 0xfb, 0x75, 0x19, 0x3b, 0x35, 0x82, 0x0d, 0xd8, 0xed,
-	0x9d, 0xc9, 0xc9, 0xee, 0xfb, 0x95, 0xa7, 0x4c, 0x14, 0x9c, 0xe8, 0x8c, 0x9f, 0x68, 0x8a, 0xa3,
-	0xf7, 0xd9, 0xce, 0x4c, 0xab, 0x25, 0x8e, 0x25, 0x8d, 0x13, 0x4d, 0x13, 0x8a, 0x53, 0xd8, 0x68,
-	0x05, 0x4e, 0x1a, 0x2d, 0x57, 0x88, 0xdb, 0xd8, 0x58, 0x0b, 0x42, 0xb9, 0x41, 0x7c, 0x50, 0x47,
-	0x6a, 0x76, 0xe3, 0xe0, 0xcc, 0x27, 0xa9, 0x85, 0x51, 0x73, 0x5a, 0x4e, 0xcd, 0x8d, 0x3b, 0xc2,
-	0xb1, 0xac, 0xe4, 0xe1, 0x9c, 0x68, 0xc7, 0x0a, 0xc3, 0xfe, 0x07, 0x03, 0x70, 0x8c, 0x87, 0x26,
-	0x12, 0x15, 0x79, 0x8b, 0xde, 0x0d, 0x95, 0x28, 0x76, 0x42, 0xee, 0x1d, 0xb0, 0xf6, 0x2d, 0x03,
-	0x74, 0x3a, 0xb7, 0xec, 0x04, 0xeb, 0xfe, 0xd0, 0x8b, 0xac, 0x16, 0x96, 0x1b, 0x6d, 0xb0, 0xde,
-	0x0b, 0x77, 0xe6, 0x7b, 0x38, 0xaf, 0x7a, 0xc0, 0x46, 0x6f, 0xe8, 0xad, 0x50, 0x6a, 0x6d, 0x38,
-	0x91, 0x74, 0x8c, 0x3d, 0x2a, 0x17, 0xdc, 0x0a, 0x6d, 0xbc, 0xbd, 0x33, 0x79, 0x2a, 0xfd, 0xaa,
-	0x0c, 0x80, 0xf9, 0x43, 0xa6, 0xb8, 0x1c, 0xd8, 0xfb, 0xbe, 0x96, 0x7a, 0xd8, 0xa9, 0x5e, 0x9c,
-	0x49, 0xdf, 0xf0, 0x31, 0xcf, 0x5a, 0xb1, 0x80, 0xd2, 0xc5, 0xbd, 0xc1, 0x49, 0xd6, 0x29, 0xf2,
-	0x60, 0x52, 0x75, 0x5f, 0xd4, 0x20, 0x6c, 0xe2, 0xa1, 0x4f, 0x76, 0x07, 0xae, 0x0e, 0x1d, 0x42,
-	0x62, 0x43, 0xbf, 0x21, 0xab, 0xe7, 0xa0, 0x22, 0x58, 0x5d, 0x0d, 0xd0, 0x73, 0x30, 0xc2, 0xfd,
-	0x2e, 0xb3, 0xa1, 0xe3, 0xd7, 0x36, 0xd2, 0xde, 0x92, 0x55, 0x03, 0x86, 0x13, 0x98, 0xf6, 0x12,
-	0x0c, 0xf4, 0x29, 0xad, 0xfa, 0xda, 0x04, 0xbf, 0x00, 0x65, 0xda, 0x9d, 0xdc, 0xe9, 0xe4, 0xd1,
-	0x65, 0x00, 0x65, 0x79, 0xfb, 0x1f, 0xb2, 0xa1, 0xe8, 0x3a, 0x32, 0x40, 0x41, 0x2d, 0xa1, 0x85,
-	0x28, 0x6a, 0xb3, 0x69, 0x47, 0x81, 0xe8, 0x11, 0x28, 0x92, 0xed, 0x56, 0x3a, 0x12, 0xe1, 0xdc,
-	0x76, 0xcb, 0x0d, 0x49, 0x44, 0x91, 0xc8, 0x76, 0x0b, 0x9d, 0x81, 0x82, 0x5b, 0x17, 0x33, 0x12,
-	0x04, 0x4e, 0x61, 0x61, 0x1e, 0x17, 0xdc, 0xba, 0xbd, 0x0d, 0x15, 0x75, 0xdd, 0x20, 0xda, 0x94,
-	0xb6, 0x89, 0x95, 0x47, 0x68, 0xaa, 0xec, 0xb7, 0x87, 0x55, 0xd2, 0x06, 0xd0, 0x75, 0x02, 0xf2,
-	0xd2, 0x65, 0x67, 0x61, 0xa0, 0x16, 0x88, 0x0a, 0x2f, 0x65, 0xdd, 0x0d, 0x33, 0x4a, 0x18, 0xc4,
-	0xbe, 0x0e, 0x63, 0x97, 0xfd, 0xe0, 0x26, 0xbb, 0x15, 0x88, 0x15, 0xc1, 0xa5, 0x1d, 0xaf, 0xd3,
-	0x1f, 0x69, 0x13, 0x98, 0x41, 0x31, 0x87, 0xa9, 0xf2, 0x9c, 0x85, 0x5e, 0xe5, 0x39, 0xed, 0x0f,
-	0x59, 0x30, 0xa2, 0x12, 0x8e, 0x2f, 0x6c, 0x6d, 0xd2, 0x7e, 0x1b, 0x61, 0xd0, 0x6e, 0xa5, 0xfb,
-	0x65, 0x37, 0x9b, 0x62, 0x0e, 0x33, 0x33, 0xf1, 0x0b, 0x7b, 0x64, 0xe2, 0x9f, 0x85, 0x81, 0x4d,
-	0xd7, 0xaf, 0xa7, 0xbd, 0x8b, 0x97, 0x5d, 0xbf, 0x8e, 0x19, 0x84, 0xb2, 0x70, 0x4c, 0xb1, 0x20,
-	0x8d, 0x8f, 0xe7, 0x60, 0x64, 0xad, 0xed, 0x7a, 0x75, 0x59, 0xdd, 0x37, 0xb5, 0x5c, 0x66, 0x0d,
-	0x18, 0x4e, 0x60, 0xa2, 0xa7, 0x00, 0xd6, 0x5c, 0xdf, 0x09, 0x3b, 0x2b, 0xda, 0xda, 0x51, 0x0a,
-	0x70, 0x56, 0x41, 0xb0, 0x81, 0x65, 0x7f, 0xa6, 0x08, 0x63, 0xc9, 0xb4, 0xeb, 0x3e, 0x9c, 0x00,
-	0x8f, 0x40, 0x89, 0x65, 0x62, 0xa7, 0x3f, 0x2d, 0x2f, 0x88, 0xcb, 0x61, 0x28, 0x82, 0x41, 0xbe,
-	0x98, 0xf3, 0xb9, 0x1d, 0x52, 0x31, 0xa9, 0x5c, 0x92, 0x2c, 0x80, 0x57, 0x78, 0x78, 0x05, 0x29,
-	0xf4, 0x51, 0x0b, 0x86, 0x82, 0x96, 0x59, 0xd6, 0xf1, 0x5d, 0x79, 0xa6, 0xa4, 0x8b, 0xbc, 0x4f,
-	0xb1, 0xe3, 0x53, 0x9f, 0x5e, 0x7e, 0x0e, 0x49, 0xfa, 0xcc, 0x4f, 0xc2, 0x88, 0x89, 0xb9, 0xd7,
-	0xa6, 0xaf, 0x6c, 0x6e, 0xfa, 0x3e, 0x61, 0x4e, 0x0a, 0x91, 0x74, 0xdf, 0xc7, 0x72, 0xbb, 0x0a,
-	0xa5, 0x9a, 0x8a, 0x72, 0xba, 0xa3, 0x9a, 0xf0, 0xaa, 0x28, 0x15, 0x3b, 0x41, 0xe6, 0xbd, 0xd9,
-	0xdf, 0xb2, 0x8c, 0xf9, 0x81, 0x49, 0xb4, 0x50, 0x47, 0x21, 0x14, 0x1b, 0x5b, 0x9b, 0x42, 0xcd,
-	0x5f, 0xca, 0x69, 0x78, 0x2f, 0x6c, 0x6d, 0xea, 0x39, 0x6e, 0xb6, 0x62, 0x4a, 0xac, 0x0f, 0xbf,
-	0x79, 0xa2, 0x36, 0x43, 0x71, 0xef, 0xda, 0x0c, 0xf6, 0x6b, 0x05, 0x38, 0xde, 0x35, 0xa9, 0xd0,
-	0xab, 0x50, 0x0a, 0xe9, 0x5b, 0x8a, 0xd7, 0x5b, 0xcc, 0xad, 0x9a, 0x42, 0xb4, 0x50, 0xd7, 0xea,
-	0x33, 0xd9, 0x8e, 0x39, 0x49, 0x74, 0x09, 0x90, 0x8e, 0xc5, 0x53, 0x4e, 0x7b, 0xfe, 0xca, 0x2a,
-	0x60, 0x67, 0xa6, 0x0b, 0x03, 0x67, 0x3c, 0x85, 0x9e, 0x4f, 0xfb, 0xfe, 0x8b, 0xc9, 0x43, 0xa7,
-	0xdd, 0xdc, 0xf8, 0xf6, 0x6f, 0x17, 0x60, 0x34, 0x51, 0x65, 0x13, 0x79, 0x50, 0x26, 0x1e, 0x3b,
-	0x11, 0x94, 0xca, 0xe6, 0xa0, 0x77, 0x66, 0x28, 0x05, 0x79, 0x4e, 0xf4, 0x8b, 0x15, 0x85, 0x7b,
-	0x23, 0x8e, 0xe7, 0x39, 0x18, 0x91, 0x0c, 0xbd, 0xcb, 0x69, 0x7a, 0x62, 0x00, 0xd5, 0x1c, 0x3d,
-	0x67, 0xc0, 0x70, 0x02, 0xd3, 0xfe, 0x83, 0x22, 0x4c, 0xf0, 0x23, 0xd4, 0xba, 0x9a, 0x79, 0x4b,
-	0xd2, 0x9f, 0xf0, 0x8b, 0xba, 0x16, 0xae, 0x95, 0xc7, 0xc5, 0xd0, 0xbd, 0x08, 0xf5, 0x15, 0x7e,
-	0xfa, 0xa5, 0x54, 0xf8, 0x29, 0xdf, 0xe2, 0x35, 0x0e, 0x89, 0xa3, 0xef, 0xaf, 0x78, 0xd4, 0x7f,
-	0x52, 0x80, 0xf1, 0xd4, 0xfd, 0x5f, 0xe8, 0x33, 0xc9, 0x2b, 0x23, 0xac, 0x3c, 0x8e, 0x97, 0x76,
-	0xbd, 0x12, 0x6a, 0x7f, 0x17, 0x47, 0xdc, 0xa5, 0xa5, 0x62, 0x7f, 0xb3, 0x00, 0x63, 0xc9, 0x8b,
-	0xcb, 0xee, 0xc1, 0x91, 0x7a, 0x33, 0x54, 0xd8, 0xdd, 0x3c, 0xec, 0xbe, 0x7d, 0x7e, 0x3a, 0xc5,
-	0xaf, 0x41, 0x91, 0x8d, 0x58, 0xc3, 0xef, 0x89, 0xfb, 0x38, 0xec, 0x7f, 0x66, 0xc1, 0x29, 0xfe,
-	0x96, 0xe9, 0x79, 0xf8, 0xb7, 0xb2, 0x46, 0xf7, 0xa5, 0x7c, 0x19, 0x4c, 0xd5, 0x70, 0xde, 0x6b,
-	0x7c, 0xd9, 0xf5, 0xd8, 0x82, 0xdb, 0xe4, 0x54, 0xb8, 0x07, 0x99, 0xdd, 0xd7, 0x64, 0xb0, 0xbf,
-	0x59, 0x04, 0x7d, 0x23, 0x38, 0x72, 0x45, 0x8e, 0x7c, 0x2e, 0xb5, 0xac, 0xab, 0x1d, 0xbf, 0xa6,
-	0xef, 0x1e, 0x2f, 0xa7, 0x52, 0xe4, 0x7f, 0xde, 0x82, 0x61, 0xd7, 0x77, 0x63, 0xd7, 0x61, 0x2e,
-	0x9b, 0x7c, 0xae, 0xf5, 0x55, 0xe4, 0x16, 0x78, 0xcf, 0x41, 0x68, 0x1e, 0x69, 0x2a, 0x62, 0xd8,
-	0xa4, 0x8c, 0xde, 0x27, 0x32, 0x44, 0x8a, 0xb9, 0x15, 0x9a, 0x28, 0xa7, 0xd2, 0x42, 0x5a, 0xd4,
-	0xf0, 0x8a, 0xc3, 0x9c, 0xea, 0xb3, 0x60, 0xda, 0x95, 0xba, 0x16, 0x41, 0x99, 0xb6, 0xac, 0x19,
-	0x73, 0x42, 0x76, 0x04, 0xa8, 0x7b, 0x2c, 0xf6, 0x19, 0x7d, 0x3f, 0x0d, 0x15, 0xa7, 0x1d, 0x07,
-	0x4d, 0x3a, 0x4c, 0xe2, 0x40, 0x54, 0xe7, 0x17, 0x48, 0x00, 0xd6, 0x38, 0xf6, 0x67, 0x4a, 0x90,
-	0xca, 0x58, 0x47, 0xdb, 0xe6, 0x6d, 0xf6, 0x56, 0xbe, 0xb7, 0xd9, 0x2b, 0x66, 0xb2, 0x6e, 0xb4,
-	0x47, 0x0d, 0xe9, 0xfd, 0xe2, 0x36, 0xe6, 0x0b, 0x69, 0xef, 0xd7, 0x4f, 0xf5, 0x77, 0xaa, 0x40,
-	0xe7, 0xea, 0x34, 0xaf, 0x51, 0x36, 0xb5, 0xa7, 0xa3, 0x6c, 0xaf, 0x8b, 0x8d, 0x3f, 0x2c, 0x2e,
-	0x21, 0xc2, 0x24, 0x6a, 0x7b, 0xb1, 0x98, 0x0d, 0x2f, 0xe4, 0xb8, 0xca, 0x78, 0xc7, 0xba, 0xf2,
-	0x0b, 0xff, 0x8f, 0x0d, 0xa2, 0x49, 0x77, 0xe6, 0xe0, 0xa1, 0xba, 0x33, 0x87, 0x72, 0x75, 0x67,
-	0x3e, 0x05, 0xc0, 0xe6, 0x36, 0x8f, 0x12, 0x2e, 0x33, 0x2f, 0x93, 0x12, 0x85, 0x58, 0x41, 0xb0,
-	0x81, 0x65, 0xff, 0x38, 0x24, 0x4b, 0x17, 0xa1, 0x49, 0x59, 0x29, 0x89, 0x9f, 0x78, 0xb0, 0x04,
-	0xad, 0x44, 0x51, 0xa3, 0xdf, 0xb4, 0xc0, 0xac, 0xaf, 0x84, 0x5e, 0xe1, 0x85, 0x9c, 0xac, 0x3c,
-	0x4e, 0xc6, 0x8d, 0x7e, 0xa7, 0x96, 0x9c, 0x56, 0x2a, 0x9a, 0x43, 0x56, 0x73, 0x3a, 0xf3, 0x16,
-	0x28, 0x4b, 0xe8, 0xbe, 0x8c, 0xba, 0x0f, 0xc2, 0x09, 0x99, 0xec, 0x2d, 0x7d, 0xf4, 0xe2, 0x54,
-	0x75, 0x6f, 0xd7, 0x8f, 0xf4, 0xe7, 0x14, 0x7a, 0xf9, 0x73, 0xd4, 0x2e, 0xb5, 0xd8, 0xb3, 0x44,
-	0xf3, 0x6f, 0x59, 0x70, 0x36, 0xcd, 0x40, 0xb4, 0x14, 0xf8, 0x6e, 0x1c, 0x84, 0x55, 0x12, 0xc7,
-	0xae, 0xdf, 0x60, 0xf5, 0x36, 0x6f, 0x3a, 0xa1, 0xbc, 0x73, 0x85, 0x09, 0xca, 0xeb, 0x4e, 0xe8,
-	0x63, 0xd6, 0x8a, 0x3a, 0x30, 0xc8, 0x43, 0x49, 0x85, 0xb5, 0x7e, 0xc0, 0xb5, 0x91, 0x31, 0x1c,
-	0x7a, 0xbb, 0xc0, 0xc3, 0x58, 0xb1, 0x20, 0x68, 0x7f, 0xc7, 0x02, 0xb4, 0xbc, 0x45, 0xc2, 0xd0,
-	0xad, 0x1b, 0xc1, 0xaf, 0xec, 0x32, 0x3f, 0xe3, 0xd2, 0x3e, 0xb3, 0x14, 0x41, 0xea, 0x32, 0x3f,
-	0xe3, 0x5f, 0xf6, 0x65, 0x7e, 0x85, 0xfd, 0x5d, 0xe6, 0x87, 0x96, 0xe1, 0x54, 0x93, 0x6f, 0x37,
-	0xf8, 0x05, 0x59, 0x7c, 0xef, 0xa1, 0xb2, 0x66, 0x4f, 0xdf, 0xda, 0x99, 0x3c, 0xb5, 0x94, 0x85,
-	0x80, 0xb3, 0x9f, 0xb3, 0xdf, 0x02, 0x88, 0xc7, 0xbc, 0xce, 0x65, 0x85, 0xed, 0xf5, 0x74, 0xbf,
-	0xd8, 0x5f, 0x2c, 0xc1, 0x78, 0xaa, 0x22, 0x3f, 0xdd, 0xea, 0x75, 0xc7, 0x09, 0x1e, 0x58, 0x7f,
-	0x77, 0xb3, 0xd7, 0x57, 0xe4, 0xa1, 0x0f, 0x25, 0xd7, 0x6f, 0xb5, 0xe3, 0x7c, 0x92, 0xf6, 0x39,
-	0x13, 0x0b, 0xb4, 0x43, 0xc3, 0x5d, 0x4c, 0xff, 0x62, 0x4e, 0x26, 0xcf, 0x38, 0xc6, 0x84, 0x31,
-	0x3e, 0x70, 0x97, 0xdc, 0x01, 0x1f, 0xd6, 0x51, 0x85, 0xa5, 0x3c, 0x1c, 0x8b, 0xa9, 0xc9, 0x72,
-	0xd8, 0xa1, 0x24, 0x5f, 0x2d, 0xc0, 0xb0, 0xf1, 0xd1, 0xd0, 0xaf, 0x24, 0xab, 0x0f, 0x5a, 0xf9,
-	0xbd, 0x12, 0xeb, 0x7f, 0x4a, 0xd7, 0x17, 0xe4, 0xaf, 0xf4, 0x68, 0x77, 0xe1, 0xc1, 0xdb, 0x3b,
-	0x93, 0xc7, 0x52, 0xa5, 0x05, 0x13, 0xc5, 0x08, 0xcf, 0x7c, 0x00, 0xc6, 0x53, 0xdd, 0x64, 0xbc,
-	0xf2, 0xaa, 0xf9, 0xca, 0x07, 0x76, 0x4b, 0x99, 0x43, 0xf6, 0x15, 0x3a, 0x64, 0x22, 0x57, 0x38,
-	0xf0, 0x48, 0x1f, 0x3e, 0xd8, 0x54, 0x49, 0x80, 0x42, 0x9f, 0x25, 0x01, 0x1e, 0x83, 0x72, 0x2b,
-	0xf0, 0xdc, 0x9a, 0xab, 0x8a, 0x17, 0xb3, 0x22, 0x04, 0x2b, 0xa2, 0x0d, 0x2b, 0x28, 0xba, 0x09,
-	0x95, 0x1b, 0x37, 0x63, 0x7e, 0xfa, 0x23, 0xfc, 0xdb, 0x79, 0x1d, 0xfa, 0x28, 0xa3, 0x45, 0x1d,
-	0x2f, 0x61, 0x4d, 0x0b, 0xd9, 0x30, 0xc8, 0x94, 0xa0, 0xcc, 0x1b, 0x62, 0xbe, 0x77, 0xa6, 0x1d,
-	0x23, 0x2c, 0x20, 0xf6, 0xf7, 0x00, 0x4e, 0x66, 0x5d, 0x8b, 0x82, 0xde, 0x0f, 0x83, 0x9c, 0xc7,
-	0x7c, 0x6e, 0xde, 0xca, 0xa2, 0x71, 0x81, 0x75, 0x28, 0xd8, 0x62, 0xbf, 0xb1, 0xa0, 0x29, 0xa8,
-	0x7b, 0xce, 0x9a, 0x98, 0x21, 0x87, 0x43, 0x7d, 0xd1, 0xd1, 0xd4, 0x17, 0x1d, 0x4e, 0xdd, 0x73,
-	0xd6, 0xd0, 0x36, 0x94, 0x1a, 0x6e, 0x4c, 0x1c, 0xe1, 0x44, 0xb8, 0x7e, 0x28, 0xc4, 0x89, 0xc3,
-	0xad, 0x34, 0xf6, 0x13, 0x73, 0x82, 0xe8, 0xcb, 0x16, 0x8c, 0xaf, 0x25, 0x6b, 0x91, 0x08, 0xe1,
-	0xe9, 0x1c, 0xc2, 0xd5, 0x37, 0x49, 0x42, 0xfc, 0x36, 0xcb, 0x54, 0x23, 0x4e, 0xb3, 0x83, 0x3e,
-	0x62, 0xc1, 0xd0, 0xba, 0xeb, 0x19, 0x77, 0x0b, 0x1c, 0xc2, 0xc7, 0x39, 0xcf, 0x08, 0xe8, 0x1d,
-	0x07, 0xff, 0x1f, 0x61, 0x49, 0xb9, 0x97, 0xa6, 0x1a, 0x3c, 0xa8, 0xa6, 0x1a, 0xba, 0x4b, 0x9a,
-	0xea, 0xe3, 0x16, 0x54, 0xd4, 0x48, 0x8b, 0x9a, 0x0e, 0xef, 0x3e, 0xc4, 0x4f, 0xce, 0x3d, 0x27,
-	0xea, 0x2f, 0xd6, 0xc4, 0xd1, 0x67, 0x2d, 0x18, 0x76, 0x5e, 0x6d, 0x87, 0xa4, 0x4e, 0xb6, 0x82,
-	0x56, 0x24, 0x8a, 0x2d, 0xbe, 0x94, 0x3f, 0x33, 0x33, 0x94, 0xc8, 0x3c, 0xd9, 0x5a, 0x6e, 0x45,
-	0x22, 0xa7, 0x51, 0x37, 0x60, 0x93, 0x05, 0xf4, 0x73, 0x5a, 0x8f, 0x43, 0x1e, 0x25, 0x77, 0xb3,
-	0xb8, 0x39, 0x6c, 0x65, 0xbe, 0x53, 0x80, 0xc9, 0x3d, 0x46, 0x01, 0x3d, 0x07, 0x23, 0x41, 0xd8,
-	0x70, 0x7c, 0xf7, 0x55, 0xb3, 0x40, 0x92, 0xb2, 0x14, 0x97, 0x0d, 0x18, 0x4e, 0x60, 0x9a, 0x95,
-	0x33, 0x0a, 0x7b, 0x54, 0xce, 0x38, 0x0b, 0x03, 0x21, 0x69, 0x05, 0xe9, 0x0d, 0x0f, 0xcb, 0x89,
-	0x62, 0x10, 0xf4, 0x10, 0x14, 0x9d, 0x96, 0x2b, 0xc2, 0x63, 0xd4, 0x3e, 0x6e, 0x66, 0x65, 0x01,
-	0xd3, 0xf6, 0x44, 0x21, 0x9f, 0xd2, 0x91, 0x14, 0xf2, 0xa1, 0xaa, 0x4c, 0x9c, 0xbf, 0x0c, 0x6a,
-	0x55, 0x96, 0x3c, 0x17, 0xb1, 0x5f, 0x2b, 0xc2, 0x43, 0xbb, 0xce, 0x79, 0x1d, 0x2b, 0x6b, 0xed,
-	0x12, 0x2b, 0x2b, 0x87, 0xa7, 0xb0, 0xd7, 0xf0, 0x14, 0x7b, 0x0c, 0xcf, 0x47, 0xe8, 0x52, 0x96,
-	0x85, 0xa5, 0xf2, 0xb9, 0x90, 0xb9, 0x57, 0x9d, 0x2a, 0xb1, 0x8a, 0x25, 0x14, 0x6b, 0xba, 0x74,
-	0x1f, 0x93, 0xa8, 0x1a, 0x51, 0xca, 0x43, 0x95, 0xf5, 0x2c, 0xee, 0xc4, 0xd7, 0x6f, 0xaf, 0x52,
-	0x14, 0xf6, 0xef, 0x0c, 0xc0, 0x23, 0x7d, 0x68, 0x20, 0x73, 0x16, 0x5b, 0x7d, 0xce, 0xe2, 0xef,
-	0xf3, 0xcf, 0xf4, 0xb1, 0xcc, 0xcf, 0x84, 0xf3, 0xff, 0x4c, 0xbb, 0x7f, 0x21, 0xf4, 0x38, 0x94,
-	0x5d, 0x3f, 0x22, 0xb5, 0x76, 0xc8, 0xf3, 0x06, 0x8c, 0x84, 0xc9, 0x05, 0xd1, 0x8e, 0x15, 0x06,
-	0xdd, 0x97, 0xd6, 0x1c, 0xba, 0xfc, 0x87, 0x72, 0xaa, 0x12, 0x60, 0xe6, 0x5e, 0x72, 0xb3, 0x68,
-	0x6e, 0x86, 0x4a, 0x00, 0x4e, 0xc6, 0xfe, 0x25, 0x0b, 0xce, 0xf4, 0x36, 0x13, 0xd0, 0x93, 0x30,
-	0xbc, 0xc6, 0x82, 0xcf, 0xd8, 0x55, 0xfc, 0x72, 0xea, 0xb0, 0xf7, 0xd5, 0xcd, 0xd8, 0xc4, 0x41,
-	0x73, 0x70, 0xdc, 0x8c, 0x5a, 0x5b, 0x32, 0x22, 0x63, 0x98, 0x23, 0x63, 0x35, 0x0d, 0xc4, 0xdd,
-	0xf8, 0xf6, 0x77, 0x8b, 0xd9, 0x6c, 0x71, 0x73, 0x72, 0x3f, 0xb3, 0x59, 0xcc, 0xd5, 0x42, 0x1f,
-	0x12, 0xb7, 0x78, 0xd4, 0x12, 0x77, 0xa0, 0x97, 0xc4, 0x45, 0xf3, 0x70, 0xcc, 0xb8, 0x2b, 0x91,
-	0xd7, 0x8d, 0xe0, 0x91, 0x92, 0xaa, 0xe8, 0xd3, 0x4a, 0x0a, 0x8e, 0xbb, 0x9e, 0xb8, 0xc7, 0xa7,
-	0xde, 0xd7, 0x0a, 0x70, 0xba, 0xa7, 0x05, 0x7f, 0x44, 0x1a, 0xc5, 0xfc, 0xfc, 0x03, 0x47, 0xf3,
-	0xf9, 0xcd, 0x8f, 0x52, 0xda, 0xf3, 0xa3, 0xf4, 0xa3, 0x9e, 0xff, 0xa4, 0xd0, 0x73, 0xb1, 0xd0,
-	0x1d, 0xdf, 0x0f, 0xec, 0x48, 0x3e, 0x0f, 0xa3, 0x4e, 0xab, 0xc5, 0xf1, 0x58, 0x64, 0x7a, 0xaa,
-	0x10, 0xdd, 0x8c, 0x09, 0xc4, 0x49, 0xdc, 0xbe, 0x06, 0xf6, 0xcf, 0x2d, 0xa8, 0x60, 0xb2, 0xce,
-	0x25, 0x16, 0xba, 0x21, 0x86, 0xc8, 0xca, 0xa3, 0x1a, 0x38, 0x1d, 0xd8, 0xc8, 0x65, 0x25, 0xb2,
-	0xb3, 0x06, 0xfb, 0xa0, 0xa9, 0xdc, 0xea, 0x86, 0xc5, 0x62, 0xef, 0x1b, 0x16, 0xed, 0xbf, 0x2c,
-	0xd3, 0xd7, 0x6b, 0x05, 0x73, 0x21, 0xa9, 0x47, 0xf4, 0xfb, 0xb6, 0x43, 0x4f, 0x4c, 0x12, 0xf5,
-	0x7d, 0xaf, 0xe2, 0x45, 0x4c, 0xdb, 0x13, 0x07, 0x81, 0x85, 0x7d, 0x95, 0xe1, 0x2a, 0xee, 0x59,
-	0x86, 0xeb, 0x79, 0x18, 0x8d, 0xa2, 0x8d, 0x95, 0xd0, 0xdd, 0x72, 0x62, 0x72, 0x99, 0x74, 0x84,
-	0x7d, 0xac, 0x4b, 0xd2, 0x54, 0x2f, 0x6a, 0x20, 0x4e, 0xe2, 0xa2, 0x0b, 0x70, 0x5c, 0x17, 0xc3,
-	0x22, 0x61, 0xcc, 0x72, 0xa7, 0xf8, 0x4c, 0x50, 0xf5, 0x27, 0x74, 0xf9, 0x2c, 0x81, 0x80, 0xbb,
-	0x9f, 0xa1, 0x32, 0x37, 0xd1, 0x48, 0x19, 0x19, 0x4c, 0xca, 0xdc, 0x44, 0x3f, 0x94, 0x97, 0xae,
-	0x27, 0xd0, 0x12, 0x9c, 0xe0, 0x13, 0x63, 0xa6, 0xd5, 0x32, 0xde, 0x68, 0x28, 0x59, 0x82, 0xf9,
-	0x42, 0x37, 0x0a, 0xce, 0x7a, 0x0e, 0x3d, 0x0b, 0xc3, 0xaa, 0x79, 0x61, 0x5e, 0x9c, 0x61, 0x29,
-	0x1f, 0x9a, 0xea, 0x66, 0xa1, 0x8e, 0x4d, 0x3c, 0xf4, 0x2e, 0xb8, 0x5f, 0xff, 0xe5, 0xb9, 0xb8,
-	0xfc, 0x60, 0x77, 0x5e, 0xd4, 0x19, 0x54, 0x37, 0xfc, 0x5c, 0xc8, 0x44, 0xab, 0xe3, 0x5e, 0xcf,
-	0xa3, 0x35, 0x38, 0xa3, 0x40, 0xe7, 0xfc, 0x98, 0x65, 0xcb, 0x45, 0x64, 0xd6, 0x89, 0xc8, 0xd5,
-	0xd0, 0x63, 0x95, 0x09, 0x2b, 0xfa, 0xca, 0xf7, 0x0b, 0x6e, 0x7c, 0x31, 0x0b, 0x13, 0x2f, 0xe2,
-	0x5d, 0x7a, 0x41, 0xd3, 0x50, 0x21, 0xbe, 0xb3, 0xe6, 0x91, 0xe5, 0xb9, 0x05, 0x56, 0xaf, 0xd0,
-	0x38, 0x47, 0x3e, 0x27, 0x01, 0x58, 0xe3, 0xa8, 0xf8, 0xe6, 0x91, 0x5e, 0xf1, 0xcd, 0x68, 0x05,
-	0x4e, 0x36, 0x6a, 0x2d, 0x6a, 0x35, 0xba, 0x35, 0x32, 0x53, 0x63, 0xe1, 0x9c, 0xf4, 0xc3, 0xf0,
-	0xda, 0xd8, 0x2a, 0x51, 0xe4, 0xc2, 0xdc, 0x4a, 0x17, 0x0e, 0xce, 0x7c, 0x92, 0x85, 0xfd, 0x86,
-	0xc1, 0x76, 0x67, 0xe2, 0x44, 0x2a, 0xec, 0x97, 0x36, 0x62, 0x0e, 0x43, 0x97, 0x00, 0xb1, 0xac,
-	0xa3, 0x8b, 0x71, 0xdc, 0x52, 0x66, 0xea, 0xc4, 0xc9, 0x64, 0xd5, 0xb1, 0xf3, 0x5d, 0x18, 0x38,
-	0xe3, 0x29, 0x6a, 0xf5, 0xf8, 0x01, 0xeb, 0x7d, 0xe2, 0xfe, 0xa4, 0xd5, 0x73, 0x85, 0x37, 0x63,
-	0x09, 0x47, 0xef, 0x81, 0x89, 0x76, 0x44, 0xd8, 0x06, 0xf8, 0x7a, 0x10, 0x6e, 0x7a, 0x81, 0x53,
-	0x5f, 0x60, 0x57, 0x39, 0xc6, 0x9d, 0x89, 0x09, 0x46, 0xfc, 0xac, 0x78, 0x76, 0xe2, 0x6a, 0x0f,
-	0x3c, 0xdc, 0xb3, 0x87, 0x74, 0xd9, 0xbc, 0xd3, 0xfd, 0x95, 0xcd, 0xb3, 0xff, 0xcc, 0x82, 0x51,
-	0x25, 0x6f, 0x8e, 0x20, 0x57, 0xd1, 0x4b, 0xe6, 0x2a, 0x5e, 0x38, 0xb8, 0xc4, 0x66, 0x9c, 0xf7,
-	0x48, 0x08, 0xf8, 0xd7, 0x23, 0x00, 0x5a, 0xaa, 0x2b, 0x85, 0x6a, 0xf5, 0x54, 0xa8, 0xf7, 0xac,
-	0x44, 0xcd, 0x2a, 0x5a, 0x56, 0xba, 0xbb, 0x45, 0xcb, 0xaa, 0x70, 0x4a, 0x9a, 0x44, 0xfc, 0xa4,
-	0xf5, 0x62, 0x10, 0x29, 0x01, 0x6d, 0x5c, 0xcd, 0xb5, 0x90, 0x85, 0x84, 0xb3, 0x9f, 0x4d, 0x58,
-	0x62, 0x43, 0x7b, 0x5a, 0x62, 0x4a, 0x26, 0x2d, 0xae, 0xcb, 0x8b, 0xf3, 0x52, 0x32, 0x69, 0xf1,
-	0x7c, 0x15, 0x6b, 0x9c, 0x6c, 0xc5, 0x54, 0xc9, 0x49, 0x31, 0xc1, 0xbe, 0x15, 0x93, 0x14, 0x91,
-	0xc3, 0x3d, 0x45, 0xa4, 0x3c, 0xd1, 0x19, 0xe9, 0x79, 0xa2, 0xf3, 0x76, 0x18, 0x73, 0xfd, 0x0d,
-	0x12, 0xba, 0x31, 0xa9, 0xb3, 0xb5, 0xc0, 0xc4, 0x67, 0x59, 0x9b, 0x25, 0x0b, 0x09, 0x28, 0x4e,
-	0x61, 0x27, 0xe5, 0xfa, 0x58, 0x1f, 0x72, 0xbd, 0x87, 0x36, 0x1d, 0xcf, 0x47, 0x9b, 0x1e, 0x3b,
-	0xb8, 0x36, 0x3d, 0x7e, 0xa8, 0xda, 0x14, 0xe5, 0xa2, 0x4d, 0xfb, 0x52, 0x54, 0xc6, 0x96, 0xfa,
-	0xe4, 0x1e, 0x5b, 0xea, 0x5e, 0xaa, 0xf4, 0xd4, 0x1d, 0xab, 0xd2, 0x6c, 0x2d, 0x79, 0xdf, 0x0f,
-	0xa5, 0x96, 0xfc, 0x78, 0x01, 0x4e, 0x69, 0x3d, 0x42, 0x57, 0xaf, 0xbb, 0x4e, 0x25, 0x29, 0xbb,
-	0x3b, 0x96, 0x9f, 0xda, 0x1a, 0x69, 0xb8, 0x3a, 0xa3, 0x57, 0x41, 0xb0, 0x81, 0xc5, 0xb2, 0x59,
-	0x49, 0xc8, 0x6e, 0x2d, 0x48, 0x2b, 0x99, 0x39, 0xd1, 0x8e, 0x15, 0x06, 0x65, 0x99, 0xfe, 0x16,
-	0x55, 0x09, 0xd2, 0xf5, 0x70, 0xe7, 0x34, 0x08, 0x9b, 0x78, 0xe8, 0x31, 0x4e, 0x84, 0x09, 0x38,
-	0xaa, 0x68, 0x46, 0xf8, 0x96, 0x4d, 0xc9, 0x34, 0x05, 0x95, 0xec, 0xb0, 0xb4, 0xe5, 0x52, 0x37,
-	0x3b, 0x2c, 0x00, 0x52, 0x61, 0xd8, 0xff, 0xd3, 0x82, 0xd3, 0x99, 0x43, 0x71, 0x04, 0xc6, 0xc3,
-	0x76, 0xd2, 0x78, 0xa8, 0xe6, 0xb5, 0xdd, 0x33, 0xde, 0xa2, 0x87, 0x21, 0xf1, 0x1f, 0x2d, 0x18,
-	0xd3, 0xf8, 0x47, 0xf0, 0xaa, 0x6e, 0xf2, 0x55, 0xf3, 0xdb, 0xd9, 0x56, 0xba, 0xde, 0xed, 0x0f,
-	0x0a, 0xa0, 0x6a, 0x54, 0xcf, 0xd4, 0xe4, 0x0d, 0x00, 0x7b, 0xc4, 0x11, 0x74, 0x60, 0x90, 0x85,
-	0x41, 0x44, 0xf9, 0x84, 0x78, 0x25, 0xe9, 0xb3, 0x90, 0x0a, 0x7d, 0x2a, 0xc5, 0xfe, 0x46, 0x58,
-	0x10, 0x64, 0x77, 0x6a, 0xf0, 0xf2, 0xbf, 0x75, 0x91, 0x94, 0xa9, 0xef, 0xd4, 0x10, 0xed, 0x58,
-	0x61, 0x50, 0xf5, 0xe6, 0xd6, 0x02, 0x7f, 0xce, 0x73, 0x22, 0x79, 0x9d, 0xbc, 0x52, 0x6f, 0x0b,
-	0x12, 0x80, 0x35, 0x0e, 0x8b, 0x90, 0x70, 0xa3, 0x96, 0xe7, 0x74, 0x0c, 0xff, 0x85, 0x51, 0x7d,
-	0x47, 0x81, 0xb0, 0x89, 0x67, 0x37, 0x61, 0x22, 0xf9, 0x12, 0xf3, 0x64, 0x9d, 0x85, 0x27, 0xf7,
-	0x35, 0x9c, 0xd3, 0x50, 0x71, 0xd8, 0x53, 0x8b, 0x6d, 0x47, 0xc8, 0x04, 0x1d, 0xa4, 0x2b, 0x01,
-	0x58, 0xe3, 0xd8, 0xff, 0xd4, 0x82, 0x13, 0x19, 0x83, 0x96, 0x63, 0xd2, 0x6b, 0xac, 0xa5, 0x4d,
-	0x96, 0x61, 0xf2, 0x63, 0x30, 0x54, 0x27, 0xeb, 0x8e, 0x0c, 0x80, 0x35, 0x44, 0xfa, 0x3c, 0x6f,
-	0xc6, 0x12, 0x6e, 0xff, 0x76, 0x01, 0xc6, 0x93, 0xbc, 0x46, 0x2c, 0x91, 0x8c, 0x0f, 0x93, 0x1b,
-	0xd5, 0x82, 0x2d, 0x12, 0x76, 0xe8, 0x9b, 0x5b, 0xa9, 0x44, 0xb2, 0x2e, 0x0c, 0x9c, 0xf1, 0x14,
-	0xab, 0x50, 0x5f, 0x57, 0xa3, 0x2d, 0x67, 0xe4, 0xb5, 0x3c, 0x67, 0xa4, 0xfe, 0x98, 0x66, 0xb0,
-	0x8c, 0x22, 0x89, 0x4d, 0xfa, 0xd4, 0x40, 0x62, 0x91, 0xf9, 0xb3, 0x6d, 0xd7, 0x8b, 0x5d, 0x5f,
-	0xbc, 0xb2, 0x98, 0xab, 0xca, 0x40, 0x5a, 0xea, 0x46, 0xc1, 0x59, 0xcf, 0xd9, 0xdf, 0x19, 0x00,
-	0x55, 0xd0, 0x81, 0x05, 0x33, 0xe6, 0x14, 0x0a, 0xba, 0xdf, 0x74, 0x44, 0x35, 0xb7, 0x06, 0x76,
-	0x8b, 0x2e, 0xe2, 0x4e, 0x2f, 0xd3, 0x3b, 0xae, 0x06, 0x6c, 0x55, 0x83, 0xb0, 0x89, 0x47, 0x39,
-	0xf1, 0xdc, 0x2d, 0xc2, 0x1f, 0x1a, 0x4c, 0x72, 0xb2, 0x28, 0x01, 0x58, 0xe3, 0x50, 0x4e, 0xea,
-	0xee, 0xfa, 0xba, 0xf0, 0xe0, 0x28, 0x4e, 0xe8, 0xe8, 0x60, 0x06, 0xe1, 0x77, 0x98, 0x04, 0x9b,
-	0x62, 0x53, 0x60, 0xdc, 0x61, 0x12, 0x6c, 0x62, 0x06, 0xa1, 0x5f, 0xc9, 0x0f, 0xc2, 0xa6, 0xe3,
-	0xb9, 0xaf, 0x92, 0xba, 0xa2, 0x22, 0x36, 0x03, 0xea, 0x2b, 0x5d, 0xe9, 0x46, 0xc1, 0x59, 0xcf,
-	0xd1, 0x09, 0xdd, 0x0a, 0x49, 0xdd, 0xad, 0xc5, 0x66, 0x6f, 0x90, 0x9c, 0xd0, 0x2b, 0x5d, 0x18,
-	0x38, 0xe3, 0x29, 0x34, 0x03, 0xe3, 0xb2, 0x20, 0x87, 0xac, 0x06, 0x37, 0x9c, 0x2c, 0x29, 0x85,
-	0x93, 0x60, 0x9c, 0xc6, 0xa7, 0x42, 0xb2, 0x29, 0x6a, 0x59, 0xb2, 0xbd, 0x83, 0x21, 0x24, 0x65,
-	0x8d, 0x4b, 0xac, 0x30, 0xec, 0x0f, 0x17, 0xa9, 0x52, 0xef, 0x51, 0x32, 0xf6, 0xc8, 0x42, 0x8f,
-	0x93, 0x33, 0x72, 0xa0, 0x8f, 0x19, 0xf9, 0x0c, 0x8c, 0xdc, 0x88, 0x02, 0x5f, 0x85, 0xf5, 0x96,
-	0x7a, 0x86, 0xf5, 0x1a, 0x58, 0xd9, 0x61, 0xbd, 0x83, 0x79, 0x85, 0xf5, 0x0e, 0xdd, 0x61, 0x58,
-	0xef, 0x1f, 0x96, 0x40, 0x5d, 0x52, 0x77, 0x85, 0xc4, 0x37, 0x83, 0x70, 0xd3, 0xf5, 0x1b, 0xac,
-	0xb8, 0xc4, 0x97, 0x2d, 0x59, 0x9f, 0x62, 0xd1, 0x4c, 0xcb, 0x5c, 0xcf, 0xe9, 0xa2, 0xb1, 0x04,
-	0xb1, 0xa9, 0x55, 0x83, 0x50, 0xea, 0xfa, 0x7f, 0x13, 0x84, 0x13, 0x1c, 0xa1, 0x0f, 0x00, 0x48,
-	0x77, 0xf7, 0xba, 0x94, 0xc0, 0x0b, 0xf9, 0xf0, 0x87, 0xc9, 0xba, 0x36, 0xa9, 0x57, 0x15, 0x11,
-	0x6c, 0x10, 0x44, 0x1f, 0xd7, 0x29, 0xab, 0x3c, 0xff, 0xe7, 0x7d, 0x87, 0x32, 0x36, 0xfd, 0x24,
-	0xac, 0x62, 0x18, 0x72, 0xfd, 0x06, 0x9d, 0x27, 0x22, 0xfc, 0xf1, 0x4d, 0x59, 0x45, 0x80, 0x16,
-	0x03, 0xa7, 0x3e, 0xeb, 0x78, 0x8e, 0x5f, 0x23, 0xe1, 0x02, 0x47, 0xd7, 0x1a, 0x54, 0x34, 0x60,
-	0xd9, 0x51, 0xd7, 0x4d, 0x7a, 0xa5, 0x7e, 0x6e, 0xd2, 0x3b, 0xf3, 0x0e, 0x38, 0xde, 0xf5, 0x31,
-	0xf7, 0x95, 0x9f, 0x7a, 0xe7, 0xa9, 0xad, 0xf6, 0xef, 0x0c, 0x6a, 0xa5, 0x75, 0x25, 0xa8, 0xf3,
-	0x8b, 0xd9, 0x42, 0xfd, 0x45, 0x85, 0xc9, 0x9c, 0xe3, 0x14, 0x51, 0x6a, 0xc6, 0x68, 0xc4, 0x26,
-	0x49, 0x3a, 0x47, 0x5b, 0x4e, 0x48, 0xfc, 0xc3, 0x9e, 0xa3, 0x2b, 0x8a, 0x08, 0x36, 0x08, 0xa2,
-	0x8d, 0x44, 0x82, 0xda, 0xf9, 0x83, 0x27, 0xa8, 0xb1, 0x92, 0x8c, 0x59, 0xf7, 0x17, 0x7d, 0xd6,
-	0x82, 0x31, 0x3f, 0x31, 0x73, 0xf3, 0x89, 0x49, 0xcf, 0x5e, 0x15, 0xfc, 0x8e, 0xd3, 0x64, 0x1b,
-	0x4e, 0xd1, 0xcf, 0x52, 0x69, 0xa5, 0x7d, 0xaa, 0x34, 0x7d, 0x31, 0xe4, 0x60, 0xaf, 0x8b, 0x21,
-	0x91, 0xaf, 0x6e, 0xec, 0x1d, 0xca, 0xa3, 0xf6, 0x43, 0xe2, 0xba, 0x5e, 0xc8, 0xb8, 0xaa, 0xf7,
-	0x3a, 0x54, 0x6a, 0x21, 0x71, 0xe2, 0x3b, 0xbc, 0xb9, 0x95, 0x45, 0xca, 0xcc, 0xc9, 0x0e, 0xb0,
-	0xee, 0xcb, 0xfe, 0x3f, 0x03, 0x70, 0x4c, 0x8e, 0x88, 0xcc, 0x67, 0xa1, 0xfa, 0x91, 0xd3, 0xd5,
-	0xb6, 0xb2, 0xd2, 0x8f, 0x17, 0x25, 0x00, 0x6b, 0x1c, 0x6a, 0x8f, 0xb5, 0x23, 0xb2, 0xdc, 0x22,
-	0xfe, 0xa2, 0xbb, 0x16, 0x89, 0xa3, 0x6d, 0xb5, 0x50, 0xae, 0x6a, 0x10, 0x36, 0xf1, 0xa8, 0x6d,
-	0xef, 0x18, 0x46, 0xab, 0x61, 0xdb, 0x4b, 0x43, 0x55, 0xc2, 0xd1, 0x17, 0x32, 0x6b, 0xd8, 0xe7,
-	0x93, 0x05, 0xda, 0x95, 0xc6, 0xb3, 0xcf, 0xab, 0xc7, 0xff, 0xa1, 0x05, 0xa7, 0x78, 0xab, 0x1c,
-	0xc9, 0xab, 0xad, 0xba, 0x13, 0x93, 0x28, 0x9f, 0xbb, 0x6f, 0x32, 0xf8, 0xd3, 0x3e, 0xef, 0x2c,
-	0xb2, 0x38, 0x9b, 0x1b, 0xf4, 0x19, 0x0b, 0xc6, 0x37, 0x13, 0x05, 0x84, 0xa4, 0xea, 0x38, 0x68,
-	0x6d, 0x8f, 0x44, 0xa7, 0x7a, 0xa9, 0x25, 0xdb, 0x23, 0x9c, 0xa6, 0x6e, 0xff, 0x0f, 0x0b, 0x4c,
-	0x31, 0x7a, 0xf4, 0x75, 0x87, 0xf6, 0x6f, 0x0a, 0x4a, 0xeb, 0xb2, 0xd4, 0xd3, 0xba, 0x7c, 0x08,
-	0x8a, 0x6d, 0xb7, 0x2e, 0xf6, 0x17, 0xfa, 0x30, 0x7d, 0x61, 0x1e, 0xd3, 0x76, 0xfb, 0xdb, 0x25,
-	0xed, 0x06, 0x11, 0x49, 0x96, 0x3f, 0x10, 0xaf, 0xbd, 0xae, 0x2a, 0x73, 0xf2, 0x37, 0xbf, 0xd2,
-	0x55, 0x99, 0xf3, 0xad, 0xfb, 0xcf, 0xa1, 0xe5, 0x03, 0xd4, 0xab, 0x30, 0xe7, 0xd0, 0x1e, 0x09,
-	0xb4, 0x37, 0xa0, 0x4c, 0xb7, 0x60, 0xcc, 0x9f, 0x59, 0x4e, 0x30, 0x55, 0xbe, 0x28, 0xda, 0x6f,
-	0xef, 0x4c, 0xfe, 0xe4, 0xfe, 0xd9, 0x92, 0x4f, 0x63, 0xd5, 0x3f, 0x8a, 0xa0, 0x42, 0x7f, 0xb3,
-	0x5c, 0x5f, 0xb1, 0xb9, 0xbb, 0xaa, 0x64, 0xa6, 0x04, 0xe4, 0x92, 0x48, 0xac, 0xe9, 0x20, 0x1f,
-	0x2a, 0x14, 0x91, 0x13, 0xe5, 0x7b, 0xc0, 0x15, 0x95, 0x71, 0x2b, 0x01, 0xb7, 0x77, 0x26, 0x9f,
-	0xdf, 0x3f, 0x51, 0xf5, 0x38, 0xd6, 0x24, 0x0c, 0xd5, 0x38, 0xdc, 0xf3, 0xce, 0xe4, 0xff, 0x3b,
-	0xa0, 0xe7, 0xb7, 0x28, 0xda, 0xfa, 0x03, 0x31, 0xbf, 0x9f, 0x4b, 0xcd, 0xef, 0xb3, 0x5d, 0xf3,
-	0x7b, 0x4c, 0x5f, 0xf3, 0x9f, 0x98, 0xb1, 0x47, 0x6d, 0x2c, 0xec, 0xed, 0x93, 0x60, 0x56, 0xd2,
-	0x2b, 0x6d, 0x37, 0x24, 0xd1, 0x4a, 0xd8, 0xf6, 0x5d, 0xbf, 0xc1, 0xa6, 0x6c, 0xd9, 0xb4, 0x92,
-	0x12, 0x60, 0x9c, 0xc6, 0xa7, 0x1b, 0x7f, 0x3a, 0x2f, 0xae, 0x3b, 0x5b, 0x7c, 0xe6, 0x19, 0x75,
-	0xfe, 0xaa, 0xa2, 0x1d, 0x2b, 0x0c, 0xb4, 0x01, 0x0f, 0xca, 0x0e, 0xe6, 0x89, 0x47, 0xc4, 0x25,
-	0xfd, 0xeb, 0x6e, 0xd8, 0xe4, 0x21, 0xf9, 0x3c, 0xce, 0xe3, 0x8d, 0xa2, 0x87, 0x07, 0xf1, 0x2e,
-	0xb8, 0x78, 0xd7, 0x9e, 0xec, 0xaf, 0xb0, 0x40, 0x03, 0xa3, 0xe4, 0x01, 0x9d, 0x7d, 0x9e, 0xdb,
-	0x74, 0x65, 0x39, 0x42, 0x35, 0xfb, 0x16, 0x69, 0x23, 0xe6, 0x30, 0x74, 0x13, 0x86, 0xd6, 0xf8,
-	0x5d, 0xca, 0xf9, 0xdc, 0xdb, 0x22, 0x2e, 0x66, 0x66, 0x35, 0x7d, 0xe5, 0x2d, 0xcd, 0xb7, 0xf5,
-	0x4f, 0x2c, 0xa9, 0xd9, 0xdf, 0x28, 0xc1, 0xb8, 0x0c, 0xdd, 0xba, 0xe8, 0x46, 0x2c, 0x7e, 0xc0,
-	0x2c, 0x74, 0x5e, 0xd8, 0xb3, 0xd0, 0xf9, 0x7b, 0x01, 0xea, 0xa4, 0xe5, 0x05, 0x1d, 0x66, 0x1c,
-	0x0e, 0xec, 0xdb, 0x38, 0x54, 0xfb, 0x89, 0x79, 0xd5, 0x0b, 0x36, 0x7a, 0x14, 0x35, 0x18, 0x79,
-	0xdd, 0xf4, 0x54, 0x0d, 0x46, 0xe3, 0x76, 0xa7, 0xc1, 0xa3, 0xbd, 0xdd, 0xc9, 0x85, 0x71, 0xce,
-	0xa2, 0x2a, 0x2c, 0x70, 0x07, 0xf5, 0x03, 0x58, 0x6a, 0xd6, 0x7c, 0xb2, 0x1b, 0x9c, 0xee, 0xd7,
-	0xbc, 0xba, 0xa9, 0x7c, 0xd4, 0x57, 0x37, 0xbd, 0x19, 0x2a, 0xf2, 0x3b, 0x47, 0x13, 0x15, 0x5d,
-	0x9c, 0x45, 0x4e, 0x83, 0x08, 0x6b, 0x78, 0x57, 0x8d, 0x14, 0xb8, 0x5b, 0x35, 0x52, 0xec, 0x4f,
-	0x17, 0xe8, 0xae, 0x82, 0xf3, 0xa5, 0xca, 0x7d, 0x3d, 0x0a, 0x83, 0x4e, 0x3b, 0xde, 0x08, 0xba,
-	0x6e, 0x63, 0x9e, 0x61, 0xad, 0x58, 0x40, 0xd1, 0x22, 0x0c, 0xd4, 0x75, 0x09, 0xa7, 0xfd, 0x7c,
-	0x4f, 0xed, 0xa0, 0x75, 0x62, 0x82, 0x59, 0x2f, 0xe8, 0x41, 0x18, 0x88, 0x9d, 0x86, 0xcc, 0x26,
-	0x65, 0x15, 0x04, 0x56, 0x9d, 0x46, 0x84, 0x59, 0xeb, 0x7e, 0xca, 0xd6, 0x3e, 0x0f, 0xa3, 0x91,
-	0xdb, 0xf0, 0x9d, 0xb8, 0x1d, 0x12, 0xe3, 0x0c, 0x53, 0x87, 0xd5, 0x98, 0x40, 0x9c, 0xc4, 0xb5,
-	0x7f, 0x77, 0x04, 0x4e, 0x56, 0xe7, 0x96, 0xe4, 0x1d, 0x1d, 0x87, 0x96, 0x10, 0x9a, 0x45, 0xe3,
-	0xe8, 0x12, 0x42, 0x7b, 0x50, 0xf7, 0x8c, 0x84, 0x50, 0xcf, 0x48, 0x08, 0x4d, 0x66, 0xe7, 0x15,
-	0xf3, 0xc8, 0xce, 0xcb, 0xe2, 0xa0, 0x9f, 0xec, 0xbc, 0x43, 0xcb, 0x10, 0xdd, 0x95, 0xa1, 0x7d,
-	0x65, 0x88, 0xaa, 0xf4, 0xd9, 0x5c, 0x72, 0x8e, 0x7a, 0x7c, 0xaa, 0xcc, 0xf4, 0x59, 0x95, 0xba,
-	0xc8, 0xf3, 0xe9, 0x84, 0xa8, 0x7f, 0x29, 0x7f, 0x06, 0xfa, 0x48, 0x5d, 0x14, 0x29, 0x7d, 0x66,
-	0xba, 0xec, 0x50, 0x1e, 0xe9, 0xb2, 0x59, 0xec, 0xec, 0x99, 0x2e, 0xfb, 0x3c, 0x8c, 0xd6, 0xbc,
-	0xc0, 0x27, 0x2b, 0x61, 0x10, 0x07, 0xb5, 0x40, 0xde, 0x07, 0xab, 0xaf, 0x33, 0x33, 0x81, 0x38,
-	0x89, 0xdb, 0x2b, 0xd7, 0xb6, 0x72, 0xd0, 0x5c, 0x5b, 0xb8, 0x4b, 0xb9, 0xb6, 0x46, 0x36, 0xe9,
-	0x70, 0x1e, 0xd9, 0xa4, 0x59, 0x5f, 0xa4, 0xaf, 0x0b, 0x5f, 0x5f, 0xe3, 0xd7, 0x21, 0x53, 0x13,
-	0x7c, 0x2e, 0x68, 0x52, 0xc3, 0x6f, 0x84, 0x0d, 0xc9, 0xcb, 0x87, 0x30, 0x61, 0xaf, 0x57, 0x35,
-	0x19, 0x75, 0x45, 0xb2, 0x6e, 0xc2, 0x49, 0x46, 0x0e, 0x92, 0xe8, 0xfa, 0xc5, 0x02, 0xfc, 0xc8,
-	0x9e, 0x2c, 0xa0, 0x9b, 0x00, 0xb1, 0xd3, 0x10, 0x13, 0x55, 0x1c, 0xdf, 0x1c, 0x30, 0xf6, 0x75,
-	0x55, 0xf6, 0xc7, 0xcb, 0x2d, 0xa9, 0xbf, 0xec, 0x60, 0x44, 0xfe, 0x66, 0x21, 0xaf, 0x81, 0xd7,
-	0x55, 0x95, 0x16, 0x07, 0x1e, 0xc1, 0x0c, 0x42, 0xd5, 0x7f, 0x48, 0x1a, 0xd4, 0xa4, 0x2d, 0x26,
-	0xd5, 0x3f, 0x66, 0xad, 0x58, 0x40, 0xd1, 0xb3, 0x30, 0xec, 0x78, 0x1e, 0x4f, 0x08, 0x23, 0x91,
-	0xb8, 0x67, 0x50, 0x97, 0xc7, 0xd4, 0x20, 0x6c, 0xe2, 0xd9, 0x7f, 0x55, 0x80, 0xc9, 0x3d, 0x64,
-	0x4a, 0x57, 0x22, 0x70, 0xa9, 0xef, 0x44, 0x60, 0x91, 0x00, 0x33, 0xd8, 0x23, 0x01, 0xe6, 0x59,
-	0x18, 0x8e, 0x89, 0xd3, 0x14, 0xd1, 0x72, 0xc2, 0x2f, 0xa1, 0xcf, 0xa3, 0x35, 0x08, 0x9b, 0x78,
-	0x54, 0x8a, 0x8d, 0x39, 0xb5, 0x1a, 0x89, 0x22, 0x99, 0xe1, 0x22, 0x7c, 0xbb, 0xb9, 0xa5, 0xcf,
-	0x30, 0x97, 0xf9, 0x4c, 0x82, 0x04, 0x4e, 0x91, 0x4c, 0x0f, 0x78, 0xa5, 0xcf, 0x01, 0xff, 0xd5,
-	0x02, 0x3c, 0xb4, 0xab, 0x76, 0xeb, 0x3b, 0xf9, 0xa8, 0x1d, 0x91, 0x30, 0x3d, 0x71, 0xae, 0x46,
-	0x24, 0xc4, 0x0c, 0xc2, 0x47, 0xa9, 0xd5, 0x52, 0x91, 0xce, 0xf9, 0x67, 0xeb, 0xf1, 0x51, 0x4a,
-	0x90, 0xc0, 0x29, 0x92, 0x77, 0x3a, 0x2d, 0xbf, 0x31, 0x00, 0x8f, 0xf4, 0x61, 0x03, 0xe4, 0x98,
-	0xd5, 0x98, 0xcc, 0xc0, 0x2d, 0xde, 0xa5, 0x0c, 0xdc, 0x3b, 0x1b, 0xae, 0xd7, 0x13, 0x77, 0xfb,
-	0xca, 0x9e, 0xfc, 0x4a, 0x01, 0xce, 0xf4, 0x36, 0x58, 0xd0, 0xdb, 0x60, 0x3c, 0x54, 0x81, 0x78,
-	0x66, 0xf2, 0xee, 0x09, 0xee, 0xd9, 0x49, 0x80, 0x70, 0x1a, 0x17, 0x4d, 0x01, 0xb4, 0x9c, 0x78,
-	0x23, 0x3a, 0xb7, 0xed, 0x46, 0xb1, 0x28, 0x43, 0x36, 0xc6, 0xcf, 0x1b, 0x65, 0x2b, 0x36, 0x30,
-	0x28, 0x39, 0xf6, 0x6f, 0x3e, 0xb8, 0x12, 0xc4, 0xfc, 0x21, 0xbe, 0xd9, 0x3a, 0x21, 0x2f, 0x25,
-	0x33, 0x40, 0x38, 0x8d, 0x4b, 0xc9, 0xb1, 0x13, 0x6d, 0xce, 0x28, 0xdf, 0x85, 0x31, 0x72, 0x8b,
-	0xaa, 0x15, 0x1b, 0x18, 0xe9, 0xb4, 0xe4, 0xd2, 0xde, 0x69, 0xc9, 0xf6, 0xbf, 0x2c, 0xc0, 0xe9,
-	0x9e, 0x06, 0x6f, 0x7f, 0x62, 0xea, 0xde, 0x4b, 0x25, 0xbe, 0xc3, 0x15, 0xb6, 0xaf, 0x14, 0x54,
-	0xfb, 0xcf, 0x7b, 0xcc, 0x34, 0x91, 0x5e, 0x7a, 0xe7, 0x95, 0x35, 0xee, 0xbd, 0xf1, 0xec, 0xca,
-	0x28, 0x1d, 0xd8, 0x47, 0x46, 0x69, 0xea, 0x63, 0x94, 0xfa, 0xd4, 0x0e, 0xff, 0x75, 0xa0, 0xe7,
-	0xf0, 0xd2, 0x0d, 0x72, 0x5f, 0x7e, 0xf3, 0x79, 0x38, 0xe6, 0xfa, 0xec, 0x9a, 0xc9, 0x6a, 0x7b,
-	0x4d, 0x54, 0xa6, 0xe2, 0xe5, 0x57, 0x55, 0x86, 0xc8, 0x42, 0x0a, 0x8e, 0xbb, 0x9e, 0xb8, 0x07,
-	0x33, 0x7c, 0xef, 0x6c, 0x48, 0xf7, 0x29, 0xb9, 0x97, 0xe1, 0x94, 0x1c, 0x8a, 0x0d, 0x27, 0x24,
-	0x75, 0xa1, 0x6c, 0x23, 0x91, 0x13, 0x74, 0x9a, 0xe7, 0x15, 0x65, 0x20, 0xe0, 0xec, 0xe7, 0xd8,
-	0x9d, 0x80, 0x41, 0xcb, 0xad, 0x89, 0xad, 0xa0, 0xbe, 0x13, 0x90, 0x36, 0x62, 0x0e, 0xd3, 0xfa,
-	0xa2, 0x72, 0x34, 0xfa, 0xe2, 0xbd, 0x50, 0x51, 0xe3, 0xcd, 0x33, 0x09, 0xd4, 0x24, 0xef, 0xca,
-	0x24, 0x50, 0x33, 0xdc, 0xc0, 0xda, 0xeb, 0xea, 0xed, 0xa7, 0x61, 0x44, 0x79, 0xbf, 0xfa, 0xbd,
-	0x99, 0xd1, 0xfe, 0x7f, 0x05, 0x48, 0xdd, 0x9d, 0x84, 0xb6, 0xa1, 0x52, 0x97, 0x97, 0x5f, 0xe7,
-	0x53, 0xfe, 0x57, 0xdd, 0xa5, 0xad, 0x8f, 0x7f, 0x54, 0x13, 0xd6, 0xc4, 0xd0, 0xfb, 0x79, 0xa5,
-	0x5d, 0x41, 0xba, 0x90, 0x47, 0x96, 0x77, 0x55, 0xf5, 0x67, 0x5e, 0xbd, 0x26, 0xdb, 0xb0, 0x41,
-	0x0f, 0xc5, 0x50, 0xd9, 0x90, 0x77, 0x44, 0xe5, 0x23, 0xee, 0xd4, 0x95, 0x53, 0xdc, 0x44, 0x53,
-	0x7f, 0xb1, 0x26, 0x64, 0xff, 0x59, 0x01, 0x4e, 0x26, 0x3f, 0x80, 0x38, 0xae, 0xfb, 0x75, 0x0b,
-	0xee, 0xf7, 0x9c, 0x28, 0xae, 0xb6, 0xd9, 0x46, 0x61, 0xbd, 0xed, 0x2d, 0xa7, 0x8a, 0x32, 0x1f,
-	0xd4, 0xd9, 0xa2, 0x3a, 0x4e, 0xdf, 0x29, 0x36, 0xfb, 0xc0, 0xad, 0x9d, 0xc9, 0xfb, 0x17, 0xb3,
-	0x89, 0xe3, 0x5e, 0x5c, 0xa1, 0xcf, 0x5a, 0x70, 0xac, 0xd6, 0x0e, 0x43, 0xe2, 0xc7, 0x9a, 0x55,
-	0xfe, 0x15, 0xaf, 0xe4, 0x32, 0x90, 0x9a, 0xc1, 0x93, 0x54, 0xa0, 0xce, 0xa5, 0x68, 0xe1, 0x2e,
-	0xea, 0xf6, 0x2f, 0x52, 0xcd, 0xd9, 0xf3, 0x3d, 0x7f, 0xc8, 0x2e, 0x41, 0xfb, 0xde, 0x20, 0x8c,
-	0x26, 0x2a, 0x4f, 0x27, 0x8e, 0xb8, 0xac, 0x3d, 0x8f, 0xb8, 0x58, 0x16, 0x5b, 0xdb, 0x97, 0x17,
-	0x2d, 0x1b, 0x59, 0x6c, 0x6d, 0x9f, 0x60, 0x0e, 0x13, 0x43, 0x8a, 0xdb, 0xbe, 0x88, 0x80, 0x37,
-	0x87, 0x14, 0xb7, 0x7d, 0x2c, 0xa0, 0xe8, 0x43, 0x16, 0x8c, 0xb0, 0xc5, 0x27, 0x0e, 0x08, 0x85,
-	0x42, 0xbb, 0x94, 0xc3, 0x72, 0x97, 0x55, 0xd6, 0x59, 0xc4, 0xa4, 0xd9, 0x82, 0x13, 0x14, 0xd1,
-	0x47, 0x2d, 0xa8, 0xa8, 0x5b, 0x1d, 0xc5, 0xd5, 0xec, 0xd5, 0x7c, 0x0b, 0x7b, 0xa7, 0xa4, 0x9e,
-	0xaa, 0xb0, 0x8c, 0x35, 0x61, 0x14, 0xa9, 0xd3, 0xbb, 0xa1, 0xc3, 0x39, 0xbd, 0x83, 0x8c, 0x93,
-	0xbb, 0x37, 0x43, 0xa5, 0xe9, 0xf8, 0xee, 0x3a, 0x89, 0x62, 0x7e, 0xa0, 0x26, 0xef, 0x1b, 0x90,
-	0x8d, 0x58, 0xc3, 0xa9, 0xb1, 0x1f, 0xb1, 0x17, 0x8b, 0x8d, 0x13, 0x30, 0x66, 0xec, 0x57, 0x75,
-	0x33, 0x36, 0x71, 0xcc, 0xe3, 0x3a, 0xb8, 0xab, 0xc7, 0x75, 0xc3, 0x7b, 0x1c, 0xd7, 0x55, 0xe1,
-	0x94, 0xd3, 0x8e, 0x83, 0x8b, 0xc4, 0xf1, 0x66, 0xe2, 0x98, 0x34, 0x5b, 0x71, 0xc4, 0x8b, 0x95,
-	0x8f, 0x30, 0x17, 0xb0, 0x8a, 0xf1, 0xaa, 0x12, 0x6f, 0xbd, 0x0b, 0x09, 0x67, 0x3f, 0x6b, 0xff,
-	0x73, 0x0b, 0x4e, 0x65, 0x4e, 0x85, 0x7b, 0x37, 0xba, 0xde, 0xfe, 0x7c, 0x09, 0x4e, 0x64, 0xd4,
-	0xa5, 0x47, 0x1d, 0x73, 0x91, 0x58, 0x79, 0x04, 0xaa, 0x25, 0xe3, 0xae, 0xe4, 0xb7, 0xc9, 0x58,
-	0x19, 0xfb, 0x3b, 0x81, 0xd7, 0xa7, 0xe0, 0xc5, 0xa3, 0x3d, 0x05, 0x37, 0xe6, 0xfa, 0xc0, 0x5d,
-	0x9d, 0xeb, 0xa5, 0x3d, 0xe6, 0xfa, 0x57, 0x2d, 0x98, 0x68, 0xf6, 0xb8, 0x0c, 0x49, 0x9c, 0x27,
-	0x5d, 0x3b, 0x9c, 0xab, 0x96, 0x66, 0x1f, 0xbc, 0xb5, 0x33, 0xd9, 0xf3, 0x0e, 0x2a, 0xdc, 0x93,
-	0x2b, 0xfb, 0x3b, 0x45, 0x60, 0xf6, 0x1a, 0xab, 0x3d, 0xdc, 0x41, 0x1f, 0x34, 0xaf, 0xb7, 0xb0,
-	0xf2, 0xba, 0x8a, 0x81, 0x77, 0xae, 0xae, 0xc7, 0xe0, 0x23, 0x98, 0x75, 0x5b, 0x46, 0x5a, 0x12,
-	0x16, 0xfa, 0x90, 0x84, 0x9e, 0xbc, 0x47, 0xa4, 0x98, 0xff, 0x3d, 0x22, 0x95, 0xf4, 0x1d, 0x22,
-	0xbb, 0x7f, 0xe2, 0x81, 0x7b, 0xf2, 0x13, 0xff, 0x9e, 0xc5, 0x05, 0x4f, 0xea, 0x2b, 0x68, 0x73,
-	0xc3, 0xda, 0xc5, 0xdc, 0x78, 0x1c, 0xca, 0x91, 0x90, 0xcc, 0xc2, 0x2c, 0xd1, 0x01, 0x50, 0xa2,
-	0x1d, 0x2b, 0x0c, 0xba, 0xeb, 0x72, 0x3c, 0x2f, 0xb8, 0x79, 0xae, 0xd9, 0x8a, 0x3b, 0xc2, 0x40,
-	0x51, 0xdb, 0x82, 0x19, 0x05, 0xc1, 0x06, 0x16, 0x7a, 0x04, 0x06, 0x79, 0x35, 0x04, 0xe1, 0xdc,
-	0x19, 0xa6, 0xeb, 0x90, 0x97, 0x4a, 0xa8, 0x63, 0x01, 0xb2, 0x37, 0xc0, 0xd8, 0x55, 0xdc, 0xf9,
-	0x05, 0xb3, 0x7d, 0xdc, 0x0c, 0xfe, 0xf7, 0x0b, 0x82, 0x14, 0xdf, 0x25, 0x3c, 0x97, 0xba, 0x89,
-	0xbd, 0xff, 0x78, 0xb8, 0xf7, 0x03, 0xd4, 0x82, 0x66, 0x8b, 0xee, 0x9b, 0x57, 0x83, 0x7c, 0x36,
-	0x5b, 0x73, 0xaa, 0x3f, 0x3d, 0xaa, 0xba, 0x0d, 0x1b, 0xf4, 0x12, 0xa2, 0xbd, 0xb8, 0xa7, 0x68,
-	0x4f, 0x48, 0xb9, 0x81, 0xdd, 0xa5, 0x9c, 0xfd, 0x57, 0x16, 0x24, 0xac, 0x3e, 0xd4, 0x82, 0x12,
-	0x65, 0xb7, 0x23, 0x04, 0xc6, 0x72, 0x7e, 0x26, 0x26, 0x95, 0xd4, 0x62, 0x15, 0xb2, 0x9f, 0x98,
-	0x13, 0x42, 0x9e, 0x88, 0xfd, 0xcb, 0x65, 0xf3, 0x63, 0x12, 0xbc, 0x18, 0x04, 0x9b, 0x3c, 0x7c,
-	0x46, 0xc7, 0x11, 0xda, 0xcf, 0xc1, 0xf1, 0x2e, 0xa6, 0xd8, 0xa5, 0xb4, 0x81, 0xdc, 0xc1, 0x1b,
-	0xab, 0x87, 0xd5, 0x70, 0xc0, 0x1c, 0x66, 0x7f, 0xc5, 0x82, 0x63, 0xe9, 0xee, 0xd1, 0x6b, 0x16,
-	0x1c, 0x8f, 0xd2, 0xfd, 0x1d, 0xd6, 0xd8, 0xa9, 0x18, 0xff, 0x2e, 0x10, 0xee, 0x66, 0xc2, 0xfe,
-	0x4b, 0xa1, 0x0d, 0xae, 0xbb, 0x7e, 0x3d, 0xb8, 0xa9, 0xec, 0x24, 0xab, 0xa7, 0x9d, 0x44, 0xc5,
-	0x43, 0x6d, 0x83, 0xd4, 0xdb, 0x5e, 0x57, 0xf1, 0x85, 0xaa, 0x68, 0xc7, 0x0a, 0x83, 0xe5, 0x9a,
-	0xb7, 0xc5, 0xbe, 0x35, 0x35, 0x29, 0xe7, 0x45, 0x3b, 0x56, 0x18, 0xe8, 0x19, 0x18, 0x31, 0x5e,
-	0x52, 0xce, 0x4b, 0xb6, 0xe9, 0x30, 0x34, 0x78, 0x84, 0x13, 0x58, 0x68, 0x0a, 0x40, 0xd9, 0x5c,
-	0x52, 0x63, 0x33, 0x47, 0xbb, 0x12, 0x8c, 0x11, 0x36, 0x30, 0x58, 0x65, 0x07, 0xaf, 0x1d, 0xb1,
-	0x93, 0xe4, 0x41, 0x5d, 0x8b, 0x7f, 0x4e, 0xb4, 0x61, 0x05, 0xa5, 0xc2, 0xad, 0xe9, 0xf8, 0x6d,
-	0xc7, 0xa3, 0x23, 0x24, 0x5c, 0x67, 0x6a, 0x19, 0x2e, 0x29, 0x08, 0x36, 0xb0, 0xe8, 0x1b, 0xc7,
-	0x6e, 0x93, 0xbc, 0x18, 0xf8, 0x32, 0x36, 0x5b, 0x07, 0x17, 0x88, 0x76, 0xac, 0x30, 0xd0, 0x73,
-	0x30, 0xec, 0xf8, 0x75, 0x6e, 0x20, 0x06, 0xa1, 0x38, 0xa3, 0x54, 0xbb, 0xcf, 0xab, 0x11, 0x99,
-	0xd1, 0x50, 0x6c, 0xa2, 0xa6, 0x2f, 0x22, 0x80, 0xfe, 0x2e, 0x22, 0xb0, 0xff, 0xc2, 0x82, 0x71,
-	0x5d, 0x58, 0x87, 0x79, 0xd8, 0x12, 0xae, 0x45, 0x6b, 0x4f, 0xd7, 0x62, 0xb2, 0x62, 0x47, 0xa1,
-	0xaf, 0x8a, 0x1d, 0x66, 0x31, 0x8d, 0xe2, 0xae, 0xc5, 0x34, 0x7e, 0x14, 0x86, 0x36, 0x49, 0xc7,
-	0xa8, 0xba, 0xc1, 0x94, 0xc3, 0x65, 0xde, 0x84, 0x25, 0x0c, 0xd9, 0x30, 0x58, 0x73, 0x54, 0x55,
-	0xbc, 0x11, 0xbe, 0x21, 0x9b, 0x9b, 0x61, 0x48, 0x02, 0x62, 0x2f, 0x43, 0x45, 0x1d, 0xea, 0x4b,
-	0x4f, 0x9f, 0x95, 0xed, 0xe9, 0xeb, 0x2b, 0xa9, 0x7f, 0x76, 0xed, 0xeb, 0xdf, 0x7d, 0xf8, 0x0d,
-	0x7f, 0xfc, 0xdd, 0x87, 0xdf, 0xf0, 0xa7, 0xdf, 0x7d, 0xf8, 0x0d, 0x1f, 0xba, 0xf5, 0xb0, 0xf5,
-	0xf5, 0x5b, 0x0f, 0x5b, 0x7f, 0x7c, 0xeb, 0x61, 0xeb, 0x4f, 0x6f, 0x3d, 0x6c, 0x7d, 0xe7, 0xd6,
-	0xc3, 0xd6, 0x67, 0xff, 0xcb, 0xc3, 0x6f, 0x78, 0x31, 0x33, 0x1b, 0x80, 0xfe, 0x78, 0xa2, 0x56,
-	0x9f, 0xde, 0x7a, 0x9a, 0x05, 0xa4, 0xd3, 0xf5, 0x3c, 0x6d, 0x4c, 0xe2, 0x69, 0xb9, 0x9e, 0xff,
-	0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x59, 0x4d, 0xa5, 0x1a, 0x86, 0xfa, 0x00, 0x00,
+	// 13389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6b, 0x70, 0x25, 0xd9,
+	0x59, 0x98, 0xfb, 0x3e, 0xa4, 0x7b, 0x8f, 0x34, 0xd2, 0x4c, 0xef, 0xcc, 0xee, 0xdd, 0xd9, 0xf5,
+	0x6a, 0xe8, 0x35, 0x6b, 0x03, 0x5e, 0x0d, 0x5e, 0x1b, 0xb3, 0xc1, 0xd8, 0xa0, 0xc7, 0x3c, 0x34,
+	0x23, 0x8d, 0xb4, 0xdf, 0xd5, 0xcc, 0x60, 0x9b, 0xf5, 0xba, 0x75, 0xef, 0x91, 0xd4, 0xa3, 0xab,
+	0xee, 0xbb, 0xdd, 0x7d, 0x35, 0xa3, 0xc5, 0x18, 0x1b, 0x30, 0x2f, 0xe3, 0x07, 0xe0, 0x04, 0x93,
+	0x0a, 0x04, 0x0a, 0xf2, 0x2e, 0x0a, 0x12, 0x7e, 0x84, 0xaa, 0x84, 0xa2, 0x78, 0xb9, 0xc8, 0xab,
+	0x20, 0x14, 0x45, 0x08, 0x8f, 0x89, 0x3d, 0x81, 0x90, 0x4a, 0x55, 0xa8, 0x72, 0xf2, 0x6f, 0x93,
+	0x4a, 0x52, 0xdf, 0x79, 0x9f, 0xbe, 0x7d, 0xa5, 0x7b, 0x47, 0xad, 0x99, 0xb1, 0xb3, 0xbf, 0xa4,
+	0x7b, 0xbe, 0xaf, 0xbf, 0xef, 0xf4, 0xe9, 0x73, 0xce, 0xf7, 0x9d, 0xef, 0x75, 0xc8, 0xf2, 0x56,
+	0x90, 0x6e, 0xf7, 0x36, 0x66, 0x5b, 0xd1, 0xee, 0x79, 0x3f, 0xde, 0x8a, 0xba, 0x71, 0x74, 0x8b,
+	0xfd, 0xf3, 0x7c, 0xab, 0x7d, 0x7e, 0xef, 0x9d, 0xe7, 0xbb, 0x3b, 0x5b, 0xe7, 0xfd, 0x6e, 0x90,
+	0x9c, 0xf7, 0xbb, 0xdd, 0x4e, 0xd0, 0xf2, 0xd3, 0x20, 0x0a, 0xcf, 0xef, 0xbd, 0xc3, 0xef, 0x74,
+	0xb7, 0xfd, 0x77, 0x9c, 0xdf, 0xa2, 0x21, 0x8d, 0xfd, 0x94, 0xb6, 0x67, 0xbb, 0x71, 0x94, 0x46,
+	0xee, 0xb7, 0x6a, 0x6a, 0xb3, 0x92, 0x1a, 0xfb, 0xe7, 0x95, 0x56, 0x7b, 0x76, 0xef, 0x9d, 0xb3,
+	0xdd, 0x9d, 0xad, 0x59, 0xa4, 0x36, 0x6b, 0x50, 0x9b, 0x95, 0xd4, 0xce, 0x3e, 0x6f, 0xf4, 0x65,
+	0x2b, 0xda, 0x8a, 0xce, 0x33, 0xa2, 0x1b, 0xbd, 0x4d, 0xf6, 0x8b, 0xfd, 0x60, 0xff, 0x71, 0x66,
+	0x67, 0xbd, 0x9d, 0x17, 0x93, 0xd9, 0x20, 0xc2, 0xee, 0x9d, 0x6f, 0x45, 0x31, 0x3d, 0xbf, 0xd7,
+	0xd7, 0xa1, 0xb3, 0x97, 0x35, 0x0e, 0xbd, 0x93, 0xd2, 0x30, 0x09, 0xa2, 0x30, 0x79, 0x1e, 0xbb,
+	0x40, 0xe3, 0x3d, 0x1a, 0x9b, 0xaf, 0x67, 0x20, 0xe4, 0x51, 0x7a, 0x97, 0xa6, 0xb4, 0xeb, 0xb7,
+	0xb6, 0x83, 0x90, 0xc6, 0xfb, 0xfa, 0xf1, 0x5d, 0x9a, 0xfa, 0x79, 0x4f, 0x9d, 0x1f, 0xf4, 0x54,
+	0xdc, 0x0b, 0xd3, 0x60, 0x97, 0xf6, 0x3d, 0xf0, 0xee, 0xc3, 0x1e, 0x48, 0x5a, 0xdb, 0x74, 0xd7,
+	0xef, 0x7b, 0xee, 0x9d, 0x83, 0x9e, 0xeb, 0xa5, 0x41, 0xe7, 0x7c, 0x10, 0xa6, 0x49, 0x1a, 0x67,
+	0x1f, 0xf2, 0xfe, 0x8e, 0x43, 0x4e, 0xcc, 0xdd, 0x6c, 0xce, 0xf5, 0xd2, 0xed, 0x85, 0x28, 0xdc,
+	0x0c, 0xb6, 0xdc, 0x6f, 0x22, 0x13, 0xad, 0x4e, 0x2f, 0x49, 0x69, 0x7c, 0xcd, 0xdf, 0xa5, 0x0d,
+	0xe7, 0x9c, 0xf3, 0xb6, 0xfa, 0xfc, 0x63, 0xbf, 0x7b, 0x77, 0xe6, 0x4d, 0xf7, 0xee, 0xce, 0x4c,
+	0x2c, 0x68, 0x10, 0x98, 0x78, 0xee, 0xd7, 0x91, 0xf1, 0x38, 0xea, 0xd0, 0x39, 0xb8, 0xd6, 0x28,
+	0xb1, 0x47, 0xa6, 0xc5, 0x23, 0xe3, 0xc0, 0x9b, 0x41, 0xc2, 0x11, 0xb5, 0x1b, 0x47, 0x9b, 0x41,
+	0x87, 0x36, 0xca, 0x36, 0xea, 0x1a, 0x6f, 0x06, 0x09, 0xf7, 0x7e, 0xaa, 0x44, 0xa6, 0xe7, 0xba,
+	0xdd, 0xcb, 0xd4, 0xef, 0xa4, 0xdb, 0xcd, 0xd4, 0x4f, 0x7b, 0x89, 0xbb, 0x45, 0xc6, 0x12, 0xf6,
+	0x9f, 0xe8, 0xdb, 0xaa, 0x78, 0x7a, 0x8c, 0xc3, 0x5f, 0xbf, 0x3b, 0xf3, 0xde, 0xbc, 0x19, 0xbd,
+	0x15, 0xa4, 0x51, 0x37, 0x79, 0x9e, 0x86, 0x5b, 0x41, 0x48, 0xd9, 0xb8, 0x6c, 0x33, 0xaa, 0xb3,
+	0x26, 0xf1, 0x85, 0xa8, 0x4d, 0x41, 0x90, 0xc7, 0x7e, 0xee, 0xd2, 0x24, 0xf1, 0xb7, 0x68, 0xf6,
+	0x95, 0x56, 0x78, 0x33, 0x48, 0xb8, 0x1b, 0x13, 0xb7, 0xe3, 0x27, 0xe9, 0x7a, 0xec, 0x87, 0x49,
+	0x80, 0x53, 0x7a, 0x3d, 0xd8, 0xe5, 0x6f, 0x37, 0xf1, 0xc2, 0xd7, 0xcf, 0xf2, 0x0f, 0x33, 0x6b,
+	0x7e, 0x18, 0xbd, 0x0e, 0x70, 0xde, 0xcc, 0xee, 0xbd, 0x63, 0x16, 0x9f, 0x98, 0x7f, 0xfc, 0xde,
+	0xdd, 0x19, 0x77, 0xb9, 0x8f, 0x12, 0xe4, 0x50, 0xf7, 0xfe, 0xa8, 0x44, 0xc8, 0x5c, 0xb7, 0xbb,
+	0x16, 0x47, 0xb7, 0x68, 0x2b, 0x75, 0x3f, 0x4c, 0x6a, 0x48, 0xaa, 0xed, 0xa7, 0x3e, 0x1b, 0x98,
+	0x89, 0x17, 0xbe, 0x71, 0x38, 0xc6, 0xab, 0x1b, 0xf8, 0xfc, 0x0a, 0x4d, 0xfd, 0x79, 0x57, 0xbc,
+	0x20, 0xd1, 0x6d, 0xa0, 0xa8, 0xba, 0x21, 0xa9, 0x24, 0x5d, 0xda, 0x62, 0x83, 0x31, 0xf1, 0xc2,
+	0xf2, 0xec, 0x51, 0x56, 0xfa, 0xac, 0xee, 0x79, 0xb3, 0x4b, 0x5b, 0xf3, 0x93, 0x82, 0x73, 0x05,
+	0x7f, 0x01, 0xe3, 0xe3, 0xee, 0xa9, 0x0f, 0xcd, 0x07, 0xf2, 0x5a, 0x61, 0x1c, 0x19, 0xd5, 0xf9,
+	0x29, 0x7b, 0xe2, 0xc8, 0xef, 0xee, 0xfd, 0xb9, 0x43, 0xa6, 0x34, 0xf2, 0x72, 0x90, 0xa4, 0xee,
+	0x77, 0xf6, 0x0d, 0xee, 0xec, 0x70, 0x83, 0x8b, 0x4f, 0xb3, 0xa1, 0x3d, 0x29, 0x98, 0xd5, 0x64,
+	0x8b, 0x31, 0xb0, 0xbb, 0xa4, 0x1a, 0xa4, 0x74, 0x37, 0x69, 0x94, 0xce, 0x95, 0xdf, 0x36, 0xf1,
+	0xc2, 0xe5, 0xa2, 0xde, 0x73, 0xfe, 0x84, 0x60, 0x5a, 0x5d, 0x42, 0xf2, 0xc0, 0xb9, 0x78, 0xbf,
+	0x3d, 0x6d, 0xbe, 0x1f, 0x0e, 0xb8, 0xfb, 0x0e, 0x32, 0x91, 0x44, 0xbd, 0xb8, 0x45, 0x81, 0x76,
+	0x23, 0x5c, 0x58, 0x65, 0x9c, 0xee, 0xb8, 0xe0, 0x9b, 0xba, 0x19, 0x4c, 0x1c, 0xf7, 0xd3, 0x0e,
+	0x99, 0x6c, 0xd3, 0x24, 0x0d, 0x42, 0xc6, 0x5f, 0x76, 0x7e, 0xfd, 0xc8, 0x9d, 0x97, 0x8d, 0x8b,
+	0x9a, 0xf8, 0xfc, 0x69, 0xf1, 0x22, 0x93, 0x46, 0x63, 0x02, 0x16, 0x7f, 0xdc, 0xb8, 0xda, 0x34,
+	0x69, 0xc5, 0x41, 0x17, 0x7f, 0x37, 0xca, 0xf6, 0xc6, 0xb5, 0xa8, 0x41, 0x60, 0xe2, 0xb9, 0x21,
+	0xa9, 0xe2, 0xc6, 0x94, 0x34, 0x2a, 0xac, 0xff, 0x4b, 0x47, 0xeb, 0xbf, 0x18, 0x54, 0xdc, 0xf3,
+	0xf4, 0xe8, 0xe3, 0xaf, 0x04, 0x38, 0x1b, 0xf7, 0x53, 0x0e, 0x69, 0x88, 0x8d, 0x13, 0x28, 0x1f,
+	0xd0, 0x9b, 0xdb, 0x41, 0x4a, 0x3b, 0x41, 0x92, 0x36, 0xaa, 0xac, 0x0f, 0xe7, 0x87, 0x9b, 0x5b,
+	0x97, 0xe2, 0xa8, 0xd7, 0xbd, 0x1a, 0x84, 0xed, 0xf9, 0x73, 0x82, 0x53, 0x63, 0x61, 0x00, 0x61,
+	0x18, 0xc8, 0xd2, 0xfd, 0x09, 0x87, 0x9c, 0x0d, 0xfd, 0x5d, 0x9a, 0x74, 0xfd, 0x16, 0x95, 0xe0,
+	0xf9, 0x8e, 0xdf, 0xda, 0x61, 0x3d, 0x1a, 0xbb, 0xbf, 0x1e, 0x79, 0xa2, 0x47, 0x67, 0xaf, 0x0d,
+	0x24, 0x0d, 0x07, 0xb0, 0x75, 0x7f, 0xde, 0x21, 0xa7, 0xa2, 0xb8, 0xbb, 0xed, 0x87, 0xb4, 0x2d,
+	0xa1, 0x49, 0x63, 0x9c, 0x2d, 0xbd, 0x0f, 0x1d, 0xed, 0x13, 0xad, 0x66, 0xc9, 0xae, 0x44, 0x61,
+	0x90, 0x46, 0x71, 0x93, 0xa6, 0x69, 0x10, 0x6e, 0x25, 0xf3, 0x67, 0xee, 0xdd, 0x9d, 0x39, 0xd5,
+	0x87, 0x05, 0xfd, 0xfd, 0x71, 0xbf, 0x8b, 0x4c, 0x24, 0xfb, 0x61, 0xeb, 0x66, 0x10, 0xb6, 0xa3,
+	0xdb, 0x49, 0xa3, 0x56, 0xc4, 0xf2, 0x6d, 0x2a, 0x82, 0x62, 0x01, 0x6a, 0x06, 0x60, 0x72, 0xcb,
+	0xff, 0x70, 0x7a, 0x2a, 0xd5, 0x8b, 0xfe, 0x70, 0x7a, 0x32, 0x1d, 0xc0, 0xd6, 0xfd, 0x41, 0x87,
+	0x9c, 0x48, 0x82, 0xad, 0xd0, 0x4f, 0x7b, 0x31, 0xbd, 0x4a, 0xf7, 0x93, 0x06, 0x61, 0x1d, 0xb9,
+	0x72, 0xc4, 0x51, 0x31, 0x48, 0xce, 0x9f, 0x11, 0x7d, 0x3c, 0x61, 0xb6, 0x26, 0x60, 0xf3, 0xcd,
+	0x5b, 0x68, 0x7a, 0x5a, 0x4f, 0x14, 0xbb, 0xd0, 0xf4, 0xa4, 0x1e, 0xc8, 0xd2, 0xfd, 0x76, 0x72,
+	0x92, 0x37, 0xa9, 0x91, 0x4d, 0x1a, 0x93, 0x6c, 0xa3, 0x3d, 0x7d, 0xef, 0xee, 0xcc, 0xc9, 0x66,
+	0x06, 0x06, 0x7d, 0xd8, 0xee, 0xab, 0x64, 0xa6, 0x4b, 0xe3, 0xdd, 0x20, 0x5d, 0x0d, 0x3b, 0xfb,
+	0x72, 0xfb, 0x6e, 0x45, 0x5d, 0xda, 0x16, 0xdd, 0x49, 0x1a, 0x27, 0xce, 0x39, 0x6f, 0xab, 0xcd,
+	0xbf, 0x55, 0x74, 0x73, 0x66, 0xed, 0x60, 0x74, 0x38, 0x8c, 0x9e, 0xfb, 0x05, 0x87, 0x9c, 0x35,
+	0x76, 0xd9, 0x26, 0x8d, 0xf7, 0x82, 0x16, 0x9d, 0x6b, 0xb5, 0xa2, 0x5e, 0x98, 0x26, 0x8d, 0x29,
+	0x36, 0x8c, 0x1b, 0xc7, 0xb1, 0xe7, 0xdb, 0xac, 0xf4, 0xbc, 0x1c, 0x88, 0x92, 0xc0, 0x01, 0x3d,
+	0x75, 0x7f, 0xdc, 0x21, 0x27, 0x63, 0xf1, 0x4d, 0xd6, 0xa2, 0x4e, 0xd0, 0x0a, 0x68, 0xd2, 0x98,
+	0x3e, 0x57, 0x3e, 0xba, 0x26, 0x03, 0x26, 0xd5, 0xfd, 0xf9, 0x86, 0xe8, 0xe8, 0x49, 0xc8, 0x70,
+	0x83, 0x3e, 0xfe, 0xee, 0x7b, 0xc8, 0x89, 0x57, 0x7b, 0xb4, 0x47, 0xd7, 0xe2, 0x20, 0x8a, 0x83,
+	0x74, 0xbf, 0x71, 0x92, 0x09, 0x2d, 0x35, 0xbf, 0x5f, 0x32, 0x81, 0x60, 0xe3, 0x7a, 0xff, 0xaa,
+	0x44, 0x4e, 0x66, 0x75, 0x1a, 0xf7, 0xef, 0x3b, 0x64, 0xfa, 0xd6, 0xed, 0x74, 0x3d, 0xda, 0xa1,
+	0x61, 0x32, 0xbf, 0x8f, 0x92, 0x87, 0x49, 0xf3, 0x89, 0x17, 0x5a, 0xc5, 0x6a, 0x4f, 0xb3, 0x57,
+	0x6c, 0x2e, 0x17, 0xc2, 0x34, 0xde, 0x9f, 0x7f, 0x42, 0xf4, 0x7c, 0xfa, 0xca, 0xcd, 0x75, 0x13,
+	0x0a, 0xd9, 0x4e, 0x9d, 0xfd, 0xa4, 0x43, 0x4e, 0xe7, 0x91, 0x70, 0x4f, 0x92, 0xf2, 0x0e, 0xdd,
+	0xe7, 0xba, 0x3d, 0xe0, 0xbf, 0xee, 0xcb, 0xa4, 0xba, 0xe7, 0x77, 0x7a, 0x54, 0x28, 0x9e, 0x97,
+	0x8e, 0xf6, 0x22, 0xaa, 0x67, 0xc0, 0xa9, 0x7e, 0x4b, 0xe9, 0x45, 0xc7, 0xfb, 0xbd, 0x32, 0x99,
+	0x30, 0xa6, 0xe1, 0x03, 0x50, 0xa6, 0x23, 0x4b, 0x99, 0x5e, 0x29, 0x6c, 0x05, 0x0d, 0xd4, 0xa6,
+	0x6f, 0x67, 0xb4, 0xe9, 0xd5, 0xe2, 0x58, 0x1e, 0xa8, 0x4e, 0xbb, 0x29, 0xa9, 0x47, 0x5d, 0x1a,
+	0x33, 0xd4, 0x46, 0xa5, 0x88, 0x4f, 0xb8, 0x2a, 0xc9, 0xcd, 0x9f, 0xb8, 0x77, 0x77, 0xa6, 0xae,
+	0x7e, 0x82, 0x66, 0xe4, 0xfd, 0x07, 0x87, 0x9c, 0x36, 0xfa, 0xb8, 0x10, 0x85, 0x6d, 0x76, 0x74,
+	0x72, 0xcf, 0x91, 0x4a, 0xba, 0xdf, 0x95, 0x07, 0x5b, 0x35, 0x52, 0xeb, 0xfb, 0x5d, 0x0a, 0x0c,
+	0xf2, 0xa8, 0x9f, 0xfb, 0x3e, 0xef, 0x90, 0x33, 0xd6, 0x96, 0xd9, 0xa5, 0x61, 0x9b, 0x86, 0xad,
+	0x7d, 0x7c, 0xb5, 0xd0, 0xdf, 0xed, 0x7b, 0x35, 0x76, 0x58, 0x67, 0x10, 0xf7, 0x65, 0x52, 0x4b,
+	0x68, 0x87, 0xb6, 0xd2, 0x28, 0x16, 0x33, 0xef, 0x9d, 0x43, 0x9e, 0x63, 0xfc, 0x0d, 0xda, 0x69,
+	0x8a, 0x47, 0xe7, 0x27, 0xf1, 0x20, 0x23, 0x7f, 0x81, 0x22, 0xe9, 0xfd, 0x84, 0x43, 0x1e, 0xcf,
+	0xdf, 0xcd, 0xdd, 0xe7, 0xc8, 0x18, 0x37, 0xb8, 0x88, 0xde, 0xe9, 0xd9, 0xc2, 0x5a, 0x41, 0x40,
+	0xdd, 0xf3, 0xa4, 0xae, 0xb4, 0x0b, 0x31, 0xfc, 0xa7, 0x04, 0x6a, 0x5d, 0xab, 0x24, 0x1a, 0x47,
+	0xbd, 0x74, 0x79, 0xd0, 0x4b, 0x7b, 0x7f, 0xe8, 0x90, 0xb7, 0x0c, 0x23, 0x63, 0x8e, 0xaf, 0x8f,
+	0x4d, 0x72, 0xa6, 0x4d, 0x37, 0xfd, 0x5e, 0x27, 0xb5, 0x39, 0x8a, 0x4e, 0xbf, 0x59, 0x3c, 0x7c,
+	0x66, 0x31, 0x0f, 0x09, 0xf2, 0x9f, 0xf5, 0xfe, 0x93, 0x43, 0xa6, 0x8d, 0xd7, 0x7a, 0x00, 0xe7,
+	0xd4, 0xd0, 0x3e, 0xa7, 0x2e, 0x15, 0xb6, 0x83, 0x0c, 0x38, 0xa8, 0x7e, 0xca, 0x21, 0x67, 0x0d,
+	0xac, 0x15, 0x3f, 0x6d, 0x6d, 0x5f, 0xb8, 0xd3, 0x8d, 0x69, 0x92, 0xe0, 0x94, 0x7a, 0xb3, 0x21,
+	0x29, 0xe6, 0x27, 0x04, 0x85, 0xf2, 0x55, 0xba, 0xcf, 0xc5, 0xc6, 0xdb, 0x49, 0x8d, 0x6f, 0x07,
+	0x62, 0xae, 0xd7, 0xf5, 0xbb, 0xad, 0x8a, 0x76, 0x50, 0x18, 0xae, 0x47, 0xc6, 0x98, 0x38, 0xc0,
+	0xed, 0x11, 0x75, 0x32, 0x82, 0xdf, 0xfd, 0x06, 0x6b, 0x01, 0x01, 0xf1, 0x12, 0xab, 0x3b, 0x6b,
+	0x31, 0x65, 0xf3, 0xa1, 0x7d, 0x31, 0xa0, 0x9d, 0x76, 0x82, 0x67, 0x68, 0x3f, 0x0c, 0xa3, 0x54,
+	0x1c, 0x87, 0x8d, 0x33, 0xf4, 0x9c, 0x6e, 0x06, 0x13, 0x07, 0x99, 0x76, 0x70, 0x61, 0xf1, 0x11,
+	0x15, 0x4c, 0xd9, 0x52, 0x4b, 0x40, 0x40, 0xbc, 0x7b, 0x25, 0x32, 0x65, 0x70, 0x6d, 0xd2, 0x07,
+	0x61, 0xea, 0x89, 0x2d, 0xe9, 0xb4, 0x56, 0x9c, 0xa8, 0xa0, 0x83, 0xcd, 0x3d, 0xaf, 0x65, 0x04,
+	0x14, 0x14, 0xca, 0xf5, 0x60, 0x93, 0xcf, 0xc7, 0xca, 0x64, 0xc6, 0x7e, 0xa0, 0x4f, 0xbe, 0xa1,
+	0x7d, 0xc1, 0x60, 0x94, 0x35, 0x8c, 0x1a, 0xf8, 0x60, 0xe2, 0x0d, 0x10, 0x11, 0xa5, 0xe3, 0x14,
+	0x11, 0xa6, 0x04, 0x2b, 0x1f, 0x22, 0xc1, 0x9e, 0x53, 0xa3, 0x5e, 0xc9, 0xec, 0x79, 0xb6, 0x14,
+	0x3f, 0x47, 0x2a, 0x49, 0x4a, 0xbb, 0x8d, 0xaa, 0xbd, 0xcd, 0x36, 0x53, 0xda, 0x05, 0x06, 0x71,
+	0xdf, 0x4b, 0xa6, 0x53, 0x3f, 0xde, 0xa2, 0x69, 0x4c, 0xf7, 0x02, 0x66, 0x44, 0x67, 0xc6, 0x83,
+	0xfa, 0xfc, 0x63, 0xa8, 0x10, 0xae, 0x33, 0x10, 0x48, 0x10, 0x64, 0x71, 0xbd, 0xff, 0x56, 0x22,
+	0x4f, 0xd8, 0x9f, 0x40, 0xcb, 0xec, 0x6f, 0xb3, 0x64, 0xf6, 0x37, 0x98, 0x32, 0xfb, 0xf5, 0xbb,
+	0x33, 0x4f, 0x0d, 0x78, 0xec, 0x2b, 0x46, 0xa4, 0xbb, 0x97, 0x32, 0x1f, 0xe1, 0x7c, 0x9f, 0x49,
+	0xfb, 0xcd, 0x03, 0xde, 0x31, 0xf3, 0x95, 0x9e, 0x23, 0x63, 0x31, 0xf5, 0x93, 0x28, 0x6c, 0x54,
+	0xed, 0xaf, 0x09, 0xac, 0x15, 0x04, 0xd4, 0xfb, 0xf2, 0x64, 0x76, 0xb0, 0x2f, 0x71, 0xc7, 0x40,
+	0x14, 0xbb, 0x01, 0xa9, 0xb0, 0x23, 0x32, 0xdf, 0x59, 0xae, 0x1e, 0x6d, 0x15, 0xa2, 0x14, 0x51,
+	0xa4, 0xe7, 0x6b, 0xf8, 0xd5, 0xb0, 0x09, 0x18, 0x0b, 0xf7, 0x0e, 0xa9, 0xb5, 0xe4, 0xc9, 0xb5,
+	0x54, 0x84, 0x8d, 0x57, 0x9c, 0x5b, 0x35, 0x47, 0xa6, 0xa9, 0xa8, 0xe3, 0xae, 0xe2, 0xe6, 0x52,
+	0x52, 0xde, 0x0a, 0x52, 0xf1, 0x59, 0x8f, 0x68, 0x9b, 0xb8, 0x14, 0x18, 0xaf, 0x38, 0x8e, 0x32,
+	0xe8, 0x52, 0x90, 0x02, 0xd2, 0x77, 0x3f, 0xe1, 0x90, 0x89, 0xa4, 0xb5, 0xbb, 0x16, 0x47, 0x7b,
+	0x41, 0x9b, 0xc6, 0x8d, 0x4a, 0x11, 0x3b, 0x5b, 0x73, 0x61, 0x45, 0x12, 0xd4, 0x7c, 0xb9, 0xad,
+	0x48, 0x43, 0xc0, 0xe4, 0x8b, 0xc7, 0xc2, 0x27, 0xc4, 0xbb, 0x2f, 0xd2, 0x16, 0x5b, 0x71, 0xf2,
+	0x78, 0xda, 0xa8, 0x16, 0x71, 0x1c, 0x58, 0xec, 0xb5, 0x76, 0x70, 0xbd, 0xe9, 0x0e, 0x3d, 0x75,
+	0xef, 0xee, 0xcc, 0x13, 0x0b, 0xf9, 0x3c, 0x61, 0x50, 0x67, 0xd8, 0x80, 0x75, 0x7b, 0x9d, 0x0e,
+	0xd0, 0x57, 0x7b, 0x94, 0x99, 0x1f, 0x0b, 0x18, 0xb0, 0x35, 0x4d, 0x30, 0x33, 0x60, 0x06, 0x04,
+	0x4c, 0xbe, 0xee, 0xab, 0x64, 0x6c, 0xd7, 0x4f, 0xe3, 0xe0, 0x4e, 0x63, 0xbc, 0x88, 0x03, 0xda,
+	0x0a, 0xa3, 0xa5, 0x99, 0x33, 0x41, 0xcf, 0x1b, 0x41, 0x30, 0x42, 0x2f, 0xc0, 0x2e, 0x8d, 0xb7,
+	0x68, 0xa3, 0x56, 0x84, 0x7f, 0x65, 0x05, 0x49, 0x69, 0x86, 0x75, 0x54, 0xae, 0x58, 0x1b, 0x70,
+	0x2e, 0xd6, 0x51, 0xa0, 0x5e, 0xf8, 0x51, 0x00, 0x07, 0xb0, 0xdb, 0xe9, 0x6d, 0x05, 0x61, 0x83,
+	0x14, 0x31, 0x80, 0x6b, 0x8c, 0x56, 0x66, 0x00, 0x79, 0x23, 0x08, 0x46, 0xb8, 0xa6, 0xa3, 0x56,
+	0xd0, 0x98, 0x28, 0x62, 0x4d, 0xaf, 0x2e, 0x2c, 0x65, 0xd6, 0xf4, 0xea, 0xc2, 0x12, 0x20, 0x7d,
+	0xf7, 0xc7, 0x1c, 0x32, 0xb5, 0x4d, 0x3b, 0xbb, 0xcc, 0x0d, 0x12, 0xa4, 0x51, 0xbc, 0xdf, 0x98,
+	0x64, 0x2c, 0xaf, 0x1f, 0x8d, 0xe5, 0x65, 0x8b, 0xa6, 0xe6, 0xee, 0xde, 0xbb, 0x3b, 0x33, 0x65,
+	0x03, 0x21, 0xd3, 0x01, 0xf7, 0xe7, 0x1c, 0xe2, 0xee, 0xf4, 0x36, 0x68, 0x1c, 0xd2, 0x94, 0x26,
+	0x6a, 0x69, 0x9f, 0x60, 0xfd, 0x7a, 0xff, 0xd1, 0xfa, 0x75, 0xb5, 0x8f, 0xae, 0xee, 0x1b, 0x13,
+	0x72, 0xfd, 0x08, 0x90, 0xd3, 0x19, 0xef, 0x2f, 0x1d, 0xe2, 0xda, 0x32, 0xe7, 0x01, 0x1c, 0x59,
+	0x5e, 0xb5, 0x8f, 0x2c, 0xcb, 0x45, 0xea, 0x94, 0x03, 0x4e, 0x2d, 0x7f, 0x32, 0x49, 0x32, 0xd2,
+	0xfa, 0x1a, 0x4d, 0x52, 0xda, 0x7e, 0x43, 0xc2, 0xbe, 0x21, 0x61, 0xdf, 0x90, 0xb0, 0xf2, 0x87,
+	0xbb, 0x91, 0x91, 0xb0, 0xef, 0x33, 0x56, 0xbd, 0x8e, 0xc3, 0x79, 0x45, 0x05, 0xea, 0x98, 0x3d,
+	0x30, 0x10, 0x70, 0x27, 0xb8, 0xd2, 0x5c, 0xbd, 0x96, 0x2b, 0x52, 0x5f, 0xb1, 0x45, 0xea, 0x51,
+	0x59, 0xbc, 0x21, 0x44, 0xdf, 0x10, 0xa2, 0x0f, 0x59, 0x88, 0x7e, 0xc1, 0x21, 0x6f, 0xb5, 0x85,
+	0x8b, 0x04, 0x2d, 0x6d, 0x85, 0x51, 0x4c, 0x17, 0x83, 0xcd, 0x4d, 0x1a, 0xd3, 0x10, 0xdd, 0x85,
+	0x87, 0x9b, 0x83, 0xdf, 0x45, 0x26, 0x6f, 0x25, 0x51, 0xb8, 0x16, 0x05, 0xa1, 0x90, 0x10, 0x78,
+	0x5e, 0x3f, 0x89, 0x81, 0x16, 0x38, 0xe1, 0x65, 0x3b, 0x58, 0x58, 0xee, 0x02, 0x39, 0x75, 0xeb,
+	0xd5, 0x35, 0x3f, 0x35, 0x6c, 0x71, 0xd2, 0x6a, 0xc6, 0x5c, 0xe7, 0x57, 0x5e, 0xca, 0x00, 0xa1,
+	0x1f, 0xdf, 0xeb, 0x64, 0x85, 0x24, 0x44, 0x9d, 0x4e, 0xd4, 0x4b, 0xe7, 0x42, 0xbf, 0xb3, 0x9f,
+	0x04, 0x09, 0x5a, 0xf7, 0x7a, 0x71, 0x27, 0x6b, 0xdd, 0xbb, 0x0e, 0xcb, 0x80, 0xed, 0x68, 0xdd,
+	0x63, 0xdd, 0xd9, 0xf3, 0x3b, 0x59, 0xeb, 0xde, 0x92, 0x68, 0x07, 0x85, 0xe1, 0xfd, 0x7c, 0x85,
+	0x3c, 0x99, 0xcb, 0x0e, 0xed, 0x17, 0xee, 0xcf, 0x38, 0xe4, 0xe4, 0xae, 0x6d, 0x5c, 0x4c, 0x84,
+	0xd7, 0xec, 0x3b, 0x0a, 0x53, 0x18, 0x32, 0xd6, 0x4b, 0xed, 0x27, 0xcc, 0x00, 0x12, 0xe8, 0xeb,
+	0x8b, 0xfb, 0x32, 0xa9, 0xef, 0xfa, 0x77, 0xae, 0x77, 0xdb, 0x7e, 0x2a, 0x4d, 0x47, 0x83, 0x2d,
+	0x7e, 0xbd, 0x34, 0xe8, 0xcc, 0xf2, 0x70, 0xbf, 0xd9, 0xa5, 0x30, 0x5d, 0x8d, 0x9b, 0x69, 0x1c,
+	0x84, 0x5b, 0xdc, 0x57, 0xb2, 0x22, 0xc9, 0x80, 0xa6, 0x88, 0x6e, 0xc8, 0xae, 0xdf, 0x4b, 0xe8,
+	0x62, 0x4f, 0x78, 0x69, 0xca, 0xb6, 0x1b, 0x72, 0xcd, 0x04, 0x82, 0x8d, 0xeb, 0xce, 0x91, 0xe9,
+	0x98, 0xbe, 0xda, 0x0b, 0x62, 0x3a, 0xd7, 0xed, 0xc6, 0x11, 0x7e, 0x8f, 0x0a, 0x73, 0x42, 0x2b,
+	0x5f, 0x20, 0xd8, 0x60, 0xc8, 0xe2, 0xa3, 0x48, 0xaa, 0xf9, 0xe2, 0xbb, 0x0b, 0x61, 0xf9, 0xc1,
+	0x22, 0x15, 0xb5, 0xcc, 0xd4, 0xe2, 0xdb, 0xad, 0xfc, 0x05, 0x8a, 0xb5, 0xf7, 0x85, 0x12, 0xf9,
+	0x9a, 0x81, 0xb3, 0x04, 0xff, 0xdd, 0xf0, 0x5b, 0x3b, 0xb8, 0x68, 0x0c, 0x9e, 0xd2, 0xd0, 0xcb,
+	0x16, 0x8d, 0xf1, 0x70, 0x02, 0x16, 0x96, 0xfb, 0x02, 0x21, 0x22, 0xf6, 0x12, 0x07, 0x18, 0xbf,
+	0x61, 0x59, 0xdb, 0x60, 0x2f, 0x29, 0x08, 0x18, 0x58, 0xee, 0x12, 0x79, 0x0c, 0x7d, 0x3b, 0x41,
+	0xb8, 0x65, 0x12, 0x16, 0x4b, 0xed, 0x89, 0x7b, 0x77, 0x67, 0x1e, 0x5b, 0xeb, 0x07, 0x43, 0xde,
+	0x33, 0x6e, 0x87, 0x9c, 0x8c, 0xe5, 0xbb, 0xf8, 0x71, 0xca, 0x6c, 0x5a, 0x95, 0x91, 0x6d, 0x5a,
+	0x2c, 0x50, 0x01, 0x32, 0x74, 0xa0, 0x8f, 0xb2, 0xf7, 0xcf, 0x2a, 0x59, 0x73, 0xaa, 0x31, 0x90,
+	0x4d, 0xdb, 0xa0, 0xe8, 0x0c, 0x34, 0x28, 0x6a, 0xd3, 0x64, 0xe9, 0x40, 0xd3, 0xe4, 0x08, 0xd6,
+	0xce, 0x7c, 0xe3, 0x5e, 0xe5, 0x58, 0x8d, 0x7b, 0x6f, 0x27, 0x35, 0x9f, 0xcd, 0x74, 0xda, 0x66,
+	0x93, 0xbb, 0xa6, 0x77, 0xaa, 0x39, 0xd1, 0x0e, 0x0a, 0xc3, 0xfd, 0x61, 0x87, 0xd4, 0x62, 0x31,
+	0xd5, 0x84, 0x6e, 0xf6, 0xca, 0x31, 0xac, 0x05, 0x73, 0x46, 0xf3, 0xf5, 0x20, 0x7f, 0x81, 0x62,
+	0x8f, 0x93, 0x06, 0xdf, 0x47, 0xae, 0x14, 0x36, 0x56, 0xe3, 0xf7, 0x37, 0x69, 0x96, 0x33, 0x74,
+	0xa0, 0x8f, 0xb2, 0xf7, 0x5f, 0x9c, 0x01, 0x22, 0xa1, 0x99, 0xc6, 0x7e, 0x4a, 0xb7, 0xf6, 0xdd,
+	0x8f, 0x90, 0x2a, 0x4e, 0x0c, 0xb9, 0x37, 0xdf, 0x3c, 0xa6, 0x71, 0xd1, 0xe7, 0x3a, 0xfc, 0x95,
+	0x00, 0x67, 0xea, 0x5e, 0x22, 0xa7, 0xe4, 0xc8, 0xac, 0x86, 0x17, 0xfd, 0xa0, 0xd3, 0x8b, 0xf9,
+	0x66, 0x5c, 0x9b, 0x7f, 0x52, 0x3c, 0x70, 0x0a, 0xb2, 0x08, 0xd0, 0xff, 0x8c, 0xf7, 0x33, 0xf5,
+	0xec, 0x41, 0x98, 0xc5, 0x60, 0xe2, 0x0e, 0x11, 0xad, 0xd3, 0xdd, 0x6e, 0x07, 0x77, 0x79, 0x87,
+	0x11, 0xd6, 0x3b, 0x84, 0x82, 0x80, 0x81, 0x85, 0xb3, 0x45, 0x6e, 0x18, 0x51, 0x2c, 0x0f, 0xb9,
+	0xd7, 0x8b, 0x1c, 0x17, 0x43, 0x85, 0xca, 0xec, 0x56, 0x51, 0x9c, 0x80, 0xc1, 0xdc, 0xfd, 0x5e,
+	0x87, 0xd4, 0x52, 0xd9, 0x7d, 0x7e, 0xec, 0x5b, 0x2f, 0xb2, 0x27, 0xf2, 0xa5, 0xf5, 0xf2, 0x51,
+	0x43, 0xa2, 0xf8, 0xba, 0x3f, 0xe0, 0x10, 0x82, 0x41, 0x72, 0x3c, 0x18, 0x47, 0xac, 0xec, 0x1b,
+	0x85, 0x7a, 0x92, 0x14, 0xf5, 0xf9, 0x29, 0x1c, 0x0d, 0xfd, 0x1b, 0x0c, 0xce, 0xee, 0x47, 0x49,
+	0x2d, 0x11, 0xf3, 0xb6, 0x51, 0x2d, 0x7e, 0x30, 0xe4, 0x9a, 0x10, 0x47, 0x07, 0xf1, 0x0b, 0x14,
+	0x4f, 0xf7, 0x27, 0x1d, 0x32, 0xdd, 0xb5, 0x3d, 0x94, 0x62, 0x3b, 0x29, 0x4e, 0xa5, 0xc9, 0x78,
+	0x40, 0xb9, 0xa3, 0x27, 0xd3, 0x08, 0xd9, 0x5e, 0xa0, 0xfa, 0xa8, 0x67, 0xf0, 0x6a, 0x97, 0xcb,
+	0xb4, 0x71, 0xad, 0x3e, 0x5e, 0xca, 0x02, 0xa1, 0x1f, 0xdf, 0x5d, 0x23, 0xa7, 0xb1, 0x77, 0xfb,
+	0xdc, 0xb4, 0x22, 0x8f, 0x4e, 0x09, 0x3b, 0xe8, 0xd5, 0xe6, 0x9f, 0x16, 0x33, 0xe4, 0xf4, 0x5c,
+	0x0e, 0x0e, 0xe4, 0x3e, 0xe9, 0xfe, 0x9e, 0x43, 0x9e, 0x0e, 0x98, 0x0e, 0x6d, 0xc6, 0x0a, 0x68,
+	0x75, 0x5a, 0x04, 0x54, 0xd2, 0x42, 0x37, 0x9d, 0x41, 0xba, 0xfb, 0xfc, 0x5b, 0xc4, 0x1b, 0x3c,
+	0xbd, 0x74, 0x40, 0x97, 0xe0, 0xc0, 0x0e, 0xbb, 0xdf, 0x4c, 0x4e, 0xc8, 0x75, 0xb1, 0x86, 0x1a,
+	0x25, 0x3b, 0x44, 0xd6, 0xe7, 0x4f, 0xa1, 0x4a, 0xb7, 0x6e, 0x02, 0xc0, 0xc6, 0xf3, 0xfe, 0xa2,
+	0x42, 0x4e, 0x67, 0xa7, 0x1b, 0x93, 0xb4, 0xb8, 0xdd, 0xb4, 0xa4, 0xeb, 0x49, 0x6e, 0xc3, 0x85,
+	0x6e, 0x37, 0xca, 0xb1, 0xa5, 0xb7, 0x1b, 0xd5, 0x94, 0x80, 0xc1, 0x1c, 0x0d, 0x2e, 0xa7, 0xfc,
+	0xac, 0x93, 0x56, 0xec, 0x80, 0x2f, 0x17, 0xd9, 0xa5, 0xfe, 0x48, 0x27, 0xb5, 0xdd, 0xf7, 0x81,
+	0xa0, 0xbf, 0x4b, 0xee, 0x77, 0x93, 0x7a, 0xac, 0x22, 0x98, 0xcb, 0x45, 0x46, 0x1c, 0x8a, 0xee,
+	0xa8, 0xd8, 0x13, 0x1d, 0xab, 0xac, 0x39, 0xe2, 0x46, 0x30, 0x19, 0x6b, 0xe1, 0x26, 0xe3, 0xdc,
+	0x5f, 0x3e, 0x26, 0xe1, 0x29, 0xfa, 0xa4, 0x02, 0xf6, 0x0d, 0x50, 0x02, 0x56, 0x47, 0x30, 0xb2,
+	0xe1, 0xf1, 0xfc, 0x5d, 0x6d, 0x88, 0x20, 0xad, 0x4f, 0x3b, 0x64, 0x02, 0xa9, 0x05, 0xe1, 0x16,
+	0xee, 0xc0, 0x8d, 0xd2, 0xb1, 0x1d, 0x1b, 0xd4, 0x56, 0xcb, 0xec, 0x59, 0xa0, 0x79, 0x82, 0xd9,
+	0x01, 0xf7, 0x73, 0x0e, 0x39, 0x21, 0x7e, 0x8b, 0x83, 0x5a, 0xf9, 0xf8, 0xbb, 0xc4, 0xd6, 0x32,
+	0x98, 0x5c, 0xc1, 0xee, 0x84, 0xf7, 0x3b, 0x25, 0xd2, 0x18, 0x24, 0xc0, 0x5c, 0x4a, 0x9e, 0x92,
+	0xbb, 0xb3, 0x9a, 0x3b, 0xab, 0xe1, 0x22, 0xed, 0x50, 0x15, 0xe2, 0x50, 0x9b, 0x7f, 0x56, 0x8c,
+	0xfe, 0x53, 0x6b, 0x83, 0x51, 0xe1, 0x20, 0x3a, 0xee, 0x07, 0xc8, 0x49, 0xf3, 0x2c, 0xa4, 0xbe,
+	0x57, 0x7d, 0x7e, 0x16, 0x75, 0xc3, 0xb9, 0x0c, 0xec, 0xf5, 0xbb, 0x33, 0x8f, 0x67, 0xdb, 0x84,
+	0x84, 0xed, 0xa3, 0xe3, 0x6e, 0x92, 0xc9, 0x5d, 0xff, 0x8e, 0x64, 0x25, 0x63, 0x47, 0x46, 0x3f,
+	0x1d, 0xb3, 0xf3, 0xdb, 0x8a, 0x41, 0x09, 0x2c, 0xba, 0xde, 0x2f, 0xf4, 0x4d, 0x56, 0xa5, 0x84,
+	0x7d, 0xde, 0xe9, 0x73, 0x61, 0x7c, 0xc7, 0x71, 0x28, 0x3e, 0xcc, 0xd9, 0xa1, 0xe2, 0xa0, 0x07,
+	0xe3, 0x3c, 0xc4, 0x28, 0x53, 0xef, 0xdf, 0x56, 0xc8, 0x01, 0x3d, 0x1b, 0xc2, 0x24, 0x35, 0x72,
+	0x6c, 0xdd, 0x8f, 0x3a, 0x2a, 0x88, 0x8a, 0x6f, 0xae, 0xed, 0xe3, 0x1a, 0x7b, 0x6e, 0xb4, 0x4d,
+	0x78, 0xa4, 0xb3, 0x3a, 0x8c, 0xda, 0xe1, 0x5a, 0xee, 0xcf, 0x3a, 0x76, 0x18, 0x18, 0xdf, 0x6d,
+	0x83, 0x63, 0xeb, 0x93, 0x11, 0x5b, 0xc6, 0x3b, 0xa6, 0x23, 0x92, 0x06, 0x45, 0x9d, 0xcd, 0x12,
+	0xb2, 0x19, 0x84, 0x7e, 0x27, 0x78, 0x0d, 0x6d, 0x7e, 0x55, 0xa6, 0x79, 0x31, 0x55, 0xf6, 0xa2,
+	0x6a, 0x05, 0x03, 0xe3, 0xec, 0xdf, 0x20, 0x13, 0xc6, 0x9b, 0xe7, 0x04, 0x68, 0x9f, 0x36, 0x03,
+	0xb4, 0xeb, 0x46, 0x5c, 0xf5, 0xd9, 0xf7, 0x91, 0x93, 0xd9, 0x0e, 0x8e, 0xf2, 0xbc, 0xf7, 0x93,
+	0x13, 0x59, 0x43, 0xc2, 0x3a, 0x8d, 0x77, 0xb1, 0x6b, 0x6f, 0x78, 0xd3, 0xde, 0xf0, 0xa6, 0xbd,
+	0xe1, 0x4d, 0x33, 0xe3, 0x55, 0x84, 0xa7, 0x68, 0xfc, 0x41, 0x79, 0x8a, 0x4c, 0xdf, 0x57, 0xad,
+	0x78, 0xdf, 0x97, 0x70, 0x44, 0xd5, 0x1f, 0xbc, 0x23, 0x8a, 0x3c, 0xa2, 0x8e, 0xa8, 0x89, 0x47,
+	0xc9, 0x11, 0xf5, 0x89, 0xbe, 0x68, 0x8e, 0xf5, 0x98, 0x52, 0x37, 0x22, 0xd5, 0x30, 0x6a, 0x53,
+	0x79, 0x36, 0xbc, 0x52, 0xcc, 0x41, 0xe7, 0x5a, 0xd4, 0x36, 0xd2, 0x69, 0xf1, 0x57, 0x02, 0x9c,
+	0x8f, 0xf7, 0xfd, 0x63, 0xc4, 0x3a, 0x86, 0xf1, 0x65, 0x89, 0xd5, 0x08, 0x68, 0x37, 0xba, 0x0e,
+	0xcb, 0x0d, 0xc7, 0x36, 0x09, 0x03, 0x6f, 0x06, 0x09, 0x47, 0x95, 0xa4, 0xeb, 0xa7, 0xdb, 0x8d,
+	0x92, 0xad, 0x92, 0xa0, 0xbf, 0x0a, 0x18, 0xc4, 0x7d, 0x1f, 0x99, 0x4a, 0xad, 0xe8, 0x55, 0x11,
+	0xa5, 0xf9, 0xb8, 0xc0, 0x9d, 0xb2, 0x63, 0x5b, 0x21, 0x83, 0xed, 0xbe, 0x4a, 0x2a, 0xf8, 0x81,
+	0xc5, 0xca, 0x6c, 0x16, 0xa7, 0x0a, 0xb0, 0x77, 0xc5, 0xb9, 0xc4, 0x05, 0x15, 0xfe, 0x07, 0x8c,
+	0x15, 0x6e, 0x4b, 0xf5, 0x9d, 0x5e, 0x92, 0x46, 0xbb, 0xc1, 0x6b, 0xd2, 0xfb, 0xfd, 0x1d, 0x05,
+	0x33, 0xbe, 0x2a, 0xe9, 0x73, 0xcf, 0x92, 0xfa, 0x09, 0x9a, 0x33, 0xeb, 0x47, 0x3b, 0x88, 0x69,
+	0xcb, 0x58, 0x58, 0x45, 0xf7, 0x63, 0x51, 0xd2, 0xe7, 0xfd, 0x50, 0x3f, 0x41, 0x73, 0x76, 0xf7,
+	0xd5, 0xf6, 0x38, 0x51, 0xc4, 0xe2, 0xee, 0xeb, 0x03, 0xdf, 0x1a, 0x73, 0xb7, 0xc9, 0x67, 0x49,
+	0xb5, 0xb5, 0xed, 0xc7, 0x29, 0xf3, 0x6f, 0xd7, 0xf5, 0x2c, 0x5e, 0xc0, 0x46, 0xe0, 0x30, 0x74,
+	0x76, 0xc6, 0x74, 0xb3, 0x71, 0xc2, 0x76, 0x76, 0x02, 0xdd, 0x04, 0x6c, 0x57, 0x6a, 0xf3, 0xd4,
+	0xc0, 0x1c, 0x97, 0x9f, 0x2b, 0x91, 0xb3, 0x7d, 0xbd, 0x52, 0x43, 0xc1, 0xd7, 0x43, 0xab, 0x17,
+	0x27, 0xd2, 0xb0, 0x6c, 0xac, 0x07, 0xd6, 0x0c, 0x12, 0xee, 0x7e, 0xdc, 0x21, 0xe3, 0xe8, 0xee,
+	0x0d, 0x69, 0xda, 0x28, 0x15, 0x6d, 0x3e, 0x65, 0xdd, 0xba, 0xc2, 0xa9, 0xeb, 0x3e, 0x88, 0x06,
+	0x90, 0x7c, 0xb1, 0xbb, 0xf4, 0x4e, 0xab, 0xd3, 0x6b, 0xf7, 0x79, 0x74, 0x2e, 0xf0, 0x66, 0x90,
+	0x70, 0x44, 0x0d, 0x42, 0x8e, 0x5a, 0xb1, 0x51, 0x97, 0x42, 0x81, 0x2a, 0xe0, 0xde, 0xaf, 0xd4,
+	0xc8, 0x99, 0xbe, 0xce, 0xe0, 0xa2, 0x41, 0x8d, 0x98, 0xe9, 0x9c, 0x17, 0x83, 0x0e, 0x95, 0x0e,
+	0x3d, 0xa6, 0x11, 0xdf, 0x50, 0xad, 0x60, 0x60, 0xb8, 0xdf, 0x43, 0x48, 0xd7, 0x8f, 0xfd, 0x5d,
+	0xaa, 0xbc, 0xe6, 0x47, 0x56, 0x3c, 0xb1, 0x1f, 0x6b, 0x92, 0xa6, 0x36, 0x7e, 0xa9, 0xa6, 0x04,
+	0x0c, 0x96, 0x98, 0x8b, 0x10, 0xd3, 0x0e, 0xf5, 0x13, 0x96, 0x1e, 0x9c, 0xad, 0x75, 0x00, 0x1a,
+	0x04, 0x26, 0x1e, 0x7a, 0xd4, 0x44, 0x92, 0x4b, 0x26, 0xd8, 0xdf, 0x4e, 0x74, 0x71, 0x3f, 0xe3,
+	0x90, 0x29, 0xac, 0xbf, 0xa2, 0xb9, 0x8b, 0xca, 0x04, 0xab, 0x47, 0x7f, 0xc9, 0x8b, 0x26, 0x5d,
+	0xbd, 0x87, 0x5a, 0xcd, 0x09, 0x64, 0xd8, 0xe3, 0x67, 0xde, 0xa3, 0x31, 0xdb, 0x7c, 0xc7, 0xec,
+	0xcf, 0x7c, 0x83, 0x37, 0x83, 0x84, 0xa3, 0x43, 0xba, 0xeb, 0x27, 0xc9, 0x42, 0x4c, 0xdb, 0x34,
+	0x4c, 0x03, 0xbf, 0xc3, 0xeb, 0x06, 0x18, 0x0e, 0xe9, 0x35, 0x1b, 0x0c, 0x59, 0x7c, 0xf7, 0xfd,
+	0xe4, 0x09, 0x6e, 0x59, 0x5d, 0x09, 0x92, 0x24, 0x08, 0xb7, 0xf4, 0x34, 0x10, 0x06, 0xe6, 0x19,
+	0x41, 0xea, 0x89, 0xa5, 0x7c, 0x34, 0x18, 0xf4, 0x3c, 0x7a, 0x03, 0x93, 0x9d, 0xa0, 0xbb, 0x10,
+	0xb7, 0x93, 0x46, 0xdd, 0xf6, 0x06, 0x36, 0x45, 0x3b, 0x28, 0x0c, 0xb7, 0x45, 0x26, 0xf9, 0x27,
+	0xe1, 0x59, 0x3a, 0x62, 0x07, 0x7d, 0x7e, 0xa0, 0x9e, 0x25, 0x4a, 0x04, 0xcd, 0x82, 0x7f, 0xfb,
+	0x82, 0x8c, 0x5f, 0xe2, 0xa6, 0x8d, 0x1b, 0x06, 0x19, 0xb0, 0x88, 0xda, 0x47, 0xee, 0x89, 0x21,
+	0x8e, 0xdc, 0xdf, 0x44, 0x26, 0x50, 0x23, 0x10, 0x23, 0xdf, 0x98, 0xb4, 0x67, 0xdf, 0x55, 0x0d,
+	0x02, 0x13, 0x8f, 0x25, 0x48, 0x75, 0x03, 0xf1, 0x0b, 0x53, 0xd5, 0x75, 0x82, 0xd4, 0xda, 0x92,
+	0x6c, 0x06, 0x13, 0x07, 0xbb, 0x86, 0x63, 0xb1, 0x4e, 0x13, 0x96, 0x6c, 0x8e, 0xc3, 0xa5, 0xba,
+	0xd6, 0x94, 0x00, 0xd0, 0x38, 0xe8, 0x17, 0xc0, 0x1f, 0x4d, 0x56, 0x22, 0xe9, 0x86, 0xdf, 0x09,
+	0xda, 0xdc, 0xe1, 0x3e, 0x6d, 0xfb, 0x05, 0x9a, 0x39, 0x38, 0x90, 0xfb, 0xa4, 0xf7, 0x53, 0x19,
+	0x03, 0x9a, 0xb9, 0x85, 0xb9, 0x09, 0x6e, 0x54, 0xe9, 0x0d, 0x3f, 0x96, 0x0a, 0xcf, 0x11, 0x8b,
+	0x3f, 0x08, 0xba, 0x37, 0xfc, 0xd8, 0xdc, 0xf2, 0x18, 0x03, 0x90, 0x9c, 0xdc, 0x5b, 0xa4, 0x92,
+	0x76, 0xfc, 0x82, 0xaa, 0xc5, 0x18, 0x1c, 0xb5, 0x99, 0x75, 0x79, 0x2e, 0x01, 0xc6, 0xc3, 0x7d,
+	0x1a, 0x0f, 0xd7, 0x1b, 0x32, 0xe6, 0x40, 0x9c, 0x87, 0x37, 0x12, 0x60, 0xad, 0xde, 0xe7, 0x4e,
+	0xe4, 0x48, 0x1d, 0xa5, 0x08, 0xa0, 0x47, 0x13, 0x27, 0xcd, 0x5a, 0x4c, 0x37, 0x83, 0x3b, 0x42,
+	0x11, 0x53, 0x3b, 0xdb, 0x35, 0x05, 0x01, 0x03, 0x4b, 0x3e, 0xd3, 0xec, 0x6d, 0xe2, 0x33, 0xa5,
+	0xfe, 0x67, 0x38, 0x04, 0x0c, 0x2c, 0xf7, 0x5d, 0x64, 0x2c, 0xd8, 0xf5, 0xb7, 0x54, 0xee, 0xde,
+	0xd3, 0xb8, 0xa5, 0x2d, 0xb1, 0x96, 0xd7, 0xef, 0xce, 0x4c, 0xa9, 0x0e, 0xb1, 0x26, 0x10, 0xb8,
+	0xee, 0x2f, 0x38, 0x64, 0xb2, 0x15, 0xed, 0xee, 0x46, 0x21, 0xb7, 0x6e, 0x08, 0x53, 0xcd, 0xad,
+	0xe3, 0x52, 0x93, 0x66, 0x17, 0x0c, 0x66, 0xdc, 0x56, 0xa3, 0xac, 0xe4, 0x26, 0x08, 0xac, 0x5e,
+	0x99, 0x3b, 0x5f, 0xf5, 0x90, 0x9d, 0xef, 0x57, 0x1d, 0x72, 0x8a, 0x3f, 0x6b, 0x18, 0x5d, 0x44,
+	0x05, 0x97, 0xe8, 0x98, 0x5f, 0xab, 0xcf, 0x0e, 0xa5, 0x9c, 0x24, 0x7d, 0x70, 0xe8, 0xef, 0x24,
+	0x3a, 0xd7, 0x37, 0xa3, 0xb8, 0x45, 0xcd, 0x81, 0x10, 0xdb, 0xb6, 0x22, 0x74, 0x31, 0x8b, 0x00,
+	0xfd, 0xcf, 0xb8, 0x37, 0xc8, 0xe3, 0x46, 0xa3, 0x39, 0x0e, 0x7c, 0xe7, 0x7e, 0x46, 0x50, 0x7b,
+	0xfc, 0x62, 0x2e, 0x16, 0x0c, 0x78, 0xda, 0xde, 0x24, 0xeb, 0x43, 0x6c, 0x92, 0xaf, 0x90, 0x27,
+	0x5b, 0xfd, 0x23, 0xb3, 0x97, 0xf4, 0x36, 0x12, 0xbe, 0x8f, 0xd7, 0xe6, 0xbf, 0x46, 0x10, 0x78,
+	0x72, 0x61, 0x10, 0x22, 0x0c, 0xa6, 0xe1, 0x7e, 0x84, 0xd4, 0x62, 0xca, 0xbe, 0x4a, 0x22, 0xca,
+	0x99, 0x5c, 0x3b, 0xea, 0xd1, 0x50, 0x6a, 0xf0, 0x9c, 0xac, 0x96, 0x4c, 0xa2, 0x21, 0x01, 0xc5,
+	0xd1, 0xbd, 0x4d, 0xc6, 0xbb, 0xe8, 0x2c, 0x14, 0x45, 0x4c, 0x8e, 0xec, 0xd3, 0x52, 0xcc, 0x99,
+	0x0b, 0xd2, 0x28, 0x09, 0xc7, 0x99, 0x80, 0xe4, 0x86, 0xba, 0x5a, 0x2b, 0xda, 0xed, 0x46, 0x21,
+	0x0d, 0x53, 0x29, 0x44, 0xa6, 0xb8, 0x9f, 0x50, 0xb6, 0x82, 0x81, 0xd1, 0x27, 0xcb, 0x35, 0x5a,
+	0xe3, 0xd4, 0x01, 0xb2, 0xdc, 0xa0, 0x36, 0xe8, 0x79, 0x14, 0x36, 0xcc, 0xea, 0x7b, 0x33, 0x48,
+	0xb7, 0xd1, 0x2b, 0x23, 0xad, 0x21, 0x53, 0xb6, 0xb0, 0x59, 0xce, 0xc1, 0x81, 0xdc, 0x27, 0xb3,
+	0x92, 0x75, 0xfa, 0xfe, 0x24, 0xeb, 0xc9, 0x21, 0x24, 0x6b, 0x93, 0x9c, 0x61, 0x3d, 0x10, 0x5a,
+	0xb2, 0xb4, 0x29, 0x27, 0x0d, 0x97, 0x75, 0x5e, 0xa5, 0xa4, 0x2f, 0xe7, 0x21, 0x41, 0xfe, 0xb3,
+	0x67, 0xbf, 0x8d, 0x9c, 0xea, 0xdb, 0xe4, 0x46, 0xb2, 0x17, 0x2f, 0x92, 0xc7, 0xf3, 0xb7, 0x93,
+	0x91, 0xac, 0xc6, 0xbf, 0x92, 0x49, 0x25, 0x35, 0x8e, 0x68, 0x43, 0x78, 0x20, 0x7c, 0x52, 0xa6,
+	0xe1, 0x9e, 0x90, 0xae, 0x17, 0x8f, 0x36, 0xab, 0x2f, 0x84, 0x7b, 0x7c, 0x37, 0x64, 0x46, 0xa7,
+	0x0b, 0xe1, 0x1e, 0x20, 0x6d, 0x2c, 0x46, 0x63, 0x1e, 0x20, 0xb8, 0xdf, 0xe2, 0x43, 0xc7, 0x72,
+	0x26, 0x1d, 0xfa, 0x4c, 0xe1, 0xfd, 0xbb, 0x12, 0x39, 0x77, 0x18, 0x91, 0x21, 0x86, 0xef, 0x59,
+	0x8c, 0xda, 0x43, 0x97, 0x9a, 0x10, 0x57, 0x13, 0xb8, 0x8a, 0xb9, 0x93, 0xed, 0x15, 0x10, 0x20,
+	0xb7, 0x43, 0xca, 0xbb, 0x7e, 0x57, 0x98, 0xb3, 0x97, 0x8e, 0x5a, 0x0d, 0x04, 0x7f, 0xfb, 0x9d,
+	0x15, 0xbf, 0xcb, 0xe7, 0xbc, 0xd1, 0x00, 0xc8, 0xc6, 0x4d, 0x49, 0xd5, 0x8f, 0x63, 0x5f, 0x86,
+	0x03, 0x5d, 0x2d, 0x86, 0xdf, 0x1c, 0x92, 0xe4, 0x1e, 0x58, 0xab, 0x09, 0x38, 0x33, 0x0c, 0xf3,
+	0x9a, 0xce, 0xb8, 0xcc, 0xdc, 0x84, 0x8c, 0x09, 0x63, 0x9e, 0x53, 0x74, 0x11, 0x16, 0x46, 0x96,
+	0x5b, 0x20, 0xf8, 0xff, 0x20, 0x58, 0xb9, 0x9f, 0x74, 0x58, 0x65, 0x3c, 0x59, 0xf4, 0xa2, 0x51,
+	0x2a, 0x38, 0x1c, 0xc9, 0x2c, 0xd4, 0x67, 0xd6, 0xdb, 0x93, 0x8d, 0x60, 0x72, 0x17, 0xd5, 0x3f,
+	0xd9, 0x69, 0xa6, 0xbf, 0xfa, 0x27, 0x36, 0x83, 0x84, 0xbb, 0x77, 0x72, 0x62, 0xb9, 0x0a, 0xa8,
+	0xae, 0x36, 0x44, 0xf4, 0xd6, 0xcf, 0x3a, 0xe4, 0x54, 0x90, 0x0d, 0xca, 0x69, 0x54, 0x8b, 0x08,
+	0x3b, 0x1c, 0x1c, 0xf3, 0xa3, 0x14, 0x9d, 0x3e, 0x10, 0xf4, 0x77, 0xc6, 0x6d, 0x93, 0x4a, 0x10,
+	0x6e, 0x46, 0x42, 0xbd, 0x9b, 0x3f, 0x5a, 0xa7, 0x96, 0xc2, 0xcd, 0x48, 0xaf, 0x66, 0xfc, 0x05,
+	0x8c, 0xba, 0xbb, 0x4c, 0x4e, 0xcb, 0x14, 0xfd, 0xcb, 0x41, 0x82, 0xb6, 0xa4, 0xe5, 0x60, 0x37,
+	0x48, 0x99, 0x6a, 0x56, 0x9e, 0x6f, 0xa0, 0x78, 0x83, 0x1c, 0x38, 0xe4, 0x3e, 0xe5, 0xbe, 0x46,
+	0xc6, 0x65, 0x20, 0x4c, 0xad, 0x08, 0x7b, 0x42, 0xff, 0xfc, 0x57, 0x93, 0x89, 0xff, 0x4e, 0x40,
+	0x32, 0x74, 0x7f, 0xc8, 0x21, 0x53, 0xfc, 0xff, 0xcb, 0xfb, 0x6d, 0x5e, 0x15, 0xa4, 0x5e, 0x44,
+	0xa2, 0x6d, 0xd3, 0xa2, 0xc9, 0xed, 0xfb, 0x76, 0x1b, 0x64, 0xf8, 0xba, 0xdf, 0x8f, 0x56, 0x51,
+	0x56, 0xb6, 0x27, 0x59, 0x0d, 0x45, 0x7d, 0xbc, 0x66, 0x81, 0xcb, 0x51, 0x16, 0x04, 0xd2, 0x1a,
+	0xea, 0xa2, 0xe4, 0x06, 0x9a, 0xb1, 0xf7, 0x0f, 0x26, 0xc9, 0xa9, 0xb9, 0x83, 0xc3, 0x95, 0x9c,
+	0x07, 0x1e, 0xae, 0x74, 0x8b, 0x54, 0x12, 0x1d, 0xcf, 0x53, 0xc0, 0x6a, 0x17, 0x5c, 0x75, 0xb0,
+	0x02, 0x46, 0xee, 0x30, 0x1e, 0x6e, 0x8f, 0x8c, 0xf1, 0x1a, 0xc0, 0x8d, 0x72, 0x11, 0x4e, 0xb3,
+	0x4c, 0xa1, 0x62, 0x6d, 0x5d, 0xe3, 0xad, 0x20, 0x98, 0xb9, 0x77, 0xc8, 0xf8, 0x36, 0x5f, 0x15,
+	0xe2, 0xc8, 0xb9, 0x72, 0xd4, 0xf1, 0xb5, 0x96, 0x9a, 0x5e, 0x03, 0xa2, 0x01, 0x24, 0x3b, 0x16,
+	0x1d, 0x6b, 0xc4, 0xef, 0xf1, 0xfd, 0xac, 0xb8, 0x3a, 0x2b, 0xc3, 0x07, 0xef, 0x7d, 0x98, 0x4c,
+	0xc6, 0xb4, 0x15, 0x85, 0xad, 0xa0, 0x43, 0xdb, 0x73, 0xd2, 0x6d, 0x3a, 0x4a, 0x54, 0x39, 0x33,
+	0x6a, 0x81, 0x41, 0x03, 0x2c, 0x8a, 0x6c, 0xb9, 0xab, 0x6a, 0x60, 0xf8, 0x41, 0x64, 0xe8, 0xfa,
+	0x72, 0x41, 0xb5, 0xc7, 0x18, 0x4d, 0xbe, 0xdc, 0xed, 0x36, 0xc8, 0xf0, 0x75, 0x3f, 0x40, 0x48,
+	0xb4, 0xc1, 0x43, 0x60, 0xe7, 0xd2, 0x46, 0x6d, 0xe4, 0x57, 0x9d, 0xe2, 0x65, 0x7a, 0x24, 0x05,
+	0x30, 0xa8, 0xb9, 0x57, 0x09, 0xe1, 0x2b, 0x07, 0x9d, 0xd9, 0x8d, 0xba, 0x55, 0x1f, 0x85, 0x34,
+	0x15, 0xe4, 0xf5, 0xbb, 0x33, 0xfd, 0xa6, 0x6f, 0x04, 0x80, 0xf1, 0xb8, 0xfb, 0x5d, 0x64, 0x3c,
+	0xe9, 0xed, 0xee, 0xfa, 0xca, 0x55, 0x53, 0x60, 0xe1, 0x1f, 0x4e, 0xd7, 0xd8, 0x9f, 0x79, 0x03,
+	0x48, 0x8e, 0xee, 0x2d, 0x94, 0x34, 0x62, 0xa3, 0xe4, 0xab, 0x48, 0x7b, 0x3d, 0xeb, 0xf3, 0xef,
+	0x96, 0x87, 0x29, 0xc8, 0xc1, 0xc1, 0x80, 0x31, 0xbb, 0x7d, 0x39, 0x6a, 0x09, 0x9b, 0x5e, 0x1e,
+	0x4d, 0xf7, 0x0a, 0x99, 0xd0, 0xaf, 0x2d, 0xab, 0x70, 0xbe, 0x4d, 0x97, 0x3b, 0x66, 0xcd, 0x83,
+	0xc7, 0xcc, 0x7c, 0xd8, 0x5d, 0x21, 0x8f, 0xb5, 0xa2, 0x30, 0x8d, 0xa3, 0x4e, 0x87, 0x97, 0x42,
+	0xe7, 0x26, 0x02, 0xee, 0xca, 0x79, 0x4a, 0x74, 0xfb, 0xb1, 0x85, 0x7e, 0x14, 0xc8, 0x7b, 0x0e,
+	0x8f, 0x06, 0x59, 0x31, 0x35, 0x55, 0x48, 0x10, 0x86, 0x45, 0x53, 0xec, 0x50, 0xca, 0xfa, 0x7e,
+	0xb0, 0xc0, 0xf2, 0x42, 0xdb, 0xd7, 0x2b, 0xbe, 0xd8, 0xbb, 0xc8, 0x24, 0x26, 0xc9, 0xc6, 0xa1,
+	0xdf, 0xb9, 0x0e, 0xcb, 0x56, 0x22, 0xd4, 0x05, 0xa3, 0x1d, 0x2c, 0x2c, 0xac, 0x79, 0x25, 0x8c,
+	0x75, 0x46, 0xcd, 0x2b, 0x6e, 0xac, 0x93, 0xa6, 0x39, 0xef, 0x97, 0xcb, 0x96, 0xea, 0xfc, 0x50,
+	0x3c, 0xcb, 0xac, 0x92, 0xad, 0x2c, 0xf9, 0xcb, 0x00, 0x8d, 0x52, 0xe1, 0x9c, 0x55, 0x8a, 0xdd,
+	0xaa, 0xc9, 0x08, 0x6c, 0xbe, 0xee, 0x0e, 0xa9, 0x6e, 0x47, 0x49, 0x2a, 0x0f, 0x8a, 0x47, 0x3c,
+	0x93, 0x5e, 0x8e, 0x92, 0x94, 0xe9, 0x7b, 0xea, 0xb5, 0xb1, 0x25, 0x01, 0xce, 0x03, 0x4d, 0x10,
+	0xc9, 0xb6, 0x1f, 0xb7, 0x93, 0x05, 0x56, 0xa1, 0xae, 0xc2, 0x14, 0x3d, 0xa5, 0xd6, 0x37, 0x35,
+	0x08, 0x4c, 0x3c, 0xef, 0xaf, 0xec, 0xaa, 0x84, 0x37, 0x59, 0x0a, 0xe3, 0x1e, 0x0d, 0x71, 0x8b,
+	0x32, 0x63, 0x79, 0xbf, 0x39, 0x53, 0xbc, 0xe9, 0xad, 0x83, 0x6e, 0x2d, 0xb8, 0x8d, 0x14, 0x66,
+	0x19, 0x09, 0x23, 0xec, 0xf7, 0x63, 0x8e, 0x5d, 0x85, 0xab, 0x54, 0xc4, 0x09, 0xd2, 0xe8, 0xf7,
+	0xe1, 0x05, 0xbd, 0xbc, 0x1f, 0x77, 0xc8, 0xf8, 0xbc, 0xdf, 0xda, 0x89, 0x36, 0x37, 0xd1, 0x9b,
+	0xd3, 0x96, 0x49, 0x93, 0x8e, 0x9d, 0x85, 0xaa, 0xf2, 0x25, 0x15, 0x06, 0x4e, 0xfd, 0x4d, 0x5f,
+	0xd5, 0x5e, 0x2c, 0xf3, 0xa9, 0x7f, 0x91, 0xb5, 0x80, 0x80, 0xe0, 0xf0, 0x63, 0xdc, 0xa9, 0x9d,
+	0x89, 0xa9, 0x3a, 0xb5, 0xa2, 0x41, 0x60, 0xe2, 0x79, 0xbf, 0xed, 0x90, 0xc6, 0xbc, 0x9f, 0x04,
+	0x2d, 0xbc, 0xc9, 0x61, 0x3e, 0x48, 0x37, 0x7a, 0xad, 0x1d, 0x9a, 0xf2, 0xba, 0x85, 0xd8, 0xcb,
+	0x5e, 0x42, 0x63, 0xe3, 0xe0, 0xae, 0x7a, 0x79, 0x5d, 0xb4, 0x83, 0xc2, 0x70, 0x5f, 0x23, 0x13,
+	0xe8, 0x0f, 0xbb, 0x1d, 0xc5, 0x6d, 0xa0, 0x9b, 0xc5, 0x14, 0x5d, 0x6d, 0xd2, 0x56, 0x4c, 0x53,
+	0xa0, 0x9b, 0x22, 0x8c, 0x49, 0xd3, 0x07, 0x93, 0x99, 0xf7, 0xc3, 0x0e, 0x39, 0x3d, 0x4f, 0xfd,
+	0x98, 0xc6, 0xac, 0x46, 0xab, 0x7a, 0x11, 0xf7, 0x55, 0x52, 0x4b, 0xb1, 0x05, 0x7b, 0xe4, 0x14,
+	0xdb, 0x23, 0x16, 0x80, 0xb4, 0x2e, 0x88, 0x83, 0x62, 0xe3, 0x7d, 0xda, 0x21, 0x4f, 0xe6, 0xf5,
+	0x65, 0xa1, 0x13, 0xf5, 0xda, 0x0f, 0xa3, 0x43, 0x7f, 0xdb, 0x21, 0x93, 0x2c, 0x6a, 0x60, 0x91,
+	0xa6, 0x7e, 0xd0, 0xe9, 0xab, 0x78, 0xef, 0x0c, 0x59, 0xf1, 0xfe, 0x1c, 0xa9, 0x6c, 0x47, 0xbb,
+	0x34, 0x1b, 0xf1, 0x72, 0x39, 0x42, 0x1b, 0x0e, 0x42, 0xd0, 0x9e, 0xb8, 0xeb, 0x07, 0x61, 0xea,
+	0xe3, 0x72, 0x94, 0x5e, 0x95, 0x69, 0x3e, 0x01, 0x55, 0x33, 0x98, 0x38, 0xde, 0x6f, 0xd4, 0xc9,
+	0xb8, 0x88, 0x9e, 0x1b, 0xba, 0x8e, 0xa6, 0x34, 0x26, 0x95, 0x06, 0x1a, 0x93, 0x12, 0x32, 0xd6,
+	0x62, 0xd7, 0x92, 0x34, 0xca, 0x45, 0x98, 0x6e, 0x44, 0x07, 0xf9, 0x4d, 0x27, 0xba, 0x5b, 0xfc,
+	0x37, 0x08, 0x56, 0xee, 0x67, 0x1d, 0x32, 0xdd, 0x8a, 0xc2, 0x90, 0xb6, 0xb4, 0xee, 0x58, 0x29,
+	0xe2, 0x80, 0xb0, 0x60, 0x13, 0xd5, 0x0e, 0xe9, 0x0c, 0x00, 0xb2, 0xec, 0x31, 0x43, 0x9b, 0x8f,
+	0xd9, 0x0d, 0xcb, 0x15, 0xa4, 0x0b, 0xa1, 0x9b, 0x40, 0xb0, 0x71, 0xd1, 0x62, 0x1e, 0xea, 0x92,
+	0xe3, 0x63, 0xda, 0x62, 0x6e, 0x14, 0x1b, 0x37, 0x30, 0x30, 0x49, 0x36, 0xa6, 0x9b, 0x31, 0x4d,
+	0xb6, 0x45, 0x74, 0x21, 0xd3, 0x5b, 0xc7, 0xef, 0x2f, 0x49, 0x16, 0xfa, 0x28, 0x41, 0x0e, 0x75,
+	0x77, 0x47, 0x58, 0x33, 0x6a, 0x45, 0xec, 0xe7, 0xe2, 0x33, 0x0f, 0x34, 0x6a, 0xcc, 0x90, 0x2a,
+	0x13, 0x5d, 0x4c, 0x5f, 0x2e, 0xf3, 0xb2, 0x1e, 0x4c, 0xb0, 0x01, 0x6f, 0x77, 0x17, 0xc9, 0xc9,
+	0x4c, 0x19, 0xf7, 0x44, 0xb8, 0x6c, 0x54, 0xd6, 0x7e, 0xa6, 0x00, 0x7c, 0x02, 0x7d, 0x4f, 0x98,
+	0x96, 0xae, 0x89, 0x43, 0x2c, 0x5d, 0xfb, 0x2a, 0x86, 0x9d, 0x3b, 0x53, 0x5e, 0x2a, 0x64, 0x00,
+	0x86, 0x0a, 0x58, 0xff, 0x54, 0x26, 0x60, 0xfd, 0xc4, 0xb9, 0xf2, 0xd1, 0x63, 0x7e, 0x64, 0x07,
+	0x46, 0x8f, 0x4e, 0x7f, 0x98, 0xd1, 0xe6, 0xff, 0xb0, 0x44, 0xe4, 0x77, 0x5d, 0xf0, 0x5b, 0xdb,
+	0x14, 0xa7, 0x0c, 0x46, 0xff, 0x29, 0xeb, 0x04, 0x57, 0x89, 0x1c, 0x36, 0x6b, 0x94, 0xee, 0x0c,
+	0x16, 0x14, 0x32, 0xd8, 0xe8, 0x38, 0xc4, 0x71, 0xe2, 0x8f, 0x72, 0xb9, 0xaf, 0x2c, 0x20, 0x73,
+	0x6b, 0x4b, 0xe2, 0x29, 0x8d, 0xe3, 0x46, 0xe4, 0x54, 0xc7, 0x4f, 0x52, 0xd6, 0x03, 0x34, 0x56,
+	0xdc, 0x67, 0xfd, 0x49, 0x96, 0x4b, 0xb9, 0x9c, 0x25, 0x04, 0xfd, 0xb4, 0x99, 0xcb, 0x1d, 0xf5,
+	0x4c, 0x53, 0xe1, 0xd3, 0x2e, 0x77, 0x05, 0x01, 0x03, 0xcb, 0xfb, 0xf7, 0x55, 0x72, 0xc2, 0xda,
+	0x4d, 0x47, 0x54, 0x32, 0xde, 0x4e, 0x6a, 0x52, 0xee, 0x67, 0xcb, 0x77, 0x28, 0xe5, 0x40, 0x61,
+	0xa0, 0xa0, 0xdb, 0xd0, 0x92, 0x38, 0xab, 0x14, 0x19, 0x42, 0x1a, 0x4c, 0x3c, 0xb6, 0x91, 0xa7,
+	0x9d, 0x64, 0xa1, 0x13, 0xd0, 0x30, 0xe5, 0xdd, 0x2c, 0x66, 0x23, 0x5f, 0x5f, 0x6e, 0x9a, 0x44,
+	0xf5, 0x46, 0x9e, 0x01, 0x40, 0x96, 0x3d, 0x9a, 0xfe, 0x4e, 0xf8, 0xb7, 0x13, 0x7d, 0xdf, 0x56,
+	0xa3, 0x5a, 0x84, 0x60, 0xb3, 0xae, 0xf0, 0xe2, 0x3e, 0x09, 0xab, 0x09, 0x6c, 0xa6, 0x98, 0xb2,
+	0xe4, 0xd2, 0x3b, 0xb4, 0x25, 0x03, 0xee, 0x45, 0x5f, 0xc6, 0x8a, 0x38, 0xf5, 0x5f, 0xe8, 0xa3,
+	0xcb, 0x25, 0x41, 0x7f, 0x3b, 0xe4, 0xf4, 0xc1, 0xbd, 0x42, 0xdc, 0x76, 0x90, 0xf8, 0x1b, 0x1d,
+	0x74, 0xc2, 0xcb, 0x12, 0x28, 0x22, 0x14, 0xe0, 0xac, 0x18, 0x67, 0x77, 0xb1, 0x0f, 0x03, 0x72,
+	0x9e, 0x62, 0xb3, 0x2c, 0x8e, 0xee, 0xec, 0x5f, 0x8f, 0x3b, 0x8d, 0x5a, 0x66, 0x96, 0x89, 0x76,
+	0x50, 0x18, 0xde, 0xe7, 0xab, 0x6a, 0xf9, 0xeb, 0xec, 0x12, 0xdf, 0x88, 0x72, 0x77, 0xee, 0x3f,
+	0xca, 0x5d, 0xf1, 0xcd, 0x89, 0x74, 0xb7, 0x12, 0xe7, 0x4b, 0x0f, 0x29, 0x71, 0xfe, 0x7b, 0x1d,
+	0xab, 0x00, 0xf6, 0xc4, 0x0b, 0x1f, 0x28, 0x36, 0xb3, 0x65, 0x96, 0x07, 0xa0, 0x65, 0x64, 0x51,
+	0x26, 0xee, 0xf0, 0xed, 0xa4, 0xb6, 0xd9, 0xf1, 0x59, 0x5d, 0x40, 0x51, 0x44, 0x46, 0x75, 0xf9,
+	0xa2, 0x68, 0x07, 0x85, 0x81, 0xea, 0x23, 0xd3, 0x19, 0x78, 0x51, 0x8d, 0x3c, 0x41, 0xbf, 0x44,
+	0x1e, 0x13, 0xb5, 0x66, 0xda, 0x86, 0x23, 0xbc, 0x31, 0xa6, 0x0b, 0xa8, 0x40, 0x3f, 0x18, 0xf2,
+	0x9e, 0xc1, 0xa8, 0x42, 0x0c, 0x0f, 0xbb, 0x1e, 0xc6, 0xd4, 0x6f, 0x6d, 0xe3, 0x44, 0xcb, 0x46,
+	0x15, 0x36, 0x6d, 0x30, 0x64, 0xf1, 0x51, 0xb2, 0x19, 0x83, 0x30, 0x92, 0x64, 0xfa, 0x93, 0x32,
+	0x99, 0x30, 0xb4, 0x9a, 0x5c, 0x15, 0xd5, 0x79, 0xc4, 0x54, 0xd4, 0xd2, 0x08, 0x2a, 0xea, 0xf7,
+	0x90, 0x7a, 0x4b, 0x4a, 0xdc, 0x62, 0x6e, 0x7b, 0xcb, 0xca, 0x71, 0x2d, 0x74, 0x55, 0x13, 0x68,
+	0x9e, 0x18, 0x7f, 0x64, 0x90, 0xb1, 0x44, 0x61, 0x5e, 0xb6, 0xb7, 0x90, 0x88, 0xfd, 0xcf, 0x64,
+	0x43, 0x31, 0xaa, 0x87, 0x87, 0x62, 0xe0, 0x55, 0x15, 0xf2, 0xe3, 0x3e, 0x80, 0x8a, 0x98, 0xb7,
+	0xec, 0x8a, 0x98, 0x17, 0x0a, 0x19, 0xe6, 0x01, 0xa5, 0x30, 0xaf, 0x91, 0x71, 0x0c, 0xe7, 0xf0,
+	0xc3, 0xb6, 0xfb, 0xb5, 0x64, 0xbc, 0xc5, 0xff, 0x15, 0x76, 0x42, 0x16, 0x17, 0x20, 0xa0, 0x20,
+	0x61, 0x18, 0x6f, 0xe8, 0xc7, 0x5b, 0xd2, 0x36, 0xc8, 0xe2, 0x0d, 0xe7, 0xe2, 0xad, 0x04, 0x58,
+	0x2b, 0xd6, 0x15, 0x62, 0x61, 0x3e, 0x7e, 0x4c, 0xdb, 0xeb, 0x11, 0xbb, 0xd2, 0xe4, 0x58, 0xbd,
+	0xe9, 0xfa, 0xe0, 0xfa, 0x28, 0x7b, 0xd4, 0x0d, 0xaf, 0x6a, 0xf9, 0x41, 0x7b, 0x55, 0xf3, 0x1d,
+	0xe5, 0x95, 0x47, 0xc8, 0x51, 0xee, 0xfd, 0xa8, 0x43, 0x5c, 0x15, 0xb4, 0xa5, 0x23, 0x59, 0xce,
+	0x93, 0xba, 0x8a, 0x12, 0x13, 0x0a, 0xab, 0xde, 0x22, 0x24, 0x00, 0x34, 0xce, 0x10, 0xd6, 0x8a,
+	0x67, 0xe5, 0xfe, 0x5d, 0xb6, 0x53, 0x3d, 0xd8, 0xae, 0x2f, 0xb6, 0x73, 0xef, 0x37, 0x4b, 0xe4,
+	0x71, 0xae, 0xea, 0xac, 0xf8, 0xa1, 0xbf, 0x45, 0x77, 0xb1, 0x57, 0xc3, 0xc6, 0x26, 0xb5, 0x50,
+	0xe4, 0x05, 0x32, 0x31, 0xe3, 0xa8, 0x6b, 0x97, 0xaf, 0x39, 0xbe, 0xca, 0x96, 0xc2, 0x20, 0x05,
+	0x46, 0xdc, 0x4d, 0x48, 0x4d, 0x5e, 0x13, 0xdb, 0x28, 0x17, 0xc9, 0x48, 0x6d, 0x4b, 0x42, 0x2b,
+	0xa0, 0xa0, 0x18, 0xa1, 0xe8, 0xef, 0x44, 0xad, 0x1d, 0xa0, 0xdd, 0x28, 0x2b, 0xfa, 0x97, 0x45,
+	0x3b, 0x28, 0x0c, 0x6f, 0x97, 0x4c, 0xcb, 0x31, 0xec, 0xe2, 0x85, 0x1f, 0x74, 0x13, 0xe5, 0x4f,
+	0x4b, 0x36, 0x19, 0x37, 0xd7, 0x2a, 0xf9, 0xb3, 0x60, 0x02, 0xc1, 0xc6, 0x95, 0x57, 0x89, 0x94,
+	0xf2, 0xaf, 0x12, 0xf1, 0x7e, 0xd3, 0x21, 0x59, 0x01, 0x68, 0x54, 0x27, 0x73, 0x86, 0xad, 0x4e,
+	0x76, 0xd8, 0xd5, 0x03, 0xdf, 0x49, 0x26, 0xfc, 0x14, 0x35, 0x32, 0x6e, 0x71, 0x29, 0xdf, 0x9f,
+	0xa7, 0x70, 0x25, 0x6a, 0x07, 0x9b, 0x01, 0x52, 0x00, 0x93, 0x9c, 0xf7, 0xb9, 0x12, 0xa9, 0x2f,
+	0xc6, 0xfb, 0xa3, 0x67, 0xc8, 0xf5, 0xe7, 0xbf, 0x95, 0x46, 0xca, 0x7f, 0x93, 0x19, 0x76, 0xe5,
+	0x81, 0x19, 0x76, 0xc6, 0x0e, 0x56, 0x79, 0xc0, 0x3b, 0x98, 0xf7, 0x3f, 0x2a, 0xe4, 0x54, 0x5f,
+	0x36, 0xb0, 0xfb, 0x22, 0x99, 0x54, 0x33, 0x44, 0x9a, 0x78, 0xeb, 0x66, 0xbc, 0xb6, 0x86, 0x81,
+	0x85, 0x39, 0xc4, 0x36, 0x21, 0xb4, 0x52, 0xda, 0xa3, 0x73, 0x9b, 0x29, 0x8d, 0x9b, 0x14, 0x1d,
+	0xe3, 0xbc, 0x72, 0x45, 0x59, 0x6b, 0xa5, 0x19, 0x30, 0xe4, 0x3d, 0xe3, 0x76, 0xc9, 0x89, 0x8e,
+	0x79, 0xce, 0x68, 0x54, 0xee, 0xff, 0x88, 0xa2, 0x56, 0x8a, 0xd5, 0x0c, 0x36, 0x03, 0xfb, 0xb0,
+	0x52, 0x7d, 0x48, 0x87, 0x95, 0xef, 0xd3, 0x87, 0x15, 0x1e, 0xfe, 0xf4, 0xc1, 0x82, 0xb3, 0xc1,
+	0x87, 0x39, 0xad, 0x1c, 0x45, 0x9f, 0x7f, 0x89, 0xd4, 0x64, 0x68, 0xe8, 0x50, 0x21, 0x95, 0x26,
+	0x9d, 0x01, 0x72, 0xe5, 0x39, 0xf2, 0x96, 0x0b, 0x71, 0x6c, 0x0c, 0xe6, 0xb5, 0x28, 0x9d, 0xeb,
+	0x74, 0xa2, 0xdb, 0xa8, 0x2a, 0x5d, 0x4f, 0xa8, 0xb0, 0x39, 0x7a, 0xaf, 0x97, 0x48, 0xce, 0x51,
+	0x1c, 0xf7, 0x03, 0xad, 0x9f, 0x59, 0xfb, 0xc1, 0x68, 0x3a, 0x9a, 0x7b, 0x87, 0x87, 0xcf, 0x72,
+	0x4d, 0xe4, 0xfd, 0x45, 0x9b, 0x12, 0x74, 0x44, 0xad, 0xda, 0xa5, 0x55, 0x54, 0xed, 0x0b, 0x84,
+	0x68, 0xb5, 0x5a, 0x64, 0xb8, 0x29, 0x3b, 0x96, 0xd6, 0xbe, 0xc1, 0xc0, 0x42, 0xcb, 0x52, 0x10,
+	0x26, 0xa9, 0xdf, 0xe9, 0x5c, 0x0e, 0xc2, 0x54, 0x98, 0xd5, 0x95, 0xca, 0xb5, 0xa4, 0x41, 0x60,
+	0xe2, 0x9d, 0x7d, 0xb7, 0xf1, 0xfd, 0x46, 0xf9, 0xee, 0xdb, 0xe4, 0xc9, 0x4b, 0x41, 0xaa, 0xf2,
+	0x32, 0xd5, 0x7c, 0x43, 0xad, 0x59, 0xed, 0x93, 0xce, 0xc0, 0x7d, 0xd2, 0xc8, 0x8b, 0x2c, 0xd9,
+	0x69, 0x9c, 0xd9, 0xbc, 0x48, 0xaf, 0x45, 0x4e, 0x5f, 0x0a, 0x52, 0xcc, 0x39, 0x3b, 0x46, 0x26,
+	0xbf, 0x3e, 0x46, 0x26, 0xcd, 0x6a, 0x12, 0xa3, 0x48, 0x15, 0x2c, 0xe0, 0x24, 0x13, 0x74, 0x03,
+	0xe5, 0x5c, 0xbf, 0x79, 0xe4, 0xd2, 0x16, 0xf9, 0x83, 0x6b, 0xa8, 0xd1, 0x9a, 0x27, 0x98, 0x1d,
+	0x70, 0x6f, 0x93, 0xea, 0x26, 0x4b, 0xf1, 0x2b, 0x17, 0x11, 0x16, 0x95, 0x37, 0xf8, 0x7a, 0xe5,
+	0xf2, 0x24, 0x41, 0xce, 0x0f, 0x55, 0x9f, 0xd8, 0xce, 0x2c, 0x37, 0x12, 0x2f, 0x78, 0x3b, 0x28,
+	0x8c, 0x41, 0xd2, 0xa3, 0x7a, 0x1f, 0xd2, 0xc3, 0xda, 0xcb, 0xc7, 0x1e, 0xd2, 0x5e, 0xce, 0xd2,
+	0x35, 0xd3, 0x6d, 0xa6, 0x98, 0x8b, 0x4c, 0xb1, 0x71, 0x36, 0x08, 0x46, 0xba, 0xa6, 0x05, 0x86,
+	0x2c, 0xbe, 0xfb, 0x51, 0x25, 0x0d, 0x6a, 0x45, 0x38, 0x2f, 0xcc, 0x19, 0x7d, 0xdc, 0x82, 0xe0,
+	0x47, 0x4b, 0x64, 0xea, 0x52, 0xd8, 0x5b, 0xbb, 0xb4, 0xd6, 0xdb, 0xe8, 0x04, 0xad, 0xab, 0x74,
+	0x1f, 0x77, 0xfb, 0x1d, 0xba, 0xbf, 0xb4, 0x28, 0x56, 0x90, 0x9a, 0x33, 0x57, 0xb1, 0x11, 0x38,
+	0x0c, 0xf7, 0xad, 0xcd, 0x20, 0xdc, 0xa2, 0x71, 0x37, 0x0e, 0x84, 0x5f, 0xc1, 0xd8, 0xb7, 0x2e,
+	0x6a, 0x10, 0x98, 0x78, 0x48, 0x3b, 0xba, 0x1d, 0xd2, 0x38, 0x7b, 0x42, 0x59, 0xc5, 0x46, 0xe0,
+	0x30, 0x44, 0x4a, 0xe3, 0x9e, 0x30, 0xc1, 0x19, 0x48, 0xeb, 0xd8, 0x08, 0x1c, 0x86, 0x2b, 0x3d,
+	0xe9, 0x6d, 0xb0, 0xa8, 0xb3, 0x4c, 0x5a, 0x5a, 0x93, 0x37, 0x83, 0x84, 0x23, 0xea, 0x0e, 0xdd,
+	0x5f, 0x44, 0x73, 0x46, 0x26, 0x77, 0xf7, 0x2a, 0x6f, 0x06, 0x09, 0x67, 0x77, 0x84, 0xd8, 0xc3,
+	0xf1, 0x15, 0x77, 0x47, 0x88, 0xdd, 0xfd, 0x01, 0x86, 0x91, 0xbf, 0x55, 0x22, 0x93, 0x66, 0xac,
+	0xa8, 0xbb, 0x95, 0x39, 0x4d, 0xac, 0xf6, 0xdd, 0x00, 0xf6, 0x5e, 0xdd, 0xab, 0xf3, 0xb2, 0x57,
+	0xe7, 0xb7, 0x82, 0x34, 0xea, 0x26, 0xcf, 0xd3, 0x70, 0x2b, 0x08, 0x29, 0x0b, 0x9b, 0xe1, 0x31,
+	0xa6, 0xb3, 0x26, 0xf1, 0x85, 0xa8, 0x4d, 0xef, 0xe7, 0x38, 0xf2, 0x30, 0x2e, 0x37, 0xbd, 0x49,
+	0x4e, 0xf5, 0x25, 0x89, 0x0f, 0xa1, 0x21, 0x1d, 0x5a, 0xc4, 0xc3, 0x03, 0x32, 0x81, 0x84, 0x65,
+	0xfd, 0xd0, 0x05, 0x72, 0x8a, 0x2f, 0x5e, 0xe4, 0xc4, 0x72, 0x7e, 0x55, 0xe2, 0x3f, 0x73, 0x9c,
+	0xdd, 0xc8, 0x02, 0xa1, 0x1f, 0x1f, 0xef, 0xa7, 0x3c, 0x61, 0xe5, 0xed, 0x17, 0xa4, 0xcb, 0xb1,
+	0xd5, 0x1d, 0xb1, 0x88, 0x69, 0x96, 0x48, 0x53, 0x66, 0x62, 0x58, 0xaf, 0x6e, 0x0d, 0x02, 0x13,
+	0xcf, 0xfb, 0xbf, 0x15, 0xf2, 0xc4, 0x80, 0xba, 0x33, 0xa3, 0x48, 0x66, 0x8f, 0x8c, 0xb1, 0xaa,
+	0x14, 0x56, 0x84, 0x1e, 0x0b, 0x3c, 0x49, 0x40, 0x40, 0xd0, 0x5e, 0x2a, 0xb2, 0x4e, 0x17, 0xa2,
+	0x30, 0x49, 0x63, 0x3f, 0x50, 0xb7, 0x99, 0x2a, 0xeb, 0xcc, 0x8d, 0x2c, 0x02, 0xf4, 0x3f, 0x83,
+	0xaf, 0xea, 0x77, 0x3a, 0xca, 0x5e, 0x5a, 0xb1, 0x5f, 0x75, 0x4e, 0x83, 0xc0, 0xc4, 0xfb, 0xaa,
+	0x93, 0x82, 0x3f, 0xac, 0x4f, 0x34, 0xe3, 0x6c, 0x17, 0xf2, 0x8f, 0xa5, 0xfc, 0xd0, 0x71, 0x8b,
+	0xb3, 0x1f, 0x2f, 0x91, 0x9a, 0x8c, 0x2f, 0x1c, 0x62, 0x31, 0x7c, 0x12, 0x6b, 0x66, 0x4a, 0x77,
+	0x39, 0x3e, 0x23, 0xb6, 0xe0, 0x6b, 0x47, 0x8f, 0x70, 0x54, 0xd6, 0x43, 0xb4, 0xfd, 0xab, 0xa3,
+	0x2d, 0x98, 0xcc, 0xc0, 0xe6, 0xed, 0xde, 0xc0, 0x74, 0xa3, 0x24, 0xa5, 0xbb, 0x86, 0x17, 0xc2,
+	0x33, 0xf6, 0xb9, 0xd9, 0x56, 0x14, 0x53, 0xdc, 0xd5, 0xd0, 0x27, 0xde, 0x54, 0x98, 0xfa, 0x8c,
+	0xa1, 0xdb, 0xc0, 0xa0, 0xe4, 0xfd, 0x52, 0x89, 0x9c, 0xcc, 0x76, 0xc9, 0xfd, 0x20, 0x46, 0xc0,
+	0xeb, 0x1b, 0xfe, 0x33, 0xd1, 0x91, 0x93, 0x60, 0xc0, 0x5e, 0xbf, 0x3b, 0x33, 0xa3, 0xa3, 0x24,
+	0xcf, 0x63, 0x2f, 0xce, 0xef, 0x19, 0x81, 0xa4, 0x38, 0x9e, 0x16, 0x31, 0x1e, 0xb3, 0x20, 0x82,
+	0x6b, 0xe6, 0xf7, 0xe7, 0xba, 0x5d, 0x11, 0x78, 0x60, 0xc4, 0x2c, 0x98, 0x50, 0xc8, 0x60, 0x63,
+	0x62, 0xab, 0xd1, 0x72, 0x8d, 0x06, 0x5b, 0xdb, 0x1b, 0x51, 0x2c, 0x4d, 0x14, 0x4f, 0xeb, 0x58,
+	0xec, 0x7e, 0x1c, 0xc8, 0x7d, 0x12, 0x75, 0xdc, 0x96, 0xdf, 0xf5, 0x5b, 0x78, 0xc9, 0x3d, 0x77,
+	0xab, 0xa8, 0xd5, 0xb0, 0x20, 0xda, 0x41, 0x61, 0x78, 0xf7, 0x2a, 0xe4, 0x24, 0x0f, 0x3e, 0xa6,
+	0x2a, 0xb6, 0xde, 0xfd, 0x20, 0xa9, 0x27, 0xa9, 0x1f, 0x73, 0xdb, 0x98, 0x33, 0xb2, 0x14, 0xd2,
+	0x75, 0x23, 0x24, 0x11, 0xd0, 0xf4, 0x30, 0x46, 0x7f, 0x33, 0x08, 0x83, 0x64, 0x9b, 0x51, 0x2f,
+	0xdd, 0x9f, 0xe5, 0xed, 0xa2, 0xa2, 0x00, 0x06, 0x35, 0xf7, 0x5b, 0x49, 0xb5, 0xbb, 0xed, 0x27,
+	0xd2, 0x2c, 0xfc, 0x9c, 0xdc, 0xf2, 0xd7, 0xb0, 0x11, 0xa3, 0xcc, 0xb3, 0xaf, 0xca, 0x00, 0xc0,
+	0x1f, 0x32, 0x05, 0x76, 0xe5, 0xf0, 0xbb, 0x5c, 0xdb, 0xf1, 0x7e, 0xf3, 0xf2, 0x5c, 0xf6, 0xf6,
+	0xcf, 0x45, 0xd6, 0x0a, 0x02, 0x8a, 0x7b, 0xee, 0x36, 0x67, 0xd9, 0x46, 0xe4, 0x31, 0x5b, 0x79,
+	0xbc, 0xac, 0x41, 0x60, 0xe2, 0x61, 0xa5, 0xcd, 0x6c, 0x68, 0xfa, 0xf8, 0x31, 0x64, 0x50, 0x0d,
+	0x19, 0x94, 0x8e, 0x93, 0xdc, 0xa8, 0x80, 0x87, 0x82, 0xad, 0x66, 0x9b, 0x25, 0xd7, 0x2c, 0x28,
+	0x64, 0xb0, 0xbd, 0xef, 0x21, 0xae, 0x78, 0x55, 0x03, 0xd1, 0xbd, 0xc2, 0x42, 0x06, 0x78, 0xf5,
+	0x43, 0xbe, 0x26, 0x67, 0x8d, 0x90, 0x01, 0xd6, 0xfe, 0xfa, 0xdd, 0x99, 0xb3, 0xfd, 0x4f, 0x4a,
+	0x28, 0xa8, 0xe7, 0xd1, 0xaa, 0xec, 0x77, 0x83, 0xac, 0x55, 0x79, 0x6e, 0x6d, 0x09, 0xb0, 0x1d,
+	0x4b, 0xf3, 0xd6, 0x05, 0x9d, 0xf5, 0x08, 0x2d, 0x8e, 0xdc, 0x6e, 0x3a, 0x1f, 0xfb, 0x61, 0x6b,
+	0x3b, 0x6b, 0x71, 0x5c, 0x37, 0x60, 0x60, 0x61, 0xba, 0x77, 0x30, 0x78, 0x6c, 0x3f, 0xea, 0xa5,
+	0xc5, 0xf8, 0xa1, 0xe4, 0xf7, 0x5f, 0xf1, 0xc3, 0x60, 0x93, 0x26, 0xe9, 0x32, 0xa3, 0x2d, 0xef,
+	0xa6, 0xc6, 0xff, 0x41, 0xf0, 0x43, 0x3b, 0x9c, 0x55, 0xfd, 0xb0, 0x5c, 0x44, 0xfc, 0x48, 0xff,
+	0xd0, 0x1e, 0x5c, 0xfb, 0xd0, 0xfb, 0x9b, 0x0e, 0x79, 0x3c, 0xbf, 0xd3, 0xee, 0xfb, 0xac, 0xd8,
+	0xf3, 0xaf, 0xcf, 0xc4, 0x9e, 0x9f, 0xcd, 0x7f, 0xca, 0x08, 0x37, 0x7f, 0x0f, 0x39, 0x21, 0x8b,
+	0x99, 0x69, 0x4f, 0x5f, 0x4d, 0xcb, 0x93, 0xab, 0x26, 0x10, 0x6c, 0x5c, 0x6f, 0x85, 0x54, 0x86,
+	0x94, 0x83, 0x43, 0x19, 0xf8, 0x5e, 0x22, 0x35, 0x24, 0x27, 0xad, 0x38, 0x45, 0x90, 0x8c, 0x48,
+	0xed, 0xca, 0xcd, 0x75, 0x1e, 0x2c, 0xe5, 0x91, 0x72, 0xe0, 0xcb, 0xe0, 0x36, 0x7d, 0x97, 0x52,
+	0x92, 0xf4, 0xd8, 0x86, 0x86, 0x40, 0xf7, 0x59, 0x52, 0xa6, 0x77, 0xba, 0xd9, 0x28, 0xb6, 0x0b,
+	0x77, 0xba, 0x41, 0x4c, 0x13, 0x44, 0xa2, 0x77, 0xba, 0xee, 0x59, 0x52, 0x0a, 0xda, 0x62, 0xaf,
+	0x23, 0x02, 0xa7, 0xb4, 0xb4, 0x08, 0xa5, 0xa0, 0xed, 0xdd, 0x21, 0x75, 0xc9, 0x90, 0xa5, 0x35,
+	0xf0, 0x73, 0x97, 0x53, 0x44, 0x5a, 0x83, 0xa4, 0x3b, 0xe0, 0xc4, 0xd5, 0x23, 0x44, 0x97, 0xba,
+	0x29, 0x4a, 0x4f, 0x3f, 0x47, 0x2a, 0xad, 0x48, 0x14, 0x29, 0x33, 0x22, 0x50, 0xd8, 0x81, 0x8b,
+	0x41, 0xbc, 0x9b, 0x64, 0xea, 0x6a, 0x18, 0xdd, 0x66, 0x77, 0x51, 0xb3, 0xfb, 0x0f, 0x90, 0xf0,
+	0x26, 0xfe, 0x93, 0x3d, 0xde, 0x33, 0x28, 0x70, 0x98, 0xaa, 0x7f, 0x5e, 0x1a, 0x54, 0xff, 0xdc,
+	0xfb, 0xe3, 0x71, 0xf2, 0xd4, 0x01, 0xb5, 0x1c, 0x33, 0xc6, 0x50, 0x67, 0x28, 0x63, 0xe8, 0x39,
+	0x52, 0xd9, 0x09, 0xc2, 0x76, 0x96, 0xeb, 0xd5, 0x20, 0x6c, 0x03, 0x83, 0xd8, 0x55, 0x50, 0xca,
+	0x43, 0x54, 0x41, 0x41, 0xb3, 0x32, 0x0f, 0x11, 0xc8, 0x4a, 0x2f, 0x19, 0x40, 0x2b, 0xe1, 0xfd,
+	0xbe, 0x8c, 0xea, 0x71, 0xfb, 0x32, 0x98, 0x0b, 0x58, 0xe4, 0x24, 0x36, 0xc6, 0xec, 0xb7, 0x51,
+	0x89, 0x8b, 0xa0, 0x71, 0x30, 0x56, 0x76, 0x8c, 0x55, 0x4c, 0x90, 0x5a, 0x3a, 0x3d, 0xb6, 0x62,
+	0x9c, 0xb3, 0xec, 0x50, 0x99, 0xd5, 0xd4, 0x79, 0x23, 0x88, 0x4e, 0x0c, 0x3a, 0x05, 0xd5, 0x8e,
+	0x7a, 0x0a, 0xaa, 0x3f, 0xa4, 0x53, 0xd0, 0xa7, 0xf4, 0x29, 0x88, 0x1c, 0xf7, 0xf8, 0x0e, 0x79,
+	0x12, 0x32, 0x3e, 0xc3, 0x48, 0xb1, 0xc8, 0x47, 0x38, 0x44, 0x7d, 0xcc, 0x21, 0x93, 0x52, 0xb0,
+	0xd0, 0x4b, 0x7b, 0x3b, 0xb8, 0x65, 0x6c, 0xc5, 0x51, 0xaf, 0x9b, 0xdd, 0x32, 0x2e, 0x61, 0x23,
+	0x70, 0x98, 0x59, 0x27, 0xaa, 0x74, 0x48, 0x9d, 0x28, 0xb9, 0xce, 0xcb, 0x83, 0xd6, 0x39, 0x76,
+	0xe1, 0xa4, 0xea, 0x82, 0xb4, 0x99, 0xbc, 0x48, 0x26, 0x37, 0x7a, 0x41, 0xa7, 0x2d, 0x7e, 0x67,
+	0x35, 0x94, 0x79, 0x03, 0x06, 0x16, 0x26, 0x6e, 0x46, 0x1b, 0x41, 0xe8, 0xc7, 0xfb, 0x6b, 0xda,
+	0x48, 0xa3, 0x36, 0xa3, 0x79, 0x05, 0x01, 0x03, 0xcb, 0xfb, 0x4c, 0x99, 0x4c, 0xd9, 0x45, 0x81,
+	0x86, 0xf0, 0x5d, 0x3c, 0x4b, 0xaa, 0xac, 0x4e, 0x50, 0x76, 0xd7, 0x66, 0xcf, 0x03, 0x87, 0x61,
+	0x52, 0x09, 0xd7, 0x9f, 0x84, 0xbe, 0xb2, 0x5a, 0x50, 0xe5, 0x22, 0xb5, 0xfb, 0x30, 0x55, 0x49,
+	0x38, 0xc5, 0x05, 0x2b, 0x0c, 0xfc, 0x1d, 0x8f, 0xba, 0x66, 0x4d, 0xf8, 0xf7, 0x17, 0x59, 0x30,
+	0x49, 0x54, 0x25, 0x11, 0xf3, 0x59, 0x7d, 0x7a, 0xf9, 0x39, 0x24, 0xeb, 0xb3, 0xdf, 0x42, 0x26,
+	0x4d, 0xcc, 0xc3, 0xe6, 0x65, 0xcd, 0x9c, 0x97, 0x9f, 0x34, 0x27, 0x85, 0x28, 0x09, 0x35, 0x84,
+	0x24, 0xbd, 0x4e, 0xaa, 0x2d, 0x15, 0xfc, 0x7e, 0x5f, 0x17, 0x17, 0xaa, 0x92, 0xa9, 0x48, 0x06,
+	0x38, 0x35, 0x8c, 0x9a, 0x9b, 0x32, 0x7a, 0x93, 0x2c, 0xb5, 0xdd, 0x98, 0x94, 0xb7, 0xf6, 0x76,
+	0xc4, 0xd9, 0xf0, 0x4a, 0x41, 0xc3, 0x7b, 0x69, 0x6f, 0x47, 0xcf, 0x71, 0xb3, 0x15, 0x90, 0xd9,
+	0x10, 0xee, 0xfe, 0x51, 0x65, 0xa6, 0xf7, 0xf9, 0x12, 0x39, 0xd5, 0x37, 0xa9, 0xdc, 0xd7, 0x48,
+	0x35, 0xc6, 0xb7, 0x6c, 0x38, 0x45, 0x9c, 0xb9, 0xec, 0x91, 0xd3, 0x67, 0x26, 0xbb, 0x1d, 0x38,
+	0x4b, 0x8c, 0xc9, 0xd6, 0x29, 0x1a, 0x4a, 0x3e, 0xf3, 0x57, 0x56, 0x31, 0xd9, 0x73, 0x7d, 0x18,
+	0x90, 0xf3, 0x14, 0xaa, 0xd4, 0xb6, 0x98, 0xcf, 0x5c, 0x36, 0x79, 0x90, 0xc4, 0xf6, 0xfe, 0x65,
+	0x89, 0x9c, 0xb0, 0x4a, 0xf4, 0xbb, 0x1d, 0x52, 0xa3, 0x1d, 0x16, 0x44, 0x25, 0xf5, 0xc8, 0xa3,
+	0xde, 0xf2, 0xab, 0x04, 0xd4, 0x05, 0x41, 0x17, 0x14, 0x87, 0x47, 0x23, 0x54, 0xfb, 0x45, 0x32,
+	0x29, 0x3b, 0xf4, 0x7e, 0x7f, 0xb7, 0x23, 0x06, 0x50, 0xcd, 0xd1, 0x0b, 0x06, 0x0c, 0x2c, 0x4c,
+	0xef, 0xb7, 0xca, 0xa4, 0xc1, 0xa3, 0xce, 0xda, 0x6a, 0xe6, 0xad, 0x48, 0x37, 0xc8, 0x8f, 0xe8,
+	0x8b, 0x34, 0xf8, 0x40, 0x6e, 0x1c, 0xed, 0xcd, 0x06, 0x31, 0x1a, 0x2a, 0x2b, 0xe9, 0x67, 0x32,
+	0x59, 0x49, 0xdc, 0x2e, 0xb8, 0x75, 0x4c, 0x3d, 0xfa, 0x8a, 0x4b, 0x53, 0x9a, 0xe6, 0x17, 0x5d,
+	0xeb, 0x65, 0xf0, 0x19, 0xfb, 0x22, 0x40, 0xa7, 0x88, 0xa8, 0x98, 0x03, 0x2f, 0xb1, 0x1f, 0xed,
+	0x3a, 0xc0, 0x87, 0xb4, 0x54, 0xbc, 0x3f, 0x2c, 0x91, 0x29, 0x76, 0x61, 0xf7, 0xa3, 0x3c, 0x52,
+	0xdf, 0x40, 0xea, 0xec, 0x36, 0xf1, 0xab, 0x74, 0x5f, 0xba, 0x5c, 0xf8, 0x5d, 0xbd, 0xb2, 0x11,
+	0x34, 0xfc, 0x91, 0xb8, 0x65, 0xd1, 0xfb, 0x27, 0x0e, 0x39, 0xc3, 0xdf, 0x32, 0x3b, 0x0f, 0x7f,
+	0x2c, 0x6f, 0x74, 0x5f, 0x2e, 0xb6, 0x83, 0x99, 0x0b, 0x60, 0x0e, 0x1b, 0x5f, 0xd4, 0x14, 0x4e,
+	0x8b, 0xde, 0xda, 0x53, 0xe1, 0x11, 0xec, 0xec, 0x48, 0x93, 0xc1, 0xfb, 0xd4, 0x38, 0x99, 0x34,
+	0xef, 0xb6, 0x18, 0xc5, 0xcb, 0xf7, 0x2e, 0x74, 0x40, 0x08, 0x07, 0x51, 0x40, 0xad, 0xbb, 0xbf,
+	0xc1, 0x68, 0x07, 0x0b, 0x0b, 0x4b, 0xc4, 0x6c, 0x06, 0x1d, 0xa3, 0x6a, 0xe1, 0x5a, 0x71, 0x37,
+	0x73, 0x5c, 0x64, 0x84, 0x75, 0x97, 0xf9, 0xef, 0x04, 0x24, 0x47, 0x3c, 0x46, 0xe0, 0xf4, 0x4b,
+	0xd2, 0xd5, 0xb0, 0xb3, 0x2f, 0x5c, 0x85, 0x6a, 0x3c, 0x97, 0x15, 0x04, 0x0c, 0x2c, 0x2c, 0x18,
+	0x51, 0xdf, 0x90, 0x85, 0x11, 0x84, 0x49, 0xa1, 0x59, 0x5c, 0x9f, 0x75, 0xcd, 0x05, 0xf6, 0x95,
+	0xd4, 0x4f, 0xd0, 0x4c, 0xf9, 0x55, 0xe5, 0x09, 0x6d, 0xe1, 0x7d, 0xb1, 0x63, 0x76, 0x68, 0xf3,
+	0x92, 0x68, 0x07, 0x85, 0x81, 0xea, 0x62, 0xb7, 0xe3, 0x07, 0xe1, 0xe5, 0xf5, 0xf5, 0x35, 0x91,
+	0x62, 0xa4, 0xd4, 0xc5, 0x35, 0x09, 0x00, 0x8d, 0xf3, 0x55, 0x67, 0x04, 0xf8, 0x68, 0xc6, 0x06,
+	0x70, 0xa3, 0xb8, 0xaf, 0x75, 0xdc, 0xee, 0xcf, 0xdf, 0x70, 0xc8, 0x99, 0xdc, 0xd9, 0xf1, 0x15,
+	0x54, 0x82, 0xe3, 0x1f, 0x3b, 0xc4, 0xed, 0x5f, 0x95, 0xee, 0x7b, 0xc9, 0xb4, 0xda, 0x08, 0xf6,
+	0xd9, 0x95, 0xf5, 0xb2, 0xde, 0x04, 0xbf, 0xe2, 0xdd, 0x02, 0x41, 0x16, 0xd7, 0x7d, 0x1b, 0xa9,
+	0xa5, 0xfe, 0xd6, 0x8a, 0x71, 0x36, 0xe7, 0x55, 0x2e, 0x44, 0x1b, 0x28, 0x28, 0x6e, 0x80, 0xa9,
+	0xbf, 0xd5, 0xa4, 0xbb, 0x7b, 0x3a, 0x4c, 0x09, 0xe7, 0xfe, 0xba, 0x6c, 0x04, 0x0d, 0xf7, 0xfe,
+	0xb0, 0x4c, 0xea, 0xda, 0x43, 0x18, 0x88, 0xda, 0x71, 0x85, 0xdc, 0x04, 0x86, 0xe9, 0xd1, 0x8a,
+	0x34, 0x8f, 0x72, 0x35, 0x4a, 0xc7, 0xfd, 0xa0, 0x83, 0x81, 0xa3, 0x41, 0x1a, 0xf8, 0xcc, 0xd1,
+	0x29, 0x3e, 0xd1, 0x5a, 0x41, 0xb5, 0xc5, 0x96, 0x38, 0xe5, 0x28, 0x36, 0x43, 0x51, 0x15, 0x33,
+	0x30, 0x39, 0xbb, 0x1f, 0x16, 0x59, 0x90, 0xe5, 0xc2, 0xea, 0x40, 0xd6, 0x32, 0x59, 0x94, 0x5d,
+	0x3c, 0x79, 0xa6, 0x71, 0x41, 0xe5, 0x53, 0x01, 0x49, 0xa9, 0x0b, 0x2c, 0xd5, 0xd9, 0x9e, 0x35,
+	0x03, 0x67, 0xe4, 0x25, 0xc4, 0xed, 0x1f, 0x8b, 0x11, 0xd7, 0x10, 0xe6, 0xdd, 0xf7, 0xd2, 0x68,
+	0x17, 0x87, 0xa9, 0x51, 0xb2, 0xf7, 0xd1, 0x39, 0x09, 0x00, 0x8d, 0xe3, 0x7d, 0xa6, 0x4a, 0x32,
+	0x95, 0xdc, 0xdc, 0x3b, 0xa4, 0xae, 0x6a, 0xb9, 0x15, 0x53, 0xe5, 0x45, 0xcf, 0x28, 0xd5, 0x19,
+	0xd5, 0x04, 0x9a, 0x99, 0xbb, 0x25, 0x7d, 0xc6, 0x7c, 0xb1, 0xbc, 0x94, 0xf5, 0x19, 0x7f, 0xfb,
+	0x70, 0xd1, 0x60, 0x38, 0x57, 0xcf, 0xf3, 0x12, 0xe2, 0xb3, 0x87, 0xba, 0x97, 0x0f, 0xbb, 0x3c,
+	0xff, 0xe3, 0xe2, 0x6e, 0x6d, 0xa0, 0x49, 0xaf, 0x93, 0x8a, 0xd9, 0xf0, 0x52, 0x81, 0xab, 0x8c,
+	0x13, 0xd6, 0x85, 0x59, 0xf9, 0x6f, 0x30, 0x98, 0xda, 0x41, 0x00, 0x63, 0xc7, 0x1a, 0x04, 0x30,
+	0x5e, 0x68, 0x10, 0xc0, 0x0b, 0x84, 0xb0, 0xb9, 0xcd, 0x33, 0x4b, 0x6b, 0x76, 0x91, 0x05, 0x50,
+	0x10, 0x30, 0xb0, 0xbc, 0x6f, 0x24, 0x76, 0x65, 0x61, 0x2c, 0x5c, 0xc2, 0x0b, 0x19, 0xf3, 0x48,
+	0x35, 0x56, 0xb8, 0xc4, 0xaa, 0x39, 0xfc, 0xab, 0x0e, 0x31, 0xcb, 0x1f, 0xbb, 0xaf, 0xf2, 0x3a,
+	0xcb, 0x4e, 0x11, 0x11, 0xcd, 0x06, 0xdd, 0xd9, 0x15, 0xbf, 0x9b, 0x89, 0xc2, 0x97, 0xc5, 0x96,
+	0x31, 0x34, 0x5e, 0x42, 0x47, 0x92, 0x9d, 0x1f, 0x25, 0x8f, 0xc9, 0x22, 0x68, 0xd2, 0x58, 0x2f,
+	0xa2, 0x61, 0x0f, 0xb7, 0x7d, 0x1f, 0xee, 0xb8, 0x92, 0x66, 0xba, 0xf2, 0xc0, 0x1b, 0x94, 0xfe,
+	0x85, 0x43, 0xce, 0x65, 0x3b, 0x90, 0xac, 0x44, 0x21, 0x0a, 0xb1, 0x26, 0x4d, 0xd3, 0x20, 0xdc,
+	0x62, 0xd7, 0x61, 0xdc, 0xf6, 0x63, 0x79, 0x33, 0x2e, 0xdb, 0x28, 0x6f, 0xfa, 0x71, 0x08, 0xac,
+	0x15, 0xab, 0xb8, 0xf0, 0xf4, 0x43, 0x61, 0xae, 0x38, 0xe2, 0xda, 0xc8, 0x19, 0x0e, 0xad, 0xb4,
+	0xf0, 0xd4, 0x47, 0x10, 0x0c, 0xbd, 0x2f, 0xa2, 0xd4, 0xde, 0xa3, 0x71, 0x1c, 0xb4, 0x8d, 0x84,
+	0x49, 0x54, 0xf2, 0x6f, 0x35, 0x57, 0xaf, 0xad, 0x45, 0x41, 0xc8, 0x74, 0x76, 0xa3, 0x44, 0xdf,
+	0x15, 0xa3, 0x1d, 0x2c, 0x2c, 0x0c, 0x8e, 0xbc, 0xf5, 0x2a, 0x5a, 0xd5, 0x2f, 0xdc, 0x91, 0xa5,
+	0x14, 0xe4, 0xf9, 0x80, 0x05, 0x47, 0x5e, 0x79, 0x29, 0x03, 0x84, 0x7e, 0x7c, 0x77, 0x95, 0x9c,
+	0xd9, 0xe5, 0xf6, 0x16, 0x7e, 0xef, 0x3b, 0x37, 0xbe, 0xa8, 0x6a, 0x52, 0x4f, 0x62, 0x71, 0xf9,
+	0x95, 0x3c, 0x04, 0xc8, 0x7f, 0xce, 0x7b, 0x37, 0x71, 0x79, 0x9e, 0xe4, 0x42, 0x5e, 0xba, 0xd5,
+	0x40, 0xfb, 0xb3, 0xf7, 0xd3, 0x55, 0x32, 0x9d, 0xb9, 0xcf, 0x10, 0x6d, 0x5d, 0xfd, 0xf9, 0x5d,
+	0x47, 0x96, 0xdf, 0xfd, 0xdd, 0x1b, 0x2a, 0x63, 0x2c, 0x24, 0xd5, 0x20, 0xec, 0xaa, 0xf0, 0x8d,
+	0xa5, 0x22, 0x3a, 0xb1, 0x84, 0x04, 0x0d, 0x57, 0x38, 0xfe, 0x04, 0xce, 0xa6, 0xc8, 0xfc, 0x33,
+	0xeb, 0xc0, 0x50, 0x79, 0x48, 0x07, 0x86, 0x8f, 0x6b, 0xaf, 0x61, 0xb5, 0x08, 0xcf, 0x4a, 0x66,
+	0xb2, 0x1c, 0xf7, 0xa1, 0xe1, 0x97, 0x4b, 0x64, 0xc2, 0xf8, 0x68, 0x78, 0xfb, 0xa3, 0x79, 0x39,
+	0x80, 0x53, 0xdc, 0x2b, 0x31, 0xfa, 0xb3, 0xba, 0xfc, 0x3f, 0x7f, 0xa5, 0xe7, 0xfa, 0xef, 0x05,
+	0x78, 0xfd, 0xee, 0xcc, 0xc9, 0x4c, 0xe5, 0x7f, 0xeb, 0xae, 0x80, 0xb3, 0xdf, 0x4d, 0xa6, 0x33,
+	0x64, 0x72, 0x5e, 0x79, 0xdd, 0x7c, 0xe5, 0x23, 0xdb, 0xe5, 0xcd, 0x21, 0xfb, 0x45, 0x1c, 0x32,
+	0x51, 0x43, 0x2b, 0xea, 0xd0, 0x21, 0x9c, 0x50, 0x99, 0x52, 0x79, 0xa5, 0x21, 0x4b, 0xe5, 0xbd,
+	0x8d, 0xd4, 0xba, 0x51, 0x27, 0x68, 0x05, 0xea, 0x6e, 0x21, 0x76, 0x6c, 0x59, 0x13, 0x6d, 0xa0,
+	0xa0, 0xee, 0x6d, 0x52, 0xbf, 0x75, 0x3b, 0xe5, 0x91, 0x2d, 0x8d, 0x4a, 0xa1, 0x01, 0x2d, 0x4a,
+	0x69, 0x91, 0x2d, 0x09, 0x68, 0x5e, 0x18, 0xad, 0xcd, 0x84, 0xa0, 0xac, 0x35, 0xc1, 0x9c, 0x8f,
+	0x4c, 0x3a, 0x26, 0x20, 0x20, 0xde, 0x5f, 0x11, 0x72, 0x3a, 0xef, 0x52, 0x59, 0xf7, 0x23, 0x64,
+	0x8c, 0xf7, 0xb1, 0x98, 0x7b, 0xcb, 0xf3, 0x78, 0x5c, 0x62, 0x04, 0x45, 0xb7, 0xd8, 0xff, 0x20,
+	0x78, 0x0a, 0xee, 0x1d, 0x7f, 0xa3, 0x51, 0x3a, 0x46, 0xee, 0xcb, 0xbe, 0xe6, 0xbe, 0xec, 0x73,
+	0xee, 0x1d, 0x7f, 0xc3, 0xbd, 0x43, 0xaa, 0x5b, 0x41, 0x4a, 0x7d, 0x61, 0x45, 0xbd, 0x79, 0x2c,
+	0xcc, 0xa9, 0xcf, 0xb5, 0x34, 0xf6, 0x2f, 0x70, 0x86, 0x58, 0x34, 0x61, 0x7a, 0xc3, 0xae, 0xd1,
+	0x29, 0x36, 0x4f, 0xbf, 0xf8, 0x4e, 0x64, 0x8a, 0x81, 0xf2, 0xf3, 0x7a, 0xa6, 0x11, 0xb2, 0xdd,
+	0xc1, 0xc8, 0x3e, 0x65, 0xe8, 0xe3, 0x9b, 0xea, 0x31, 0x7c, 0x9c, 0x43, 0x0d, 0x7e, 0x03, 0x24,
+	0xd5, 0xd8, 0x51, 0x25, 0xd5, 0xf8, 0x43, 0x92, 0x54, 0x3f, 0x84, 0xc6, 0x48, 0x39, 0xd2, 0xa2,
+	0xd6, 0xe1, 0x07, 0x8f, 0xf1, 0x93, 0x0b, 0xa3, 0xa4, 0xfc, 0x09, 0x9a, 0x39, 0x56, 0x10, 0x9a,
+	0xf0, 0x5f, 0xeb, 0xc5, 0xb4, 0x4d, 0xf7, 0xa2, 0x6e, 0x22, 0xac, 0x7d, 0x2f, 0x17, 0xdf, 0x99,
+	0x39, 0x64, 0xb2, 0x48, 0xf7, 0x56, 0xbb, 0x89, 0xa8, 0x83, 0xa3, 0x1b, 0xc0, 0xec, 0x02, 0x56,
+	0xa7, 0xb7, 0x2d, 0x7f, 0x1f, 0x2a, 0xbe, 0x37, 0xc7, 0x2d, 0xcc, 0xef, 0x96, 0xc8, 0xcc, 0x21,
+	0xa3, 0x80, 0xfe, 0xdb, 0x28, 0xde, 0xf2, 0x43, 0x19, 0x53, 0x9a, 0x89, 0xa3, 0x59, 0x35, 0x60,
+	0x60, 0x61, 0x9a, 0x15, 0x25, 0x4b, 0x87, 0x54, 0x94, 0x3c, 0x47, 0x2a, 0x31, 0xed, 0x46, 0xd9,
+	0x03, 0x0f, 0xab, 0xa3, 0xc1, 0x20, 0x32, 0x3a, 0xb9, 0x92, 0x1f, 0x9d, 0x6c, 0x15, 0xb8, 0xad,
+	0x3e, 0x90, 0x02, 0xb7, 0x28, 0xca, 0x84, 0x03, 0x7a, 0x4c, 0x8b, 0x32, 0xdb, 0x31, 0xec, 0x7d,
+	0xbe, 0x4c, 0xde, 0x7c, 0xe0, 0x9c, 0xd7, 0x39, 0x8e, 0xce, 0x01, 0x39, 0x8e, 0x72, 0x78, 0x4a,
+	0x87, 0x0d, 0x4f, 0x79, 0xc0, 0xf0, 0x7c, 0x9f, 0xe5, 0x57, 0xa8, 0x14, 0x71, 0x51, 0xee, 0xa0,
+	0xfa, 0xcd, 0x07, 0xb8, 0x16, 0x7e, 0xc4, 0xb1, 0x2b, 0x23, 0x56, 0x8b, 0x10, 0x65, 0x03, 0x8b,
+	0x1e, 0xf3, 0xf5, 0x3b, 0xa8, 0xdc, 0xa2, 0xf7, 0x6b, 0x15, 0xf2, 0xec, 0x10, 0x12, 0xc8, 0x9c,
+	0xc5, 0xce, 0x90, 0xb3, 0xf8, 0x2b, 0xfc, 0x33, 0x7d, 0x22, 0xf7, 0x33, 0x41, 0xf1, 0x9f, 0xe9,
+	0xe0, 0x2f, 0x34, 0xa2, 0x27, 0x2a, 0x24, 0xd5, 0x96, 0x8f, 0xcb, 0x7f, 0xbc, 0xa0, 0xca, 0x72,
+	0x66, 0xbd, 0x1e, 0xae, 0x16, 0x2d, 0xcc, 0xe1, 0x0e, 0xc0, 0xd9, 0x78, 0x9f, 0x73, 0xc8, 0xd9,
+	0xc1, 0x6a, 0x02, 0x56, 0x56, 0xdb, 0x60, 0x09, 0x0f, 0xa6, 0xf7, 0x81, 0xbf, 0xaf, 0x6e, 0x06,
+	0x13, 0x07, 0x0d, 0x19, 0x66, 0xa6, 0x84, 0xe9, 0x7e, 0x60, 0x86, 0x8c, 0xf5, 0x2c, 0x10, 0xfa,
+	0xf1, 0xbd, 0x2f, 0x95, 0xf3, 0xbb, 0xc5, 0xd5, 0xc9, 0x51, 0x66, 0xf3, 0xc1, 0xf9, 0x20, 0xd6,
+	0x8e, 0x5b, 0x7e, 0xd0, 0x3b, 0x6e, 0x65, 0xd0, 0x8e, 0x8b, 0xc5, 0x90, 0x8d, 0x6c, 0x0b, 0x5e,
+	0x6b, 0x90, 0xe7, 0x17, 0xa9, 0x62, 0xc8, 0x6b, 0x19, 0x38, 0xf4, 0x3d, 0xf1, 0x88, 0x4f, 0xbd,
+	0x2f, 0x94, 0xc8, 0x93, 0x03, 0x35, 0xf8, 0x07, 0x24, 0x51, 0xcc, 0xcf, 0x5f, 0x79, 0x30, 0x9f,
+	0xdf, 0xfc, 0x28, 0xd5, 0x43, 0x3f, 0xca, 0x30, 0xe2, 0xf9, 0x8f, 0x4a, 0x03, 0x17, 0x0b, 0x9e,
+	0xf8, 0xbe, 0x6a, 0x47, 0xf2, 0x3d, 0xe4, 0x84, 0xdf, 0xed, 0x72, 0x3c, 0x96, 0xcf, 0x99, 0x29,
+	0xd0, 0x3e, 0x67, 0x02, 0xc1, 0xc6, 0x1d, 0x6a, 0x60, 0xff, 0xcc, 0x21, 0x75, 0xa0, 0x9b, 0x7c,
+	0xc7, 0xc2, 0x5b, 0xb2, 0xd8, 0x10, 0x39, 0x45, 0xdc, 0x92, 0xa5, 0x9d, 0xb7, 0xb9, 0x83, 0x7d,
+	0xd4, 0xf2, 0x5f, 0xcf, 0x92, 0x2a, 0x4b, 0x1a, 0xcf, 0xd6, 0x9c, 0x60, 0x19, 0xe5, 0xc0, 0x61,
+	0xde, 0x7f, 0xaf, 0xe1, 0xeb, 0x75, 0x23, 0xbc, 0x85, 0x3d, 0xc1, 0xef, 0xdb, 0x8b, 0x3b, 0x0d,
+	0xc7, 0xfe, 0xbe, 0x18, 0xbe, 0x82, 0xed, 0x96, 0x23, 0xb0, 0x34, 0x52, 0xa9, 0xe9, 0xf2, 0xa1,
+	0xa5, 0xa6, 0xb1, 0x8c, 0x69, 0xb2, 0xbd, 0x16, 0x07, 0x7b, 0x7e, 0x8a, 0x16, 0xf7, 0x46, 0xc5,
+	0xfe, 0x90, 0xcd, 0xe6, 0x65, 0x0d, 0x04, 0x1b, 0x17, 0xb3, 0xe2, 0x75, 0xc1, 0x67, 0x1a, 0xa7,
+	0xac, 0xe6, 0x45, 0xd5, 0xce, 0x8a, 0xd7, 0x25, 0xa2, 0x05, 0x02, 0xf4, 0x3f, 0x83, 0x7b, 0xae,
+	0xd5, 0x88, 0x1d, 0x19, 0xb3, 0xf7, 0x5c, 0x8b, 0x0e, 0xf6, 0xa5, 0xef, 0x09, 0xbc, 0x9a, 0x88,
+	0x4f, 0x8c, 0xb9, 0x6e, 0xd7, 0x78, 0xa3, 0x71, 0xfb, 0x6a, 0xa2, 0x4b, 0xfd, 0x28, 0x90, 0xf7,
+	0x1c, 0xda, 0xd0, 0x54, 0xf3, 0xd2, 0xa2, 0xf0, 0x61, 0x29, 0x1b, 0x9a, 0x22, 0xb3, 0xd4, 0x06,
+	0x13, 0x0f, 0x2f, 0xe0, 0xd5, 0x3f, 0x79, 0x0d, 0x25, 0xee, 0xd8, 0x5d, 0x14, 0xf5, 0xf7, 0xd5,
+	0x05, 0xbc, 0x97, 0x72, 0xd1, 0xda, 0x30, 0xe8, 0x79, 0x77, 0x83, 0x9c, 0x55, 0xa0, 0x0b, 0x61,
+	0xca, 0xaa, 0x9c, 0x24, 0x74, 0xde, 0x4f, 0x28, 0x56, 0x7c, 0x26, 0xec, 0x3d, 0x3d, 0x41, 0xfd,
+	0xec, 0xa5, 0x20, 0xbd, 0x9c, 0x87, 0x09, 0xcb, 0x70, 0x00, 0x15, 0xf4, 0x23, 0xd3, 0xd0, 0xdf,
+	0xe8, 0xd0, 0xd5, 0x85, 0xa5, 0xc6, 0x84, 0xed, 0x47, 0xbe, 0x20, 0x01, 0xa0, 0x71, 0x54, 0xee,
+	0xd6, 0xe4, 0xa0, 0xdc, 0x2d, 0x4c, 0xaf, 0xde, 0x6a, 0x75, 0x51, 0x6b, 0x0c, 0x5a, 0x74, 0xae,
+	0xc5, 0xe2, 0xd9, 0xf1, 0xc3, 0xf0, 0x3b, 0xa3, 0x54, 0x7a, 0xf5, 0xa5, 0x85, 0xb5, 0x3e, 0x1c,
+	0xc8, 0x7d, 0x92, 0xe5, 0x3d, 0x60, 0x19, 0xeb, 0xc6, 0x63, 0x99, 0xbc, 0x07, 0x6c, 0x04, 0x0e,
+	0xc3, 0x28, 0x6e, 0x56, 0x2d, 0xe2, 0x72, 0x9a, 0x76, 0x95, 0x9a, 0xda, 0x38, 0x6d, 0x57, 0xd6,
+	0xbe, 0xd8, 0x87, 0x01, 0x39, 0x4f, 0xa1, 0xd6, 0x13, 0x46, 0x8c, 0x7a, 0xe3, 0x09, 0x5b, 0xeb,
+	0xb9, 0xc6, 0x9b, 0x41, 0xc2, 0xdd, 0xef, 0x24, 0x8d, 0x5e, 0x42, 0xd9, 0x01, 0xf8, 0x66, 0x14,
+	0xef, 0x74, 0x22, 0xbf, 0xbd, 0xd4, 0xa6, 0x61, 0x8a, 0xa9, 0xe0, 0x0d, 0xc6, 0xfc, 0x9c, 0x78,
+	0xb6, 0x71, 0x7d, 0x00, 0x1e, 0x0c, 0xa4, 0x90, 0x2d, 0x0d, 0xff, 0xe4, 0x70, 0xa5, 0xe1, 0xbd,
+	0x3f, 0x75, 0xc8, 0x09, 0xb5, 0xdf, 0x3c, 0x80, 0x1a, 0x33, 0x1d, 0xbb, 0xc6, 0xcc, 0xa5, 0xa3,
+	0xef, 0xd8, 0xac, 0xe7, 0x03, 0x92, 0x1d, 0x7f, 0x67, 0x92, 0x10, 0xbd, 0xab, 0x2b, 0x81, 0xea,
+	0x0c, 0x14, 0xa8, 0x8f, 0xec, 0x8e, 0x9a, 0x57, 0xe8, 0xba, 0xfa, 0x70, 0x0b, 0x5d, 0x37, 0xc9,
+	0x19, 0xa9, 0x12, 0x71, 0x4f, 0x2b, 0xd6, 0x76, 0x90, 0x1b, 0xb4, 0x71, 0x73, 0xf6, 0x52, 0x1e,
+	0x12, 0xe4, 0x3f, 0x6b, 0x69, 0x62, 0xe3, 0xc3, 0xc4, 0x08, 0xf2, 0xfd, 0x66, 0x79, 0x53, 0xde,
+	0x6b, 0x9f, 0xd9, 0x93, 0x96, 0x2f, 0x36, 0x41, 0xe3, 0xe4, 0x0b, 0xa6, 0x7a, 0x41, 0x82, 0x89,
+	0x8c, 0x2c, 0x98, 0xe4, 0x16, 0x39, 0x31, 0x70, 0x8b, 0x94, 0x1e, 0x9d, 0xc9, 0x81, 0x1e, 0x9d,
+	0xf7, 0x91, 0xa9, 0x20, 0xdc, 0xa6, 0x71, 0x90, 0xd2, 0x36, 0x5b, 0x0b, 0x6c, 0xfb, 0xac, 0x69,
+	0xb5, 0x64, 0xc9, 0x82, 0x42, 0x06, 0xdb, 0xde, 0xd7, 0xa7, 0x86, 0xd8, 0xd7, 0x07, 0x48, 0xd3,
+	0xe9, 0x62, 0xa4, 0xe9, 0xc9, 0xa3, 0x4b, 0xd3, 0x53, 0xc7, 0x2a, 0x4d, 0xdd, 0x42, 0xa4, 0xe9,
+	0x50, 0x82, 0xca, 0x38, 0x52, 0x9f, 0x3e, 0xe4, 0x48, 0x3d, 0x48, 0x94, 0x9e, 0xb9, 0x6f, 0x51,
+	0x9a, 0x2f, 0x25, 0x1f, 0xff, 0xff, 0x52, 0x4a, 0xfe, 0x50, 0x89, 0x9c, 0xd1, 0x72, 0x04, 0x57,
+	0x6f, 0xb0, 0x89, 0x3b, 0x29, 0xbb, 0x33, 0x86, 0x7b, 0x6d, 0x8d, 0xe2, 0x35, 0xba, 0x0e, 0x8e,
+	0x82, 0x80, 0x81, 0xc5, 0x6a, 0xc0, 0xd0, 0x98, 0x95, 0x57, 0xc8, 0x0a, 0x99, 0x05, 0xd1, 0x0e,
+	0x0a, 0x03, 0xbb, 0x8c, 0xff, 0x8b, 0x6a, 0x72, 0xd9, 0x3b, 0x5f, 0x16, 0x34, 0x08, 0x4c, 0x3c,
+	0xf4, 0xd8, 0xb6, 0xe4, 0x06, 0x87, 0x82, 0x66, 0x92, 0x1f, 0xd9, 0xd4, 0x9e, 0xa6, 0xa0, 0xb2,
+	0x3b, 0x4b, 0xf2, 0x0a, 0x89, 0x4c, 0x77, 0xb0, 0x1d, 0x14, 0x86, 0xf7, 0x3f, 0x1d, 0xf2, 0x64,
+	0xee, 0x50, 0x3c, 0x00, 0xe5, 0xe1, 0x8e, 0xad, 0x3c, 0x34, 0x8b, 0x3a, 0xee, 0x19, 0x6f, 0x31,
+	0x40, 0x91, 0xf8, 0x8f, 0x0e, 0x99, 0xd2, 0xf8, 0x0f, 0xe0, 0x55, 0x03, 0xfb, 0x55, 0x8b, 0x3b,
+	0xd9, 0xd6, 0xfb, 0xde, 0xed, 0xb7, 0x4a, 0x44, 0xdd, 0xdd, 0x34, 0xd7, 0x92, 0x37, 0xe3, 0x1d,
+	0x12, 0x47, 0xb0, 0xaf, 0x0a, 0x00, 0x14, 0x12, 0xe2, 0x65, 0xf3, 0x67, 0x21, 0x15, 0x03, 0x93,
+	0xfd, 0xf1, 0xae, 0x49, 0x7e, 0xc5, 0x4d, 0x5b, 0x14, 0x9c, 0xd0, 0x77, 0x4d, 0x8a, 0x76, 0x50,
+	0x18, 0x28, 0xde, 0x82, 0x56, 0x14, 0x2e, 0x74, 0xfc, 0x24, 0x11, 0x1a, 0x97, 0x12, 0x6f, 0x4b,
+	0x12, 0x00, 0x1a, 0x87, 0x45, 0x48, 0x04, 0x49, 0xb7, 0xe3, 0xef, 0x1b, 0xf6, 0x0b, 0xa3, 0x6a,
+	0xaa, 0x02, 0x81, 0x89, 0xe7, 0xed, 0x92, 0x86, 0xfd, 0x12, 0x8b, 0x74, 0x93, 0x85, 0x27, 0x0f,
+	0x35, 0x9c, 0x18, 0xa4, 0xcb, 0x9e, 0x5a, 0xee, 0xf9, 0x8d, 0x92, 0xdd, 0xcb, 0x39, 0x09, 0x00,
+	0x8d, 0xe3, 0xfd, 0x23, 0x87, 0x3c, 0x96, 0x33, 0x68, 0x05, 0x16, 0xf4, 0x48, 0xf5, 0x6e, 0x93,
+	0xa7, 0x98, 0x7c, 0x1d, 0x19, 0x6f, 0xd3, 0x4d, 0x5f, 0x06, 0xc0, 0x1a, 0x5b, 0xfa, 0x22, 0x6f,
+	0x06, 0x09, 0xc7, 0x64, 0xd5, 0x69, 0xbb, 0xaf, 0x09, 0xcb, 0xa4, 0xe5, 0xc3, 0x14, 0x24, 0xad,
+	0x68, 0x8f, 0xc6, 0xfb, 0xf8, 0xe6, 0x4e, 0x26, 0x93, 0xb6, 0x0f, 0x03, 0x72, 0x9e, 0x62, 0x37,
+	0xb7, 0xb5, 0xd5, 0x68, 0xcb, 0x19, 0x79, 0xa3, 0xc8, 0x19, 0xa9, 0x3f, 0xa6, 0x31, 0x15, 0x34,
+	0x4b, 0x30, 0xf9, 0xa3, 0x82, 0xc4, 0x52, 0x93, 0xb0, 0x10, 0x40, 0x1a, 0x84, 0xe2, 0x95, 0xc5,
+	0x5c, 0x55, 0x0a, 0xd2, 0x4a, 0x3f, 0x0a, 0xe4, 0x3d, 0xe7, 0x7d, 0xb1, 0x42, 0x54, 0x19, 0x34,
+	0x16, 0xcc, 0x58, 0x50, 0x28, 0xe8, 0xc8, 0x35, 0x4c, 0xe4, 0xdc, 0xaa, 0x1c, 0x14, 0x5d, 0xc4,
+	0x8d, 0x5e, 0xa6, 0x75, 0x5c, 0x0d, 0xd8, 0xba, 0x06, 0x81, 0x89, 0x87, 0x3d, 0xe9, 0x04, 0x7b,
+	0x94, 0x3f, 0x94, 0xa9, 0x3f, 0xb2, 0x2c, 0x01, 0xa0, 0x71, 0xb0, 0x27, 0xed, 0x60, 0x73, 0xb3,
+	0x31, 0x6e, 0xf7, 0x04, 0x47, 0x07, 0x18, 0x84, 0xdf, 0xed, 0x19, 0xed, 0x88, 0x43, 0x81, 0x71,
+	0xb7, 0x67, 0xb4, 0x03, 0x0c, 0x82, 0x5f, 0x29, 0x8c, 0xe2, 0x5d, 0xbf, 0x13, 0xbc, 0x46, 0xdb,
+	0x8a, 0x8b, 0x38, 0x0c, 0xa8, 0xaf, 0x74, 0xad, 0x1f, 0x05, 0xf2, 0x9e, 0xc3, 0x09, 0xdd, 0x8d,
+	0x69, 0x3b, 0x68, 0xa5, 0x26, 0x35, 0x62, 0x4f, 0xe8, 0xb5, 0x3e, 0x0c, 0xc8, 0x79, 0x0a, 0x4b,
+	0x01, 0xcb, 0x32, 0x76, 0xb2, 0x70, 0xcd, 0x84, 0x5d, 0x0a, 0x18, 0x6c, 0x30, 0x64, 0xf1, 0x71,
+	0x93, 0xdc, 0x15, 0xf7, 0x1f, 0x34, 0x26, 0xed, 0x4d, 0x52, 0xde, 0x8b, 0x00, 0x0a, 0xc3, 0xfb,
+	0x78, 0x19, 0x85, 0xfa, 0x80, 0x6b, 0x46, 0x1e, 0x58, 0xe8, 0xb1, 0x3d, 0x23, 0x2b, 0x43, 0xcc,
+	0x48, 0x0c, 0xeb, 0x4d, 0xa2, 0x50, 0x85, 0xf5, 0x56, 0x07, 0x86, 0xf5, 0x1a, 0x58, 0xf9, 0x61,
+	0xbd, 0x63, 0x45, 0x85, 0xf5, 0x8e, 0xdf, 0x67, 0x58, 0xef, 0xbf, 0xa9, 0x12, 0x75, 0x79, 0xfb,
+	0x35, 0x9a, 0xde, 0x8e, 0xe2, 0x9d, 0x20, 0xdc, 0x62, 0x85, 0xb3, 0x7e, 0xd6, 0x91, 0x35, 0xd1,
+	0x96, 0xcd, 0xbc, 0xf4, 0xcd, 0x82, 0x2e, 0xe0, 0xb6, 0x98, 0xcd, 0xae, 0x1b, 0x8c, 0x78, 0x78,
+	0x48, 0xa6, 0xf6, 0x1a, 0x07, 0x81, 0xd5, 0x23, 0xf7, 0xbb, 0x09, 0x91, 0xe6, 0xee, 0x4d, 0xb9,
+	0x03, 0x2f, 0x15, 0xd3, 0x3f, 0x74, 0x37, 0x28, 0x95, 0x7a, 0x5d, 0x31, 0x01, 0x83, 0x21, 0x06,
+	0x14, 0x49, 0xd7, 0x01, 0xcf, 0xff, 0xf9, 0xf0, 0xb1, 0x8c, 0xcd, 0x30, 0x19, 0xfb, 0x40, 0xc6,
+	0x83, 0x70, 0x0b, 0xe7, 0x89, 0x08, 0x7f, 0x7c, 0x6b, 0x5e, 0xe9, 0xcc, 0xe5, 0xc8, 0x6f, 0xcf,
+	0xfb, 0x1d, 0x3f, 0x6c, 0xe1, 0x4d, 0x66, 0x0c, 0x5d, 0x4b, 0x50, 0xd1, 0x00, 0x92, 0x50, 0xdf,
+	0x0d, 0xf3, 0xd5, 0x61, 0x6e, 0x98, 0x3f, 0xfb, 0x6d, 0xe4, 0x54, 0xdf, 0xc7, 0x1c, 0xb5, 0x76,
+	0xcf, 0x7d, 0x3e, 0xea, 0xfd, 0xda, 0x98, 0x16, 0x5a, 0x58, 0x26, 0x94, 0x5d, 0x58, 0x1e, 0xeb,
+	0x2f, 0x2a, 0x54, 0xe6, 0x02, 0xa7, 0x88, 0x12, 0x33, 0x46, 0x23, 0x98, 0x2c, 0x71, 0x8e, 0x76,
+	0xfd, 0x98, 0x86, 0xc7, 0x3d, 0x47, 0xd7, 0x14, 0x13, 0x30, 0x18, 0xba, 0xdb, 0x56, 0x82, 0xda,
+	0xc5, 0xa3, 0x27, 0xa8, 0xb1, 0x52, 0xfa, 0x79, 0xd7, 0xfd, 0x7d, 0xd6, 0x21, 0x53, 0xa1, 0x35,
+	0x73, 0x8b, 0x89, 0x49, 0xcf, 0x5f, 0x15, 0xf3, 0x2e, 0x5a, 0x99, 0xec, 0x36, 0xc8, 0xf0, 0xcf,
+	0x13, 0x69, 0xd5, 0x11, 0x45, 0x9a, 0x47, 0xc6, 0x82, 0x5d, 0x7f, 0x8b, 0x5a, 0xde, 0xc1, 0x25,
+	0xd6, 0x02, 0x02, 0xe2, 0x86, 0x64, 0x8c, 0x17, 0xfe, 0x6e, 0x8c, 0x17, 0x51, 0xfc, 0xc6, 0xac,
+	0x1e, 0xce, 0xf9, 0xf1, 0x16, 0x10, 0x5c, 0xdc, 0x9b, 0xa4, 0xde, 0x8a, 0xa9, 0xcf, 0xd3, 0xb0,
+	0x6a, 0x23, 0x27, 0x4a, 0xb1, 0x48, 0x99, 0x05, 0x49, 0x00, 0x34, 0x2d, 0xef, 0x7f, 0x55, 0xc8,
+	0x49, 0x39, 0x22, 0x32, 0x9f, 0x05, 0xe5, 0x23, 0xe7, 0xab, 0x75, 0x65, 0x25, 0x1f, 0x2f, 0x4b,
+	0x00, 0x68, 0x1c, 0xd4, 0xc7, 0x7a, 0x09, 0xd6, 0x53, 0x0d, 0x97, 0x83, 0x8d, 0x44, 0xb8, 0xb6,
+	0xd5, 0x42, 0xb9, 0xae, 0x41, 0x60, 0xe2, 0xa1, 0x6e, 0xef, 0x1b, 0x4a, 0xab, 0xa1, 0xdb, 0x4b,
+	0x45, 0x55, 0xc2, 0xdd, 0x9f, 0xca, 0xbd, 0xf7, 0xac, 0x98, 0x2c, 0xd0, 0xbe, 0x34, 0x9e, 0xd1,
+	0x2e, 0x3c, 0x73, 0xff, 0x9e, 0x43, 0xce, 0xf0, 0x56, 0x39, 0x92, 0xd7, 0xbb, 0x6d, 0x3f, 0xa5,
+	0x49, 0x63, 0xec, 0x98, 0xfa, 0xa7, 0x6d, 0xde, 0x79, 0x6c, 0x21, 0xbf, 0x37, 0x58, 0x89, 0x63,
+	0x7a, 0xc7, 0x2a, 0x8e, 0x28, 0x45, 0xc7, 0x51, 0x8b, 0x1b, 0x59, 0x44, 0xf5, 0x52, 0xb3, 0xdb,
+	0x13, 0xc8, 0x72, 0xf7, 0x7e, 0xc0, 0x30, 0x09, 0xb0, 0x18, 0xff, 0x61, 0x6e, 0xe7, 0x59, 0x27,
+	0x55, 0xd4, 0xf3, 0xe4, 0xce, 0x7a, 0x7e, 0xb8, 0x75, 0xc0, 0x94, 0x48, 0xd4, 0x12, 0x8d, 0x0b,
+	0x1e, 0x90, 0x0a, 0x70, 0x62, 0x76, 0x6d, 0xc2, 0xf2, 0x10, 0xb5, 0x09, 0x47, 0xa8, 0x13, 0x7c,
+	0x8e, 0x54, 0x76, 0xb1, 0x6c, 0x65, 0xd5, 0x7e, 0xa7, 0x15, 0x56, 0xb6, 0x12, 0x21, 0xde, 0x97,
+	0x1d, 0x62, 0xca, 0x93, 0x07, 0x5f, 0x81, 0x6e, 0x74, 0x9d, 0x58, 0x7e, 0xa8, 0xea, 0xc0, 0x0f,
+	0x85, 0x51, 0x05, 0x41, 0xbb, 0x31, 0x96, 0x89, 0x2a, 0x58, 0x5a, 0x04, 0x6c, 0xf7, 0xfe, 0xbc,
+	0xaa, 0x3f, 0xbe, 0xc8, 0x36, 0xfd, 0xaa, 0x78, 0xed, 0x4d, 0x75, 0xb5, 0x04, 0x7f, 0xf3, 0x6b,
+	0x7d, 0x57, 0x4b, 0x7c, 0xeb, 0xe8, 0xc9, 0xc4, 0x7c, 0x80, 0x06, 0xdd, 0x2c, 0x31, 0x7e, 0xc8,
+	0x04, 0xbc, 0x45, 0x6a, 0x78, 0x16, 0x65, 0x86, 0xdd, 0x9a, 0xd5, 0xa9, 0xda, 0x65, 0xd1, 0xfe,
+	0xfa, 0xdd, 0x99, 0x6f, 0x19, 0xbd, 0x5b, 0xf2, 0x69, 0x50, 0xf4, 0xdd, 0x84, 0xd4, 0xf1, 0x7f,
+	0x96, 0xf4, 0x2c, 0x4e, 0xb9, 0xd7, 0x95, 0xf0, 0x90, 0x80, 0x42, 0x32, 0xaa, 0x35, 0x1f, 0x37,
+	0x24, 0x75, 0x44, 0xe4, 0x4c, 0xf9, 0x61, 0x78, 0x4d, 0x32, 0x6d, 0x4a, 0xc0, 0xeb, 0x77, 0x67,
+	0xde, 0x33, 0x3a, 0x53, 0xf5, 0x38, 0x68, 0x16, 0x86, 0x8e, 0x30, 0x31, 0x48, 0x47, 0xf0, 0xfe,
+	0x77, 0x45, 0xcf, 0x6f, 0xfe, 0xe9, 0xbf, 0x3a, 0xe6, 0xf7, 0x8b, 0x99, 0xf9, 0x7d, 0xae, 0x6f,
+	0x7e, 0x4f, 0xe1, 0x98, 0xe5, 0xdc, 0x85, 0xf2, 0xa0, 0xb5, 0xa6, 0xc3, 0x8d, 0x33, 0x4c, 0x5d,
+	0x64, 0x97, 0x4f, 0x27, 0x6b, 0x71, 0x2f, 0xc4, 0xcb, 0x3f, 0xea, 0xf6, 0x2d, 0xd3, 0x60, 0x83,
+	0x21, 0x8b, 0x8f, 0x16, 0x10, 0x9c, 0x17, 0x37, 0xfd, 0x3d, 0x3e, 0xf3, 0x8c, 0x62, 0xce, 0x4d,
+	0xd1, 0x0e, 0x0a, 0xc3, 0xdd, 0x26, 0x4f, 0x4b, 0x02, 0x8b, 0xb4, 0x43, 0x53, 0x7e, 0x39, 0xc7,
+	0x66, 0x10, 0xef, 0xfa, 0xa9, 0xb4, 0xbf, 0xd4, 0xe6, 0xdf, 0x22, 0x28, 0x3c, 0x0d, 0x07, 0xe0,
+	0xc2, 0x81, 0x94, 0xbc, 0x5f, 0x64, 0x11, 0x17, 0x46, 0xed, 0x07, 0x9c, 0x7d, 0x9d, 0x60, 0x37,
+	0x90, 0x35, 0xa7, 0xd5, 0xec, 0x5b, 0xc6, 0x46, 0xe0, 0x30, 0xf7, 0x36, 0x19, 0xdf, 0xf0, 0x5b,
+	0x3b, 0xd1, 0xe6, 0x66, 0x31, 0x97, 0x9e, 0xce, 0x73, 0x62, 0xec, 0x52, 0x9a, 0x71, 0xf1, 0xe3,
+	0x75, 0xfd, 0x2f, 0x48, 0x6e, 0xde, 0x1f, 0x54, 0xc9, 0xb4, 0x8c, 0x61, 0xbb, 0x1c, 0x24, 0x2c,
+	0x90, 0xc2, 0xbc, 0xa9, 0xab, 0x74, 0xe8, 0x4d, 0x5d, 0x1f, 0x22, 0xa4, 0x4d, 0xbb, 0x9d, 0x68,
+	0x9f, 0x69, 0xc9, 0x95, 0x91, 0xb5, 0x64, 0x75, 0xb0, 0x5a, 0x54, 0x54, 0xc0, 0xa0, 0x28, 0x0a,
+	0x6d, 0xf3, 0x2b, 0x4f, 0x32, 0x85, 0xb6, 0x8d, 0xab, 0x91, 0xc7, 0x1e, 0xec, 0xd5, 0xc8, 0x01,
+	0x99, 0xe6, 0x5d, 0x54, 0x15, 0x16, 0xee, 0xa3, 0x90, 0x02, 0xcb, 0x51, 0x5b, 0xb4, 0xc9, 0x40,
+	0x96, 0xae, 0x79, 0x6b, 0x68, 0xed, 0x41, 0xdf, 0x7b, 0xfc, 0x0d, 0xa4, 0x2e, 0xbf, 0x33, 0xe6,
+	0x4e, 0xa9, 0x32, 0x5d, 0x72, 0x1a, 0x24, 0xa0, 0xe1, 0x7d, 0xc5, 0x62, 0xc8, 0xc3, 0x2a, 0x16,
+	0xe3, 0x7d, 0xba, 0x84, 0xc7, 0x2b, 0xde, 0x2f, 0x55, 0xf8, 0xf1, 0x39, 0x32, 0xe6, 0xf7, 0xd2,
+	0xed, 0x28, 0xce, 0xde, 0x64, 0x3b, 0xc7, 0x5a, 0x41, 0x40, 0xdd, 0x65, 0x52, 0x69, 0xeb, 0x62,
+	0x7e, 0xa3, 0x7c, 0x4f, 0x6d, 0xa9, 0xf6, 0x53, 0x0a, 0x8c, 0x0a, 0x96, 0x52, 0x48, 0xfd, 0x2d,
+	0x99, 0x56, 0xcb, 0x4a, 0x29, 0xac, 0xfb, 0x78, 0x8b, 0x24, 0xb6, 0x8e, 0xa2, 0xcd, 0x62, 0x7c,
+	0x51, 0xb0, 0x15, 0xfa, 0x29, 0x06, 0xd5, 0x68, 0x67, 0xae, 0x8e, 0x2f, 0x32, 0x81, 0x60, 0xe3,
+	0x7a, 0xbf, 0x3e, 0x49, 0x4e, 0x37, 0x17, 0x56, 0xe4, 0xe5, 0x0d, 0xc7, 0x96, 0x19, 0x9b, 0xc7,
+	0xe3, 0xc1, 0x65, 0xc6, 0x0e, 0xe0, 0xde, 0x31, 0x32, 0x63, 0x3b, 0x46, 0x66, 0xac, 0x9d, 0xa6,
+	0x58, 0x2e, 0x22, 0x4d, 0x31, 0xaf, 0x07, 0xc3, 0xa4, 0x29, 0x1e, 0x5b, 0xaa, 0xec, 0x81, 0x1d,
+	0x1a, 0x29, 0x55, 0x56, 0xe5, 0x11, 0x17, 0x92, 0x7c, 0x35, 0xe0, 0x53, 0xe5, 0xe6, 0x11, 0xab,
+	0x1c, 0x4e, 0x9e, 0x58, 0xd8, 0x18, 0x2b, 0x22, 0x87, 0x33, 0xaf, 0x03, 0x43, 0xe4, 0x70, 0xf2,
+	0x1f, 0x56, 0xde, 0xf0, 0x78, 0x11, 0x79, 0xc3, 0x79, 0xdd, 0x39, 0x34, 0x6f, 0x18, 0xef, 0x02,
+	0xef, 0x44, 0x21, 0xde, 0x79, 0x9b, 0x46, 0xad, 0xa8, 0xd3, 0xa8, 0xd9, 0x5b, 0xc2, 0x82, 0x09,
+	0x04, 0x1b, 0x77, 0x50, 0xd2, 0x71, 0xfd, 0xa8, 0x49, 0xc7, 0xe4, 0x21, 0x25, 0x1d, 0x1b, 0x69,
+	0xb5, 0x13, 0x45, 0xa4, 0xd5, 0xe6, 0x7d, 0x91, 0x61, 0xd2, 0x6a, 0xdd, 0xcf, 0x3b, 0xe4, 0x84,
+	0x7f, 0x9b, 0xa9, 0xe0, 0x78, 0xa7, 0x70, 0x90, 0x32, 0x0f, 0xdd, 0xc4, 0x0b, 0xaf, 0x1c, 0xc3,
+	0x84, 0xbd, 0xd9, 0xd4, 0x6c, 0xe6, 0x4f, 0xb1, 0x0c, 0x0c, 0xb3, 0x09, 0xec, 0x8e, 0x1c, 0x25,
+	0xe3, 0xf7, 0xa7, 0x4b, 0xe4, 0x6b, 0x0e, 0xed, 0x82, 0x7b, 0x1b, 0xfd, 0x44, 0x5b, 0x62, 0xa2,
+	0x36, 0x9c, 0x22, 0x82, 0x80, 0xd7, 0x25, 0x3d, 0x5e, 0x77, 0x4a, 0xfd, 0x64, 0x1e, 0x22, 0xf9,
+	0x3f, 0x8b, 0xfd, 0x8d, 0x3a, 0x7d, 0xf5, 0xc9, 0x21, 0xea, 0x50, 0x60, 0x10, 0x14, 0xff, 0x31,
+	0xdd, 0xd2, 0x66, 0x26, 0xf5, 0xf9, 0x80, 0xb5, 0x82, 0x80, 0x8a, 0x9b, 0xfa, 0x78, 0x66, 0x1c,
+	0xcd, 0xbb, 0xa9, 0x4f, 0x82, 0xc0, 0xc4, 0xf3, 0xfe, 0xba, 0x44, 0x66, 0x0e, 0xd9, 0x53, 0xfa,
+	0x32, 0xa2, 0xab, 0x43, 0x67, 0x44, 0x8b, 0x4c, 0xa0, 0xb1, 0x01, 0x99, 0x40, 0xe8, 0x98, 0xa7,
+	0x78, 0x4f, 0x2c, 0x8f, 0x26, 0x1c, 0xcf, 0x38, 0xe6, 0x35, 0x08, 0x4c, 0x3c, 0xdc, 0xc5, 0xa6,
+	0xfc, 0x56, 0x8b, 0x26, 0x89, 0x4c, 0xf5, 0x11, 0x46, 0xee, 0xc2, 0xf2, 0x88, 0x98, 0xef, 0x60,
+	0xce, 0x62, 0x01, 0x19, 0x96, 0xd9, 0x01, 0xaf, 0x0f, 0x39, 0xe0, 0x3f, 0x5f, 0x22, 0x6f, 0x3e,
+	0x50, 0xba, 0x0d, 0x9d, 0x85, 0x85, 0x01, 0xdf, 0xd9, 0x89, 0x83, 0xe1, 0xe0, 0xc0, 0x20, 0x7c,
+	0x94, 0xba, 0x5d, 0xa3, 0x92, 0x64, 0xa3, 0x7c, 0x1c, 0xa3, 0x64, 0xb1, 0x80, 0x0c, 0xcb, 0xfb,
+	0x9d, 0x96, 0x7f, 0x50, 0x21, 0xcf, 0x0e, 0xa1, 0x03, 0x14, 0x98, 0xde, 0x69, 0xa7, 0x22, 0x97,
+	0x1f, 0x52, 0x2a, 0xf2, 0xfd, 0x0d, 0xd7, 0x1b, 0x19, 0xcc, 0x43, 0xa5, 0x91, 0xfe, 0x62, 0x89,
+	0x9c, 0x1d, 0xac, 0xb0, 0x1c, 0xb5, 0x86, 0xea, 0x2c, 0xfa, 0x71, 0xd3, 0xed, 0xe4, 0xc2, 0x9d,
+	0x20, 0x49, 0x45, 0x3d, 0xb6, 0x29, 0xee, 0x78, 0x95, 0xad, 0x60, 0x60, 0x20, 0x3b, 0xf6, 0x6b,
+	0x31, 0xba, 0x16, 0xa5, 0xfc, 0x21, 0x7e, 0xd8, 0x7a, 0x4c, 0xde, 0xaa, 0x6d, 0x80, 0x20, 0x8b,
+	0x8b, 0xec, 0x98, 0x6b, 0x9f, 0x77, 0x94, 0x9f, 0xc2, 0xa6, 0x78, 0xa5, 0x65, 0xd9, 0x0a, 0x06,
+	0x46, 0x36, 0x3f, 0xbb, 0x7a, 0x78, 0x7e, 0xb6, 0xf7, 0xcf, 0x4b, 0xe4, 0xc9, 0x81, 0x0a, 0xef,
+	0x70, 0xdb, 0xd4, 0xa3, 0x97, 0x53, 0x7d, 0x9f, 0x2b, 0x6c, 0xa4, 0x5c, 0x5c, 0xef, 0xcf, 0x06,
+	0xcc, 0x34, 0x91, 0x67, 0x7b, 0xff, 0x25, 0x46, 0x1e, 0xbd, 0xf1, 0xec, 0x4b, 0xad, 0xad, 0x8c,
+	0x90, 0x5a, 0x9b, 0xf9, 0x18, 0xd5, 0x21, 0xa5, 0xc3, 0x5f, 0x54, 0x06, 0x0e, 0x2f, 0x1e, 0x90,
+	0x87, 0xb2, 0x9b, 0x2f, 0x92, 0x93, 0x41, 0xd8, 0xea, 0xf4, 0xda, 0xb4, 0xd9, 0xdb, 0x10, 0x25,
+	0xba, 0x78, 0x1d, 0x5a, 0x95, 0x2a, 0xb3, 0x94, 0x81, 0x43, 0xdf, 0x13, 0x8f, 0x60, 0xaa, 0xf3,
+	0xfd, 0x0d, 0xe9, 0x88, 0x3b, 0xf7, 0x2a, 0x39, 0x23, 0x87, 0x62, 0xdb, 0x8f, 0x69, 0x5b, 0x08,
+	0xdb, 0x44, 0x24, 0x47, 0x3d, 0xc9, 0x13, 0xac, 0x72, 0x10, 0x20, 0xff, 0x39, 0xfc, 0x64, 0x69,
+	0xd4, 0x0d, 0x5a, 0x8d, 0x9a, 0xfd, 0xc9, 0xd6, 0xb1, 0x11, 0x38, 0x4c, 0xcb, 0x8b, 0xfa, 0x83,
+	0x91, 0x17, 0x1f, 0x22, 0x75, 0x35, 0xde, 0x3c, 0xa5, 0x42, 0x4d, 0xf2, 0xbe, 0x94, 0x0a, 0x35,
+	0xc3, 0x0d, 0x2c, 0xf7, 0xcd, 0xfc, 0xa0, 0x92, 0x59, 0xad, 0xc8, 0x0f, 0xdb, 0xbd, 0x77, 0x92,
+	0x49, 0x65, 0xfd, 0x12, 0x69, 0xa2, 0x3b, 0x74, 0x7f, 0x69, 0x31, 0x3b, 0x6f, 0xaf, 0x62, 0x23,
+	0x70, 0x98, 0xf7, 0x7f, 0x4a, 0x24, 0x73, 0xf5, 0x2a, 0xd6, 0x41, 0xc6, 0xab, 0x63, 0x59, 0x63,
+	0x31, 0x75, 0x90, 0x17, 0x25, 0x39, 0xed, 0xfe, 0x51, 0x4d, 0xa0, 0x99, 0xb9, 0x1f, 0xe1, 0x25,
+	0x87, 0x05, 0xeb, 0x52, 0x11, 0xe9, 0xee, 0x4d, 0x45, 0xcf, 0x18, 0x5e, 0xd5, 0x06, 0x06, 0x3f,
+	0x37, 0x25, 0xf5, 0x6d, 0x79, 0x41, 0x6b, 0x31, 0xdb, 0x9d, 0xba, 0xef, 0x95, 0xab, 0x68, 0xea,
+	0x27, 0x68, 0x46, 0xde, 0x9f, 0x96, 0xc8, 0x69, 0xfb, 0x03, 0x08, 0x77, 0xdd, 0x2f, 0x39, 0xe4,
+	0x89, 0x8e, 0x9f, 0xa4, 0xcd, 0x1e, 0x3b, 0x28, 0x6c, 0xf6, 0x3a, 0xab, 0x99, 0xea, 0xd4, 0x47,
+	0x35, 0xb6, 0x28, 0xc2, 0xd9, 0x2b, 0x89, 0xe7, 0x9f, 0xc2, 0x94, 0xb2, 0xe5, 0x7c, 0xe6, 0x30,
+	0xa8, 0x57, 0x68, 0xa1, 0x3a, 0xd9, 0xea, 0xc5, 0x31, 0x0d, 0x53, 0xdd, 0x55, 0xfe, 0x15, 0xaf,
+	0x15, 0x32, 0x90, 0xba, 0x83, 0xa7, 0x71, 0x43, 0x5d, 0xc8, 0xf0, 0x82, 0x3e, 0xee, 0xde, 0xbf,
+	0x46, 0xc9, 0x39, 0xf0, 0x3d, 0xdf, 0xb8, 0x43, 0x79, 0xa4, 0x3b, 0x94, 0xff, 0x6a, 0x8c, 0x9c,
+	0xb0, 0x4a, 0x78, 0x5b, 0x2e, 0x32, 0xe7, 0x50, 0x17, 0x19, 0x4b, 0x07, 0xec, 0x85, 0xe2, 0xb2,
+	0x39, 0x33, 0x1d, 0xb0, 0x17, 0x62, 0x89, 0x72, 0xfc, 0x23, 0x3e, 0x09, 0xf4, 0x42, 0x91, 0x4a,
+	0x60, 0x7e, 0x12, 0xe8, 0x85, 0x20, 0xa0, 0x18, 0x6a, 0x39, 0xc9, 0x16, 0xaf, 0x70, 0x30, 0x36,
+	0x2a, 0x45, 0x78, 0x75, 0x9b, 0x06, 0x45, 0x1e, 0x7a, 0x6a, 0xb6, 0x80, 0xc5, 0x11, 0x6f, 0xf9,
+	0xab, 0xab, 0x4b, 0xe5, 0x1b, 0x63, 0x45, 0xa4, 0x6b, 0x65, 0x2b, 0xa4, 0x67, 0x76, 0x4d, 0xd9,
+	0xc2, 0x1c, 0x4e, 0xe2, 0x5f, 0xbc, 0xe1, 0x90, 0xff, 0x2b, 0x26, 0x57, 0xe1, 0x8e, 0x31, 0x92,
+	0xe3, 0xf9, 0xc3, 0x9b, 0x6b, 0xc4, 0x3d, 0xca, 0xdc, 0x21, 0x27, 0x6f, 0xae, 0x91, 0x8d, 0xa0,
+	0xe1, 0x78, 0x58, 0x48, 0xd8, 0x8b, 0xa5, 0x86, 0x07, 0x8d, 0x1d, 0x16, 0x9a, 0xba, 0x19, 0x4c,
+	0x1c, 0xd3, 0xdd, 0x47, 0x1e, 0xaa, 0xbb, 0x6f, 0xe2, 0x10, 0x77, 0x5f, 0x93, 0x9c, 0xf1, 0x7b,
+	0x69, 0x84, 0xce, 0xff, 0xb9, 0x14, 0xcd, 0xb0, 0x69, 0xc2, 0xab, 0xbe, 0x4f, 0x32, 0x13, 0xb2,
+	0x0a, 0x96, 0x6b, 0xd2, 0xce, 0x66, 0x1f, 0x12, 0xe4, 0x3f, 0xeb, 0xfd, 0x53, 0x87, 0x9c, 0xc9,
+	0x9d, 0x0a, 0x8f, 0x6e, 0x9a, 0x82, 0xf7, 0x13, 0x55, 0xf2, 0x58, 0x4e, 0x81, 0x7f, 0x77, 0xdf,
+	0x5c, 0x24, 0x4e, 0x11, 0x11, 0x7f, 0x76, 0xdc, 0x96, 0xfc, 0x36, 0x39, 0x2b, 0x63, 0x34, 0x0f,
+	0xbe, 0xf6, 0xa2, 0x97, 0x1f, 0xac, 0x17, 0xdd, 0x98, 0xeb, 0x95, 0x87, 0x3a, 0xd7, 0xab, 0x87,
+	0xcc, 0xf5, 0x5f, 0x76, 0x48, 0x63, 0x77, 0xc0, 0xb5, 0x7a, 0x8d, 0xb1, 0x22, 0x6c, 0x5c, 0x83,
+	0x2e, 0xed, 0x9b, 0x7f, 0x1a, 0x73, 0xa1, 0x07, 0x41, 0x61, 0x60, 0xaf, 0xbc, 0x2f, 0x96, 0x09,
+	0xd3, 0xf7, 0x44, 0x80, 0xe7, 0x47, 0xcd, 0x7b, 0x42, 0x9c, 0xa2, 0xee, 0xb4, 0xe0, 0xc4, 0xd5,
+	0x3d, 0x23, 0x7c, 0x04, 0xf3, 0xae, 0x1d, 0xc9, 0xee, 0x84, 0xa5, 0x21, 0x76, 0xc2, 0x8e, 0xbc,
+	0x90, 0xa5, 0x5c, 0xfc, 0x85, 0x2c, 0xf5, 0xec, 0x65, 0x2c, 0x07, 0x7f, 0xe2, 0xca, 0x23, 0xf9,
+	0x89, 0x7f, 0xc3, 0x21, 0x8f, 0xe5, 0x7c, 0x05, 0xad, 0x6e, 0x38, 0x07, 0xa8, 0x1b, 0x18, 0x40,
+	0x25, 0x76, 0x66, 0xa1, 0x96, 0xe8, 0x00, 0x2a, 0xd1, 0x0e, 0x0a, 0x83, 0xdd, 0xb3, 0xde, 0xe9,
+	0x44, 0xb7, 0x2f, 0xec, 0x76, 0xd3, 0x7d, 0xa1, 0xa0, 0xe8, 0x7b, 0xd6, 0x15, 0x04, 0x0c, 0x2c,
+	0xf7, 0x59, 0x32, 0xc6, 0xcb, 0x4a, 0x08, 0xe3, 0xd0, 0x04, 0xae, 0x43, 0x5e, 0x73, 0xa2, 0x0d,
+	0x02, 0xe4, 0xdd, 0x73, 0x88, 0x71, 0x2c, 0x41, 0x8b, 0x8e, 0x59, 0x9a, 0x30, 0x6b, 0xd1, 0x31,
+	0x2b, 0x19, 0x82, 0x85, 0xa9, 0x6e, 0x4d, 0x2e, 0x0d, 0xbc, 0x35, 0xf9, 0x0e, 0x26, 0x11, 0xed,
+	0x47, 0xbd, 0xb4, 0x98, 0x0b, 0x05, 0xa5, 0xf2, 0x2b, 0x05, 0xff, 0x32, 0xa3, 0x2d, 0xab, 0x9a,
+	0xe1, 0xff, 0x20, 0xf8, 0x79, 0x7f, 0xb7, 0x24, 0x5e, 0x92, 0x1f, 0x70, 0x74, 0x28, 0x9f, 0x33,
+	0x62, 0x28, 0xdf, 0x47, 0x08, 0x69, 0x45, 0xbb, 0x5d, 0x3c, 0xf2, 0xaf, 0x47, 0xc5, 0x9c, 0x13,
+	0x17, 0x14, 0x3d, 0xfd, 0x41, 0x75, 0x1b, 0x18, 0xfc, 0x2c, 0xa9, 0x52, 0x3e, 0x54, 0xaa, 0x58,
+	0x1b, 0x6c, 0xe5, 0xe0, 0x0d, 0xd6, 0xfb, 0x6b, 0x87, 0x58, 0x0a, 0x27, 0xde, 0xc6, 0x84, 0xdd,
+	0xdd, 0x17, 0x7b, 0xd5, 0x6a, 0x71, 0xda, 0x2d, 0x0a, 0x09, 0xb1, 0x01, 0xb0, 0x7f, 0x81, 0x33,
+	0x72, 0x3b, 0x22, 0x6c, 0xb1, 0x90, 0x73, 0x9b, 0xc9, 0x10, 0x03, 0x1f, 0x79, 0xe4, 0x8f, 0x0e,
+	0x81, 0xf4, 0x5e, 0x24, 0xa7, 0xfa, 0x3a, 0x85, 0x0b, 0x97, 0x95, 0xd7, 0xc8, 0x2e, 0x5c, 0x56,
+	0x87, 0x03, 0x38, 0x0c, 0x23, 0x0c, 0x4f, 0x66, 0xc9, 0xa3, 0xd3, 0xf9, 0x54, 0x92, 0xa5, 0x77,
+	0x5c, 0x63, 0xa7, 0xf2, 0x34, 0xfa, 0x40, 0xd0, 0xdf, 0x09, 0xef, 0xcb, 0x55, 0x3e, 0xf9, 0x6f,
+	0x06, 0x61, 0x3b, 0xba, 0xad, 0x54, 0x34, 0x67, 0xa0, 0x8a, 0x86, 0x3b, 0x53, 0x6b, 0x9b, 0xb6,
+	0x7b, 0x9d, 0xbe, 0x02, 0x1a, 0x4d, 0xd1, 0x0e, 0x0a, 0x03, 0xb1, 0xdb, 0x3d, 0x71, 0xe4, 0xce,
+	0x4c, 0xca, 0x45, 0xd1, 0x0e, 0x0a, 0x03, 0x53, 0xed, 0x8c, 0x97, 0x94, 0xf3, 0x92, 0x9d, 0x77,
+	0x0c, 0xe5, 0x21, 0x01, 0x0b, 0x0b, 0x7d, 0x04, 0x4a, 0xdd, 0x93, 0xca, 0x02, 0xf3, 0x11, 0xa8,
+	0x3d, 0x39, 0x01, 0x03, 0x83, 0x55, 0xe7, 0xe8, 0xf4, 0x12, 0xe6, 0x04, 0x1f, 0xd3, 0xf7, 0x29,
+	0x2c, 0x88, 0x36, 0x50, 0x50, 0xdc, 0x57, 0x77, 0xfd, 0xb0, 0xe7, 0x77, 0x70, 0x84, 0x84, 0xd5,
+	0x4f, 0x2d, 0xc3, 0x15, 0x05, 0x01, 0x03, 0x0b, 0xdf, 0x38, 0x0d, 0x76, 0xe9, 0x07, 0xa2, 0x50,
+	0x86, 0x95, 0xeb, 0xb8, 0x08, 0xd1, 0x0e, 0x0a, 0xc3, 0x7d, 0x11, 0x6f, 0x18, 0x6e, 0x73, 0xdd,
+	0x34, 0x8a, 0x85, 0x7b, 0x55, 0x1d, 0x7c, 0xb1, 0xc8, 0x8a, 0x86, 0x82, 0x89, 0x9a, 0xbd, 0x4c,
+	0x82, 0x0c, 0x79, 0x99, 0xc4, 0x27, 0x1c, 0x42, 0xda, 0x7e, 0x4a, 0xc1, 0x0f, 0xb7, 0x54, 0x30,
+	0x46, 0x01, 0xba, 0x06, 0x9f, 0x3f, 0x8b, 0x92, 0xb2, 0x11, 0x37, 0xaa, 0x98, 0x81, 0xc1, 0xd8,
+	0x7d, 0x8d, 0xd4, 0x5a, 0x7e, 0x87, 0x86, 0x6d, 0x3f, 0x6e, 0x4c, 0x16, 0x11, 0x8a, 0xa8, 0x3b,
+	0xb1, 0x20, 0xe8, 0x8a, 0xcf, 0x2a, 0x7e, 0x81, 0xe2, 0xe7, 0xb5, 0x89, 0xdb, 0x8f, 0x2d, 0x92,
+	0x5d, 0xb8, 0xb1, 0x34, 0x9b, 0xe0, 0xa5, 0x2f, 0xce, 0xd1, 0x38, 0x87, 0xd9, 0x3d, 0x3f, 0x2b,
+	0xe4, 0x7f, 0x66, 0x64, 0x70, 0x1b, 0x61, 0xb7, 0x7d, 0x65, 0x8f, 0x4a, 0x2c, 0xa6, 0x14, 0x38,
+	0x0c, 0x69, 0xd3, 0xb0, 0x9d, 0xa5, 0x7d, 0x21, 0x6c, 0x03, 0xb6, 0x67, 0x3f, 0x7e, 0x79, 0xb8,
+	0x8f, 0xef, 0xfd, 0xa5, 0xd8, 0x9c, 0x78, 0x97, 0xd6, 0x68, 0x1c, 0x44, 0x6d, 0x77, 0xd5, 0xec,
+	0xcf, 0x88, 0x29, 0x74, 0xb9, 0x7d, 0x5f, 0xd2, 0x7d, 0x1f, 0x8d, 0x5c, 0x61, 0xef, 0xf9, 0x5f,
+	0x1d, 0x32, 0xad, 0x2b, 0x80, 0xb1, 0x0f, 0x66, 0x99, 0xfe, 0x9d, 0x43, 0x4d, 0xff, 0x76, 0x69,
+	0xa1, 0xd2, 0x50, 0xa5, 0x85, 0xcc, 0xaa, 0x3f, 0xe5, 0x03, 0xab, 0xfe, 0x7c, 0x2d, 0x19, 0xdf,
+	0xa1, 0xfb, 0x46, 0x79, 0x20, 0xa6, 0x7c, 0x5d, 0xe5, 0x4d, 0x20, 0x61, 0x98, 0x50, 0xd1, 0xf2,
+	0x55, 0xf9, 0xce, 0x49, 0xae, 0xbc, 0x2c, 0xcc, 0x31, 0x24, 0x01, 0xf1, 0x56, 0x49, 0x5d, 0x05,
+	0xdd, 0xc8, 0x19, 0xe9, 0xe4, 0xcf, 0xc8, 0xa1, 0xaa, 0x8f, 0xcc, 0x6f, 0xfc, 0xee, 0x97, 0x9e,
+	0x79, 0xd3, 0xef, 0x7f, 0xe9, 0x99, 0x37, 0xfd, 0xf1, 0x97, 0x9e, 0x79, 0xd3, 0xc7, 0xee, 0x3d,
+	0xe3, 0xfc, 0xee, 0xbd, 0x67, 0x9c, 0xdf, 0xbf, 0xf7, 0x8c, 0xf3, 0xc7, 0xf7, 0x9e, 0x71, 0xbe,
+	0x78, 0xef, 0x19, 0xe7, 0xb3, 0xff, 0xf9, 0x99, 0x37, 0x7d, 0x20, 0x37, 0x5b, 0x07, 0xff, 0x79,
+	0xbe, 0xd5, 0x3e, 0xbf, 0xf7, 0x4e, 0x96, 0x30, 0x82, 0x1f, 0xf8, 0xbc, 0xb1, 0x56, 0xcf, 0xcb,
+	0xb5, 0xfa, 0xff, 0x06, 0x00, 0x0d, 0x1d, 0x98, 0x16, 0xee, 0x1b, 0x01, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -5784,6 +6308,27 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.QueuePriority)
+	copy(dAtA[i:], m.QueuePriority)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueuePriority)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.ResourcePolicies) > 0 {
+		for iNdEx := len(m.ResourcePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourcePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.DestinationServiceAccounts) > 0 {
 		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -6118,6 +6663,46 @@ func (m *ApplicationCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationDestination) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6516,6 +7101,42 @@ func (m *ApplicationSetGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.KubernetesResource != nil {
+		{
+			size, err := m.KubernetesResource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.HelmRepository != nil {
+		{
+			size, err := m.HelmRepository.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.OCI != nil {
+		{
+			size, err := m.OCI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -6706,6 +7327,42 @@ func (m *ApplicationSetNestedGenerator) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.KubernetesResource != nil {
+		{
+			size, err := m.KubernetesResource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.HelmRepository != nil {
+		{
+			size, err := m.HelmRepository.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.OCI != nil {
+		{
+			size, err := m.OCI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -6875,6 +7532,39 @@ func (m *ApplicationSetResourceIgnoreDifferences) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetRolloutAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetRolloutStep) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6895,6 +7585,31 @@ func (m *ApplicationSetRolloutStep) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Analysis != nil {
+		{
+			size, err := m.Analysis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i--
+	if m.RequireApproval {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.PauseDuration)
+	copy(dAtA[i:], m.PauseDuration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PauseDuration)))
+	i--
+	dAtA[i] = 0x1a
 	if m.MaxUpdate != nil {
 		{
 			size, err := m.MaxUpdate.MarshalToSizedBuffer(dAtA[:i])
@@ -6924,6 +7639,144 @@ func (m *ApplicationSetRolloutStep) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetRolloutStepRollback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutStepRollback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutStepRollback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RolloutStartTime != nil {
+		{
+			size, err := m.RolloutStartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PendingApplications) > 0 {
+		for iNdEx := len(m.PendingApplications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PendingApplications[iNdEx])
+			copy(dAtA[i:], m.PendingApplications[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.PendingApplications[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Generation))
+	i--
+	dAtA[i] = 0x10
+	if len(m.Applications) > 0 {
+		for iNdEx := len(m.Applications) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Applications[iNdEx])
+			copy(dAtA[i:], m.Applications[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Applications[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationSetRolloutStepStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutStepStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutStepStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastAnalysisTime != nil {
+		{
+			size, err := m.LastAnalysisTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Rollback != nil {
+		{
+			size, err := m.Rollback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i--
+	if m.Approved {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if m.LastTransitionTime != nil {
+		{
+			size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Step)
+	copy(dAtA[i:], m.Step)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Step)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationSetRolloutStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6944,6 +7797,14 @@ func (m *ApplicationSetRolloutStrategy) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.RollbackOnFailure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
 	if len(m.Steps) > 0 {
 		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7110,6 +7971,20 @@ func (m *ApplicationSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RolloutSteps) > 0 {
+		for iNdEx := len(m.RolloutSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RolloutSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Resources) > 0 {
 		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7175,6 +8050,18 @@ func (m *ApplicationSetStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.RollingUpdate != nil {
+		{
+			size, err := m.RollingUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.RollingSync != nil {
 		{
 			size, err := m.RollingSync.MarshalToSizedBuffer(dAtA[:i])
@@ -7215,6 +8102,18 @@ func (m *ApplicationSetSyncPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDeletions != nil {
+		{
+			size, err := m.MaxDeletions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.ApplicationsSync != nil {
 		i -= len(*m.ApplicationsSync)
 		copy(dAtA[i:], *m.ApplicationsSync)
@@ -7386,6 +8285,42 @@ func (m *ApplicationSetTerminalGenerator) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.KubernetesResource != nil {
+		{
+			size, err := m.KubernetesResource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.HelmRepository != nil {
+		{
+			size, err := m.HelmRepository.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.OCI != nil {
+		{
+			size, err := m.OCI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Selector != nil {
 		{
 			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
@@ -8220,6 +9155,20 @@ func (m *ApplicationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependsOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.SourceHydrator != nil {
 		{
 			size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
@@ -8998,6 +9947,9 @@ func (m *ClusterCacheInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.NodesCount))
+	i--
+	dAtA[i] = 0x20
 	if m.LastCacheSyncTime != nil {
 		{
 			size, err := m.LastCacheSyncTime.MarshalToSizedBuffer(dAtA[:i])
@@ -9125,6 +10077,31 @@ func (m *ClusterGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	i--
+	if m.SkipUnreachable {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	if len(m.RequiredAPIVersions) > 0 {
+		for iNdEx := len(m.RequiredAPIVersions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAPIVersions[iNdEx])
+			copy(dAtA[i:], m.RequiredAPIVersions[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RequiredAPIVersions[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i--
+	if m.Info {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i--
 	if m.FlatList {
 		dAtA[i] = 1
 	} else {
@@ -9592,6 +10569,20 @@ func (m *DrySource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Sources) > 0 {
+		for iNdEx := len(m.Sources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -10247,6 +11238,95 @@ func (m *HelmParameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HelmRepositoryGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HelmRepositoryGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HelmRepositoryGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		keysForValues := make([]string, 0, len(m.Values))
+		for k := range m.Values {
+			keysForValues = append(keysForValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+		for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Values[string(keysForValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForValues[iNdEx])
+			copy(dAtA[i:], keysForValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.RequeueAfterSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RequeueAfterSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	i--
+	if m.AllVersions {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.VersionConstraint)
+	copy(dAtA[i:], m.VersionConstraint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.VersionConstraint)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Charts) > 0 {
+		for iNdEx := len(m.Charts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Charts[iNdEx])
+			copy(dAtA[i:], m.Charts[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Charts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HostInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10356,6 +11436,11 @@ func (m *HydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.PullRequestURL)
+	copy(dAtA[i:], m.PullRequestURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PullRequestURL)))
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -10411,6 +11496,39 @@ func (m *HydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HydratePullRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydratePullRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydratePullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.API)
+	copy(dAtA[i:], m.API)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.API)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Provider)
+	copy(dAtA[i:], m.Provider)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Provider)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HydrateTo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10431,6 +11549,30 @@ func (m *HydrateTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PullRequest != nil {
+		{
+			size, err := m.PullRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Layout != nil {
+		{
+			size, err := m.Layout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	i -= len(m.TargetBranch)
 	copy(dAtA[i:], m.TargetBranch)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetBranch)))
@@ -10439,6 +11581,42 @@ func (m *HydrateTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HydratedManifestLayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HydratedManifestLayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HydratedManifestLayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Kustomization {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Info) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10650,6 +11828,127 @@ func (m *KnownTypeField) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KubernetesResourceGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KubernetesResourceGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubernetesResourceGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		keysForValues := make([]string, 0, len(m.Values))
+		for k := range m.Values {
+			keysForValues = append(keysForValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+		for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Values[string(keysForValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForValues[iNdEx])
+			copy(dAtA[i:], keysForValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.RequeueAfterSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RequeueAfterSeconds))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Params) > 0 {
+		keysForParams := make([]string, 0, len(m.Params))
+		for k := range m.Params {
+			keysForParams = append(keysForParams, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForParams)
+		for iNdEx := len(keysForParams) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Params[string(keysForParams[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForParams[iNdEx])
+			copy(dAtA[i:], keysForParams[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForParams[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i -= len(m.Condition)
+	copy(dAtA[i:], m.Condition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Condition)))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.LabelSelector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Cluster)
+	copy(dAtA[i:], m.Cluster)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Cluster)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.APIVersion)
+	copy(dAtA[i:], m.APIVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIVersion)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *KustomizeGvk) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11226,6 +12525,216 @@ func (m *NestedMergeGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OCIGenerator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIGenerator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIGenerator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		keysForValues := make([]string, 0, len(m.Values))
+		for k := range m.Values {
+			keysForValues = append(keysForValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+		for iNdEx := len(keysForValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Values[string(keysForValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForValues[iNdEx])
+			copy(dAtA[i:], keysForValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.RequeueAfterSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RequeueAfterSeconds))
+		i--
+		dAtA[i] = 0x40
+	}
+	i--
+	if m.PlainHTTP {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	if m.BasicAuth != nil {
+		{
+			size, err := m.BasicAuth.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i--
+	if m.LatestOnly {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Repositories) > 0 {
+		for iNdEx := len(m.Repositories) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Repositories[iNdEx])
+			copy(dAtA[i:], m.Repositories[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Repositories[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OCIGeneratorBasicAuth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIGeneratorBasicAuth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIGeneratorBasicAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PasswordRef != nil {
+		{
+			size, err := m.PasswordRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Username)
+	copy(dAtA[i:], m.Username)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OCIGeneratorFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OCIGeneratorFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OCIGeneratorFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TagSemver != nil {
+		i -= len(*m.TagSemver)
+		copy(dAtA[i:], *m.TagSemver)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.TagSemver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TagMatch != nil {
+		i -= len(*m.TagMatch)
+		copy(dAtA[i:], *m.TagMatch)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.TagMatch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RepositoryMatch != nil {
+		i -= len(*m.RepositoryMatch)
+		copy(dAtA[i:], *m.RepositoryMatch)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.RepositoryMatch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Operation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13646,6 +15155,63 @@ func (m *ResourceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourcePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourcePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourcePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Mode)
+	copy(dAtA[i:], m.Mode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mode)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Condition)
+	copy(dAtA[i:], m.Condition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Condition)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Kinds) > 0 {
+		for iNdEx := len(m.Kinds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Kinds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ResourceRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14940,6 +16506,11 @@ func (m *SuccessfulHydrateOperation) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.PullRequestURL)
+	copy(dAtA[i:], m.PullRequestURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PullRequestURL)))
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.SourceHydrator.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15364,6 +16935,18 @@ func (m *SyncSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Layout != nil {
+		{
+			size, err := m.Layout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -15560,6 +17143,32 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Calendar != nil {
+		{
+			size, err := m.Calendar.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.DateRanges) > 0 {
+		for iNdEx := len(m.DateRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DateRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	i -= len(m.Description)
 	copy(dAtA[i:], m.Description)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
@@ -15631,6 +17240,125 @@ func (m *SyncWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SyncWindowCalendar) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowCalendar) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowCalendar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ConfigMap)
+	copy(dAtA[i:], m.ConfigMap)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConfigMap)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWindowDateRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowDateRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowDateRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Description)
+	copy(dAtA[i:], m.Description)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.End)
+	copy(dAtA[i:], m.End)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Start)
+	copy(dAtA[i:], m.Start)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Start)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncWindowPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncWindowPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncWindowPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Description)
+	copy(dAtA[i:], m.Description)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Description)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TLSClientConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15875,6 +17603,14 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.ResourcePolicies) > 0 {
+		for _, e := range m.ResourcePolicies {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.QueuePriority)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -15932,6 +17668,21 @@ func (m *ApplicationCondition) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ApplicationDestination) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16128,6 +17879,18 @@ func (m *ApplicationSetGenerator) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.OCI != nil {
+		l = m.OCI.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HelmRepository != nil {
+		l = m.HelmRepository.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.KubernetesResource != nil {
+		l = m.KubernetesResource.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16194,6 +17957,18 @@ func (m *ApplicationSetNestedGenerator) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.OCI != nil {
+		l = m.OCI.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HelmRepository != nil {
+		l = m.HelmRepository.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.KubernetesResource != nil {
+		l = m.KubernetesResource.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16220,6 +17995,19 @@ func (m *ApplicationSetResourceIgnoreDifferences) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetRolloutAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationSetRolloutStep) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16236,6 +18024,67 @@ func (m *ApplicationSetRolloutStep) Size() (n int) {
 		l = m.MaxUpdate.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.PauseDuration)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetRolloutStepRollback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Applications) > 0 {
+		for _, s := range m.Applications {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.Generation))
+	if len(m.PendingApplications) > 0 {
+		for _, s := range m.PendingApplications {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RolloutStartTime != nil {
+		l = m.RolloutStartTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationSetRolloutStepStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Step)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastTransitionTime != nil {
+		l = m.LastTransitionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	if m.Rollback != nil {
+		l = m.Rollback.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LastAnalysisTime != nil {
+		l = m.LastAnalysisTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16251,6 +18100,7 @@ func (m *ApplicationSetRolloutStrategy) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	n += 2
 	return n
 }
 
@@ -16325,6 +18175,12 @@ func (m *ApplicationSetStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.RolloutSteps) > 0 {
+		for _, e := range m.RolloutSteps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -16340,6 +18196,10 @@ func (m *ApplicationSetStrategy) Size() (n int) {
 		l = m.RollingSync.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.RollingUpdate != nil {
+		l = m.RollingUpdate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16354,6 +18214,10 @@ func (m *ApplicationSetSyncPolicy) Size() (n int) {
 		l = len(*m.ApplicationsSync)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.MaxDeletions != nil {
+		l = m.MaxDeletions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16443,6 +18307,18 @@ func (m *ApplicationSetTerminalGenerator) Size() (n int) {
 		l = m.Selector.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.OCI != nil {
+		l = m.OCI.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HelmRepository != nil {
+		l = m.HelmRepository.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.KubernetesResource != nil {
+		l = m.KubernetesResource.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -16753,6 +18629,12 @@ func (m *ApplicationSpec) Size() (n int) {
 		l = m.SourceHydrator.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17018,6 +18900,7 @@ func (m *ClusterCacheInfo) Size() (n int) {
 		l = m.LastCacheSyncTime.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.NodesCount))
 	return n
 }
 
@@ -17068,6 +18951,14 @@ func (m *ClusterGenerator) Size() (n int) {
 		}
 	}
 	n += 2
+	n += 2
+	if len(m.RequiredAPIVersions) > 0 {
+		for _, s := range m.RequiredAPIVersions {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
 	return n
 }
 
@@ -17231,6 +19122,12 @@ func (m *DrySource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Sources) > 0 {
+		for _, e := range m.Sources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17474,6 +19371,39 @@ func (m *HelmParameter) Size() (n int) {
 	return n
 }
 
+func (m *HelmRepositoryGenerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Charts) > 0 {
+		for _, s := range m.Charts {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.VersionConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.RequeueAfterSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.RequeueAfterSeconds))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *HostInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17529,6 +19459,21 @@ func (m *HydrateOperation) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PullRequestURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *HydratePullRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.API)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17540,6 +19485,26 @@ func (m *HydrateTo) Size() (n int) {
 	_ = l
 	l = len(m.TargetBranch)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Layout != nil {
+		l = m.Layout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PullRequest != nil {
+		l = m.PullRequest.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HydratedManifestLayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
 	return n
 }
 
@@ -17624,6 +19589,48 @@ func (m *KnownTypeField) Size() (n int) {
 	return n
 }
 
+func (m *KubernetesResourceGenerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.APIVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Cluster)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LabelSelector.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Condition)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Params) > 0 {
+		for k, v := range m.Params {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.RequeueAfterSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.RequeueAfterSeconds))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *KustomizeGvk) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17840,6 +19847,85 @@ func (m *NestedMergeGenerator) Size() (n int) {
 	return n
 }
 
+func (m *OCIGenerator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Repositories) > 0 {
+		for _, s := range m.Repositories {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	if m.BasicAuth != nil {
+		l = m.BasicAuth.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	n += 2
+	if m.RequeueAfterSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.RequeueAfterSeconds))
+	}
+	l = m.Template.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *OCIGeneratorBasicAuth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PasswordRef != nil {
+		l = m.PasswordRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *OCIGeneratorFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RepositoryMatch != nil {
+		l = len(*m.RepositoryMatch)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TagMatch != nil {
+		l = len(*m.TagMatch)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TagSemver != nil {
+		l = len(*m.TagSemver)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Operation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18732,6 +20818,29 @@ func (m *ResourceOverride) Size() (n int) {
 	return n
 }
 
+func (m *ResourcePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Kinds) > 0 {
+		for _, e := range m.Kinds {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Condition)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Mode)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ResourceRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19202,6 +21311,8 @@ func (m *SuccessfulHydrateOperation) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.SourceHydrator.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PullRequestURL)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19361,6 +21472,10 @@ func (m *SyncSource) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Layout != nil {
+		l = m.Layout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19459,6 +21574,59 @@ func (m *SyncWindow) Size() (n int) {
 	n += 2
 	l = len(m.Description)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DateRanges) > 0 {
+		for _, e := range m.DateRanges {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Calendar != nil {
+		l = m.Calendar.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncWindowCalendar) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigMap)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncWindowDateRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Start)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.End)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Description)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *SyncWindowPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Start.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Description)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -19606,6 +21774,11 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForDestinationServiceAccounts += strings.Replace(strings.Replace(f.String(), "ApplicationDestinationServiceAccount", "ApplicationDestinationServiceAccount", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForDestinationServiceAccounts += "}"
+	repeatedStringForResourcePolicies := "[]ResourcePolicy{"
+	for _, f := range this.ResourcePolicies {
+		repeatedStringForResourcePolicies += strings.Replace(strings.Replace(f.String(), "ResourcePolicy", "ResourcePolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForResourcePolicies += "}"
 	s := strings.Join([]string{`&AppProjectSpec{`,
 		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
 		`Destinations:` + repeatedStringForDestinations + `,`,
@@ -19621,6 +21794,8 @@ func (this *AppProjectSpec) String() string {
 		`SourceNamespaces:` + fmt.Sprintf("%v", this.SourceNamespaces) + `,`,
 		`PermitOnlyProjectScopedClusters:` + fmt.Sprintf("%v", this.PermitOnlyProjectScopedClusters) + `,`,
 		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
+		`ResourcePolicies:` + repeatedStringForResourcePolicies + `,`,
+		`QueuePriority:` + fmt.Sprintf("%v", this.QueuePriority) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19670,6 +21845,17 @@ func (this *ApplicationCondition) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationDestination) String() string {
 	if this == nil {
 		return "nil"
@@ -19789,6 +21975,9 @@ func (this *ApplicationSetGenerator) String() string {
 		`Merge:` + strings.Replace(this.Merge.String(), "MergeGenerator", "MergeGenerator", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
+		`OCI:` + strings.Replace(this.OCI.String(), "OCIGenerator", "OCIGenerator", 1) + `,`,
+		`HelmRepository:` + strings.Replace(this.HelmRepository.String(), "HelmRepositoryGenerator", "HelmRepositoryGenerator", 1) + `,`,
+		`KubernetesResource:` + strings.Replace(this.KubernetesResource.String(), "KubernetesResourceGenerator", "KubernetesResourceGenerator", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19824,6 +22013,9 @@ func (this *ApplicationSetNestedGenerator) String() string {
 		`Merge:` + strings.Replace(fmt.Sprintf("%v", this.Merge), "JSON", "v11.JSON", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
+		`OCI:` + strings.Replace(this.OCI.String(), "OCIGenerator", "OCIGenerator", 1) + `,`,
+		`HelmRepository:` + strings.Replace(this.HelmRepository.String(), "HelmRepositoryGenerator", "HelmRepositoryGenerator", 1) + `,`,
+		`KubernetesResource:` + strings.Replace(this.KubernetesResource.String(), "KubernetesResourceGenerator", "KubernetesResourceGenerator", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19840,6 +22032,17 @@ func (this *ApplicationSetResourceIgnoreDifferences) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationSetRolloutAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetRolloutAnalysis{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationSetRolloutStep) String() string {
 	if this == nil {
 		return "nil"
@@ -19852,6 +22055,38 @@ func (this *ApplicationSetRolloutStep) String() string {
 	s := strings.Join([]string{`&ApplicationSetRolloutStep{`,
 		`MatchExpressions:` + repeatedStringForMatchExpressions + `,`,
 		`MaxUpdate:` + strings.Replace(fmt.Sprintf("%v", this.MaxUpdate), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`PauseDuration:` + fmt.Sprintf("%v", this.PauseDuration) + `,`,
+		`RequireApproval:` + fmt.Sprintf("%v", this.RequireApproval) + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "ApplicationSetRolloutAnalysis", "ApplicationSetRolloutAnalysis", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetRolloutStepRollback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetRolloutStepRollback{`,
+		`Applications:` + fmt.Sprintf("%v", this.Applications) + `,`,
+		`Generation:` + fmt.Sprintf("%v", this.Generation) + `,`,
+		`PendingApplications:` + fmt.Sprintf("%v", this.PendingApplications) + `,`,
+		`RolloutStartTime:` + strings.Replace(fmt.Sprintf("%v", this.RolloutStartTime), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationSetRolloutStepStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationSetRolloutStepStatus{`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastTransitionTime:` + strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1) + `,`,
+		`Approved:` + fmt.Sprintf("%v", this.Approved) + `,`,
+		`Rollback:` + strings.Replace(this.Rollback.String(), "ApplicationSetRolloutStepRollback", "ApplicationSetRolloutStepRollback", 1) + `,`,
+		`LastAnalysisTime:` + strings.Replace(fmt.Sprintf("%v", this.LastAnalysisTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19867,6 +22102,7 @@ func (this *ApplicationSetRolloutStrategy) String() string {
 	repeatedStringForSteps += "}"
 	s := strings.Join([]string{`&ApplicationSetRolloutStrategy{`,
 		`Steps:` + repeatedStringForSteps + `,`,
+		`RollbackOnFailure:` + fmt.Sprintf("%v", this.RollbackOnFailure) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19919,10 +22155,16 @@ func (this *ApplicationSetStatus) String() string {
 		repeatedStringForResources += strings.Replace(strings.Replace(f.String(), "ResourceStatus", "ResourceStatus", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForResources += "}"
+	repeatedStringForRolloutSteps := "[]ApplicationSetRolloutStepStatus{"
+	for _, f := range this.RolloutSteps {
+		repeatedStringForRolloutSteps += strings.Replace(strings.Replace(f.String(), "ApplicationSetRolloutStepStatus", "ApplicationSetRolloutStepStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRolloutSteps += "}"
 	s := strings.Join([]string{`&ApplicationSetStatus{`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`ApplicationStatus:` + repeatedStringForApplicationStatus + `,`,
 		`Resources:` + repeatedStringForResources + `,`,
+		`RolloutSteps:` + repeatedStringForRolloutSteps + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19934,6 +22176,7 @@ func (this *ApplicationSetStrategy) String() string {
 	s := strings.Join([]string{`&ApplicationSetStrategy{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`RollingSync:` + strings.Replace(this.RollingSync.String(), "ApplicationSetRolloutStrategy", "ApplicationSetRolloutStrategy", 1) + `,`,
+		`RollingUpdate:` + strings.Replace(this.RollingUpdate.String(), "ApplicationSetRolloutStrategy", "ApplicationSetRolloutStrategy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -19945,6 +22188,7 @@ func (this *ApplicationSetSyncPolicy) String() string {
 	s := strings.Join([]string{`&ApplicationSetSyncPolicy{`,
 		`PreserveResourcesOnDeletion:` + fmt.Sprintf("%v", this.PreserveResourcesOnDeletion) + `,`,
 		`ApplicationsSync:` + valueToStringGenerated(this.ApplicationsSync) + `,`,
+		`MaxDeletions:` + strings.Replace(fmt.Sprintf("%v", this.MaxDeletions), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20007,6 +22251,9 @@ func (this *ApplicationSetTerminalGenerator) String() string {
 		`PullRequest:` + strings.Replace(this.PullRequest.String(), "PullRequestGenerator", "PullRequestGenerator", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginGenerator", "PluginGenerator", 1) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`OCI:` + strings.Replace(this.OCI.String(), "OCIGenerator", "OCIGenerator", 1) + `,`,
+		`HelmRepository:` + strings.Replace(this.HelmRepository.String(), "HelmRepositoryGenerator", "HelmRepositoryGenerator", 1) + `,`,
+		`KubernetesResource:` + strings.Replace(this.KubernetesResource.String(), "KubernetesResourceGenerator", "KubernetesResourceGenerator", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20225,6 +22472,11 @@ func (this *ApplicationSpec) String() string {
 		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForSources += "}"
+	repeatedStringForDependsOn := "[]ApplicationDependency{"
+	for _, f := range this.DependsOn {
+		repeatedStringForDependsOn += strings.Replace(strings.Replace(f.String(), "ApplicationDependency", "ApplicationDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependsOn += "}"
 	s := strings.Join([]string{`&ApplicationSpec{`,
 		`Source:` + strings.Replace(this.Source.String(), "ApplicationSource", "ApplicationSource", 1) + `,`,
 		`Destination:` + strings.Replace(strings.Replace(this.Destination.String(), "ApplicationDestination", "ApplicationDestination", 1), `&`, ``, 1) + `,`,
@@ -20235,6 +22487,7 @@ func (this *ApplicationSpec) String() string {
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`Sources:` + repeatedStringForSources + `,`,
 		`SourceHydrator:` + strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1) + `,`,
+		`DependsOn:` + repeatedStringForDependsOn + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20432,6 +22685,7 @@ func (this *ClusterCacheInfo) String() string {
 		`ResourcesCount:` + fmt.Sprintf("%v", this.ResourcesCount) + `,`,
 		`APIsCount:` + fmt.Sprintf("%v", this.APIsCount) + `,`,
 		`LastCacheSyncTime:` + strings.Replace(fmt.Sprintf("%v", this.LastCacheSyncTime), "Time", "v1.Time", 1) + `,`,
+		`NodesCount:` + fmt.Sprintf("%v", this.NodesCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20472,6 +22726,9 @@ func (this *ClusterGenerator) String() string {
 		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
 		`Values:` + mapStringForValues + `,`,
 		`FlatList:` + fmt.Sprintf("%v", this.FlatList) + `,`,
+		`Info:` + fmt.Sprintf("%v", this.Info) + `,`,
+		`RequiredAPIVersions:` + fmt.Sprintf("%v", this.RequiredAPIVersions) + `,`,
+		`SkipUnreachable:` + fmt.Sprintf("%v", this.SkipUnreachable) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20592,10 +22849,16 @@ func (this *DrySource) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSources := "[]ApplicationSource{"
+	for _, f := range this.Sources {
+		repeatedStringForSources += strings.Replace(strings.Replace(f.String(), "ApplicationSource", "ApplicationSource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSources += "}"
 	s := strings.Join([]string{`&DrySource{`,
 		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
 		`TargetRevision:` + fmt.Sprintf("%v", this.TargetRevision) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Sources:` + repeatedStringForSources + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20804,6 +23067,32 @@ func (this *HelmParameter) String() string {
 	}, "")
 	return s
 }
+func (this *HelmRepositoryGenerator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForValues := make([]string, 0, len(this.Values))
+	for k := range this.Values {
+		keysForValues = append(keysForValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+	mapStringForValues := "map[string]string{"
+	for _, k := range keysForValues {
+		mapStringForValues += fmt.Sprintf("%v: %v,", k, this.Values[k])
+	}
+	mapStringForValues += "}"
+	s := strings.Join([]string{`&HelmRepositoryGenerator{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Charts:` + fmt.Sprintf("%v", this.Charts) + `,`,
+		`VersionConstraint:` + fmt.Sprintf("%v", this.VersionConstraint) + `,`,
+		`AllVersions:` + fmt.Sprintf("%v", this.AllVersions) + `,`,
+		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Values:` + mapStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HostInfo) String() string {
 	if this == nil {
 		return "nil"
@@ -20846,6 +23135,18 @@ func (this *HydrateOperation) String() string {
 		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
 		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
 		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1), `&`, ``, 1) + `,`,
+		`PullRequestURL:` + fmt.Sprintf("%v", this.PullRequestURL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydratePullRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydratePullRequest{`,
+		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
+		`API:` + fmt.Sprintf("%v", this.API) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20856,6 +23157,19 @@ func (this *HydrateTo) String() string {
 	}
 	s := strings.Join([]string{`&HydrateTo{`,
 		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
+		`Layout:` + strings.Replace(this.Layout.String(), "HydratedManifestLayout", "HydratedManifestLayout", 1) + `,`,
+		`PullRequest:` + strings.Replace(this.PullRequest.String(), "HydratePullRequest", "HydratePullRequest", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HydratedManifestLayout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HydratedManifestLayout{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Kustomization:` + fmt.Sprintf("%v", this.Kustomization) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20932,6 +23246,45 @@ func (this *KnownTypeField) String() string {
 	}, "")
 	return s
 }
+func (this *KubernetesResourceGenerator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForParams := make([]string, 0, len(this.Params))
+	for k := range this.Params {
+		keysForParams = append(keysForParams, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForParams)
+	mapStringForParams := "map[string]string{"
+	for _, k := range keysForParams {
+		mapStringForParams += fmt.Sprintf("%v: %v,", k, this.Params[k])
+	}
+	mapStringForParams += "}"
+	keysForValues := make([]string, 0, len(this.Values))
+	for k := range this.Values {
+		keysForValues = append(keysForValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+	mapStringForValues := "map[string]string{"
+	for _, k := range keysForValues {
+		mapStringForValues += fmt.Sprintf("%v: %v,", k, this.Values[k])
+	}
+	mapStringForValues += "}"
+	s := strings.Join([]string{`&KubernetesResourceGenerator{`,
+		`APIVersion:` + fmt.Sprintf("%v", this.APIVersion) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Cluster:` + fmt.Sprintf("%v", this.Cluster) + `,`,
+		`LabelSelector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LabelSelector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`Condition:` + fmt.Sprintf("%v", this.Condition) + `,`,
+		`Params:` + mapStringForParams + `,`,
+		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Values:` + mapStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *KustomizeGvk) String() string {
 	if this == nil {
 		return "nil"
@@ -21125,6 +23478,63 @@ func (this *NestedMergeGenerator) String() string {
 	}, "")
 	return s
 }
+func (this *OCIGenerator) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFilters := "[]OCIGeneratorFilter{"
+	for _, f := range this.Filters {
+		repeatedStringForFilters += strings.Replace(strings.Replace(f.String(), "OCIGeneratorFilter", "OCIGeneratorFilter", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFilters += "}"
+	keysForValues := make([]string, 0, len(this.Values))
+	for k := range this.Values {
+		keysForValues = append(keysForValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForValues)
+	mapStringForValues := "map[string]string{"
+	for _, k := range keysForValues {
+		mapStringForValues += fmt.Sprintf("%v: %v,", k, this.Values[k])
+	}
+	mapStringForValues += "}"
+	s := strings.Join([]string{`&OCIGenerator{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Repositories:` + fmt.Sprintf("%v", this.Repositories) + `,`,
+		`Filters:` + repeatedStringForFilters + `,`,
+		`LatestOnly:` + fmt.Sprintf("%v", this.LatestOnly) + `,`,
+		`BasicAuth:` + strings.Replace(this.BasicAuth.String(), "OCIGeneratorBasicAuth", "OCIGeneratorBasicAuth", 1) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`PlainHTTP:` + fmt.Sprintf("%v", this.PlainHTTP) + `,`,
+		`RequeueAfterSeconds:` + valueToStringGenerated(this.RequeueAfterSeconds) + `,`,
+		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ApplicationSetTemplate", "ApplicationSetTemplate", 1), `&`, ``, 1) + `,`,
+		`Values:` + mapStringForValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCIGeneratorBasicAuth) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OCIGeneratorBasicAuth{`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`PasswordRef:` + strings.Replace(this.PasswordRef.String(), "SecretRef", "SecretRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCIGeneratorFilter) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OCIGeneratorFilter{`,
+		`RepositoryMatch:` + valueToStringGenerated(this.RepositoryMatch) + `,`,
+		`TagMatch:` + valueToStringGenerated(this.TagMatch) + `,`,
+		`TagSemver:` + valueToStringGenerated(this.TagSemver) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Operation) String() string {
 	if this == nil {
 		return "nil"
@@ -21772,6 +24182,25 @@ func (this *ResourceOverride) String() string {
 	}, "")
 	return s
 }
+func (this *ResourcePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForKinds := "[]GroupKind{"
+	for _, f := range this.Kinds {
+		repeatedStringForKinds += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForKinds += "}"
+	s := strings.Join([]string{`&ResourcePolicy{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Kinds:` + repeatedStringForKinds + `,`,
+		`Condition:` + fmt.Sprintf("%v", this.Condition) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ResourceRef) String() string {
 	if this == nil {
 		return "nil"
@@ -22083,6 +24512,7 @@ func (this *SuccessfulHydrateOperation) String() string {
 		`DrySHA:` + fmt.Sprintf("%v", this.DrySHA) + `,`,
 		`HydratedSHA:` + fmt.Sprintf("%v", this.HydratedSHA) + `,`,
 		`SourceHydrator:` + strings.Replace(strings.Replace(this.SourceHydrator.String(), "SourceHydrator", "SourceHydrator", 1), `&`, ``, 1) + `,`,
+		`PullRequestURL:` + fmt.Sprintf("%v", this.PullRequestURL) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22188,6 +24618,7 @@ func (this *SyncSource) String() string {
 	s := strings.Join([]string{`&SyncSource{`,
 		`TargetBranch:` + fmt.Sprintf("%v", this.TargetBranch) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Layout:` + strings.Replace(this.Layout.String(), "HydratedManifestLayout", "HydratedManifestLayout", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22240,6 +24671,11 @@ func (this *SyncWindow) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForDateRanges := "[]SyncWindowDateRange{"
+	for _, f := range this.DateRanges {
+		repeatedStringForDateRanges += strings.Replace(strings.Replace(f.String(), "SyncWindowDateRange", "SyncWindowDateRange", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDateRanges += "}"
 	s := strings.Join([]string{`&SyncWindow{`,
 		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
 		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
@@ -22251,6 +24687,43 @@ func (this *SyncWindow) String() string {
 		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
 		`UseAndOperator:` + fmt.Sprintf("%v", this.UseAndOperator) + `,`,
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`DateRanges:` + repeatedStringForDateRanges + `,`,
+		`Calendar:` + strings.Replace(this.Calendar.String(), "SyncWindowCalendar", "SyncWindowCalendar", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWindowCalendar) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindowCalendar{`,
+		`ConfigMap:` + fmt.Sprintf("%v", this.ConfigMap) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWindowDateRange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindowDateRange{`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SyncWindowPeriod) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SyncWindowPeriod{`,
+		`Start:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Start), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`End:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.End), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -23337,6 +25810,72 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcePolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourcePolicies = append(m.ResourcePolicies, ResourcePolicy{})
+			if err := m.ResourcePolicies[len(m.ResourcePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuePriority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuePriority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23872,7 +26411,7 @@ func (m *ApplicationCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDependency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23895,15 +26434,15 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDependency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDependency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23931,13 +26470,13 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23947,55 +26486,27 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24018,7 +26529,7 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24041,10 +26552,10 @@ func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDestination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDestination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24113,7 +26624,7 @@ func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultServiceAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24141,7 +26652,7 @@ func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DefaultServiceAccount = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24164,7 +26675,7 @@ func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationList) Unmarshal(dAtA []byte) error {
+func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24187,17 +26698,17 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24207,30 +26718,29 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24240,25 +26750,55 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Application{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultServiceAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultServiceAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24281,7 +26821,7 @@ func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
+func (m *ApplicationList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24304,17 +26844,17 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24324,29 +26864,30 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24356,55 +26897,25 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, Application{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24427,7 +26938,7 @@ func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
+func (m *ApplicationMatchExpression) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24450,15 +26961,15 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationMatchExpression: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationMatchExpression: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24486,11 +26997,11 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Annotations = append(m.Annotations, string(dAtA[iNdEx:postIndex]))
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24518,7 +27029,39 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24541,7 +27084,7 @@ func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
+func (m *ApplicationPreservedFields) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24564,17 +27107,17 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationPreservedFields: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationPreservedFields: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24584,63 +27127,29 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Annotations = append(m.Annotations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24650,24 +27159,23 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24690,7 +27198,7 @@ func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24713,17 +27221,17 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetApplicationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24733,27 +27241,28 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Application = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24780,82 +27289,15 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastTransitionTime == nil {
-				m.LastTransitionTime = &v1.Time{}
-			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
-			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24865,55 +27307,24 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Step = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevisions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TargetRevisions = append(m.TargetRevisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24936,7 +27347,7 @@ func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetApplicationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24959,15 +27370,15 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetApplicationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24995,9 +27406,45 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ApplicationSetConditionType(dAtA[iNdEx:postIndex])
+			m.Application = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
@@ -25029,11 +27476,11 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			}
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25043,31 +27490,27 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastTransitionTime == nil {
-				m.LastTransitionTime = &v1.Time{}
-			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25095,11 +27538,11 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ApplicationSetConditionStatus(dAtA[iNdEx:postIndex])
+			m.Step = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevisions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25127,7 +27570,7 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.TargetRevisions = append(m.TargetRevisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25150,7 +27593,7 @@ func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25173,17 +27616,17 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25193,33 +27636,29 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.List == nil {
-				m.List = &ListGenerator{}
-			}
-			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = ApplicationSetConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25229,31 +27668,249 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clusters == nil {
-				m.Clusters = &ClusterGenerator{}
-			}
-			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ApplicationSetConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.List == nil {
+				m.List = &ListGenerator{}
+			}
+			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clusters == nil {
+				m.Clusters = &ClusterGenerator{}
+			}
+			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25539,6 +28196,114 @@ func (m *ApplicationSetGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OCI == nil {
+				m.OCI = &OCIGenerator{}
+			}
+			if err := m.OCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmRepository", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HelmRepository == nil {
+				m.HelmRepository = &HelmRepositoryGenerator{}
+			}
+			if err := m.HelmRepository.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesResource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubernetesResource == nil {
+				m.KubernetesResource = &KubernetesResourceGenerator{}
+			}
+			if err := m.KubernetesResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26066,6 +28831,114 @@ func (m *ApplicationSetNestedGenerator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OCI == nil {
+				m.OCI = &OCIGenerator{}
+			}
+			if err := m.OCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmRepository", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HelmRepository == nil {
+				m.HelmRepository = &HelmRepositoryGenerator{}
+			}
+			if err := m.HelmRepository.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesResource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubernetesResource == nil {
+				m.KubernetesResource = &KubernetesResourceGenerator{}
+			}
+			if err := m.KubernetesResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26233,7 +29106,7 @@ func (m *ApplicationSetResourceIgnoreDifferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutAnalysis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26256,17 +29129,17 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutAnalysis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchExpressions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26276,31 +29149,29 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MatchExpressions = append(m.MatchExpressions, ApplicationMatchExpression{})
-			if err := m.MatchExpressions[len(m.MatchExpressions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26310,27 +29181,23 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxUpdate == nil {
-				m.MaxUpdate = &intstr.IntOrString{}
-			}
-			if err := m.MaxUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Interval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26353,7 +29220,7 @@ func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26376,15 +29243,15 @@ func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchExpressions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26411,84 +29278,14 @@ func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, ApplicationSetRolloutStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MatchExpressions = append(m.MatchExpressions, ApplicationMatchExpression{})
+			if err := m.MatchExpressions[len(m.MatchExpressions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplate", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.GoTemplate = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26515,16 +29312,18 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxUpdate == nil {
+				m.MaxUpdate = &intstr.IntOrString{}
+			}
+			if err := m.MaxUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseDuration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26534,30 +29333,29 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PauseDuration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireApproval", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26567,31 +29365,15 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SyncPolicy == nil {
-				m.SyncPolicy = &ApplicationSetSyncPolicy{}
-			}
-			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.RequireApproval = bool(v != 0)
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26618,52 +29400,66 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Strategy == nil {
-				m.Strategy = &ApplicationSetStrategy{}
+			if m.Analysis == nil {
+				m.Analysis = &ApplicationSetRolloutAnalysis{}
 			}
-			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreservedFields", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreservedFields == nil {
-				m.PreservedFields = &ApplicationPreservedFields{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetRolloutStepRollback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.PreservedFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetRolloutStepRollback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetRolloutStepRollback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplateOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26691,13 +29487,13 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GoTemplateOptions = append(m.GoTemplateOptions, string(dAtA[iNdEx:postIndex]))
+			m.Applications = append(m.Applications, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplyNestedSelectors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
 			}
-			var v int
+			m.Generation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26707,17 +29503,16 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Generation |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ApplyNestedSelectors = bool(v != 0)
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreApplicationDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingApplications", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26727,31 +29522,29 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreApplicationDifferences = append(m.IgnoreApplicationDifferences, ApplicationSetResourceIgnoreDifferences{})
-			if err := m.IgnoreApplicationDifferences[len(m.IgnoreApplicationDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PendingApplications = append(m.PendingApplications, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutStartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26761,24 +29554,27 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.TemplatePatch = &s
+			if m.RolloutStartTime == nil {
+				m.RolloutStartTime = &v1.Time{}
+			}
+			if err := m.RolloutStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26801,7 +29597,7 @@ func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutStepStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26824,15 +29620,111 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutStepStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutStepStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26859,14 +29751,36 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26893,14 +29807,16 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApplicationStatus = append(m.ApplicationStatus, ApplicationSetApplicationStatus{})
-			if err := m.ApplicationStatus[len(m.ApplicationStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Rollback == nil {
+				m.Rollback = &ApplicationSetRolloutStepRollback{}
+			}
+			if err := m.Rollback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAnalysisTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26927,8 +29843,10 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ResourceStatus{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastAnalysisTime == nil {
+				m.LastAnalysisTime = &v1.Time{}
+			}
+			if err := m.LastAnalysisTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -26953,7 +29871,7 @@ func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetRolloutStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26976,17 +29894,17 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetRolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26996,29 +29914,31 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Steps = append(m.Steps, ApplicationSetRolloutStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollingSync", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackOnFailure", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27028,28 +29948,12 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RollingSync == nil {
-				m.RollingSync = &ApplicationSetRolloutStrategy{}
-			}
-			if err := m.RollingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.RollbackOnFailure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27071,7 +29975,7 @@ func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27094,15 +29998,15 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplate", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -27119,12 +30023,12 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.PreserveResourcesOnDeletion = bool(v != 0)
+			m.GoTemplate = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27134,78 +30038,29 @@ func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := ApplicationsSyncPolicy(dAtA[iNdEx:postIndex])
-			m.ApplicationsSync = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.Generators = append(m.Generators, ApplicationSetGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27232,13 +30087,13 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27265,65 +30120,54 @@ func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &ApplicationSetSyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Strategy == nil {
+				m.Strategy = &ApplicationSetStrategy{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTemplateMeta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTemplateMeta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreservedFields", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27333,27 +30177,31 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.PreservedFields == nil {
+				m.PreservedFields = &ApplicationPreservedFields{}
+			}
+			if err := m.PreservedFields.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GoTemplateOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27381,11 +30229,31 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.GoTemplateOptions = append(m.GoTemplateOptions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyNestedSelectors", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ApplyNestedSelectors = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreApplicationDifferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27412,234 +30280,14 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.IgnoreApplicationDifferences = append(m.IgnoreApplicationDifferences, ApplicationSetResourceIgnoreDifferences{})
+			if err := m.IgnoreApplicationDifferences[len(m.IgnoreApplicationDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27667,7 +30315,8 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Finalizers = append(m.Finalizers, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.TemplatePatch = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27690,7 +30339,7 @@ func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27713,15 +30362,15 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27748,16 +30397,14 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.List == nil {
-				m.List = &ListGenerator{}
-			}
-			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, ApplicationSetCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27784,16 +30431,14 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clusters == nil {
-				m.Clusters = &ClusterGenerator{}
-			}
-			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ApplicationStatus = append(m.ApplicationStatus, ApplicationSetApplicationStatus{})
+			if err := m.ApplicationStatus[len(m.ApplicationStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27820,16 +30465,14 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitGenerator{}
-			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutSteps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27856,54 +30499,66 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SCMProvider == nil {
-				m.SCMProvider = &SCMProviderGenerator{}
-			}
-			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RolloutSteps = append(m.RolloutSteps, ApplicationSetRolloutStepStatus{})
+			if err := m.RolloutSteps[len(m.RolloutSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClusterDecisionResource == nil {
-				m.ClusterDecisionResource = &DuckTypeGenerator{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27913,31 +30568,27 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PullRequest == nil {
-				m.PullRequest = &PullRequestGenerator{}
-			}
-			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingSync", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27964,16 +30615,16 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &PluginGenerator{}
+			if m.RollingSync == nil {
+				m.RollingSync = &ApplicationSetRolloutStrategy{}
 			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RollingSync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollingUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28000,10 +30651,10 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
+			if m.RollingUpdate == nil {
+				m.RollingUpdate = &ApplicationSetRolloutStrategy{}
 			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RollingUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28028,7 +30679,7 @@ func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetSyncPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28051,15 +30702,68 @@ func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSetTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSetTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetSyncPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreserveResourcesOnDeletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreserveResourcesOnDeletion = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsSync", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := ApplicationsSyncPolicy(dAtA[iNdEx:postIndex])
+			m.ApplicationsSync = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeletions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28086,8 +30790,10 @@ func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, ResourceNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxDeletions == nil {
+				m.MaxDeletions = &intstr.IntOrString{}
+			}
+			if err := m.MaxDeletions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28112,7 +30818,7 @@ func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28135,17 +30841,17 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationSetTemplateMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28155,29 +30861,30 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if err := m.ApplicationSetTemplateMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28187,97 +30894,80 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRevision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTemplateMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Helm == nil {
-				m.Helm = &ApplicationSourceHelm{}
-			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTemplateMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTemplateMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28287,33 +30977,29 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kustomize == nil {
-				m.Kustomize = &ApplicationSourceKustomize{}
-			}
-			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28323,31 +31009,27 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Directory == nil {
-				m.Directory = &ApplicationSourceDirectory{}
-			}
-			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28374,18 +31056,109 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &ApplicationSourcePlugin{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28395,59 +31168,122 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chart = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ref = string(dAtA[iNdEx:postIndex])
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28475,7 +31311,7 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Finalizers = append(m.Finalizers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28498,7 +31334,7 @@ func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSetTerminalGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28521,17 +31357,17 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSetTerminalGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28541,15 +31377,31 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Recurse = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.List == nil {
+				m.List = &ListGenerator{}
+			}
+			if err := m.List.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28576,15 +31428,18 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Clusters == nil {
+				m.Clusters = &ClusterGenerator{}
+			}
+			if err := m.Clusters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28594,29 +31449,33 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exclude = string(dAtA[iNdEx:postIndex])
+			if m.Git == nil {
+				m.Git = &GitGenerator{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SCMProvider", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28626,79 +31485,33 @@ func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.SCMProvider == nil {
+				m.SCMProvider = &SCMProviderGenerator{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.SCMProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDecisionResource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28708,27 +31521,31 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			if m.ClusterDecisionResource == nil {
+				m.ClusterDecisionResource = &DuckTypeGenerator{}
+			}
+			if err := m.ClusterDecisionResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28755,16 +31572,18 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, HelmParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PullRequest == nil {
+				m.PullRequest = &PullRequestGenerator{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28774,29 +31593,33 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReleaseName = string(dAtA[iNdEx:postIndex])
+			if m.Plugin == nil {
+				m.Plugin = &PluginGenerator{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28806,27 +31629,31 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = string(dAtA[iNdEx:postIndex])
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OCI", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28853,14 +31680,222 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileParameters = append(m.FileParameters, HelmFileParameter{})
-			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OCI == nil {
+				m.OCI = &OCIGenerator{}
+			}
+			if err := m.OCI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmRepository", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HelmRepository == nil {
+				m.HelmRepository = &HelmRepositoryGenerator{}
+			}
+			if err := m.HelmRepository.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesResource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubernetesResource == nil {
+				m.KubernetesResource = &KubernetesResourceGenerator{}
+			}
+			if err := m.KubernetesResource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSetTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, ResourceNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28888,13 +31923,13 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PassCredentials", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28904,17 +31939,29 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PassCredentials = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingValueFiles", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28924,17 +31971,29 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IgnoreMissingValueFiles = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipCrds", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28944,15 +32003,31 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SkipCrds = bool(v != 0)
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Helm == nil {
+				m.Helm = &ApplicationSourceHelm{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValuesObject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28979,18 +32054,18 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValuesObject == nil {
-				m.ValuesObject = &runtime.RawExtension{}
+			if m.Kustomize == nil {
+				m.Kustomize = &ApplicationSourceKustomize{}
 			}
-			if err := m.ValuesObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29000,29 +32075,33 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if m.Directory == nil {
+				m.Directory = &ApplicationSourceDirectory{}
+			}
+			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29032,27 +32111,31 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			if m.Plugin == nil {
+				m.Plugin = &ApplicationSourcePlugin{}
+			}
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 13:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29080,13 +32163,13 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			m.Chart = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipTests", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29096,17 +32179,29 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SkipTests = bool(v != 0)
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkipSchemaValidation", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ref = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29116,12 +32211,24 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SkipSchemaValidation = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29143,7 +32250,7 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourceDirectory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29166,15 +32273,35 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceJsonnet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourceDirectory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceJsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourceDirectory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Recurse = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtVars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jsonnet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29201,16 +32328,15 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtVars = append(m.ExtVars, JsonnetVar{})
-			if err := m.ExtVars[len(m.ExtVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Jsonnet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLAs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29220,29 +32346,27 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLAs = append(m.TLAs, JsonnetVar{})
-			if err := m.TLAs[len(m.TLAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Exclude = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Libs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29270,7 +32394,7 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Libs = append(m.Libs, string(dAtA[iNdEx:postIndex]))
+			m.Include = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29293,7 +32417,7 @@ func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
+func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29316,15 +32440,15 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourceKustomize: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationSourceHelm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourceKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationSourceHelm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29352,13 +32476,13 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameSuffix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29368,27 +32492,29 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NameSuffix = string(dAtA[iNdEx:postIndex])
+			m.Parameters = append(m.Parameters, HelmParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29416,13 +32542,13 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, KustomizeImage(dAtA[iNdEx:postIndex]))
+			m.ReleaseName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29432,124 +32558,29 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommonLabels == nil {
-				m.CommonLabels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.CommonLabels[mapkey] = mapvalue
+			m.Values = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29559,29 +32590,31 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.FileParameters = append(m.FileParameters, HelmFileParameter{})
+			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29591,122 +32624,27 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommonAnnotations == nil {
-				m.CommonAnnotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.CommonAnnotations[mapkey] = mapvalue
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonLabels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PassCredentials", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -29723,10 +32661,10 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ForceCommonLabels = bool(v != 0)
+			m.PassCredentials = bool(v != 0)
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingValueFiles", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -29743,12 +32681,12 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ForceCommonAnnotations = bool(v != 0)
+			m.IgnoreMissingValueFiles = bool(v != 0)
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCrds", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29758,29 +32696,17 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.SkipCrds = bool(v != 0)
 		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotationsEnvsubst", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesObject", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29790,17 +32716,33 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CommonAnnotationsEnvsubst = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValuesObject == nil {
+				m.ValuesObject = &runtime.RawExtension{}
+			}
+			if err := m.ValuesObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29810,31 +32752,29 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Replicas = append(m.Replicas, KustomizeReplica{})
-			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29844,29 +32784,27 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patches = append(m.Patches, KustomizePatch{})
-			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29894,11 +32832,11 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Components = append(m.Components, string(dAtA[iNdEx:postIndex]))
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelWithoutSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipTests", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -29915,12 +32853,82 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.LabelWithoutSelector = bool(v != 0)
+			m.SkipTests = bool(v != 0)
 		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipSchemaValidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipSchemaValidation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceJsonnet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceJsonnet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceJsonnet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtVars", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29930,27 +32938,63 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			m.ExtVars = append(m.ExtVars, JsonnetVar{})
+			if err := m.ExtVars[len(m.ExtVars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLAs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLAs = append(m.TLAs, JsonnetVar{})
+			if err := m.TLAs[len(m.TLAs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Libs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29978,13 +33022,63 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			m.Libs = append(m.Libs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingComponents", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourceKustomize: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourceKustomize: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamePrefix", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29994,17 +33088,2719 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IgnoreMissingComponents = bool(v != 0)
-		case 18:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelIncludeTemplates", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameSuffix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NameSuffix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, KustomizeImage(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommonLabels == nil {
+				m.CommonLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CommonLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommonAnnotations == nil {
+				m.CommonAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CommonAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonLabels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceCommonLabels = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceCommonAnnotations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceCommonAnnotations = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommonAnnotationsEnvsubst", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CommonAnnotationsEnvsubst = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replicas = append(m.Replicas, KustomizeReplica{})
+			if err := m.Replicas[len(m.Replicas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patches = append(m.Patches, KustomizePatch{})
+			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Components", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Components = append(m.Components, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelWithoutSelector", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LabelWithoutSelector = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreMissingComponents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreMissingComponents = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelIncludeTemplates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LabelIncludeTemplates = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourcePlugin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourcePlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Env = append(m.Env, &EnvEntry{})
+			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, ApplicationSourcePluginParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSourcePluginParameter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSourcePluginParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalMap == nil {
+				m.OptionalMap = &OptionalMap{}
+			}
+			if err := m.OptionalMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptionalArray", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OptionalArray == nil {
+				m.OptionalArray = &OptionalArray{}
+			}
+			if err := m.OptionalArray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.String_ = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Project = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SyncPolicy == nil {
+				m.SyncPolicy = &SyncPolicy{}
+			}
+			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
+			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, Info{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevisionHistoryLimit = &v
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SourceHydrator == nil {
+				m.SourceHydrator = &SourceHydrator{}
+			}
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, ApplicationDependency{})
+			if err := m.DependsOn[len(m.DependsOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, ResourceStatus{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, RevisionHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, ApplicationCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconciledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReconciledAt == nil {
+				m.ReconciledAt = &v1.Time{}
+			}
+			if err := m.ReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationState == nil {
+				m.OperationState = &OperationState{}
+			}
+			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ObservedAt == nil {
+				m.ObservedAt = &v1.Time{}
+			}
+			if err := m.ObservedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceType = ApplicationSourceType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHealthSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceHealthSource = ResourceHealthLocation(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceTypes = append(m.SourceTypes, ApplicationSourceType(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationTree: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationTree: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, ResourceNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrphanedNodes = append(m.OrphanedNodes, ResourceNode{})
+			if err := m.OrphanedNodes[len(m.OrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hosts = append(m.Hosts, HostInfo{})
+			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardsCount", wireType)
+			}
+			m.ShardsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Factor = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PasswordRef == nil {
+				m.PasswordRef = &SecretRef{}
+			}
+			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BearerTokenBitbucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BearerTokenBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BearerTokenBitbucketCloud: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30014,12 +35810,28 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LabelIncludeTemplates = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TokenRef == nil {
+				m.TokenRef = &SecretRef{}
+			}
+			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30041,7 +35853,7 @@ func (m *ApplicationSourceKustomize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
+func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30064,15 +35876,15 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourcePlugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChartDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourcePlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChartDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30100,13 +35912,13 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30116,31 +35928,29 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, &EnvEntry{})
-			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Home = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30150,25 +35960,23 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, ApplicationSourcePluginParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30191,7 +35999,7 @@ func (m *ApplicationSourcePlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
+func (m *Cluster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30214,13 +36022,45 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSourcePluginParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSourcePluginParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -30254,7 +36094,7 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionalMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30281,16 +36121,13 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OptionalMap == nil {
-				m.OptionalMap = &OptionalMap{}
-			}
-			if err := m.OptionalMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptionalArray", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30317,16 +36154,13 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OptionalArray == nil {
-				m.OptionalArray = &OptionalArray{}
-			}
-			if err := m.OptionalArray.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30354,62 +36188,43 @@ func (m *ApplicationSourcePluginParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.String_ = &s
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefreshRequestedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30436,16 +36251,16 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &ApplicationSource{}
+			if m.RefreshRequestedAt == nil {
+				m.RefreshRequestedAt = &v1.Time{}
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RefreshRequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30472,11 +36287,51 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Shard = &v
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterResources", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterResources = bool(v != 0)
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
@@ -30508,9 +36363,9 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			}
 			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30537,16 +36392,107 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SyncPolicy == nil {
-				m.SyncPolicy = &SyncPolicy{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.SyncPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30573,16 +36519,159 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
-			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCacheInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
 			}
-			var msglen int
+			m.ResourcesCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30592,31 +36681,16 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ResourcesCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Info = append(m.Info, Info{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIsCount", wireType)
 			}
-			var v int64
+			m.APIsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30626,15 +36700,14 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				m.APIsCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RevisionHistoryLimit = &v
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCacheSyncTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30661,16 +36734,18 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LastCacheSyncTime == nil {
+				m.LastCacheSyncTime = &v1.Time{}
+			}
+			if err := m.LastCacheSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodesCount", wireType)
 			}
-			var msglen int
+			m.NodesCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30680,28 +36755,11 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NodesCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SourceHydrator == nil {
-				m.SourceHydrator = &SourceHydrator{}
-			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30723,7 +36781,7 @@ func (m *ApplicationSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
+func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30746,17 +36804,17 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30766,31 +36824,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ResourceStatus{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30800,30 +36856,29 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sync.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Password = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30833,28 +36888,27 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BearerToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30881,14 +36935,13 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.History = append(m.History, RevisionHistory{})
-			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30915,14 +36968,16 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ApplicationCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AWSAuthConfig == nil {
+				m.AWSAuthConfig = &AWSAuthConfig{}
+			}
+			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReconciledAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecProviderConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30949,18 +37004,18 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ReconciledAt == nil {
-				m.ReconciledAt = &v1.Time{}
+			if m.ExecProviderConfig == nil {
+				m.ExecProviderConfig = &ExecProviderConfig{}
 			}
-			if err := m.ReconciledAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationState", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableCompression", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30970,33 +37025,17 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.OperationState == nil {
-				m.OperationState = &OperationState{}
-			}
-			if err := m.OperationState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.DisableCompression = bool(v != 0)
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyUrl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31006,63 +37045,77 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObservedAt == nil {
-				m.ObservedAt = &v1.Time{}
-			}
-			if err := m.ObservedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProxyUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceType = ApplicationSourceType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31089,15 +37142,15 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Summary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceHealthSource", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31107,29 +37160,30 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceHealthSource = ResourceHealthLocation(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31139,61 +37193,124 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceTypes = append(m.SourceTypes, ApplicationSourceType(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerNamespace", wireType)
+			if m.Values == nil {
+				m.Values = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ControllerNamespace = string(dAtA[iNdEx:postIndex])
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatList", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31203,80 +37320,17 @@ func (m *ApplicationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationSummary: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationSummary: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+			m.FlatList = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31286,27 +37340,15 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
+			m.Info = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAPIVersions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31334,8 +37376,28 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.RequiredAPIVersions = append(m.RequiredAPIVersions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipUnreachable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipUnreachable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31357,7 +37419,7 @@ func (m *ApplicationSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
+func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31380,15 +37442,15 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationTree: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationTree: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31415,16 +37477,15 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, ResourceNode{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrphanedNodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31434,29 +37495,27 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrphanedNodes = append(m.OrphanedNodes, ResourceNode{})
-			if err := m.OrphanedNodes[len(m.OrphanedNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServerVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hosts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31483,16 +37542,15 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hosts = append(m.Hosts, HostInfo{})
-			if err := m.Hosts[len(m.Hosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CacheInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardsCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsCount", wireType)
 			}
-			m.ShardsCount = 0
+			m.ApplicationsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31502,11 +37560,43 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardsCount |= int64(b&0x7F) << shift
+				m.ApplicationsCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31528,7 +37618,7 @@ func (m *ApplicationTree) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
+func (m *ClusterList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31551,17 +37641,17 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationWatchEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31571,27 +37661,28 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = k8s_io_apimachinery_pkg_watch.EventType(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31618,7 +37709,8 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Cluster{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31643,7 +37735,7 @@ func (m *ApplicationWatchEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *Command) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31666,15 +37758,15 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+			return fmt.Errorf("proto: Command: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31702,31 +37794,11 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Factor = &v
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31754,7 +37826,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31777,7 +37849,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
+func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31800,17 +37872,17 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BasicAuthBitbucketServer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31820,27 +37892,28 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31867,66 +37940,13 @@ func (m *BasicAuthBitbucketServer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordRef == nil {
-				m.PasswordRef = &SecretRef{}
-			}
-			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BearerTokenBitbucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BearerTokenBitbucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31950,69 +37970,17 @@ func (m *BearerTokenBitbucket) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BearerTokenBitbucketCloud: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BearerTokenBitbucketCloud: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32039,10 +38007,8 @@ func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TokenRef == nil {
-				m.TokenRef = &SecretRef{}
-			}
-			if err := m.TokenRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
+			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32067,7 +38033,7 @@ func (m *BearerTokenBitbucketCloud) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChartDetails) Unmarshal(dAtA []byte) error {
+func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32090,15 +38056,15 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChartDetails: wiretype end group for non-group")
+			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartDetails: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32126,11 +38092,11 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Component = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Home", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32158,11 +38124,11 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Home = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maintainers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32190,7 +38156,7 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Maintainers = append(m.Maintainers, string(dAtA[iNdEx:postIndex]))
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32213,7 +38179,7 @@ func (m *ChartDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cluster) Unmarshal(dAtA []byte) error {
+func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32236,15 +38202,15 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32272,13 +38238,13 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32288,27 +38254,31 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Init == nil {
+				m.Init = &Command{}
+			}
+			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32335,15 +38305,15 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRepo", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32353,28 +38323,65 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.LockRepo = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigMapKeyRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigMapKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32402,11 +38409,11 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32434,120 +38441,61 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefreshRequestedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RefreshRequestedAt == nil {
-				m.RefreshRequestedAt = &v1.Time{}
-			}
-			if err := m.RefreshRequestedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			m.Shard = &v
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterResources", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.ClusterResources = bool(v != 0)
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32575,13 +38523,13 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Project = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32591,122 +38539,27 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32733,103 +38586,12 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.ModifiedAt == nil {
+				m.ModifiedAt = &v1.Time{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32852,7 +38614,7 @@ func (m *Cluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
+func (m *DrySource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32875,17 +38637,17 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCacheInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DrySource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCacheInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DrySource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			m.ResourcesCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32895,16 +38657,29 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResourcesCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIsCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
 			}
-			m.APIsCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32914,14 +38689,59 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.APIsCount |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastCacheSyncTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32948,10 +38768,8 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastCacheSyncTime == nil {
-				m.LastCacheSyncTime = &v1.Time{}
-			}
-			if err := m.LastCacheSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sources = append(m.Sources, ApplicationSource{})
+			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32976,7 +38794,7 @@ func (m *ClusterCacheInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
+func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32999,15 +38817,15 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33035,11 +38853,11 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33067,13 +38885,13 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BearerToken", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33083,27 +38901,15 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BearerToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.RequeueAfterSeconds = &v
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33130,13 +38936,13 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TLSClientConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AWSAuthConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33163,16 +38969,13 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AWSAuthConfig == nil {
-				m.AWSAuthConfig = &AWSAuthConfig{}
-			}
-			if err := m.AWSAuthConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33199,18 +39002,159 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecProviderConfig == nil {
-				m.ExecProviderConfig = &ExecProviderConfig{}
+			if m.Values == nil {
+				m.Values = make(map[string]string)
 			}
-			if err := m.ExecProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableCompression", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnvEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33220,15 +39164,27 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableCompression = bool(v != 0)
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProxyUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33256,7 +39212,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProxyUrl = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33279,7 +39235,7 @@ func (m *ClusterConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
+func (m *ErrApplicationNotAllowedToUseProject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33302,17 +39258,67 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ErrApplicationNotAllowedToUseProject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ErrApplicationNotAllowedToUseProject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33322,30 +39328,29 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Command = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33355,28 +39360,27 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33403,8 +39407,8 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
+			if m.Env == nil {
+				m.Env = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -33486,127 +39490,24 @@ func (m *ClusterGenerator) Unmarshal(dAtA []byte) error {
 					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Values[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlatList", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FlatList = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionState", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ConnectionState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Env[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33634,63 +39535,11 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CacheInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CacheInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationsCount", wireType)
-			}
-			m.ApplicationsCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ApplicationsCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33718,7 +39567,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersions = append(m.APIVersions, string(dAtA[iNdEx:postIndex]))
+			m.InstallHint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33741,7 +39590,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterList) Unmarshal(dAtA []byte) error {
+func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33764,17 +39613,17 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33784,30 +39633,29 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33817,26 +39665,12 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, Cluster{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Exclude = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33858,7 +39692,7 @@ func (m *ClusterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Command) Unmarshal(dAtA []byte) error {
+func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33881,15 +39715,15 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Command: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Command: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33917,13 +39751,13 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33933,24 +39767,12 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Exclude = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33972,7 +39794,7 @@ func (m *Command) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComparedTo) Unmarshal(dAtA []byte) error {
+func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33995,17 +39817,17 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComparedTo: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComparedTo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34015,28 +39837,27 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directories", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34063,13 +39884,14 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Directories = append(m.Directories, GitDirectoryGeneratorItem{})
+			if err := m.Directories[len(m.Directories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34096,16 +39918,16 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sources = append(m.Sources, ApplicationSource{})
-			if err := m.Sources[len(m.Sources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Files = append(m.Files, GitFileGeneratorItem{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34115,81 +39937,49 @@ func (m *ComparedTo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreDifferences = append(m.IgnoreDifferences, ResourceIgnoreDifferences{})
-			if err := m.IgnoreDifferences[len(m.IgnoreDifferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ComponentParameter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComponentParameter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.RequeueAfterSeconds = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Component", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34199,27 +39989,28 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Component = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PathParamPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34247,13 +40038,13 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PathParamPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34263,23 +40054,118 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34302,7 +40188,7 @@ func (m *ComponentParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
+func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34325,15 +40211,15 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: wiretype end group for non-group")
+			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigManagementPlugin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34361,13 +40247,13 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Init", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34377,33 +40263,61 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Init == nil {
-				m.Init = &Command{}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			if err := m.Init.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34413,30 +40327,61 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trust = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Generate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockRepo", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34446,12 +40391,24 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.LockRepo = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34473,7 +40430,7 @@ func (m *ConfigManagementPlugin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
+func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34496,17 +40453,17 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigMapKeyRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigMapKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34516,29 +40473,30 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34548,23 +40506,25 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, GnuPGPublicKey{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34587,7 +40547,7 @@ func (m *ConfigMapKeyRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConnectionState) Unmarshal(dAtA []byte) error {
+func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34610,10 +40570,10 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConnectionState: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConnectionState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34646,7 +40606,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -34682,7 +40642,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34709,10 +40669,10 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ModifiedAt == nil {
-				m.ModifiedAt = &v1.Time{}
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
 			}
-			if err := m.ModifiedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34737,7 +40697,7 @@ func (m *ConnectionState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DrySource) Unmarshal(dAtA []byte) error {
+func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34760,15 +40720,15 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DrySource: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DrySource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34796,11 +40756,11 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34828,11 +40788,61 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetRevision = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34860,7 +40870,7 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34883,7 +40893,7 @@ func (m *DrySource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
+func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34906,15 +40916,15 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DuckTypeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DuckTypeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34942,11 +40952,11 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapRef = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34974,99 +40984,13 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequeueAfterSeconds = &v
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35076,119 +41000,12 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Values[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.ForceString = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35210,7 +41027,7 @@ func (m *DuckTypeGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnvEntry) Unmarshal(dAtA []byte) error {
+func (m *HelmRepositoryGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35233,15 +41050,15 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnvEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmRepositoryGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnvEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmRepositoryGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35269,11 +41086,11 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35301,111 +41118,11 @@ func (m *EnvEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Charts = append(m.Charts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ErrApplicationNotAllowedToUseProject) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ErrApplicationNotAllowedToUseProject: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ErrApplicationNotAllowedToUseProject: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecProviderConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecProviderConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionConstraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35433,13 +41150,53 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Command = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.VersionConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllVersions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllVersions = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequeueAfterSeconds = &v
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35449,27 +41206,28 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35496,8 +41254,8 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Env == nil {
-				m.Env = make(map[string]string)
+			if m.Values == nil {
+				m.Values = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -35592,71 +41350,7 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Env[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallHint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InstallHint = string(dAtA[iNdEx:postIndex])
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35679,7 +41373,7 @@ func (m *ExecProviderConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
+func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35702,15 +41396,15 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitDirectoryGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35738,13 +41432,13 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35754,12 +41448,59 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Exclude = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
+			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35781,7 +41522,7 @@ func (m *GitDirectoryGeneratorItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
+func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35804,15 +41545,15 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitFileGeneratorItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35840,13 +41581,13 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
 			}
-			var v int
+			m.RequestedByApp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35856,12 +41597,49 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.RequestedByApp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
+			}
+			m.RequestedByNeighbors = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedByNeighbors |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Exclude = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35883,7 +41661,7 @@ func (m *GitFileGeneratorItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitGenerator) Unmarshal(dAtA []byte) error {
+func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35906,17 +41684,17 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydrateOperation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35926,27 +41704,28 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35973,16 +41752,18 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Directories = append(m.Directories, GitDirectoryGeneratorItem{})
-			if err := m.Directories[len(m.Directories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35992,29 +41773,27 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Files = append(m.Files, GitFileGeneratorItem{})
-			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = HydrateOperationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36042,13 +41821,13 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36058,17 +41837,29 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequeueAfterSeconds = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DrySHA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36078,30 +41869,29 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathParamPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36111,29 +41901,30 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathParamPrefix = string(dAtA[iNdEx:postIndex])
+			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36143,118 +41934,23 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Values == nil {
-				m.Values = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Values[mapkey] = mapvalue
+			m.PullRequestURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36277,7 +41973,7 @@ func (m *GitGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
+func (m *HydratePullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36300,15 +41996,15 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydratePullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydratePullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36336,11 +42032,11 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			m.Provider = HydratePullRequestProvider(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field API", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36368,43 +42064,61 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			m.API = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HydrateTo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HydrateTo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HydrateTo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trust", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36432,13 +42146,13 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trust = string(dAtA[iNdEx:postIndex])
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36448,29 +42162,33 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubType = string(dAtA[iNdEx:postIndex])
+			if m.Layout == nil {
+				m.Layout = &HydratedManifestLayout{}
+			}
+			if err := m.Layout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36480,23 +42198,27 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyData = string(dAtA[iNdEx:postIndex])
+			if m.PullRequest == nil {
+				m.PullRequest = &HydratePullRequest{}
+			}
+			if err := m.PullRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36519,7 +42241,7 @@ func (m *GnuPGPublicKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
+func (m *HydratedManifestLayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36542,17 +42264,17 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: wiretype end group for non-group")
+			return fmt.Errorf("proto: HydratedManifestLayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GnuPGPublicKeyList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HydratedManifestLayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36562,30 +42284,29 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = HydratedManifestLayoutType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomization", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36595,26 +42316,12 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, GnuPGPublicKey{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Kustomization = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -36636,7 +42343,7 @@ func (m *GnuPGPublicKeyList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthStatus) Unmarshal(dAtA []byte) error {
+func (m *Info) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36659,15 +42366,15 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Info: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36695,11 +42402,11 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36727,13 +42434,63 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfoItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36743,27 +42500,55 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastTransitionTime == nil {
-				m.LastTransitionTime = &v1.Time{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36786,7 +42571,7 @@ func (m *HealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
+func (m *JWTToken) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36809,17 +42594,17 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmFileParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmFileParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
 			}
-			var stringLen uint64
+			m.IssuedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36829,27 +42614,33 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.IssuedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36877,7 +42668,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36900,7 +42691,7 @@ func (m *HelmFileParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmOptions) Unmarshal(dAtA []byte) error {
+func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -36923,17 +42714,17 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValuesFileSchemes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36943,23 +42734,25 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValuesFileSchemes = append(m.ValuesFileSchemes, string(dAtA[iNdEx:postIndex]))
+			m.Items = append(m.Items, JWTToken{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -36982,7 +42775,7 @@ func (m *HelmOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmParameter) Unmarshal(dAtA []byte) error {
+func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37005,10 +42798,10 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmParameter: wiretype end group for non-group")
+			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmParameter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -37077,7 +42870,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForceString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -37094,7 +42887,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ForceString = bool(v != 0)
+			m.Code = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37116,7 +42909,7 @@ func (m *HelmParameter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostInfo) Unmarshal(dAtA []byte) error {
+func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37139,15 +42932,15 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37175,47 +42968,13 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResourcesInfo = append(m.ResourcesInfo, HostResourceInfo{})
-			if err := m.ResourcesInfo[len(m.ResourcesInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37225,24 +42984,23 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SystemInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37265,7 +43023,7 @@ func (m *HostInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
+func (m *KubernetesResourceGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37288,15 +43046,15 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostResourceInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: KubernetesResourceGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostResourceInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KubernetesResourceGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37324,13 +43082,13 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceName = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postIndex])
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByApp", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			m.RequestedByApp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37340,35 +43098,29 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RequestedByApp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedByNeighbors", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.RequestedByNeighbors = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestedByNeighbors |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Capacity = 0
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37378,66 +43130,29 @@ func (m *HostResourceInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Capacity |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HydrateOperation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HydrateOperation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37447,28 +43162,27 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37495,16 +43209,13 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LabelSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37532,13 +43243,13 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = HydrateOperationPhase(dAtA[iNdEx:postIndex])
+			m.Condition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37548,29 +43259,124 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Params == nil {
+				m.Params = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Params[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DrySHA", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			var stringLen uint64
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37580,29 +43386,17 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DrySHA = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.RequeueAfterSeconds = &v
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSHA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37612,27 +43406,28 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HydratedSHA = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceHydrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37659,9 +43454,103 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceHydrator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Values == nil {
+				m.Values = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37684,7 +43573,7 @@ func (m *HydrateOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HydrateTo) Unmarshal(dAtA []byte) error {
+func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37707,15 +43596,15 @@ func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HydrateTo: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HydrateTo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37743,61 +43632,11 @@ func (m *HydrateTo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Info) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Info: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Info: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37825,11 +43664,11 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37857,7 +43696,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37880,7 +43719,7 @@ func (m *Info) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfoItem) Unmarshal(dAtA []byte) error {
+func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37903,15 +43742,15 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfoItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfoItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37939,11 +43778,11 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.BuildOptions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37971,7 +43810,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.BinaryPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37994,7 +43833,7 @@ func (m *InfoItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JWTToken) Unmarshal(dAtA []byte) error {
+func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38017,17 +43856,17 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JWTToken: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuedAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			m.IssuedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38037,16 +43876,29 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IssuedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
-			m.ExpiresAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38056,16 +43908,29 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiresAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38075,77 +43940,31 @@ func (m *JWTToken) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JWTTokens) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Target == nil {
+				m.Target = &KustomizeSelector{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JWTTokens: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JWTTokens: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38172,10 +43991,91 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, JWTToken{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Options == nil {
+				m.Options = make(map[string]bool)
 			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Options[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38198,7 +44098,7 @@ func (m *JWTTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
+func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38221,10 +44121,10 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JsonnetVar: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JsonnetVar: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -38261,9 +44161,9 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38273,44 +44173,25 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Code = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38332,7 +44213,7 @@ func (m *JsonnetVar) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
+func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38355,15 +44236,48 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KnownTypeField: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KnownTypeField: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38391,11 +44305,11 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Field = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38423,7 +44337,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38446,7 +44360,7 @@ func (m *KnownTypeField) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
+func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38469,17 +44383,17 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeGvk: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeSelector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeGvk: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeSelector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeResId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38489,27 +44403,28 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Group = string(dAtA[iNdEx:postIndex])
+			if err := m.KustomizeResId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38537,11 +44452,11 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.AnnotationSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38569,7 +44484,7 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.LabelSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38592,7 +44507,7 @@ func (m *KustomizeGvk) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
+func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38615,17 +44530,17 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuildOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38635,27 +44550,62 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BuildOptions = string(dAtA[iNdEx:postIndex])
+			m.Elements = append(m.Elements, v11.JSON{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElementsYaml", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38683,7 +44633,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryPath = string(dAtA[iNdEx:postIndex])
+			m.ElementsYaml = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38706,7 +44656,7 @@ func (m *KustomizeOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
+func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38729,79 +44679,15 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizePatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizePatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedNamespaceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Patch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38828,16 +44714,107 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Target == nil {
-				m.Target = &KustomizeSelector{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -38864,11 +44841,11 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Options == nil {
-				m.Options = make(map[string]bool)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue bool
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -38917,7 +44894,7 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapvaluetemp int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -38927,12 +44904,24 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					mapvalue = bool(mapvaluetemp != 0)
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38948,7 +44937,7 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Options[mapkey] = mapvalue
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38971,7 +44960,7 @@ func (m *KustomizePatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
+func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38994,17 +44983,17 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeReplica: wiretype end group for non-group")
+			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeReplica: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39014,27 +45003,29 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39061,7 +45052,7 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -39086,7 +45077,7 @@ func (m *KustomizeReplica) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
+func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39109,15 +45100,15 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeResId: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeResId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeGvk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39144,13 +45135,14 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KustomizeGvk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39178,13 +45170,13 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39194,23 +45186,24 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39233,7 +45226,7 @@ func (m *KustomizeResId) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
+func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39256,15 +45249,15 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeSelector: wiretype end group for non-group")
+			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeSelector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeResId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39291,15 +45284,66 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KustomizeResId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39309,27 +45353,29 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnnotationSelector = string(dAtA[iNdEx:postIndex])
+			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
+			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39357,7 +45403,7 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LabelSelector = string(dAtA[iNdEx:postIndex])
+			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39380,7 +45426,7 @@ func (m *KustomizeSelector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListGenerator) Unmarshal(dAtA []byte) error {
+func (m *OCIGenerator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39403,15 +45449,79 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIGenerator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repositories", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repositories = append(m.Repositories, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39438,14 +45548,34 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Elements = append(m.Elements, v11.JSON{})
-			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Filters = append(m.Filters, OCIGeneratorFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LatestOnly = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BasicAuth", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39472,15 +45602,18 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.BasicAuth == nil {
+				m.BasicAuth = &OCIGeneratorBasicAuth{}
+			}
+			if err := m.BasicAuth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElementsYaml", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39490,77 +45623,55 @@ func (m *ListGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ElementsYaml = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Insecure = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlainHTTP", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.PlainHTTP = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequeueAfterSeconds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedNamespaceMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedNamespaceMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.RequeueAfterSeconds = &v
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39587,107 +45698,13 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39714,8 +45731,8 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.Values == nil {
+				m.Values = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -39810,7 +45827,7 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.Values[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -39833,7 +45850,7 @@ func (m *ManagedNamespaceMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
+func (m *OCIGeneratorBasicAuth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39856,17 +45873,17 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MatrixGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIGeneratorBasicAuth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIGeneratorBasicAuth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39876,29 +45893,27 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Username = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -39925,7 +45940,10 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PasswordRef == nil {
+				m.PasswordRef = &SecretRef{}
+			}
+			if err := m.PasswordRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -39950,7 +45968,7 @@ func (m *MatrixGenerator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
+func (m *OCIGeneratorFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39973,17 +45991,17 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeGenerator: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIGeneratorFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIGeneratorFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepositoryMatch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -39993,29 +46011,28 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Generators = append(m.Generators, ApplicationSetNestedGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.RepositoryMatch = &s
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TagMatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40043,212 +46060,12 @@ func (m *MergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.TagMatch = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NestedMatrixGenerator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMatrixGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NestedMergeGenerator: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NestedMergeGenerator: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Generators", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Generators = append(m.Generators, ApplicationSetTerminalGenerator{})
-			if err := m.Generators[len(m.Generators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MergeKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TagSemver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -40276,7 +46093,8 @@ func (m *NestedMergeGenerator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MergeKeys = append(m.MergeKeys, string(dAtA[iNdEx:postIndex]))
+			s := string(dAtA[iNdEx:postIndex])
+			m.TagSemver = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48040,16 +53858,335 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ingress = append(m.Ingress, v12.LoadBalancerIngress{})
-			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ingress = append(m.Ingress, v12.LoadBalancerIngress{})
+			if err := m.Ingress[len(m.Ingress)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
+			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, InfoItem{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NetworkingInfo == nil {
+				m.NetworkingInfo = &ResourceNetworkingInfo{}
+			}
+			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &HealthStatus{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalURLs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48059,23 +54196,27 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalURLs = append(m.ExternalURLs, string(dAtA[iNdEx:postIndex]))
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48098,7 +54239,7 @@ func (m *ResourceNetworkingInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceNode) Unmarshal(dAtA []byte) error {
+func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48121,17 +54262,17 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48141,28 +54282,27 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HealthLua = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48189,16 +54329,15 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentRefs = append(m.ParentRefs, ResourceRef{})
-			if err := m.ParentRefs[len(m.ParentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IgnoreDifferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48208,29 +54347,27 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Info = append(m.Info, InfoItem{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Actions = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownTypeFields", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48257,18 +54394,16 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NetworkingInfo == nil {
-				m.NetworkingInfo = &ResourceNetworkingInfo{}
-			}
-			if err := m.NetworkingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
+			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48278,95 +54413,15 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResourceVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.UseOpenLibs = bool(v != 0)
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Health == nil {
-				m.Health = &HealthStatus{}
-			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreResourceUpdates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48393,10 +54448,7 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &v1.Time{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IgnoreResourceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -48421,7 +54473,7 @@ func (m *ResourceNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
+func (m *ResourcePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48444,15 +54496,15 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceOverride: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourcePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourcePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthLua", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48480,11 +54532,11 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthLua = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreDifferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48511,13 +54563,14 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgnoreDifferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Kinds = append(m.Kinds, v1.GroupKind{})
+			if err := m.Kinds[len(m.Kinds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48545,13 +54598,13 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = string(dAtA[iNdEx:postIndex])
+			m.Condition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KnownTypeFields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48561,51 +54614,29 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KnownTypeFields = append(m.KnownTypeFields, KnownTypeField{})
-			if err := m.KnownTypeFields[len(m.KnownTypeFields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseOpenLibs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseOpenLibs = bool(v != 0)
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreResourceUpdates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48615,24 +54646,23 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IgnoreResourceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -53124,6 +59154,38 @@ func (m *SuccessfulHydrateOperation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PullRequestURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PullRequestURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -54365,6 +60427,42 @@ func (m *SyncSource) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Layout == nil {
+				m.Layout = &HydratedManifestLayout{}
+			}
+			if err := m.Layout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -55165,6 +61263,484 @@ func (m *SyncWindow) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DateRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DateRanges = append(m.DateRanges, SyncWindowDateRange{})
+			if err := m.DateRanges[len(m.DateRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calendar", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Calendar == nil {
+				m.Calendar = &SyncWindowCalendar{}
+			}
+			if err := m.Calendar.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowCalendar) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowCalendar: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowCalendar: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowDateRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowDateRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowDateRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncWindowPeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncWindowPeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncWindowPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -45,6 +46,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/env"
 	"github.com/argoproj/argo-cd/v3/util/helm"
 	utilhttp "github.com/argoproj/argo-cd/v3/util/http"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 	"github.com/argoproj/argo-cd/v3/util/security"
 )
 
@@ -92,6 +94,33 @@ type ApplicationSpec struct {
 
 	// SourceHydrator provides a way to push hydrated manifests back to git before syncing them to the cluster.
 	SourceHydrator *SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,9,opt,name=sourceHydrator"`
+
+	// DependsOn is a list of Applications which must be synced and healthy before this application is synced
+	DependsOn []ApplicationDependency `json:"dependsOn,omitempty" protobuf:"bytes,10,rep,name=dependsOn"`
+}
+
+// ApplicationDependency selects Applications an application depends on. The Applications must be in the same
+// namespace and belong to the same project as the application.
+type ApplicationDependency struct {
+	// Name is the name of an Application
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
+	// Selector selects Applications by their labels
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,2,opt,name=selector"`
+}
+
+// Matches returns whether the given Application is selected by the dependency, regardless of its namespace and project.
+func (d *ApplicationDependency) Matches(app *Application) (bool, error) {
+	if d.Name != "" {
+		return d.Name == app.Name, nil
+	}
+	if d.Selector == nil {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(d.Selector)
+	if err != nil {
+		return false, fmt.Errorf("error parsing dependency selector: %w", err)
+	}
+	return selector.Matches(labels.Set(app.Labels)), nil
 }
 
 type IgnoreDifferences []ResourceIgnoreDifferences
@@ -320,6 +349,11 @@ func (source *ApplicationSource) IsHelmOci() bool {
 	return helm.IsHelmOciRepo(source.RepoURL)
 }
 
+// IsOCI returns true when the application source is a generic OCI artifact
+func (source *ApplicationSource) IsOCI() bool {
+	return source.Chart == "" && oci.IsOCIRepo(source.RepoURL)
+}
+
 // IsZero returns true if the application source is considered empty
 func (source *ApplicationSource) IsZero() bool {
 	return source == nil ||
@@ -401,12 +435,27 @@ type SourceHydrator struct {
 
 // GetSyncSource gets the source from which we should sync when a source hydrator is configured.
 func (s SourceHydrator) GetSyncSource() ApplicationSource {
-	return ApplicationSource{
+	source := ApplicationSource{
 		// Pull the RepoURL from the dry source. The SyncSource's RepoURL is assumed to be the same.
 		RepoURL:        s.DrySource.RepoURL,
 		Path:           s.SyncSource.Path,
 		TargetRevision: s.SyncSource.TargetBranch,
 	}
+	// Manifests pushed to the HydrateTo branch end up in the sync branch, so they share the same layout.
+	if layout := s.GetHydrateToLayout(); layout.IsSplitPerResource() && !layout.Kustomization {
+		// Manifests are grouped in one directory per namespace, so they have to be discovered recursively.
+		source.Directory = &ApplicationSourceDirectory{Recurse: true}
+	}
+	return source
+}
+
+// GetHydrateToLayout gets the layout used to write hydrated manifests to the branch the hydrator commits to. The
+// HydrateTo layout takes precedence over the SyncSource layout.
+func (s SourceHydrator) GetHydrateToLayout() *HydratedManifestLayout {
+	if s.HydrateTo != nil && s.HydrateTo.Layout != nil {
+		return s.HydrateTo.Layout
+	}
+	return s.SyncSource.Layout
 }
 
 // GetDrySource gets the dry source when a source hydrator is configured.
@@ -418,9 +467,18 @@ func (s SourceHydrator) GetDrySource() ApplicationSource {
 	}
 }
 
+// GetDrySources gets the sources from which manifests are hydrated. These are the sources of the dry source if it has
+// any, otherwise the dry source itself.
+func (s SourceHydrator) GetDrySources() ApplicationSources {
+	if s.DrySource.HasSources() {
+		return s.DrySource.Sources
+	}
+	return ApplicationSources{s.GetDrySource()}
+}
+
 // DeepEquals returns true if the SourceHydrator is deeply equal to the given SourceHydrator.
 func (s SourceHydrator) DeepEquals(hydrator SourceHydrator) bool {
-	return s.DrySource == hydrator.DrySource && s.SyncSource == hydrator.SyncSource && s.HydrateTo.DeepEquals(hydrator.HydrateTo)
+	return s.DrySource.DeepEquals(hydrator.DrySource) && s.SyncSource.DeepEquals(hydrator.SyncSource) && s.HydrateTo.DeepEquals(hydrator.HydrateTo)
 }
 
 // DrySource specifies a location for dry "don't repeat yourself" manifest source information.
@@ -430,7 +488,22 @@ type DrySource struct {
 	// TargetRevision defines the revision of the source to hydrate
 	TargetRevision string `json:"targetRevision" protobuf:"bytes,2,name=targetRevision"`
 	// Path is a directory path within the Git repository where the manifests are located
-	Path string `json:"path" protobuf:"bytes,3,name=path"`
+	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+	// Sources specifies the sources to hydrate manifests from, in the same way as the sources of a multi-source
+	// application. This allows hydrating Helm charts, including charts from OCI registries, with value files from Git
+	// repositories referenced with `$ref`. When set, Path must be empty and one of the sources must use the RepoURL and
+	// TargetRevision of the dry source: its resolved revision is the dry SHA the hydrated manifests are committed for.
+	Sources ApplicationSources `json:"sources,omitempty" protobuf:"bytes,4,rep,name=sources"`
+}
+
+// HasSources returns true if manifests are hydrated from the sources of the DrySource rather than from its path.
+func (s DrySource) HasSources() bool {
+	return len(s.Sources) > 0
+}
+
+// DeepEquals returns true if the DrySource is deeply equal to the given DrySource.
+func (s DrySource) DeepEquals(to DrySource) bool {
+	return s.RepoURL == to.RepoURL && s.TargetRevision == to.TargetRevision && s.Path == to.Path && s.Sources.Equals(to.Sources)
 }
 
 // SyncSource specifies a location from which hydrated manifests may be synced. RepoURL is assumed based on the
@@ -441,6 +514,14 @@ type SyncSource struct {
 	// Path is a directory path within the git repository where hydrated manifests should be committed to and synced
 	// from. If hydrateTo is set, this is just the path from which hydrated manifests will be synced.
 	Path string `json:"path" protobuf:"bytes,2,name=path"`
+	// Layout specifies how hydrated manifests are written to the path. By default, all manifests are written to a
+	// single manifest.yaml file.
+	Layout *HydratedManifestLayout `json:"layout,omitempty" protobuf:"bytes,3,opt,name=layout"`
+}
+
+// DeepEquals returns true if the SyncSource is deeply equal to the given SyncSource.
+func (s SyncSource) DeepEquals(to SyncSource) bool {
+	return s.TargetBranch == to.TargetBranch && s.Path == to.Path && s.Layout.DeepEquals(to.Layout)
 }
 
 // HydrateTo specifies a location to which hydrated manifests should be pushed as a "staging area" before being moved to
@@ -448,6 +529,13 @@ type SyncSource struct {
 type HydrateTo struct {
 	// TargetBranch is the branch to which hydrated manifests should be committed
 	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+	// Layout specifies how hydrated manifests are written to the target branch. Defaults to the layout of the
+	// SyncSource.
+	Layout *HydratedManifestLayout `json:"layout,omitempty" protobuf:"bytes,2,opt,name=layout"`
+	// PullRequest, if set, makes the source hydrator push hydrated manifests to a new branch for every dry commit instead
+	// of the TargetBranch, named after the TargetBranch and the dry commit SHA, and open a pull request from it to the
+	// SyncSource branch.
+	PullRequest *HydratePullRequest `json:"pullRequest,omitempty" protobuf:"bytes,3,opt,name=pullRequest"`
 }
 
 // DeepEquals returns true if the HydrateTo is deeply equal to the given HydrateTo.
@@ -459,7 +547,77 @@ func (in *HydrateTo) DeepEquals(to *HydrateTo) bool {
 		// We already know in is not nil.
 		return false
 	}
-	// Compare de-referenced structs.
+	return in.TargetBranch == to.TargetBranch && in.Layout.DeepEquals(to.Layout) && in.PullRequest.DeepEquals(to.PullRequest)
+}
+
+// HydratePullRequestProvider is the SCM provider used to open hydrator pull requests
+type HydratePullRequestProvider string
+
+const (
+	HydratePullRequestProviderGitHub          HydratePullRequestProvider = "GitHub"
+	HydratePullRequestProviderGitLab          HydratePullRequestProvider = "GitLab"
+	HydratePullRequestProviderGitea           HydratePullRequestProvider = "Gitea"
+	HydratePullRequestProviderBitbucketServer HydratePullRequestProvider = "BitbucketServer"
+	HydratePullRequestProviderBitbucketCloud  HydratePullRequestProvider = "BitbucketCloud"
+	HydratePullRequestProviderAzureDevOps     HydratePullRequestProvider = "AzureDevOps"
+)
+
+// HydratePullRequest specifies how the source hydrator opens pull requests. The pull request is opened with the
+// credentials of the repository-write secret of the repository.
+type HydratePullRequest struct {
+	// Provider is the SCM provider hosting the repository
+	// +kubebuilder:validation:Enum=GitHub;GitLab;Gitea;BitbucketServer;BitbucketCloud;AzureDevOps
+	Provider HydratePullRequestProvider `json:"provider" protobuf:"bytes,1,opt,name=provider,casttype=HydratePullRequestProvider"`
+	// API is the URL of the SCM provider API. Defaults to the public API of the provider for GitHub, Bitbucket Cloud and
+	// Azure DevOps, and to the scheme and host of the repository URL otherwise.
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
+}
+
+// DeepEquals returns true if the HydratePullRequest is deeply equal to the given HydratePullRequest.
+func (in *HydratePullRequest) DeepEquals(to *HydratePullRequest) bool {
+	if in == nil {
+		return to == nil
+	}
+	if to == nil {
+		return false
+	}
+	return *in == *to
+}
+
+// HydratedManifestLayoutType is the type of layout used to write hydrated manifests
+type HydratedManifestLayoutType string
+
+const (
+	// HydratedManifestLayoutSingleFile writes all hydrated manifests of a path to a single manifest.yaml file
+	HydratedManifestLayoutSingleFile HydratedManifestLayoutType = "SingleFile"
+	// HydratedManifestLayoutSplitPerResource writes each hydrated manifest to its own <kind>-<name>.yaml file, in a
+	// sub-directory named after the namespace of the resource. Cluster-scoped resources are written to the root of
+	// the path.
+	HydratedManifestLayoutSplitPerResource HydratedManifestLayoutType = "SplitPerResource"
+)
+
+// HydratedManifestLayout specifies how hydrated manifests are written to a path
+type HydratedManifestLayout struct {
+	// Type is the layout of the hydrated manifests. One of SingleFile (the default) or SplitPerResource.
+	// +kubebuilder:validation:Enum=SingleFile;SplitPerResource
+	Type HydratedManifestLayoutType `json:"type,omitempty" protobuf:"bytes,1,opt,name=type,casttype=HydratedManifestLayoutType"`
+	// Kustomization generates a kustomization.yaml file referencing all hydrated manifests of the path
+	Kustomization bool `json:"kustomization,omitempty" protobuf:"varint,2,opt,name=kustomization"`
+}
+
+// IsSplitPerResource returns true if each hydrated manifest is written to its own file
+func (in *HydratedManifestLayout) IsSplitPerResource() bool {
+	return in != nil && in.Type == HydratedManifestLayoutSplitPerResource
+}
+
+// DeepEquals returns true if the HydratedManifestLayout is deeply equal to the given HydratedManifestLayout.
+func (in *HydratedManifestLayout) DeepEquals(to *HydratedManifestLayout) bool {
+	if in == nil {
+		return to == nil
+	}
+	if to == nil {
+		return false
+	}
 	return *in == *to
 }
 
@@ -1199,6 +1357,8 @@ type HydrateOperation struct {
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
 	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// PullRequestURL is the URL of the pull request opened for the hydrated manifests, if pull requests are enabled
+	PullRequestURL string `json:"pullRequestURL,omitempty" protobuf:"bytes,8,opt,name=pullRequestURL"`
 }
 
 // SuccessfulHydrateOperation contains information about the most recent successful hydrate operation
@@ -1209,6 +1369,8 @@ type SuccessfulHydrateOperation struct {
 	HydratedSHA string `json:"hydratedSHA,omitempty" protobuf:"bytes,6,opt,name=hydratedSHA"`
 	// SourceHydrator holds the hydrator config used for the hydrate operation
 	SourceHydrator SourceHydrator `json:"sourceHydrator,omitempty" protobuf:"bytes,7,opt,name=sourceHydrator"`
+	// PullRequestURL is the URL of the pull request opened for the hydrated manifests, if pull requests are enabled
+	PullRequestURL string `json:"pullRequestURL,omitempty" protobuf:"bytes,8,opt,name=pullRequestURL"`
 }
 
 // HydrateOperationPhase indicates the status of a hydrate operation
@@ -1366,6 +1528,10 @@ func (o *SyncOperation) IsApplyStrategy() bool {
 	return o.SyncStrategy != nil && o.SyncStrategy.Apply != nil
 }
 
+// OperationQueued is the phase of a sync operation waiting for fewer sync operations to run on its destination cluster
+// or in its project, when the application controller limits how many of them run at the same time
+const OperationQueued synccommon.OperationPhase = "Queued"
+
 // OperationState contains information about state of a running operation
 type OperationState struct {
 	// Operation is the original requested operation
@@ -1744,6 +1910,15 @@ const (
 	ApplicationConditionSyncError = "SyncError"
 	// ApplicationConditionUnknownError indicates an unknown controller error
 	ApplicationConditionUnknownError = "UnknownError"
+	// ApplicationConditionPolicyViolationError indicates that a manifest of the application violates an enforced resource
+	// policy of its project
+	ApplicationConditionPolicyViolationError = "PolicyViolationError"
+	// ApplicationConditionPolicyViolationWarning indicates that a manifest of the application violates a resource policy
+	// of its project in warn mode
+	ApplicationConditionPolicyViolationWarning = "PolicyViolationWarning"
+	// ApplicationConditionDependencyNotReady indicates that a sync of the application is held until the Applications it
+	// depends on are synced and healthy
+	ApplicationConditionDependencyNotReady = "DependencyNotReady"
 	// ApplicationConditionSharedResourceWarning indicates that controller detected resources which belongs to more than one application
 	ApplicationConditionSharedResourceWarning = "SharedResourceWarning"
 	// ApplicationConditionRepeatedResourceWarning indicates that application source has resource with same Group, Kind, Name, Namespace multiple times
@@ -2249,6 +2424,8 @@ type ClusterCacheInfo struct {
 	APIsCount int64 `json:"apisCount,omitempty" protobuf:"bytes,2,opt,name=apisCount"`
 	// LastCacheSyncTime holds time of most recent cache synchronization
 	LastCacheSyncTime *metav1.Time `json:"lastCacheSyncTime,omitempty" protobuf:"bytes,3,opt,name=lastCacheSyncTime"`
+	// NodesCount holds number of observed Kubernetes nodes
+	NodesCount int64 `json:"nodesCount,omitempty" protobuf:"bytes,4,opt,name=nodesCount"`
 }
 
 // ClusterList is a collection of Clusters.
@@ -2643,6 +2820,35 @@ type AppProjectSpec struct {
 	PermitOnlyProjectScopedClusters bool `json:"permitOnlyProjectScopedClusters,omitempty" protobuf:"bytes,13,opt,name=permitOnlyProjectScopedClusters"`
 	// DestinationServiceAccounts holds information about the service accounts to be impersonated for the application sync operation for each destination.
 	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,14,name=destinationServiceAccounts"`
+	// ResourcePolicies are checked against the manifests of the applications in this project before they are synced
+	ResourcePolicies []ResourcePolicy `json:"resourcePolicies,omitempty" protobuf:"bytes,15,rep,name=resourcePolicies"`
+	// QueuePriority is the highest priority tier of the applications of this project in the refresh and operation queues
+	// of the application controller, when queue priorities are enabled. Either 'high', 'normal' or 'low', defaults to
+	// 'normal'. An application may only lower its tier with the argocd.argoproj.io/queue-priority annotation.
+	QueuePriority string `json:"queuePriority,omitempty" protobuf:"bytes,16,opt,name=queuePriority"`
+}
+
+const (
+	// ResourcePolicyModeEnforce prevents the applications with manifests violating the policy from being synced
+	ResourcePolicyModeEnforce = "enforce"
+	// ResourcePolicyModeWarn only reports the manifests violating the policy
+	ResourcePolicyModeWarn = "warn"
+)
+
+// ResourcePolicy is a condition the manifests of the applications of a project must satisfy
+type ResourcePolicy struct {
+	// Name is the name of the policy
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Kinds restricts the policy to the resources of the given groups and kinds. All the resources are checked when empty.
+	Kinds []metav1.GroupKind `json:"kinds,omitempty" protobuf:"bytes,2,rep,name=kinds"`
+	// Condition is a CEL (https://cel.dev) expression evaluated against each manifest, available as the 'object'
+	// variable, which must return true for the manifest to comply with the policy
+	Condition string `json:"condition" protobuf:"bytes,3,opt,name=condition"`
+	// Message describes the policy, and is reported along with the manifests violating it
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// Mode is either 'enforce', to prevent the applications with manifests violating the policy from being synced, or
+	// 'warn', to only report them. Defaults to 'enforce'.
+	Mode string `json:"mode,omitempty" protobuf:"bytes,5,opt,name=mode"`
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -2670,6 +2876,40 @@ type SyncWindow struct {
 	UseAndOperator bool `json:"andOperator,omitempty" protobuf:"bytes,9,opt,name=andOperator"`
 	// Description of the sync that will be applied to the schedule, can be used to add any information such as a ticket number for example
 	Description string `json:"description,omitempty" protobuf:"bytes,10,opt,name=description"`
+	// DateRanges are periods of time, from a start to an end date, during which the window is active
+	DateRanges []SyncWindowDateRange `json:"dateRanges,omitempty" protobuf:"bytes,11,rep,name=dateRanges"`
+	// Calendar references an iCalendar file stored in a ConfigMap, the events of which are periods of time during which the window is active
+	Calendar *SyncWindowCalendar `json:"calendar,omitempty" protobuf:"bytes,12,opt,name=calendar"`
+}
+
+// SyncWindowDateRange is a period of time during which a sync window is active
+type SyncWindowDateRange struct {
+	// Start is the beginning of the period, either a date (e.g. 2025-12-15) or a date and a time (e.g. 2025-12-15T18:00:00)
+	// in the time zone of the window, unless an offset is specified (e.g. 2025-12-15T18:00:00+01:00)
+	Start string `json:"start" protobuf:"bytes,1,opt,name=start"`
+	// End is the end of the period, either a date, which is included in the period, or a date and a time
+	End string `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Description of the period, e.g. the name of a holiday
+	Description string `json:"description,omitempty" protobuf:"bytes,3,opt,name=description"`
+}
+
+// SyncWindowCalendar references an iCalendar file stored in a ConfigMap
+type SyncWindowCalendar struct {
+	// ConfigMap is the name of the ConfigMap holding the iCalendar file, in the namespace of Argo CD. The ConfigMap must
+	// be labeled with app.kubernetes.io/part-of: argocd.
+	ConfigMap string `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	// Key is the key of the iCalendar file in the ConfigMap
+	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
+}
+
+// SyncWindowPeriod is a period of time during which a sync window is active
+type SyncWindowPeriod struct {
+	// Start is the beginning of the period
+	Start metav1.Time `json:"start" protobuf:"bytes,1,opt,name=start"`
+	// End is the end of the period
+	End metav1.Time `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Description of the period
+	Description string `json:"description,omitempty" protobuf:"bytes,3,opt,name=description"`
 }
 
 // HasWindows returns true if SyncWindows has one or more SyncWindow
@@ -2683,27 +2923,14 @@ func (w *SyncWindows) Active() (*SyncWindows, error) {
 }
 
 func (w *SyncWindows) active(currentTime time.Time) (*SyncWindows, error) {
-	// If SyncWindows.Active() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if w.HasWindows() {
 		var active SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
-			schedule, sErr := specParser.Parse(w.Schedule)
-			if sErr != nil {
-				return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
-			}
-			duration, dErr := time.ParseDuration(w.Duration)
-			if dErr != nil {
-				return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+			isActive, err := w.active(currentTime)
+			if err != nil {
+				return nil, err
 			}
-
-			// Offset the nextWindow time to consider the timeZone of the sync window
-			timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-			nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-			if nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			if isActive {
 				active = append(active, w)
 			}
 		}
@@ -2722,28 +2949,15 @@ func (w *SyncWindows) InactiveAllows() (*SyncWindows, error) {
 }
 
 func (w *SyncWindows) inactiveAllows(currentTime time.Time) (*SyncWindows, error) {
-	// If SyncWindows.InactiveAllows() is called outside of a UTC locale, it should be
-	// first converted to UTC before we scan through the SyncWindows.
-	currentTime = currentTime.In(time.UTC)
-
 	if w.HasWindows() {
 		var inactive SyncWindows
-		specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
 		for _, w := range *w {
 			if w.Kind == "allow" {
-				schedule, sErr := specParser.Parse(w.Schedule)
-				if sErr != nil {
-					return nil, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
+				isActive, err := w.active(currentTime)
+				if err != nil {
+					return nil, err
 				}
-				duration, dErr := time.ParseDuration(w.Duration)
-				if dErr != nil {
-					return nil, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
-				}
-				// Offset the nextWindow time to consider the timeZone of the sync window
-				timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-				nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
-
-				if !nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+				if !isActive {
 					inactive = append(inactive, w)
 				}
 			}
@@ -2766,9 +2980,9 @@ func (w *SyncWindow) scheduleOffsetByTimeZone() time.Duration {
 }
 
 // AddWindow adds a sync window with the given parameters to the AppProject
-func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool, timeZone string, andOperator bool, description string) error {
-	if len(knd) == 0 || len(sch) == 0 || len(dur) == 0 {
-		return errors.New("cannot create window: require kind, schedule, duration and one or more of applications, namespaces and clusters")
+func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []string, ns []string, cl []string, ms bool, timeZone string, andOperator bool, description string, dateRanges []SyncWindowDateRange, calendar *SyncWindowCalendar) error {
+	if len(knd) == 0 || (len(sch) == 0 || len(dur) == 0) && len(dateRanges) == 0 && calendar == nil {
+		return errors.New("cannot create window: require kind, schedule and duration, date ranges or calendar, and one or more of applications, namespaces and clusters")
 	}
 
 	window := &SyncWindow{
@@ -2779,6 +2993,8 @@ func (spec *AppProjectSpec) AddWindow(knd string, sch string, dur string, app []
 		TimeZone:       timeZone,
 		UseAndOperator: andOperator,
 		Description:    description,
+		DateRanges:     dateRanges,
+		Calendar:       calendar,
 	}
 
 	if len(app) > 0 {
@@ -2991,21 +3207,148 @@ func (w SyncWindow) active(currentTime time.Time) (bool, error) {
 	// first converted to UTC before search
 	currentTime = currentTime.UTC()
 
+	if w.hasSchedule() {
+		schedule, duration, err := w.parseSchedule()
+		if err != nil {
+			return false, err
+		}
+
+		// Offset the nextWindow time to consider the timeZone of the sync window
+		timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
+		nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
+		if nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)) {
+			return true, nil
+		}
+	}
+
+	location := w.location()
+	for _, dateRange := range w.DateRanges {
+		start, end, err := dateRange.period(location)
+		if err != nil {
+			return false, err
+		}
+		if !currentTime.Before(start) && currentTime.Before(end) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// maxSyncWindowOccurrences is the maximum number of occurrences of the schedule of a sync window which are iterated to
+// list its periods, e.g. a schedule occurring every minute can be listed for about 69 days
+const maxSyncWindowOccurrences = 100000
+
+// Periods returns the periods of time during which the sync window is active which overlap the given period, ordered by
+// start. The overlapping occurrences of the schedule are merged into a single period.
+func (w *SyncWindow) Periods(from time.Time, to time.Time) ([]SyncWindowPeriod, error) {
+	var periods []SyncWindowPeriod
+	if w.hasSchedule() {
+		schedule, duration, err := w.parseSchedule()
+		if err != nil {
+			return nil, err
+		}
+		timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
+		last := to.UTC().Add(timeZoneOffsetDuration)
+		occurrences := 0
+		for next := schedule.Next(from.UTC().Add(timeZoneOffsetDuration - duration)); !next.IsZero() && !next.After(last); next = schedule.Next(next) {
+			occurrences++
+			if occurrences > maxSyncWindowOccurrences {
+				return nil, fmt.Errorf("schedule '%s' occurs more than %d times until %s, list the periods over a shorter duration", w.Schedule, maxSyncWindowOccurrences, to.UTC().Format(time.RFC3339))
+			}
+			start := next.Add(-timeZoneOffsetDuration)
+			end := start.Add(duration)
+			if len(periods) > 0 && !start.After(periods[len(periods)-1].End.Time) {
+				periods[len(periods)-1].End = metav1.NewTime(end)
+				continue
+			}
+			periods = append(periods, SyncWindowPeriod{Start: metav1.NewTime(start), End: metav1.NewTime(end), Description: w.Description})
+		}
+	}
+
+	location := w.location()
+	for _, dateRange := range w.DateRanges {
+		start, end, err := dateRange.period(location)
+		if err != nil {
+			return nil, err
+		}
+		if end.After(from) && !start.After(to) {
+			description := dateRange.Description
+			if description == "" {
+				description = w.Description
+			}
+			periods = append(periods, SyncWindowPeriod{Start: metav1.NewTime(start), End: metav1.NewTime(end), Description: description})
+		}
+	}
+	sort.SliceStable(periods, func(i, j int) bool {
+		return periods[i].Start.Before(&periods[j].Start)
+	})
+	return periods, nil
+}
+
+// hasSchedule returns whether the sync window is active on a cron schedule, which is required unless the window has
+// date ranges or a calendar
+func (w *SyncWindow) hasSchedule() bool {
+	return w.Schedule != "" || w.Duration != "" || (len(w.DateRanges) == 0 && w.Calendar == nil)
+}
+
+// parseSchedule parses the cron schedule and the duration of the sync window
+func (w *SyncWindow) parseSchedule() (cron.Schedule, time.Duration, error) {
 	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	schedule, sErr := specParser.Parse(w.Schedule)
-	if sErr != nil {
-		return false, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, sErr)
+	schedule, err := specParser.Parse(w.Schedule)
+	if err != nil {
+		return nil, 0, fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		return nil, 0, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
 	}
-	duration, dErr := time.ParseDuration(w.Duration)
-	if dErr != nil {
-		return false, fmt.Errorf("cannot parse duration '%s': %w", w.Duration, dErr)
+	return schedule, duration, nil
+}
+
+// location returns the time zone of the sync window, in which the dates of its date ranges are evaluated
+func (w *SyncWindow) location() *time.Location {
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return time.UTC
 	}
+	return loc
+}
 
-	// Offset the nextWindow time to consider the timeZone of the sync window
-	timeZoneOffsetDuration := w.scheduleOffsetByTimeZone()
-	nextWindow := schedule.Next(currentTime.Add(timeZoneOffsetDuration - duration))
+// syncWindowTimeLayouts are the layouts of the dates and times of the sync window date ranges
+var syncWindowTimeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04"}
 
-	return nextWindow.Before(currentTime.Add(timeZoneOffsetDuration)), nil
+// parseSyncWindowTime parses a date or a date and a time of a sync window date range in the given location. It returns
+// whether the value is a date only.
+func parseSyncWindowTime(value string, location *time.Location) (time.Time, bool, error) {
+	if date, err := time.ParseInLocation(time.DateOnly, value, location); err == nil {
+		return date, true, nil
+	}
+	for _, layout := range syncWindowTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, location); err == nil {
+			return t, false, nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("cannot parse date '%s': expected a date (e.g. 2025-12-15) or a date and a time (e.g. 2025-12-15T18:00:00)", value)
+}
+
+// period returns the start and the end of the date range in the given location. A date range ending on a date includes
+// the whole day.
+func (r SyncWindowDateRange) period(location *time.Location) (time.Time, time.Time, error) {
+	start, _, err := parseSyncWindowTime(r.Start, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	end, isDate, err := parseSyncWindowTime(r.End, location)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if isDate {
+		end = end.AddDate(0, 0, 1)
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("date range end '%s' is not after its start '%s'", r.End, r.Start)
+	}
+	return start.UTC(), end.UTC(), nil
 }
 
 // Update updates a sync window's settings with the given parameter
@@ -3059,14 +3402,19 @@ func (w *SyncWindow) Validate() error {
 	if w.Kind != "allow" && w.Kind != "deny" {
 		return fmt.Errorf("kind '%s' mismatch: can only be allow or deny", w.Kind)
 	}
-	specParser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
-	_, err := specParser.Parse(w.Schedule)
-	if err != nil {
-		return fmt.Errorf("cannot parse schedule '%s': %w", w.Schedule, err)
+	if w.hasSchedule() {
+		if _, _, err := w.parseSchedule(); err != nil {
+			return err
+		}
 	}
-	_, err = time.ParseDuration(w.Duration)
-	if err != nil {
-		return fmt.Errorf("cannot parse duration '%s': %w", w.Duration, err)
+	location := w.location()
+	for _, dateRange := range w.DateRanges {
+		if _, _, err := dateRange.period(location); err != nil {
+			return err
+		}
+	}
+	if w.Calendar != nil && (w.Calendar.ConfigMap == "" || w.Calendar.Key == "") {
+		return errors.New("calendar requires a ConfigMap and a key")
 	}
 
 	if len(w.Description) > 255 {
@@ -811,6 +811,50 @@ func TestAppProject_ValidateGroupName(t *testing.T) {
 	}
 }
 
+// TestAppProject_ValidateResourcePolicies tests for invalid resource policies
+func TestAppProject_ValidateResourcePolicies(t *testing.T) {
+	p := newTestProject()
+	p.Spec.ResourcePolicies = []ResourcePolicy{{Name: "no-host-network", Condition: "object.?spec.?hostNetwork.orValue(false) != true"}}
+	require.NoError(t, p.ValidateProject())
+
+	p.Spec.ResourcePolicies[0].Mode = ResourcePolicyModeWarn
+	require.NoError(t, p.ValidateProject())
+
+	badPolicies := [][]ResourcePolicy{
+		{{Condition: "true"}},
+		{{Name: "no-condition"}},
+		{{Name: "bad-mode", Condition: "true", Mode: "audit"}},
+		{{Name: "duplicate", Condition: "true"}, {Name: "duplicate", Condition: "false"}},
+	}
+	for _, policies := range badPolicies {
+		p.Spec.ResourcePolicies = policies
+		require.Error(t, p.ValidateProject())
+	}
+}
+
+// TestAppProject_ValidateQueuePriority tests for invalid queue priorities
+func TestAppProject_ValidateQueuePriority(t *testing.T) {
+	p := newTestProject()
+	for _, priority := range []string{"", QueuePriorityHigh, QueuePriorityNormal, QueuePriorityLow} {
+		p.Spec.QueuePriority = priority
+		require.NoError(t, p.ValidateProject())
+	}
+
+	p.Spec.QueuePriority = "urgent"
+	require.EqualError(t, p.ValidateProject(), "rpc error: code = InvalidArgument desc = invalid queue priority 'urgent', must be 'high', 'normal' or 'low'")
+}
+
+func TestResourcePolicy_AppliesTo(t *testing.T) {
+	policy := ResourcePolicy{Name: "all"}
+	assert.True(t, policy.AppliesTo(schema.GroupKind{Kind: "ConfigMap"}))
+	assert.True(t, policy.IsEnforced())
+
+	policy = ResourcePolicy{Name: "workloads", Kinds: []metav1.GroupKind{{Group: "apps", Kind: "*"}}, Mode: ResourcePolicyModeWarn}
+	assert.True(t, policy.AppliesTo(schema.GroupKind{Group: "apps", Kind: "Deployment"}))
+	assert.False(t, policy.AppliesTo(schema.GroupKind{Kind: "ConfigMap"}))
+	assert.False(t, policy.IsEnforced())
+}
+
 func TestAppProject_ValidateSyncWindowList(t *testing.T) {
 	t.Run("WorkingSyncWindow", func(t *testing.T) {
 		p := newTestProjectWithSyncWindows()
@@ -1093,6 +1137,48 @@ func TestAppSource_GetNamespaceOrDefault(t *testing.T) {
 	}
 }
 
+func TestSourceHydrator_GetSyncSource(t *testing.T) {
+	splitPerResource := &HydratedManifestLayout{Type: HydratedManifestLayoutSplitPerResource}
+	cases := []struct {
+		name          string
+		hydrator      SourceHydrator
+		expectRecurse bool
+	}{
+		{
+			"default layout",
+			SourceHydrator{},
+			false,
+		},
+		{
+			"split layout on the sync source",
+			SourceHydrator{SyncSource: SyncSource{Layout: splitPerResource}},
+			true,
+		},
+		{
+			"split layout with a kustomization",
+			SourceHydrator{SyncSource: SyncSource{Layout: &HydratedManifestLayout{Type: HydratedManifestLayoutSplitPerResource, Kustomization: true}}},
+			false,
+		},
+		{
+			"split layout only on hydrateTo",
+			SourceHydrator{HydrateTo: &HydrateTo{TargetBranch: "env/dev-next", Layout: splitPerResource}},
+			true,
+		},
+		{
+			"hydrateTo layout overrides the sync source layout",
+			SourceHydrator{SyncSource: SyncSource{Layout: splitPerResource}, HydrateTo: &HydrateTo{TargetBranch: "env/dev-next", Layout: &HydratedManifestLayout{}}},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			source := tc.hydrator.GetSyncSource()
+			assert.Equal(t, tc.expectRecurse, source.Directory != nil && source.Directory.Recurse)
+		})
+	}
+}
+
 func TestAppProjectSpec_DestinationClusters(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -2220,18 +2306,34 @@ func TestAppProjectSpec_AddWindow(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			switch tt.want {
 			case "error":
-				require.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description))
+				require.Error(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description, nil, nil))
 			case "noError":
-				require.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description))
+				require.NoError(t, tt.p.Spec.AddWindow(tt.k, tt.s, tt.d, tt.a, tt.n, tt.c, tt.m, tt.t, tt.o, tt.description, nil, nil))
 				require.NoError(t, tt.p.Spec.DeleteWindow(0))
 			}
 		})
 	}
 }
 
+func TestAppProjectSpec_AddWindowWithDateRanges(t *testing.T) {
+	proj := newTestProjectWithSyncWindows()
+	dateRanges := []SyncWindowDateRange{{Start: "2025-12-15", End: "2026-01-05"}}
+	calendar := &SyncWindowCalendar{ConfigMap: "holidays", Key: "holidays.ics"}
+
+	require.NoError(t, proj.Spec.AddWindow("deny", "", "", []string{"app1"}, []string{}, []string{}, false, "UTC", false, "", dateRanges, nil))
+	assert.Equal(t, dateRanges, proj.Spec.SyncWindows[1].DateRanges)
+	require.NoError(t, proj.Spec.AddWindow("deny", "", "", []string{"app1"}, []string{}, []string{}, false, "UTC", false, "", nil, calendar))
+	assert.Equal(t, calendar, proj.Spec.SyncWindows[2].Calendar)
+
+	require.Error(t, proj.Spec.AddWindow("deny", "", "", []string{"app1"}, []string{}, []string{}, false, "UTC", false, "", []SyncWindowDateRange{{Start: "2026-01-05", End: "2025-12-15"}}, nil))
+	require.Error(t, proj.Spec.AddWindow("deny", "", "", []string{"app1"}, []string{}, []string{}, false, "UTC", false, "", nil, &SyncWindowCalendar{ConfigMap: "holidays"}))
+	require.EqualError(t, proj.Spec.AddWindow("deny", "", "", []string{"app1"}, []string{}, []string{}, false, "UTC", false, "", nil, nil),
+		"cannot create window: require kind, schedule and duration, date ranges or calendar, and one or more of applications, namespaces and clusters")
+}
+
 func TestAppProjectSpecWindowWithDescription(t *testing.T) {
 	proj := newTestProjectWithSyncWindows()
-	require.NoError(t, proj.Spec.AddWindow("allow", "* * * * *", "1h", []string{"app1"}, []string{}, []string{}, false, "error", false, "Ticket AAAAA"))
+	require.NoError(t, proj.Spec.AddWindow("allow", "* * * * *", "1h", []string{"app1"}, []string{}, []string{}, false, "error", false, "Ticket AAAAA", nil, nil))
 	require.Equal(t, "Ticket AAAAA", proj.Spec.SyncWindows[1].Description)
 
 	require.NoError(t, proj.Spec.SyncWindows[1].Update("", "", []string{}, []string{}, []string{}, "", "Ticket BBBBB"))
@@ -2993,6 +3095,127 @@ func TestSyncWindow_Active(t *testing.T) {
 	}
 }
 
+func TestSyncWindow_ActiveDateRanges(t *testing.T) {
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+	window := SyncWindow{
+		Kind:     "deny",
+		TimeZone: "Europe/Paris",
+		DateRanges: []SyncWindowDateRange{
+			{Start: "2025-12-15", End: "2026-01-05", Description: "Year-end freeze"},
+			{Start: "2026-03-01T22:00", End: "2026-03-02T02:00"},
+			{Start: "2026-04-01T10:00:00Z", End: "2026-04-01T12:00:00Z"},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		currentTime    time.Time
+		expectedResult bool
+	}{
+		{"BeforeFirstDay", time.Date(2025, 12, 14, 23, 59, 0, 0, paris), false},
+		{"FirstDay", time.Date(2025, 12, 15, 0, 0, 0, 0, paris), true},
+		{"LastDay", time.Date(2026, 1, 5, 23, 59, 0, 0, paris), true},
+		{"AfterLastDay", time.Date(2026, 1, 6, 0, 0, 0, 0, paris), false},
+		{"LocalTime", time.Date(2026, 3, 1, 23, 0, 0, 0, paris), true},
+		{"LocalTimeInUTC", time.Date(2026, 3, 1, 21, 30, 0, 0, time.UTC), true},
+		{"AfterLocalTime", time.Date(2026, 3, 2, 2, 0, 0, 0, paris), false},
+		{"TimeWithOffset", time.Date(2026, 4, 1, 11, 0, 0, 0, time.UTC), true},
+		{"AfterTimeWithOffset", time.Date(2026, 4, 1, 14, 0, 0, 0, paris), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := window.active(tt.currentTime)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+
+	t.Run("ScheduleAndDateRanges", func(t *testing.T) {
+		window := SyncWindow{Kind: "deny", Schedule: "0 10 * * *", Duration: "1h", DateRanges: []SyncWindowDateRange{{Start: "2026-01-01", End: "2026-01-01"}}}
+		result, err := window.active(time.Date(2026, 1, 2, 10, 30, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.True(t, result)
+		result, err = window.active(time.Date(2026, 1, 1, 18, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.True(t, result)
+		result, err = window.active(time.Date(2026, 1, 2, 18, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.False(t, result)
+	})
+
+	t.Run("CalendarOnly", func(t *testing.T) {
+		window := SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{ConfigMap: "holidays", Key: "holidays.ics"}}
+		result, err := window.active(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		assert.False(t, result)
+	})
+
+	t.Run("InvalidDateRange", func(t *testing.T) {
+		window := SyncWindow{Kind: "deny", DateRanges: []SyncWindowDateRange{{Start: "2026-01-01", End: "tomorrow"}}}
+		_, err := window.active(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		require.Error(t, err)
+	})
+}
+
+func TestSyncWindow_Periods(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 30, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Schedule", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Schedule: "0 0 * * *", Duration: "1h", Description: "nightly"}
+		periods, err := window.Periods(from, to)
+		require.NoError(t, err)
+		require.Len(t, periods, 4)
+		for i, period := range periods {
+			day := time.Date(2026, 1, 1+i, 0, 0, 0, 0, time.UTC)
+			assert.True(t, day.Equal(period.Start.Time), period.Start)
+			assert.True(t, day.Add(time.Hour).Equal(period.End.Time), period.End)
+			assert.Equal(t, "nightly", period.Description)
+		}
+	})
+
+	t.Run("OverlappingOccurrencesAreMerged", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Schedule: "0 * * * *", Duration: "2h"}
+		periods, err := window.Periods(from, from.Add(3*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, periods, 1)
+		assert.True(t, time.Date(2025, 12, 31, 23, 0, 0, 0, time.UTC).Equal(periods[0].Start.Time), periods[0].Start)
+		assert.True(t, time.Date(2026, 1, 1, 5, 0, 0, 0, time.UTC).Equal(periods[0].End.Time), periods[0].End)
+	})
+
+	t.Run("DateRanges", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Description: "freeze", DateRanges: []SyncWindowDateRange{
+			{Start: "2026-01-03", End: "2026-01-03", Description: "holiday"},
+			{Start: "2025-12-15", End: "2026-01-01"},
+			{Start: "2026-02-01", End: "2026-02-02"},
+		}}
+		periods, err := window.Periods(from, to)
+		require.NoError(t, err)
+		require.Len(t, periods, 2)
+		assert.True(t, time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC).Equal(periods[0].Start.Time), periods[0].Start)
+		assert.True(t, time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC).Equal(periods[0].End.Time), periods[0].End)
+		assert.Equal(t, "freeze", periods[0].Description)
+		assert.True(t, time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC).Equal(periods[1].Start.Time), periods[1].Start)
+		assert.Equal(t, "holiday", periods[1].Description)
+	})
+
+	t.Run("InvalidSchedule", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Schedule: "* * *", Duration: "1h"}
+		_, err := window.Periods(from, to)
+		require.Error(t, err)
+	})
+
+	t.Run("TooManyOccurrences", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Schedule: "* * * * *", Duration: "1m"}
+		periods, err := window.Periods(from, from.Add(30*24*time.Hour))
+		require.NoError(t, err)
+		require.Len(t, periods, 1)
+		_, err = window.Periods(from, from.Add(366*24*time.Hour))
+		require.ErrorContains(t, err, "schedule '* * * * *' occurs more than 100000 times")
+	})
+}
+
 func TestSyncWindow_Update(t *testing.T) {
 	e := SyncWindow{Kind: "allow", Schedule: "* * * * *", Duration: "1h", Applications: []string{"app1"}}
 	t.Run("AddApplication", func(t *testing.T) {
@@ -3051,6 +3274,26 @@ func TestSyncWindow_Validate(t *testing.T) {
 		window.Duration = "1000days"
 		require.Error(t, window.Validate())
 	})
+	t.Run("DateRangesWithoutSchedule", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", DateRanges: []SyncWindowDateRange{{Start: "2025-12-15", End: "2026-01-05T12:00"}}}
+		require.NoError(t, window.Validate())
+	})
+	t.Run("IncorrectDateRange", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", DateRanges: []SyncWindowDateRange{{Start: "15/12/2025", End: "2026-01-05"}}}
+		require.Error(t, window.Validate())
+		window.DateRanges[0].Start = "2026-01-06"
+		require.Error(t, window.Validate())
+	})
+	t.Run("Calendar", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Calendar: &SyncWindowCalendar{ConfigMap: "holidays", Key: "holidays.ics"}}
+		require.NoError(t, window.Validate())
+		window.Calendar.Key = ""
+		require.EqualError(t, window.Validate(), "calendar requires a ConfigMap and a key")
+	})
+	t.Run("ScheduleWithoutDuration", func(t *testing.T) {
+		window := &SyncWindow{Kind: "deny", Schedule: "* * * * *", DateRanges: []SyncWindowDateRange{{Start: "2025-12-15", End: "2026-01-05"}}}
+		require.Error(t, window.Validate())
+	})
 }
 
 func TestApplicationStatus_GetConditions(t *testing.T) {
@@ -194,6 +194,13 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]ApplicationDestinationServiceAccount, len(*in))
 		copy(*out, *in)
 	}
+	if in.ResourcePolicies != nil {
+		in, out := &in.ResourcePolicies, &out.ResourcePolicies
+		*out = make([]ResourcePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -283,6 +290,27 @@ func (in *ApplicationCondition) DeepCopy() *ApplicationCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDependency) DeepCopyInto(out *ApplicationDependency) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDependency.
+func (in *ApplicationDependency) DeepCopy() *ApplicationDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationDestination) DeepCopyInto(out *ApplicationDestination) {
 	*out = *in
@@ -521,6 +549,21 @@ func (in *ApplicationSetGenerator) DeepCopyInto(out *ApplicationSetGenerator) {
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelmRepository != nil {
+		in, out := &in.HelmRepository, &out.HelmRepository
+		*out = new(HelmRepositoryGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -642,6 +685,21 @@ func (in *ApplicationSetNestedGenerator) DeepCopyInto(out *ApplicationSetNestedG
 		*out = new(PluginGenerator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelmRepository != nil {
+		in, out := &in.HelmRepository, &out.HelmRepository
+		*out = new(HelmRepositoryGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -703,6 +761,22 @@ func (in *ApplicationSetResourceIgnoreDifferences) DeepCopy() *ApplicationSetRes
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutAnalysis) DeepCopyInto(out *ApplicationSetRolloutAnalysis) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutAnalysis.
+func (in *ApplicationSetRolloutAnalysis) DeepCopy() *ApplicationSetRolloutAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep) {
 	*out = *in
@@ -718,6 +792,11 @@ func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(ApplicationSetRolloutAnalysis)
+		**out = **in
+	}
 	return
 }
 
@@ -731,6 +810,65 @@ func (in *ApplicationSetRolloutStep) DeepCopy() *ApplicationSetRolloutStep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutStepRollback) DeepCopyInto(out *ApplicationSetRolloutStepRollback) {
+	*out = *in
+	if in.Applications != nil {
+		in, out := &in.Applications, &out.Applications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PendingApplications != nil {
+		in, out := &in.PendingApplications, &out.PendingApplications
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RolloutStartTime != nil {
+		in, out := &in.RolloutStartTime, &out.RolloutStartTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutStepRollback.
+func (in *ApplicationSetRolloutStepRollback) DeepCopy() *ApplicationSetRolloutStepRollback {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutStepRollback)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutStepStatus) DeepCopyInto(out *ApplicationSetRolloutStepStatus) {
+	*out = *in
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(ApplicationSetRolloutStepRollback)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastAnalysisTime != nil {
+		in, out := &in.LastAnalysisTime, &out.LastAnalysisTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutStepStatus.
+func (in *ApplicationSetRolloutStepStatus) DeepCopy() *ApplicationSetRolloutStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetRolloutStrategy) DeepCopyInto(out *ApplicationSetRolloutStrategy) {
 	*out = *in
@@ -834,6 +972,13 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RolloutSteps != nil {
+		in, out := &in.RolloutSteps, &out.RolloutSteps
+		*out = make([]ApplicationSetRolloutStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -855,6 +1000,11 @@ func (in *ApplicationSetStrategy) DeepCopyInto(out *ApplicationSetStrategy) {
 		*out = new(ApplicationSetRolloutStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(ApplicationSetRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -876,6 +1026,11 @@ func (in *ApplicationSetSyncPolicy) DeepCopyInto(out *ApplicationSetSyncPolicy)
 		*out = new(ApplicationsSyncPolicy)
 		**out = **in
 	}
+	if in.MaxDeletions != nil {
+		in, out := &in.MaxDeletions, &out.MaxDeletions
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 	return
 }
 
@@ -985,6 +1140,21 @@ func (in *ApplicationSetTerminalGenerator) DeepCopyInto(out *ApplicationSetTermi
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(OCIGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelmRepository != nil {
+		in, out := &in.HelmRepository, &out.HelmRepository
+		*out = new(HelmRepositoryGenerator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesResource != nil {
+		in, out := &in.KubernetesResource, &out.KubernetesResource
+		*out = new(KubernetesResourceGenerator)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1377,6 +1547,13 @@ func (in *ApplicationSpec) DeepCopyInto(out *ApplicationSpec) {
 		*out = new(SourceHydrator)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]ApplicationDependency, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1740,6 +1917,11 @@ func (in *ClusterGenerator) DeepCopyInto(out *ClusterGenerator) {
 			(*out)[key] = val
 		}
 	}
+	if in.RequiredAPIVersions != nil {
+		in, out := &in.RequiredAPIVersions, &out.RequiredAPIVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1935,6 +2117,13 @@ func (in *ConnectionState) DeepCopy() *ConnectionState {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DrySource) DeepCopyInto(out *DrySource) {
 	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make(ApplicationSources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2246,6 +2435,40 @@ func (in *HelmParameter) DeepCopy() *HelmParameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmRepositoryGenerator) DeepCopyInto(out *HelmRepositoryGenerator) {
+	*out = *in
+	if in.Charts != nil {
+		in, out := &in.Charts, &out.Charts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmRepositoryGenerator.
+func (in *HelmRepositoryGenerator) DeepCopy() *HelmRepositoryGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmRepositoryGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostInfo) DeepCopyInto(out *HostInfo) {
 	*out = *in
@@ -2306,9 +2529,35 @@ func (in *HydrateOperation) DeepCopy() *HydrateOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratePullRequest) DeepCopyInto(out *HydratePullRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratePullRequest.
+func (in *HydratePullRequest) DeepCopy() *HydratePullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratePullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HydrateTo) DeepCopyInto(out *HydrateTo) {
 	*out = *in
+	if in.Layout != nil {
+		in, out := &in.Layout, &out.Layout
+		*out = new(HydratedManifestLayout)
+		**out = **in
+	}
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratePullRequest)
+		**out = **in
+	}
 	return
 }
 
@@ -2322,6 +2571,22 @@ func (in *HydrateTo) DeepCopy() *HydrateTo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratedManifestLayout) DeepCopyInto(out *HydratedManifestLayout) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratedManifestLayout.
+func (in *HydratedManifestLayout) DeepCopy() *HydratedManifestLayout {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratedManifestLayout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in IgnoreDifferences) DeepCopyInto(out *IgnoreDifferences) {
 	{
@@ -2445,6 +2710,43 @@ func (in *KnownTypeField) DeepCopy() *KnownTypeField {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesResourceGenerator) DeepCopyInto(out *KubernetesResourceGenerator) {
+	*out = *in
+	in.LabelSelector.DeepCopyInto(&out.LabelSelector)
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesResourceGenerator.
+func (in *KubernetesResourceGenerator) DeepCopy() *KubernetesResourceGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesResourceGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KustomizeGvk) DeepCopyInto(out *KustomizeGvk) {
 	*out = *in
@@ -2776,6 +3078,104 @@ func (in *NestedMergeGenerator) DeepCopy() *NestedMergeGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIGenerator) DeepCopyInto(out *OCIGenerator) {
+	*out = *in
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]OCIGeneratorFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(OCIGeneratorBasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequeueAfterSeconds != nil {
+		in, out := &in.RequeueAfterSeconds, &out.RequeueAfterSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIGenerator.
+func (in *OCIGenerator) DeepCopy() *OCIGenerator {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIGenerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIGeneratorBasicAuth) DeepCopyInto(out *OCIGeneratorBasicAuth) {
+	*out = *in
+	if in.PasswordRef != nil {
+		in, out := &in.PasswordRef, &out.PasswordRef
+		*out = new(SecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIGeneratorBasicAuth.
+func (in *OCIGeneratorBasicAuth) DeepCopy() *OCIGeneratorBasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIGeneratorBasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIGeneratorFilter) DeepCopyInto(out *OCIGeneratorFilter) {
+	*out = *in
+	if in.RepositoryMatch != nil {
+		in, out := &in.RepositoryMatch, &out.RepositoryMatch
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagMatch != nil {
+		in, out := &in.TagMatch, &out.TagMatch
+		*out = new(string)
+		**out = **in
+	}
+	if in.TagSemver != nil {
+		in, out := &in.TagSemver, &out.TagSemver
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIGeneratorFilter.
+func (in *OCIGeneratorFilter) DeepCopy() *OCIGeneratorFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIGeneratorFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Operation) DeepCopyInto(out *Operation) {
 	*out = *in
@@ -3789,6 +4189,27 @@ func (in *ResourceOverride) DeepCopy() *ResourceOverride {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePolicy) DeepCopyInto(out *ResourcePolicy) {
+	*out = *in
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]v1.GroupKind, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePolicy.
+func (in *ResourcePolicy) DeepCopy() *ResourcePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRef) DeepCopyInto(out *ResourceRef) {
 	*out = *in
@@ -4293,12 +4714,12 @@ func (in *SignatureKey) DeepCopy() *SignatureKey {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SourceHydrator) DeepCopyInto(out *SourceHydrator) {
 	*out = *in
-	out.DrySource = in.DrySource
-	out.SyncSource = in.SyncSource
+	in.DrySource.DeepCopyInto(&out.DrySource)
+	in.SyncSource.DeepCopyInto(&out.SyncSource)
 	if in.HydrateTo != nil {
 		in, out := &in.HydrateTo, &out.HydrateTo
 		*out = new(HydrateTo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -4550,6 +4971,11 @@ func (in *SyncPolicyAutomated) DeepCopy() *SyncPolicyAutomated {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SyncSource) DeepCopyInto(out *SyncSource) {
 	*out = *in
+	if in.Layout != nil {
+		in, out := &in.Layout, &out.Layout
+		*out = new(HydratedManifestLayout)
+		**out = **in
+	}
 	return
 }
 
@@ -4662,6 +5088,16 @@ func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DateRanges != nil {
+		in, out := &in.DateRanges, &out.DateRanges
+		*out = make([]SyncWindowDateRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.Calendar != nil {
+		in, out := &in.Calendar, &out.Calendar
+		*out = new(SyncWindowCalendar)
+		**out = **in
+	}
 	return
 }
 
@@ -4675,6 +5111,56 @@ func (in *SyncWindow) DeepCopy() *SyncWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowCalendar) DeepCopyInto(out *SyncWindowCalendar) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowCalendar.
+func (in *SyncWindowCalendar) DeepCopy() *SyncWindowCalendar {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowCalendar)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowDateRange) DeepCopyInto(out *SyncWindowDateRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowDateRange.
+func (in *SyncWindowDateRange) DeepCopy() *SyncWindowDateRange {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowDateRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindowPeriod) DeepCopyInto(out *SyncWindowPeriod) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindowPeriod.
+func (in *SyncWindowPeriod) DeepCopy() *SyncWindowPeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindowPeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in SyncWindows) DeepCopyInto(out *SyncWindows) {
 	{
@@ -181,6 +181,44 @@ func (c *Cache) GetHelmIndex(repo string, indexData *[]byte) error {
 	return c.cache.GetItem(helmIndexRefsKey(repo), indexData)
 }
 
+func ociTagsKey(repo string) string {
+	return "oci-tags|" + repo
+}
+
+// SetOCITags stores the list of tags of an OCI repository to cache
+func (c *Cache) SetOCITags(repo string, tagsData []byte) error {
+	if tagsData == nil {
+		// Logged as warning upstream
+		return errors.New("oci tags data is nil, skipping cache")
+	}
+	return c.cache.SetItem(
+		ociTagsKey(repo),
+		tagsData,
+		&cacheutil.CacheActionOpts{Expiration: c.revisionCacheExpiration})
+}
+
+// GetOCITags retrieves the list of tags of an OCI repository from cache
+func (c *Cache) GetOCITags(repo string, tagsData *[]byte) error {
+	return c.cache.GetItem(ociTagsKey(repo), tagsData)
+}
+
+func ociDigestKey(repo, tag string) string {
+	return fmt.Sprintf("oci-digest|%s|%s", repo, tag)
+}
+
+// SetOCIDigest stores the digest a tag of an OCI repository resolved to
+func (c *Cache) SetOCIDigest(repo, tag, digest string) error {
+	return c.cache.SetItem(
+		ociDigestKey(repo, tag),
+		digest,
+		&cacheutil.CacheActionOpts{Expiration: c.revisionCacheExpiration})
+}
+
+// GetOCIDigest retrieves the digest a tag of an OCI repository resolved to
+func (c *Cache) GetOCIDigest(repo, tag string, digest *string) error {
+	return c.cache.GetItem(ociDigestKey(repo, tag), digest)
+}
+
 func gitRefsKey(repo string) string {
 	return "git-refs|" + repo
 }
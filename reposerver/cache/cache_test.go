@@ -593,6 +593,39 @@ func TestSetHelmIndex(t *testing.T) {
 	})
 }
 
+func TestSetOCITags(t *testing.T) {
+	t.Run("SetOCITags with valid data", func(t *testing.T) {
+		fixtures := newFixtures()
+		t.Cleanup(fixtures.mockCache.StopRedisCallback)
+		err := fixtures.cache.SetOCITags("test-repo", []byte(`["1.0.0"]`))
+		require.NoError(t, err)
+		fixtures.mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalSets: 1})
+	})
+	t.Run("SetOCITags with nil", func(t *testing.T) {
+		fixtures := newFixtures()
+		t.Cleanup(fixtures.mockCache.StopRedisCallback)
+		err := fixtures.cache.SetOCITags("test-repo", nil)
+		require.Error(t, err, "nil data should not be cached")
+		var tagsData []byte
+		err = fixtures.cache.GetOCITags("test-repo", &tagsData)
+		require.Error(t, err)
+		fixtures.mockCache.AssertCacheCalledTimes(t, &mocks.CacheCallCounts{ExternalGets: 1})
+	})
+}
+
+func TestOCIDigest(t *testing.T) {
+	fixtures := newFixtures()
+	t.Cleanup(fixtures.mockCache.StopRedisCallback)
+	var digest string
+	err := fixtures.cache.GetOCIDigest("test-repo", "latest", &digest)
+	require.ErrorIs(t, err, ErrCacheMiss)
+	err = fixtures.cache.SetOCIDigest("test-repo", "latest", "sha256:abc")
+	require.NoError(t, err)
+	err = fixtures.cache.GetOCIDigest("test-repo", "latest", &digest)
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:abc", digest)
+}
+
 func TestRevisionChartDetails(t *testing.T) {
 	t.Run("GetRevisionChartDetails cache miss", func(t *testing.T) {
 		fixtures := newFixtures()
@@ -26,6 +26,7 @@ import (
 	"github.com/google/go-jsonnet"
 	"github.com/google/uuid"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/retry"
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
@@ -58,6 +59,7 @@ import (
 	pathutil "github.com/argoproj/argo-cd/v3/util/io/path"
 	"github.com/argoproj/argo-cd/v3/util/kustomize"
 	"github.com/argoproj/argo-cd/v3/util/manifeststream"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 	"github.com/argoproj/argo-cd/v3/util/text"
 	"github.com/argoproj/argo-cd/v3/util/versions"
 )
@@ -78,6 +80,7 @@ type Service struct {
 	rootDir                   string
 	gitRepoPaths              utilio.TempPaths
 	chartPaths                utilio.TempPaths
+	ociPaths                  utilio.TempPaths
 	gitRepoInitializer        func(rootPath string) goio.Closer
 	repoLock                  *repositoryLock
 	cache                     *cache.Cache
@@ -86,6 +89,7 @@ type Service struct {
 	resourceTracking          argo.ResourceTracking
 	newGitClient              func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, proxy string, noProxy string, opts ...git.ClientOpts) (git.Client, error)
 	newHelmClient             func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client
+	newOCIClient              func(repoURL string, creds oci.Creds, proxy string, noProxy string, opts ...oci.ClientOpts) (oci.Client, error)
 	initConstants             RepoServerInitConstants
 	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
 	now func() time.Time
@@ -105,6 +109,9 @@ type RepoServerInitConstants struct {
 	HelmManifestMaxExtractedSize                 int64
 	HelmRegistryMaxIndexSize                     int64
 	DisableHelmManifestMaxExtractedSize          bool
+	OCIManifestMaxExtractedSize                  int64
+	DisableOCIManifestMaxExtractedSize           bool
+	OCILayerMediaTypes                           []string
 	IncludeHiddenDirectories                     bool
 	CMPUseManifestGeneratePaths                  bool
 }
@@ -120,6 +127,7 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 	repoLock := NewRepositoryLock()
 	gitRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	helmRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
+	ociRandomizedPaths := utilio.NewRandomizedTempPaths(rootDir)
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  repoLock,
@@ -130,11 +138,13 @@ func NewService(metricsServer *metrics.MetricsServer, cache *cache.Cache, initCo
 		newHelmClient: func(repoURL string, creds helm.Creds, enableOci bool, proxy string, noProxy string, opts ...helm.ClientOpts) helm.Client {
 			return helm.NewClientWithLock(repoURL, creds, sync.NewKeyLock(), enableOci, proxy, noProxy, opts...)
 		},
+		newOCIClient:       oci.NewClient,
 		initConstants:      initConstants,
 		now:                time.Now,
 		gitCredsStore:      gitCredsStore,
 		gitRepoPaths:       gitRandomizedPaths,
 		chartPaths:         helmRandomizedPaths,
+		ociPaths:           ociRandomizedPaths,
 		gitRepoInitializer: directoryPermissionInitializer,
 		rootDir:            rootDir,
 	}
@@ -275,7 +285,7 @@ type operationContext struct {
 // the calling function (for example, 'runManifestGen')
 type operationContextSrc = func() (*operationContext, error)
 
-// runRepoOperation downloads either git folder or helm chart and executes specified operation
+// runRepoOperation downloads either git folder, helm chart or OCI artifact and executes specified operation
 // - Returns a value from the cache if present (by calling getCached(...)); if no value is present, the
 // provide operation(...) is called. The specific return type of this function is determined by the
 // calling function, via the provided  getCached(...) and operation(...) function.
@@ -298,16 +308,23 @@ func (s *Service) runRepoOperation(
 
 	var gitClient git.Client
 	var helmClient helm.Client
+	var ociClient oci.Client
 	var err error
 	gitClientOpts := git.WithCache(s.cache, !settings.noRevisionCache && !settings.noCache)
 	revision = textutils.FirstNonEmpty(revision, source.TargetRevision)
 	unresolvedRevision := revision
-	if source.IsHelm() {
+	switch {
+	case source.IsOCI():
+		ociClient, revision, err = s.newOCIClientResolveRevision(ctx, repo, revision, settings.noCache || settings.noRevisionCache)
+		if err != nil {
+			return err
+		}
+	case source.IsHelm():
 		helmClient, revision, err = s.newHelmClientResolveRevision(repo, revision, source.Chart, settings.noCache || settings.noRevisionCache)
 		if err != nil {
 			return err
 		}
-	} else {
+	default:
 		gitClient, revision, err = s.newClientResolveRevision(repo, revision, gitClientOpts)
 		if err != nil {
 			return err
@@ -336,6 +353,43 @@ func (s *Service) runRepoOperation(
 		defer settings.sem.Release(1)
 	}
 
+	if source.IsOCI() {
+		if settings.noCache {
+			err = ociClient.CleanCache(revision)
+			if err != nil {
+				return err
+			}
+		}
+		ociPath, closer, err := ociClient.Extract(ctx, revision, s.initConstants.OCIManifestMaxExtractedSize, s.initConstants.DisableOCIManifestMaxExtractedSize)
+		if err != nil {
+			return err
+		}
+		defer utilio.Close(closer)
+		if !s.initConstants.AllowOutOfBoundsSymlinks {
+			err := apppathutil.CheckOutOfBoundsSymlinks(ociPath)
+			if err != nil {
+				oobError := &apppathutil.OutOfBoundsSymlinkError{}
+				if errors.As(err, &oobError) {
+					log.WithFields(log.Fields{
+						common.SecurityField: common.SecurityHigh,
+						"repo":               repo.Repo,
+						"digest":             revision,
+						"file":               oobError.File,
+					}).Warn("oci image contains out-of-bounds symlink")
+					return fmt.Errorf("oci image contains out-of-bounds symlinks. file: %s", oobError.File)
+				}
+				return err
+			}
+		}
+		return operation(ociPath, revision, revision, func() (*operationContext, error) {
+			appPath, err := apppathutil.Path(ociPath, source.Path)
+			if err != nil {
+				return nil, err
+			}
+			return &operationContext{appPath, ""}, nil
+		})
+	}
+
 	if source.IsHelm() {
 		if settings.noCache {
 			err = helmClient.CleanChartCache(source.Chart, revision)
@@ -2257,7 +2311,10 @@ func populatePluginAppDetails(ctx context.Context, res *apiclient.RepoAppDetails
 	return nil
 }
 
-func (s *Service) GetRevisionMetadata(_ context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+	if oci.IsOCIRepo(q.Repo.Repo) {
+		return s.getOCIRevisionMetadata(ctx, q)
+	}
 	if !git.IsCommitSHA(q.Revision) && !git.IsTruncatedCommitSHA(q.Revision) {
 		return nil, fmt.Errorf("revision %s must be resolved", q.Revision)
 	}
@@ -2332,6 +2389,40 @@ func (s *Service) GetRevisionMetadata(_ context.Context, q *apiclient.RepoServer
 	return metadata, nil
 }
 
+// getOCIRevisionMetadata builds the revision metadata of an OCI artifact from its manifest annotations
+func (s *Service) getOCIRevisionMetadata(ctx context.Context, q *apiclient.RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error) {
+	metadata, err := s.cache.GetRevisionMetadata(q.Repo.Repo, q.Revision)
+	if err == nil {
+		log.Infof("revision metadata cache hit: %s/%s", q.Repo.Repo, q.Revision)
+		return metadata, nil
+	}
+	if !errors.Is(err, cache.ErrCacheMiss) {
+		log.Warnf("revision metadata cache error %s/%s: %v", q.Repo.Repo, q.Revision, err)
+	}
+
+	ociClient, err := s.newOCIClient(q.Repo.Repo, q.Repo.GetOCICreds(), q.Repo.Proxy, q.Repo.NoProxy, s.ociClientOpts()...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize oci client: %w", err)
+	}
+	manifest, err := ociClient.DigestMetadata(ctx, q.Revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manifest of %s: %w", q.Revision, err)
+	}
+
+	metadata = &v1alpha1.RevisionMetadata{
+		Author:  manifest.Annotations[imagev1.AnnotationAuthors],
+		Message: manifest.Annotations[imagev1.AnnotationDescription],
+	}
+	if version := manifest.Annotations[imagev1.AnnotationVersion]; version != "" {
+		metadata.Tags = []string{version}
+	}
+	if created, err := time.Parse(time.RFC3339, manifest.Annotations[imagev1.AnnotationCreated]); err == nil {
+		metadata.Date = metav1.Time{Time: created}
+	}
+	_ = s.cache.SetRevisionMetadata(q.Repo.Repo, q.Revision, metadata)
+	return metadata, nil
+}
+
 // GetRevisionChartDetails returns the helm chart details of a given version
 func (s *Service) GetRevisionChartDetails(_ context.Context, q *apiclient.RepoServerRevisionChartDetailsRequest) (*v1alpha1.ChartDetails, error) {
 	details, err := s.cache.GetRevisionChartDetails(q.Repo.Repo, q.Name, q.Revision)
@@ -2437,6 +2528,26 @@ func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revisi
 	return helmClient, maxV, nil
 }
 
+func (s *Service) newOCIClientResolveRevision(ctx context.Context, repo *v1alpha1.Repository, revision string, noRevisionCache bool) (oci.Client, string, error) {
+	ociClient, err := s.newOCIClient(repo.Repo, repo.GetOCICreds(), repo.Proxy, repo.NoProxy, s.ociClientOpts()...)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to initialize oci client: %w", err)
+	}
+	digest, err := ociClient.ResolveRevision(ctx, revision, noRevisionCache)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve revision %q: %w", revision, err)
+	}
+	return ociClient, digest, nil
+}
+
+func (s *Service) ociClientOpts() []oci.ClientOpts {
+	opts := []oci.ClientOpts{oci.WithIndexCache(s.cache), oci.WithImagePaths(s.ociPaths)}
+	if len(s.initConstants.OCILayerMediaTypes) > 0 {
+		opts = append(opts, oci.WithLayerMediaTypes(s.initConstants.OCILayerMediaTypes))
+	}
+	return opts
+}
+
 // directoryPermissionInitializer ensures the directory has read/write/execute permissions and returns
 // a function that can be used to remove all permissions.
 func directoryPermissionInitializer(rootPath string) goio.Closer {
@@ -2570,7 +2681,7 @@ func (s *Service) GetHelmCharts(_ context.Context, q *apiclient.HelmChartsReques
 	return &res, nil
 }
 
-func (s *Service) TestRepository(_ context.Context, q *apiclient.TestRepositoryRequest) (*apiclient.TestRepositoryResponse, error) {
+func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositoryRequest) (*apiclient.TestRepositoryResponse, error) {
 	repo := q.Repo
 	// per Type doc, "git" should be assumed if empty or absent
 	if repo.Type == "" {
@@ -2591,6 +2702,17 @@ func (s *Service) TestRepository(_ context.Context, q *apiclient.TestRepositoryR
 			_, err := helm.NewClient(repo.Repo, repo.GetHelmCreds(), repo.EnableOCI, repo.Proxy, repo.NoProxy).GetIndex(false, s.initConstants.HelmRegistryMaxIndexSize)
 			return err
 		},
+		"oci": func() error {
+			if !oci.IsOCIRepo(repo.Repo) {
+				return errors.New("OCI repository URL should start with " + oci.Prefix)
+			}
+			ociClient, err := s.newOCIClient(repo.Repo, repo.GetOCICreds(), repo.Proxy, repo.NoProxy, s.ociClientOpts()...)
+			if err != nil {
+				return err
+			}
+			_, err = ociClient.TestRepo(ctx)
+			return err
+		},
 	}
 	check := checks[repo.Type]
 	apiResp := &apiclient.TestRepositoryResponse{VerifiedRepository: false}
@@ -2602,12 +2724,22 @@ func (s *Service) TestRepository(_ context.Context, q *apiclient.TestRepositoryR
 }
 
 // ResolveRevision resolves the revision/ambiguousRevision specified in the ResolveRevisionRequest request into a concrete revision.
-func (s *Service) ResolveRevision(_ context.Context, q *apiclient.ResolveRevisionRequest) (*apiclient.ResolveRevisionResponse, error) {
+func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevisionRequest) (*apiclient.ResolveRevisionResponse, error) {
 	repo := q.Repo
 	app := q.App
 	ambiguousRevision := q.AmbiguousRevision
 	var revision string
 	source := app.Spec.GetSourcePtrByIndex(int(q.SourceIndex))
+	if source.IsOCI() {
+		_, revision, err := s.newOCIClientResolveRevision(ctx, repo, ambiguousRevision, true)
+		if err != nil {
+			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
+		}
+		return &apiclient.ResolveRevisionResponse{
+			Revision:          revision,
+			AmbiguousRevision: fmt.Sprintf("%v (%v)", ambiguousRevision, revision),
+		}, nil
+	}
 	if source.IsHelm() {
 		_, revision, err := s.newHelmClientResolveRevision(repo, ambiguousRevision, source.Chart, true)
 		if err != nil {
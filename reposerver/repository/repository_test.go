@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -48,6 +49,8 @@ import (
 	helmmocks "github.com/argoproj/argo-cd/v3/util/helm/mocks"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	iomocks "github.com/argoproj/argo-cd/v3/util/io/mocks"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+	ocimocks "github.com/argoproj/argo-cd/v3/util/oci/mocks"
 )
 
 const testSignature = `gpg: Signature made Wed Feb 26 23:22:34 2020 CET
@@ -509,6 +512,45 @@ func TestHelmManifestFromChartRepo(t *testing.T) {
 	gitMocks.AssertNotCalled(t, "LsRemote", mock.Anything)
 }
 
+const testOCIDigest = "sha256:7c2b9ba69a2c0edb1bbda4f6ef4a8a6cc3baf2e4b14ca6b6bd40ae8bbba8c2bd"
+
+func newServiceWithOCIMocks(t *testing.T, root string, extractedPath string) (*Service, *ocimocks.Client) {
+	t.Helper()
+	service, _, _ := newServiceWithMocks(t, root, false)
+	ociClient := &ocimocks.Client{}
+	ociClient.On("ResolveRevision", mock.Anything, "1.0.0", mock.Anything).Return(testOCIDigest, nil)
+	ociClient.On("ResolveRevision", mock.Anything, testOCIDigest, mock.Anything).Return(testOCIDigest, nil)
+	ociClient.On("CleanCache", testOCIDigest).Return(nil)
+	ociClient.On("Extract", mock.Anything, testOCIDigest, int64(0), false).Return(extractedPath, utilio.NopCloser, nil)
+	service.newOCIClient = func(_ string, _ oci.Creds, _ string, _ string, _ ...oci.ClientOpts) (oci.Client, error) {
+		return ociClient, nil
+	}
+	return service, ociClient
+}
+
+func TestOCIManifestFromRepo(t *testing.T) {
+	service, ociClient := newServiceWithOCIMocks(t, t.TempDir(), "./testdata/my-chart")
+	source := &v1alpha1.ApplicationSource{RepoURL: "oci://example.com/manifests", Path: "templates", TargetRevision: "1.0.0"}
+	request := &apiclient.ManifestRequest{
+		Repo: &v1alpha1.Repository{Repo: "oci://example.com/manifests", Type: "oci"}, ApplicationSource: source, NoCache: true, ProjectName: "something",
+		ProjectSourceRepos: []string{"*"},
+	}
+	response, err := service.GenerateManifest(t.Context(), request)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"}, response.Manifests)
+	assert.Equal(t, testOCIDigest, response.Revision)
+	assert.Equal(t, "Directory", response.SourceType)
+	ociClient.AssertCalled(t, "CleanCache", testOCIDigest)
+}
+
+func TestOCIManifestWithOutOfBoundsSymlink(t *testing.T) {
+	service, _ := newServiceWithOCIMocks(t, t.TempDir(), "./testdata/out-of-bounds-link")
+	source := &v1alpha1.ApplicationSource{RepoURL: "oci://example.com/manifests", Path: ".", TargetRevision: "1.0.0"}
+	request := &apiclient.ManifestRequest{Repo: &v1alpha1.Repository{Repo: "oci://example.com/manifests", Type: "oci"}, ApplicationSource: source, NoCache: true}
+	_, err := service.GenerateManifest(t.Context(), request)
+	assert.ErrorContains(t, err, "oci image contains out-of-bounds symlinks")
+}
+
 func TestHelmChartReferencingExternalValues(t *testing.T) {
 	service := newService(t, ".")
 	spec := v1alpha1.ApplicationSpec{
@@ -2862,6 +2904,56 @@ func TestTestRepoOCI(t *testing.T) {
 	assert.ErrorContains(t, err, "OCI Helm repository URL should include hostname and port only")
 }
 
+func TestTestRepoGenericOCI(t *testing.T) {
+	service := newService(t, ".")
+	_, err := service.TestRepository(t.Context(), &apiclient.TestRepositoryRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "https://example.com/manifests",
+			Type: "oci",
+		},
+	})
+	assert.ErrorContains(t, err, "OCI repository URL should start with oci://")
+}
+
+func TestResolveRevisionOCI(t *testing.T) {
+	service, _ := newServiceWithOCIMocks(t, ".", "./testdata/my-chart")
+	repo := &v1alpha1.Repository{Repo: "oci://example.com/manifests", Type: "oci"}
+	app := &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{Source: &v1alpha1.ApplicationSource{RepoURL: repo.Repo}}}
+	resolveRevisionResponse, err := service.ResolveRevision(t.Context(), &apiclient.ResolveRevisionRequest{
+		Repo:              repo,
+		App:               app,
+		AmbiguousRevision: "1.0.0",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &apiclient.ResolveRevisionResponse{
+		Revision:          testOCIDigest,
+		AmbiguousRevision: "1.0.0 (" + testOCIDigest + ")",
+	}, resolveRevisionResponse)
+}
+
+func TestGetRevisionMetadataOCI(t *testing.T) {
+	service, ociClient := newServiceWithOCIMocks(t, ".", "./testdata/my-chart")
+	created := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	ociClient.On("DigestMetadata", mock.Anything, testOCIDigest).Return(&imagev1.Manifest{
+		Annotations: map[string]string{
+			imagev1.AnnotationAuthors:     "author",
+			imagev1.AnnotationCreated:     created.Format(time.RFC3339),
+			imagev1.AnnotationDescription: "test",
+			imagev1.AnnotationVersion:     "1.0.0",
+		},
+	}, nil)
+
+	res, err := service.GetRevisionMetadata(t.Context(), &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:     &v1alpha1.Repository{Repo: "oci://example.com/manifests", Type: "oci"},
+		Revision: testOCIDigest,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "author", res.Author)
+	assert.Equal(t, "test", res.Message)
+	assert.True(t, created.Equal(res.Date.Time))
+	assert.Equal(t, []string{"1.0.0"}, res.Tags)
+}
+
 func Test_getHelmDependencyRepos(t *testing.T) {
 	repo1 := "https://charts.bitnami.com/bitnami"
 	repo2 := "https://eventstore.github.io/EventStore.Charts"
@@ -2,6 +2,13 @@ local hs = {}
 
 if obj.status ~= nil then
   if obj.status.conditions ~= nil then
+    for i, condition in pairs(obj.status.conditions) do
+      if condition.type == "RolloutProgressing" and (condition.reason == "RolloutDegraded" or condition.reason == "RolloutRolledBack") then
+        hs.status = "Degraded"
+        hs.message = condition.message
+        return hs
+      end
+    end
     for i, condition in pairs(obj.status.conditions) do
       if condition.type == "ErrorOccurred" and condition.status == "True" then
         hs.status = "Degraded"
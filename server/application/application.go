@@ -40,6 +40,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
 
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	argocommon "github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -86,6 +87,7 @@ type Server struct {
 	appInformer            cache.SharedIndexInformer
 	appBroadcaster         Broadcaster
 	repoClientset          apiclient.Clientset
+	commitClientset        commitclient.Clientset
 	kubectl                kube.Kubectl
 	db                     db.ArgoDB
 	enf                    *rbac.Enforcer
@@ -107,6 +109,7 @@ func NewServer(
 	appInformer cache.SharedIndexInformer,
 	appBroadcaster Broadcaster,
 	repoClientset apiclient.Clientset,
+	commitClientset commitclient.Clientset,
 	cache *servercache.Cache,
 	kubectl kube.Kubectl,
 	db db.ArgoDB,
@@ -135,6 +138,7 @@ func NewServer(
 		cache:                  cache,
 		db:                     db,
 		repoClientset:          repoClientset,
+		commitClientset:        commitClientset,
 		kubectl:                kubectl,
 		enf:                    enf,
 		projectLock:            projectLock,
@@ -461,8 +465,61 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 		return nil, security.NamespaceNotPermittedError(a.Namespace)
 	}
 
+	sources := make([]v1alpha1.ApplicationSource, 0)
+	appSpec := a.Spec
+	if a.Spec.HasMultipleSources() {
+		numOfSources := int64(len(a.Spec.GetSources()))
+		for i, pos := range q.SourcePositions {
+			if pos <= 0 || pos > numOfSources {
+				return nil, errors.New("source position is out of range")
+			}
+			appSpec.Sources[pos-1].TargetRevision = q.Revisions[i]
+		}
+		sources = appSpec.GetSources()
+	} else {
+		source := a.Spec.GetSource()
+		if q.GetRevision() != "" {
+			source.TargetRevision = q.GetRevision()
+		}
+		sources = append(sources, source)
+	}
+
+	manifestInfos, err := s.generateManifests(ctx, a, proj, sources)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := &apiclient.ManifestResponse{}
+	for _, manifestInfo := range manifestInfos {
+		for i, manifest := range manifestInfo.Manifests {
+			obj := &unstructured.Unstructured{}
+			err = json.Unmarshal([]byte(manifest), obj)
+			if err != nil {
+				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+			}
+			if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
+				obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
+				if err != nil {
+					return nil, fmt.Errorf("error hiding secret data: %w", err)
+				}
+				data, err := json.Marshal(obj)
+				if err != nil {
+					return nil, fmt.Errorf("error marshaling manifest: %w", err)
+				}
+				manifestInfo.Manifests[i] = string(data)
+			}
+		}
+		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
+	}
+
+	return manifests, nil
+}
+
+// generateManifests generates the manifests of the given sources of the app on the repo server, one response per
+// source.
+func (s *Server) generateManifests(ctx context.Context, a *v1alpha1.Application, proj *v1alpha1.AppProject, sources []v1alpha1.ApplicationSource) ([]*apiclient.ManifestResponse, error) {
 	manifestInfos := make([]*apiclient.ManifestResponse, 0)
-	err = s.queryRepoServer(ctx, proj, func(
+	err := s.queryRepoServer(ctx, proj, func(
 		client apiclient.RepoServerServiceClient, helmRepos []*v1alpha1.Repository, helmCreds []*v1alpha1.RepoCreds, helmOptions *v1alpha1.HelmOptions, enableGenerateManifests map[string]bool,
 	) error {
 		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
@@ -485,27 +542,8 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("error getting API resources: %w", err)
 		}
 
-		sources := make([]v1alpha1.ApplicationSource, 0)
-		appSpec := a.Spec
-		if a.Spec.HasMultipleSources() {
-			numOfSources := int64(len(a.Spec.GetSources()))
-			for i, pos := range q.SourcePositions {
-				if pos <= 0 || pos > numOfSources {
-					return errors.New("source position is out of range")
-				}
-				appSpec.Sources[pos-1].TargetRevision = q.Revisions[i]
-			}
-			sources = appSpec.GetSources()
-		} else {
-			source := a.Spec.GetSource()
-			if q.GetRevision() != "" {
-				source.TargetRevision = q.GetRevision()
-			}
-			sources = append(sources, source)
-		}
-
 		// Store the map of all sources having ref field into a map for applications with sources field
-		refSources, err := argo.GetRefSources(context.Background(), sources, appSpec.Project, s.db.GetRepository, []string{}, false)
+		refSources, err := argo.GetRefSources(context.Background(), sources, a.Spec.Project, s.db.GetRepository, []string{}, false)
 		if err != nil {
 			return fmt.Errorf("failed to get ref sources: %w", err)
 		}
@@ -566,31 +604,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	if err != nil {
 		return nil, err
 	}
-
-	manifests := &apiclient.ManifestResponse{}
-	for _, manifestInfo := range manifestInfos {
-		for i, manifest := range manifestInfo.Manifests {
-			obj := &unstructured.Unstructured{}
-			err = json.Unmarshal([]byte(manifest), obj)
-			if err != nil {
-				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
-			}
-			if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
-				obj, _, err = diff.HideSecretData(obj, nil, s.settingsMgr.GetSensitiveAnnotations())
-				if err != nil {
-					return nil, fmt.Errorf("error hiding secret data: %w", err)
-				}
-				data, err := json.Marshal(obj)
-				if err != nil {
-					return nil, fmt.Errorf("error marshaling manifest: %w", err)
-				}
-				manifestInfo.Manifests[i] = string(data)
-			}
-		}
-		manifests.Manifests = append(manifests.Manifests, manifestInfo.Manifests...)
-	}
-
-	return manifests, nil
+	return manifestInfos, nil
 }
 
 func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_GetManifestsWithFilesServer) error {
@@ -1939,7 +1953,11 @@ func (s *Server) Sync(ctx context.Context, syncReq *application.ApplicationSyncR
 
 	s.inferResourcesStatusHealth(a)
 
-	canSync, err := proj.Spec.SyncWindows.Matches(a).CanSync(true)
+	windows, err := argo.GetSyncWindows(proj, s.settingsMgr, time.Now(), time.Now())
+	if err != nil {
+		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
+	}
+	canSync, err := windows.Matches(a).CanSync(true)
 	if err != nil {
 		return a, status.Errorf(codes.PermissionDenied, "cannot sync: invalid sync window: %v", err)
 	}
@@ -2119,18 +2137,8 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 		syncOptions = a.Spec.SyncPolicy.SyncOptions
 	}
 
-	// Rollback is just a convenience around Sync
 	op := v1alpha1.Operation{
-		Sync: &v1alpha1.SyncOperation{
-			Revision:     deploymentInfo.Revision,
-			Revisions:    deploymentInfo.Revisions,
-			DryRun:       rollbackReq.GetDryRun(),
-			Prune:        rollbackReq.GetPrune(),
-			SyncOptions:  syncOptions,
-			SyncStrategy: &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{}},
-			Source:       &deploymentInfo.Source,
-			Sources:      deploymentInfo.Sources,
-		},
+		Sync:        argo.NewRollbackSyncOperation(deploymentInfo, syncOptions, rollbackReq.GetDryRun(), rollbackReq.GetPrune()),
 		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
 	}
 	appName := rollbackReq.GetName()
@@ -2657,7 +2665,11 @@ func (s *Server) GetApplicationSyncWindows(ctx context.Context, q *application.A
 		return nil, err
 	}
 
-	windows := proj.Spec.SyncWindows.Matches(a)
+	projWindows, err := argo.GetSyncWindows(proj, s.settingsMgr, time.Now(), time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("invalid sync windows: %w", err)
+	}
+	windows := projWindows.Matches(a)
 	sync, err := windows.CanSync(true)
 	if err != nil {
 		return nil, fmt.Errorf("invalid sync windows: %w", err)
@@ -2709,6 +2721,9 @@ func convertSyncWindows(w *v1alpha1.SyncWindows) []*application.ApplicationSyncW
 				Duration:   &w.Duration,
 				ManualSync: &w.ManualSync,
 			}
+			for i := range w.DateRanges {
+				nw.DateRanges = append(nw.DateRanges, &w.DateRanges[i])
+			}
 			windows = append(windows, nw)
 		}
 		if len(windows) > 0 {
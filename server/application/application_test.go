@@ -41,6 +41,8 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	mockcommitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -57,6 +59,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/grpc"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/rbac"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
@@ -307,6 +310,7 @@ func newTestAppServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforcer),
 		appInformer,
 		broadcaster,
 		mockRepoClient,
+		nil,
 		appCache,
 		kubectl,
 		db,
@@ -470,6 +474,7 @@ func newTestAppServerWithEnforcerConfigureWithBenchmark(b *testing.B, f func(*rb
 		appInformer,
 		broadcaster,
 		mockRepoClient,
+		nil,
 		appCache,
 		kubectl,
 		db,
@@ -2411,6 +2416,82 @@ func TestGetAppRefresh_HardRefresh(t *testing.T) {
 	}
 }
 
+func TestHydrate(t *testing.T) {
+	t.Parallel()
+
+	newHydratedApp := func() *v1alpha1.Application {
+		return newTestApp(func(app *v1alpha1.Application) {
+			app.Spec.Source = nil
+			app.Spec.SourceHydrator = &v1alpha1.SourceHydrator{
+				DrySource: v1alpha1.DrySource{
+					RepoURL:        "https://github.com/argoproj/argocd-example-apps.git",
+					TargetRevision: "main",
+					Path:           "guestbook",
+				},
+				SyncSource: v1alpha1.SyncSource{
+					TargetBranch: "env/prod",
+					Path:         "prod/guestbook",
+				},
+			}
+		})
+	}
+
+	t.Run("app does not use the source hydrator", func(t *testing.T) {
+		t.Parallel()
+
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+		_, err := appServer.Hydrate(t.Context(), &application.ApplicationHydrateRequest{Name: &testApp.Name})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("request hydration", func(t *testing.T) {
+		t.Parallel()
+
+		testApp := newHydratedApp()
+		appServer := newTestAppServer(t, testApp)
+		_, err := appServer.Hydrate(t.Context(), &application.ApplicationHydrateRequest{Name: &testApp.Name})
+		require.NoError(t, err)
+
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testApp.Namespace).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, string(v1alpha1.HydrateTypeNormal), app.Annotations[v1alpha1.AnnotationKeyHydrate])
+	})
+
+	t.Run("dry run without commit server", func(t *testing.T) {
+		t.Parallel()
+
+		testApp := newHydratedApp()
+		appServer := newTestAppServer(t, testApp)
+		_, err := appServer.Hydrate(t.Context(), &application.ApplicationHydrateRequest{Name: &testApp.Name, DryRun: ptr.To(true)})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		t.Parallel()
+
+		testApp := newHydratedApp()
+		appServer := newTestAppServer(t, testApp)
+		commitServiceClient := mockcommitclient.NewCommitServiceClient(t)
+		commitServiceClient.EXPECT().CommitHydratedManifests(mock.Anything, mock.MatchedBy(func(r *commitclient.CommitHydratedManifestsRequest) bool {
+			return r.DryRun && r.TargetBranch == "env/prod" && r.SyncBranch == "env/prod"
+		})).Return(&commitclient.CommitHydratedManifestsResponse{Diff: "diff --git a/prod/guestbook/manifest.yaml"}, nil)
+		commitClientset := mockcommitclient.NewClientset(t)
+		commitClientset.EXPECT().NewCommitServerClient().Return(utilio.NopCloser, commitServiceClient, nil)
+		appServer.commitClientset = commitClientset
+
+		resp, err := appServer.Hydrate(t.Context(), &application.ApplicationHydrateRequest{Name: &testApp.Name, DryRun: ptr.To(true)})
+		require.NoError(t, err)
+		assert.Equal(t, "env/prod", resp.GetTargetBranch())
+		assert.Equal(t, []string{"prod/guestbook"}, resp.GetPaths())
+		assert.Equal(t, "diff --git a/prod/guestbook/manifest.yaml", resp.GetDiff())
+
+		app, err := appServer.appclientset.ArgoprojV1alpha1().Applications(testApp.Namespace).Get(t.Context(), testApp.Name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.NotContains(t, app.Annotations, v1alpha1.AnnotationKeyHydrate)
+	})
+}
+
 func TestInferResourcesStatusHealth(t *testing.T) {
 	cacheClient := cache.NewCache(cache.NewInMemoryCache(1 * time.Hour))
 
@@ -0,0 +1,145 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+
+	argocommon "github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applisters "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+// Hydrate requests the hydration of an application's dry sources. Dry runs render the manifests of all applications
+// sharing the app's hydrated branch and return the diff against the current content of the branch, without committing
+// anything.
+func (s *Server) Hydrate(ctx context.Context, q *application.ApplicationHydrateRequest) (*application.ApplicationHydrateResponse, error) {
+	a, _, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if a.Spec.SourceHydrator == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "application %q does not use the source hydrator", a.QualifiedName())
+	}
+
+	if !q.GetDryRun() {
+		appIf := s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace)
+		if _, err = argo.RefreshApp(appIf, a.Name, v1alpha1.RefreshTypeNormal, true); err != nil {
+			return nil, fmt.Errorf("error requesting hydration of the app: %w", err)
+		}
+		return &application.ApplicationHydrateResponse{}, nil
+	}
+
+	if s.commitClientset == nil {
+		return nil, status.Error(codes.Unimplemented, "dry runs require the source hydrator to be enabled on the API server")
+	}
+
+	manifestsRequest, project, err := hydrator.DryRun(&hydrationRenderer{ctx: ctx, server: s}, a)
+	if err != nil {
+		return nil, fmt.Errorf("error rendering hydrated manifests: %w", err)
+	}
+	repo, err := s.db.GetWriteRepository(ctx, manifestsRequest.Repo.Repo, project)
+	if err != nil {
+		return nil, fmt.Errorf("error getting hydrator credentials: %w", err)
+	}
+	if repo != nil {
+		manifestsRequest.Repo = repo
+	}
+
+	closer, commitClient, err := s.commitClientset.NewCommitServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating commit server client: %w", err)
+	}
+	defer utilio.Close(closer)
+	resp, err := commitClient.CommitHydratedManifests(ctx, manifestsRequest)
+	if err != nil {
+		return nil, fmt.Errorf("error diffing hydrated manifests: %w", err)
+	}
+
+	paths := make([]string, 0, len(manifestsRequest.Paths))
+	for _, path := range manifestsRequest.Paths {
+		paths = append(paths, path.Path)
+	}
+	return &application.ApplicationHydrateResponse{
+		DrySHA:       ptr.To(manifestsRequest.DrySha),
+		TargetBranch: ptr.To(manifestsRequest.TargetBranch),
+		Paths:        paths,
+		Diff:         ptr.To(resp.Diff),
+	}, nil
+}
+
+// hydrationRenderer implements hydrator.RenderDependencies for the API server, so that previews are rendered exactly
+// like the app controller hydrates apps.
+type hydrationRenderer struct {
+	ctx    context.Context
+	server *Server
+}
+
+func (r *hydrationRenderer) GetProcessableAppProj(app *v1alpha1.Application) (*v1alpha1.AppProject, error) {
+	s := r.server
+	return argo.GetAppProject(r.ctx, app, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db)
+}
+
+func (r *hydrationRenderer) GetProcessableApps() (*v1alpha1.ApplicationList, error) {
+	apps, err := r.server.appLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("error listing apps: %w", err)
+	}
+	list := &v1alpha1.ApplicationList{}
+	for _, app := range apps {
+		if r.server.isNamespaceEnabled(app.Namespace) {
+			list.Items = append(list.Items, *app)
+		}
+	}
+	return list, nil
+}
+
+// GetRepoObjs renders the dry sources of an app. All apps sharing the hydrated branch of the previewed app are
+// rendered, so the user must be allowed to get each of them. Secrets are returned as they are, the commit server masks
+// them in the diff.
+func (r *hydrationRenderer) GetRepoObjs(origApp *v1alpha1.Application, drySources []v1alpha1.ApplicationSource, _ []string, project *v1alpha1.AppProject) ([]*unstructured.Unstructured, []*apiclient.ManifestResponse, error) {
+	s := r.server
+	if err := s.enf.EnforceErr(r.ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, origApp.RBACName(s.ns)); err != nil {
+		log.WithField("application", origApp.QualifiedName()).WithField(argocommon.SecurityField, argocommon.SecurityMedium).
+			Warnf("user tried to preview hydration of application which they do not have access to: %s", err)
+		return nil, nil, argocommon.PermissionDeniedAPIError
+	}
+
+	app := origApp.DeepCopy()
+	// The app controller ignores the manifest generate paths annotation when hydrating, so do we.
+	delete(app.Annotations, v1alpha1.AnnotationKeyManifestGeneratePaths)
+	if len(drySources) > 1 {
+		app.Spec.SourceHydrator = nil
+		app.Spec.Sources = drySources
+	}
+
+	resps, err := s.generateManifests(r.ctx, app, project, drySources)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var objs []*unstructured.Unstructured
+	for _, resp := range resps {
+		for _, manifest := range resp.Manifests {
+			obj := &unstructured.Unstructured{}
+			if err := json.Unmarshal([]byte(manifest), obj); err != nil {
+				return nil, nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+			}
+			objs = append(objs, obj)
+		}
+	}
+	return objs, resps, nil
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	appsetstatus "github.com/argoproj/argo-cd/v3/applicationset/status"
 	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
@@ -58,6 +59,7 @@ type Server struct {
 	projLister               applisters.AppProjectNamespaceLister
 	auditLogger              *argo.AuditLogger
 	settings                 *settings.SettingsManager
+	clusterInfo              generators.ClusterInfoGetter
 	projectLock              sync.KeyLock
 	enabledNamespaces        []string
 	GitSubmoduleEnabled      bool
@@ -65,6 +67,8 @@ type Server struct {
 	ScmRootCAPath            string
 	AllowedScmProviders      []string
 	EnableScmProviders       bool
+	OCIConfig                generators.OCIConfig
+	KubernetesResourceConfig generators.KubernetesResourceConfig
 }
 
 // NewServer returns a new instance of the ApplicationSet service
@@ -80,6 +84,7 @@ func NewServer(
 	appsetLister applisters.ApplicationSetLister,
 	projLister applisters.AppProjectNamespaceLister,
 	settings *settings.SettingsManager,
+	clusterInfo generators.ClusterInfoGetter,
 	namespace string,
 	projectLock sync.KeyLock,
 	enabledNamespaces []string,
@@ -88,6 +93,10 @@ func NewServer(
 	scmRootCAPath string,
 	allowedScmProviders []string,
 	enableScmProviders bool,
+	ociInsecureRegistries []string,
+	enableKubernetesResource bool,
+	kubernetesResources []string,
+	kubernetesNamespaces []string,
 	enableK8sEvent []string,
 ) applicationset.ApplicationSetServiceServer {
 	s := &Server{
@@ -103,6 +112,7 @@ func NewServer(
 		appsetLister:             appsetLister,
 		projLister:               projLister,
 		settings:                 settings,
+		clusterInfo:              clusterInfo,
 		projectLock:              projectLock,
 		auditLogger:              argo.NewAuditLogger(namespace, kubeclientset, "argocd-server", enableK8sEvent),
 		enabledNamespaces:        enabledNamespaces,
@@ -111,6 +121,8 @@ func NewServer(
 		ScmRootCAPath:            scmRootCAPath,
 		AllowedScmProviders:      allowedScmProviders,
 		EnableScmProviders:       enableScmProviders,
+		OCIConfig:                generators.NewOCIConfig(ociInsecureRegistries),
+		KubernetesResourceConfig: generators.NewKubernetesResourceConfig(enableKubernetesResource, kubernetesResources, kubernetesNamespaces),
 	}
 	return s
 }
@@ -267,7 +279,7 @@ func (s *Server) generateApplicationSetApps(ctx context.Context, logEntry *log.E
 
 	scmConfig := generators.NewSCMConfig(s.ScmRootCAPath, s.AllowedScmProviders, s.EnableScmProviders, github_app.NewAuthCredentials(argoCDDB.(db.RepoCredsDB)), true)
 	argoCDService := services.NewArgoCDService(s.db, s.GitSubmoduleEnabled, s.repoClientSet, s.EnableNewGitFileGlobbing)
-	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, namespace, argoCDService, s.dynamicClient, scmConfig)
+	appSetGenerators := generators.GetGenerators(ctx, s.client, s.k8sClient, namespace, argoCDService, s.dynamicClient, scmConfig, s.OCIConfig, s.KubernetesResourceConfig, nil, s.clusterInfo)
 
 	apps, _, err := appsettemplate.GenerateApplications(logEntry, appset, appSetGenerators, &appsetutils.Render{}, s.client)
 	if err != nil {
@@ -340,6 +352,131 @@ func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDel
 	return &applicationset.ApplicationSetResponse{}, nil
 }
 
+// Promote approves a step of the RollingSync strategy of an ApplicationSet, or completes a paused or degraded step.
+// The promotion is recorded by the ApplicationSet controller.
+func (s *Server) Promote(ctx context.Context, q *applicationset.ApplicationSetPromoteRequest) (*v1alpha1.ApplicationSet, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+
+	appset, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionUpdate, appset.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	step, err := getPromotedStep(appset, int(q.Step))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	for i := 0; i < 10; i++ {
+		if appset.Annotations == nil {
+			appset.Annotations = map[string]string{}
+		}
+		appset.Annotations[common.AnnotationApplicationSetPromote] = strconv.Itoa(step)
+		res, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Update(ctx, appset, metav1.UpdateOptions{})
+		if err == nil {
+			s.logAppSetEvent(ctx, appset, argo.EventReasonOperationStarted, fmt.Sprintf("promoted step %d of ApplicationSet rollout", step))
+			return res, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating ApplicationSet: %w", err)
+		}
+
+		appset, err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to update ApplicationSets. Too many conflicts")
+}
+
+// getPromotedStep returns the number of the RollingSync step to promote. If no step is requested, it is the first step
+// awaiting approval, paused or degraded.
+func getPromotedStep(appset *v1alpha1.ApplicationSet, step int) (int, error) {
+	if appset.Spec.Strategy == nil || appset.Spec.Strategy.Type != "RollingSync" || appset.Spec.Strategy.RollingSync == nil || len(appset.Spec.Strategy.RollingSync.Steps) == 0 {
+		return 0, fmt.Errorf("ApplicationSet %s does not use the RollingSync strategy", appset.Name)
+	}
+	steps := len(appset.Spec.Strategy.RollingSync.Steps)
+	if step < 0 || step > steps {
+		return 0, fmt.Errorf("step %d does not exist, ApplicationSet %s has %d steps", step, appset.Name, steps)
+	}
+	if step > 0 {
+		return step, nil
+	}
+	for _, stepStatus := range appset.Status.RolloutSteps {
+		switch stepStatus.Status {
+		case v1alpha1.RolloutStepStatusAwaitingApproval, v1alpha1.RolloutStepStatusPaused, v1alpha1.RolloutStepStatusDegraded:
+			return strconv.Atoi(stepStatus.Step)
+		}
+	}
+	return 0, fmt.Errorf("no step of ApplicationSet %s is awaiting approval, paused or degraded", appset.Name)
+}
+
+// ApproveDeletion approves the deletions of Applications exceeding the maxDeletions of an ApplicationSet. The
+// ApplicationSet controller deletes them on its next reconcile.
+func (s *Server) ApproveDeletion(ctx context.Context, q *applicationset.ApplicationSetApproveDeletionRequest) (*v1alpha1.ApplicationSet, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+
+	appset, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+	}
+
+	// Approving the deletions deletes Applications of the ApplicationSet
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionDelete, appset.RBACName(s.ns)); err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < 10; i++ {
+		// The approval holds the digest of the deletions awaiting approval, so that the controller does not apply it
+		// to deletions which were not pending when it was given
+		deletionSet, ok := deletionsAwaitingApproval(appset)
+		if !ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "ApplicationSet %s has no deletions awaiting approval", appset.Name)
+		}
+		if appset.Annotations == nil {
+			appset.Annotations = map[string]string{}
+		}
+		appset.Annotations[common.AnnotationApplicationSetApproveDeletion] = deletionSet
+		res, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Update(ctx, appset, metav1.UpdateOptions{})
+		if err == nil {
+			s.logAppSetEvent(ctx, appset, argo.EventReasonOperationStarted, fmt.Sprintf("approved deletions of ApplicationSet Applications %s", appsetutils.FormatDeletionSet(deletionSet)))
+			return res, nil
+		}
+		if !apierrors.IsConflict(err) {
+			return nil, fmt.Errorf("error updating ApplicationSet: %w", err)
+		}
+
+		appset, err = s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, q.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "Failed to update ApplicationSets. Too many conflicts")
+}
+
+// deletionsAwaitingApproval returns the digest of the deletions exceeding the maxDeletions of the ApplicationSet which
+// the ApplicationSet controller paused, if any.
+func deletionsAwaitingApproval(appset *v1alpha1.ApplicationSet) (string, bool) {
+	for _, condition := range appset.Status.Conditions {
+		if condition.Type == v1alpha1.ApplicationSetConditionResourcesUpToDate && condition.Status == v1alpha1.ApplicationSetConditionStatusFalse && condition.Reason == v1alpha1.ApplicationSetReasonDeletionThresholdExceeded {
+			return appsetutils.ParseDeletionSet(condition.Message)
+		}
+	}
+	return "", false
+}
+
 func (s *Server) ResourceTree(ctx context.Context, q *applicationset.ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error) {
 	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
 
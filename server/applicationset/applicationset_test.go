@@ -15,6 +15,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	k8scache "k8s.io/client-go/tools/cache"
 
+	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -158,6 +159,7 @@ func newTestAppSetServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforce
 		factory.Argoproj().V1alpha1().ApplicationSets().Lister(),
 		fakeProjLister,
 		settingsMgr,
+		nil,
 		testNamespace,
 		sync.NewKeyLock(),
 		[]string{testNamespace, "external-namespace"},
@@ -166,6 +168,10 @@ func newTestAppSetServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforce
 		"",
 		[]string{},
 		true,
+		[]string{},
+		false,
+		[]string{},
+		[]string{},
 		testEnableEventList,
 	)
 	return server.(*Server)
@@ -505,6 +511,106 @@ func TestDeleteAppSet(t *testing.T) {
 	})
 }
 
+func TestPromoteAppSet(t *testing.T) {
+	rollingSync := func(appset *appsv1.ApplicationSet) {
+		appset.Name = "AppSet1"
+		appset.Spec.Strategy = &appsv1.ApplicationSetStrategy{
+			Type: "RollingSync",
+			RollingSync: &appsv1.ApplicationSetRolloutStrategy{
+				Steps: []appsv1.ApplicationSetRolloutStep{{}, {RequireApproval: true}, {PauseDuration: "1h"}},
+			},
+		}
+		appset.Status.RolloutSteps = []appsv1.ApplicationSetRolloutStepStatus{
+			{Step: "1", Status: appsv1.RolloutStepStatusHealthy},
+			{Step: "2", Status: appsv1.RolloutStepStatusAwaitingApproval},
+			{Step: "3", Status: appsv1.RolloutStepStatusWaiting},
+		}
+	}
+
+	t.Run("Promote the step awaiting approval", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestAppSet(rollingSync))
+
+		res, err := appSetServer.Promote(t.Context(), &applicationset.ApplicationSetPromoteRequest{Name: "AppSet1"})
+		require.NoError(t, err)
+		assert.Equal(t, "2", res.Annotations[common.AnnotationApplicationSetPromote])
+	})
+
+	t.Run("Promote a given step", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestAppSet(rollingSync))
+
+		res, err := appSetServer.Promote(t.Context(), &applicationset.ApplicationSetPromoteRequest{Name: "AppSet1", AppsetNamespace: testNamespace, Step: 3})
+		require.NoError(t, err)
+		assert.Equal(t, "3", res.Annotations[common.AnnotationApplicationSetPromote])
+	})
+
+	t.Run("Promote a step which does not exist", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestAppSet(rollingSync))
+
+		_, err := appSetServer.Promote(t.Context(), &applicationset.ApplicationSetPromoteRequest{Name: "AppSet1", Step: 4})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = step 4 does not exist, ApplicationSet AppSet1 has 3 steps")
+	})
+
+	t.Run("Promote without step to promote", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestAppSet(rollingSync, func(appset *appsv1.ApplicationSet) {
+			appset.Status.RolloutSteps = nil
+		}))
+
+		_, err := appSetServer.Promote(t.Context(), &applicationset.ApplicationSetPromoteRequest{Name: "AppSet1"})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = no step of ApplicationSet AppSet1 is awaiting approval, paused or degraded")
+	})
+
+	t.Run("Promote without RollingSync strategy", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "AppSet1"
+		}))
+
+		_, err := appSetServer.Promote(t.Context(), &applicationset.ApplicationSetPromoteRequest{Name: "AppSet1", Step: 1})
+		require.EqualError(t, err, "rpc error: code = InvalidArgument desc = ApplicationSet AppSet1 does not use the RollingSync strategy")
+	})
+}
+
+func TestApproveDeletionAppSet(t *testing.T) {
+	t.Run("Approve deletions awaiting approval", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "AppSet1"
+			appset.Status.Conditions = []appsv1.ApplicationSetCondition{{
+				Type:    appsv1.ApplicationSetConditionResourcesUpToDate,
+				Status:  appsv1.ApplicationSetConditionStatusFalse,
+				Reason:  appsv1.ApplicationSetReasonDeletionThresholdExceeded,
+				Message: "2 Applications would be deleted, which exceeds the maximum of 1 deletions: the deletions are awaiting approval " + appsetutils.FormatDeletionSet(appsetutils.DeletionSetDigest([]string{"app1", "app2"})),
+			}}
+		}))
+
+		res, err := appSetServer.ApproveDeletion(t.Context(), &applicationset.ApplicationSetApproveDeletionRequest{Name: "AppSet1"})
+		require.NoError(t, err)
+		assert.Equal(t, appsetutils.DeletionSetDigest([]string{"app1", "app2"}), res.Annotations[common.AnnotationApplicationSetApproveDeletion])
+	})
+
+	t.Run("Approve deletions without deletion set", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "AppSet1"
+			appset.Status.Conditions = []appsv1.ApplicationSetCondition{{
+				Type:    appsv1.ApplicationSetConditionResourcesUpToDate,
+				Status:  appsv1.ApplicationSetConditionStatusFalse,
+				Reason:  appsv1.ApplicationSetReasonDeletionThresholdExceeded,
+				Message: "2 Applications would be deleted, which exceeds the maximum of 1 deletions: the deletions are awaiting approval",
+			}}
+		}))
+
+		_, err := appSetServer.ApproveDeletion(t.Context(), &applicationset.ApplicationSetApproveDeletionRequest{Name: "AppSet1"})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = ApplicationSet AppSet1 has no deletions awaiting approval")
+	})
+
+	t.Run("Approve without deletions awaiting approval", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "AppSet1"
+		}))
+
+		_, err := appSetServer.ApproveDeletion(t.Context(), &applicationset.ApplicationSetApproveDeletionRequest{Name: "AppSet1"})
+		require.EqualError(t, err, "rpc error: code = FailedPrecondition desc = ApplicationSet AppSet1 has no deletions awaiting approval")
+	})
+}
+
 func TestUpdateAppSet(t *testing.T) {
 	appSet := newTestAppSet(func(appset *appsv1.ApplicationSet) {
 		appset.Annotations = map[string]string{
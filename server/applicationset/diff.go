@@ -0,0 +1,366 @@
+package applicationset
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+)
+
+const (
+	diffActionCreate    = "create"
+	diffActionUpdate    = "update"
+	diffActionDelete    = "delete"
+	diffActionUnchanged = "unchanged"
+	// diffActionConflict is the action for a generated Application whose name is taken by an Application which is not
+	// owned by the ApplicationSet. Its live state is not returned, as it may not be readable by the user.
+	diffActionConflict = "conflict"
+)
+
+// fieldNameRegexp matches the field names which do not need to be quoted in a field path
+var fieldNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Diff compares the Applications generated by an ApplicationSet with the live Applications, and returns the changes
+// the ApplicationSet controller would make to them.
+func (s *Server) Diff(ctx context.Context, q *applicationset.ApplicationSetDiffRequest) (*applicationset.ApplicationSetDiffResponse, error) {
+	appset := q.GetApplicationSet()
+
+	if appset == nil {
+		return nil, errors.New("error comparing ApplicationSet: ApplicationSet is nil in request")
+	}
+	namespace := s.appsetNamespaceOrDefault(appset.Namespace)
+
+	if !s.isNamespaceEnabled(namespace) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+	projectName, err := s.validateAppSet(appset)
+	if err != nil {
+		return nil, fmt.Errorf("error validating ApplicationSets: %w", err)
+	}
+	if err := s.checkCreatePermissions(ctx, appset, projectName); err != nil {
+		return nil, fmt.Errorf("error checking create permissions for ApplicationSets %s : %w", appset.Name, err)
+	}
+
+	// The Applications of the existing ApplicationSet are deleted when they are no longer generated
+	existing, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Get(ctx, appset.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("error getting ApplicationSet: %w", err)
+		}
+		existing = nil
+	}
+	if existing != nil {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplicationSets, rbac.ActionGet, existing.RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+	}
+
+	logs := bytes.NewBuffer(nil)
+	logger := log.New()
+	logger.SetOutput(logs)
+
+	generatedAppSet := appset.DeepCopy()
+	generatedAppSet.Namespace = namespace
+	apps, err := s.generateApplicationSetApps(ctx, logger.WithField("applicationset", appset.Name), *generatedAppSet, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate Applications of ApplicationSet: %w\n%s", err, logs.String())
+	}
+
+	liveApps, err := s.appclientset.ArgoprojV1alpha1().Applications(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing Applications: %w", err)
+	}
+
+	diffs, err := diffApplicationSetApps(generatedAppSet, existing, apps, liveApps.Items)
+	if err != nil {
+		return nil, err
+	}
+	// The ApplicationSet owning an Application does not grant access to it
+	liveByName := map[string]*v1alpha1.Application{}
+	for i := range liveApps.Items {
+		liveByName[liveApps.Items[i].Name] = &liveApps.Items[i]
+	}
+	for _, diff := range diffs {
+		if diff.LiveState == "" {
+			continue
+		}
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, rbac.ActionGet, liveByName[diff.Name].RBACName(s.ns)); err != nil {
+			return nil, err
+		}
+	}
+	return &applicationset.ApplicationSetDiffResponse{Applications: diffs}, nil
+}
+
+// diffApplicationSetApps compares the generated Applications with the live Applications, the way the ApplicationSet
+// controller does. Only the live Applications owned by the existing ApplicationSet, if any, are compared, and deleted
+// when they are no longer generated: a generated Application named like another live Application is a conflict. The
+// sync policy of the ApplicationSet is honored, the policy of the ApplicationSet controller is not known.
+func diffApplicationSetApps(appset *v1alpha1.ApplicationSet, existing *v1alpha1.ApplicationSet, generatedApps []v1alpha1.Application, liveApps []v1alpha1.Application) ([]*applicationset.ApplicationSetApplicationDiff, error) {
+	policy := appsetutils.DefaultPolicy(appset.Spec.SyncPolicy, v1alpha1.ApplicationsSyncPolicySync, true)
+
+	preservedAnnotations := []string{}
+	preservedLabels := []string{}
+	if appset.Spec.PreservedFields != nil {
+		preservedAnnotations = append(preservedAnnotations, appset.Spec.PreservedFields.Annotations...)
+		preservedLabels = append(preservedLabels, appset.Spec.PreservedFields.Labels...)
+	}
+	preservedAnnotations = append(preservedAnnotations, appsetutils.DefaultPreservedAnnotations...)
+
+	liveByName := map[string]*v1alpha1.Application{}
+	otherNames := map[string]bool{}
+	for i := range liveApps {
+		if existing != nil && metav1.IsControlledBy(&liveApps[i], existing) {
+			liveByName[liveApps[i].Name] = &liveApps[i]
+		} else {
+			otherNames[liveApps[i].Name] = true
+		}
+	}
+
+	diffs := []*applicationset.ApplicationSetApplicationDiff{}
+	generated := map[string]bool{}
+	for _, generatedApp := range generatedApps {
+		generated[generatedApp.Name] = true
+		generatedApp.Spec = *argo.NormalizeApplicationSpec(&generatedApp.Spec)
+
+		found, ok := liveByName[generatedApp.Name]
+		if !ok {
+			action := diffActionCreate
+			if otherNames[generatedApp.Name] {
+				action = diffActionConflict
+			}
+			diff, err := newApplicationDiff(generatedApp.Name, appset.Namespace, action, nil, &generatedApp)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, diff)
+			continue
+		}
+
+		live := found.DeepCopy()
+		desired := found.DeepCopy()
+		appsetutils.MergeGeneratedApplication(desired, generatedApp, preservedAnnotations, preservedLabels)
+		changed, err := appsetutils.NormalizeAndCompare(appset.Spec.IgnoreApplicationDifferences, normalizers.IgnoreNormalizerOpts{}, live, desired)
+		if err != nil {
+			return nil, fmt.Errorf("error comparing Application %s: %w", generatedApp.Name, err)
+		}
+		action := diffActionUnchanged
+		if changed && policy.AllowUpdate() {
+			action = diffActionUpdate
+		} else {
+			desired = live
+		}
+		diff, err := newApplicationDiff(generatedApp.Name, appset.Namespace, action, live, desired)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, diff)
+	}
+
+	if policy.AllowDelete() {
+		for name, live := range liveByName {
+			if generated[name] {
+				continue
+			}
+			diff, err := newApplicationDiff(live.Name, live.Namespace, diffActionDelete, live, nil)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, diff)
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Name < diffs[j].Name
+	})
+	return diffs, nil
+}
+
+func newApplicationDiff(name string, namespace string, action string, live *v1alpha1.Application, target *v1alpha1.Application) (*applicationset.ApplicationSetApplicationDiff, error) {
+	liveState, err := managedApplicationState(live)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the state of Application %s: %w", name, err)
+	}
+	targetState, err := managedApplicationState(target)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the state of Application %s: %w", name, err)
+	}
+
+	diff := &applicationset.ApplicationSetApplicationDiff{
+		Name:      name,
+		Namespace: namespace,
+		Action:    action,
+	}
+	if liveState != nil {
+		data, err := json.Marshal(liveState)
+		if err != nil {
+			return nil, err
+		}
+		diff.LiveState = string(data)
+	}
+	if targetState != nil {
+		data, err := json.Marshal(targetState)
+		if err != nil {
+			return nil, err
+		}
+		diff.TargetState = string(data)
+	}
+	if action != diffActionUnchanged && action != diffActionConflict {
+		diff.Fields, err = diffFields("", liveState, targetState, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return diff, nil
+}
+
+// managedApplicationState returns the fields of an Application managed by the ApplicationSet controller, as a JSON
+// object.
+func managedApplicationState(app *v1alpha1.Application) (map[string]any, error) {
+	if app == nil {
+		return nil, nil
+	}
+	state := &v1alpha1.Application{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.ApplicationSchemaGroupVersionKind.GroupVersion().String(),
+			Kind:       v1alpha1.ApplicationSchemaGroupVersionKind.Kind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        app.Name,
+			Namespace:   app.Namespace,
+			Annotations: app.Annotations,
+			Labels:      app.Labels,
+			Finalizers:  app.Finalizers,
+		},
+		Spec:      app.Spec,
+		Operation: app.Operation,
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	res := map[string]any{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	// The status is not managed by the ApplicationSet controller
+	delete(res, "status")
+	return res, nil
+}
+
+// diffFields returns the differences between two JSON values, field by field. Empty values are treated as unset.
+func diffFields(path string, live any, target any, diffs []*applicationset.ApplicationSetFieldDiff) ([]*applicationset.ApplicationSetFieldDiff, error) {
+	live = emptyToNil(live)
+	target = emptyToNil(target)
+
+	liveMap, liveIsMap := live.(map[string]any)
+	targetMap, targetIsMap := target.(map[string]any)
+	if (liveIsMap || live == nil) && (targetIsMap || target == nil) && (liveIsMap || targetIsMap) {
+		keys := []string{}
+		for key := range liveMap {
+			keys = append(keys, key)
+		}
+		for key := range targetMap {
+			if _, ok := liveMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		var err error
+		for _, key := range keys {
+			diffs, err = diffFields(fieldPath(path, key), liveMap[key], targetMap[key], diffs)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return diffs, nil
+	}
+
+	liveList, liveIsList := live.([]any)
+	targetList, targetIsList := target.([]any)
+	if (liveIsList || live == nil) && (targetIsList || target == nil) && (liveIsList || targetIsList) {
+		var err error
+		for i := 0; i < len(liveList) || i < len(targetList); i++ {
+			var liveItem, targetItem any
+			if i < len(liveList) {
+				liveItem = liveList[i]
+			}
+			if i < len(targetList) {
+				targetItem = targetList[i]
+			}
+			diffs, err = diffFields(fmt.Sprintf("%s[%d]", path, i), liveItem, targetItem, diffs)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return diffs, nil
+	}
+
+	if reflect.DeepEqual(live, target) {
+		return diffs, nil
+	}
+	liveValue, err := jsonValue(live)
+	if err != nil {
+		return nil, err
+	}
+	targetValue, err := jsonValue(target)
+	if err != nil {
+		return nil, err
+	}
+	return append(diffs, &applicationset.ApplicationSetFieldDiff{
+		Path:        path,
+		LiveValue:   liveValue,
+		TargetValue: targetValue,
+	}), nil
+}
+
+func fieldPath(path string, key string) string {
+	if !fieldNameRegexp.MatchString(key) {
+		return path + "[" + strconv.Quote(key) + "]"
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func emptyToNil(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 0 {
+			return nil
+		}
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+	}
+	return value
+}
+
+func jsonValue(value any) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
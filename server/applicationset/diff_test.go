@@ -0,0 +1,194 @@
+package applicationset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newDiffTestApp(name string, path string, opts ...func(app *appsv1.Application)) appsv1.Application {
+	app := appsv1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+		Spec: appsv1.ApplicationSpec{
+			Project: "default",
+			Source: &appsv1.ApplicationSource{
+				RepoURL: fakeRepoURL,
+				Path:    path,
+			},
+			Destination: appsv1.ApplicationDestination{
+				Server:    "https://kubernetes.default.svc",
+				Namespace: name,
+			},
+		},
+	}
+	for i := range opts {
+		opts[i](&app)
+	}
+	return app
+}
+
+func ownedBy(appset *appsv1.ApplicationSet) func(app *appsv1.Application) {
+	return func(app *appsv1.Application) {
+		app.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: appsv1.ApplicationSetSchemaGroupVersionKind.GroupVersion().String(),
+			Kind:       appsv1.ApplicationSetSchemaGroupVersionKind.Kind,
+			Name:       appset.Name,
+			UID:        appset.UID,
+			Controller: ptr.To(true),
+		}}
+	}
+}
+
+func TestDiffApplicationSetApps(t *testing.T) {
+	existing := newTestAppSet(func(appset *appsv1.ApplicationSet) {
+		appset.Name = "set"
+		appset.UID = types.UID("set-uid")
+	})
+	notified := func(app *appsv1.Application) {
+		app.Annotations = map[string]string{"notified.notifications.argoproj.io": "{}"}
+	}
+
+	generatedApps := func() []appsv1.Application {
+		return []appsv1.Application{
+			newDiffTestApp("created", "apps/created"),
+			newDiffTestApp("updated", "apps/updated-new"),
+			newDiffTestApp("unchanged", "apps/unchanged"),
+		}
+	}
+	liveApps := func() []appsv1.Application {
+		return []appsv1.Application{
+			newDiffTestApp("updated", "apps/updated", ownedBy(existing)),
+			newDiffTestApp("unchanged", "apps/unchanged", ownedBy(existing), notified),
+			newDiffTestApp("deleted", "apps/deleted", ownedBy(existing)),
+			newDiffTestApp("other", "apps/other"),
+		}
+	}
+
+	t.Run("sync policy", func(t *testing.T) {
+		diffs, err := diffApplicationSetApps(existing, existing, generatedApps(), liveApps())
+		require.NoError(t, err)
+		require.Len(t, diffs, 4)
+
+		assert.Equal(t, "created", diffs[0].Name)
+		assert.Equal(t, "create", diffs[0].Action)
+		assert.Empty(t, diffs[0].LiveState)
+		assert.NotEmpty(t, diffs[0].TargetState)
+		assert.Contains(t, diffs[0].Fields, &applicationset.ApplicationSetFieldDiff{Path: "spec.source.path", TargetValue: `"apps/created"`})
+
+		assert.Equal(t, "deleted", diffs[1].Name)
+		assert.Equal(t, "delete", diffs[1].Action)
+		assert.NotEmpty(t, diffs[1].LiveState)
+		assert.Empty(t, diffs[1].TargetState)
+		assert.Contains(t, diffs[1].Fields, &applicationset.ApplicationSetFieldDiff{Path: "metadata.name", LiveValue: `"deleted"`})
+
+		assert.Equal(t, "unchanged", diffs[2].Name)
+		assert.Equal(t, "unchanged", diffs[2].Action)
+		assert.Empty(t, diffs[2].Fields)
+
+		assert.Equal(t, "updated", diffs[3].Name)
+		assert.Equal(t, "update", diffs[3].Action)
+		assert.Equal(t, []*applicationset.ApplicationSetFieldDiff{{
+			Path:        "spec.source.path",
+			LiveValue:   `"apps/updated"`,
+			TargetValue: `"apps/updated-new"`,
+		}}, diffs[3].Fields)
+	})
+
+	t.Run("create-only sync policy", func(t *testing.T) {
+		appset := existing.DeepCopy()
+		appset.Spec.SyncPolicy = &appsv1.ApplicationSetSyncPolicy{ApplicationsSync: ptr.To(appsv1.ApplicationsSyncPolicyCreateOnly)}
+
+		diffs, err := diffApplicationSetApps(appset, existing, generatedApps(), liveApps())
+		require.NoError(t, err)
+		require.Len(t, diffs, 3)
+		assert.Equal(t, "create", diffs[0].Action)
+		assert.Equal(t, "unchanged", diffs[1].Action)
+		assert.Equal(t, "updated", diffs[2].Name)
+		assert.Equal(t, "unchanged", diffs[2].Action)
+		assert.Empty(t, diffs[2].Fields)
+	})
+
+	t.Run("ignored differences", func(t *testing.T) {
+		appset := existing.DeepCopy()
+		appset.Spec.IgnoreApplicationDifferences = appsv1.ApplicationSetIgnoreDifferences{{
+			JSONPointers: []string{"/spec/source/path"},
+		}}
+
+		diffs, err := diffApplicationSetApps(appset, existing, generatedApps(), liveApps())
+		require.NoError(t, err)
+		require.Len(t, diffs, 4)
+		assert.Equal(t, "updated", diffs[3].Name)
+		assert.Equal(t, "unchanged", diffs[3].Action)
+	})
+
+	t.Run("new ApplicationSet", func(t *testing.T) {
+		diffs, err := diffApplicationSetApps(existing, nil, generatedApps(), liveApps())
+		require.NoError(t, err)
+		require.Len(t, diffs, 3)
+		assert.Equal(t, "create", diffs[0].Action)
+		// the live Applications are owned by another ApplicationSet
+		for _, diff := range diffs[1:] {
+			assert.Equal(t, "conflict", diff.Action)
+			assert.Empty(t, diff.LiveState)
+			assert.Empty(t, diff.Fields)
+		}
+	})
+
+	t.Run("Application not owned by the ApplicationSet", func(t *testing.T) {
+		apps := append(generatedApps(), newDiffTestApp("other", "apps/other-new"))
+		diffs, err := diffApplicationSetApps(existing, existing, apps, liveApps())
+		require.NoError(t, err)
+		require.Len(t, diffs, 5)
+		assert.Equal(t, "other", diffs[2].Name)
+		assert.Equal(t, "conflict", diffs[2].Action)
+		assert.Empty(t, diffs[2].LiveState)
+		assert.NotEmpty(t, diffs[2].TargetState)
+		assert.Empty(t, diffs[2].Fields)
+	})
+}
+
+func TestDiffFields(t *testing.T) {
+	live := map[string]any{
+		"metadata": map[string]any{
+			"labels":            map[string]any{"app.kubernetes.io/name": "guestbook"},
+			"creationTimestamp": nil,
+		},
+		"spec": map[string]any{
+			"sources": []any{map[string]any{"path": "a"}, map[string]any{"path": "b"}},
+			"syncPolicy": map[string]any{
+				"syncOptions": []any{},
+			},
+		},
+	}
+	target := map[string]any{
+		"metadata": map[string]any{
+			"labels": map[string]any{"app.kubernetes.io/name": "guestbook-v2"},
+		},
+		"spec": map[string]any{
+			"sources": []any{map[string]any{"path": "a"}},
+		},
+	}
+
+	diffs, err := diffFields("", live, target, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*applicationset.ApplicationSetFieldDiff{
+		{Path: `metadata.labels["app.kubernetes.io/name"]`, LiveValue: `"guestbook"`, TargetValue: `"guestbook-v2"`},
+		{Path: "spec.sources[1].path", LiveValue: `"b"`},
+	}, diffs)
+}
+
+func TestDiffAppSetWithoutAppSet(t *testing.T) {
+	appServer := newTestAppSetServer(t)
+	_, err := appServer.Diff(t.Context(), &applicationset.ApplicationSetDiffRequest{})
+	require.EqualError(t, err, "error comparing ApplicationSet: ApplicationSet is nil in request")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/pkg/v2/sync"
@@ -39,6 +40,11 @@ import (
 const (
 	// JWTTokenSubFormat format of the JWT token subject that Argo CD vends out.
 	JWTTokenSubFormat = "proj:%s:%s"
+
+	// defaultSyncWindowPeriodsUntil is the duration for which the sync window periods are listed when none is given
+	defaultSyncWindowPeriodsUntil = 30 * 24 * time.Hour
+	// maxSyncWindowPeriodsUntil is the longest duration for which the sync window periods can be listed
+	maxSyncWindowPeriodsUntil = 366 * 24 * time.Hour
 )
 
 // Server provides a Project service
@@ -519,7 +525,11 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 
 	res := &project.SyncWindowsResponse{}
 
-	windows, err := proj.Spec.SyncWindows.Active()
+	projWindows, err := argo.GetSyncWindows(proj, s.settingsMgr, time.Now(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	windows, err := projWindows.Active()
 	if err != nil {
 		return nil, err
 	}
@@ -532,6 +542,48 @@ func (s *Server) GetSyncWindowsState(ctx context.Context, q *project.SyncWindows
 	return res, nil
 }
 
+// ListSyncWindowPeriods returns, for each sync window of the project, the periods of time during which the window is
+// active from now until the given duration. The events of the calendars referenced by the windows are included.
+func (s *Server) ListSyncWindowPeriods(ctx context.Context, q *project.SyncWindowPeriodsQuery) (*project.SyncWindowPeriodsResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceProjects, rbac.ActionGet, q.Name); err != nil {
+		return nil, err
+	}
+	until := defaultSyncWindowPeriodsUntil
+	if q.Until != "" {
+		var err error
+		if until, err = time.ParseDuration(q.Until); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid duration '%s': %v", q.Until, err)
+		}
+		if until <= 0 || until > maxSyncWindowPeriodsUntil {
+			return nil, status.Errorf(codes.InvalidArgument, "duration must be positive and at most %s", maxSyncWindowPeriodsUntil)
+		}
+	}
+	proj, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).Get(ctx, q.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	from := time.Now()
+	to := from.Add(until)
+	windows, err := argo.GetSyncWindows(proj, s.settingsMgr, from, to)
+	if err != nil {
+		return nil, err
+	}
+	res := &project.SyncWindowPeriodsResponse{Windows: []*project.SyncWindowPeriods{}}
+	for i, window := range *windows {
+		periods, err := window.Periods(from, to)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sync window %d: %v", i, err)
+		}
+		windowPeriods := &project.SyncWindowPeriods{Id: int32(i), Window: proj.Spec.SyncWindows[i]}
+		for _, period := range periods {
+			windowPeriods.Periods = append(windowPeriods.Periods, &period)
+		}
+		res.Windows = append(res.Windows, windowPeriods)
+	}
+	return res, nil
+}
+
 func (s *Server) NormalizeProjs() error {
 	projList, err := s.appclientset.ArgoprojV1alpha1().AppProjects(s.ns).List(context.Background(), metav1.ListOptions{})
 	if err != nil {
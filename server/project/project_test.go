@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/db"
@@ -727,6 +728,37 @@ p, role:admin, projects, update, *, allow`)
 		assert.Nil(t, res)
 	})
 
+	t.Run("TestListSyncWindowPeriods", func(t *testing.T) {
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjLister(), "", nil, session.NewUserStateStorage(nil))
+		projectWithSyncWindows := existingProj.DeepCopy()
+		start := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Minute)
+		projectWithSyncWindows.Spec.SyncWindows = v1alpha1.SyncWindows{
+			{Kind: "allow", Schedule: "0 0 * * *", Duration: "1h"},
+			{Kind: "deny", DateRanges: []v1alpha1.SyncWindowDateRange{
+				{Start: start.Format(time.RFC3339), End: start.Add(time.Hour).Format(time.RFC3339), Description: "maintenance"},
+				{Start: start.AddDate(1, 0, 0).Format(time.RFC3339), End: start.AddDate(1, 0, 1).Format(time.RFC3339)},
+			}},
+		}
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), apps.NewSimpleClientset(projectWithSyncWindows), enforcer, sync.NewKeyLock(), sessionMgr, nil, projInformer, settingsMgr, argoDB, testEnableEventList)
+		res, err := projectServer.ListSyncWindowPeriods(ctx, &project.SyncWindowPeriodsQuery{Name: projectWithSyncWindows.Name, Until: "168h"})
+		require.NoError(t, err)
+		require.Len(t, res.Windows, 2)
+		assert.Equal(t, int32(0), res.Windows[0].Id)
+		assert.NotEmpty(t, res.Windows[0].Periods)
+		assert.Equal(t, int32(1), res.Windows[1].Id)
+		assert.Equal(t, "deny", res.Windows[1].Window.Kind)
+		require.Len(t, res.Windows[1].Periods, 1)
+		assert.True(t, start.Equal(res.Windows[1].Periods[0].Start.Time))
+		assert.True(t, start.Add(time.Hour).Equal(res.Windows[1].Periods[0].End.Time))
+		assert.Equal(t, "maintenance", res.Windows[1].Periods[0].Description)
+
+		_, err = projectServer.ListSyncWindowPeriods(ctx, &project.SyncWindowPeriodsQuery{Name: projectWithSyncWindows.Name, Until: "1y"})
+		assert.ErrorContains(t, err, "invalid duration '1y'")
+		_, err = projectServer.ListSyncWindowPeriods(ctx, &project.SyncWindowPeriodsQuery{Name: projectWithSyncWindows.Name, Until: "9000h"})
+		assert.ErrorContains(t, err, "duration must be positive and at most")
+	})
+
 	t.Run("TestGetSyncWindowsStateDenied", func(t *testing.T) {
 		enforcer = newEnforcer(kubeclientset)
 		_ = enforcer.SetBuiltinPolicy(`p, *, *, *, *, deny`)
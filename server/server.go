@@ -63,6 +63,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	accountpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
@@ -227,6 +228,7 @@ type ArgoCDServerOpts struct {
 	KubeClientset           kubernetes.Interface
 	AppClientset            appclientset.Interface
 	RepoClientset           repoapiclient.Clientset
+	CommitClientset         commitclient.Clientset
 	Cache                   *servercache.Cache
 	RepoServerCache         *repocache.Cache
 	RedisClient             *redis.Client
@@ -247,6 +249,10 @@ type ApplicationSetOpts struct {
 	ScmRootCAPath            string
 	AllowedScmProviders      []string
 	EnableScmProviders       bool
+	OCIInsecureRegistries    []string
+	EnableKubernetesResource bool
+	KubernetesResources      []string
+	KubernetesNamespaces     []string
 }
 
 // GracefulRestartSignal implements a signal to be used for a graceful restart trigger.
@@ -1026,6 +1032,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.appInformer,
 		nil,
 		a.RepoClientset,
+		a.CommitClientset,
 		a.Cache,
 		kubectl,
 		a.db,
@@ -1050,6 +1057,7 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.appsetLister,
 		a.projLister,
 		a.settingsMgr,
+		a.Cache,
 		a.Namespace,
 		projectLock,
 		a.ApplicationNamespaces,
@@ -1058,6 +1066,10 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.ScmRootCAPath,
 		a.AllowedScmProviders,
 		a.EnableScmProviders,
+		a.OCIInsecureRegistries,
+		a.EnableKubernetesResource,
+		a.KubernetesResources,
+		a.KubernetesNamespaces,
 		a.EnableK8sEvent,
 	)
 
@@ -1082,7 +1082,7 @@ export const ResourceResultIcon = ({resource}: {resource: appModels.ResourceResu
 export const getAppOperationState = (app: appModels.Application): appModels.OperationState => {
     if (app.operation) {
         return {
-            phase: appModels.OperationPhases.Running,
+            phase: app.status?.operationState?.phase === appModels.OperationPhases.Queued ? appModels.OperationPhases.Queued : appModels.OperationPhases.Running,
             message: (app.status && app.status.operationState && app.status.operationState.message) || 'waiting to start',
             startedAt: new Date().toISOString(),
             operation: {
@@ -1120,6 +1120,8 @@ const getOperationStateTitle = (app: appModels.Application) => {
             switch (appOperationState.phase) {
                 case 'Running':
                     return 'Syncing';
+                case 'Queued':
+                    return 'Sync queued';
                 case 'Error':
                     return 'Sync error';
                 case 'Failed':
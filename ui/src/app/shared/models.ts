@@ -62,7 +62,7 @@ export interface Operation {
     initiatedBy: OperationInitiator;
 }
 
-export type OperationPhase = 'Running' | 'Error' | 'Failed' | 'Succeeded' | 'Terminating' | 'Progressing' | 'Pending' | 'Waiting';
+export type OperationPhase = 'Running' | 'Error' | 'Failed' | 'Succeeded' | 'Terminating' | 'Progressing' | 'Pending' | 'Waiting' | 'Queued';
 
 export const OperationPhases = {
     Running: 'Running' as OperationPhase,
@@ -72,7 +72,8 @@ export const OperationPhases = {
     Terminating: 'Terminating' as OperationPhase,
     Progressing: 'Progressing' as OperationPhase,
     Pending: 'Pending' as OperationPhase,
-    Waiting: 'Waiting' as OperationPhase
+    Waiting: 'Waiting' as OperationPhase,
+    Queued: 'Queued' as OperationPhase
 };
 
 /**
@@ -227,16 +228,30 @@ export interface SourceHydrator {
 export interface DrySource {
     repoURL: string;
     targetRevision: string;
-    path: string;
+    path?: string;
+    sources?: ApplicationSource[];
 }
 
 export interface SyncSource {
     targetBranch: string;
     path: string;
+    layout?: HydratedManifestLayout;
 }
 
 export interface HydrateTo {
     targetBranch: string;
+    layout?: HydratedManifestLayout;
+    pullRequest?: HydratePullRequest;
+}
+
+export interface HydratePullRequest {
+    provider: 'GitHub' | 'GitLab' | 'Gitea' | 'BitbucketServer' | 'BitbucketCloud' | 'AzureDevOps';
+    api?: string;
+}
+
+export interface HydratedManifestLayout {
+    type?: 'SingleFile' | 'SplitPerResource';
+    kustomization?: boolean;
 }
 
 export interface ApplicationSourceHelm {
@@ -317,6 +332,15 @@ export interface ApplicationSpec {
     ignoreDifferences?: ResourceIgnoreDifferences[];
     info?: Info[];
     revisionHistoryLimit?: number;
+    dependsOn?: ApplicationDependency[];
+}
+
+export interface ApplicationDependency {
+    name?: string;
+    selector?: {
+        matchLabels?: {[name: string]: string};
+        matchExpressions?: {key: string; operator: string; values?: string[]}[];
+    };
 }
 
 export interface ResourceIgnoreDifferences {
@@ -488,12 +512,14 @@ export interface HydrateOperation {
     drySHA: string;
     hydratedSHA: string;
     sourceHydrator: SourceHydrator;
+    pullRequestURL?: string;
 }
 
 export interface SuccessfulHydrateOperation {
     drySHA: string;
     hydratedSHA: string;
     sourceHydrator: SourceHydrator;
+    pullRequestURL?: string;
 }
 
 export type HydrateOperationPhase = 'Hydrating' | 'Failed' | 'Hydrated';
@@ -653,6 +679,7 @@ export interface ClusterCacheInfo {
     resourcesCount: number;
     apisCount: number;
     lastCacheSyncTime: models.Time;
+    nodesCount: number;
 }
 
 export interface ClusterList extends ItemsList<Cluster> {}
@@ -805,6 +832,16 @@ export interface ProjectSpec {
     signatureKeys: ProjectSignatureKey[];
     orphanedResources?: {warn?: boolean; ignore: OrphanedResource[]};
     syncWindows?: SyncWindows;
+    resourcePolicies?: ResourcePolicy[];
+    queuePriority?: string;
+}
+
+export interface ResourcePolicy {
+    name: string;
+    kinds?: GroupKind[];
+    condition: string;
+    message?: string;
+    mode?: 'enforce' | 'warn';
 }
 
 export type SyncWindows = SyncWindow[];
@@ -820,6 +857,19 @@ export interface SyncWindow {
     timeZone: string;
     andOperator: boolean;
     description: string;
+    dateRanges?: SyncWindowDateRange[];
+    calendar?: SyncWindowCalendar;
+}
+
+export interface SyncWindowDateRange {
+    start: string;
+    end: string;
+    description?: string;
+}
+
+export interface SyncWindowCalendar {
+    configMap: string;
+    key: string;
 }
 
 export interface Project {
@@ -1056,8 +1106,25 @@ export const AppDeletionConfirmedAnnotation = 'argocd.argoproj.io/deletion-appro
 
 export interface ApplicationSetSpec {
     strategy?: {
-        type: 'AllAtOnce' | 'RollingSync';
+        type: 'AllAtOnce' | 'RollingSync' | 'RollingUpdate';
         rollingSync?: {
+            steps: Array<{
+                matchExpressions: Array<{
+                    key: string;
+                    operator: string;
+                    values: string[];
+                }>;
+                maxUpdate: number;
+                pauseDuration?: string;
+                requireApproval?: boolean;
+                analysis?: {
+                    url: string;
+                    interval?: string;
+                };
+            }>;
+            rollbackOnFailure?: boolean;
+        };
+        rollingUpdate?: {
             steps: Array<{
                 matchExpressions: Array<{
                     key: string;
@@ -1108,5 +1175,19 @@ export interface ApplicationSet {
             targetRevisions?: string[];
         }>;
         resources?: ApplicationSetResource[];
+        rolloutSteps?: Array<{
+            step: string;
+            status: 'Waiting' | 'AwaitingApproval' | 'Progressing' | 'Paused' | 'Healthy' | 'Degraded';
+            message?: string;
+            lastTransitionTime?: string;
+            approved?: boolean;
+            lastAnalysisTime?: string;
+            rollback?: {
+                applications?: string[];
+                generation: number;
+                pendingApplications?: string[];
+                rolloutStartTime?: string;
+            };
+        }>;
     };
 }
@@ -30,6 +30,7 @@ import (
 	applicationsv1 "github.com/argoproj/argo-cd/v3/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/settings"
@@ -260,11 +261,14 @@ func RefreshApp(appIf v1alpha1.ApplicationInterface, name string, refreshType ar
 	return nil, err
 }
 
-func TestRepoWithKnownType(ctx context.Context, repoClient apiclient.RepoServerServiceClient, repo *argoappv1.Repository, isHelm bool, isHelmOci bool) error {
+func TestRepoWithKnownType(ctx context.Context, repoClient apiclient.RepoServerServiceClient, repo *argoappv1.Repository, isHelm bool, isHelmOci bool, isOCI bool) error {
 	repo = repo.DeepCopy()
-	if isHelm {
+	switch {
+	case isHelm:
 		repo.Type = "helm"
-	} else {
+	case isOCI:
+		repo.Type = "oci"
+	default:
 		repo.Type = "git"
 	}
 	repo.EnableOCI = repo.EnableOCI || isHelmOci
@@ -392,12 +396,18 @@ func validateRepo(ctx context.Context,
 	conditions := make([]argoappv1.ApplicationCondition, 0)
 	errMessage := ""
 
+	// If using the source hydrator, check the dry sources instead of the sync source, since the sync source branch may
+	// not exist yet.
+	if app.Spec.SourceHydrator != nil {
+		sources = app.Spec.SourceHydrator.GetDrySources()
+	}
+
 	for _, source := range sources {
 		repo, err := db.GetRepository(ctx, source.RepoURL, proj.Name)
 		if err != nil {
 			return nil, err
 		}
-		if err := TestRepoWithKnownType(ctx, repoClient, repo, source.IsHelm(), source.IsHelmOci()); err != nil {
+		if err := TestRepoWithKnownType(ctx, repoClient, repo, source.IsHelm(), source.IsHelmOci(), source.IsOCI()); err != nil {
 			errMessage = fmt.Sprintf("repositories not accessible: %v: %v", repo.StringForLogging(), err)
 		}
 		repoAccessible := false
@@ -423,12 +433,6 @@ func validateRepo(ctx context.Context,
 		}
 	}
 
-	// If using the source hydrator, check the dry source instead of the sync source, since the sync source branch may
-	// not exist yet.
-	if app.Spec.SourceHydrator != nil {
-		sources = []argoappv1.ApplicationSource{app.Spec.SourceHydrator.GetDrySource()}
-	}
-
 	refSources, err := GetRefSources(ctx, sources, app.Spec.Project, db.GetRepository, []string{}, false)
 	if err != nil {
 		return nil, fmt.Errorf("error getting ref sources: %w", err)
@@ -527,6 +531,33 @@ func validateSourcePermissions(source argoappv1.ApplicationSource, hasMultipleSo
 	return conditions
 }
 
+// validateDependencies validates that each dependency selects Applications either by name or by labels
+func validateDependencies(dependencies []argoappv1.ApplicationDependency) []argoappv1.ApplicationCondition {
+	var conditions []argoappv1.ApplicationCondition
+	for i, dependency := range dependencies {
+		switch {
+		case dependency.Name == "" && dependency.Selector == nil:
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.dependsOn[%d]: either name or selector is required", i),
+			})
+		case dependency.Name != "" && dependency.Selector != nil:
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: fmt.Sprintf("spec.dependsOn[%d]: name and selector are mutually exclusive", i),
+			})
+		case dependency.Selector != nil:
+			if _, err := metav1.LabelSelectorAsSelector(dependency.Selector); err != nil {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("spec.dependsOn[%d]: invalid selector: %v", i, err),
+				})
+			}
+		}
+	}
+	return conditions
+}
+
 func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.ApplicationCondition {
 	var conditions []argoappv1.ApplicationCondition
 	if hydrator.DrySource.RepoURL == "" {
@@ -541,6 +572,27 @@ func validateSourceHydrator(hydrator *argoappv1.SourceHydrator) []argoappv1.Appl
 			Message: "spec.sourceHydrator.syncSource.targetBranch is required",
 		})
 	}
+	if hydrator.DrySource.HasSources() {
+		if hydrator.DrySource.Path != "" {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "spec.sourceHydrator.drySource.path must be empty when spec.sourceHydrator.drySource.sources is set",
+			})
+		}
+		usesDryRepo := false
+		for _, source := range hydrator.DrySource.Sources {
+			if git.SameURL(source.RepoURL, hydrator.DrySource.RepoURL) && source.TargetRevision == hydrator.DrySource.TargetRevision {
+				usesDryRepo = true
+				break
+			}
+		}
+		if !usesDryRepo {
+			conditions = append(conditions, argoappv1.ApplicationCondition{
+				Type:    argoappv1.ApplicationConditionInvalidSpecError,
+				Message: "one of spec.sourceHydrator.drySource.sources must use the repoURL and targetRevision of spec.sourceHydrator.drySource",
+			})
+		}
+	}
 	if hydrator.HydrateTo != nil && hydrator.HydrateTo.TargetBranch == "" {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
 			Type:    argoappv1.ApplicationConditionInvalidSpecError,
@@ -561,11 +613,20 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 			conditions = append(conditions, condition...)
 			return conditions, nil
 		}
-		if !proj.IsSourcePermitted(spec.SourceHydrator.GetDrySource()) {
-			conditions = append(conditions, argoappv1.ApplicationCondition{
-				Type:    argoappv1.ApplicationConditionInvalidSpecError,
-				Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", spec.GetSource().RepoURL, spec.Project),
-			})
+		for _, source := range spec.SourceHydrator.DrySource.Sources {
+			condition := validateSourcePermissions(source, true)
+			if len(condition) > 0 {
+				conditions = append(conditions, condition...)
+				return conditions, nil
+			}
+		}
+		for _, source := range spec.SourceHydrator.GetDrySources() {
+			if !proj.IsSourcePermitted(source) {
+				conditions = append(conditions, argoappv1.ApplicationCondition{
+					Type:    argoappv1.ApplicationConditionInvalidSpecError,
+					Message: fmt.Sprintf("application repo %s is not permitted in project '%s'", source.RepoURL, spec.Project),
+				})
+			}
 		}
 	case spec.HasMultipleSources():
 		for _, source := range spec.Sources {
@@ -596,6 +657,8 @@ func ValidatePermissions(ctx context.Context, spec *argoappv1.ApplicationSpec, p
 		}
 	}
 
+	conditions = append(conditions, validateDependencies(spec.DependsOn)...)
+
 	destCluster, err := GetDestinationCluster(ctx, spec.Destination, db)
 	if err != nil {
 		conditions = append(conditions, argoappv1.ApplicationCondition{
@@ -810,7 +873,7 @@ func verifyGenerateManifests(
 			TrackingMethod:                  trackingMethod,
 			EnabledSourceTypes:              enableGenerateManifests,
 			NoRevisionCache:                 true,
-			HasMultipleSources:              app.Spec.HasMultipleSources(),
+			HasMultipleSources:              app.Spec.HasMultipleSources() || (app.Spec.SourceHydrator != nil && app.Spec.SourceHydrator.DrySource.HasSources()),
 			RefSources:                      refSources,
 			ProjectName:                     proj.Name,
 			ProjectSourceRepos:              proj.Spec.SourceRepos,
@@ -835,6 +898,21 @@ func verifyGenerateManifests(
 	return conditions
 }
 
+// NewRollbackSyncOperation returns the sync operation rolling an application back to the given deployment of its
+// history. Rollback is just a convenience around Sync.
+func NewRollbackSyncOperation(deploymentInfo *argoappv1.RevisionHistory, syncOptions argoappv1.SyncOptions, dryRun bool, prune bool) *argoappv1.SyncOperation {
+	return &argoappv1.SyncOperation{
+		Revision:     deploymentInfo.Revision,
+		Revisions:    deploymentInfo.Revisions,
+		DryRun:       dryRun,
+		Prune:        prune,
+		SyncOptions:  syncOptions,
+		SyncStrategy: &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}},
+		Source:       &deploymentInfo.Source,
+		Sources:      deploymentInfo.Sources,
+	}
+}
+
 // SetAppOperation updates an application with the specified operation, retrying conflict errors
 func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *argoappv1.Operation) (*argoappv1.Application, error) {
 	for {
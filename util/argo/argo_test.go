@@ -925,6 +925,25 @@ func TestSetAppOperations(t *testing.T) {
 	})
 }
 
+func TestNewRollbackSyncOperation(t *testing.T) {
+	deploymentInfo := &argoappv1.RevisionHistory{
+		ID:       1,
+		Revision: "aaa",
+		Source:   argoappv1.ApplicationSource{RepoURL: "https://github.com/argoproj/argocd-example-apps.git", Path: "guestbook"},
+	}
+	syncOptions := argoappv1.SyncOptions{"CreateNamespace=true"}
+
+	op := NewRollbackSyncOperation(deploymentInfo, syncOptions, false, true)
+
+	assert.Equal(t, &argoappv1.SyncOperation{
+		Revision:     "aaa",
+		Prune:        true,
+		SyncOptions:  syncOptions,
+		SyncStrategy: &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}},
+		Source:       &deploymentInfo.Source,
+	}, op)
+}
+
 func TestGetDestinationCluster(t *testing.T) {
 	t.Run("Validate destination with server url", func(t *testing.T) {
 		dest := argoappv1.ApplicationDestination{
@@ -1470,6 +1489,91 @@ func TestValidatePermissionsMultipleSources(t *testing.T) {
 	})
 }
 
+func TestValidatePermissionsSourceHydratorDrySources(t *testing.T) {
+	newSpec := func(drySource argoappv1.DrySource) argoappv1.ApplicationSpec {
+		return argoappv1.ApplicationSpec{
+			SourceHydrator: &argoappv1.SourceHydrator{
+				DrySource:  drySource,
+				SyncSource: argoappv1.SyncSource{TargetBranch: "env/dev", Path: "guestbook"},
+			},
+			Destination: argoappv1.ApplicationDestination{
+				Server:    "https://127.0.0.1:6443",
+				Namespace: "testns",
+			},
+		}
+	}
+	proj := argoappv1.AppProject{
+		Spec: argoappv1.AppProjectSpec{
+			Destinations: []argoappv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			SourceRepos:  []string{"https://github.com/argoproj/*"},
+		},
+	}
+	cluster := &argoappv1.Cluster{Server: "https://127.0.0.1:6443", Name: "test"}
+
+	t.Run("Path and sources result in condition", func(t *testing.T) {
+		spec := newSpec(argoappv1.DrySource{
+			RepoURL:        "https://github.com/argoproj/values",
+			TargetRevision: "main",
+			Path:           "guestbook",
+			Sources: argoappv1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/values", TargetRevision: "main", Ref: "values"},
+			},
+		})
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, &dbmocks.ArgoDB{})
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "spec.sourceHydrator.drySource.path must be empty")
+	})
+
+	t.Run("Sources without the dry source repo result in condition", func(t *testing.T) {
+		spec := newSpec(argoappv1.DrySource{
+			RepoURL:        "https://github.com/argoproj/values",
+			TargetRevision: "main",
+			Sources: argoappv1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/charts", Path: "guestbook", TargetRevision: "main"},
+				{RepoURL: "https://github.com/argoproj/values", TargetRevision: "stable", Ref: "values"},
+			},
+		})
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, &dbmocks.ArgoDB{})
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "must use the repoURL and targetRevision of spec.sourceHydrator.drySource")
+	})
+
+	t.Run("Source not permitted in project results in condition", func(t *testing.T) {
+		spec := newSpec(argoappv1.DrySource{
+			RepoURL:        "https://github.com/argoproj/values",
+			TargetRevision: "main",
+			Sources: argoappv1.ApplicationSources{
+				{RepoURL: "https://charts.example.com", Chart: "guestbook", TargetRevision: "1.0.0"},
+				{RepoURL: "https://github.com/argoproj/values", TargetRevision: "main", Ref: "values"},
+			},
+		})
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		require.Len(t, conditions, 1)
+		assert.Contains(t, conditions[0].Message, "application repo https://charts.example.com is not permitted")
+	})
+
+	t.Run("Valid sources", func(t *testing.T) {
+		spec := newSpec(argoappv1.DrySource{
+			RepoURL:        "https://github.com/argoproj/values",
+			TargetRevision: "main",
+			Sources: argoappv1.ApplicationSources{
+				{RepoURL: "https://github.com/argoproj/charts", Chart: "guestbook", TargetRevision: "1.0.0"},
+				{RepoURL: "https://github.com/argoproj/values.git", TargetRevision: "main", Ref: "values"},
+			},
+		})
+		db := &dbmocks.ArgoDB{}
+		db.On("GetCluster", t.Context(), spec.Destination.Server).Return(cluster, nil)
+		conditions, err := ValidatePermissions(t.Context(), &spec, &proj, db)
+		require.NoError(t, err)
+		assert.Empty(t, conditions)
+	})
+}
+
 func TestAugmentSyncMsg(t *testing.T) {
 	mockAPIResourcesFn := func() ([]kube.APIResourceInfo, error) {
 		return []kube.APIResourceInfo{
@@ -1708,3 +1812,20 @@ func TestGetAppEventLabels(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDependencies(t *testing.T) {
+	conditions := validateDependencies([]argoappv1.ApplicationDependency{
+		{Name: "cni"},
+		{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "platform"}}},
+		{},
+		{Name: "cni", Selector: &metav1.LabelSelector{}},
+		{Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Unknown"}}}},
+	})
+	require.Len(t, conditions, 3)
+	for _, condition := range conditions {
+		assert.Equal(t, argoappv1.ApplicationConditionInvalidSpecError, condition.Type)
+	}
+	assert.Equal(t, "spec.dependsOn[2]: either name or selector is required", conditions[0].Message)
+	assert.Equal(t, "spec.dependsOn[3]: name and selector are mutually exclusive", conditions[1].Message)
+	assert.Contains(t, conditions[2].Message, "spec.dependsOn[4]: invalid selector: ")
+}
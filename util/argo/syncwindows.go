@@ -0,0 +1,71 @@
+package argo
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/calendar"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// syncWindowCalendars caches the calendars of the sync windows by ConfigMap and key, so that a calendar is parsed again
+// only when its ConfigMap changes
+var syncWindowCalendars sync.Map
+
+type cachedSyncWindowCalendar struct {
+	resourceVersion string
+	calendar        *calendar.Calendar
+}
+
+// GetSyncWindows returns the sync windows of the project in which the events of the calendars, from the ConfigMaps
+// referenced by the windows, are resolved as date ranges. Only the events overlapping the given period are resolved.
+// The windows of the project are returned as is if none of them references a calendar.
+func GetSyncWindows(proj *argoappv1.AppProject, settingsMgr *settings.SettingsManager, from time.Time, to time.Time) (*argoappv1.SyncWindows, error) {
+	if !slices.ContainsFunc(proj.Spec.SyncWindows, func(w *argoappv1.SyncWindow) bool { return w.Calendar != nil }) {
+		return &proj.Spec.SyncWindows, nil
+	}
+	windows := make(argoappv1.SyncWindows, 0, len(proj.Spec.SyncWindows))
+	for _, w := range proj.Spec.SyncWindows {
+		if w.Calendar == nil {
+			windows = append(windows, w)
+			continue
+		}
+		cal, err := getSyncWindowCalendar(settingsMgr, w.Calendar)
+		if err != nil {
+			return nil, err
+		}
+		resolved := w.DeepCopy()
+		resolved.DateRanges = append(resolved.DateRanges, cal.DateRanges(from, to)...)
+		windows = append(windows, resolved)
+	}
+	return &windows, nil
+}
+
+func getSyncWindowCalendar(settingsMgr *settings.SettingsManager, ref *argoappv1.SyncWindowCalendar) (*calendar.Calendar, error) {
+	cm, err := settingsMgr.GetConfigMapByName(ref.ConfigMap)
+	if err != nil {
+		return nil, fmt.Errorf("error getting ConfigMap '%s', which must be labeled with app.kubernetes.io/part-of: argocd: %w", ref.ConfigMap, err)
+	}
+	data, ok := cm.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("key '%s' not found in ConfigMap '%s'", ref.Key, ref.ConfigMap)
+	}
+
+	cacheKey := ref.ConfigMap + "/" + ref.Key
+	if cached, ok := syncWindowCalendars.Load(cacheKey); ok && cm.ResourceVersion != "" {
+		if cached := cached.(*cachedSyncWindowCalendar); cached.resourceVersion == cm.ResourceVersion {
+			return cached.calendar, nil
+		}
+	}
+	cal, err := calendar.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing calendar '%s' of ConfigMap '%s': %w", ref.Key, ref.ConfigMap, err)
+	}
+	if cm.ResourceVersion != "" {
+		syncWindowCalendars.Store(cacheKey, &cachedSyncWindowCalendar{resourceVersion: cm.ResourceVersion, calendar: cal})
+	}
+	return cal, nil
+}
@@ -0,0 +1,90 @@
+package argo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	argoappv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+func TestGetSyncWindows(t *testing.T) {
+	newConfigMap := func(name string, labels map[string]string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: test.FakeArgoCDNamespace, Labels: labels},
+			Data:       data,
+		}
+	}
+	partOfArgoCD := map[string]string{"app.kubernetes.io/part-of": "argocd"}
+	kubeClient := fake.NewClientset(
+		newConfigMap("argocd-cm", partOfArgoCD, nil),
+		newConfigMap("holidays", partOfArgoCD, map[string]string{
+			"holidays.ics": `BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:christmas@example.com
+DTSTART;VALUE=DATE:20251225
+SUMMARY:Christmas
+END:VEVENT
+END:VCALENDAR
+`,
+			"invalid.ics": "not a calendar",
+		}),
+		newConfigMap("unlabeled", nil, map[string]string{"holidays.ics": ""}),
+	)
+	settingsMgr := settings.NewSettingsManager(t.Context(), kubeClient, test.FakeArgoCDNamespace)
+	newProject := func(calendar *argoappv1.SyncWindowCalendar) *argoappv1.AppProject {
+		return &argoappv1.AppProject{Spec: argoappv1.AppProjectSpec{SyncWindows: argoappv1.SyncWindows{
+			{Kind: "allow", Schedule: "0 8 * * *", Duration: "8h", Applications: []string{"*"}},
+			{Kind: "deny", Applications: []string{"*"}, Calendar: calendar},
+		}}}
+	}
+	christmas := time.Date(2025, 12, 25, 12, 0, 0, 0, time.UTC)
+
+	t.Run("windows without calendar", func(t *testing.T) {
+		proj := newProject(nil)
+		windows, err := GetSyncWindows(proj, settingsMgr, christmas, christmas)
+		require.NoError(t, err)
+		assert.Same(t, &proj.Spec.SyncWindows, windows)
+	})
+
+	t.Run("calendar events are resolved as date ranges", func(t *testing.T) {
+		proj := newProject(&argoappv1.SyncWindowCalendar{ConfigMap: "holidays", Key: "holidays.ics"})
+		windows, err := GetSyncWindows(proj, settingsMgr, christmas, christmas)
+		require.NoError(t, err)
+		require.Len(t, *windows, 2)
+		assert.Same(t, proj.Spec.SyncWindows[0], (*windows)[0])
+		assert.Equal(t, []argoappv1.SyncWindowDateRange{{Start: "2025-12-25", End: "2025-12-25", Description: "Christmas"}}, (*windows)[1].DateRanges)
+		assert.Empty(t, proj.Spec.SyncWindows[1].DateRanges)
+		periods, err := (*windows)[1].Periods(christmas, christmas)
+		require.NoError(t, err)
+		require.Len(t, periods, 1)
+		assert.Equal(t, time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), periods[0].Start.Time)
+		assert.Equal(t, time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC), periods[0].End.Time)
+
+		windows, err = GetSyncWindows(proj, settingsMgr, christmas.AddDate(0, 0, 7), christmas.AddDate(0, 0, 7))
+		require.NoError(t, err)
+		assert.Empty(t, (*windows)[1].DateRanges)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := GetSyncWindows(newProject(&argoappv1.SyncWindowCalendar{ConfigMap: "holidays", Key: "missing.ics"}), settingsMgr, christmas, christmas)
+		require.EqualError(t, err, "key 'missing.ics' not found in ConfigMap 'holidays'")
+	})
+
+	t.Run("invalid calendar", func(t *testing.T) {
+		_, err := GetSyncWindows(newProject(&argoappv1.SyncWindowCalendar{ConfigMap: "holidays", Key: "invalid.ics"}), settingsMgr, christmas, christmas)
+		require.ErrorContains(t, err, "error parsing calendar 'invalid.ics' of ConfigMap 'holidays'")
+	})
+
+	t.Run("ConfigMap not part of Argo CD", func(t *testing.T) {
+		_, err := GetSyncWindows(newProject(&argoappv1.SyncWindowCalendar{ConfigMap: "unlabeled", Key: "holidays.ics"}), settingsMgr, christmas, christmas)
+		require.ErrorContains(t, err, "error getting ConfigMap 'unlabeled', which must be labeled with app.kubernetes.io/part-of: argocd")
+	})
+}
@@ -0,0 +1,253 @@
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	ics "github.com/arran4/golang-ical"
+	"github.com/teambition/rrule-go"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+	// localDateTimeLayout is the layout of the sync window date ranges evaluated in the time zone of the window
+	localDateTimeLayout = "2006-01-02T15:04:05"
+)
+
+var durationRegex = regexp.MustCompile(`^([+-])?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// Calendar holds the events of an iCalendar file
+type Calendar struct {
+	events []event
+}
+
+// event is an event of a calendar, which may recur
+type event struct {
+	summary  string
+	start    time.Time
+	duration time.Duration
+	// allDay is true when the event lasts whole days, in which case its start is a date
+	allDay bool
+	// floating is true when the times of the event are not bound to a time zone. The dates and the floating times are
+	// held in UTC, and evaluated in the time zone of the sync window.
+	floating bool
+	// recurrence holds the start of the occurrences of a recurring event
+	recurrence *rrule.Set
+}
+
+// Parse parses an iCalendar file (RFC 5545). The recurring events are expanded according to their RRULE, RDATE and
+// EXDATE properties, the cancelled events and the events without duration are ignored.
+func Parse(data string) (*Calendar, error) {
+	cal, err := ics.ParseCalendar(strings.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing calendar: %w", err)
+	}
+	c := &Calendar{}
+	for _, vevent := range cal.Events() {
+		e, err := parseEvent(vevent)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing event '%s': %w", vevent.Id(), err)
+		}
+		if e != nil {
+			c.events = append(c.events, *e)
+		}
+	}
+	return c, nil
+}
+
+// DateRanges returns the periods of time of the events of the calendar which overlap the given period, as sync window
+// date ranges. The dates of the all-day events, and the times of the events which are not bound to a time zone, are
+// evaluated in the time zone of the sync window.
+func (c *Calendar) DateRanges(from time.Time, to time.Time) []v1alpha1.SyncWindowDateRange {
+	// The dates and the floating times are compared as if they were in UTC, the period is extended by a day to cover
+	// the time zone of any sync window
+	from = from.Add(-24 * time.Hour)
+	to = to.Add(24 * time.Hour)
+
+	var dateRanges []v1alpha1.SyncWindowDateRange
+	for _, e := range c.events {
+		for _, start := range e.occurrences(from, to) {
+			dateRanges = append(dateRanges, e.dateRange(start))
+		}
+	}
+	return dateRanges
+}
+
+// occurrences returns the start of the occurrences of the event which overlap the given period
+func (e *event) occurrences(from time.Time, to time.Time) []time.Time {
+	if e.recurrence != nil {
+		return e.recurrence.Between(from.Add(-e.duration), to, true)
+	}
+	if e.start.Before(to) && e.start.Add(e.duration).After(from) {
+		return []time.Time{e.start}
+	}
+	return nil
+}
+
+// dateRange returns the sync window date range of the occurrence of the event starting at the given time
+func (e *event) dateRange(start time.Time) v1alpha1.SyncWindowDateRange {
+	end := start.Add(e.duration)
+	switch {
+	case e.allDay:
+		// The end of an all-day event is exclusive, whereas the end date of a date range is included in the range
+		lastDay := end.AddDate(0, 0, -1)
+		if lastDay.Before(start) {
+			lastDay = start
+		}
+		return v1alpha1.SyncWindowDateRange{Start: start.Format(time.DateOnly), End: lastDay.Format(time.DateOnly), Description: e.summary}
+	case e.floating:
+		return v1alpha1.SyncWindowDateRange{Start: start.Format(localDateTimeLayout), End: end.Format(localDateTimeLayout), Description: e.summary}
+	default:
+		return v1alpha1.SyncWindowDateRange{Start: start.Format(time.RFC3339), End: end.Format(time.RFC3339), Description: e.summary}
+	}
+}
+
+func parseEvent(vevent *ics.VEvent) (*event, error) {
+	if status := vevent.GetProperty(ics.ComponentPropertyStatus); status != nil && strings.EqualFold(status.Value, "CANCELLED") {
+		return nil, nil
+	}
+	dtStart := vevent.GetProperty(ics.ComponentPropertyDtStart)
+	if dtStart == nil {
+		return nil, errors.New("DTSTART is missing")
+	}
+	starts, allDay, floating, err := parseTimes(&dtStart.BaseProperty)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DTSTART: %w", err)
+	}
+	e := &event{start: starts[0], allDay: allDay, floating: floating}
+	if summary := vevent.GetProperty(ics.ComponentPropertySummary); summary != nil {
+		e.summary = summary.Value
+	}
+
+	if dtEnd := vevent.GetProperty(ics.ComponentPropertyDtEnd); dtEnd != nil {
+		ends, _, _, err := parseTimes(&dtEnd.BaseProperty)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DTEND: %w", err)
+		}
+		e.duration = ends[0].Sub(e.start)
+	} else if duration := vevent.GetProperty(ics.ComponentPropertyDuration); duration != nil {
+		if e.duration, err = parseDuration(duration.Value); err != nil {
+			return nil, fmt.Errorf("invalid DURATION: %w", err)
+		}
+	} else if allDay {
+		e.duration = 24 * time.Hour
+	}
+	if e.duration <= 0 {
+		return nil, nil
+	}
+
+	rrules := vevent.GetProperties(ics.ComponentPropertyRrule)
+	rdates := vevent.GetProperties(ics.ComponentPropertyRdate)
+	if len(rrules) == 0 && len(rdates) == 0 {
+		return e, nil
+	}
+	e.recurrence = &rrule.Set{}
+	// The start of the event is always its first occurrence
+	e.recurrence.RDate(e.start)
+	if len(rrules) > 0 {
+		option, err := rrule.StrToROptionInLocation(rrules[0].Value, e.start.Location())
+		if err != nil {
+			return nil, fmt.Errorf("invalid RRULE: %w", err)
+		}
+		option.Dtstart = e.start
+		rule, err := rrule.NewRRule(*option)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RRULE: %w", err)
+		}
+		e.recurrence.RRule(rule)
+	}
+	for _, rdate := range rdates {
+		times, _, _, err := parseTimes(&rdate.BaseProperty)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RDATE: %w", err)
+		}
+		for _, t := range times {
+			e.recurrence.RDate(t)
+		}
+	}
+	for _, exdate := range vevent.GetProperties(ics.ComponentPropertyExdate) {
+		times, _, _, err := parseTimes(&exdate.BaseProperty)
+		if err != nil {
+			return nil, fmt.Errorf("invalid EXDATE: %w", err)
+		}
+		for _, t := range times {
+			e.recurrence.ExDate(t)
+		}
+	}
+	return e, nil
+}
+
+// parseTimes parses the comma separated dates or date-times of a property. It returns whether the values are dates,
+// and whether they are floating, that is not bound to a time zone.
+func parseTimes(property *ics.BaseProperty) ([]time.Time, bool, bool, error) {
+	location := time.UTC
+	floating := true
+	if tzIDs := property.ICalParameters[string(ics.ParameterTzid)]; len(tzIDs) > 0 {
+		var err error
+		if location, err = time.LoadLocation(tzIDs[0]); err != nil {
+			return nil, false, false, fmt.Errorf("unknown time zone '%s'", tzIDs[0])
+		}
+		floating = false
+	}
+	isDate := slices.ContainsFunc(property.ICalParameters[string(ics.ParameterValue)], func(value string) bool {
+		return strings.EqualFold(value, "DATE")
+	})
+
+	var times []time.Time
+	for _, value := range strings.Split(property.Value, ",") {
+		value = strings.TrimSpace(value)
+		var t time.Time
+		var err error
+		switch {
+		case isDate || len(value) == len(dateLayout):
+			isDate = true
+			t, err = time.ParseInLocation(dateLayout, value, time.UTC)
+		case strings.HasSuffix(value, "Z"):
+			floating = false
+			t, err = time.ParseInLocation(dateTimeLayout, strings.TrimSuffix(value, "Z"), time.UTC)
+		default:
+			t, err = time.ParseInLocation(dateTimeLayout, value, location)
+		}
+		if err != nil {
+			return nil, false, false, fmt.Errorf("cannot parse '%s'", value)
+		}
+		times = append(times, t)
+	}
+	return times, isDate, floating && !isDate, nil
+}
+
+// parseDuration parses a duration in the ISO 8601 format used by iCalendar, e.g. P1D or PT1H30M
+func parseDuration(value string) (time.Duration, error) {
+	match := durationRegex.FindStringSubmatch(value)
+	if match == nil || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("cannot parse duration '%s'", value)
+	}
+	var duration time.Duration
+	hasValue := false
+	for i, unit := range []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second} {
+		if match[i+2] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match[i+2])
+		if err != nil {
+			return 0, fmt.Errorf("cannot parse duration '%s': %w", value, err)
+		}
+		duration += time.Duration(n) * unit
+		hasValue = true
+	}
+	if !hasValue {
+		return 0, fmt.Errorf("cannot parse duration '%s'", value)
+	}
+	if match[1] == "-" {
+		duration = -duration
+	}
+	return duration, nil
+}
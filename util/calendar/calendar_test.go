@@ -0,0 +1,130 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const holidays = `BEGIN:VCALENDAR
+VERSION:2.0
+PRODID:-//Argo CD//Holidays//EN
+BEGIN:VEVENT
+UID:christmas@example.com
+DTSTAMP:20250101T000000Z
+DTSTART;VALUE=DATE:20251225
+DTEND;VALUE=DATE:20251227
+SUMMARY:Christmas
+END:VEVENT
+BEGIN:VEVENT
+UID:new-year@example.com
+DTSTAMP:20250101T000000Z
+DTSTART;VALUE=DATE:20240101
+RRULE:FREQ=YEARLY
+EXDATE;VALUE=DATE:20260101
+SUMMARY:New Year's Day
+END:VEVENT
+BEGIN:VEVENT
+UID:release@example.com
+DTSTAMP:20250101T000000Z
+DTSTART;TZID=Europe/Paris:20251215T180000
+DURATION:PT2H
+SUMMARY:Release party
+END:VEVENT
+BEGIN:VEVENT
+UID:maintenance@example.com
+DTSTAMP:20250101T000000Z
+DTSTART:20251216T220000Z
+DTEND:20251217T020000Z
+SUMMARY:Maintenance
+END:VEVENT
+BEGIN:VEVENT
+UID:standup@example.com
+DTSTAMP:20250101T000000Z
+DTSTART:20251215T093000
+DURATION:PT15M
+RRULE:FREQ=WEEKLY;BYDAY=MO,WE;COUNT=4
+SUMMARY:Standup
+END:VEVENT
+BEGIN:VEVENT
+UID:cancelled@example.com
+DTSTAMP:20250101T000000Z
+DTSTART;VALUE=DATE:20251218
+STATUS:CANCELLED
+SUMMARY:Cancelled
+END:VEVENT
+END:VCALENDAR
+`
+
+func TestParse(t *testing.T) {
+	cal, err := Parse(holidays)
+	require.NoError(t, err)
+
+	t.Run("events of a period", func(t *testing.T) {
+		dateRanges := cal.DateRanges(time.Date(2025, 12, 16, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 16, 23, 0, 0, 0, time.UTC))
+		assert.ElementsMatch(t, []v1alpha1.SyncWindowDateRange{
+			{Start: "2025-12-15T18:00:00+01:00", End: "2025-12-15T20:00:00+01:00", Description: "Release party"},
+			{Start: "2025-12-16T22:00:00Z", End: "2025-12-17T02:00:00Z", Description: "Maintenance"},
+			{Start: "2025-12-15T09:30:00", End: "2025-12-15T09:45:00", Description: "Standup"},
+			{Start: "2025-12-17T09:30:00", End: "2025-12-17T09:45:00", Description: "Standup"},
+		}, dateRanges)
+	})
+
+	t.Run("all-day events", func(t *testing.T) {
+		dateRanges := cal.DateRanges(time.Date(2025, 12, 25, 12, 0, 0, 0, time.UTC), time.Date(2025, 12, 25, 12, 0, 0, 0, time.UTC))
+		assert.Equal(t, []v1alpha1.SyncWindowDateRange{{Start: "2025-12-25", End: "2025-12-26", Description: "Christmas"}}, dateRanges)
+	})
+
+	t.Run("recurring events", func(t *testing.T) {
+		dateRanges := cal.DateRanges(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, 12, 1, 0, 0, 0, 0, time.UTC))
+		var newYears []string
+		for _, dateRange := range dateRanges {
+			if dateRange.Description == "New Year's Day" {
+				newYears = append(newYears, dateRange.Start+"/"+dateRange.End)
+			}
+		}
+		assert.Equal(t, []string{"2025-01-01/2025-01-01", "2027-01-01/2027-01-01"}, newYears)
+	})
+
+	t.Run("no events", func(t *testing.T) {
+		assert.Empty(t, cal.DateRanges(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)))
+	})
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse("not a calendar")
+	require.Error(t, err)
+
+	_, err = Parse(`BEGIN:VCALENDAR
+BEGIN:VEVENT
+UID:invalid@example.com
+DTSTART;TZID=Nowhere/Unknown:20251215T180000
+DURATION:PT2H
+END:VEVENT
+END:VCALENDAR
+`)
+	require.EqualError(t, err, "error parsing event 'invalid@example.com': invalid DTSTART: unknown time zone 'Nowhere/Unknown'")
+}
+
+func TestParseDuration(t *testing.T) {
+	for value, expected := range map[string]time.Duration{
+		"P1D":      24 * time.Hour,
+		"P2W":      14 * 24 * time.Hour,
+		"PT1H30M":  90 * time.Minute,
+		"P1DT12H":  36 * time.Hour,
+		"-PT15M":   -15 * time.Minute,
+		"PT0H0M1S": time.Second,
+	} {
+		duration, err := parseDuration(value)
+		require.NoError(t, err, value)
+		assert.Equal(t, expected, duration, value)
+	}
+	for _, value := range []string{"", "P", "PT", "1D", "P1H"} {
+		_, err := parseDuration(value)
+		require.Error(t, err, value)
+	}
+}
@@ -77,9 +77,15 @@ type Client interface {
 	VerifyCommitSignature(string) (string, error)
 	IsAnnotatedTag(string) bool
 	ChangedFiles(revision string, targetRevision string) ([]string, error)
+	// ListCommits returns the SHAs of the commits on the ancestry path from revision (exclusive) to targetRevision
+	// (inclusive) which changed any of paths, newest first. All commits are returned if paths is empty. It is empty if
+	// revision is not an ancestor of targetRevision.
+	ListCommits(revision string, targetRevision string, paths []string) ([]string, error)
 	IsRevisionPresent(revision string) bool
 	// SetAuthor sets the author name and email in the git configuration.
 	SetAuthor(name, email string) (string, error)
+	// SetSigningKey configures git to sign all commits with the given key. The format is passed to git as gpg.format.
+	SetSigningKey(format, key string) (string, error)
 	// CheckoutOrOrphan checks out the branch. If the branch does not exist, it creates an orphan branch.
 	CheckoutOrOrphan(branch string, submoduleEnabled bool) (string, error)
 	// CheckoutOrNew checks out the given branch. If the branch does not exist, it creates an empty branch based on
@@ -87,8 +93,15 @@ type Client interface {
 	CheckoutOrNew(branch, base string, submoduleEnabled bool) (string, error)
 	// RemoveContents removes all files from the git repository.
 	RemoveContents() (string, error)
+	// Commit commits all changes in the working tree without pushing them.
+	Commit(message string) (string, error)
 	// CommitAndPush commits and pushes changes to the target branch.
 	CommitAndPush(branch, message string) (string, error)
+	// CommitAndForcePush commits changes and force-pushes the checked out commit to the target branch, replacing its
+	// history.
+	CommitAndForcePush(branch, message string) (string, error)
+	// DiffWorkingTree stages all changes in the working tree and returns their diff against HEAD.
+	DiffWorkingTree() (string, error)
 }
 
 type EventHandlers struct {
@@ -793,6 +806,34 @@ func (m *nativeGitClient) ChangedFiles(revision string, targetRevision string) (
 	return files, nil
 }
 
+// ListCommits returns the SHAs of the commits on the ancestry path from revision (exclusive) to targetRevision
+// (inclusive) which changed any of paths, newest first. All commits are returned if paths is empty. It is empty if
+// revision is not an ancestor of targetRevision.
+func (m *nativeGitClient) ListCommits(revision string, targetRevision string, paths []string) ([]string, error) {
+	if revision == targetRevision {
+		return []string{}, nil
+	}
+
+	if !IsCommitSHA(revision) || !IsCommitSHA(targetRevision) {
+		return []string{}, errors.New("invalid revision provided, must be SHA")
+	}
+
+	args := []string{"rev-list", "--ancestry-path", fmt.Sprintf("%s..%s", revision, targetRevision)}
+	if len(paths) > 0 {
+		args = append(append(args, "--"), paths...)
+	}
+	out, err := m.runCmd(args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits %s..%s: %w", revision, targetRevision, err)
+	}
+
+	if out == "" {
+		return []string{}, nil
+	}
+
+	return strings.Split(out, "\n"), nil
+}
+
 // config runs a git config command.
 func (m *nativeGitClient) config(args ...string) (string, error) {
 	args = append([]string{"config"}, args...)
@@ -820,6 +861,22 @@ func (m *nativeGitClient) SetAuthor(name, email string) (string, error) {
 	return "", nil
 }
 
+// SetSigningKey configures git to sign all commits with the given key. The format is passed to git as gpg.format, and
+// the key as user.signingkey: a GnuPG key ID for the openpgp format, or the path to a private key for the ssh format.
+func (m *nativeGitClient) SetSigningKey(format, key string) (string, error) {
+	if format != "" {
+		out, err := m.config("--local", "gpg.format", format)
+		if err != nil {
+			return out, err
+		}
+	}
+	out, err := m.config("--local", "user.signingkey", key)
+	if err != nil {
+		return out, err
+	}
+	return m.config("--local", "commit.gpgsign", "true")
+}
+
 // CheckoutOrOrphan checks out the branch. If the branch does not exist, it creates an orphan branch.
 func (m *nativeGitClient) CheckoutOrOrphan(branch string, submoduleEnabled bool) (string, error) {
 	out, err := m.Checkout(branch, submoduleEnabled)
@@ -880,8 +937,34 @@ func (m *nativeGitClient) RemoveContents() (string, error) {
 	return "", nil
 }
 
+// Commit commits all changes in the working tree without pushing them. The commit is not signed, since it never leaves
+// the local repository.
+func (m *nativeGitClient) Commit(message string) (string, error) {
+	out, err := m.runCmd("add", ".")
+	if err != nil {
+		return out, fmt.Errorf("failed to add files: %w", err)
+	}
+
+	out, err = m.runCmd("commit", "--allow-empty", "--no-gpg-sign", "-m", message)
+	if err != nil {
+		return out, fmt.Errorf("failed to commit: %w", err)
+	}
+	return "", nil
+}
+
 // CommitAndPush commits and pushes changes to the target branch.
 func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error) {
+	return m.commitAndPush(message, "push", "origin", branch)
+}
+
+// CommitAndForcePush commits changes and force-pushes the checked out commit to the target branch, replacing its
+// history. The checked out branch does not need to be the target branch.
+func (m *nativeGitClient) CommitAndForcePush(branch, message string) (string, error) {
+	return m.commitAndPush(message, "push", "--force", "origin", "HEAD:refs/heads/"+branch)
+}
+
+// commitAndPush commits changes and runs the given push command.
+func (m *nativeGitClient) commitAndPush(message string, pushArgs ...string) (string, error) {
 	out, err := m.runCmd("add", ".")
 	if err != nil {
 		return out, fmt.Errorf("failed to add files: %w", err)
@@ -900,7 +983,7 @@ func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error)
 		defer done()
 	}
 
-	err = m.runCredentialedCmd("push", "origin", branch)
+	err = m.runCredentialedCmd(pushArgs...)
 	if err != nil {
 		return "", fmt.Errorf("failed to push: %w", err)
 	}
@@ -908,6 +991,21 @@ func (m *nativeGitClient) CommitAndPush(branch, message string) (string, error)
 	return "", nil
 }
 
+// DiffWorkingTree stages all changes in the working tree and returns their diff against HEAD. If HEAD does not exist,
+// e.g. on a new orphan branch, the diff is against an empty tree.
+func (m *nativeGitClient) DiffWorkingTree() (string, error) {
+	out, err := m.runCmd("add", ".")
+	if err != nil {
+		return out, fmt.Errorf("failed to add files: %w", err)
+	}
+
+	out, err = m.runCmd("diff", "--cached", "--no-color", "--no-ext-diff")
+	if err != nil {
+		return out, fmt.Errorf("failed to diff: %w", err)
+	}
+	return out, nil
+}
+
 // runWrapper runs a custom command with all the semantics of running the Git client
 func (m *nativeGitClient) runGnuPGWrapper(wrapper string, args ...string) (string, error) {
 	cmd := exec.Command(wrapper, args...)
@@ -203,6 +203,54 @@ func Test_ChangedFiles(t *testing.T) {
 	assert.ElementsMatch(t, []string{"README"}, changedFiles)
 }
 
+func Test_ListCommits(t *testing.T) {
+	tempDir := t.TempDir()
+
+	client, err := NewClientExt("file://"+tempDir, tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	var shas []string
+	for _, commit := range []struct{ message, path string }{{"First", ""}, {"Second", "app/a.yaml"}, {"Third", "other/b.yaml"}} {
+		if commit.path != "" {
+			require.NoError(t, os.MkdirAll(filepath.Join(client.Root(), filepath.Dir(commit.path)), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(client.Root(), commit.path), []byte(commit.message), 0o644))
+			require.NoError(t, runCmd(client.Root(), "git", "add", commit.path))
+		}
+		err = runCmd(client.Root(), "git", "commit", "-m", commit.message, "--allow-empty")
+		require.NoError(t, err)
+		sha, err := client.CommitSHA()
+		require.NoError(t, err)
+		shas = append(shas, sha)
+	}
+
+	// Not SHAs, error
+	_, err = client.ListCommits(shas[0], "HEAD", nil)
+	require.Error(t, err)
+
+	// Same commit, no commits
+	commits, err := client.ListCommits(shas[2], shas[2], nil)
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+
+	// Newest first, excluding the first revision
+	commits, err = client.ListCommits(shas[0], shas[2], nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{shas[2], shas[1]}, commits)
+
+	// Only the commits changing the paths
+	commits, err = client.ListCommits(shas[0], shas[2], []string{"app"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{shas[1]}, commits)
+
+	// Not an ancestor, no commits
+	commits, err = client.ListCommits(shas[2], shas[0], nil)
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+}
+
 func Test_SemverTags(t *testing.T) {
 	tempDir := t.TempDir()
 
@@ -538,6 +586,45 @@ func Test_nativeGitClient_SetAuthor(t *testing.T) {
 	require.Equal(t, expectedEmail, actualEmail)
 }
 
+func Test_nativeGitClient_SetSigningKey(t *testing.T) {
+	tempDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+
+	client, err := NewClientExt("file://"+tempDir, tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	keyPath := filepath.Join(t.TempDir(), "id_ed25519")
+	_, err = outputCmd(t.TempDir(), "ssh-keygen", "-q", "-t", "ed25519", "-N", "", "-C", "test@example.com", "-f", keyPath)
+	require.NoError(t, err)
+
+	out, err := client.SetSigningKey("ssh", keyPath)
+	require.NoError(t, err, "error output: ", out)
+
+	for key, expected := range map[string]string{
+		"gpg.format":      "ssh",
+		"user.signingkey": keyPath,
+		"commit.gpgsign":  "true",
+	} {
+		actual, err := outputCmd(client.Root(), "git", "config", "--local", key)
+		require.NoError(t, err)
+		assert.Equal(t, expected, strings.TrimSpace(string(actual)))
+	}
+
+	out, err = client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err, "error output: %s", out)
+
+	// The initial commit of an orphan branch must be signed as well.
+	out, err = client.CheckoutOrOrphan("signed", false)
+	require.NoError(t, err, "error output: %s", out)
+
+	commit, err := outputCmd(tempDir, "git", "cat-file", "commit", "signed")
+	require.NoError(t, err)
+	assert.Contains(t, string(commit), "-----BEGIN SSH SIGNATURE-----")
+}
+
 func Test_nativeGitClient_CheckoutOrOrphan(t *testing.T) {
 	t.Run("checkout to an existing branch", func(t *testing.T) {
 		// not main or master
@@ -859,6 +946,141 @@ func Test_nativeGitClient_CommitAndPush(t *testing.T) {
 	require.Equal(t, expectedCommitHash, actualCommitHash)
 }
 
+func Test_nativeGitClient_CommitAndForcePush(t *testing.T) {
+	tempDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+
+	gitCurrentBranch, err := outputCmd(tempDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	branch := strings.TrimSpace(string(gitCurrentBranch))
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+	err = client.Init()
+	require.NoError(t, err)
+	out, err := client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err, "error output: ", out)
+	err = client.Fetch(branch)
+	require.NoError(t, err)
+
+	for _, file := range []string{"first.yaml", "second.yaml"} {
+		// every commit is made on top of the original branch, so that the second one does not descend from the first
+		out, err = client.Checkout(branch, false)
+		require.NoError(t, err, "error output: ", out)
+		err = runCmd(client.Root(), "git", "reset", "--hard", "origin/"+branch)
+		require.NoError(t, err)
+		err = runCmd(client.Root(), "touch", file)
+		require.NoError(t, err)
+
+		out, err = client.CommitAndForcePush("pull-request", "add "+file)
+		require.NoError(t, err, "error output: %s", out)
+
+		expectedCommitHash, err := client.CommitSHA()
+		require.NoError(t, err)
+		gitCurrentCommitHash, err := outputCmd(tempDir, "git", "rev-parse", "pull-request")
+		require.NoError(t, err)
+		require.Equal(t, expectedCommitHash, strings.TrimSpace(string(gitCurrentCommitHash)))
+	}
+
+	// the original branch is left untouched
+	out, err = client.Checkout(branch, false)
+	require.NoError(t, err, "error output: ", out)
+	gitFiles, err := outputCmd(tempDir, "git", "ls-tree", "--name-only", branch)
+	require.NoError(t, err)
+	assert.Empty(t, strings.TrimSpace(string(gitFiles)))
+}
+
+func Test_nativeGitClient_DiffWorkingTree(t *testing.T) {
+	tempDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(tempDir, "unchanged.yaml"), []byte("a: b\n"), 0o644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tempDir, "changed.yaml"), []byte("a: b\n"), 0o644)
+	require.NoError(t, err)
+	err = runCmd(tempDir, "git", "add", ".")
+	require.NoError(t, err)
+	err = runCmd(tempDir, "git", "commit", "-m", "Add manifests")
+	require.NoError(t, err)
+
+	gitCurrentBranch, err := outputCmd(tempDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	branch := strings.TrimSpace(string(gitCurrentBranch))
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	err = client.Fetch(branch)
+	require.NoError(t, err)
+
+	out, err := client.Checkout(branch, false)
+	require.NoError(t, err, "error output: ", out)
+
+	err = os.WriteFile(filepath.Join(client.Root(), "changed.yaml"), []byte("a: c\n"), 0o644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(client.Root(), "new.yaml"), []byte("d: e\n"), 0o644)
+	require.NoError(t, err)
+
+	diff, err := client.DiffWorkingTree()
+	require.NoError(t, err)
+	assert.Contains(t, diff, "diff --git a/changed.yaml b/changed.yaml")
+	assert.Contains(t, diff, "-a: b\n+a: c")
+	assert.Contains(t, diff, "diff --git a/new.yaml b/new.yaml")
+	assert.Contains(t, diff, "+d: e")
+	assert.NotContains(t, diff, "unchanged.yaml")
+
+	// Nothing must have been committed
+	sha, err := client.CommitSHA()
+	require.NoError(t, err)
+	originSHA, err := outputCmd(tempDir, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, strings.TrimSpace(string(originSHA)), sha)
+}
+
+func Test_nativeGitClient_Commit(t *testing.T) {
+	tempDir, err := _createEmptyGitRepo()
+	require.NoError(t, err)
+
+	gitCurrentBranch, err := outputCmd(tempDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	branch := strings.TrimSpace(string(gitCurrentBranch))
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	err = client.Fetch(branch)
+	require.NoError(t, err)
+
+	out, err := client.Checkout(branch, false)
+	require.NoError(t, err, "error output: ", out)
+
+	out, err = client.SetAuthor("test", "test@example.com")
+	require.NoError(t, err, "error output: ", out)
+
+	err = os.WriteFile(filepath.Join(client.Root(), "manifest.yaml"), []byte("a: b\n"), 0o644)
+	require.NoError(t, err)
+
+	out, err = client.Commit("local commit")
+	require.NoError(t, err, "error output: ", out)
+
+	diff, err := client.DiffWorkingTree()
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	// The commit must not have been pushed
+	sha, err := client.CommitSHA()
+	require.NoError(t, err)
+	originSHA, err := outputCmd(tempDir, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	assert.NotEqual(t, strings.TrimSpace(string(originSHA)), sha)
+}
+
 func Test_newAuth_AzureWorkloadIdentity(t *testing.T) {
 	tokenprovider := new(mocks.TokenProvider)
 	tokenprovider.On("GetToken", azureDevopsEntraResourceId).Return("accessToken", nil)
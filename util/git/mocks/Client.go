@@ -259,6 +259,115 @@ func (_c *Client_CheckoutOrOrphan_Call) RunAndReturn(run func(branch string, sub
 	return _c
 }
 
+// Commit provides a mock function for the type Client
+func (_mock *Client) Commit(message string) (string, error) {
+	ret := _mock.Called(message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Commit")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(message)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(message)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(message)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_Commit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Commit'
+type Client_Commit_Call struct {
+	*mock.Call
+}
+
+// Commit is a helper method to define mock.On call
+//   - message
+func (_e *Client_Expecter) Commit(message interface{}) *Client_Commit_Call {
+	return &Client_Commit_Call{Call: _e.mock.On("Commit", message)}
+}
+
+func (_c *Client_Commit_Call) Run(run func(message string)) *Client_Commit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Client_Commit_Call) Return(s string, err error) *Client_Commit_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_Commit_Call) RunAndReturn(run func(message string) (string, error)) *Client_Commit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommitAndForcePush provides a mock function for the type Client
+func (_mock *Client) CommitAndForcePush(branch string, message string) (string, error) {
+	ret := _mock.Called(branch, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitAndForcePush")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return returnFunc(branch, message)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = returnFunc(branch, message)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(branch, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_CommitAndForcePush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitAndForcePush'
+type Client_CommitAndForcePush_Call struct {
+	*mock.Call
+}
+
+// CommitAndForcePush is a helper method to define mock.On call
+//   - branch
+//   - message
+func (_e *Client_Expecter) CommitAndForcePush(branch interface{}, message interface{}) *Client_CommitAndForcePush_Call {
+	return &Client_CommitAndForcePush_Call{Call: _e.mock.On("CommitAndForcePush", branch, message)}
+}
+
+func (_c *Client_CommitAndForcePush_Call) Run(run func(branch string, message string)) *Client_CommitAndForcePush_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Client_CommitAndForcePush_Call) Return(s string, err error) *Client_CommitAndForcePush_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_CommitAndForcePush_Call) RunAndReturn(run func(branch string, message string) (string, error)) *Client_CommitAndForcePush_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CommitAndPush provides a mock function for the type Client
 func (_mock *Client) CommitAndPush(branch string, message string) (string, error) {
 	ret := _mock.Called(branch, message)
@@ -367,6 +476,59 @@ func (_c *Client_CommitSHA_Call) RunAndReturn(run func() (string, error)) *Clien
 	return _c
 }
 
+// DiffWorkingTree provides a mock function for the type Client
+func (_mock *Client) DiffWorkingTree() (string, error) {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffWorkingTree")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func() (string, error)); ok {
+		return returnFunc()
+	}
+	if returnFunc, ok := ret.Get(0).(func() string); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func() error); ok {
+		r1 = returnFunc()
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_DiffWorkingTree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffWorkingTree'
+type Client_DiffWorkingTree_Call struct {
+	*mock.Call
+}
+
+// DiffWorkingTree is a helper method to define mock.On call
+func (_e *Client_Expecter) DiffWorkingTree() *Client_DiffWorkingTree_Call {
+	return &Client_DiffWorkingTree_Call{Call: _e.mock.On("DiffWorkingTree")}
+}
+
+func (_c *Client_DiffWorkingTree_Call) Run(run func()) *Client_DiffWorkingTree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Client_DiffWorkingTree_Call) Return(s string, err error) *Client_DiffWorkingTree_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_DiffWorkingTree_Call) RunAndReturn(run func() (string, error)) *Client_DiffWorkingTree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Fetch provides a mock function for the type Client
 func (_mock *Client) Fetch(revision string) error {
 	ret := _mock.Called(revision)
@@ -546,6 +708,64 @@ func (_c *Client_IsRevisionPresent_Call) RunAndReturn(run func(revision string)
 	return _c
 }
 
+// ListCommits provides a mock function for the type Client
+func (_mock *Client) ListCommits(revision string, targetRevision string, paths []string) ([]string, error) {
+	ret := _mock.Called(revision, targetRevision, paths)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommits")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, []string) ([]string, error)); ok {
+		return returnFunc(revision, targetRevision, paths)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, []string) []string); ok {
+		r0 = returnFunc(revision, targetRevision, paths)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, []string) error); ok {
+		r1 = returnFunc(revision, targetRevision, paths)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_ListCommits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCommits'
+type Client_ListCommits_Call struct {
+	*mock.Call
+}
+
+// ListCommits is a helper method to define mock.On call
+//   - revision
+//   - targetRevision
+//   - paths
+func (_e *Client_Expecter) ListCommits(revision interface{}, targetRevision interface{}, paths interface{}) *Client_ListCommits_Call {
+	return &Client_ListCommits_Call{Call: _e.mock.On("ListCommits", revision, targetRevision, paths)}
+}
+
+func (_c *Client_ListCommits_Call) Run(run func(revision string, targetRevision string, paths []string)) *Client_ListCommits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *Client_ListCommits_Call) Return(strings []string, err error) *Client_ListCommits_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *Client_ListCommits_Call) RunAndReturn(run func(revision string, targetRevision string, paths []string) ([]string, error)) *Client_ListCommits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LsFiles provides a mock function for the type Client
 func (_mock *Client) LsFiles(path string, enableNewGitFileGlobbing bool) ([]string, error) {
 	ret := _mock.Called(path, enableNewGitFileGlobbing)
@@ -975,6 +1195,61 @@ func (_c *Client_SetAuthor_Call) RunAndReturn(run func(name string, email string
 	return _c
 }
 
+// SetSigningKey provides a mock function for the type Client
+func (_mock *Client) SetSigningKey(format string, key string) (string, error) {
+	ret := _mock.Called(format, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSigningKey")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return returnFunc(format, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = returnFunc(format, key)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(format, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_SetSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSigningKey'
+type Client_SetSigningKey_Call struct {
+	*mock.Call
+}
+
+// SetSigningKey is a helper method to define mock.On call
+//   - format
+//   - key
+func (_e *Client_Expecter) SetSigningKey(format interface{}, key interface{}) *Client_SetSigningKey_Call {
+	return &Client_SetSigningKey_Call{Call: _e.mock.On("SetSigningKey", format, key)}
+}
+
+func (_c *Client_SetSigningKey_Call) Run(run func(format string, key string)) *Client_SetSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Client_SetSigningKey_Call) Return(s string, err error) *Client_SetSigningKey_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_SetSigningKey_Call) RunAndReturn(run func(format string, key string) (string, error)) *Client_SetSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Submodule provides a mock function for the type Client
 func (_mock *Client) Submodule() error {
 	ret := _mock.Called()
@@ -342,6 +342,45 @@ func ImportPGPKeys(keyFile string) ([]*appsv1.GnuPGPublicKey, error) {
 	return keys, nil
 }
 
+// ImportSigningKey imports a private key from a file into the local keyring, so that it can be used to sign commits.
+// It returns the fingerprint of the key. Importing a key that is already in the keyring is not an error.
+func ImportSigningKey(keyFile string) (string, error) {
+	cmd := exec.Command("gpg", "--no-permission-warning", "--batch", "--with-colons", "--import-options", "show-only", "--import", keyFile)
+	cmd.Env = getGPGEnviron()
+
+	out, err := executil.Run(cmd)
+	if err != nil {
+		return "", fmt.Errorf("failed to read signing key: %w", err)
+	}
+
+	// The fingerprint follows the sec record of the first private key in the file.
+	fingerprint := ""
+	inSecretKey := false
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ":")
+		if fields[0] == "sec" {
+			inSecretKey = true
+		} else if fields[0] == "fpr" && inSecretKey && len(fields) > 9 {
+			fingerprint = fields[9]
+			break
+		}
+	}
+	if fingerprint == "" {
+		return "", fmt.Errorf("no private key found in %s", keyFile)
+	}
+
+	cmd = exec.Command("gpg", "--no-permission-warning", "--batch", "--import", keyFile)
+	cmd.Env = getGPGEnviron()
+
+	_, err = executil.Run(cmd)
+	if err != nil {
+		return "", fmt.Errorf("failed to import signing key: %w", err)
+	}
+
+	return fingerprint, nil
+}
+
 func ValidatePGPKeysFromString(keyData string) (map[string]*appsv1.GnuPGPublicKey, error) {
 	f, err := writeKeyToFile(keyData)
 	if err != nil {
@@ -246,6 +246,33 @@ func Test_ImportPGPKeysFromString(t *testing.T) {
 	assert.Equal(t, "unknown", keys[0].SubType)
 }
 
+func Test_ImportSigningKey(t *testing.T) {
+	initTempDir(t)
+
+	err := InitializeGnuPG()
+	require.NoError(t, err)
+
+	fingerprint, err := ImportSigningKey("testdata/signing.asc")
+	require.NoError(t, err)
+	assert.Equal(t, "A8EA2C2E6208308E01A4832FDFB0F0543C6320DD", fingerprint)
+
+	secret, err := IsSecretKey(KeyID(fingerprint))
+	require.NoError(t, err)
+	assert.True(t, secret)
+
+	// Importing the same key again, e.g. after a container restart, must not fail
+	fingerprint, err = ImportSigningKey("testdata/signing.asc")
+	require.NoError(t, err)
+	assert.Equal(t, "A8EA2C2E6208308E01A4832FDFB0F0543C6320DD", fingerprint)
+
+	// Public keys cannot be used for signing
+	_, err = ImportSigningKey("testdata/github.asc")
+	require.ErrorContains(t, err, "no private key found")
+
+	_, err = ImportSigningKey("testdata/garbage.asc")
+	require.Error(t, err)
+}
+
 func Test_ValidateGPGKeysFromString(t *testing.T) {
 	initTempDir(t)
 
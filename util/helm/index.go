@@ -6,8 +6,11 @@ import (
 )
 
 type Entry struct {
-	Version string
-	Created time.Time
+	Version     string
+	Created     time.Time
+	AppVersion  string            `yaml:"appVersion,omitempty"`
+	Description string            `yaml:"description,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
 }
 
 type Entries []Entry
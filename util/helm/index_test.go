@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
 )
 
 var index = Index{
@@ -34,3 +35,30 @@ func TestIndex_GetEntries(t *testing.T) {
 		assert.Len(t, ts, len(index.Entries["argo-cd"]))
 	})
 }
+
+func TestIndex_Decode(t *testing.T) {
+	data := `apiVersion: v1
+entries:
+  guestbook:
+  - name: guestbook
+    version: 1.1.0
+    appVersion: v2
+    description: A guestbook
+    annotations:
+      category: demo
+    created: "2024-01-02T03:04:05Z"
+  - name: guestbook
+    version: 1.0.0
+`
+	decoded := Index{}
+	require.NoError(t, yaml.Unmarshal([]byte(data), &decoded))
+	entries, err := decoded.GetEntries("guestbook")
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "1.1.0", entries[0].Version)
+	assert.Equal(t, "v2", entries[0].AppVersion)
+	assert.Equal(t, "A guestbook", entries[0].Description)
+	assert.Equal(t, map[string]string{"category": "demo"}, entries[0].Annotations)
+	assert.Equal(t, 2024, entries[0].Created.Year())
+	assert.Equal(t, []string{"1.1.0", "1.0.0"}, entries.Tags())
+}
@@ -68,7 +68,17 @@ func Untgz(dstPath string, r io.Reader, maxSize int64, preserveFileMode bool) er
 	}
 	defer gzr.Close()
 
-	lr := io.LimitReader(gzr, maxSize)
+	return Untar(dstPath, gzr, maxSize, preserveFileMode)
+}
+
+// Untar will loop over the uncompressed tar reader creating the file structure at dstPath.
+// The same restrictions as for Untgz apply to dstPath.
+func Untar(dstPath string, r io.Reader, maxSize int64, preserveFileMode bool) error {
+	if !filepath.IsAbs(dstPath) {
+		return fmt.Errorf("dstPath points to a relative path: %s", dstPath)
+	}
+
+	lr := io.LimitReader(r, maxSize)
 	tr := tar.NewReader(lr)
 
 	for {
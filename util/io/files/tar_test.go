@@ -207,6 +207,37 @@ func TestUntgz(t *testing.T) {
 	})
 }
 
+func TestUntar(t *testing.T) {
+	t.Run("will untar successfully", func(t *testing.T) {
+		// given
+		tmpDir := t.TempDir()
+		tgzFile, err := os.CreateTemp(tmpDir, "")
+		require.NoError(t, err)
+		defer tgzFile.Close()
+		_, err = files.Tgz(getTestAppDir(t), nil, nil, tgzFile)
+		require.NoError(t, err)
+		_, err = tgzFile.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		gzr, err := gzip.NewReader(tgzFile)
+		require.NoError(t, err)
+		defer gzr.Close()
+
+		destDir := filepath.Join(tmpDir, "untar1")
+
+		// when
+		err = files.Untar(destDir, gzr, math.MaxInt64, false)
+
+		// then
+		require.NoError(t, err)
+		assert.FileExists(t, filepath.Join(destDir, "README.md"))
+		assert.FileExists(t, filepath.Join(destDir, "applicationset/latest/kustomization.yaml"))
+	})
+	t.Run("will fail for relative destination", func(t *testing.T) {
+		err := files.Untar("relative", nil, math.MaxInt64, false)
+		assert.ErrorContains(t, err, "relative path")
+	})
+}
+
 // read returns a map with the filename as key. In case
 // the file is a symlink, the value will be populated with
 // the target file pointed by the symlink.
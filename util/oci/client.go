@@ -0,0 +1,494 @@
+package oci
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/argoproj/pkg/v2/sync"
+	"github.com/opencontainers/go-digest"
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	log "github.com/sirupsen/logrus"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	ocistore "oras.land/oras-go/v2/content/oci"
+	"oras.land/oras-go/v2/registry"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/credentials"
+
+	"github.com/argoproj/argo-cd/v3/util/cache"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/io/files"
+	"github.com/argoproj/argo-cd/v3/util/proxy"
+	"github.com/argoproj/argo-cd/v3/util/versions"
+)
+
+const (
+	// Prefix is the scheme prefix of OCI repository URLs, e.g. oci://ghcr.io/argoproj/manifests
+	Prefix = "oci://"
+
+	// annotationUnpack is set by the oras CLI on layers holding a directory packed as tarball
+	annotationUnpack = "io.deis.oras.content.unpack"
+	// mediaTypeDockerManifest is the Docker image manifest, which shares the structure of the OCI image manifest
+	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+var (
+	globalLock = sync.NewKeyLock()
+	tagsLock   = sync.NewKeyLock()
+
+	// DefaultLayerMediaTypes are the layer media types which are extracted if no other types are configured
+	DefaultLayerMediaTypes = []string{
+		imagev1.MediaTypeImageLayer,
+		imagev1.MediaTypeImageLayerGzip,
+	}
+)
+
+type indexCache interface {
+	SetOCITags(repo string, tagsData []byte) error
+	GetOCITags(repo string, tagsData *[]byte) error
+	SetOCIDigest(repo, tag, digest string) error
+	GetOCIDigest(repo, tag string, digest *string) error
+}
+
+// Client is a client for generic OCI artifacts holding application manifests.
+type Client interface {
+	// ResolveRevision resolves a tag, semver constraint or digest into the digest of the referenced artifact
+	ResolveRevision(ctx context.Context, revision string, noCache bool) (string, error)
+	// DigestMetadata returns the image manifest of the artifact with the given digest
+	DigestMetadata(ctx context.Context, digest string) (*imagev1.Manifest, error)
+	// CleanCache removes the locally cached artifact with the given digest
+	CleanCache(digest string) error
+	// Extract pulls the artifact with the given digest and extracts its layers into a temporary directory. The
+	// directory is removed when the returned closer is closed.
+	Extract(ctx context.Context, digest string, manifestMaxExtractedSize int64, disableManifestMaxExtractedSize bool) (string, utilio.Closer, error)
+	// GetTags returns all tags of the repository
+	GetTags(ctx context.Context, noCache bool) ([]string, error)
+	// TestRepo checks whether the repository can be accessed with the configured credentials
+	TestRepo(ctx context.Context) (bool, error)
+}
+
+// Creds holds the credentials used to access an OCI registry
+type Creds struct {
+	Username           string
+	Password           string
+	CAPath             string
+	CertData           []byte
+	KeyData            []byte
+	InsecureSkipVerify bool
+}
+
+// repository is the subset of the oras remote repository used by the client
+type repository interface {
+	oras.ReadOnlyTarget
+	registry.TagLister
+}
+
+type ClientOpts func(c *nativeOCIClient)
+
+func WithIndexCache(indexCache indexCache) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.indexCache = indexCache
+	}
+}
+
+func WithImagePaths(imagePaths utilio.TempPaths) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.imagePaths = imagePaths
+	}
+}
+
+// WithLayerMediaTypes restricts the layer media types which may be extracted from an artifact
+func WithLayerMediaTypes(layerMediaTypes []string) ClientOpts {
+	return func(c *nativeOCIClient) {
+		c.layerMediaTypes = layerMediaTypes
+	}
+}
+
+// NewClient returns a new OCI client for the given repository URL. The URL may or may not be prefixed with oci://.
+func NewClient(repoURL string, creds Creds, proxy string, noProxy string, opts ...ClientOpts) (Client, error) {
+	return NewClientWithLock(repoURL, creds, globalLock, proxy, noProxy, opts...)
+}
+
+func NewClientWithLock(repoURL string, creds Creds, repoLock sync.KeyLock, proxyURL string, noProxy string, opts ...ClientOpts) (Client, error) {
+	ociRepo := strings.TrimPrefix(repoURL, Prefix)
+	repo, err := remote.NewRepository(ociRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize repository %s: %w", repoURL, err)
+	}
+
+	tlsConf, err := newTLSConfig(creds)
+	if err != nil {
+		return nil, fmt.Errorf("failed setup tlsConfig: %w", err)
+	}
+	client := &http.Client{Transport: &http.Transport{
+		Proxy:             proxy.GetCallback(proxyURL, noProxy),
+		TLSClientConfig:   tlsConf,
+		DisableKeepAlives: true,
+	}}
+
+	credential := auth.StaticCredential(repo.Reference.Registry, auth.Credential{
+		Username: creds.Username,
+		Password: creds.Password,
+	})
+	// Try to fallback to the environment config, but we shouldn't error if the file is not set
+	if creds.Username == "" && creds.Password == "" {
+		store, _ := credentials.NewStoreFromDocker(credentials.StoreOptions{})
+		if store != nil {
+			credential = credentials.Credential(store)
+		}
+	}
+	repo.Client = &auth.Client{
+		Client:     client,
+		Cache:      nil,
+		Credential: credential,
+	}
+
+	return newClientWithRepository(ociRepo, repo, repoLock, opts...), nil
+}
+
+func newClientWithRepository(repoURL string, repo repository, repoLock sync.KeyLock, opts ...ClientOpts) *nativeOCIClient {
+	c := &nativeOCIClient{
+		repoURL:         repoURL,
+		repo:            repo,
+		repoLock:        repoLock,
+		imagePaths:      utilio.NewRandomizedTempPaths(os.TempDir()),
+		layerMediaTypes: DefaultLayerMediaTypes,
+	}
+	for i := range opts {
+		opts[i](c)
+	}
+	return c
+}
+
+var _ Client = &nativeOCIClient{}
+
+type nativeOCIClient struct {
+	repoURL         string
+	repo            repository
+	repoLock        sync.KeyLock
+	indexCache      indexCache
+	imagePaths      utilio.TempPaths
+	layerMediaTypes []string
+}
+
+// IsOCIRepo returns true if the given repository URL references an OCI repository
+func IsOCIRepo(repoURL string) bool {
+	return strings.HasPrefix(repoURL, Prefix)
+}
+
+func (c *nativeOCIClient) ResolveRevision(ctx context.Context, revision string, noCache bool) (string, error) {
+	if d, err := digest.Parse(revision); err == nil {
+		return d.String(), nil
+	}
+
+	tag := revision
+	if versions.IsConstraint(revision) {
+		tags, err := c.GetTags(ctx, noCache)
+		if err != nil {
+			return "", fmt.Errorf("unable to get tags: %w", err)
+		}
+		tag, err = versions.MaxVersion(revision, tags)
+		if err != nil {
+			return "", fmt.Errorf("invalid revision: %w", err)
+		}
+	}
+
+	if !noCache && c.indexCache != nil {
+		var cached string
+		if err := c.indexCache.GetOCIDigest(c.repoURL, tag, &cached); err == nil && cached != "" {
+			return cached, nil
+		} else if err != nil && !errors.Is(err, cache.ErrCacheMiss) {
+			log.Warnf("Failed to load digest cache for repo %s and tag %s: %v", c.repoURL, tag, err)
+		}
+	}
+
+	desc, err := c.repo.Resolve(ctx, tag)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve tag %q: %w", tag, err)
+	}
+
+	if c.indexCache != nil {
+		if err := c.indexCache.SetOCIDigest(c.repoURL, tag, desc.Digest.String()); err != nil {
+			log.Warnf("Failed to store digest cache for repo %s and tag %s: %v", c.repoURL, tag, err)
+		}
+	}
+	return desc.Digest.String(), nil
+}
+
+func (c *nativeOCIClient) DigestMetadata(ctx context.Context, revision string) (*imagev1.Manifest, error) {
+	d, err := digest.Parse(revision)
+	if err != nil {
+		return nil, fmt.Errorf("revision %s must be resolved: %w", revision, err)
+	}
+	desc, err := c.repo.Resolve(ctx, d.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve digest %s: %w", d, err)
+	}
+	return fetchManifest(ctx, c.repo, desc)
+}
+
+func (c *nativeOCIClient) CleanCache(revision string) error {
+	cachePath, err := c.getCachedPath(revision)
+	if err != nil {
+		return fmt.Errorf("error getting cached image path: %w", err)
+	}
+	if err := os.RemoveAll(cachePath); err != nil {
+		return fmt.Errorf("error removing image cache at %s: %w", cachePath, err)
+	}
+	return nil
+}
+
+func (c *nativeOCIClient) Extract(ctx context.Context, revision string, manifestMaxExtractedSize int64, disableManifestMaxExtractedSize bool) (string, utilio.Closer, error) {
+	d, err := digest.Parse(revision)
+	if err != nil {
+		return "", nil, fmt.Errorf("revision %s must be resolved: %w", revision, err)
+	}
+
+	cachePath, err := c.getCachedPath(d.String())
+	if err != nil {
+		return "", nil, fmt.Errorf("error getting cached image path: %w", err)
+	}
+
+	c.repoLock.Lock(cachePath)
+	defer c.repoLock.Unlock(cachePath)
+
+	// the artifact is cached as OCI image layout, so that it only needs to be pulled once
+	store, err := ocistore.NewWithContext(ctx, cachePath)
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating image layout at %s: %w", cachePath, err)
+	}
+	desc, err := store.Resolve(ctx, d.String())
+	if err != nil {
+		start := time.Now()
+		desc, err = oras.Copy(ctx, c.repo, d.String(), store, d.String(), oras.DefaultCopyOptions)
+		if err != nil {
+			_ = os.RemoveAll(cachePath)
+			return "", nil, fmt.Errorf("error pulling image %s@%s: %w", c.repoURL, d, err)
+		}
+		log.WithFields(log.Fields{"seconds": time.Since(start).Seconds(), "repo": c.repoURL, "digest": d.String()}).Info("took to pull image")
+	}
+
+	manifest, err := fetchManifest(ctx, store, desc)
+	if err != nil {
+		return "", nil, err
+	}
+
+	maxSize := manifestMaxExtractedSize
+	if disableManifestMaxExtractedSize {
+		maxSize = math.MaxInt64
+	}
+
+	// throw away temp directory that stores extracted layers and should be deleted as soon as no longer needed by returned closer
+	tempDir, err := files.CreateTempDir(os.TempDir())
+	if err != nil {
+		return "", nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+	// the maximum size applies to the artifact as a whole, so every layer takes from the same budget
+	remaining := maxSize
+	for _, layer := range manifest.Layers {
+		if err := c.extractLayer(ctx, store, layer, tempDir, maxSize, &remaining); err != nil {
+			_ = os.RemoveAll(tempDir)
+			return "", nil, err
+		}
+	}
+	return tempDir, utilio.NewCloser(func() error {
+		return os.RemoveAll(tempDir)
+	}), nil
+}
+
+// extractLayer extracts the layer into dstPath and deducts the number of extracted bytes from remaining.
+func (c *nativeOCIClient) extractLayer(ctx context.Context, store content.Fetcher, layer imagev1.Descriptor, dstPath string, maxSize int64, remaining *int64) error {
+	if !slices.Contains(c.layerMediaTypes, layer.MediaType) {
+		return fmt.Errorf("layer %s has unsupported media type %q", layer.Digest, layer.MediaType)
+	}
+	exceededErr := fmt.Errorf("layer %s exceeds the maximum extracted size of %d bytes", layer.Digest, maxSize)
+	if layer.Size > *remaining {
+		return exceededErr
+	}
+
+	rc, err := store.Fetch(ctx, layer)
+	if err != nil {
+		return fmt.Errorf("error fetching layer %s: %w", layer.Digest, err)
+	}
+	defer utilio.Close(rc)
+	// one byte more than the remaining budget is read, so that a layer using exactly all of it is told apart from one
+	// exceeding it
+	limit := *remaining
+	if limit < math.MaxInt64 {
+		limit++
+	}
+
+	title := layer.Annotations[imagev1.AnnotationTitle]
+	if title != "" && layer.Annotations[annotationUnpack] != "true" {
+		// a single file pushed as is, e.g. with "oras push <ref> deployment.yaml"
+		target := filepath.Join(dstPath, title)
+		if !files.Inbound(target, dstPath) {
+			return fmt.Errorf("illegal filepath in layer title: %s", title)
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return fmt.Errorf("error creating nested folders: %w", err)
+		}
+		f, err := os.OpenFile(target, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+		if err != nil {
+			return fmt.Errorf("error creating file %q: %w", target, err)
+		}
+		defer utilio.Close(f)
+		n, err := io.Copy(f, io.LimitReader(rc, limit))
+		if err != nil {
+			return fmt.Errorf("error writing file %q: %w", target, err)
+		}
+		*remaining -= n
+		if *remaining < 0 {
+			return exceededErr
+		}
+		return nil
+	}
+
+	var r io.Reader = rc
+	if strings.HasSuffix(layer.MediaType, "+gzip") {
+		gzr, err := gzip.NewReader(rc)
+		if err != nil {
+			return fmt.Errorf("error decompressing layer %s: %w", layer.Digest, err)
+		}
+		defer utilio.Close(gzr)
+		r = gzr
+	}
+	// Untar stops reading past the remaining budget, so a layer that exceeded it has been cut short
+	counter := &countingReader{r: r}
+	err = files.Untar(dstPath, counter, limit, false)
+	*remaining -= counter.n
+	if *remaining < 0 {
+		return exceededErr
+	}
+	if err != nil {
+		return fmt.Errorf("error extracting layer %s: %w", layer.Digest, err)
+	}
+	return nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	return n, err
+}
+
+func (c *nativeOCIClient) GetTags(ctx context.Context, noCache bool) ([]string, error) {
+	tagsLock.Lock(c.repoURL)
+	defer tagsLock.Unlock(c.repoURL)
+
+	var data []byte
+	if !noCache && c.indexCache != nil {
+		if err := c.indexCache.GetOCITags(c.repoURL, &data); err != nil && !errors.Is(err, cache.ErrCacheMiss) {
+			log.Warnf("Failed to load tags cache for repo: %s: %v", c.repoURL, err)
+		}
+	}
+
+	var tags []string
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &tags); err != nil {
+			return nil, fmt.Errorf("failed to decode tags: %w", err)
+		}
+		return tags, nil
+	}
+
+	start := time.Now()
+	err := c.repo.Tags(ctx, "", func(tagsResult []string) error {
+		tags = append(tags, tagsResult...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+	log.WithFields(log.Fields{"seconds": time.Since(start).Seconds(), "repo": c.repoURL}).Info("took to get tags")
+
+	if c.indexCache != nil {
+		data, err = json.Marshal(tags)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode tags: %w", err)
+		}
+		if err := c.indexCache.SetOCITags(c.repoURL, data); err != nil {
+			log.Warnf("Failed to store tags list cache for repo: %s: %v", c.repoURL, err)
+		}
+	}
+	return tags, nil
+}
+
+var errStopListing = errors.New("stop listing")
+
+func (c *nativeOCIClient) TestRepo(ctx context.Context) (bool, error) {
+	// listing the first page of tags requires pull access to the repository
+	err := c.repo.Tags(ctx, "", func(_ []string) error {
+		return errStopListing
+	})
+	if err != nil && !errors.Is(err, errStopListing) {
+		return false, fmt.Errorf("failed to list tags of %s: %w", c.repoURL, err)
+	}
+	return true, nil
+}
+
+func (c *nativeOCIClient) getCachedPath(revision string) (string, error) {
+	keyData, err := json.Marshal(map[string]string{"url": c.repoURL, "digest": revision})
+	if err != nil {
+		return "", fmt.Errorf("error marshaling cache key data: %w", err)
+	}
+	return c.imagePaths.GetPath(string(keyData))
+}
+
+func fetchManifest(ctx context.Context, store content.Fetcher, desc imagev1.Descriptor) (*imagev1.Manifest, error) {
+	if desc.MediaType != imagev1.MediaTypeImageManifest && desc.MediaType != mediaTypeDockerManifest {
+		return nil, fmt.Errorf("unsupported manifest media type %q, expected %q", desc.MediaType, imagev1.MediaTypeImageManifest)
+	}
+	data, err := content.FetchAll(ctx, store, desc)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching manifest %s: %w", desc.Digest, err)
+	}
+	manifest := &imagev1.Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("error decoding manifest %s: %w", desc.Digest, err)
+	}
+	return manifest, nil
+}
+
+func newTLSConfig(creds Creds) (*tls.Config, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: creds.InsecureSkipVerify}
+
+	if creds.CAPath != "" {
+		caData, err := os.ReadFile(creds.CAPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA file %s: %w", creds.CAPath, err)
+		}
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM(caData)
+		tlsConfig.RootCAs = caCertPool
+	}
+
+	// If a client cert & key is provided then configure TLS config accordingly.
+	if len(creds.CertData) > 0 && len(creds.KeyData) > 0 {
+		cert, err := tls.X509KeyPair(creds.CertData, creds.KeyData)
+		if err != nil {
+			return nil, fmt.Errorf("error creating X509 key pair: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
@@ -0,0 +1,355 @@
+package oci
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/argoproj/pkg/v2/sync"
+	imagev1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content/memory"
+
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+// taggedStore is an in-memory repository which keeps track of the pushed tags
+type taggedStore struct {
+	*memory.Store
+	tags []string
+}
+
+func (s *taggedStore) Tags(_ context.Context, _ string, fn func(tags []string) error) error {
+	return fn(s.tags)
+}
+
+func (s *taggedStore) tag(t *testing.T, desc imagev1.Descriptor, tag string) {
+	t.Helper()
+	require.NoError(t, s.Tag(t.Context(), desc, tag))
+	s.tags = append(s.tags, tag)
+}
+
+type fakeIndexCache struct {
+	tags    map[string][]byte
+	digests map[string]string
+}
+
+func (f *fakeIndexCache) SetOCITags(repo string, tagsData []byte) error {
+	f.tags[repo] = tagsData
+	return nil
+}
+
+func (f *fakeIndexCache) GetOCITags(repo string, tagsData *[]byte) error {
+	*tagsData = f.tags[repo]
+	return nil
+}
+
+func (f *fakeIndexCache) SetOCIDigest(repo, tag, digest string) error {
+	f.digests[repo+"|"+tag] = digest
+	return nil
+}
+
+func (f *fakeIndexCache) GetOCIDigest(repo, tag string, digest *string) error {
+	*digest = f.digests[repo+"|"+tag]
+	return nil
+}
+
+func tgzLayer(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+	for name, data := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+	return buf.Bytes()
+}
+
+func pushArtifact(t *testing.T, store *taggedStore, layers map[string][]byte, annotations map[string]map[string]string, tags ...string) imagev1.Descriptor {
+	t.Helper()
+	ctx := t.Context()
+	var descs []imagev1.Descriptor
+	for mediaType, data := range layers {
+		desc, err := oras.PushBytes(ctx, store, mediaType, data)
+		require.NoError(t, err)
+		desc.Annotations = annotations[mediaType]
+		descs = append(descs, desc)
+	}
+	manifest, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, "application/vnd.argoproj.test", oras.PackManifestOptions{
+		Layers:              descs,
+		ManifestAnnotations: map[string]string{imagev1.AnnotationAuthors: "argo", imagev1.AnnotationCreated: "2025-01-01T00:00:00Z"},
+	})
+	require.NoError(t, err)
+	// unlike a registry, the memory store only resolves digests which have been tagged explicitly
+	require.NoError(t, store.Tag(ctx, manifest, manifest.Digest.String()))
+	for _, tag := range tags {
+		store.tag(t, manifest, tag)
+	}
+	return manifest
+}
+
+func newTestClient(t *testing.T, store *taggedStore, opts ...ClientOpts) *nativeOCIClient {
+	t.Helper()
+	opts = append([]ClientOpts{WithImagePaths(utilio.NewRandomizedTempPaths(t.TempDir()))}, opts...)
+	return newClientWithRepository("example.com/argoproj/manifests", store, sync.NewKeyLock(), opts...)
+}
+
+func TestResolveRevision(t *testing.T) {
+	store := &taggedStore{Store: memory.New()}
+	v1 := pushArtifact(t, store, map[string][]byte{imagev1.MediaTypeImageLayerGzip: tgzLayer(t, map[string]string{"a.yaml": "a: 1"})}, nil, "1.0.0")
+	v2 := pushArtifact(t, store, map[string][]byte{imagev1.MediaTypeImageLayerGzip: tgzLayer(t, map[string]string{"a.yaml": "a: 2"})}, nil, "1.1.0", "latest")
+	client := newTestClient(t, store)
+
+	t.Run("tag", func(t *testing.T) {
+		digest, err := client.ResolveRevision(t.Context(), "1.0.0", false)
+		require.NoError(t, err)
+		assert.Equal(t, v1.Digest.String(), digest)
+	})
+	t.Run("non semver tag", func(t *testing.T) {
+		digest, err := client.ResolveRevision(t.Context(), "latest", false)
+		require.NoError(t, err)
+		assert.Equal(t, v2.Digest.String(), digest)
+	})
+	t.Run("constraint", func(t *testing.T) {
+		digest, err := client.ResolveRevision(t.Context(), "^1.0.0", false)
+		require.NoError(t, err)
+		assert.Equal(t, v2.Digest.String(), digest)
+	})
+	t.Run("digest", func(t *testing.T) {
+		digest, err := client.ResolveRevision(t.Context(), v1.Digest.String(), false)
+		require.NoError(t, err)
+		assert.Equal(t, v1.Digest.String(), digest)
+	})
+	t.Run("unknown tag", func(t *testing.T) {
+		_, err := client.ResolveRevision(t.Context(), "2.0.0", false)
+		assert.ErrorContains(t, err, "failed to resolve tag")
+	})
+	t.Run("unsatisfied constraint", func(t *testing.T) {
+		_, err := client.ResolveRevision(t.Context(), "^2.0.0", false)
+		assert.ErrorContains(t, err, "invalid revision")
+	})
+}
+
+func TestResolveRevision_Cache(t *testing.T) {
+	store := &taggedStore{Store: memory.New()}
+	v1 := pushArtifact(t, store, map[string][]byte{imagev1.MediaTypeImageLayerGzip: tgzLayer(t, map[string]string{"a.yaml": "a: 1"})}, nil, "latest")
+	indexCache := &fakeIndexCache{tags: map[string][]byte{}, digests: map[string]string{}}
+	client := newTestClient(t, store, WithIndexCache(indexCache))
+
+	digest, err := client.ResolveRevision(t.Context(), "latest", false)
+	require.NoError(t, err)
+	assert.Equal(t, v1.Digest.String(), digest)
+	assert.Equal(t, v1.Digest.String(), indexCache.digests["example.com/argoproj/manifests|latest"])
+
+	// move the tag, the cached digest is returned unless the cache is bypassed
+	v2 := pushArtifact(t, store, map[string][]byte{imagev1.MediaTypeImageLayerGzip: tgzLayer(t, map[string]string{"a.yaml": "a: 2"})}, nil, "latest")
+	digest, err = client.ResolveRevision(t.Context(), "latest", false)
+	require.NoError(t, err)
+	assert.Equal(t, v1.Digest.String(), digest)
+	digest, err = client.ResolveRevision(t.Context(), "latest", true)
+	require.NoError(t, err)
+	assert.Equal(t, v2.Digest.String(), digest)
+}
+
+func TestExtract(t *testing.T) {
+	store := &taggedStore{Store: memory.New()}
+	artifact := pushArtifact(t, store,
+		map[string][]byte{
+			imagev1.MediaTypeImageLayerGzip: tgzLayer(t, map[string]string{"app/kustomization.yaml": "resources: []"}),
+			imagev1.MediaTypeImageLayer:     []byte("kind: ConfigMap"),
+		},
+		map[string]map[string]string{
+			imagev1.MediaTypeImageLayerGzip: {imagev1.AnnotationTitle: "app", annotationUnpack: "true"},
+			imagev1.MediaTypeImageLayer:     {imagev1.AnnotationTitle: "configmap.yaml"},
+		},
+		"1.0.0")
+	client := newTestClient(t, store)
+
+	path, closer, err := client.Extract(t.Context(), artifact.Digest.String(), math.MaxInt64, false)
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+
+	data, err := os.ReadFile(filepath.Join(path, "app", "kustomization.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "resources: []", string(data))
+	data, err = os.ReadFile(filepath.Join(path, "configmap.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "kind: ConfigMap", string(data))
+
+	t.Run("served from cache", func(t *testing.T) {
+		cachedPath, err := client.getCachedPath(artifact.Digest.String())
+		require.NoError(t, err)
+		assert.DirExists(t, cachedPath)
+
+		otherPath, otherCloser, err := client.Extract(t.Context(), artifact.Digest.String(), math.MaxInt64, false)
+		require.NoError(t, err)
+		defer utilio.Close(otherCloser)
+		assert.NotEqual(t, path, otherPath)
+		assert.FileExists(t, filepath.Join(otherPath, "configmap.yaml"))
+
+		require.NoError(t, client.CleanCache(artifact.Digest.String()))
+		assert.NoDirExists(t, cachedPath)
+	})
+
+	t.Run("closer removes extracted files", func(t *testing.T) {
+		otherPath, otherCloser, err := client.Extract(t.Context(), artifact.Digest.String(), math.MaxInt64, false)
+		require.NoError(t, err)
+		require.NoError(t, otherCloser.Close())
+		assert.NoDirExists(t, otherPath)
+	})
+
+	t.Run("unresolved revision", func(t *testing.T) {
+		_, _, err := client.Extract(t.Context(), "1.0.0", math.MaxInt64, false)
+		assert.ErrorContains(t, err, "must be resolved")
+	})
+
+	t.Run("max extracted size", func(t *testing.T) {
+		_, _, err := client.Extract(t.Context(), artifact.Digest.String(), 5, false)
+		require.ErrorContains(t, err, "exceeds the maximum extracted size")
+
+		path, closer, err := client.Extract(t.Context(), artifact.Digest.String(), 5, true)
+		require.NoError(t, err)
+		defer utilio.Close(closer)
+		assert.FileExists(t, filepath.Join(path, "configmap.yaml"))
+	})
+}
+
+func TestExtract_MaxExtractedSizeAcrossLayers(t *testing.T) {
+	store := &taggedStore{Store: memory.New()}
+	artifact := pushArtifact(t, store,
+		map[string][]byte{
+			imagev1.MediaTypeImageLayerGzip: tgzLayer(t, map[string]string{"app/kustomization.yaml": "resources: []"}),
+			imagev1.MediaTypeImageLayer:     []byte(strings.Repeat("a", 3000)),
+		},
+		map[string]map[string]string{
+			imagev1.MediaTypeImageLayerGzip: {imagev1.AnnotationTitle: "app", annotationUnpack: "true"},
+			imagev1.MediaTypeImageLayer:     {imagev1.AnnotationTitle: "data.txt"},
+		},
+		"1.0.0")
+	client := newTestClient(t, store)
+
+	// each layer fits in the limit on its own, but not together
+	_, _, err := client.Extract(t.Context(), artifact.Digest.String(), 3500, false)
+	require.ErrorContains(t, err, "exceeds the maximum extracted size")
+
+	path, closer, err := client.Extract(t.Context(), artifact.Digest.String(), 10000, false)
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	assert.FileExists(t, filepath.Join(path, "data.txt"))
+	assert.FileExists(t, filepath.Join(path, "app", "kustomization.yaml"))
+}
+
+func TestExtract_MaxExtractedSizeBoundary(t *testing.T) {
+	layer := tgzLayer(t, map[string]string{"app/kustomization.yaml": "resources: []"})
+	gzr, err := gzip.NewReader(bytes.NewReader(layer))
+	require.NoError(t, err)
+	tarball, err := io.ReadAll(gzr)
+	require.NoError(t, err)
+	store := &taggedStore{Store: memory.New()}
+	artifact := pushArtifact(t, store,
+		map[string][]byte{imagev1.MediaTypeImageLayerGzip: layer},
+		map[string]map[string]string{imagev1.MediaTypeImageLayerGzip: {imagev1.AnnotationTitle: "app", annotationUnpack: "true"}},
+		"1.0.0")
+	client := newTestClient(t, store)
+
+	// an artifact whose size is exactly the limit is extracted
+	path, closer, err := client.Extract(t.Context(), artifact.Digest.String(), int64(len(tarball)), false)
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	assert.FileExists(t, filepath.Join(path, "app", "kustomization.yaml"))
+
+	_, _, err = client.Extract(t.Context(), artifact.Digest.String(), int64(len(tarball)-1), false)
+	require.ErrorContains(t, err, "exceeds the maximum extracted size")
+}
+
+func TestExtract_UnsupportedMediaType(t *testing.T) {
+	store := &taggedStore{Store: memory.New()}
+	artifact := pushArtifact(t, store,
+		map[string][]byte{"application/vnd.cncf.helm.chart.content.v1.tar+gzip": tgzLayer(t, map[string]string{"Chart.yaml": "name: test"})},
+		nil, "1.0.0")
+	client := newTestClient(t, store)
+
+	_, _, err := client.Extract(t.Context(), artifact.Digest.String(), math.MaxInt64, false)
+	require.ErrorContains(t, err, "unsupported media type")
+
+	client = newTestClient(t, store, WithLayerMediaTypes([]string{"application/vnd.cncf.helm.chart.content.v1.tar+gzip"}))
+	path, closer, err := client.Extract(t.Context(), artifact.Digest.String(), math.MaxInt64, false)
+	require.NoError(t, err)
+	defer utilio.Close(closer)
+	assert.FileExists(t, filepath.Join(path, "Chart.yaml"))
+}
+
+func TestExtract_IllegalTitle(t *testing.T) {
+	store := &taggedStore{Store: memory.New()}
+	artifact := pushArtifact(t, store,
+		map[string][]byte{imagev1.MediaTypeImageLayer: []byte("kind: ConfigMap")},
+		map[string]map[string]string{imagev1.MediaTypeImageLayer: {imagev1.AnnotationTitle: "../../configmap.yaml"}},
+		"1.0.0")
+	client := newTestClient(t, store)
+
+	_, _, err := client.Extract(t.Context(), artifact.Digest.String(), math.MaxInt64, false)
+	assert.ErrorContains(t, err, "illegal filepath")
+}
+
+func TestDigestMetadata(t *testing.T) {
+	store := &taggedStore{Store: memory.New()}
+	artifact := pushArtifact(t, store, map[string][]byte{imagev1.MediaTypeImageLayerGzip: tgzLayer(t, map[string]string{"a.yaml": "a: 1"})}, nil, "1.0.0")
+	client := newTestClient(t, store)
+
+	manifest, err := client.DigestMetadata(t.Context(), artifact.Digest.String())
+	require.NoError(t, err)
+	assert.Equal(t, "argo", manifest.Annotations[imagev1.AnnotationAuthors])
+	assert.Len(t, manifest.Layers, 1)
+
+	_, err = client.DigestMetadata(t.Context(), "1.0.0")
+	assert.ErrorContains(t, err, "must be resolved")
+}
+
+func TestGetTags(t *testing.T) {
+	store := &taggedStore{Store: memory.New()}
+	pushArtifact(t, store, map[string][]byte{imagev1.MediaTypeImageLayerGzip: tgzLayer(t, map[string]string{"a.yaml": "a: 1"})}, nil, "1.0.0", "1.1.0")
+	indexCache := &fakeIndexCache{tags: map[string][]byte{}, digests: map[string]string{}}
+	client := newTestClient(t, store, WithIndexCache(indexCache))
+
+	tags, err := client.GetTags(t.Context(), false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.0.0", "1.1.0"}, tags)
+	assert.JSONEq(t, `["1.0.0","1.1.0"]`, string(indexCache.tags["example.com/argoproj/manifests"]))
+
+	store.tags = append(store.tags, "1.2.0")
+	tags, err = client.GetTags(t.Context(), false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.0.0", "1.1.0"}, tags)
+	tags, err = client.GetTags(t.Context(), true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.0.0", "1.1.0", "1.2.0"}, tags)
+}
+
+func TestTestRepo(t *testing.T) {
+	store := &taggedStore{Store: memory.New()}
+	pushArtifact(t, store, map[string][]byte{imagev1.MediaTypeImageLayerGzip: tgzLayer(t, map[string]string{"a.yaml": "a: 1"})}, nil, "1.0.0")
+	client := newTestClient(t, store)
+
+	ok, err := client.TestRepo(t.Context())
+	require.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestIsOCIRepo(t *testing.T) {
+	assert.True(t, IsOCIRepo("oci://ghcr.io/argoproj/manifests"))
+	assert.False(t, IsOCIRepo("ghcr.io/argoproj/manifests"))
+	assert.False(t, IsOCIRepo("https://github.com/argoproj/argo-cd"))
+}
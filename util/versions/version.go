@@ -6,3 +6,12 @@ func IsVersion(text string) bool {
 	_, err := semver.NewVersion(text)
 	return err == nil
 }
+
+// IsConstraint returns true if the text is a semver constraint which is not a plain version.
+func IsConstraint(text string) bool {
+	if IsVersion(text) {
+		return false
+	}
+	_, err := semver.NewConstraint(text)
+	return err == nil
+}
@@ -13,3 +13,11 @@ func TestIsVersion(t *testing.T) {
 	assert.True(t, IsVersion("1.0"))
 	assert.True(t, IsVersion("1.0.0"))
 }
+
+func TestIsConstraint(t *testing.T) {
+	assert.True(t, IsConstraint("*"))
+	assert.True(t, IsConstraint("1.*"))
+	assert.True(t, IsConstraint(">=1.0.0 <2.0.0"))
+	assert.False(t, IsConstraint("1.0.0"))
+	assert.False(t, IsConstraint("latest"))
+}